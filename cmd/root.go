@@ -15,26 +15,69 @@
 package cmd
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/palantir/conjure-go/v6/conjure"
+	"github.com/palantir/conjure-go/v6/conjure-api/conjure/spec"
 	"github.com/palantir/pkg/cobracli"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 const (
-	outputDirFlagName    = "output"
-	serverFlagName       = "server"
-	funcsVisitorFlagName = "funcs-visitor"
+	configFlagName           = "config"
+	outputDirFlagName        = "output"
+	serverFlagName           = "server"
+	cliFlagName              = "cli"
+	funcsVisitorFlagName     = "funcs-visitor"
+	fakesFlagName            = "fakes"
+	testPairsFlagName        = "test-pairs"
+	externalPkgFlagName      = "external-packages"
+	verifyFlagName           = "verify"
+	keepStaleFlagName        = "keep-stale-files"
+	openAPIFlagName          = "openapi"
+	openAPIFmtFlagName       = "openapi-format"
+	validationFlagName       = "validation"
+	buildersFlagName         = "builders"
+	hashFlagName             = "hash"
+	setTypesFlagName         = "set-types"
+	cborFlagName             = "cbor"
+	authValidatorFlagName    = "auth-validator"
+	interceptorsFlagName     = "endpoint-interceptors"
+	endpointMetadataFlagName = "endpoint-metadata"
+	errorMappingFlagName     = "error-mapping"
+	clientIteratorsFlagName  = "client-iterators"
+	unionHelpersFlagName     = "union-helpers"
 )
 
 var (
-	version             = "unspecified"
-	debug               bool
-	outputDirFlagVar    string
-	serverFlagVar       bool
-	funcsVisitorFlagVar bool
+	version                 = "unspecified"
+	debug                   bool
+	configFlagVar           string
+	outputDirFlagVar        string
+	serverFlagVar           bool
+	cliFlagVar              bool
+	funcsVisitorFlagVar     bool
+	fakesFlagVar            bool
+	testPairsFlagVar        bool
+	externalPkgFlagVar      map[string]string
+	verifyFlagVar           bool
+	keepStaleFlagVar        bool
+	openAPIFlagVar          bool
+	openAPIFmtFlagVar       string
+	validationFlagVar       bool
+	buildersFlagVar         bool
+	hashFlagVar             bool
+	setTypesFlagVar         bool
+	cborFlagVar             bool
+	authValidatorFlagVar    bool
+	interceptorsFlagVar     bool
+	endpointMetadataFlagVar bool
+	errorMappingFlagVar     bool
+	clientIteratorsFlagVar  bool
+	unionHelpersFlagVar     bool
 )
 
 var rootCmd = &cobra.Command{
@@ -42,7 +85,14 @@ var rootCmd = &cobra.Command{
 	Short: "Generates Go files based on a specified input Conjure IR file",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return Generate(args[0], outputDirFlagVar)
+		output, err := outputConfiguration(cmd.Flags())
+		if err != nil {
+			return err
+		}
+		if verifyFlagVar {
+			return verify(cmd, args[0], output)
+		}
+		return generate(args[0], output)
 	},
 }
 
@@ -52,26 +102,147 @@ func Execute() int {
 
 func init() {
 	rootCmd.PersistentFlags().BoolVar(&debug, "debug", false, "print debug output")
+	rootCmd.Flags().StringVar(&configFlagVar, configFlagName, "", "YAML or JSON generator configuration file; flags that are set explicitly override its values and its relative output directories are resolved against its directory")
 	rootCmd.Flags().StringVar(&outputDirFlagVar, outputDirFlagName, ".", "base directory into which generated Conjure is written")
 	rootCmd.Flags().BoolVar(&serverFlagVar, serverFlagName, false, "enable witchcraft-go server generation")
+	rootCmd.Flags().BoolVar(&cliFlagVar, cliFlagName, false, "enable cobra CLI generation")
 	rootCmd.Flags().BoolVar(&funcsVisitorFlagVar, funcsVisitorFlagName, false, "enable witchcraft-go funcs visitor generation")
+	rootCmd.Flags().BoolVar(&fakesFlagVar, fakesFlagName, false, "enable generation of in-memory fake service implementations for tests")
+	rootCmd.Flags().BoolVar(&testPairsFlagVar, testPairsFlagName, false, "enable generation of httptest-backed client/server pairs for tests in <pkg>test subpackages (requires --server)")
+	rootCmd.Flags().StringToStringVar(&externalPkgFlagVar, externalPkgFlagName, nil, "Conjure packages whose generated code already exists, as a comma-separated list of <conjure-package>=<go-import-path> pairs")
+	rootCmd.Flags().BoolVar(&verifyFlagVar, verifyFlagName, false, "print the differences between the generated files and the files on disk without writing, and fail if there are any")
+	rootCmd.Flags().BoolVar(&validationFlagVar, validationFlagName, false, "enable validation of decoded request parameters that implement Validate() error in generated server handlers (requires --server)")
+	rootCmd.Flags().BoolVar(&authValidatorFlagVar, authValidatorFlagName, false, "enable validation of the tokens of authenticated requests by an AuthValidator configured when registering generated server routes (requires --server)")
+	rootCmd.Flags().BoolVar(&interceptorsFlagVar, interceptorsFlagName, false, "enable EndpointInterceptors, configured when registering generated server routes, that wrap the handlers of endpoints (requires --server)")
+	rootCmd.Flags().BoolVar(&endpointMetadataFlagVar, endpointMetadataFlagName, false, "enable generation of <Service>Endpoints variables that describe the endpoints of services")
+	rootCmd.Flags().BoolVar(&errorMappingFlagVar, errorMappingFlagName, false, "enable generation of MapTo<Error> functions, server ErrorMappers, <Service>Errors registries and Is/Match<Service><Endpoint>Error functions for the errors that endpoints declare using error:<namespace>:<name> tags (requires --server)")
+	rootCmd.Flags().BoolVar(&unionHelpersFlagVar, unionHelpersFlagName, false, "enable generation of Match<Union> functions, As<Variant> accessors and <Union>Variant types returned by Type methods for unions")
+	rootCmd.Flags().BoolVar(&clientIteratorsFlagVar, clientIteratorsFlagName, false, "enable generation of <Endpoint>Iter client methods that decode the elements of list and set responses incrementally")
+	rootCmd.Flags().BoolVar(&buildersFlagVar, buildersFlagName, false, "enable generation of New<Object> constructors, With<Field> setters and Validate methods for objects")
+	rootCmd.Flags().BoolVar(&hashFlagVar, hashFlagName, false, "enable generation of Hash methods, consistent with the generated Equal methods, for Conjure types")
+	rootCmd.Flags().BoolVar(&setTypesFlagVar, setTypesFlagName, false, "enable generation of named set types with set semantics instead of slices for Conjure sets of comparable elements")
+	rootCmd.Flags().BoolVar(&cborFlagVar, cborFlagName, false, "enable experimental generation of CBOR encoding methods for Conjure types and CBOR content negotiation in clients and servers")
+	rootCmd.Flags().BoolVar(&openAPIFlagVar, openAPIFlagName, false, "enable generation of an OpenAPI 3.1 document for each service")
+	rootCmd.Flags().StringVar(&openAPIFmtFlagVar, openAPIFmtFlagName, conjure.OpenAPIFormatJSON, "format of the generated OpenAPI documents (json or yaml)")
+	rootCmd.Flags().BoolVar(&keepStaleFlagVar, keepStaleFlagName, false, "do not remove previously generated files that are no longer generated")
 }
 
 func Generate(irFile, outDir string) error {
-	if !strings.HasSuffix(irFile, ".json") {
-		return errors.Errorf(`IR file %s does not have suffix ".json"`, irFile)
+	return generate(irFile, conjure.OutputConfiguration{
+		GenerateFuncsVisitor:         funcsVisitorFlagVar,
+		GenerateServer:               serverFlagVar,
+		GenerateCLI:                  cliFlagVar,
+		GenerateFakes:                fakesFlagVar,
+		GenerateTestPairs:            testPairsFlagVar,
+		OutputDir:                    outDir,
+		ExternalPackages:             externalPkgFlagVar,
+		KeepStaleFiles:               keepStaleFlagVar,
+		GenerateOpenAPI:              openAPIFlagVar,
+		OpenAPIFormat:                openAPIFmtFlagVar,
+		GenerateValidation:           validationFlagVar,
+		GenerateBuilders:             buildersFlagVar,
+		GenerateHash:                 hashFlagVar,
+		GenerateSetTypes:             setTypesFlagVar,
+		GenerateCBOR:                 cborFlagVar,
+		GenerateAuthValidator:        authValidatorFlagVar,
+		GenerateEndpointInterceptors: interceptorsFlagVar,
+		GenerateEndpointMetadata:     endpointMetadataFlagVar,
+		GenerateErrorMapping:         errorMappingFlagVar,
+		GenerateClientIterators:      clientIteratorsFlagVar,
+		GenerateUnionHelpers:         unionHelpersFlagVar,
+	})
+}
+
+// outputConfiguration returns the configuration read from the --config file, if one was provided, with the values
+// of all explicitly set flags applied on top of it. If no configuration file is provided, the flag values are used.
+func outputConfiguration(flags *pflag.FlagSet) (conjure.OutputConfiguration, error) {
+	var output conjure.OutputConfiguration
+	if configFlagVar != "" {
+		cfg, err := conjure.OutputConfigurationFromFile(configFlagVar)
+		if err != nil {
+			return conjure.OutputConfiguration{}, err
+		}
+		output = cfg
+	}
+	if output.OutputDir == "" || flags.Changed(outputDirFlagName) {
+		output.OutputDir = outputDirFlagVar
+	}
+	for _, flag := range []struct {
+		name  string
+		value bool
+		dst   *bool
+	}{
+		{name: serverFlagName, value: serverFlagVar, dst: &output.GenerateServer},
+		{name: cliFlagName, value: cliFlagVar, dst: &output.GenerateCLI},
+		{name: funcsVisitorFlagName, value: funcsVisitorFlagVar, dst: &output.GenerateFuncsVisitor},
+		{name: fakesFlagName, value: fakesFlagVar, dst: &output.GenerateFakes},
+		{name: testPairsFlagName, value: testPairsFlagVar, dst: &output.GenerateTestPairs},
+		{name: keepStaleFlagName, value: keepStaleFlagVar, dst: &output.KeepStaleFiles},
+		{name: openAPIFlagName, value: openAPIFlagVar, dst: &output.GenerateOpenAPI},
+		{name: validationFlagName, value: validationFlagVar, dst: &output.GenerateValidation},
+		{name: buildersFlagName, value: buildersFlagVar, dst: &output.GenerateBuilders},
+		{name: hashFlagName, value: hashFlagVar, dst: &output.GenerateHash},
+		{name: setTypesFlagName, value: setTypesFlagVar, dst: &output.GenerateSetTypes},
+		{name: cborFlagName, value: cborFlagVar, dst: &output.GenerateCBOR},
+		{name: authValidatorFlagName, value: authValidatorFlagVar, dst: &output.GenerateAuthValidator},
+		{name: interceptorsFlagName, value: interceptorsFlagVar, dst: &output.GenerateEndpointInterceptors},
+		{name: endpointMetadataFlagName, value: endpointMetadataFlagVar, dst: &output.GenerateEndpointMetadata},
+		{name: errorMappingFlagName, value: errorMappingFlagVar, dst: &output.GenerateErrorMapping},
+		{name: clientIteratorsFlagName, value: clientIteratorsFlagVar, dst: &output.GenerateClientIterators},
+		{name: unionHelpersFlagName, value: unionHelpersFlagVar, dst: &output.GenerateUnionHelpers},
+	} {
+		if configFlagVar == "" || flags.Changed(flag.name) {
+			*flag.dst = flag.value
+		}
+	}
+	if output.OpenAPIFormat == "" || flags.Changed(openAPIFmtFlagName) {
+		output.OpenAPIFormat = openAPIFmtFlagVar
 	}
-	conjureDefinition, err := conjure.FromIRFile(irFile)
+	if flags.Changed(externalPkgFlagName) {
+		if output.ExternalPackages == nil {
+			output.ExternalPackages = map[string]string{}
+		}
+		for conjurePkg, importPath := range externalPkgFlagVar {
+			output.ExternalPackages[conjurePkg] = importPath
+		}
+	}
+	return output, nil
+}
+
+func generate(irFile string, output conjure.OutputConfiguration) error {
+	conjureDefinition, err := readIRFile(irFile)
 	if err != nil {
 		return err
 	}
-	output := conjure.OutputConfiguration{
-		GenerateFuncsVisitor: funcsVisitorFlagVar,
-		GenerateServer:       serverFlagVar,
-		OutputDir:            outDir,
-	}
 	if err := conjure.Generate(conjureDefinition, output); err != nil {
 		return errors.Wrapf(err, "failed to generate Conjure")
 	}
 	return nil
 }
+
+// verify prints the differences between the files that would be generated and the files on disk and returns an
+// error if there are any.
+func verify(cmd *cobra.Command, irFile string, output conjure.OutputConfiguration) error {
+	conjureDefinition, err := readIRFile(irFile)
+	if err != nil {
+		return err
+	}
+	diffs, err := conjure.Verify(conjureDefinition, output)
+	if err != nil {
+		return errors.Wrapf(err, "failed to verify Conjure")
+	}
+	for _, diff := range diffs {
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%s: %s\n%s", diff.Status, diff.Path, diff.Diff)
+	}
+	if len(diffs) > 0 {
+		return errors.Errorf("%d generated file(s) are out of date", len(diffs))
+	}
+	return nil
+}
+
+func readIRFile(irFile string) (spec.ConjureDefinition, error) {
+	if !strings.HasSuffix(irFile, ".json") {
+		return spec.ConjureDefinition{}, errors.Errorf(`IR file %s does not have suffix ".json"`, irFile)
+	}
+	return conjure.FromIRFile(irFile)
+}
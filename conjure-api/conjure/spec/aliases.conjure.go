@@ -2,17 +2,227 @@
 
 package spec
 
+import (
+	"github.com/tidwall/gjson"
+)
+
 // Must be in lowerCamelCase. Numbers are permitted, but not at the beginning of a word. Allowed argument names: "fooBar", "build2Request". Disallowed names: "FooBar", "2BuildRequest".
 type ArgumentName string
+
+func (a *ArgumentName) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *ArgumentName) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v string
+	v, err = decodeJSONString(value)
+	if err != nil {
+		return err
+	}
+	*a = ArgumentName(v)
+	return nil
+}
+
+// Equal returns true if the ArgumentName is equal to other according to the Conjure semantics of its values.
+func (a ArgumentName) Equal(other ArgumentName) bool {
+	return string(a) == string(other)
+}
+
+// Clone returns a deep copy of the ArgumentName.
+func (a ArgumentName) Clone() ArgumentName {
+	return a
+}
+
 type Documentation string
 
+func (a *Documentation) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *Documentation) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v string
+	v, err = decodeJSONString(value)
+	if err != nil {
+		return err
+	}
+	*a = Documentation(v)
+	return nil
+}
+
+// Equal returns true if the Documentation is equal to other according to the Conjure semantics of its values.
+func (a Documentation) Equal(other Documentation) bool {
+	return string(a) == string(other)
+}
+
+// Clone returns a deep copy of the Documentation.
+func (a Documentation) Clone() Documentation {
+	return a
+}
+
 // Should be in lowerCamelCase.
 type EndpointName string
+
+func (a *EndpointName) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *EndpointName) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v string
+	v, err = decodeJSONString(value)
+	if err != nil {
+		return err
+	}
+	*a = EndpointName(v)
+	return nil
+}
+
+// Equal returns true if the EndpointName is equal to other according to the Conjure semantics of its values.
+func (a EndpointName) Equal(other EndpointName) bool {
+	return string(a) == string(other)
+}
+
+// Clone returns a deep copy of the EndpointName.
+func (a EndpointName) Clone() EndpointName {
+	return a
+}
+
 type ErrorNamespace string
 
+func (a *ErrorNamespace) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *ErrorNamespace) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v string
+	v, err = decodeJSONString(value)
+	if err != nil {
+		return err
+	}
+	*a = ErrorNamespace(v)
+	return nil
+}
+
+// Equal returns true if the ErrorNamespace is equal to other according to the Conjure semantics of its values.
+func (a ErrorNamespace) Equal(other ErrorNamespace) bool {
+	return string(a) == string(other)
+}
+
+// Clone returns a deep copy of the ErrorNamespace.
+func (a ErrorNamespace) Clone() ErrorNamespace {
+	return a
+}
+
 // Should be in lowerCamelCase, but kebab-case and snake_case are also permitted.
 type FieldName string
+
+func (a *FieldName) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *FieldName) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v string
+	v, err = decodeJSONString(value)
+	if err != nil {
+		return err
+	}
+	*a = FieldName(v)
+	return nil
+}
+
+// Equal returns true if the FieldName is equal to other according to the Conjure semantics of its values.
+func (a FieldName) Equal(other FieldName) bool {
+	return string(a) == string(other)
+}
+
+// Clone returns a deep copy of the FieldName.
+func (a FieldName) Clone() FieldName {
+	return a
+}
+
 type HttpPath string
 
+func (a *HttpPath) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *HttpPath) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v string
+	v, err = decodeJSONString(value)
+	if err != nil {
+		return err
+	}
+	*a = HttpPath(v)
+	return nil
+}
+
+// Equal returns true if the HttpPath is equal to other according to the Conjure semantics of its values.
+func (a HttpPath) Equal(other HttpPath) bool {
+	return string(a) == string(other)
+}
+
+// Clone returns a deep copy of the HttpPath.
+func (a HttpPath) Clone() HttpPath {
+	return a
+}
+
 // For header parameters, the parameter id must be in Upper-Kebab-Case. For query parameters, the parameter id must be in lowerCamelCase. Numbers are permitted, but not at the beginning of a word.
 type ParameterId string
+
+func (a *ParameterId) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *ParameterId) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v string
+	v, err = decodeJSONString(value)
+	if err != nil {
+		return err
+	}
+	*a = ParameterId(v)
+	return nil
+}
+
+// Equal returns true if the ParameterId is equal to other according to the Conjure semantics of its values.
+func (a ParameterId) Equal(other ParameterId) bool {
+	return string(a) == string(other)
+}
+
+// Clone returns a deep copy of the ParameterId.
+func (a ParameterId) Clone() ParameterId {
+	return a
+}
@@ -4,6 +4,8 @@ package spec
 
 import (
 	"strings"
+
+	"github.com/tidwall/gjson"
 )
 
 type ErrorCode struct {
@@ -87,6 +89,36 @@ func (e *ErrorCode) UnmarshalText(data []byte) error {
 	return nil
 }
 
+func (e ErrorCode) AppendJSON(out []byte) ([]byte, error) {
+	return appendJSONString(out, string(e.val)), nil
+}
+
+func (e ErrorCode) JSONSize() (int, error) {
+	return jsonStringSize(string(e.val)), nil
+}
+
+func (e *ErrorCode) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return e.decodeJSONStrict(value)
+}
+
+func (e *ErrorCode) decodeJSONStrict(value gjson.Result) error {
+	return decodeJSONEnum(value, e)
+}
+
+// Equal returns true if the ErrorCode is equal to other according to the Conjure semantics of its values.
+func (e ErrorCode) Equal(other ErrorCode) bool {
+	return e.val == other.val
+}
+
+// Clone returns a deep copy of the ErrorCode.
+func (e ErrorCode) Clone() ErrorCode {
+	return e
+}
+
 type HttpMethod struct {
 	val HttpMethod_Value
 }
@@ -150,6 +182,36 @@ func (e *HttpMethod) UnmarshalText(data []byte) error {
 	return nil
 }
 
+func (e HttpMethod) AppendJSON(out []byte) ([]byte, error) {
+	return appendJSONString(out, string(e.val)), nil
+}
+
+func (e HttpMethod) JSONSize() (int, error) {
+	return jsonStringSize(string(e.val)), nil
+}
+
+func (e *HttpMethod) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return e.decodeJSONStrict(value)
+}
+
+func (e *HttpMethod) decodeJSONStrict(value gjson.Result) error {
+	return decodeJSONEnum(value, e)
+}
+
+// Equal returns true if the HttpMethod is equal to other according to the Conjure semantics of its values.
+func (e HttpMethod) Equal(other HttpMethod) bool {
+	return e.val == other.val
+}
+
+// Clone returns a deep copy of the HttpMethod.
+func (e HttpMethod) Clone() HttpMethod {
+	return e
+}
+
 // Safety with regards to logging based on [safe-logging](https://github.com/palantir/safe-logging) concepts.
 type LogSafety struct {
 	val LogSafety_Value
@@ -214,6 +276,36 @@ func (e *LogSafety) UnmarshalText(data []byte) error {
 	return nil
 }
 
+func (e LogSafety) AppendJSON(out []byte) ([]byte, error) {
+	return appendJSONString(out, string(e.val)), nil
+}
+
+func (e LogSafety) JSONSize() (int, error) {
+	return jsonStringSize(string(e.val)), nil
+}
+
+func (e *LogSafety) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return e.decodeJSONStrict(value)
+}
+
+func (e *LogSafety) decodeJSONStrict(value gjson.Result) error {
+	return decodeJSONEnum(value, e)
+}
+
+// Equal returns true if the LogSafety is equal to other according to the Conjure semantics of its values.
+func (e LogSafety) Equal(other LogSafety) bool {
+	return e.val == other.val
+}
+
+// Clone returns a deep copy of the LogSafety.
+func (e LogSafety) Clone() LogSafety {
+	return e
+}
+
 type PrimitiveType struct {
 	val PrimitiveType_Value
 }
@@ -297,3 +389,33 @@ func (e *PrimitiveType) UnmarshalText(data []byte) error {
 	}
 	return nil
 }
+
+func (e PrimitiveType) AppendJSON(out []byte) ([]byte, error) {
+	return appendJSONString(out, string(e.val)), nil
+}
+
+func (e PrimitiveType) JSONSize() (int, error) {
+	return jsonStringSize(string(e.val)), nil
+}
+
+func (e *PrimitiveType) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return e.decodeJSONStrict(value)
+}
+
+func (e *PrimitiveType) decodeJSONStrict(value gjson.Result) error {
+	return decodeJSONEnum(value, e)
+}
+
+// Equal returns true if the PrimitiveType is equal to other according to the Conjure semantics of its values.
+func (e PrimitiveType) Equal(other PrimitiveType) bool {
+	return e.val == other.val
+}
+
+// Clone returns a deep copy of the PrimitiveType.
+func (e PrimitiveType) Clone() PrimitiveType {
+	return e
+}
@@ -3,8 +3,14 @@
 package spec
 
 import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type AliasDefinition struct {
@@ -14,6 +20,233 @@ type AliasDefinition struct {
 	Safety   *LogSafety     `json:"safety"`
 }
 
+func (o AliasDefinition) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"typeName\":"...)
+	out, err = o.TypeName.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"alias\":"...)
+	out, err = o.Alias.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"docs\":"...)
+	if o.Docs == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, string(*o.Docs))
+	}
+	out = append(out, ",\"safety\":"...)
+	if o.Safety == nil {
+		out = append(out, "null"...)
+	} else {
+		out, err = o.Safety.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o AliasDefinition) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 40
+	n, err = o.TypeName.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.Alias.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	if o.Docs == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(string(*o.Docs))
+	}
+	if o.Safety == nil {
+		size += 4
+	} else {
+		n, err = o.Safety.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (o *AliasDefinition) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenTypeName, seenAlias, seenDocs, seenSafety bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "typeName":
+			if seenTypeName {
+				ok = false
+				return false
+			}
+			seenTypeName = true
+			if !o.TypeName.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "alias":
+			if seenAlias {
+				ok = false
+				return false
+			}
+			seenAlias = true
+			if !o.Alias.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "docs":
+			if seenDocs {
+				ok = false
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v Documentation
+				var v1 string
+				if !unmarshalJSONString(field, &v1) {
+					ok = false
+					return false
+				}
+				v = Documentation(v1)
+				o.Docs = &v
+			}
+		case "safety":
+			if seenSafety {
+				ok = false
+				return false
+			}
+			seenSafety = true
+			if field.Type != gjson.Null {
+				var v2 LogSafety
+				if field.Type != gjson.Null {
+					var s string
+					if !unmarshalJSONString(field, &s) || v2.UnmarshalText([]byte(s)) != nil {
+						ok = false
+						return false
+					}
+				}
+				o.Safety = &v2
+			}
+		default:
+			if matchesJSONField(key.Str, "typeName", "alias", "docs", "safety") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *AliasDefinition) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *AliasDefinition) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = AliasDefinition{}
+	var seenTypeName, seenAlias, seenDocs, seenSafety bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "typeName":
+			if seenTypeName {
+				err = fmt.Errorf("duplicate field \"typeName\"")
+				return false
+			}
+			seenTypeName = true
+			err = o.TypeName.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"typeName\": %w", err)
+				return false
+			}
+		case "alias":
+			if seenAlias {
+				err = fmt.Errorf("duplicate field \"alias\"")
+				return false
+			}
+			seenAlias = true
+			err = o.Alias.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"alias\": %w", err)
+				return false
+			}
+		case "docs":
+			if seenDocs {
+				err = fmt.Errorf("duplicate field \"docs\"")
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v Documentation
+				err = v.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"docs\": %w", err)
+					return false
+				}
+				o.Docs = &v
+			}
+		case "safety":
+			if seenSafety {
+				err = fmt.Errorf("duplicate field \"safety\"")
+				return false
+			}
+			seenSafety = true
+			if field.Type != gjson.Null {
+				var v1 LogSafety
+				err = v1.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"safety\": %w", err)
+					return false
+				}
+				o.Safety = &v1
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenTypeName {
+		return fmt.Errorf("field \"typeName\" is required")
+	}
+	if !seenAlias {
+		return fmt.Errorf("field \"alias\" is required")
+	}
+	return nil
+}
+
 func (o AliasDefinition) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -30,6 +263,47 @@ func (o *AliasDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the AliasDefinition is equal to other according to the Conjure semantics of its values.
+func (o AliasDefinition) Equal(other AliasDefinition) bool {
+	if !o.TypeName.Equal(other.TypeName) {
+		return false
+	}
+	if !o.Alias.Equal(other.Alias) {
+		return false
+	}
+	if (o.Docs == nil) != (other.Docs == nil) {
+		return false
+	}
+	if o.Docs != nil {
+		if !(*o.Docs).Equal(*other.Docs) {
+			return false
+		}
+	}
+	if (o.Safety == nil) != (other.Safety == nil) {
+		return false
+	}
+	if o.Safety != nil {
+		if !(*o.Safety).Equal(*other.Safety) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the AliasDefinition.
+func (o AliasDefinition) Clone() AliasDefinition {
+	out := o
+	if o.Docs != nil {
+		v := *o.Docs
+		out.Docs = &v
+	}
+	if o.Safety != nil {
+		v := *o.Safety
+		out.Safety = &v
+	}
+	return out
+}
+
 type ArgumentDefinition struct {
 	ArgName   ArgumentName   `json:"argName"`
 	Type      Type           `json:"type"`
@@ -40,18 +314,122 @@ type ArgumentDefinition struct {
 	Tags      []string       `json:"tags"`
 }
 
-func (o ArgumentDefinition) MarshalJSON() ([]byte, error) {
-	if o.Markers == nil {
-		o.Markers = make([]Type, 0)
+func (o ArgumentDefinition) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"argName\":"...)
+	out = appendJSONString(out, string(o.ArgName))
+	out = append(out, ",\"type\":"...)
+	out, err = o.Type.AppendJSON(out)
+	if err != nil {
+		return nil, err
 	}
-	if o.Tags == nil {
-		o.Tags = make([]string, 0)
+	out = append(out, ",\"paramType\":"...)
+	out, err = o.ParamType.AppendJSON(out)
+	if err != nil {
+		return nil, err
 	}
-	type ArgumentDefinitionAlias ArgumentDefinition
-	return safejson.Marshal(ArgumentDefinitionAlias(o))
+	out = append(out, ",\"safety\":"...)
+	if o.Safety == nil {
+		out = append(out, "null"...)
+	} else {
+		out, err = o.Safety.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ",\"docs\":"...)
+	if o.Docs == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, string(*o.Docs))
+	}
+	out = append(out, ",\"markers\":"...)
+	out = append(out, '[')
+	for i, v := range o.Markers {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	out = append(out, ",\"tags\":"...)
+	out = append(out, '[')
+	for i, v := range o.Tags {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
+	}
+	out = append(out, ']')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o ArgumentDefinition) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 70
+	size += jsonStringSize(string(o.ArgName))
+	n, err = o.Type.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.ParamType.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	if o.Safety == nil {
+		size += 4
+	} else {
+		n, err = o.Safety.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	if o.Docs == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(string(*o.Docs))
+	}
+	size += 2
+	if len(o.Markers) > 1 {
+		size += len(o.Markers) - 1
+	}
+	for _, v := range o.Markers {
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 2
+	if len(o.Tags) > 1 {
+		size += len(o.Tags) - 1
+	}
+	for _, v := range o.Tags {
+		size += jsonStringSize(v)
+	}
+	return size, nil
+}
+
+func (o ArgumentDefinition) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *ArgumentDefinition) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v ArgumentDefinition
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type ArgumentDefinitionAlias ArgumentDefinition
 	var rawArgumentDefinition ArgumentDefinitionAlias
 	if err := safejson.Unmarshal(data, &rawArgumentDefinition); err != nil {
@@ -67,6 +445,327 @@ func (o *ArgumentDefinition) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *ArgumentDefinition) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Markers == nil {
+			o.Markers = make([]Type, 0)
+		}
+		if o.Tags == nil {
+			o.Tags = make([]string, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenArgName, seenType, seenParamType, seenSafety, seenDocs, seenMarkers, seenTags bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "argName":
+			if seenArgName {
+				ok = false
+				return false
+			}
+			seenArgName = true
+			var v string
+			if !unmarshalJSONString(field, &v) {
+				ok = false
+				return false
+			}
+			o.ArgName = ArgumentName(v)
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !o.Type.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "paramType":
+			if seenParamType {
+				ok = false
+				return false
+			}
+			seenParamType = true
+			if !o.ParamType.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "safety":
+			if seenSafety {
+				ok = false
+				return false
+			}
+			seenSafety = true
+			if field.Type != gjson.Null {
+				var v1 LogSafety
+				if field.Type != gjson.Null {
+					var s string
+					if !unmarshalJSONString(field, &s) || v1.UnmarshalText([]byte(s)) != nil {
+						ok = false
+						return false
+					}
+				}
+				o.Safety = &v1
+			}
+		case "docs":
+			if seenDocs {
+				ok = false
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v2 Documentation
+				var v3 string
+				if !unmarshalJSONString(field, &v3) {
+					ok = false
+					return false
+				}
+				v2 = Documentation(v3)
+				o.Docs = &v2
+			}
+		case "markers":
+			if seenMarkers {
+				ok = false
+				return false
+			}
+			seenMarkers = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Markers = make([]Type, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v4 Type
+					if !v4.unmarshalJSONValue(elem) {
+						ok1 = false
+						return false
+					}
+					o.Markers = append(o.Markers, v4)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "tags":
+			if seenTags {
+				ok = false
+				return false
+			}
+			seenTags = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Tags = make([]string, 0)
+				ok2 := true
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v5 string
+					if !unmarshalJSONString(elem1, &v5) {
+						ok2 = false
+						return false
+					}
+					o.Tags = append(o.Tags, v5)
+					return true
+				})
+				if !ok2 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "argName", "type", "paramType", "safety", "docs", "markers", "tags") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Markers == nil {
+		o.Markers = make([]Type, 0)
+	}
+	if o.Tags == nil {
+		o.Tags = make([]string, 0)
+	}
+	return true
+}
+
+func (o *ArgumentDefinition) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *ArgumentDefinition) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = ArgumentDefinition{}
+	var seenArgName, seenType, seenParamType, seenSafety, seenDocs, seenMarkers, seenTags bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "argName":
+			if seenArgName {
+				err = fmt.Errorf("duplicate field \"argName\"")
+				return false
+			}
+			seenArgName = true
+			err = o.ArgName.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"argName\": %w", err)
+				return false
+			}
+		case "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			err = o.Type.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"type\": %w", err)
+				return false
+			}
+		case "paramType":
+			if seenParamType {
+				err = fmt.Errorf("duplicate field \"paramType\"")
+				return false
+			}
+			seenParamType = true
+			err = o.ParamType.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"paramType\": %w", err)
+				return false
+			}
+		case "safety":
+			if seenSafety {
+				err = fmt.Errorf("duplicate field \"safety\"")
+				return false
+			}
+			seenSafety = true
+			if field.Type != gjson.Null {
+				var v LogSafety
+				err = v.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"safety\": %w", err)
+					return false
+				}
+				o.Safety = &v
+			}
+		case "docs":
+			if seenDocs {
+				err = fmt.Errorf("duplicate field \"docs\"")
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v1 Documentation
+				err = v1.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"docs\": %w", err)
+					return false
+				}
+				o.Docs = &v1
+			}
+		case "markers":
+			if seenMarkers {
+				err = fmt.Errorf("duplicate field \"markers\"")
+				return false
+			}
+			seenMarkers = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"markers\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Markers = make([]Type, 0)
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v2 Type
+					err = v2.decodeJSONStrict(elem)
+					if err != nil {
+						return false
+					}
+					o.Markers = append(o.Markers, v2)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"markers\": %w", err)
+					return false
+				}
+			}
+		case "tags":
+			if seenTags {
+				err = fmt.Errorf("duplicate field \"tags\"")
+				return false
+			}
+			seenTags = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"tags\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Tags = make([]string, 0)
+				seen := make(map[string]struct{})
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v3 string
+					v3, err = decodeJSONString(elem1)
+					if err != nil {
+						return false
+					}
+					if _, ok := seen[v3]; ok {
+						err = fmt.Errorf("duplicate set element %s", elem1.Raw)
+						return false
+					}
+					seen[v3] = struct{}{}
+					o.Tags = append(o.Tags, v3)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"tags\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenArgName {
+		return fmt.Errorf("field \"argName\" is required")
+	}
+	if !seenType {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	if !seenParamType {
+		return fmt.Errorf("field \"paramType\" is required")
+	}
+	if o.Markers == nil {
+		o.Markers = make([]Type, 0)
+	}
+	if o.Tags == nil {
+		o.Tags = make([]string, 0)
+	}
+	return nil
+}
+
 func (o ArgumentDefinition) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -83,8 +782,127 @@ func (o *ArgumentDefinition) UnmarshalYAML(unmarshal func(interface{}) error) er
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the ArgumentDefinition is equal to other according to the Conjure semantics of its values.
+func (o ArgumentDefinition) Equal(other ArgumentDefinition) bool {
+	if !o.ArgName.Equal(other.ArgName) {
+		return false
+	}
+	if !o.Type.Equal(other.Type) {
+		return false
+	}
+	if !o.ParamType.Equal(other.ParamType) {
+		return false
+	}
+	if (o.Safety == nil) != (other.Safety == nil) {
+		return false
+	}
+	if o.Safety != nil {
+		if !(*o.Safety).Equal(*other.Safety) {
+			return false
+		}
+	}
+	if (o.Docs == nil) != (other.Docs == nil) {
+		return false
+	}
+	if o.Docs != nil {
+		if !(*o.Docs).Equal(*other.Docs) {
+			return false
+		}
+	}
+	if len(o.Markers) != len(other.Markers) {
+		return false
+	}
+	for i := range o.Markers {
+		if !o.Markers[i].Equal(other.Markers[i]) {
+			return false
+		}
+	}
+	if !func() bool {
+		if len(o.Tags) != len(other.Tags) {
+			return false
+		}
+		counts := make(map[string]int, len(o.Tags))
+		for _, v := range o.Tags {
+			counts[v]++
+		}
+		for _, v := range other.Tags {
+			if counts[v] == 0 {
+				return false
+			}
+			counts[v]--
+		}
+		return true
+	}() {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the ArgumentDefinition.
+func (o ArgumentDefinition) Clone() ArgumentDefinition {
+	out := o
+	if o.Safety != nil {
+		v := *o.Safety
+		out.Safety = &v
+	}
+	if o.Docs != nil {
+		v := *o.Docs
+		out.Docs = &v
+	}
+	if o.Markers != nil {
+		out.Markers = make([]Type, len(o.Markers))
+		copy(out.Markers, o.Markers)
+	}
+	if o.Tags != nil {
+		out.Tags = make([]string, len(o.Tags))
+		copy(out.Tags, o.Tags)
+	}
+	return out
+}
+
 type BodyParameterType struct{}
 
+func (o BodyParameterType) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{}"...)
+	return out, nil
+}
+
+func (o BodyParameterType) JSONSize() (int, error) {
+	size := 2
+	return size, nil
+}
+
+func (o *BodyParameterType) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	return value.IsObject()
+}
+
+func (o *BodyParameterType) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *BodyParameterType) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = BodyParameterType{}
+	var err error
+	value.ForEach(func(key, _ gjson.Result) bool {
+		err = fmt.Errorf("unknown field %q", key.Str)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (o BodyParameterType) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -101,6 +919,17 @@ func (o *BodyParameterType) UnmarshalYAML(unmarshal func(interface{}) error) err
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the BodyParameterType is equal to other according to the Conjure semantics of its values.
+func (o BodyParameterType) Equal(other BodyParameterType) bool {
+	return true
+}
+
+// Clone returns a deep copy of the BodyParameterType.
+func (o BodyParameterType) Clone() BodyParameterType {
+	out := o
+	return out
+}
+
 type ConjureDefinition struct {
 	Version    int                    `json:"version"`
 	Errors     []ErrorDefinition      `json:"errors"`
@@ -109,24 +938,142 @@ type ConjureDefinition struct {
 	Extensions map[string]interface{} `json:"extensions"`
 }
 
-func (o ConjureDefinition) MarshalJSON() ([]byte, error) {
-	if o.Errors == nil {
-		o.Errors = make([]ErrorDefinition, 0)
+func (o ConjureDefinition) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"version\":"...)
+	out = strconv.AppendInt(out, int64(o.Version), 10)
+	out = append(out, ",\"errors\":"...)
+	out = append(out, '[')
+	for i, v := range o.Errors {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	}
-	if o.Types == nil {
-		o.Types = make([]TypeDefinition, 0)
+	out = append(out, ']')
+	out = append(out, ",\"types\":"...)
+	out = append(out, '[')
+	for i, v := range o.Types {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	}
-	if o.Services == nil {
-		o.Services = make([]ServiceDefinition, 0)
+	out = append(out, ']')
+	out = append(out, ",\"services\":"...)
+	out = append(out, '[')
+	for i, v := range o.Services {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	}
-	if o.Extensions == nil {
-		o.Extensions = make(map[string]interface{}, 0)
+	out = append(out, ']')
+	out = append(out, ",\"extensions\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value interface{}
+		}
+		entries := make([]mapEntry, 0, len(o.Extensions))
+		for k, v := range o.Extensions {
+			entries = append(entries, mapEntry{key: k, value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out, err = appendJSONMarshal(out, entry.value)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
-	type ConjureDefinitionAlias ConjureDefinition
-	return safejson.Marshal(ConjureDefinitionAlias(o))
+	out = append(out, '}')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o ConjureDefinition) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 57
+	size += jsonIntSize(int64(o.Version))
+	size += 2
+	if len(o.Errors) > 1 {
+		size += len(o.Errors) - 1
+	}
+	for _, v := range o.Errors {
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 2
+	if len(o.Types) > 1 {
+		size += len(o.Types) - 1
+	}
+	for _, v := range o.Types {
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 2
+	if len(o.Services) > 1 {
+		size += len(o.Services) - 1
+	}
+	for _, v := range o.Services {
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 2
+	if len(o.Extensions) > 1 {
+		size += len(o.Extensions) - 1
+	}
+	for k, v := range o.Extensions {
+		size += jsonStringSize(k) + 1
+		n, err = jsonMarshalSize(v)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (o ConjureDefinition) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *ConjureDefinition) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v ConjureDefinition
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type ConjureDefinitionAlias ConjureDefinition
 	var rawConjureDefinition ConjureDefinitionAlias
 	if err := safejson.Unmarshal(data, &rawConjureDefinition); err != nil {
@@ -148,27 +1095,344 @@ func (o *ConjureDefinition) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-func (o ConjureDefinition) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(o)
-	if err != nil {
-		return nil, err
+func (o *ConjureDefinition) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Errors == nil {
+			o.Errors = make([]ErrorDefinition, 0)
+		}
+		if o.Types == nil {
+			o.Types = make([]TypeDefinition, 0)
+		}
+		if o.Services == nil {
+			o.Services = make([]ServiceDefinition, 0)
+		}
+		if o.Extensions == nil {
+			o.Extensions = make(map[string]interface{}, 0)
+		}
+		return true
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	if !value.IsObject() {
+		return false
+	}
+	var seenVersion, seenErrors, seenTypes, seenServices, seenExtensions bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "version":
+			if seenVersion {
+				ok = false
+				return false
+			}
+			seenVersion = true
+			if !unmarshalJSONInt(field, &o.Version) {
+				ok = false
+				return false
+			}
+		case "errors":
+			if seenErrors {
+				ok = false
+				return false
+			}
+			seenErrors = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Errors = make([]ErrorDefinition, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v ErrorDefinition
+					if !v.unmarshalJSONValue(elem) {
+						ok1 = false
+						return false
+					}
+					o.Errors = append(o.Errors, v)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "types":
+			if seenTypes {
+				ok = false
+				return false
+			}
+			seenTypes = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Types = make([]TypeDefinition, 0)
+				ok2 := true
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v1 TypeDefinition
+					if !v1.unmarshalJSONValue(elem1) {
+						ok2 = false
+						return false
+					}
+					o.Types = append(o.Types, v1)
+					return true
+				})
+				if !ok2 {
+					ok = false
+					return false
+				}
+			}
+		case "services":
+			if seenServices {
+				ok = false
+				return false
+			}
+			seenServices = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Services = make([]ServiceDefinition, 0)
+				ok3 := true
+				field.ForEach(func(_, elem2 gjson.Result) bool {
+					var v2 ServiceDefinition
+					if !v2.unmarshalJSONValue(elem2) {
+						ok3 = false
+						return false
+					}
+					o.Services = append(o.Services, v2)
+					return true
+				})
+				if !ok3 {
+					ok = false
+					return false
+				}
+			}
+		case "extensions":
+			if seenExtensions {
+				ok = false
+				return false
+			}
+			seenExtensions = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.Extensions = make(map[string]interface{})
+				ok4 := true
+				field.ForEach(func(key1, elem3 gjson.Result) bool {
+					var k string
+					if !unmarshalJSONString(key1, &k) {
+						ok4 = false
+						return false
+					}
+					var v3 interface{}
+					if !unmarshalJSONAny(elem3, &v3) {
+						ok4 = false
+						return false
+					}
+					o.Extensions[k] = v3
+					return true
+				})
+				if !ok4 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "version", "errors", "types", "services", "extensions") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Errors == nil {
+		o.Errors = make([]ErrorDefinition, 0)
+	}
+	if o.Types == nil {
+		o.Types = make([]TypeDefinition, 0)
+	}
+	if o.Services == nil {
+		o.Services = make([]ServiceDefinition, 0)
+	}
+	if o.Extensions == nil {
+		o.Extensions = make(map[string]interface{}, 0)
+	}
+	return true
 }
 
-func (o *ConjureDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+func (o *ConjureDefinition) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
 	if err != nil {
 		return err
 	}
-	return safejson.Unmarshal(jsonBytes, *&o)
+	return o.decodeJSONStrict(value)
 }
 
-type CookieAuthType struct {
-	CookieName string `json:"cookieName"`
+func (o *ConjureDefinition) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = ConjureDefinition{}
+	var seenVersion, seenErrors, seenTypes, seenServices, seenExtensions bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "version":
+			if seenVersion {
+				err = fmt.Errorf("duplicate field \"version\"")
+				return false
+			}
+			seenVersion = true
+			o.Version, err = decodeJSONInt(field)
+			if err != nil {
+				err = fmt.Errorf("field \"version\": %w", err)
+				return false
+			}
+		case "errors":
+			if seenErrors {
+				err = fmt.Errorf("duplicate field \"errors\"")
+				return false
+			}
+			seenErrors = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"errors\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Errors = make([]ErrorDefinition, 0)
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v ErrorDefinition
+					err = v.decodeJSONStrict(elem)
+					if err != nil {
+						return false
+					}
+					o.Errors = append(o.Errors, v)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"errors\": %w", err)
+					return false
+				}
+			}
+		case "types":
+			if seenTypes {
+				err = fmt.Errorf("duplicate field \"types\"")
+				return false
+			}
+			seenTypes = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"types\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Types = make([]TypeDefinition, 0)
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v1 TypeDefinition
+					err = v1.decodeJSONStrict(elem1)
+					if err != nil {
+						return false
+					}
+					o.Types = append(o.Types, v1)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"types\": %w", err)
+					return false
+				}
+			}
+		case "services":
+			if seenServices {
+				err = fmt.Errorf("duplicate field \"services\"")
+				return false
+			}
+			seenServices = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"services\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Services = make([]ServiceDefinition, 0)
+				field.ForEach(func(_, elem2 gjson.Result) bool {
+					var v2 ServiceDefinition
+					err = v2.decodeJSONStrict(elem2)
+					if err != nil {
+						return false
+					}
+					o.Services = append(o.Services, v2)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"services\": %w", err)
+					return false
+				}
+			}
+		case "extensions":
+			if seenExtensions {
+				err = fmt.Errorf("duplicate field \"extensions\"")
+				return false
+			}
+			seenExtensions = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"extensions\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.Extensions = make(map[string]interface{})
+				field.ForEach(func(key1, elem3 gjson.Result) bool {
+					var k string
+					k = key1.Str
+					if _, ok := o.Extensions[k]; ok {
+						err = fmt.Errorf("duplicate map key %s", key1.Raw)
+						return false
+					}
+					var v3 interface{}
+					v3, err = decodeJSONAny(elem3)
+					if err != nil {
+						return false
+					}
+					o.Extensions[k] = v3
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"extensions\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenVersion {
+		return fmt.Errorf("field \"version\" is required")
+	}
+	if o.Errors == nil {
+		o.Errors = make([]ErrorDefinition, 0)
+	}
+	if o.Types == nil {
+		o.Types = make([]TypeDefinition, 0)
+	}
+	if o.Services == nil {
+		o.Services = make([]ServiceDefinition, 0)
+	}
+	if o.Extensions == nil {
+		o.Extensions = make(map[string]interface{}, 0)
+	}
+	return nil
 }
 
-func (o CookieAuthType) MarshalYAML() (interface{}, error) {
+func (o ConjureDefinition) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
 		return nil, err
@@ -176,7 +1440,7 @@ func (o CookieAuthType) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (o *CookieAuthType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (o *ConjureDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -184,53 +1448,2574 @@ func (o *CookieAuthType) UnmarshalYAML(unmarshal func(interface{}) error) error
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
-type EndpointDefinition struct {
-	EndpointName EndpointName         `json:"endpointName"`
-	HttpMethod   HttpMethod           `json:"httpMethod"`
-	HttpPath     HttpPath             `json:"httpPath"`
-	Auth         *AuthType            `json:"auth"`
-	Args         []ArgumentDefinition `json:"args"`
-	Returns      *Type                `json:"returns"`
-	Docs         *Documentation       `json:"docs"`
-	Deprecated   *Documentation       `json:"deprecated"`
-	Markers      []Type               `json:"markers"`
-	Tags         []string             `json:"tags"`
+// Equal returns true if the ConjureDefinition is equal to other according to the Conjure semantics of its values.
+func (o ConjureDefinition) Equal(other ConjureDefinition) bool {
+	if o.Version != other.Version {
+		return false
+	}
+	if len(o.Errors) != len(other.Errors) {
+		return false
+	}
+	for i := range o.Errors {
+		if !o.Errors[i].Equal(other.Errors[i]) {
+			return false
+		}
+	}
+	if len(o.Types) != len(other.Types) {
+		return false
+	}
+	for i := range o.Types {
+		if !o.Types[i].Equal(other.Types[i]) {
+			return false
+		}
+	}
+	if len(o.Services) != len(other.Services) {
+		return false
+	}
+	for i := range o.Services {
+		if !o.Services[i].Equal(other.Services[i]) {
+			return false
+		}
+	}
+	if len(o.Extensions) != len(other.Extensions) {
+		return false
+	}
+	for k, v := range o.Extensions {
+		otherV, ok := other.Extensions[k]
+		if !ok {
+			return false
+		}
+		if !reflect.DeepEqual(v, otherV) {
+			return false
+		}
+	}
+	return true
 }
 
-func (o EndpointDefinition) MarshalJSON() ([]byte, error) {
-	if o.Args == nil {
-		o.Args = make([]ArgumentDefinition, 0)
+// Clone returns a deep copy of the ConjureDefinition.
+func (o ConjureDefinition) Clone() ConjureDefinition {
+	out := o
+	if o.Errors != nil {
+		out.Errors = make([]ErrorDefinition, len(o.Errors))
+		for i := range o.Errors {
+			out.Errors[i] = o.Errors[i].Clone()
+		}
 	}
-	if o.Markers == nil {
-		o.Markers = make([]Type, 0)
+	if o.Types != nil {
+		out.Types = make([]TypeDefinition, len(o.Types))
+		for i := range o.Types {
+			out.Types[i] = o.Types[i].Clone()
+		}
 	}
-	if o.Tags == nil {
-		o.Tags = make([]string, 0)
+	if o.Services != nil {
+		out.Services = make([]ServiceDefinition, len(o.Services))
+		for i := range o.Services {
+			out.Services[i] = o.Services[i].Clone()
+		}
 	}
-	type EndpointDefinitionAlias EndpointDefinition
-	return safejson.Marshal(EndpointDefinitionAlias(o))
+	if o.Extensions != nil {
+		out.Extensions = make(map[string]interface{}, len(o.Extensions))
+		for k, v := range o.Extensions {
+			out.Extensions[k] = v
+		}
+	}
+	return out
 }
 
-func (o *EndpointDefinition) UnmarshalJSON(data []byte) error {
-	type EndpointDefinitionAlias EndpointDefinition
-	var rawEndpointDefinition EndpointDefinitionAlias
-	if err := safejson.Unmarshal(data, &rawEndpointDefinition); err != nil {
+type CookieAuthType struct {
+	CookieName string `json:"cookieName"`
+}
+
+func (o CookieAuthType) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"cookieName\":"...)
+	out = appendJSONString(out, o.CookieName)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o CookieAuthType) JSONSize() (int, error) {
+	size := 15
+	size += jsonStringSize(o.CookieName)
+	return size, nil
+}
+
+func (o *CookieAuthType) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenCookieName bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "cookieName":
+			if seenCookieName {
+				ok = false
+				return false
+			}
+			seenCookieName = true
+			if !unmarshalJSONString(field, &o.CookieName) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "cookieName") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *CookieAuthType) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *CookieAuthType) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = CookieAuthType{}
+	var seenCookieName bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "cookieName":
+			if seenCookieName {
+				err = fmt.Errorf("duplicate field \"cookieName\"")
+				return false
+			}
+			seenCookieName = true
+			o.CookieName, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"cookieName\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenCookieName {
+		return fmt.Errorf("field \"cookieName\" is required")
+	}
+	return nil
+}
+
+func (o CookieAuthType) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *CookieAuthType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the CookieAuthType is equal to other according to the Conjure semantics of its values.
+func (o CookieAuthType) Equal(other CookieAuthType) bool {
+	if o.CookieName != other.CookieName {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the CookieAuthType.
+func (o CookieAuthType) Clone() CookieAuthType {
+	out := o
+	return out
+}
+
+type EndpointDefinition struct {
+	EndpointName EndpointName         `json:"endpointName"`
+	HttpMethod   HttpMethod           `json:"httpMethod"`
+	HttpPath     HttpPath             `json:"httpPath"`
+	Auth         *AuthType            `json:"auth"`
+	Args         []ArgumentDefinition `json:"args"`
+	Returns      *Type                `json:"returns"`
+	Docs         *Documentation       `json:"docs"`
+	Deprecated   *Documentation       `json:"deprecated"`
+	Markers      []Type               `json:"markers"`
+	Tags         []string             `json:"tags"`
+}
+
+func (o EndpointDefinition) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"endpointName\":"...)
+	out = appendJSONString(out, string(o.EndpointName))
+	out = append(out, ",\"httpMethod\":"...)
+	out, err = o.HttpMethod.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"httpPath\":"...)
+	out = appendJSONString(out, string(o.HttpPath))
+	out = append(out, ",\"auth\":"...)
+	if o.Auth == nil {
+		out = append(out, "null"...)
+	} else {
+		out, err = o.Auth.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ",\"args\":"...)
+	out = append(out, '[')
+	for i, v := range o.Args {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	out = append(out, ",\"returns\":"...)
+	if o.Returns == nil {
+		out = append(out, "null"...)
+	} else {
+		out, err = o.Returns.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ",\"docs\":"...)
+	if o.Docs == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, string(*o.Docs))
+	}
+	out = append(out, ",\"deprecated\":"...)
+	if o.Deprecated == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, string(*o.Deprecated))
+	}
+	out = append(out, ",\"markers\":"...)
+	out = append(out, '[')
+	for i, v := range o.Markers {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	out = append(out, ",\"tags\":"...)
+	out = append(out, '[')
+	for i, v := range o.Tags {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
+	}
+	out = append(out, ']')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o EndpointDefinition) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 111
+	size += jsonStringSize(string(o.EndpointName))
+	n, err = o.HttpMethod.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	size += jsonStringSize(string(o.HttpPath))
+	if o.Auth == nil {
+		size += 4
+	} else {
+		n, err = o.Auth.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 2
+	if len(o.Args) > 1 {
+		size += len(o.Args) - 1
+	}
+	for _, v := range o.Args {
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	if o.Returns == nil {
+		size += 4
+	} else {
+		n, err = o.Returns.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	if o.Docs == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(string(*o.Docs))
+	}
+	if o.Deprecated == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(string(*o.Deprecated))
+	}
+	size += 2
+	if len(o.Markers) > 1 {
+		size += len(o.Markers) - 1
+	}
+	for _, v := range o.Markers {
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 2
+	if len(o.Tags) > 1 {
+		size += len(o.Tags) - 1
+	}
+	for _, v := range o.Tags {
+		size += jsonStringSize(v)
+	}
+	return size, nil
+}
+
+func (o EndpointDefinition) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
+}
+
+func (o *EndpointDefinition) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v EndpointDefinition
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
+	type EndpointDefinitionAlias EndpointDefinition
+	var rawEndpointDefinition EndpointDefinitionAlias
+	if err := safejson.Unmarshal(data, &rawEndpointDefinition); err != nil {
+		return err
+	}
+	if rawEndpointDefinition.Args == nil {
+		rawEndpointDefinition.Args = make([]ArgumentDefinition, 0)
+	}
+	if rawEndpointDefinition.Markers == nil {
+		rawEndpointDefinition.Markers = make([]Type, 0)
+	}
+	if rawEndpointDefinition.Tags == nil {
+		rawEndpointDefinition.Tags = make([]string, 0)
+	}
+	*o = EndpointDefinition(rawEndpointDefinition)
+	return nil
+}
+
+func (o *EndpointDefinition) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Args == nil {
+			o.Args = make([]ArgumentDefinition, 0)
+		}
+		if o.Markers == nil {
+			o.Markers = make([]Type, 0)
+		}
+		if o.Tags == nil {
+			o.Tags = make([]string, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenEndpointName, seenHttpMethod, seenHttpPath, seenAuth, seenArgs, seenReturns, seenDocs, seenDeprecated, seenMarkers, seenTags bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "endpointName":
+			if seenEndpointName {
+				ok = false
+				return false
+			}
+			seenEndpointName = true
+			var v string
+			if !unmarshalJSONString(field, &v) {
+				ok = false
+				return false
+			}
+			o.EndpointName = EndpointName(v)
+		case "httpMethod":
+			if seenHttpMethod {
+				ok = false
+				return false
+			}
+			seenHttpMethod = true
+			if field.Type != gjson.Null {
+				var s string
+				if !unmarshalJSONString(field, &s) || o.HttpMethod.UnmarshalText([]byte(s)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "httpPath":
+			if seenHttpPath {
+				ok = false
+				return false
+			}
+			seenHttpPath = true
+			var v1 string
+			if !unmarshalJSONString(field, &v1) {
+				ok = false
+				return false
+			}
+			o.HttpPath = HttpPath(v1)
+		case "auth":
+			if seenAuth {
+				ok = false
+				return false
+			}
+			seenAuth = true
+			if field.Type != gjson.Null {
+				var v2 AuthType
+				if !v2.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				o.Auth = &v2
+			}
+		case "args":
+			if seenArgs {
+				ok = false
+				return false
+			}
+			seenArgs = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Args = make([]ArgumentDefinition, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v3 ArgumentDefinition
+					if !v3.unmarshalJSONValue(elem) {
+						ok1 = false
+						return false
+					}
+					o.Args = append(o.Args, v3)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "returns":
+			if seenReturns {
+				ok = false
+				return false
+			}
+			seenReturns = true
+			if field.Type != gjson.Null {
+				var v4 Type
+				if !v4.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				o.Returns = &v4
+			}
+		case "docs":
+			if seenDocs {
+				ok = false
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v5 Documentation
+				var v6 string
+				if !unmarshalJSONString(field, &v6) {
+					ok = false
+					return false
+				}
+				v5 = Documentation(v6)
+				o.Docs = &v5
+			}
+		case "deprecated":
+			if seenDeprecated {
+				ok = false
+				return false
+			}
+			seenDeprecated = true
+			if field.Type != gjson.Null {
+				var v7 Documentation
+				var v8 string
+				if !unmarshalJSONString(field, &v8) {
+					ok = false
+					return false
+				}
+				v7 = Documentation(v8)
+				o.Deprecated = &v7
+			}
+		case "markers":
+			if seenMarkers {
+				ok = false
+				return false
+			}
+			seenMarkers = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Markers = make([]Type, 0)
+				ok2 := true
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v9 Type
+					if !v9.unmarshalJSONValue(elem1) {
+						ok2 = false
+						return false
+					}
+					o.Markers = append(o.Markers, v9)
+					return true
+				})
+				if !ok2 {
+					ok = false
+					return false
+				}
+			}
+		case "tags":
+			if seenTags {
+				ok = false
+				return false
+			}
+			seenTags = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Tags = make([]string, 0)
+				ok3 := true
+				field.ForEach(func(_, elem2 gjson.Result) bool {
+					var v10 string
+					if !unmarshalJSONString(elem2, &v10) {
+						ok3 = false
+						return false
+					}
+					o.Tags = append(o.Tags, v10)
+					return true
+				})
+				if !ok3 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "endpointName", "httpMethod", "httpPath", "auth", "args", "returns", "docs", "deprecated", "markers", "tags") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Args == nil {
+		o.Args = make([]ArgumentDefinition, 0)
+	}
+	if o.Markers == nil {
+		o.Markers = make([]Type, 0)
+	}
+	if o.Tags == nil {
+		o.Tags = make([]string, 0)
+	}
+	return true
+}
+
+func (o *EndpointDefinition) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *EndpointDefinition) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = EndpointDefinition{}
+	var seenEndpointName, seenHttpMethod, seenHttpPath, seenAuth, seenArgs, seenReturns, seenDocs, seenDeprecated, seenMarkers, seenTags bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "endpointName":
+			if seenEndpointName {
+				err = fmt.Errorf("duplicate field \"endpointName\"")
+				return false
+			}
+			seenEndpointName = true
+			err = o.EndpointName.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"endpointName\": %w", err)
+				return false
+			}
+		case "httpMethod":
+			if seenHttpMethod {
+				err = fmt.Errorf("duplicate field \"httpMethod\"")
+				return false
+			}
+			seenHttpMethod = true
+			err = o.HttpMethod.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"httpMethod\": %w", err)
+				return false
+			}
+		case "httpPath":
+			if seenHttpPath {
+				err = fmt.Errorf("duplicate field \"httpPath\"")
+				return false
+			}
+			seenHttpPath = true
+			err = o.HttpPath.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"httpPath\": %w", err)
+				return false
+			}
+		case "auth":
+			if seenAuth {
+				err = fmt.Errorf("duplicate field \"auth\"")
+				return false
+			}
+			seenAuth = true
+			if field.Type != gjson.Null {
+				var v AuthType
+				err = v.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"auth\": %w", err)
+					return false
+				}
+				o.Auth = &v
+			}
+		case "args":
+			if seenArgs {
+				err = fmt.Errorf("duplicate field \"args\"")
+				return false
+			}
+			seenArgs = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"args\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Args = make([]ArgumentDefinition, 0)
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v1 ArgumentDefinition
+					err = v1.decodeJSONStrict(elem)
+					if err != nil {
+						return false
+					}
+					o.Args = append(o.Args, v1)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"args\": %w", err)
+					return false
+				}
+			}
+		case "returns":
+			if seenReturns {
+				err = fmt.Errorf("duplicate field \"returns\"")
+				return false
+			}
+			seenReturns = true
+			if field.Type != gjson.Null {
+				var v2 Type
+				err = v2.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"returns\": %w", err)
+					return false
+				}
+				o.Returns = &v2
+			}
+		case "docs":
+			if seenDocs {
+				err = fmt.Errorf("duplicate field \"docs\"")
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v3 Documentation
+				err = v3.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"docs\": %w", err)
+					return false
+				}
+				o.Docs = &v3
+			}
+		case "deprecated":
+			if seenDeprecated {
+				err = fmt.Errorf("duplicate field \"deprecated\"")
+				return false
+			}
+			seenDeprecated = true
+			if field.Type != gjson.Null {
+				var v4 Documentation
+				err = v4.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"deprecated\": %w", err)
+					return false
+				}
+				o.Deprecated = &v4
+			}
+		case "markers":
+			if seenMarkers {
+				err = fmt.Errorf("duplicate field \"markers\"")
+				return false
+			}
+			seenMarkers = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"markers\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Markers = make([]Type, 0)
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v5 Type
+					err = v5.decodeJSONStrict(elem1)
+					if err != nil {
+						return false
+					}
+					o.Markers = append(o.Markers, v5)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"markers\": %w", err)
+					return false
+				}
+			}
+		case "tags":
+			if seenTags {
+				err = fmt.Errorf("duplicate field \"tags\"")
+				return false
+			}
+			seenTags = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"tags\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Tags = make([]string, 0)
+				seen := make(map[string]struct{})
+				field.ForEach(func(_, elem2 gjson.Result) bool {
+					var v6 string
+					v6, err = decodeJSONString(elem2)
+					if err != nil {
+						return false
+					}
+					if _, ok := seen[v6]; ok {
+						err = fmt.Errorf("duplicate set element %s", elem2.Raw)
+						return false
+					}
+					seen[v6] = struct{}{}
+					o.Tags = append(o.Tags, v6)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"tags\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenEndpointName {
+		return fmt.Errorf("field \"endpointName\" is required")
+	}
+	if !seenHttpMethod {
+		return fmt.Errorf("field \"httpMethod\" is required")
+	}
+	if !seenHttpPath {
+		return fmt.Errorf("field \"httpPath\" is required")
+	}
+	if o.Args == nil {
+		o.Args = make([]ArgumentDefinition, 0)
+	}
+	if o.Markers == nil {
+		o.Markers = make([]Type, 0)
+	}
+	if o.Tags == nil {
+		o.Tags = make([]string, 0)
+	}
+	return nil
+}
+
+func (o EndpointDefinition) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *EndpointDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the EndpointDefinition is equal to other according to the Conjure semantics of its values.
+func (o EndpointDefinition) Equal(other EndpointDefinition) bool {
+	if !o.EndpointName.Equal(other.EndpointName) {
+		return false
+	}
+	if !o.HttpMethod.Equal(other.HttpMethod) {
+		return false
+	}
+	if !o.HttpPath.Equal(other.HttpPath) {
+		return false
+	}
+	if (o.Auth == nil) != (other.Auth == nil) {
+		return false
+	}
+	if o.Auth != nil {
+		if !(*o.Auth).Equal(*other.Auth) {
+			return false
+		}
+	}
+	if len(o.Args) != len(other.Args) {
+		return false
+	}
+	for i := range o.Args {
+		if !o.Args[i].Equal(other.Args[i]) {
+			return false
+		}
+	}
+	if (o.Returns == nil) != (other.Returns == nil) {
+		return false
+	}
+	if o.Returns != nil {
+		if !(*o.Returns).Equal(*other.Returns) {
+			return false
+		}
+	}
+	if (o.Docs == nil) != (other.Docs == nil) {
+		return false
+	}
+	if o.Docs != nil {
+		if !(*o.Docs).Equal(*other.Docs) {
+			return false
+		}
+	}
+	if (o.Deprecated == nil) != (other.Deprecated == nil) {
+		return false
+	}
+	if o.Deprecated != nil {
+		if !(*o.Deprecated).Equal(*other.Deprecated) {
+			return false
+		}
+	}
+	if len(o.Markers) != len(other.Markers) {
+		return false
+	}
+	for i := range o.Markers {
+		if !o.Markers[i].Equal(other.Markers[i]) {
+			return false
+		}
+	}
+	if !func() bool {
+		if len(o.Tags) != len(other.Tags) {
+			return false
+		}
+		counts := make(map[string]int, len(o.Tags))
+		for _, v := range o.Tags {
+			counts[v]++
+		}
+		for _, v := range other.Tags {
+			if counts[v] == 0 {
+				return false
+			}
+			counts[v]--
+		}
+		return true
+	}() {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the EndpointDefinition.
+func (o EndpointDefinition) Clone() EndpointDefinition {
+	out := o
+	if o.Auth != nil {
+		v := *o.Auth
+		out.Auth = &v
+	}
+	if o.Args != nil {
+		out.Args = make([]ArgumentDefinition, len(o.Args))
+		for i := range o.Args {
+			out.Args[i] = o.Args[i].Clone()
+		}
+	}
+	if o.Returns != nil {
+		v := *o.Returns
+		out.Returns = &v
+	}
+	if o.Docs != nil {
+		v := *o.Docs
+		out.Docs = &v
+	}
+	if o.Deprecated != nil {
+		v := *o.Deprecated
+		out.Deprecated = &v
+	}
+	if o.Markers != nil {
+		out.Markers = make([]Type, len(o.Markers))
+		copy(out.Markers, o.Markers)
+	}
+	if o.Tags != nil {
+		out.Tags = make([]string, len(o.Tags))
+		copy(out.Tags, o.Tags)
+	}
+	return out
+}
+
+type EnumDefinition struct {
+	TypeName TypeName              `json:"typeName"`
+	Values   []EnumValueDefinition `json:"values"`
+	Docs     *Documentation        `json:"docs"`
+}
+
+func (o EnumDefinition) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"typeName\":"...)
+	out, err = o.TypeName.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"values\":"...)
+	out = append(out, '[')
+	for i, v := range o.Values {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	out = append(out, ",\"docs\":"...)
+	if o.Docs == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, string(*o.Docs))
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o EnumDefinition) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 31
+	n, err = o.TypeName.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	size += 2
+	if len(o.Values) > 1 {
+		size += len(o.Values) - 1
+	}
+	for _, v := range o.Values {
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	if o.Docs == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(string(*o.Docs))
+	}
+	return size, nil
+}
+
+func (o EnumDefinition) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
+}
+
+func (o *EnumDefinition) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v EnumDefinition
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
+	type EnumDefinitionAlias EnumDefinition
+	var rawEnumDefinition EnumDefinitionAlias
+	if err := safejson.Unmarshal(data, &rawEnumDefinition); err != nil {
+		return err
+	}
+	if rawEnumDefinition.Values == nil {
+		rawEnumDefinition.Values = make([]EnumValueDefinition, 0)
+	}
+	*o = EnumDefinition(rawEnumDefinition)
+	return nil
+}
+
+func (o *EnumDefinition) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Values == nil {
+			o.Values = make([]EnumValueDefinition, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenTypeName, seenValues, seenDocs bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "typeName":
+			if seenTypeName {
+				ok = false
+				return false
+			}
+			seenTypeName = true
+			if !o.TypeName.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "values":
+			if seenValues {
+				ok = false
+				return false
+			}
+			seenValues = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Values = make([]EnumValueDefinition, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v EnumValueDefinition
+					if !v.unmarshalJSONValue(elem) {
+						ok1 = false
+						return false
+					}
+					o.Values = append(o.Values, v)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "docs":
+			if seenDocs {
+				ok = false
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v1 Documentation
+				var v2 string
+				if !unmarshalJSONString(field, &v2) {
+					ok = false
+					return false
+				}
+				v1 = Documentation(v2)
+				o.Docs = &v1
+			}
+		default:
+			if matchesJSONField(key.Str, "typeName", "values", "docs") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Values == nil {
+		o.Values = make([]EnumValueDefinition, 0)
+	}
+	return true
+}
+
+func (o *EnumDefinition) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *EnumDefinition) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = EnumDefinition{}
+	var seenTypeName, seenValues, seenDocs bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "typeName":
+			if seenTypeName {
+				err = fmt.Errorf("duplicate field \"typeName\"")
+				return false
+			}
+			seenTypeName = true
+			err = o.TypeName.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"typeName\": %w", err)
+				return false
+			}
+		case "values":
+			if seenValues {
+				err = fmt.Errorf("duplicate field \"values\"")
+				return false
+			}
+			seenValues = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"values\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Values = make([]EnumValueDefinition, 0)
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v EnumValueDefinition
+					err = v.decodeJSONStrict(elem)
+					if err != nil {
+						return false
+					}
+					o.Values = append(o.Values, v)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"values\": %w", err)
+					return false
+				}
+			}
+		case "docs":
+			if seenDocs {
+				err = fmt.Errorf("duplicate field \"docs\"")
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v1 Documentation
+				err = v1.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"docs\": %w", err)
+					return false
+				}
+				o.Docs = &v1
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenTypeName {
+		return fmt.Errorf("field \"typeName\" is required")
+	}
+	if o.Values == nil {
+		o.Values = make([]EnumValueDefinition, 0)
+	}
+	return nil
+}
+
+func (o EnumDefinition) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *EnumDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the EnumDefinition is equal to other according to the Conjure semantics of its values.
+func (o EnumDefinition) Equal(other EnumDefinition) bool {
+	if !o.TypeName.Equal(other.TypeName) {
+		return false
+	}
+	if len(o.Values) != len(other.Values) {
+		return false
+	}
+	for i := range o.Values {
+		if !o.Values[i].Equal(other.Values[i]) {
+			return false
+		}
+	}
+	if (o.Docs == nil) != (other.Docs == nil) {
+		return false
+	}
+	if o.Docs != nil {
+		if !(*o.Docs).Equal(*other.Docs) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the EnumDefinition.
+func (o EnumDefinition) Clone() EnumDefinition {
+	out := o
+	if o.Values != nil {
+		out.Values = make([]EnumValueDefinition, len(o.Values))
+		for i := range o.Values {
+			out.Values[i] = o.Values[i].Clone()
+		}
+	}
+	if o.Docs != nil {
+		v := *o.Docs
+		out.Docs = &v
+	}
+	return out
+}
+
+type EnumValueDefinition struct {
+	Value      string         `json:"value"`
+	Docs       *Documentation `json:"docs"`
+	Deprecated *Documentation `json:"deprecated"`
+}
+
+func (o EnumValueDefinition) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"value\":"...)
+	out = appendJSONString(out, o.Value)
+	out = append(out, ",\"docs\":"...)
+	if o.Docs == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, string(*o.Docs))
+	}
+	out = append(out, ",\"deprecated\":"...)
+	if o.Deprecated == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, string(*o.Deprecated))
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o EnumValueDefinition) JSONSize() (int, error) {
+	size := 32
+	size += jsonStringSize(o.Value)
+	if o.Docs == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(string(*o.Docs))
+	}
+	if o.Deprecated == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(string(*o.Deprecated))
+	}
+	return size, nil
+}
+
+func (o *EnumValueDefinition) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue, seenDocs, seenDeprecated bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if !unmarshalJSONString(field, &o.Value) {
+				ok = false
+				return false
+			}
+		case "docs":
+			if seenDocs {
+				ok = false
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v Documentation
+				var v1 string
+				if !unmarshalJSONString(field, &v1) {
+					ok = false
+					return false
+				}
+				v = Documentation(v1)
+				o.Docs = &v
+			}
+		case "deprecated":
+			if seenDeprecated {
+				ok = false
+				return false
+			}
+			seenDeprecated = true
+			if field.Type != gjson.Null {
+				var v2 Documentation
+				var v3 string
+				if !unmarshalJSONString(field, &v3) {
+					ok = false
+					return false
+				}
+				v2 = Documentation(v3)
+				o.Deprecated = &v2
+			}
+		default:
+			if matchesJSONField(key.Str, "value", "docs", "deprecated") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *EnumValueDefinition) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *EnumValueDefinition) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = EnumValueDefinition{}
+	var seenValue, seenDocs, seenDeprecated bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			o.Value, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"value\": %w", err)
+				return false
+			}
+		case "docs":
+			if seenDocs {
+				err = fmt.Errorf("duplicate field \"docs\"")
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v Documentation
+				err = v.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"docs\": %w", err)
+					return false
+				}
+				o.Docs = &v
+			}
+		case "deprecated":
+			if seenDeprecated {
+				err = fmt.Errorf("duplicate field \"deprecated\"")
+				return false
+			}
+			seenDeprecated = true
+			if field.Type != gjson.Null {
+				var v1 Documentation
+				err = v1.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"deprecated\": %w", err)
+					return false
+				}
+				o.Deprecated = &v1
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenValue {
+		return fmt.Errorf("field \"value\" is required")
+	}
+	return nil
+}
+
+func (o EnumValueDefinition) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *EnumValueDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the EnumValueDefinition is equal to other according to the Conjure semantics of its values.
+func (o EnumValueDefinition) Equal(other EnumValueDefinition) bool {
+	if o.Value != other.Value {
+		return false
+	}
+	if (o.Docs == nil) != (other.Docs == nil) {
+		return false
+	}
+	if o.Docs != nil {
+		if !(*o.Docs).Equal(*other.Docs) {
+			return false
+		}
+	}
+	if (o.Deprecated == nil) != (other.Deprecated == nil) {
+		return false
+	}
+	if o.Deprecated != nil {
+		if !(*o.Deprecated).Equal(*other.Deprecated) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the EnumValueDefinition.
+func (o EnumValueDefinition) Clone() EnumValueDefinition {
+	out := o
+	if o.Docs != nil {
+		v := *o.Docs
+		out.Docs = &v
+	}
+	if o.Deprecated != nil {
+		v := *o.Deprecated
+		out.Deprecated = &v
+	}
+	return out
+}
+
+type ErrorDefinition struct {
+	ErrorName  TypeName          `json:"errorName"`
+	Docs       *Documentation    `json:"docs"`
+	Namespace  ErrorNamespace    `json:"namespace"`
+	Code       ErrorCode         `json:"code"`
+	SafeArgs   []FieldDefinition `json:"safeArgs"`
+	UnsafeArgs []FieldDefinition `json:"unsafeArgs"`
+}
+
+func (o ErrorDefinition) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"errorName\":"...)
+	out, err = o.ErrorName.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"docs\":"...)
+	if o.Docs == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, string(*o.Docs))
+	}
+	out = append(out, ",\"namespace\":"...)
+	out = appendJSONString(out, string(o.Namespace))
+	out = append(out, ",\"code\":"...)
+	out, err = o.Code.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"safeArgs\":"...)
+	out = append(out, '[')
+	for i, v := range o.SafeArgs {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	out = append(out, ",\"unsafeArgs\":"...)
+	out = append(out, '[')
+	for i, v := range o.UnsafeArgs {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o ErrorDefinition) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 69
+	n, err = o.ErrorName.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	if o.Docs == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(string(*o.Docs))
+	}
+	size += jsonStringSize(string(o.Namespace))
+	n, err = o.Code.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	size += 2
+	if len(o.SafeArgs) > 1 {
+		size += len(o.SafeArgs) - 1
+	}
+	for _, v := range o.SafeArgs {
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 2
+	if len(o.UnsafeArgs) > 1 {
+		size += len(o.UnsafeArgs) - 1
+	}
+	for _, v := range o.UnsafeArgs {
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (o ErrorDefinition) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
+}
+
+func (o *ErrorDefinition) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v ErrorDefinition
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
+	type ErrorDefinitionAlias ErrorDefinition
+	var rawErrorDefinition ErrorDefinitionAlias
+	if err := safejson.Unmarshal(data, &rawErrorDefinition); err != nil {
+		return err
+	}
+	if rawErrorDefinition.SafeArgs == nil {
+		rawErrorDefinition.SafeArgs = make([]FieldDefinition, 0)
+	}
+	if rawErrorDefinition.UnsafeArgs == nil {
+		rawErrorDefinition.UnsafeArgs = make([]FieldDefinition, 0)
+	}
+	*o = ErrorDefinition(rawErrorDefinition)
+	return nil
+}
+
+func (o *ErrorDefinition) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.SafeArgs == nil {
+			o.SafeArgs = make([]FieldDefinition, 0)
+		}
+		if o.UnsafeArgs == nil {
+			o.UnsafeArgs = make([]FieldDefinition, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenErrorName, seenDocs, seenNamespace, seenCode, seenSafeArgs, seenUnsafeArgs bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "errorName":
+			if seenErrorName {
+				ok = false
+				return false
+			}
+			seenErrorName = true
+			if !o.ErrorName.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "docs":
+			if seenDocs {
+				ok = false
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v Documentation
+				var v1 string
+				if !unmarshalJSONString(field, &v1) {
+					ok = false
+					return false
+				}
+				v = Documentation(v1)
+				o.Docs = &v
+			}
+		case "namespace":
+			if seenNamespace {
+				ok = false
+				return false
+			}
+			seenNamespace = true
+			var v2 string
+			if !unmarshalJSONString(field, &v2) {
+				ok = false
+				return false
+			}
+			o.Namespace = ErrorNamespace(v2)
+		case "code":
+			if seenCode {
+				ok = false
+				return false
+			}
+			seenCode = true
+			if field.Type != gjson.Null {
+				var s string
+				if !unmarshalJSONString(field, &s) || o.Code.UnmarshalText([]byte(s)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "safeArgs":
+			if seenSafeArgs {
+				ok = false
+				return false
+			}
+			seenSafeArgs = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.SafeArgs = make([]FieldDefinition, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v3 FieldDefinition
+					if !v3.unmarshalJSONValue(elem) {
+						ok1 = false
+						return false
+					}
+					o.SafeArgs = append(o.SafeArgs, v3)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "unsafeArgs":
+			if seenUnsafeArgs {
+				ok = false
+				return false
+			}
+			seenUnsafeArgs = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.UnsafeArgs = make([]FieldDefinition, 0)
+				ok2 := true
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v4 FieldDefinition
+					if !v4.unmarshalJSONValue(elem1) {
+						ok2 = false
+						return false
+					}
+					o.UnsafeArgs = append(o.UnsafeArgs, v4)
+					return true
+				})
+				if !ok2 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "errorName", "docs", "namespace", "code", "safeArgs", "unsafeArgs") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.SafeArgs == nil {
+		o.SafeArgs = make([]FieldDefinition, 0)
+	}
+	if o.UnsafeArgs == nil {
+		o.UnsafeArgs = make([]FieldDefinition, 0)
+	}
+	return true
+}
+
+func (o *ErrorDefinition) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *ErrorDefinition) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = ErrorDefinition{}
+	var seenErrorName, seenDocs, seenNamespace, seenCode, seenSafeArgs, seenUnsafeArgs bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "errorName":
+			if seenErrorName {
+				err = fmt.Errorf("duplicate field \"errorName\"")
+				return false
+			}
+			seenErrorName = true
+			err = o.ErrorName.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"errorName\": %w", err)
+				return false
+			}
+		case "docs":
+			if seenDocs {
+				err = fmt.Errorf("duplicate field \"docs\"")
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v Documentation
+				err = v.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"docs\": %w", err)
+					return false
+				}
+				o.Docs = &v
+			}
+		case "namespace":
+			if seenNamespace {
+				err = fmt.Errorf("duplicate field \"namespace\"")
+				return false
+			}
+			seenNamespace = true
+			err = o.Namespace.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"namespace\": %w", err)
+				return false
+			}
+		case "code":
+			if seenCode {
+				err = fmt.Errorf("duplicate field \"code\"")
+				return false
+			}
+			seenCode = true
+			err = o.Code.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"code\": %w", err)
+				return false
+			}
+		case "safeArgs":
+			if seenSafeArgs {
+				err = fmt.Errorf("duplicate field \"safeArgs\"")
+				return false
+			}
+			seenSafeArgs = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"safeArgs\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.SafeArgs = make([]FieldDefinition, 0)
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v1 FieldDefinition
+					err = v1.decodeJSONStrict(elem)
+					if err != nil {
+						return false
+					}
+					o.SafeArgs = append(o.SafeArgs, v1)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"safeArgs\": %w", err)
+					return false
+				}
+			}
+		case "unsafeArgs":
+			if seenUnsafeArgs {
+				err = fmt.Errorf("duplicate field \"unsafeArgs\"")
+				return false
+			}
+			seenUnsafeArgs = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"unsafeArgs\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.UnsafeArgs = make([]FieldDefinition, 0)
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v2 FieldDefinition
+					err = v2.decodeJSONStrict(elem1)
+					if err != nil {
+						return false
+					}
+					o.UnsafeArgs = append(o.UnsafeArgs, v2)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"unsafeArgs\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenErrorName {
+		return fmt.Errorf("field \"errorName\" is required")
+	}
+	if !seenNamespace {
+		return fmt.Errorf("field \"namespace\" is required")
+	}
+	if !seenCode {
+		return fmt.Errorf("field \"code\" is required")
+	}
+	if o.SafeArgs == nil {
+		o.SafeArgs = make([]FieldDefinition, 0)
+	}
+	if o.UnsafeArgs == nil {
+		o.UnsafeArgs = make([]FieldDefinition, 0)
+	}
+	return nil
+}
+
+func (o ErrorDefinition) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *ErrorDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the ErrorDefinition is equal to other according to the Conjure semantics of its values.
+func (o ErrorDefinition) Equal(other ErrorDefinition) bool {
+	if !o.ErrorName.Equal(other.ErrorName) {
+		return false
+	}
+	if (o.Docs == nil) != (other.Docs == nil) {
+		return false
+	}
+	if o.Docs != nil {
+		if !(*o.Docs).Equal(*other.Docs) {
+			return false
+		}
+	}
+	if !o.Namespace.Equal(other.Namespace) {
+		return false
+	}
+	if !o.Code.Equal(other.Code) {
+		return false
+	}
+	if len(o.SafeArgs) != len(other.SafeArgs) {
+		return false
+	}
+	for i := range o.SafeArgs {
+		if !o.SafeArgs[i].Equal(other.SafeArgs[i]) {
+			return false
+		}
+	}
+	if len(o.UnsafeArgs) != len(other.UnsafeArgs) {
+		return false
+	}
+	for i := range o.UnsafeArgs {
+		if !o.UnsafeArgs[i].Equal(other.UnsafeArgs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the ErrorDefinition.
+func (o ErrorDefinition) Clone() ErrorDefinition {
+	out := o
+	if o.Docs != nil {
+		v := *o.Docs
+		out.Docs = &v
+	}
+	if o.SafeArgs != nil {
+		out.SafeArgs = make([]FieldDefinition, len(o.SafeArgs))
+		for i := range o.SafeArgs {
+			out.SafeArgs[i] = o.SafeArgs[i].Clone()
+		}
+	}
+	if o.UnsafeArgs != nil {
+		out.UnsafeArgs = make([]FieldDefinition, len(o.UnsafeArgs))
+		for i := range o.UnsafeArgs {
+			out.UnsafeArgs[i] = o.UnsafeArgs[i].Clone()
+		}
+	}
+	return out
+}
+
+type ExternalReference struct {
+	// An identifier for a non-Conjure type which is already defined in a different language (e.g. Java).
+	ExternalReference TypeName `conjure-docs:"An identifier for a non-Conjure type which is already defined in a different language (e.g. Java)." json:"externalReference"`
+	// Other language generators may use the provided fallback if the non-Conjure type is not available. The ANY PrimitiveType is permissible for all external types, but a more specific definition is preferable.
+	Fallback Type `conjure-docs:"Other language generators may use the provided fallback if the non-Conjure type is not available. The ANY PrimitiveType is permissible for all external types, but a more specific definition is preferable." json:"fallback"`
+}
+
+func (o ExternalReference) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"externalReference\":"...)
+	out, err = o.ExternalReference.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"fallback\":"...)
+	out, err = o.Fallback.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o ExternalReference) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 34
+	n, err = o.ExternalReference.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.Fallback.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (o *ExternalReference) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenExternalReference, seenFallback bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "externalReference":
+			if seenExternalReference {
+				ok = false
+				return false
+			}
+			seenExternalReference = true
+			if !o.ExternalReference.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "fallback":
+			if seenFallback {
+				ok = false
+				return false
+			}
+			seenFallback = true
+			if !o.Fallback.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "externalReference", "fallback") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *ExternalReference) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *ExternalReference) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = ExternalReference{}
+	var seenExternalReference, seenFallback bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "externalReference":
+			if seenExternalReference {
+				err = fmt.Errorf("duplicate field \"externalReference\"")
+				return false
+			}
+			seenExternalReference = true
+			err = o.ExternalReference.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"externalReference\": %w", err)
+				return false
+			}
+		case "fallback":
+			if seenFallback {
+				err = fmt.Errorf("duplicate field \"fallback\"")
+				return false
+			}
+			seenFallback = true
+			err = o.Fallback.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"fallback\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenExternalReference {
+		return fmt.Errorf("field \"externalReference\" is required")
+	}
+	if !seenFallback {
+		return fmt.Errorf("field \"fallback\" is required")
+	}
+	return nil
+}
+
+func (o ExternalReference) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *ExternalReference) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the ExternalReference is equal to other according to the Conjure semantics of its values.
+func (o ExternalReference) Equal(other ExternalReference) bool {
+	if !o.ExternalReference.Equal(other.ExternalReference) {
+		return false
+	}
+	if !o.Fallback.Equal(other.Fallback) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the ExternalReference.
+func (o ExternalReference) Clone() ExternalReference {
+	out := o
+	return out
+}
+
+type FieldDefinition struct {
+	FieldName  FieldName      `json:"fieldName"`
+	Type       Type           `json:"type"`
+	Docs       *Documentation `json:"docs"`
+	Deprecated *Documentation `json:"deprecated"`
+	Safety     *LogSafety     `json:"safety"`
+}
+
+func (o FieldDefinition) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"fieldName\":"...)
+	out = appendJSONString(out, string(o.FieldName))
+	out = append(out, ",\"type\":"...)
+	out, err = o.Type.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"docs\":"...)
+	if o.Docs == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, string(*o.Docs))
+	}
+	out = append(out, ",\"deprecated\":"...)
+	if o.Deprecated == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, string(*o.Deprecated))
+	}
+	out = append(out, ",\"safety\":"...)
+	if o.Safety == nil {
+		out = append(out, "null"...)
+	} else {
+		out, err = o.Safety.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o FieldDefinition) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 54
+	size += jsonStringSize(string(o.FieldName))
+	n, err = o.Type.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	if o.Docs == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(string(*o.Docs))
+	}
+	if o.Deprecated == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(string(*o.Deprecated))
+	}
+	if o.Safety == nil {
+		size += 4
+	} else {
+		n, err = o.Safety.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (o *FieldDefinition) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenFieldName, seenType, seenDocs, seenDeprecated, seenSafety bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "fieldName":
+			if seenFieldName {
+				ok = false
+				return false
+			}
+			seenFieldName = true
+			var v string
+			if !unmarshalJSONString(field, &v) {
+				ok = false
+				return false
+			}
+			o.FieldName = FieldName(v)
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !o.Type.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "docs":
+			if seenDocs {
+				ok = false
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v1 Documentation
+				var v2 string
+				if !unmarshalJSONString(field, &v2) {
+					ok = false
+					return false
+				}
+				v1 = Documentation(v2)
+				o.Docs = &v1
+			}
+		case "deprecated":
+			if seenDeprecated {
+				ok = false
+				return false
+			}
+			seenDeprecated = true
+			if field.Type != gjson.Null {
+				var v3 Documentation
+				var v4 string
+				if !unmarshalJSONString(field, &v4) {
+					ok = false
+					return false
+				}
+				v3 = Documentation(v4)
+				o.Deprecated = &v3
+			}
+		case "safety":
+			if seenSafety {
+				ok = false
+				return false
+			}
+			seenSafety = true
+			if field.Type != gjson.Null {
+				var v5 LogSafety
+				if field.Type != gjson.Null {
+					var s string
+					if !unmarshalJSONString(field, &s) || v5.UnmarshalText([]byte(s)) != nil {
+						ok = false
+						return false
+					}
+				}
+				o.Safety = &v5
+			}
+		default:
+			if matchesJSONField(key.Str, "fieldName", "type", "docs", "deprecated", "safety") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *FieldDefinition) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *FieldDefinition) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = FieldDefinition{}
+	var seenFieldName, seenType, seenDocs, seenDeprecated, seenSafety bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "fieldName":
+			if seenFieldName {
+				err = fmt.Errorf("duplicate field \"fieldName\"")
+				return false
+			}
+			seenFieldName = true
+			err = o.FieldName.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"fieldName\": %w", err)
+				return false
+			}
+		case "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			err = o.Type.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"type\": %w", err)
+				return false
+			}
+		case "docs":
+			if seenDocs {
+				err = fmt.Errorf("duplicate field \"docs\"")
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v Documentation
+				err = v.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"docs\": %w", err)
+					return false
+				}
+				o.Docs = &v
+			}
+		case "deprecated":
+			if seenDeprecated {
+				err = fmt.Errorf("duplicate field \"deprecated\"")
+				return false
+			}
+			seenDeprecated = true
+			if field.Type != gjson.Null {
+				var v1 Documentation
+				err = v1.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"deprecated\": %w", err)
+					return false
+				}
+				o.Deprecated = &v1
+			}
+		case "safety":
+			if seenSafety {
+				err = fmt.Errorf("duplicate field \"safety\"")
+				return false
+			}
+			seenSafety = true
+			if field.Type != gjson.Null {
+				var v2 LogSafety
+				err = v2.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"safety\": %w", err)
+					return false
+				}
+				o.Safety = &v2
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenFieldName {
+		return fmt.Errorf("field \"fieldName\" is required")
+	}
+	if !seenType {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	return nil
+}
+
+func (o FieldDefinition) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *FieldDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
 		return err
 	}
-	if rawEndpointDefinition.Args == nil {
-		rawEndpointDefinition.Args = make([]ArgumentDefinition, 0)
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the FieldDefinition is equal to other according to the Conjure semantics of its values.
+func (o FieldDefinition) Equal(other FieldDefinition) bool {
+	if !o.FieldName.Equal(other.FieldName) {
+		return false
+	}
+	if !o.Type.Equal(other.Type) {
+		return false
+	}
+	if (o.Docs == nil) != (other.Docs == nil) {
+		return false
+	}
+	if o.Docs != nil {
+		if !(*o.Docs).Equal(*other.Docs) {
+			return false
+		}
+	}
+	if (o.Deprecated == nil) != (other.Deprecated == nil) {
+		return false
+	}
+	if o.Deprecated != nil {
+		if !(*o.Deprecated).Equal(*other.Deprecated) {
+			return false
+		}
+	}
+	if (o.Safety == nil) != (other.Safety == nil) {
+		return false
+	}
+	if o.Safety != nil {
+		if !(*o.Safety).Equal(*other.Safety) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the FieldDefinition.
+func (o FieldDefinition) Clone() FieldDefinition {
+	out := o
+	if o.Docs != nil {
+		v := *o.Docs
+		out.Docs = &v
+	}
+	if o.Deprecated != nil {
+		v := *o.Deprecated
+		out.Deprecated = &v
+	}
+	if o.Safety != nil {
+		v := *o.Safety
+		out.Safety = &v
+	}
+	return out
+}
+
+type HeaderAuthType struct{}
+
+func (o HeaderAuthType) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{}"...)
+	return out, nil
+}
+
+func (o HeaderAuthType) JSONSize() (int, error) {
+	size := 2
+	return size, nil
+}
+
+func (o *HeaderAuthType) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	return value.IsObject()
+}
+
+func (o *HeaderAuthType) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
 	}
-	if rawEndpointDefinition.Markers == nil {
-		rawEndpointDefinition.Markers = make([]Type, 0)
+	return o.decodeJSONStrict(value)
+}
+
+func (o *HeaderAuthType) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
 	}
-	if rawEndpointDefinition.Tags == nil {
-		rawEndpointDefinition.Tags = make([]string, 0)
+	*o = HeaderAuthType{}
+	var err error
+	value.ForEach(func(key, _ gjson.Result) bool {
+		err = fmt.Errorf("unknown field %q", key.Str)
+		return false
+	})
+	if err != nil {
+		return err
 	}
-	*o = EndpointDefinition(rawEndpointDefinition)
 	return nil
 }
 
-func (o EndpointDefinition) MarshalYAML() (interface{}, error) {
+func (o HeaderAuthType) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
 		return nil, err
@@ -238,7 +4023,7 @@ func (o EndpointDefinition) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (o *EndpointDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (o *HeaderAuthType) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -246,34 +4031,115 @@ func (o *EndpointDefinition) UnmarshalYAML(unmarshal func(interface{}) error) er
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
-type EnumDefinition struct {
-	TypeName TypeName              `json:"typeName"`
-	Values   []EnumValueDefinition `json:"values"`
-	Docs     *Documentation        `json:"docs"`
+// Equal returns true if the HeaderAuthType is equal to other according to the Conjure semantics of its values.
+func (o HeaderAuthType) Equal(other HeaderAuthType) bool {
+	return true
 }
 
-func (o EnumDefinition) MarshalJSON() ([]byte, error) {
-	if o.Values == nil {
-		o.Values = make([]EnumValueDefinition, 0)
+// Clone returns a deep copy of the HeaderAuthType.
+func (o HeaderAuthType) Clone() HeaderAuthType {
+	out := o
+	return out
+}
+
+type HeaderParameterType struct {
+	ParamId ParameterId `json:"paramId"`
+}
+
+func (o HeaderParameterType) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"paramId\":"...)
+	out = appendJSONString(out, string(o.ParamId))
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o HeaderParameterType) JSONSize() (int, error) {
+	size := 12
+	size += jsonStringSize(string(o.ParamId))
+	return size, nil
+}
+
+func (o *HeaderParameterType) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
 	}
-	type EnumDefinitionAlias EnumDefinition
-	return safejson.Marshal(EnumDefinitionAlias(o))
+	if !value.IsObject() {
+		return false
+	}
+	var seenParamId bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "paramId":
+			if seenParamId {
+				ok = false
+				return false
+			}
+			seenParamId = true
+			var v string
+			if !unmarshalJSONString(field, &v) {
+				ok = false
+				return false
+			}
+			o.ParamId = ParameterId(v)
+		default:
+			if matchesJSONField(key.Str, "paramId") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
 }
 
-func (o *EnumDefinition) UnmarshalJSON(data []byte) error {
-	type EnumDefinitionAlias EnumDefinition
-	var rawEnumDefinition EnumDefinitionAlias
-	if err := safejson.Unmarshal(data, &rawEnumDefinition); err != nil {
+func (o *HeaderParameterType) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
 		return err
 	}
-	if rawEnumDefinition.Values == nil {
-		rawEnumDefinition.Values = make([]EnumValueDefinition, 0)
+	return o.decodeJSONStrict(value)
+}
+
+func (o *HeaderParameterType) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = HeaderParameterType{}
+	var seenParamId bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "paramId":
+			if seenParamId {
+				err = fmt.Errorf("duplicate field \"paramId\"")
+				return false
+			}
+			seenParamId = true
+			err = o.ParamId.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"paramId\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenParamId {
+		return fmt.Errorf("field \"paramId\" is required")
 	}
-	*o = EnumDefinition(rawEnumDefinition)
 	return nil
 }
 
-func (o EnumDefinition) MarshalYAML() (interface{}, error) {
+func (o HeaderParameterType) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
 		return nil, err
@@ -281,7 +4147,7 @@ func (o EnumDefinition) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (o *EnumDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (o *HeaderParameterType) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -289,65 +4155,126 @@ func (o *EnumDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
-type EnumValueDefinition struct {
-	Value      string         `json:"value"`
-	Docs       *Documentation `json:"docs"`
-	Deprecated *Documentation `json:"deprecated"`
+// Equal returns true if the HeaderParameterType is equal to other according to the Conjure semantics of its values.
+func (o HeaderParameterType) Equal(other HeaderParameterType) bool {
+	if !o.ParamId.Equal(other.ParamId) {
+		return false
+	}
+	return true
 }
 
-func (o EnumValueDefinition) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(o)
+// Clone returns a deep copy of the HeaderParameterType.
+func (o HeaderParameterType) Clone() HeaderParameterType {
+	out := o
+	return out
+}
+
+type ListType struct {
+	ItemType Type `json:"itemType"`
+}
+
+func (o ListType) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"itemType\":"...)
+	out, err = o.ItemType.AppendJSON(out)
 	if err != nil {
 		return nil, err
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	out = append(out, '}')
+	return out, nil
 }
 
-func (o *EnumValueDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+func (o ListType) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 13
+	n, err = o.ItemType.JSONSize()
 	if err != nil {
-		return err
+		return 0, err
 	}
-	return safejson.Unmarshal(jsonBytes, *&o)
-}
-
-type ErrorDefinition struct {
-	ErrorName  TypeName          `json:"errorName"`
-	Docs       *Documentation    `json:"docs"`
-	Namespace  ErrorNamespace    `json:"namespace"`
-	Code       ErrorCode         `json:"code"`
-	SafeArgs   []FieldDefinition `json:"safeArgs"`
-	UnsafeArgs []FieldDefinition `json:"unsafeArgs"`
+	size += n
+	return size, nil
 }
 
-func (o ErrorDefinition) MarshalJSON() ([]byte, error) {
-	if o.SafeArgs == nil {
-		o.SafeArgs = make([]FieldDefinition, 0)
+func (o *ListType) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
 	}
-	if o.UnsafeArgs == nil {
-		o.UnsafeArgs = make([]FieldDefinition, 0)
+	if !value.IsObject() {
+		return false
 	}
-	type ErrorDefinitionAlias ErrorDefinition
-	return safejson.Marshal(ErrorDefinitionAlias(o))
+	var seenItemType bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "itemType":
+			if seenItemType {
+				ok = false
+				return false
+			}
+			seenItemType = true
+			if !o.ItemType.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "itemType") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
 }
 
-func (o *ErrorDefinition) UnmarshalJSON(data []byte) error {
-	type ErrorDefinitionAlias ErrorDefinition
-	var rawErrorDefinition ErrorDefinitionAlias
-	if err := safejson.Unmarshal(data, &rawErrorDefinition); err != nil {
+func (o *ListType) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
 		return err
 	}
-	if rawErrorDefinition.SafeArgs == nil {
-		rawErrorDefinition.SafeArgs = make([]FieldDefinition, 0)
+	return o.decodeJSONStrict(value)
+}
+
+func (o *ListType) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
 	}
-	if rawErrorDefinition.UnsafeArgs == nil {
-		rawErrorDefinition.UnsafeArgs = make([]FieldDefinition, 0)
+	*o = ListType{}
+	var seenItemType bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "itemType":
+			if seenItemType {
+				err = fmt.Errorf("duplicate field \"itemType\"")
+				return false
+			}
+			seenItemType = true
+			err = o.ItemType.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"itemType\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenItemType {
+		return fmt.Errorf("field \"itemType\" is required")
 	}
-	*o = ErrorDefinition(rawErrorDefinition)
 	return nil
 }
 
-func (o ErrorDefinition) MarshalYAML() (interface{}, error) {
+func (o ListType) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
 		return nil, err
@@ -355,7 +4282,7 @@ func (o ErrorDefinition) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (o *ErrorDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (o *ListType) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -363,56 +4290,161 @@ func (o *ErrorDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
-type ExternalReference struct {
-	// An identifier for a non-Conjure type which is already defined in a different language (e.g. Java).
-	ExternalReference TypeName `conjure-docs:"An identifier for a non-Conjure type which is already defined in a different language (e.g. Java)." json:"externalReference"`
-	// Other language generators may use the provided fallback if the non-Conjure type is not available. The ANY PrimitiveType is permissible for all external types, but a more specific definition is preferable.
-	Fallback Type `conjure-docs:"Other language generators may use the provided fallback if the non-Conjure type is not available. The ANY PrimitiveType is permissible for all external types, but a more specific definition is preferable." json:"fallback"`
+// Equal returns true if the ListType is equal to other according to the Conjure semantics of its values.
+func (o ListType) Equal(other ListType) bool {
+	if !o.ItemType.Equal(other.ItemType) {
+		return false
+	}
+	return true
 }
 
-func (o ExternalReference) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(o)
+// Clone returns a deep copy of the ListType.
+func (o ListType) Clone() ListType {
+	out := o
+	return out
+}
+
+type MapType struct {
+	KeyType   Type `json:"keyType"`
+	ValueType Type `json:"valueType"`
+}
+
+func (o MapType) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"keyType\":"...)
+	out, err = o.KeyType.AppendJSON(out)
 	if err != nil {
 		return nil, err
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	out = append(out, ",\"valueType\":"...)
+	out, err = o.ValueType.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
 }
 
-func (o *ExternalReference) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+func (o MapType) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 25
+	n, err = o.KeyType.JSONSize()
 	if err != nil {
-		return err
+		return 0, err
 	}
-	return safejson.Unmarshal(jsonBytes, *&o)
+	size += n
+	n, err = o.ValueType.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
 }
 
-type FieldDefinition struct {
-	FieldName  FieldName      `json:"fieldName"`
-	Type       Type           `json:"type"`
-	Docs       *Documentation `json:"docs"`
-	Deprecated *Documentation `json:"deprecated"`
-	Safety     *LogSafety     `json:"safety"`
+func (o *MapType) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenKeyType, seenValueType bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "keyType":
+			if seenKeyType {
+				ok = false
+				return false
+			}
+			seenKeyType = true
+			if !o.KeyType.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "valueType":
+			if seenValueType {
+				ok = false
+				return false
+			}
+			seenValueType = true
+			if !o.ValueType.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "keyType", "valueType") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
 }
 
-func (o FieldDefinition) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(o)
+func (o *MapType) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	return o.decodeJSONStrict(value)
 }
 
-func (o *FieldDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+func (o *MapType) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = MapType{}
+	var seenKeyType, seenValueType bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "keyType":
+			if seenKeyType {
+				err = fmt.Errorf("duplicate field \"keyType\"")
+				return false
+			}
+			seenKeyType = true
+			err = o.KeyType.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"keyType\": %w", err)
+				return false
+			}
+		case "valueType":
+			if seenValueType {
+				err = fmt.Errorf("duplicate field \"valueType\"")
+				return false
+			}
+			seenValueType = true
+			err = o.ValueType.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"valueType\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
 	if err != nil {
 		return err
 	}
-	return safejson.Unmarshal(jsonBytes, *&o)
+	if !seenKeyType {
+		return fmt.Errorf("field \"keyType\" is required")
+	}
+	if !seenValueType {
+		return fmt.Errorf("field \"valueType\" is required")
+	}
+	return nil
 }
 
-type HeaderAuthType struct{}
-
-func (o HeaderAuthType) MarshalYAML() (interface{}, error) {
+func (o MapType) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
 		return nil, err
@@ -420,7 +4452,7 @@ func (o HeaderAuthType) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (o *HeaderAuthType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (o *MapType) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -428,31 +4460,282 @@ func (o *HeaderAuthType) UnmarshalYAML(unmarshal func(interface{}) error) error
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
-type HeaderParameterType struct {
-	ParamId ParameterId `json:"paramId"`
+// Equal returns true if the MapType is equal to other according to the Conjure semantics of its values.
+func (o MapType) Equal(other MapType) bool {
+	if !o.KeyType.Equal(other.KeyType) {
+		return false
+	}
+	if !o.ValueType.Equal(other.ValueType) {
+		return false
+	}
+	return true
 }
 
-func (o HeaderParameterType) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(o)
+// Clone returns a deep copy of the MapType.
+func (o MapType) Clone() MapType {
+	out := o
+	return out
+}
+
+type ObjectDefinition struct {
+	TypeName TypeName          `json:"typeName"`
+	Fields   []FieldDefinition `json:"fields"`
+	Docs     *Documentation    `json:"docs"`
+}
+
+func (o ObjectDefinition) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"typeName\":"...)
+	out, err = o.TypeName.AppendJSON(out)
 	if err != nil {
 		return nil, err
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	out = append(out, ",\"fields\":"...)
+	out = append(out, '[')
+	for i, v := range o.Fields {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	out = append(out, ",\"docs\":"...)
+	if o.Docs == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, string(*o.Docs))
+	}
+	out = append(out, '}')
+	return out, nil
 }
 
-func (o *HeaderParameterType) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+func (o ObjectDefinition) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 31
+	n, err = o.TypeName.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	size += 2
+	if len(o.Fields) > 1 {
+		size += len(o.Fields) - 1
+	}
+	for _, v := range o.Fields {
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	if o.Docs == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(string(*o.Docs))
+	}
+	return size, nil
+}
+
+func (o ObjectDefinition) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
+}
+
+func (o *ObjectDefinition) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v ObjectDefinition
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
+	type ObjectDefinitionAlias ObjectDefinition
+	var rawObjectDefinition ObjectDefinitionAlias
+	if err := safejson.Unmarshal(data, &rawObjectDefinition); err != nil {
+		return err
+	}
+	if rawObjectDefinition.Fields == nil {
+		rawObjectDefinition.Fields = make([]FieldDefinition, 0)
+	}
+	*o = ObjectDefinition(rawObjectDefinition)
+	return nil
+}
+
+func (o *ObjectDefinition) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Fields == nil {
+			o.Fields = make([]FieldDefinition, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenTypeName, seenFields, seenDocs bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "typeName":
+			if seenTypeName {
+				ok = false
+				return false
+			}
+			seenTypeName = true
+			if !o.TypeName.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "fields":
+			if seenFields {
+				ok = false
+				return false
+			}
+			seenFields = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Fields = make([]FieldDefinition, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v FieldDefinition
+					if !v.unmarshalJSONValue(elem) {
+						ok1 = false
+						return false
+					}
+					o.Fields = append(o.Fields, v)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "docs":
+			if seenDocs {
+				ok = false
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v1 Documentation
+				var v2 string
+				if !unmarshalJSONString(field, &v2) {
+					ok = false
+					return false
+				}
+				v1 = Documentation(v2)
+				o.Docs = &v1
+			}
+		default:
+			if matchesJSONField(key.Str, "typeName", "fields", "docs") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Fields == nil {
+		o.Fields = make([]FieldDefinition, 0)
+	}
+	return true
+}
+
+func (o *ObjectDefinition) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
 	if err != nil {
 		return err
 	}
-	return safejson.Unmarshal(jsonBytes, *&o)
+	return o.decodeJSONStrict(value)
 }
 
-type ListType struct {
-	ItemType Type `json:"itemType"`
+func (o *ObjectDefinition) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = ObjectDefinition{}
+	var seenTypeName, seenFields, seenDocs bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "typeName":
+			if seenTypeName {
+				err = fmt.Errorf("duplicate field \"typeName\"")
+				return false
+			}
+			seenTypeName = true
+			err = o.TypeName.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"typeName\": %w", err)
+				return false
+			}
+		case "fields":
+			if seenFields {
+				err = fmt.Errorf("duplicate field \"fields\"")
+				return false
+			}
+			seenFields = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"fields\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Fields = make([]FieldDefinition, 0)
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v FieldDefinition
+					err = v.decodeJSONStrict(elem)
+					if err != nil {
+						return false
+					}
+					o.Fields = append(o.Fields, v)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"fields\": %w", err)
+					return false
+				}
+			}
+		case "docs":
+			if seenDocs {
+				err = fmt.Errorf("duplicate field \"docs\"")
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v1 Documentation
+				err = v1.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"docs\": %w", err)
+					return false
+				}
+				o.Docs = &v1
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenTypeName {
+		return fmt.Errorf("field \"typeName\" is required")
+	}
+	if o.Fields == nil {
+		o.Fields = make([]FieldDefinition, 0)
+	}
+	return nil
 }
 
-func (o ListType) MarshalYAML() (interface{}, error) {
+func (o ObjectDefinition) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
 		return nil, err
@@ -460,7 +4743,7 @@ func (o ListType) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (o *ListType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (o *ObjectDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -468,55 +4751,152 @@ func (o *ListType) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
-type MapType struct {
-	KeyType   Type `json:"keyType"`
-	ValueType Type `json:"valueType"`
+// Equal returns true if the ObjectDefinition is equal to other according to the Conjure semantics of its values.
+func (o ObjectDefinition) Equal(other ObjectDefinition) bool {
+	if !o.TypeName.Equal(other.TypeName) {
+		return false
+	}
+	if len(o.Fields) != len(other.Fields) {
+		return false
+	}
+	for i := range o.Fields {
+		if !o.Fields[i].Equal(other.Fields[i]) {
+			return false
+		}
+	}
+	if (o.Docs == nil) != (other.Docs == nil) {
+		return false
+	}
+	if o.Docs != nil {
+		if !(*o.Docs).Equal(*other.Docs) {
+			return false
+		}
+	}
+	return true
 }
 
-func (o MapType) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(o)
+// Clone returns a deep copy of the ObjectDefinition.
+func (o ObjectDefinition) Clone() ObjectDefinition {
+	out := o
+	if o.Fields != nil {
+		out.Fields = make([]FieldDefinition, len(o.Fields))
+		for i := range o.Fields {
+			out.Fields[i] = o.Fields[i].Clone()
+		}
+	}
+	if o.Docs != nil {
+		v := *o.Docs
+		out.Docs = &v
+	}
+	return out
+}
+
+type OptionalType struct {
+	ItemType Type `json:"itemType"`
+}
+
+func (o OptionalType) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"itemType\":"...)
+	out, err = o.ItemType.AppendJSON(out)
 	if err != nil {
 		return nil, err
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	out = append(out, '}')
+	return out, nil
 }
 
-func (o *MapType) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+func (o OptionalType) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 13
+	n, err = o.ItemType.JSONSize()
 	if err != nil {
-		return err
+		return 0, err
 	}
-	return safejson.Unmarshal(jsonBytes, *&o)
+	size += n
+	return size, nil
 }
 
-type ObjectDefinition struct {
-	TypeName TypeName          `json:"typeName"`
-	Fields   []FieldDefinition `json:"fields"`
-	Docs     *Documentation    `json:"docs"`
+func (o *OptionalType) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenItemType bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "itemType":
+			if seenItemType {
+				ok = false
+				return false
+			}
+			seenItemType = true
+			if !o.ItemType.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "itemType") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
 }
 
-func (o ObjectDefinition) MarshalJSON() ([]byte, error) {
-	if o.Fields == nil {
-		o.Fields = make([]FieldDefinition, 0)
+func (o *OptionalType) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
 	}
-	type ObjectDefinitionAlias ObjectDefinition
-	return safejson.Marshal(ObjectDefinitionAlias(o))
+	return o.decodeJSONStrict(value)
 }
 
-func (o *ObjectDefinition) UnmarshalJSON(data []byte) error {
-	type ObjectDefinitionAlias ObjectDefinition
-	var rawObjectDefinition ObjectDefinitionAlias
-	if err := safejson.Unmarshal(data, &rawObjectDefinition); err != nil {
+func (o *OptionalType) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = OptionalType{}
+	var seenItemType bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "itemType":
+			if seenItemType {
+				err = fmt.Errorf("duplicate field \"itemType\"")
+				return false
+			}
+			seenItemType = true
+			err = o.ItemType.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"itemType\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
 		return err
 	}
-	if rawObjectDefinition.Fields == nil {
-		rawObjectDefinition.Fields = make([]FieldDefinition, 0)
+	if !seenItemType {
+		return fmt.Errorf("field \"itemType\" is required")
 	}
-	*o = ObjectDefinition(rawObjectDefinition)
 	return nil
 }
 
-func (o ObjectDefinition) MarshalYAML() (interface{}, error) {
+func (o OptionalType) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
 		return nil, err
@@ -524,7 +4904,7 @@ func (o ObjectDefinition) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (o *ObjectDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (o *OptionalType) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -532,27 +4912,62 @@ func (o *ObjectDefinition) UnmarshalYAML(unmarshal func(interface{}) error) erro
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
-type OptionalType struct {
-	ItemType Type `json:"itemType"`
+// Equal returns true if the OptionalType is equal to other according to the Conjure semantics of its values.
+func (o OptionalType) Equal(other OptionalType) bool {
+	if !o.ItemType.Equal(other.ItemType) {
+		return false
+	}
+	return true
 }
 
-func (o OptionalType) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(o)
-	if err != nil {
-		return nil, err
+// Clone returns a deep copy of the OptionalType.
+func (o OptionalType) Clone() OptionalType {
+	out := o
+	return out
+}
+
+type PathParameterType struct{}
+
+func (o PathParameterType) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{}"...)
+	return out, nil
+}
+
+func (o PathParameterType) JSONSize() (int, error) {
+	size := 2
+	return size, nil
+}
+
+func (o *PathParameterType) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	return value.IsObject()
 }
 
-func (o *OptionalType) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+func (o *PathParameterType) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
 	if err != nil {
 		return err
 	}
-	return safejson.Unmarshal(jsonBytes, *&o)
+	return o.decodeJSONStrict(value)
 }
 
-type PathParameterType struct{}
+func (o *PathParameterType) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = PathParameterType{}
+	var err error
+	value.ForEach(func(key, _ gjson.Result) bool {
+		err = fmt.Errorf("unknown field %q", key.Str)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
 
 func (o PathParameterType) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
@@ -570,10 +4985,114 @@ func (o *PathParameterType) UnmarshalYAML(unmarshal func(interface{}) error) err
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the PathParameterType is equal to other according to the Conjure semantics of its values.
+func (o PathParameterType) Equal(other PathParameterType) bool {
+	return true
+}
+
+// Clone returns a deep copy of the PathParameterType.
+func (o PathParameterType) Clone() PathParameterType {
+	out := o
+	return out
+}
+
 type QueryParameterType struct {
 	ParamId ParameterId `json:"paramId"`
 }
 
+func (o QueryParameterType) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"paramId\":"...)
+	out = appendJSONString(out, string(o.ParamId))
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o QueryParameterType) JSONSize() (int, error) {
+	size := 12
+	size += jsonStringSize(string(o.ParamId))
+	return size, nil
+}
+
+func (o *QueryParameterType) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenParamId bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "paramId":
+			if seenParamId {
+				ok = false
+				return false
+			}
+			seenParamId = true
+			var v string
+			if !unmarshalJSONString(field, &v) {
+				ok = false
+				return false
+			}
+			o.ParamId = ParameterId(v)
+		default:
+			if matchesJSONField(key.Str, "paramId") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *QueryParameterType) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *QueryParameterType) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = QueryParameterType{}
+	var seenParamId bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "paramId":
+			if seenParamId {
+				err = fmt.Errorf("duplicate field \"paramId\"")
+				return false
+			}
+			seenParamId = true
+			err = o.ParamId.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"paramId\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenParamId {
+		return fmt.Errorf("field \"paramId\" is required")
+	}
+	return nil
+}
+
 func (o QueryParameterType) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -590,21 +5109,95 @@ func (o *QueryParameterType) UnmarshalYAML(unmarshal func(interface{}) error) er
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the QueryParameterType is equal to other according to the Conjure semantics of its values.
+func (o QueryParameterType) Equal(other QueryParameterType) bool {
+	if !o.ParamId.Equal(other.ParamId) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the QueryParameterType.
+func (o QueryParameterType) Clone() QueryParameterType {
+	out := o
+	return out
+}
+
 type ServiceDefinition struct {
 	ServiceName TypeName             `json:"serviceName"`
 	Endpoints   []EndpointDefinition `json:"endpoints"`
 	Docs        *Documentation       `json:"docs"`
 }
 
-func (o ServiceDefinition) MarshalJSON() ([]byte, error) {
-	if o.Endpoints == nil {
-		o.Endpoints = make([]EndpointDefinition, 0)
+func (o ServiceDefinition) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"serviceName\":"...)
+	out, err = o.ServiceName.AppendJSON(out)
+	if err != nil {
+		return nil, err
 	}
-	type ServiceDefinitionAlias ServiceDefinition
-	return safejson.Marshal(ServiceDefinitionAlias(o))
+	out = append(out, ",\"endpoints\":"...)
+	out = append(out, '[')
+	for i, v := range o.Endpoints {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	out = append(out, ",\"docs\":"...)
+	if o.Docs == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, string(*o.Docs))
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o ServiceDefinition) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 37
+	n, err = o.ServiceName.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	size += 2
+	if len(o.Endpoints) > 1 {
+		size += len(o.Endpoints) - 1
+	}
+	for _, v := range o.Endpoints {
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	if o.Docs == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(string(*o.Docs))
+	}
+	return size, nil
+}
+
+func (o ServiceDefinition) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *ServiceDefinition) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v ServiceDefinition
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type ServiceDefinitionAlias ServiceDefinition
 	var rawServiceDefinition ServiceDefinitionAlias
 	if err := safejson.Unmarshal(data, &rawServiceDefinition); err != nil {
@@ -617,6 +5210,177 @@ func (o *ServiceDefinition) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *ServiceDefinition) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Endpoints == nil {
+			o.Endpoints = make([]EndpointDefinition, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenServiceName, seenEndpoints, seenDocs bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "serviceName":
+			if seenServiceName {
+				ok = false
+				return false
+			}
+			seenServiceName = true
+			if !o.ServiceName.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "endpoints":
+			if seenEndpoints {
+				ok = false
+				return false
+			}
+			seenEndpoints = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Endpoints = make([]EndpointDefinition, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v EndpointDefinition
+					if !v.unmarshalJSONValue(elem) {
+						ok1 = false
+						return false
+					}
+					o.Endpoints = append(o.Endpoints, v)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "docs":
+			if seenDocs {
+				ok = false
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v1 Documentation
+				var v2 string
+				if !unmarshalJSONString(field, &v2) {
+					ok = false
+					return false
+				}
+				v1 = Documentation(v2)
+				o.Docs = &v1
+			}
+		default:
+			if matchesJSONField(key.Str, "serviceName", "endpoints", "docs") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Endpoints == nil {
+		o.Endpoints = make([]EndpointDefinition, 0)
+	}
+	return true
+}
+
+func (o *ServiceDefinition) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *ServiceDefinition) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = ServiceDefinition{}
+	var seenServiceName, seenEndpoints, seenDocs bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "serviceName":
+			if seenServiceName {
+				err = fmt.Errorf("duplicate field \"serviceName\"")
+				return false
+			}
+			seenServiceName = true
+			err = o.ServiceName.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"serviceName\": %w", err)
+				return false
+			}
+		case "endpoints":
+			if seenEndpoints {
+				err = fmt.Errorf("duplicate field \"endpoints\"")
+				return false
+			}
+			seenEndpoints = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"endpoints\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Endpoints = make([]EndpointDefinition, 0)
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v EndpointDefinition
+					err = v.decodeJSONStrict(elem)
+					if err != nil {
+						return false
+					}
+					o.Endpoints = append(o.Endpoints, v)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"endpoints\": %w", err)
+					return false
+				}
+			}
+		case "docs":
+			if seenDocs {
+				err = fmt.Errorf("duplicate field \"docs\"")
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v1 Documentation
+				err = v1.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"docs\": %w", err)
+					return false
+				}
+				o.Docs = &v1
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenServiceName {
+		return fmt.Errorf("field \"serviceName\" is required")
+	}
+	if o.Endpoints == nil {
+		o.Endpoints = make([]EndpointDefinition, 0)
+	}
+	return nil
+}
+
 func (o ServiceDefinition) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -633,10 +5397,151 @@ func (o *ServiceDefinition) UnmarshalYAML(unmarshal func(interface{}) error) err
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the ServiceDefinition is equal to other according to the Conjure semantics of its values.
+func (o ServiceDefinition) Equal(other ServiceDefinition) bool {
+	if !o.ServiceName.Equal(other.ServiceName) {
+		return false
+	}
+	if len(o.Endpoints) != len(other.Endpoints) {
+		return false
+	}
+	for i := range o.Endpoints {
+		if !o.Endpoints[i].Equal(other.Endpoints[i]) {
+			return false
+		}
+	}
+	if (o.Docs == nil) != (other.Docs == nil) {
+		return false
+	}
+	if o.Docs != nil {
+		if !(*o.Docs).Equal(*other.Docs) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the ServiceDefinition.
+func (o ServiceDefinition) Clone() ServiceDefinition {
+	out := o
+	if o.Endpoints != nil {
+		out.Endpoints = make([]EndpointDefinition, len(o.Endpoints))
+		for i := range o.Endpoints {
+			out.Endpoints[i] = o.Endpoints[i].Clone()
+		}
+	}
+	if o.Docs != nil {
+		v := *o.Docs
+		out.Docs = &v
+	}
+	return out
+}
+
 type SetType struct {
 	ItemType Type `json:"itemType"`
 }
 
+func (o SetType) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"itemType\":"...)
+	out, err = o.ItemType.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o SetType) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 13
+	n, err = o.ItemType.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (o *SetType) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenItemType bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "itemType":
+			if seenItemType {
+				ok = false
+				return false
+			}
+			seenItemType = true
+			if !o.ItemType.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "itemType") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *SetType) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *SetType) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = SetType{}
+	var seenItemType bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "itemType":
+			if seenItemType {
+				err = fmt.Errorf("duplicate field \"itemType\"")
+				return false
+			}
+			seenItemType = true
+			err = o.ItemType.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"itemType\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenItemType {
+		return fmt.Errorf("field \"itemType\" is required")
+	}
+	return nil
+}
+
 func (o SetType) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -653,6 +5558,20 @@ func (o *SetType) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the SetType is equal to other according to the Conjure semantics of its values.
+func (o SetType) Equal(other SetType) bool {
+	if !o.ItemType.Equal(other.ItemType) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the SetType.
+func (o SetType) Clone() SetType {
+	out := o
+	return out
+}
+
 type TypeName struct {
 	// The name of the custom Conjure type or service. It must be in UpperCamelCase. Numbers are permitted, but not at the beginning of a word. Allowed names: "FooBar", "XYCoordinate", "Build2Request". Disallowed names: "fooBar", "2BuildRequest".
 	Name string `conjure-docs:"The name of the custom Conjure type or service. It must be in UpperCamelCase. Numbers are permitted, but not at the beginning of a word. Allowed names: \"FooBar\", \"XYCoordinate\", \"Build2Request\". Disallowed names: \"fooBar\", \"2BuildRequest\"." json:"name"`
@@ -660,6 +5579,124 @@ type TypeName struct {
 	Package string `conjure-docs:"A period-delimited string of package names. The package names must be lowercase. Numbers are permitted, but not at the beginning of a package name. Allowed packages: \"foo\", \"com.palantir.bar\", \"com.palantir.foo.thing2\". Disallowed packages: \"Foo\", \"com.palantir.foo.2thing\"." json:"package"`
 }
 
+func (o TypeName) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"name\":"...)
+	out = appendJSONString(out, o.Name)
+	out = append(out, ",\"package\":"...)
+	out = appendJSONString(out, o.Package)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o TypeName) JSONSize() (int, error) {
+	size := 20
+	size += jsonStringSize(o.Name)
+	size += jsonStringSize(o.Package)
+	return size, nil
+}
+
+func (o *TypeName) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenName, seenPackage bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "name":
+			if seenName {
+				ok = false
+				return false
+			}
+			seenName = true
+			if !unmarshalJSONString(field, &o.Name) {
+				ok = false
+				return false
+			}
+		case "package":
+			if seenPackage {
+				ok = false
+				return false
+			}
+			seenPackage = true
+			if !unmarshalJSONString(field, &o.Package) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "name", "package") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *TypeName) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *TypeName) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = TypeName{}
+	var seenName, seenPackage bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "name":
+			if seenName {
+				err = fmt.Errorf("duplicate field \"name\"")
+				return false
+			}
+			seenName = true
+			o.Name, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"name\": %w", err)
+				return false
+			}
+		case "package":
+			if seenPackage {
+				err = fmt.Errorf("duplicate field \"package\"")
+				return false
+			}
+			seenPackage = true
+			o.Package, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"package\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenName {
+		return fmt.Errorf("field \"name\" is required")
+	}
+	if !seenPackage {
+		return fmt.Errorf("field \"package\" is required")
+	}
+	return nil
+}
+
 func (o TypeName) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -676,21 +5713,98 @@ func (o *TypeName) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the TypeName is equal to other according to the Conjure semantics of its values.
+func (o TypeName) Equal(other TypeName) bool {
+	if o.Name != other.Name {
+		return false
+	}
+	if o.Package != other.Package {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the TypeName.
+func (o TypeName) Clone() TypeName {
+	out := o
+	return out
+}
+
 type UnionDefinition struct {
 	TypeName TypeName          `json:"typeName"`
 	Union    []FieldDefinition `json:"union"`
 	Docs     *Documentation    `json:"docs"`
 }
 
-func (o UnionDefinition) MarshalJSON() ([]byte, error) {
-	if o.Union == nil {
-		o.Union = make([]FieldDefinition, 0)
+func (o UnionDefinition) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"typeName\":"...)
+	out, err = o.TypeName.AppendJSON(out)
+	if err != nil {
+		return nil, err
 	}
-	type UnionDefinitionAlias UnionDefinition
-	return safejson.Marshal(UnionDefinitionAlias(o))
+	out = append(out, ",\"union\":"...)
+	out = append(out, '[')
+	for i, v := range o.Union {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	out = append(out, ",\"docs\":"...)
+	if o.Docs == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, string(*o.Docs))
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o UnionDefinition) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 30
+	n, err = o.TypeName.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	size += 2
+	if len(o.Union) > 1 {
+		size += len(o.Union) - 1
+	}
+	for _, v := range o.Union {
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	if o.Docs == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(string(*o.Docs))
+	}
+	return size, nil
+}
+
+func (o UnionDefinition) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *UnionDefinition) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v UnionDefinition
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type UnionDefinitionAlias UnionDefinition
 	var rawUnionDefinition UnionDefinitionAlias
 	if err := safejson.Unmarshal(data, &rawUnionDefinition); err != nil {
@@ -703,6 +5817,177 @@ func (o *UnionDefinition) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *UnionDefinition) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Union == nil {
+			o.Union = make([]FieldDefinition, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenTypeName, seenUnion, seenDocs bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "typeName":
+			if seenTypeName {
+				ok = false
+				return false
+			}
+			seenTypeName = true
+			if !o.TypeName.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "union":
+			if seenUnion {
+				ok = false
+				return false
+			}
+			seenUnion = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Union = make([]FieldDefinition, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v FieldDefinition
+					if !v.unmarshalJSONValue(elem) {
+						ok1 = false
+						return false
+					}
+					o.Union = append(o.Union, v)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "docs":
+			if seenDocs {
+				ok = false
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v1 Documentation
+				var v2 string
+				if !unmarshalJSONString(field, &v2) {
+					ok = false
+					return false
+				}
+				v1 = Documentation(v2)
+				o.Docs = &v1
+			}
+		default:
+			if matchesJSONField(key.Str, "typeName", "union", "docs") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Union == nil {
+		o.Union = make([]FieldDefinition, 0)
+	}
+	return true
+}
+
+func (o *UnionDefinition) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *UnionDefinition) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = UnionDefinition{}
+	var seenTypeName, seenUnion, seenDocs bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "typeName":
+			if seenTypeName {
+				err = fmt.Errorf("duplicate field \"typeName\"")
+				return false
+			}
+			seenTypeName = true
+			err = o.TypeName.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"typeName\": %w", err)
+				return false
+			}
+		case "union":
+			if seenUnion {
+				err = fmt.Errorf("duplicate field \"union\"")
+				return false
+			}
+			seenUnion = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"union\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Union = make([]FieldDefinition, 0)
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v FieldDefinition
+					err = v.decodeJSONStrict(elem)
+					if err != nil {
+						return false
+					}
+					o.Union = append(o.Union, v)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"union\": %w", err)
+					return false
+				}
+			}
+		case "docs":
+			if seenDocs {
+				err = fmt.Errorf("duplicate field \"docs\"")
+				return false
+			}
+			seenDocs = true
+			if field.Type != gjson.Null {
+				var v1 Documentation
+				err = v1.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"docs\": %w", err)
+					return false
+				}
+				o.Docs = &v1
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenTypeName {
+		return fmt.Errorf("field \"typeName\" is required")
+	}
+	if o.Union == nil {
+		o.Union = make([]FieldDefinition, 0)
+	}
+	return nil
+}
+
 func (o UnionDefinition) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -718,3 +6003,43 @@ func (o *UnionDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error
 	}
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
+
+// Equal returns true if the UnionDefinition is equal to other according to the Conjure semantics of its values.
+func (o UnionDefinition) Equal(other UnionDefinition) bool {
+	if !o.TypeName.Equal(other.TypeName) {
+		return false
+	}
+	if len(o.Union) != len(other.Union) {
+		return false
+	}
+	for i := range o.Union {
+		if !o.Union[i].Equal(other.Union[i]) {
+			return false
+		}
+	}
+	if (o.Docs == nil) != (other.Docs == nil) {
+		return false
+	}
+	if o.Docs != nil {
+		if !(*o.Docs).Equal(*other.Docs) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the UnionDefinition.
+func (o UnionDefinition) Clone() UnionDefinition {
+	out := o
+	if o.Union != nil {
+		out.Union = make([]FieldDefinition, len(o.Union))
+		for i := range o.Union {
+			out.Union[i] = o.Union[i].Clone()
+		}
+	}
+	if o.Docs != nil {
+		v := *o.Docs
+		out.Docs = &v
+	}
+	return out
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type AuthType struct {
@@ -26,7 +27,8 @@ func (u *authTypeDeserializer) toStruct() AuthType {
 	return AuthType{typ: u.Type, header: u.Header, cookie: u.Cookie}
 }
 
-func (u *AuthType) toSerializer() (interface{}, error) {
+func (u AuthType) AppendJSON(out []byte) ([]byte, error) {
+	var err error
 	switch u.typ {
 	default:
 		return nil, fmt.Errorf("unknown type %q", u.typ)
@@ -34,30 +36,68 @@ func (u *AuthType) toSerializer() (interface{}, error) {
 		if u.header == nil {
 			return nil, fmt.Errorf("field \"header\" is required")
 		}
-		return struct {
-			Type   string         `json:"type"`
-			Header HeaderAuthType `json:"header"`
-		}{Type: "header", Header: *u.header}, nil
+		out = append(out, "{\"type\":\"header\",\"header\":"...)
+		out, err = u.header.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "cookie":
 		if u.cookie == nil {
 			return nil, fmt.Errorf("field \"cookie\" is required")
 		}
-		return struct {
-			Type   string         `json:"type"`
-			Cookie CookieAuthType `json:"cookie"`
-		}{Type: "cookie", Cookie: *u.cookie}, nil
+		out = append(out, "{\"type\":\"cookie\",\"cookie\":"...)
+		out, err = u.cookie.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	}
+	out = append(out, '}')
+	return out, nil
 }
 
-func (u AuthType) MarshalJSON() ([]byte, error) {
-	ser, err := u.toSerializer()
-	if err != nil {
-		return nil, err
+func (u AuthType) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	switch u.typ {
+	default:
+		return 0, fmt.Errorf("unknown type %q", u.typ)
+	case "header":
+		if u.header == nil {
+			return 0, fmt.Errorf("field \"header\" is required")
+		}
+		size = 27
+		n, err = u.header.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "cookie":
+		if u.cookie == nil {
+			return 0, fmt.Errorf("field \"cookie\" is required")
+		}
+		size = 27
+		n, err = u.cookie.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
 	}
-	return safejson.Marshal(ser)
+	return size, nil
+}
+
+func (u AuthType) MarshalJSON() ([]byte, error) {
+	return u.AppendJSON(nil)
 }
 
 func (u *AuthType) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v AuthType
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*u = v
+			return nil
+		}
+	}
 	var deser authTypeDeserializer
 	if err := safejson.Unmarshal(data, &deser); err != nil {
 		return err
@@ -76,6 +116,155 @@ func (u *AuthType) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (u *AuthType) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenType, seenHeader, seenCookie bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !unmarshalJSONString(field, &u.typ) {
+				ok = false
+				return false
+			}
+		case "header":
+			if seenHeader {
+				ok = false
+				return false
+			}
+			seenHeader = true
+			if field.Type != gjson.Null {
+				var v HeaderAuthType
+				if !v.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.header = &v
+			}
+		case "cookie":
+			if seenCookie {
+				ok = false
+				return false
+			}
+			seenCookie = true
+			if field.Type != gjson.Null {
+				var v1 CookieAuthType
+				if !v1.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.cookie = &v1
+			}
+		default:
+			if matchesJSONField(key.Str, "type", "header", "cookie") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	switch u.typ {
+	case "header":
+		if u.header == nil {
+			return false
+		}
+	case "cookie":
+		if u.cookie == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *AuthType) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return u.decodeJSONStrict(value)
+}
+
+func (u *AuthType) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	var typ string
+	var variantName string
+	var variant gjson.Result
+	var seenType, seenVariant bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch {
+		case key.Str == "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			typ, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"type\": %w", err)
+				return false
+			}
+			return true
+		case seenVariant && key.Str == variantName:
+			err = fmt.Errorf("duplicate field %q", key.Str)
+			return false
+		case seenVariant:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		variantName, variant, seenVariant = key.Str, field, true
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenType {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	if seenVariant && variantName != typ {
+		return fmt.Errorf("unknown field %q", variantName)
+	}
+	*u = AuthType{typ: typ}
+	switch typ {
+	case "header":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"header\" is required")
+		}
+		var v HeaderAuthType
+		err = v.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"header\": %w", err)
+		}
+		u.header = &v
+	case "cookie":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"cookie\" is required")
+		}
+		var v1 CookieAuthType
+		err = v1.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"cookie\": %w", err)
+		}
+		u.cookie = &v1
+	}
+	return nil
+}
+
 func (u AuthType) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(u)
 	if err != nil {
@@ -184,6 +373,35 @@ func NewAuthTypeFromCookie(v CookieAuthType) AuthType {
 	return AuthType{typ: "cookie", cookie: &v}
 }
 
+// Equal returns true if the AuthType is equal to other according to the Conjure semantics of its values.
+func (u AuthType) Equal(other AuthType) bool {
+	if u.typ != other.typ {
+		return false
+	}
+	if (u.header == nil) != (other.header == nil) {
+		return false
+	}
+	if u.header != nil {
+		if !(*u.header).Equal(*other.header) {
+			return false
+		}
+	}
+	if (u.cookie == nil) != (other.cookie == nil) {
+		return false
+	}
+	if u.cookie != nil {
+		if !(*u.cookie).Equal(*other.cookie) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the AuthType.
+func (u AuthType) Clone() AuthType {
+	return u
+}
+
 type ParameterType struct {
 	typ    string
 	body   *BodyParameterType
@@ -204,7 +422,8 @@ func (u *parameterTypeDeserializer) toStruct() ParameterType {
 	return ParameterType{typ: u.Type, body: u.Body, header: u.Header, path: u.Path, query: u.Query}
 }
 
-func (u *ParameterType) toSerializer() (interface{}, error) {
+func (u ParameterType) AppendJSON(out []byte) ([]byte, error) {
+	var err error
 	switch u.typ {
 	default:
 		return nil, fmt.Errorf("unknown type %q", u.typ)
@@ -212,46 +431,106 @@ func (u *ParameterType) toSerializer() (interface{}, error) {
 		if u.body == nil {
 			return nil, fmt.Errorf("field \"body\" is required")
 		}
-		return struct {
-			Type string            `json:"type"`
-			Body BodyParameterType `json:"body"`
-		}{Type: "body", Body: *u.body}, nil
+		out = append(out, "{\"type\":\"body\",\"body\":"...)
+		out, err = u.body.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "header":
 		if u.header == nil {
 			return nil, fmt.Errorf("field \"header\" is required")
 		}
-		return struct {
-			Type   string              `json:"type"`
-			Header HeaderParameterType `json:"header"`
-		}{Type: "header", Header: *u.header}, nil
+		out = append(out, "{\"type\":\"header\",\"header\":"...)
+		out, err = u.header.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "path":
 		if u.path == nil {
 			return nil, fmt.Errorf("field \"path\" is required")
 		}
-		return struct {
-			Type string            `json:"type"`
-			Path PathParameterType `json:"path"`
-		}{Type: "path", Path: *u.path}, nil
+		out = append(out, "{\"type\":\"path\",\"path\":"...)
+		out, err = u.path.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "query":
 		if u.query == nil {
 			return nil, fmt.Errorf("field \"query\" is required")
 		}
-		return struct {
-			Type  string             `json:"type"`
-			Query QueryParameterType `json:"query"`
-		}{Type: "query", Query: *u.query}, nil
+		out = append(out, "{\"type\":\"query\",\"query\":"...)
+		out, err = u.query.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	}
+	out = append(out, '}')
+	return out, nil
 }
 
-func (u ParameterType) MarshalJSON() ([]byte, error) {
-	ser, err := u.toSerializer()
-	if err != nil {
-		return nil, err
+func (u ParameterType) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	switch u.typ {
+	default:
+		return 0, fmt.Errorf("unknown type %q", u.typ)
+	case "body":
+		if u.body == nil {
+			return 0, fmt.Errorf("field \"body\" is required")
+		}
+		size = 23
+		n, err = u.body.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "header":
+		if u.header == nil {
+			return 0, fmt.Errorf("field \"header\" is required")
+		}
+		size = 27
+		n, err = u.header.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "path":
+		if u.path == nil {
+			return 0, fmt.Errorf("field \"path\" is required")
+		}
+		size = 23
+		n, err = u.path.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "query":
+		if u.query == nil {
+			return 0, fmt.Errorf("field \"query\" is required")
+		}
+		size = 25
+		n, err = u.query.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
 	}
-	return safejson.Marshal(ser)
+	return size, nil
+}
+
+func (u ParameterType) MarshalJSON() ([]byte, error) {
+	return u.AppendJSON(nil)
 }
 
 func (u *ParameterType) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v ParameterType
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*u = v
+			return nil
+		}
+	}
 	var deser parameterTypeDeserializer
 	if err := safejson.Unmarshal(data, &deser); err != nil {
 		return err
@@ -278,6 +557,211 @@ func (u *ParameterType) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (u *ParameterType) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenType, seenBody, seenHeader, seenPath, seenQuery bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !unmarshalJSONString(field, &u.typ) {
+				ok = false
+				return false
+			}
+		case "body":
+			if seenBody {
+				ok = false
+				return false
+			}
+			seenBody = true
+			if field.Type != gjson.Null {
+				var v BodyParameterType
+				if !v.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.body = &v
+			}
+		case "header":
+			if seenHeader {
+				ok = false
+				return false
+			}
+			seenHeader = true
+			if field.Type != gjson.Null {
+				var v1 HeaderParameterType
+				if !v1.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.header = &v1
+			}
+		case "path":
+			if seenPath {
+				ok = false
+				return false
+			}
+			seenPath = true
+			if field.Type != gjson.Null {
+				var v2 PathParameterType
+				if !v2.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.path = &v2
+			}
+		case "query":
+			if seenQuery {
+				ok = false
+				return false
+			}
+			seenQuery = true
+			if field.Type != gjson.Null {
+				var v3 QueryParameterType
+				if !v3.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.query = &v3
+			}
+		default:
+			if matchesJSONField(key.Str, "type", "body", "header", "path", "query") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	switch u.typ {
+	case "body":
+		if u.body == nil {
+			return false
+		}
+	case "header":
+		if u.header == nil {
+			return false
+		}
+	case "path":
+		if u.path == nil {
+			return false
+		}
+	case "query":
+		if u.query == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *ParameterType) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return u.decodeJSONStrict(value)
+}
+
+func (u *ParameterType) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	var typ string
+	var variantName string
+	var variant gjson.Result
+	var seenType, seenVariant bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch {
+		case key.Str == "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			typ, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"type\": %w", err)
+				return false
+			}
+			return true
+		case seenVariant && key.Str == variantName:
+			err = fmt.Errorf("duplicate field %q", key.Str)
+			return false
+		case seenVariant:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		variantName, variant, seenVariant = key.Str, field, true
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenType {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	if seenVariant && variantName != typ {
+		return fmt.Errorf("unknown field %q", variantName)
+	}
+	*u = ParameterType{typ: typ}
+	switch typ {
+	case "body":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"body\" is required")
+		}
+		var v BodyParameterType
+		err = v.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"body\": %w", err)
+		}
+		u.body = &v
+	case "header":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"header\" is required")
+		}
+		var v1 HeaderParameterType
+		err = v1.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"header\": %w", err)
+		}
+		u.header = &v1
+	case "path":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"path\" is required")
+		}
+		var v2 PathParameterType
+		err = v2.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"path\": %w", err)
+		}
+		u.path = &v2
+	case "query":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"query\" is required")
+		}
+		var v3 QueryParameterType
+		err = v3.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"query\": %w", err)
+		}
+		u.query = &v3
+	}
+	return nil
+}
+
 func (u ParameterType) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(u)
 	if err != nil {
@@ -436,6 +920,51 @@ func NewParameterTypeFromQuery(v QueryParameterType) ParameterType {
 	return ParameterType{typ: "query", query: &v}
 }
 
+// Equal returns true if the ParameterType is equal to other according to the Conjure semantics of its values.
+func (u ParameterType) Equal(other ParameterType) bool {
+	if u.typ != other.typ {
+		return false
+	}
+	if (u.body == nil) != (other.body == nil) {
+		return false
+	}
+	if u.body != nil {
+		if !(*u.body).Equal(*other.body) {
+			return false
+		}
+	}
+	if (u.header == nil) != (other.header == nil) {
+		return false
+	}
+	if u.header != nil {
+		if !(*u.header).Equal(*other.header) {
+			return false
+		}
+	}
+	if (u.path == nil) != (other.path == nil) {
+		return false
+	}
+	if u.path != nil {
+		if !(*u.path).Equal(*other.path) {
+			return false
+		}
+	}
+	if (u.query == nil) != (other.query == nil) {
+		return false
+	}
+	if u.query != nil {
+		if !(*u.query).Equal(*other.query) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the ParameterType.
+func (u ParameterType) Clone() ParameterType {
+	return u
+}
+
 type Type struct {
 	typ       string
 	primitive *PrimitiveType
@@ -462,7 +991,8 @@ func (u *typeDeserializer) toStruct() Type {
 	return Type{typ: u.Type, primitive: u.Primitive, optional: u.Optional, list: u.List, set: u.Set, map_: u.Map, reference: u.Reference, external: u.External}
 }
 
-func (u *Type) toSerializer() (interface{}, error) {
+func (u Type) AppendJSON(out []byte) ([]byte, error) {
+	var err error
 	switch u.typ {
 	default:
 		return nil, fmt.Errorf("unknown type %q", u.typ)
@@ -470,70 +1000,163 @@ func (u *Type) toSerializer() (interface{}, error) {
 		if u.primitive == nil {
 			return nil, fmt.Errorf("field \"primitive\" is required")
 		}
-		return struct {
-			Type      string        `json:"type"`
-			Primitive PrimitiveType `json:"primitive"`
-		}{Type: "primitive", Primitive: *u.primitive}, nil
+		out = append(out, "{\"type\":\"primitive\",\"primitive\":"...)
+		out, err = u.primitive.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "optional":
 		if u.optional == nil {
 			return nil, fmt.Errorf("field \"optional\" is required")
 		}
-		return struct {
-			Type     string       `json:"type"`
-			Optional OptionalType `json:"optional"`
-		}{Type: "optional", Optional: *u.optional}, nil
+		out = append(out, "{\"type\":\"optional\",\"optional\":"...)
+		out, err = u.optional.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "list":
 		if u.list == nil {
 			return nil, fmt.Errorf("field \"list\" is required")
 		}
-		return struct {
-			Type string   `json:"type"`
-			List ListType `json:"list"`
-		}{Type: "list", List: *u.list}, nil
+		out = append(out, "{\"type\":\"list\",\"list\":"...)
+		out, err = u.list.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "set":
 		if u.set == nil {
 			return nil, fmt.Errorf("field \"set\" is required")
 		}
-		return struct {
-			Type string  `json:"type"`
-			Set  SetType `json:"set"`
-		}{Type: "set", Set: *u.set}, nil
+		out = append(out, "{\"type\":\"set\",\"set\":"...)
+		out, err = u.set.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "map":
 		if u.map_ == nil {
 			return nil, fmt.Errorf("field \"map\" is required")
 		}
-		return struct {
-			Type string  `json:"type"`
-			Map  MapType `json:"map"`
-		}{Type: "map", Map: *u.map_}, nil
+		out = append(out, "{\"type\":\"map\",\"map\":"...)
+		out, err = u.map_.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "reference":
 		if u.reference == nil {
 			return nil, fmt.Errorf("field \"reference\" is required")
 		}
-		return struct {
-			Type      string   `json:"type"`
-			Reference TypeName `json:"reference"`
-		}{Type: "reference", Reference: *u.reference}, nil
+		out = append(out, "{\"type\":\"reference\",\"reference\":"...)
+		out, err = u.reference.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "external":
 		if u.external == nil {
 			return nil, fmt.Errorf("field \"external\" is required")
 		}
-		return struct {
-			Type     string            `json:"type"`
-			External ExternalReference `json:"external"`
-		}{Type: "external", External: *u.external}, nil
+		out = append(out, "{\"type\":\"external\",\"external\":"...)
+		out, err = u.external.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	}
+	out = append(out, '}')
+	return out, nil
 }
 
-func (u Type) MarshalJSON() ([]byte, error) {
-	ser, err := u.toSerializer()
-	if err != nil {
-		return nil, err
+func (u Type) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	switch u.typ {
+	default:
+		return 0, fmt.Errorf("unknown type %q", u.typ)
+	case "primitive":
+		if u.primitive == nil {
+			return 0, fmt.Errorf("field \"primitive\" is required")
+		}
+		size = 33
+		n, err = u.primitive.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "optional":
+		if u.optional == nil {
+			return 0, fmt.Errorf("field \"optional\" is required")
+		}
+		size = 31
+		n, err = u.optional.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "list":
+		if u.list == nil {
+			return 0, fmt.Errorf("field \"list\" is required")
+		}
+		size = 23
+		n, err = u.list.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "set":
+		if u.set == nil {
+			return 0, fmt.Errorf("field \"set\" is required")
+		}
+		size = 21
+		n, err = u.set.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "map":
+		if u.map_ == nil {
+			return 0, fmt.Errorf("field \"map\" is required")
+		}
+		size = 21
+		n, err = u.map_.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "reference":
+		if u.reference == nil {
+			return 0, fmt.Errorf("field \"reference\" is required")
+		}
+		size = 33
+		n, err = u.reference.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "external":
+		if u.external == nil {
+			return 0, fmt.Errorf("field \"external\" is required")
+		}
+		size = 31
+		n, err = u.external.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
 	}
-	return safejson.Marshal(ser)
+	return size, nil
+}
+
+func (u Type) MarshalJSON() ([]byte, error) {
+	return u.AppendJSON(nil)
 }
 
 func (u *Type) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Type
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*u = v
+			return nil
+		}
+	}
 	var deser typeDeserializer
 	if err := safejson.Unmarshal(data, &deser); err != nil {
 		return err
@@ -572,6 +1195,298 @@ func (u *Type) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (u *Type) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenType, seenPrimitive, seenOptional, seenList, seenSet, seenMap, seenReference, seenExternal bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !unmarshalJSONString(field, &u.typ) {
+				ok = false
+				return false
+			}
+		case "primitive":
+			if seenPrimitive {
+				ok = false
+				return false
+			}
+			seenPrimitive = true
+			if field.Type != gjson.Null {
+				var v PrimitiveType
+				if field.Type != gjson.Null {
+					var s string
+					if !unmarshalJSONString(field, &s) || v.UnmarshalText([]byte(s)) != nil {
+						ok = false
+						return false
+					}
+				}
+				u.primitive = &v
+			}
+		case "optional":
+			if seenOptional {
+				ok = false
+				return false
+			}
+			seenOptional = true
+			if field.Type != gjson.Null {
+				var v1 OptionalType
+				if !v1.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.optional = &v1
+			}
+		case "list":
+			if seenList {
+				ok = false
+				return false
+			}
+			seenList = true
+			if field.Type != gjson.Null {
+				var v2 ListType
+				if !v2.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.list = &v2
+			}
+		case "set":
+			if seenSet {
+				ok = false
+				return false
+			}
+			seenSet = true
+			if field.Type != gjson.Null {
+				var v3 SetType
+				if !v3.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.set = &v3
+			}
+		case "map":
+			if seenMap {
+				ok = false
+				return false
+			}
+			seenMap = true
+			if field.Type != gjson.Null {
+				var v4 MapType
+				if !v4.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.map_ = &v4
+			}
+		case "reference":
+			if seenReference {
+				ok = false
+				return false
+			}
+			seenReference = true
+			if field.Type != gjson.Null {
+				var v5 TypeName
+				if !v5.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.reference = &v5
+			}
+		case "external":
+			if seenExternal {
+				ok = false
+				return false
+			}
+			seenExternal = true
+			if field.Type != gjson.Null {
+				var v6 ExternalReference
+				if !v6.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.external = &v6
+			}
+		default:
+			if matchesJSONField(key.Str, "type", "primitive", "optional", "list", "set", "map", "reference", "external") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	switch u.typ {
+	case "primitive":
+		if u.primitive == nil {
+			return false
+		}
+	case "optional":
+		if u.optional == nil {
+			return false
+		}
+	case "list":
+		if u.list == nil {
+			return false
+		}
+	case "set":
+		if u.set == nil {
+			return false
+		}
+	case "map":
+		if u.map_ == nil {
+			return false
+		}
+	case "reference":
+		if u.reference == nil {
+			return false
+		}
+	case "external":
+		if u.external == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *Type) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return u.decodeJSONStrict(value)
+}
+
+func (u *Type) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	var typ string
+	var variantName string
+	var variant gjson.Result
+	var seenType, seenVariant bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch {
+		case key.Str == "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			typ, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"type\": %w", err)
+				return false
+			}
+			return true
+		case seenVariant && key.Str == variantName:
+			err = fmt.Errorf("duplicate field %q", key.Str)
+			return false
+		case seenVariant:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		variantName, variant, seenVariant = key.Str, field, true
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenType {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	if seenVariant && variantName != typ {
+		return fmt.Errorf("unknown field %q", variantName)
+	}
+	*u = Type{typ: typ}
+	switch typ {
+	case "primitive":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"primitive\" is required")
+		}
+		var v PrimitiveType
+		err = v.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"primitive\": %w", err)
+		}
+		u.primitive = &v
+	case "optional":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"optional\" is required")
+		}
+		var v1 OptionalType
+		err = v1.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"optional\": %w", err)
+		}
+		u.optional = &v1
+	case "list":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"list\" is required")
+		}
+		var v2 ListType
+		err = v2.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"list\": %w", err)
+		}
+		u.list = &v2
+	case "set":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"set\" is required")
+		}
+		var v3 SetType
+		err = v3.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"set\": %w", err)
+		}
+		u.set = &v3
+	case "map":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"map\" is required")
+		}
+		var v4 MapType
+		err = v4.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"map\": %w", err)
+		}
+		u.map_ = &v4
+	case "reference":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"reference\" is required")
+		}
+		var v5 TypeName
+		err = v5.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"reference\": %w", err)
+		}
+		u.reference = &v5
+	case "external":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"external\" is required")
+		}
+		var v6 ExternalReference
+		err = v6.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"external\": %w", err)
+		}
+		u.external = &v6
+	}
+	return nil
+}
+
 func (u Type) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(u)
 	if err != nil {
@@ -805,6 +1720,75 @@ func NewTypeFromExternal(v ExternalReference) Type {
 	return Type{typ: "external", external: &v}
 }
 
+// Equal returns true if the Type is equal to other according to the Conjure semantics of its values.
+func (u Type) Equal(other Type) bool {
+	if u.typ != other.typ {
+		return false
+	}
+	if (u.primitive == nil) != (other.primitive == nil) {
+		return false
+	}
+	if u.primitive != nil {
+		if !(*u.primitive).Equal(*other.primitive) {
+			return false
+		}
+	}
+	if (u.optional == nil) != (other.optional == nil) {
+		return false
+	}
+	if u.optional != nil {
+		if !(*u.optional).Equal(*other.optional) {
+			return false
+		}
+	}
+	if (u.list == nil) != (other.list == nil) {
+		return false
+	}
+	if u.list != nil {
+		if !(*u.list).Equal(*other.list) {
+			return false
+		}
+	}
+	if (u.set == nil) != (other.set == nil) {
+		return false
+	}
+	if u.set != nil {
+		if !(*u.set).Equal(*other.set) {
+			return false
+		}
+	}
+	if (u.map_ == nil) != (other.map_ == nil) {
+		return false
+	}
+	if u.map_ != nil {
+		if !(*u.map_).Equal(*other.map_) {
+			return false
+		}
+	}
+	if (u.reference == nil) != (other.reference == nil) {
+		return false
+	}
+	if u.reference != nil {
+		if !(*u.reference).Equal(*other.reference) {
+			return false
+		}
+	}
+	if (u.external == nil) != (other.external == nil) {
+		return false
+	}
+	if u.external != nil {
+		if !(*u.external).Equal(*other.external) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Type.
+func (u Type) Clone() Type {
+	return u
+}
+
 type TypeDefinition struct {
 	typ    string
 	alias  *AliasDefinition
@@ -825,7 +1809,8 @@ func (u *typeDefinitionDeserializer) toStruct() TypeDefinition {
 	return TypeDefinition{typ: u.Type, alias: u.Alias, enum: u.Enum, object: u.Object, union: u.Union}
 }
 
-func (u *TypeDefinition) toSerializer() (interface{}, error) {
+func (u TypeDefinition) AppendJSON(out []byte) ([]byte, error) {
+	var err error
 	switch u.typ {
 	default:
 		return nil, fmt.Errorf("unknown type %q", u.typ)
@@ -833,46 +1818,106 @@ func (u *TypeDefinition) toSerializer() (interface{}, error) {
 		if u.alias == nil {
 			return nil, fmt.Errorf("field \"alias\" is required")
 		}
-		return struct {
-			Type  string          `json:"type"`
-			Alias AliasDefinition `json:"alias"`
-		}{Type: "alias", Alias: *u.alias}, nil
+		out = append(out, "{\"type\":\"alias\",\"alias\":"...)
+		out, err = u.alias.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "enum":
 		if u.enum == nil {
 			return nil, fmt.Errorf("field \"enum\" is required")
 		}
-		return struct {
-			Type string         `json:"type"`
-			Enum EnumDefinition `json:"enum"`
-		}{Type: "enum", Enum: *u.enum}, nil
+		out = append(out, "{\"type\":\"enum\",\"enum\":"...)
+		out, err = u.enum.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "object":
 		if u.object == nil {
 			return nil, fmt.Errorf("field \"object\" is required")
 		}
-		return struct {
-			Type   string           `json:"type"`
-			Object ObjectDefinition `json:"object"`
-		}{Type: "object", Object: *u.object}, nil
+		out = append(out, "{\"type\":\"object\",\"object\":"...)
+		out, err = u.object.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "union":
 		if u.union == nil {
 			return nil, fmt.Errorf("field \"union\" is required")
 		}
-		return struct {
-			Type  string          `json:"type"`
-			Union UnionDefinition `json:"union"`
-		}{Type: "union", Union: *u.union}, nil
+		out = append(out, "{\"type\":\"union\",\"union\":"...)
+		out, err = u.union.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	}
+	out = append(out, '}')
+	return out, nil
 }
 
-func (u TypeDefinition) MarshalJSON() ([]byte, error) {
-	ser, err := u.toSerializer()
-	if err != nil {
-		return nil, err
+func (u TypeDefinition) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	switch u.typ {
+	default:
+		return 0, fmt.Errorf("unknown type %q", u.typ)
+	case "alias":
+		if u.alias == nil {
+			return 0, fmt.Errorf("field \"alias\" is required")
+		}
+		size = 25
+		n, err = u.alias.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "enum":
+		if u.enum == nil {
+			return 0, fmt.Errorf("field \"enum\" is required")
+		}
+		size = 23
+		n, err = u.enum.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "object":
+		if u.object == nil {
+			return 0, fmt.Errorf("field \"object\" is required")
+		}
+		size = 27
+		n, err = u.object.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "union":
+		if u.union == nil {
+			return 0, fmt.Errorf("field \"union\" is required")
+		}
+		size = 25
+		n, err = u.union.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
 	}
-	return safejson.Marshal(ser)
+	return size, nil
+}
+
+func (u TypeDefinition) MarshalJSON() ([]byte, error) {
+	return u.AppendJSON(nil)
 }
 
 func (u *TypeDefinition) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v TypeDefinition
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*u = v
+			return nil
+		}
+	}
 	var deser typeDefinitionDeserializer
 	if err := safejson.Unmarshal(data, &deser); err != nil {
 		return err
@@ -899,6 +1944,211 @@ func (u *TypeDefinition) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (u *TypeDefinition) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenType, seenAlias, seenEnum, seenObject, seenUnion bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !unmarshalJSONString(field, &u.typ) {
+				ok = false
+				return false
+			}
+		case "alias":
+			if seenAlias {
+				ok = false
+				return false
+			}
+			seenAlias = true
+			if field.Type != gjson.Null {
+				var v AliasDefinition
+				if !v.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.alias = &v
+			}
+		case "enum":
+			if seenEnum {
+				ok = false
+				return false
+			}
+			seenEnum = true
+			if field.Type != gjson.Null {
+				var v1 EnumDefinition
+				if !v1.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.enum = &v1
+			}
+		case "object":
+			if seenObject {
+				ok = false
+				return false
+			}
+			seenObject = true
+			if field.Type != gjson.Null {
+				var v2 ObjectDefinition
+				if !v2.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.object = &v2
+			}
+		case "union":
+			if seenUnion {
+				ok = false
+				return false
+			}
+			seenUnion = true
+			if field.Type != gjson.Null {
+				var v3 UnionDefinition
+				if !v3.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.union = &v3
+			}
+		default:
+			if matchesJSONField(key.Str, "type", "alias", "enum", "object", "union") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	switch u.typ {
+	case "alias":
+		if u.alias == nil {
+			return false
+		}
+	case "enum":
+		if u.enum == nil {
+			return false
+		}
+	case "object":
+		if u.object == nil {
+			return false
+		}
+	case "union":
+		if u.union == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *TypeDefinition) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return u.decodeJSONStrict(value)
+}
+
+func (u *TypeDefinition) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	var typ string
+	var variantName string
+	var variant gjson.Result
+	var seenType, seenVariant bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch {
+		case key.Str == "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			typ, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"type\": %w", err)
+				return false
+			}
+			return true
+		case seenVariant && key.Str == variantName:
+			err = fmt.Errorf("duplicate field %q", key.Str)
+			return false
+		case seenVariant:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		variantName, variant, seenVariant = key.Str, field, true
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenType {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	if seenVariant && variantName != typ {
+		return fmt.Errorf("unknown field %q", variantName)
+	}
+	*u = TypeDefinition{typ: typ}
+	switch typ {
+	case "alias":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"alias\" is required")
+		}
+		var v AliasDefinition
+		err = v.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"alias\": %w", err)
+		}
+		u.alias = &v
+	case "enum":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"enum\" is required")
+		}
+		var v1 EnumDefinition
+		err = v1.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"enum\": %w", err)
+		}
+		u.enum = &v1
+	case "object":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"object\" is required")
+		}
+		var v2 ObjectDefinition
+		err = v2.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"object\": %w", err)
+		}
+		u.object = &v2
+	case "union":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"union\" is required")
+		}
+		var v3 UnionDefinition
+		err = v3.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"union\": %w", err)
+		}
+		u.union = &v3
+	}
+	return nil
+}
+
 func (u TypeDefinition) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(u)
 	if err != nil {
@@ -1056,3 +2306,65 @@ func NewTypeDefinitionFromObject(v ObjectDefinition) TypeDefinition {
 func NewTypeDefinitionFromUnion(v UnionDefinition) TypeDefinition {
 	return TypeDefinition{typ: "union", union: &v}
 }
+
+// Equal returns true if the TypeDefinition is equal to other according to the Conjure semantics of its values.
+func (u TypeDefinition) Equal(other TypeDefinition) bool {
+	if u.typ != other.typ {
+		return false
+	}
+	if (u.alias == nil) != (other.alias == nil) {
+		return false
+	}
+	if u.alias != nil {
+		if !(*u.alias).Equal(*other.alias) {
+			return false
+		}
+	}
+	if (u.enum == nil) != (other.enum == nil) {
+		return false
+	}
+	if u.enum != nil {
+		if !(*u.enum).Equal(*other.enum) {
+			return false
+		}
+	}
+	if (u.object == nil) != (other.object == nil) {
+		return false
+	}
+	if u.object != nil {
+		if !(*u.object).Equal(*other.object) {
+			return false
+		}
+	}
+	if (u.union == nil) != (other.union == nil) {
+		return false
+	}
+	if u.union != nil {
+		if !(*u.union).Equal(*other.union) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the TypeDefinition.
+func (u TypeDefinition) Clone() TypeDefinition {
+	out := u
+	if u.alias != nil {
+		v := (*u.alias).Clone()
+		out.alias = &v
+	}
+	if u.enum != nil {
+		v := (*u.enum).Clone()
+		out.enum = &v
+	}
+	if u.object != nil {
+		v := (*u.object).Clone()
+		out.object = &v
+	}
+	if u.union != nil {
+		v := (*u.union).Clone()
+		out.union = &v
+	}
+	return out
+}
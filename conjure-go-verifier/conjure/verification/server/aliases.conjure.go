@@ -2,4 +2,37 @@
 
 package server
 
+import (
+	"github.com/tidwall/gjson"
+)
+
 type EndpointName string
+
+func (a *EndpointName) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *EndpointName) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v string
+	v, err = decodeJSONString(value)
+	if err != nil {
+		return err
+	}
+	*a = EndpointName(v)
+	return nil
+}
+
+// Equal returns true if the EndpointName is equal to other according to the Conjure semantics of its values.
+func (a EndpointName) Equal(other EndpointName) bool {
+	return string(a) == string(other)
+}
+
+// Clone returns a deep copy of the EndpointName.
+func (a EndpointName) Clone() EndpointName {
+	return a
+}
@@ -3,8 +3,12 @@
 package server
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type ClientTestCases struct {
@@ -14,24 +18,205 @@ type ClientTestCases struct {
 	SingleQueryParamService map[EndpointName][]string                     `json:"singleQueryParamService"`
 }
 
-func (o ClientTestCases) MarshalJSON() ([]byte, error) {
-	if o.AutoDeserialize == nil {
-		o.AutoDeserialize = make(map[EndpointName]PositiveAndNegativeTestCases, 0)
+func (o ClientTestCases) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"autoDeserialize\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value PositiveAndNegativeTestCases
+		}
+		entries := make([]mapEntry, 0, len(o.AutoDeserialize))
+		for k, v := range o.AutoDeserialize {
+			entries = append(entries, mapEntry{key: string(k), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out, err = entry.value.AppendJSON(out)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
-	if o.SingleHeaderService == nil {
-		o.SingleHeaderService = make(map[EndpointName][]string, 0)
+	out = append(out, '}')
+	out = append(out, ",\"singleHeaderService\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value []string
+		}
+		entries := make([]mapEntry, 0, len(o.SingleHeaderService))
+		for k, v := range o.SingleHeaderService {
+			entries = append(entries, mapEntry{key: string(k), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = append(out, '[')
+			for i1, v1 := range entry.value {
+				if i1 > 0 {
+					out = append(out, ',')
+				}
+				out = appendJSONString(out, v1)
+			}
+			out = append(out, ']')
+		}
 	}
-	if o.SinglePathParamService == nil {
-		o.SinglePathParamService = make(map[EndpointName][]string, 0)
+	out = append(out, '}')
+	out = append(out, ",\"singlePathParamService\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value []string
+		}
+		entries := make([]mapEntry, 0, len(o.SinglePathParamService))
+		for k, v := range o.SinglePathParamService {
+			entries = append(entries, mapEntry{key: string(k), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = append(out, '[')
+			for i1, v1 := range entry.value {
+				if i1 > 0 {
+					out = append(out, ',')
+				}
+				out = appendJSONString(out, v1)
+			}
+			out = append(out, ']')
+		}
 	}
-	if o.SingleQueryParamService == nil {
-		o.SingleQueryParamService = make(map[EndpointName][]string, 0)
+	out = append(out, '}')
+	out = append(out, ",\"singleQueryParamService\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value []string
+		}
+		entries := make([]mapEntry, 0, len(o.SingleQueryParamService))
+		for k, v := range o.SingleQueryParamService {
+			entries = append(entries, mapEntry{key: string(k), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = append(out, '[')
+			for i1, v1 := range entry.value {
+				if i1 > 0 {
+					out = append(out, ',')
+				}
+				out = appendJSONString(out, v1)
+			}
+			out = append(out, ']')
+		}
 	}
-	type ClientTestCasesAlias ClientTestCases
-	return safejson.Marshal(ClientTestCasesAlias(o))
+	out = append(out, '}')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o ClientTestCases) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 96
+	size += 2
+	if len(o.AutoDeserialize) > 1 {
+		size += len(o.AutoDeserialize) - 1
+	}
+	for k, v := range o.AutoDeserialize {
+		size += jsonStringSize(string(k)) + 1
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 2
+	if len(o.SingleHeaderService) > 1 {
+		size += len(o.SingleHeaderService) - 1
+	}
+	for k, v := range o.SingleHeaderService {
+		size += jsonStringSize(string(k)) + 1
+		size += 2
+		if len(v) > 1 {
+			size += len(v) - 1
+		}
+		for _, v1 := range v {
+			size += jsonStringSize(v1)
+		}
+	}
+	size += 2
+	if len(o.SinglePathParamService) > 1 {
+		size += len(o.SinglePathParamService) - 1
+	}
+	for k, v := range o.SinglePathParamService {
+		size += jsonStringSize(string(k)) + 1
+		size += 2
+		if len(v) > 1 {
+			size += len(v) - 1
+		}
+		for _, v1 := range v {
+			size += jsonStringSize(v1)
+		}
+	}
+	size += 2
+	if len(o.SingleQueryParamService) > 1 {
+		size += len(o.SingleQueryParamService) - 1
+	}
+	for k, v := range o.SingleQueryParamService {
+		size += jsonStringSize(string(k)) + 1
+		size += 2
+		if len(v) > 1 {
+			size += len(v) - 1
+		}
+		for _, v1 := range v {
+			size += jsonStringSize(v1)
+		}
+	}
+	return size, nil
+}
+
+func (o ClientTestCases) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *ClientTestCases) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v ClientTestCases
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type ClientTestCasesAlias ClientTestCases
 	var rawClientTestCases ClientTestCasesAlias
 	if err := safejson.Unmarshal(data, &rawClientTestCases); err != nil {
@@ -53,6 +238,458 @@ func (o *ClientTestCases) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *ClientTestCases) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.AutoDeserialize == nil {
+			o.AutoDeserialize = make(map[EndpointName]PositiveAndNegativeTestCases, 0)
+		}
+		if o.SingleHeaderService == nil {
+			o.SingleHeaderService = make(map[EndpointName][]string, 0)
+		}
+		if o.SinglePathParamService == nil {
+			o.SinglePathParamService = make(map[EndpointName][]string, 0)
+		}
+		if o.SingleQueryParamService == nil {
+			o.SingleQueryParamService = make(map[EndpointName][]string, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenAutoDeserialize, seenSingleHeaderService, seenSinglePathParamService, seenSingleQueryParamService bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "autoDeserialize":
+			if seenAutoDeserialize {
+				ok = false
+				return false
+			}
+			seenAutoDeserialize = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.AutoDeserialize = make(map[EndpointName]PositiveAndNegativeTestCases)
+				ok1 := true
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k EndpointName
+					var k1 string
+					if !unmarshalJSONString(key1, &k1) {
+						ok1 = false
+						return false
+					}
+					k = EndpointName(k1)
+					var v PositiveAndNegativeTestCases
+					if !v.unmarshalJSONValue(elem) {
+						ok1 = false
+						return false
+					}
+					o.AutoDeserialize[k] = v
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "singleHeaderService":
+			if seenSingleHeaderService {
+				ok = false
+				return false
+			}
+			seenSingleHeaderService = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.SingleHeaderService = make(map[EndpointName][]string)
+				ok2 := true
+				field.ForEach(func(key2, elem1 gjson.Result) bool {
+					var k2 EndpointName
+					var k3 string
+					if !unmarshalJSONString(key2, &k3) {
+						ok2 = false
+						return false
+					}
+					k2 = EndpointName(k3)
+					var v1 []string
+					if elem1.Type != gjson.Null {
+						if !elem1.IsArray() {
+							ok2 = false
+							return false
+						}
+						v1 = make([]string, 0)
+						ok3 := true
+						elem1.ForEach(func(_, elem2 gjson.Result) bool {
+							var v2 string
+							if !unmarshalJSONString(elem2, &v2) {
+								ok3 = false
+								return false
+							}
+							v1 = append(v1, v2)
+							return true
+						})
+						if !ok3 {
+							ok2 = false
+							return false
+						}
+					}
+					o.SingleHeaderService[k2] = v1
+					return true
+				})
+				if !ok2 {
+					ok = false
+					return false
+				}
+			}
+		case "singlePathParamService":
+			if seenSinglePathParamService {
+				ok = false
+				return false
+			}
+			seenSinglePathParamService = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.SinglePathParamService = make(map[EndpointName][]string)
+				ok4 := true
+				field.ForEach(func(key3, elem3 gjson.Result) bool {
+					var k4 EndpointName
+					var k5 string
+					if !unmarshalJSONString(key3, &k5) {
+						ok4 = false
+						return false
+					}
+					k4 = EndpointName(k5)
+					var v3 []string
+					if elem3.Type != gjson.Null {
+						if !elem3.IsArray() {
+							ok4 = false
+							return false
+						}
+						v3 = make([]string, 0)
+						ok5 := true
+						elem3.ForEach(func(_, elem4 gjson.Result) bool {
+							var v4 string
+							if !unmarshalJSONString(elem4, &v4) {
+								ok5 = false
+								return false
+							}
+							v3 = append(v3, v4)
+							return true
+						})
+						if !ok5 {
+							ok4 = false
+							return false
+						}
+					}
+					o.SinglePathParamService[k4] = v3
+					return true
+				})
+				if !ok4 {
+					ok = false
+					return false
+				}
+			}
+		case "singleQueryParamService":
+			if seenSingleQueryParamService {
+				ok = false
+				return false
+			}
+			seenSingleQueryParamService = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.SingleQueryParamService = make(map[EndpointName][]string)
+				ok6 := true
+				field.ForEach(func(key4, elem5 gjson.Result) bool {
+					var k6 EndpointName
+					var k7 string
+					if !unmarshalJSONString(key4, &k7) {
+						ok6 = false
+						return false
+					}
+					k6 = EndpointName(k7)
+					var v5 []string
+					if elem5.Type != gjson.Null {
+						if !elem5.IsArray() {
+							ok6 = false
+							return false
+						}
+						v5 = make([]string, 0)
+						ok7 := true
+						elem5.ForEach(func(_, elem6 gjson.Result) bool {
+							var v6 string
+							if !unmarshalJSONString(elem6, &v6) {
+								ok7 = false
+								return false
+							}
+							v5 = append(v5, v6)
+							return true
+						})
+						if !ok7 {
+							ok6 = false
+							return false
+						}
+					}
+					o.SingleQueryParamService[k6] = v5
+					return true
+				})
+				if !ok6 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "autoDeserialize", "singleHeaderService", "singlePathParamService", "singleQueryParamService") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.AutoDeserialize == nil {
+		o.AutoDeserialize = make(map[EndpointName]PositiveAndNegativeTestCases, 0)
+	}
+	if o.SingleHeaderService == nil {
+		o.SingleHeaderService = make(map[EndpointName][]string, 0)
+	}
+	if o.SinglePathParamService == nil {
+		o.SinglePathParamService = make(map[EndpointName][]string, 0)
+	}
+	if o.SingleQueryParamService == nil {
+		o.SingleQueryParamService = make(map[EndpointName][]string, 0)
+	}
+	return true
+}
+
+func (o *ClientTestCases) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *ClientTestCases) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = ClientTestCases{}
+	var seenAutoDeserialize, seenSingleHeaderService, seenSinglePathParamService, seenSingleQueryParamService bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "autoDeserialize":
+			if seenAutoDeserialize {
+				err = fmt.Errorf("duplicate field \"autoDeserialize\"")
+				return false
+			}
+			seenAutoDeserialize = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"autoDeserialize\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.AutoDeserialize = make(map[EndpointName]PositiveAndNegativeTestCases)
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k EndpointName
+					var k1 string
+					k1 = key1.Str
+					k = EndpointName(k1)
+					if _, ok := o.AutoDeserialize[k]; ok {
+						err = fmt.Errorf("duplicate map key %s", key1.Raw)
+						return false
+					}
+					var v PositiveAndNegativeTestCases
+					err = v.decodeJSONStrict(elem)
+					if err != nil {
+						return false
+					}
+					o.AutoDeserialize[k] = v
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"autoDeserialize\": %w", err)
+					return false
+				}
+			}
+		case "singleHeaderService":
+			if seenSingleHeaderService {
+				err = fmt.Errorf("duplicate field \"singleHeaderService\"")
+				return false
+			}
+			seenSingleHeaderService = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"singleHeaderService\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.SingleHeaderService = make(map[EndpointName][]string)
+				field.ForEach(func(key2, elem1 gjson.Result) bool {
+					var k2 EndpointName
+					var k3 string
+					k3 = key2.Str
+					k2 = EndpointName(k3)
+					if _, ok := o.SingleHeaderService[k2]; ok {
+						err = fmt.Errorf("duplicate map key %s", key2.Raw)
+						return false
+					}
+					var v1 []string
+					if !elem1.IsArray() {
+						err = jsonTypeError(elem1, "array")
+						return false
+					}
+					v1 = make([]string, 0)
+					elem1.ForEach(func(_, elem2 gjson.Result) bool {
+						var v2 string
+						v2, err = decodeJSONString(elem2)
+						if err != nil {
+							return false
+						}
+						v1 = append(v1, v2)
+						return true
+					})
+					if err != nil {
+						return false
+					}
+					o.SingleHeaderService[k2] = v1
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"singleHeaderService\": %w", err)
+					return false
+				}
+			}
+		case "singlePathParamService":
+			if seenSinglePathParamService {
+				err = fmt.Errorf("duplicate field \"singlePathParamService\"")
+				return false
+			}
+			seenSinglePathParamService = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"singlePathParamService\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.SinglePathParamService = make(map[EndpointName][]string)
+				field.ForEach(func(key3, elem3 gjson.Result) bool {
+					var k4 EndpointName
+					var k5 string
+					k5 = key3.Str
+					k4 = EndpointName(k5)
+					if _, ok := o.SinglePathParamService[k4]; ok {
+						err = fmt.Errorf("duplicate map key %s", key3.Raw)
+						return false
+					}
+					var v3 []string
+					if !elem3.IsArray() {
+						err = jsonTypeError(elem3, "array")
+						return false
+					}
+					v3 = make([]string, 0)
+					elem3.ForEach(func(_, elem4 gjson.Result) bool {
+						var v4 string
+						v4, err = decodeJSONString(elem4)
+						if err != nil {
+							return false
+						}
+						v3 = append(v3, v4)
+						return true
+					})
+					if err != nil {
+						return false
+					}
+					o.SinglePathParamService[k4] = v3
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"singlePathParamService\": %w", err)
+					return false
+				}
+			}
+		case "singleQueryParamService":
+			if seenSingleQueryParamService {
+				err = fmt.Errorf("duplicate field \"singleQueryParamService\"")
+				return false
+			}
+			seenSingleQueryParamService = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"singleQueryParamService\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.SingleQueryParamService = make(map[EndpointName][]string)
+				field.ForEach(func(key4, elem5 gjson.Result) bool {
+					var k6 EndpointName
+					var k7 string
+					k7 = key4.Str
+					k6 = EndpointName(k7)
+					if _, ok := o.SingleQueryParamService[k6]; ok {
+						err = fmt.Errorf("duplicate map key %s", key4.Raw)
+						return false
+					}
+					var v5 []string
+					if !elem5.IsArray() {
+						err = jsonTypeError(elem5, "array")
+						return false
+					}
+					v5 = make([]string, 0)
+					elem5.ForEach(func(_, elem6 gjson.Result) bool {
+						var v6 string
+						v6, err = decodeJSONString(elem6)
+						if err != nil {
+							return false
+						}
+						v5 = append(v5, v6)
+						return true
+					})
+					if err != nil {
+						return false
+					}
+					o.SingleQueryParamService[k6] = v5
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"singleQueryParamService\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if o.AutoDeserialize == nil {
+		o.AutoDeserialize = make(map[EndpointName]PositiveAndNegativeTestCases, 0)
+	}
+	if o.SingleHeaderService == nil {
+		o.SingleHeaderService = make(map[EndpointName][]string, 0)
+	}
+	if o.SinglePathParamService == nil {
+		o.SinglePathParamService = make(map[EndpointName][]string, 0)
+	}
+	if o.SingleQueryParamService == nil {
+		o.SingleQueryParamService = make(map[EndpointName][]string, 0)
+	}
+	return nil
+}
+
 func (o ClientTestCases) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -69,6 +706,119 @@ func (o *ClientTestCases) UnmarshalYAML(unmarshal func(interface{}) error) error
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the ClientTestCases is equal to other according to the Conjure semantics of its values.
+func (o ClientTestCases) Equal(other ClientTestCases) bool {
+	if len(o.AutoDeserialize) != len(other.AutoDeserialize) {
+		return false
+	}
+	for k, v := range o.AutoDeserialize {
+		otherV, ok := other.AutoDeserialize[k]
+		if !ok {
+			return false
+		}
+		if !v.Equal(otherV) {
+			return false
+		}
+	}
+	if len(o.SingleHeaderService) != len(other.SingleHeaderService) {
+		return false
+	}
+	for k, v := range o.SingleHeaderService {
+		otherV, ok := other.SingleHeaderService[k]
+		if !ok {
+			return false
+		}
+		if len(v) != len(otherV) {
+			return false
+		}
+		for i1 := range v {
+			if v[i1] != otherV[i1] {
+				return false
+			}
+		}
+	}
+	if len(o.SinglePathParamService) != len(other.SinglePathParamService) {
+		return false
+	}
+	for k, v := range o.SinglePathParamService {
+		otherV, ok := other.SinglePathParamService[k]
+		if !ok {
+			return false
+		}
+		if len(v) != len(otherV) {
+			return false
+		}
+		for i1 := range v {
+			if v[i1] != otherV[i1] {
+				return false
+			}
+		}
+	}
+	if len(o.SingleQueryParamService) != len(other.SingleQueryParamService) {
+		return false
+	}
+	for k, v := range o.SingleQueryParamService {
+		otherV, ok := other.SingleQueryParamService[k]
+		if !ok {
+			return false
+		}
+		if len(v) != len(otherV) {
+			return false
+		}
+		for i1 := range v {
+			if v[i1] != otherV[i1] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the ClientTestCases.
+func (o ClientTestCases) Clone() ClientTestCases {
+	out := o
+	if o.AutoDeserialize != nil {
+		out.AutoDeserialize = make(map[EndpointName]PositiveAndNegativeTestCases, len(o.AutoDeserialize))
+		for k, v := range o.AutoDeserialize {
+			out.AutoDeserialize[k] = v.Clone()
+		}
+	}
+	if o.SingleHeaderService != nil {
+		out.SingleHeaderService = make(map[EndpointName][]string, len(o.SingleHeaderService))
+		for k, v := range o.SingleHeaderService {
+			var elem []string
+			if v != nil {
+				elem = make([]string, len(v))
+				copy(elem, v)
+			}
+			out.SingleHeaderService[k] = elem
+		}
+	}
+	if o.SinglePathParamService != nil {
+		out.SinglePathParamService = make(map[EndpointName][]string, len(o.SinglePathParamService))
+		for k, v := range o.SinglePathParamService {
+			var elem []string
+			if v != nil {
+				elem = make([]string, len(v))
+				copy(elem, v)
+			}
+			out.SinglePathParamService[k] = elem
+		}
+	}
+	if o.SingleQueryParamService != nil {
+		out.SingleQueryParamService = make(map[EndpointName][]string, len(o.SingleQueryParamService))
+		for k, v := range o.SingleQueryParamService {
+			var elem []string
+			if v != nil {
+				elem = make([]string, len(v))
+				copy(elem, v)
+			}
+			out.SingleQueryParamService[k] = elem
+		}
+	}
+	return out
+}
+
 type IgnoredClientTestCases struct {
 	AutoDeserialize         map[EndpointName][]string `json:"autoDeserialize"`
 	SingleHeaderService     map[EndpointName][]string `json:"singleHeaderService"`
@@ -76,24 +826,208 @@ type IgnoredClientTestCases struct {
 	SingleQueryParamService map[EndpointName][]string `json:"singleQueryParamService"`
 }
 
-func (o IgnoredClientTestCases) MarshalJSON() ([]byte, error) {
-	if o.AutoDeserialize == nil {
-		o.AutoDeserialize = make(map[EndpointName][]string, 0)
+func (o IgnoredClientTestCases) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"autoDeserialize\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value []string
+		}
+		entries := make([]mapEntry, 0, len(o.AutoDeserialize))
+		for k, v := range o.AutoDeserialize {
+			entries = append(entries, mapEntry{key: string(k), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = append(out, '[')
+			for i1, v1 := range entry.value {
+				if i1 > 0 {
+					out = append(out, ',')
+				}
+				out = appendJSONString(out, v1)
+			}
+			out = append(out, ']')
+		}
 	}
-	if o.SingleHeaderService == nil {
-		o.SingleHeaderService = make(map[EndpointName][]string, 0)
+	out = append(out, '}')
+	out = append(out, ",\"singleHeaderService\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value []string
+		}
+		entries := make([]mapEntry, 0, len(o.SingleHeaderService))
+		for k, v := range o.SingleHeaderService {
+			entries = append(entries, mapEntry{key: string(k), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = append(out, '[')
+			for i1, v1 := range entry.value {
+				if i1 > 0 {
+					out = append(out, ',')
+				}
+				out = appendJSONString(out, v1)
+			}
+			out = append(out, ']')
+		}
 	}
-	if o.SinglePathParamService == nil {
-		o.SinglePathParamService = make(map[EndpointName][]string, 0)
+	out = append(out, '}')
+	out = append(out, ",\"singlePathParamService\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value []string
+		}
+		entries := make([]mapEntry, 0, len(o.SinglePathParamService))
+		for k, v := range o.SinglePathParamService {
+			entries = append(entries, mapEntry{key: string(k), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = append(out, '[')
+			for i1, v1 := range entry.value {
+				if i1 > 0 {
+					out = append(out, ',')
+				}
+				out = appendJSONString(out, v1)
+			}
+			out = append(out, ']')
+		}
 	}
-	if o.SingleQueryParamService == nil {
-		o.SingleQueryParamService = make(map[EndpointName][]string, 0)
+	out = append(out, '}')
+	out = append(out, ",\"singleQueryParamService\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value []string
+		}
+		entries := make([]mapEntry, 0, len(o.SingleQueryParamService))
+		for k, v := range o.SingleQueryParamService {
+			entries = append(entries, mapEntry{key: string(k), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = append(out, '[')
+			for i1, v1 := range entry.value {
+				if i1 > 0 {
+					out = append(out, ',')
+				}
+				out = appendJSONString(out, v1)
+			}
+			out = append(out, ']')
+		}
 	}
-	type IgnoredClientTestCasesAlias IgnoredClientTestCases
-	return safejson.Marshal(IgnoredClientTestCasesAlias(o))
+	out = append(out, '}')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o IgnoredClientTestCases) JSONSize() (int, error) {
+	size := 96
+	size += 2
+	if len(o.AutoDeserialize) > 1 {
+		size += len(o.AutoDeserialize) - 1
+	}
+	for k, v := range o.AutoDeserialize {
+		size += jsonStringSize(string(k)) + 1
+		size += 2
+		if len(v) > 1 {
+			size += len(v) - 1
+		}
+		for _, v1 := range v {
+			size += jsonStringSize(v1)
+		}
+	}
+	size += 2
+	if len(o.SingleHeaderService) > 1 {
+		size += len(o.SingleHeaderService) - 1
+	}
+	for k, v := range o.SingleHeaderService {
+		size += jsonStringSize(string(k)) + 1
+		size += 2
+		if len(v) > 1 {
+			size += len(v) - 1
+		}
+		for _, v1 := range v {
+			size += jsonStringSize(v1)
+		}
+	}
+	size += 2
+	if len(o.SinglePathParamService) > 1 {
+		size += len(o.SinglePathParamService) - 1
+	}
+	for k, v := range o.SinglePathParamService {
+		size += jsonStringSize(string(k)) + 1
+		size += 2
+		if len(v) > 1 {
+			size += len(v) - 1
+		}
+		for _, v1 := range v {
+			size += jsonStringSize(v1)
+		}
+	}
+	size += 2
+	if len(o.SingleQueryParamService) > 1 {
+		size += len(o.SingleQueryParamService) - 1
+	}
+	for k, v := range o.SingleQueryParamService {
+		size += jsonStringSize(string(k)) + 1
+		size += 2
+		if len(v) > 1 {
+			size += len(v) - 1
+		}
+		for _, v1 := range v {
+			size += jsonStringSize(v1)
+		}
+	}
+	return size, nil
+}
+
+func (o IgnoredClientTestCases) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *IgnoredClientTestCases) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v IgnoredClientTestCases
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type IgnoredClientTestCasesAlias IgnoredClientTestCases
 	var rawIgnoredClientTestCases IgnoredClientTestCasesAlias
 	if err := safejson.Unmarshal(data, &rawIgnoredClientTestCases); err != nil {
@@ -115,6 +1049,512 @@ func (o *IgnoredClientTestCases) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *IgnoredClientTestCases) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.AutoDeserialize == nil {
+			o.AutoDeserialize = make(map[EndpointName][]string, 0)
+		}
+		if o.SingleHeaderService == nil {
+			o.SingleHeaderService = make(map[EndpointName][]string, 0)
+		}
+		if o.SinglePathParamService == nil {
+			o.SinglePathParamService = make(map[EndpointName][]string, 0)
+		}
+		if o.SingleQueryParamService == nil {
+			o.SingleQueryParamService = make(map[EndpointName][]string, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenAutoDeserialize, seenSingleHeaderService, seenSinglePathParamService, seenSingleQueryParamService bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "autoDeserialize":
+			if seenAutoDeserialize {
+				ok = false
+				return false
+			}
+			seenAutoDeserialize = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.AutoDeserialize = make(map[EndpointName][]string)
+				ok1 := true
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k EndpointName
+					var k1 string
+					if !unmarshalJSONString(key1, &k1) {
+						ok1 = false
+						return false
+					}
+					k = EndpointName(k1)
+					var v []string
+					if elem.Type != gjson.Null {
+						if !elem.IsArray() {
+							ok1 = false
+							return false
+						}
+						v = make([]string, 0)
+						ok2 := true
+						elem.ForEach(func(_, elem1 gjson.Result) bool {
+							var v1 string
+							if !unmarshalJSONString(elem1, &v1) {
+								ok2 = false
+								return false
+							}
+							v = append(v, v1)
+							return true
+						})
+						if !ok2 {
+							ok1 = false
+							return false
+						}
+					}
+					o.AutoDeserialize[k] = v
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "singleHeaderService":
+			if seenSingleHeaderService {
+				ok = false
+				return false
+			}
+			seenSingleHeaderService = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.SingleHeaderService = make(map[EndpointName][]string)
+				ok3 := true
+				field.ForEach(func(key2, elem2 gjson.Result) bool {
+					var k2 EndpointName
+					var k3 string
+					if !unmarshalJSONString(key2, &k3) {
+						ok3 = false
+						return false
+					}
+					k2 = EndpointName(k3)
+					var v2 []string
+					if elem2.Type != gjson.Null {
+						if !elem2.IsArray() {
+							ok3 = false
+							return false
+						}
+						v2 = make([]string, 0)
+						ok4 := true
+						elem2.ForEach(func(_, elem3 gjson.Result) bool {
+							var v3 string
+							if !unmarshalJSONString(elem3, &v3) {
+								ok4 = false
+								return false
+							}
+							v2 = append(v2, v3)
+							return true
+						})
+						if !ok4 {
+							ok3 = false
+							return false
+						}
+					}
+					o.SingleHeaderService[k2] = v2
+					return true
+				})
+				if !ok3 {
+					ok = false
+					return false
+				}
+			}
+		case "singlePathParamService":
+			if seenSinglePathParamService {
+				ok = false
+				return false
+			}
+			seenSinglePathParamService = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.SinglePathParamService = make(map[EndpointName][]string)
+				ok5 := true
+				field.ForEach(func(key3, elem4 gjson.Result) bool {
+					var k4 EndpointName
+					var k5 string
+					if !unmarshalJSONString(key3, &k5) {
+						ok5 = false
+						return false
+					}
+					k4 = EndpointName(k5)
+					var v4 []string
+					if elem4.Type != gjson.Null {
+						if !elem4.IsArray() {
+							ok5 = false
+							return false
+						}
+						v4 = make([]string, 0)
+						ok6 := true
+						elem4.ForEach(func(_, elem5 gjson.Result) bool {
+							var v5 string
+							if !unmarshalJSONString(elem5, &v5) {
+								ok6 = false
+								return false
+							}
+							v4 = append(v4, v5)
+							return true
+						})
+						if !ok6 {
+							ok5 = false
+							return false
+						}
+					}
+					o.SinglePathParamService[k4] = v4
+					return true
+				})
+				if !ok5 {
+					ok = false
+					return false
+				}
+			}
+		case "singleQueryParamService":
+			if seenSingleQueryParamService {
+				ok = false
+				return false
+			}
+			seenSingleQueryParamService = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.SingleQueryParamService = make(map[EndpointName][]string)
+				ok7 := true
+				field.ForEach(func(key4, elem6 gjson.Result) bool {
+					var k6 EndpointName
+					var k7 string
+					if !unmarshalJSONString(key4, &k7) {
+						ok7 = false
+						return false
+					}
+					k6 = EndpointName(k7)
+					var v6 []string
+					if elem6.Type != gjson.Null {
+						if !elem6.IsArray() {
+							ok7 = false
+							return false
+						}
+						v6 = make([]string, 0)
+						ok8 := true
+						elem6.ForEach(func(_, elem7 gjson.Result) bool {
+							var v7 string
+							if !unmarshalJSONString(elem7, &v7) {
+								ok8 = false
+								return false
+							}
+							v6 = append(v6, v7)
+							return true
+						})
+						if !ok8 {
+							ok7 = false
+							return false
+						}
+					}
+					o.SingleQueryParamService[k6] = v6
+					return true
+				})
+				if !ok7 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "autoDeserialize", "singleHeaderService", "singlePathParamService", "singleQueryParamService") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.AutoDeserialize == nil {
+		o.AutoDeserialize = make(map[EndpointName][]string, 0)
+	}
+	if o.SingleHeaderService == nil {
+		o.SingleHeaderService = make(map[EndpointName][]string, 0)
+	}
+	if o.SinglePathParamService == nil {
+		o.SinglePathParamService = make(map[EndpointName][]string, 0)
+	}
+	if o.SingleQueryParamService == nil {
+		o.SingleQueryParamService = make(map[EndpointName][]string, 0)
+	}
+	return true
+}
+
+func (o *IgnoredClientTestCases) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *IgnoredClientTestCases) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = IgnoredClientTestCases{}
+	var seenAutoDeserialize, seenSingleHeaderService, seenSinglePathParamService, seenSingleQueryParamService bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "autoDeserialize":
+			if seenAutoDeserialize {
+				err = fmt.Errorf("duplicate field \"autoDeserialize\"")
+				return false
+			}
+			seenAutoDeserialize = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"autoDeserialize\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.AutoDeserialize = make(map[EndpointName][]string)
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k EndpointName
+					var k1 string
+					k1 = key1.Str
+					k = EndpointName(k1)
+					if _, ok := o.AutoDeserialize[k]; ok {
+						err = fmt.Errorf("duplicate map key %s", key1.Raw)
+						return false
+					}
+					var v []string
+					if !elem.IsArray() {
+						err = jsonTypeError(elem, "array")
+						return false
+					}
+					v = make([]string, 0)
+					seen := make(map[string]struct{})
+					elem.ForEach(func(_, elem1 gjson.Result) bool {
+						var v1 string
+						v1, err = decodeJSONString(elem1)
+						if err != nil {
+							return false
+						}
+						if _, ok := seen[v1]; ok {
+							err = fmt.Errorf("duplicate set element %s", elem1.Raw)
+							return false
+						}
+						seen[v1] = struct{}{}
+						v = append(v, v1)
+						return true
+					})
+					if err != nil {
+						return false
+					}
+					o.AutoDeserialize[k] = v
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"autoDeserialize\": %w", err)
+					return false
+				}
+			}
+		case "singleHeaderService":
+			if seenSingleHeaderService {
+				err = fmt.Errorf("duplicate field \"singleHeaderService\"")
+				return false
+			}
+			seenSingleHeaderService = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"singleHeaderService\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.SingleHeaderService = make(map[EndpointName][]string)
+				field.ForEach(func(key2, elem2 gjson.Result) bool {
+					var k2 EndpointName
+					var k3 string
+					k3 = key2.Str
+					k2 = EndpointName(k3)
+					if _, ok := o.SingleHeaderService[k2]; ok {
+						err = fmt.Errorf("duplicate map key %s", key2.Raw)
+						return false
+					}
+					var v2 []string
+					if !elem2.IsArray() {
+						err = jsonTypeError(elem2, "array")
+						return false
+					}
+					v2 = make([]string, 0)
+					seen1 := make(map[string]struct{})
+					elem2.ForEach(func(_, elem3 gjson.Result) bool {
+						var v3 string
+						v3, err = decodeJSONString(elem3)
+						if err != nil {
+							return false
+						}
+						if _, ok := seen1[v3]; ok {
+							err = fmt.Errorf("duplicate set element %s", elem3.Raw)
+							return false
+						}
+						seen1[v3] = struct{}{}
+						v2 = append(v2, v3)
+						return true
+					})
+					if err != nil {
+						return false
+					}
+					o.SingleHeaderService[k2] = v2
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"singleHeaderService\": %w", err)
+					return false
+				}
+			}
+		case "singlePathParamService":
+			if seenSinglePathParamService {
+				err = fmt.Errorf("duplicate field \"singlePathParamService\"")
+				return false
+			}
+			seenSinglePathParamService = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"singlePathParamService\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.SinglePathParamService = make(map[EndpointName][]string)
+				field.ForEach(func(key3, elem4 gjson.Result) bool {
+					var k4 EndpointName
+					var k5 string
+					k5 = key3.Str
+					k4 = EndpointName(k5)
+					if _, ok := o.SinglePathParamService[k4]; ok {
+						err = fmt.Errorf("duplicate map key %s", key3.Raw)
+						return false
+					}
+					var v4 []string
+					if !elem4.IsArray() {
+						err = jsonTypeError(elem4, "array")
+						return false
+					}
+					v4 = make([]string, 0)
+					seen2 := make(map[string]struct{})
+					elem4.ForEach(func(_, elem5 gjson.Result) bool {
+						var v5 string
+						v5, err = decodeJSONString(elem5)
+						if err != nil {
+							return false
+						}
+						if _, ok := seen2[v5]; ok {
+							err = fmt.Errorf("duplicate set element %s", elem5.Raw)
+							return false
+						}
+						seen2[v5] = struct{}{}
+						v4 = append(v4, v5)
+						return true
+					})
+					if err != nil {
+						return false
+					}
+					o.SinglePathParamService[k4] = v4
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"singlePathParamService\": %w", err)
+					return false
+				}
+			}
+		case "singleQueryParamService":
+			if seenSingleQueryParamService {
+				err = fmt.Errorf("duplicate field \"singleQueryParamService\"")
+				return false
+			}
+			seenSingleQueryParamService = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"singleQueryParamService\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.SingleQueryParamService = make(map[EndpointName][]string)
+				field.ForEach(func(key4, elem6 gjson.Result) bool {
+					var k6 EndpointName
+					var k7 string
+					k7 = key4.Str
+					k6 = EndpointName(k7)
+					if _, ok := o.SingleQueryParamService[k6]; ok {
+						err = fmt.Errorf("duplicate map key %s", key4.Raw)
+						return false
+					}
+					var v6 []string
+					if !elem6.IsArray() {
+						err = jsonTypeError(elem6, "array")
+						return false
+					}
+					v6 = make([]string, 0)
+					seen3 := make(map[string]struct{})
+					elem6.ForEach(func(_, elem7 gjson.Result) bool {
+						var v7 string
+						v7, err = decodeJSONString(elem7)
+						if err != nil {
+							return false
+						}
+						if _, ok := seen3[v7]; ok {
+							err = fmt.Errorf("duplicate set element %s", elem7.Raw)
+							return false
+						}
+						seen3[v7] = struct{}{}
+						v6 = append(v6, v7)
+						return true
+					})
+					if err != nil {
+						return false
+					}
+					o.SingleQueryParamService[k6] = v6
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"singleQueryParamService\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if o.AutoDeserialize == nil {
+		o.AutoDeserialize = make(map[EndpointName][]string, 0)
+	}
+	if o.SingleHeaderService == nil {
+		o.SingleHeaderService = make(map[EndpointName][]string, 0)
+	}
+	if o.SinglePathParamService == nil {
+		o.SinglePathParamService = make(map[EndpointName][]string, 0)
+	}
+	if o.SingleQueryParamService == nil {
+		o.SingleQueryParamService = make(map[EndpointName][]string, 0)
+	}
+	return nil
+}
+
 func (o IgnoredClientTestCases) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -131,10 +1571,274 @@ func (o *IgnoredClientTestCases) UnmarshalYAML(unmarshal func(interface{}) error
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the IgnoredClientTestCases is equal to other according to the Conjure semantics of its values.
+func (o IgnoredClientTestCases) Equal(other IgnoredClientTestCases) bool {
+	if len(o.AutoDeserialize) != len(other.AutoDeserialize) {
+		return false
+	}
+	for k, v := range o.AutoDeserialize {
+		otherV, ok := other.AutoDeserialize[k]
+		if !ok {
+			return false
+		}
+		if !func() bool {
+			if len(v) != len(otherV) {
+				return false
+			}
+			counts1 := make(map[string]int, len(v))
+			for _, v1 := range v {
+				counts1[v1]++
+			}
+			for _, v1 := range otherV {
+				if counts1[v1] == 0 {
+					return false
+				}
+				counts1[v1]--
+			}
+			return true
+		}() {
+			return false
+		}
+	}
+	if len(o.SingleHeaderService) != len(other.SingleHeaderService) {
+		return false
+	}
+	for k, v := range o.SingleHeaderService {
+		otherV, ok := other.SingleHeaderService[k]
+		if !ok {
+			return false
+		}
+		if !func() bool {
+			if len(v) != len(otherV) {
+				return false
+			}
+			counts1 := make(map[string]int, len(v))
+			for _, v1 := range v {
+				counts1[v1]++
+			}
+			for _, v1 := range otherV {
+				if counts1[v1] == 0 {
+					return false
+				}
+				counts1[v1]--
+			}
+			return true
+		}() {
+			return false
+		}
+	}
+	if len(o.SinglePathParamService) != len(other.SinglePathParamService) {
+		return false
+	}
+	for k, v := range o.SinglePathParamService {
+		otherV, ok := other.SinglePathParamService[k]
+		if !ok {
+			return false
+		}
+		if !func() bool {
+			if len(v) != len(otherV) {
+				return false
+			}
+			counts1 := make(map[string]int, len(v))
+			for _, v1 := range v {
+				counts1[v1]++
+			}
+			for _, v1 := range otherV {
+				if counts1[v1] == 0 {
+					return false
+				}
+				counts1[v1]--
+			}
+			return true
+		}() {
+			return false
+		}
+	}
+	if len(o.SingleQueryParamService) != len(other.SingleQueryParamService) {
+		return false
+	}
+	for k, v := range o.SingleQueryParamService {
+		otherV, ok := other.SingleQueryParamService[k]
+		if !ok {
+			return false
+		}
+		if !func() bool {
+			if len(v) != len(otherV) {
+				return false
+			}
+			counts1 := make(map[string]int, len(v))
+			for _, v1 := range v {
+				counts1[v1]++
+			}
+			for _, v1 := range otherV {
+				if counts1[v1] == 0 {
+					return false
+				}
+				counts1[v1]--
+			}
+			return true
+		}() {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the IgnoredClientTestCases.
+func (o IgnoredClientTestCases) Clone() IgnoredClientTestCases {
+	out := o
+	if o.AutoDeserialize != nil {
+		out.AutoDeserialize = make(map[EndpointName][]string, len(o.AutoDeserialize))
+		for k, v := range o.AutoDeserialize {
+			var elem []string
+			if v != nil {
+				elem = make([]string, len(v))
+				copy(elem, v)
+			}
+			out.AutoDeserialize[k] = elem
+		}
+	}
+	if o.SingleHeaderService != nil {
+		out.SingleHeaderService = make(map[EndpointName][]string, len(o.SingleHeaderService))
+		for k, v := range o.SingleHeaderService {
+			var elem []string
+			if v != nil {
+				elem = make([]string, len(v))
+				copy(elem, v)
+			}
+			out.SingleHeaderService[k] = elem
+		}
+	}
+	if o.SinglePathParamService != nil {
+		out.SinglePathParamService = make(map[EndpointName][]string, len(o.SinglePathParamService))
+		for k, v := range o.SinglePathParamService {
+			var elem []string
+			if v != nil {
+				elem = make([]string, len(v))
+				copy(elem, v)
+			}
+			out.SinglePathParamService[k] = elem
+		}
+	}
+	if o.SingleQueryParamService != nil {
+		out.SingleQueryParamService = make(map[EndpointName][]string, len(o.SingleQueryParamService))
+		for k, v := range o.SingleQueryParamService {
+			var elem []string
+			if v != nil {
+				elem = make([]string, len(v))
+				copy(elem, v)
+			}
+			out.SingleQueryParamService[k] = elem
+		}
+	}
+	return out
+}
+
 type IgnoredTestCases struct {
 	Client IgnoredClientTestCases `json:"client"`
 }
 
+func (o IgnoredTestCases) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"client\":"...)
+	out, err = o.Client.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o IgnoredTestCases) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 11
+	n, err = o.Client.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (o *IgnoredTestCases) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenClient bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "client":
+			if seenClient {
+				ok = false
+				return false
+			}
+			seenClient = true
+			if !o.Client.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "client") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *IgnoredTestCases) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *IgnoredTestCases) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = IgnoredTestCases{}
+	var seenClient bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "client":
+			if seenClient {
+				err = fmt.Errorf("duplicate field \"client\"")
+				return false
+			}
+			seenClient = true
+			err = o.Client.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"client\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenClient {
+		return fmt.Errorf("field \"client\" is required")
+	}
+	return nil
+}
+
 func (o IgnoredTestCases) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -151,23 +1855,80 @@ func (o *IgnoredTestCases) UnmarshalYAML(unmarshal func(interface{}) error) erro
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the IgnoredTestCases is equal to other according to the Conjure semantics of its values.
+func (o IgnoredTestCases) Equal(other IgnoredTestCases) bool {
+	if !o.Client.Equal(other.Client) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the IgnoredTestCases.
+func (o IgnoredTestCases) Clone() IgnoredTestCases {
+	out := o
+	out.Client = o.Client.Clone()
+	return out
+}
+
 type PositiveAndNegativeTestCases struct {
 	Positive []string `json:"positive"`
 	Negative []string `json:"negative"`
 }
 
-func (o PositiveAndNegativeTestCases) MarshalJSON() ([]byte, error) {
-	if o.Positive == nil {
-		o.Positive = make([]string, 0)
+func (o PositiveAndNegativeTestCases) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"positive\":"...)
+	out = append(out, '[')
+	for i, v := range o.Positive {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
 	}
-	if o.Negative == nil {
-		o.Negative = make([]string, 0)
+	out = append(out, ']')
+	out = append(out, ",\"negative\":"...)
+	out = append(out, '[')
+	for i, v := range o.Negative {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
 	}
-	type PositiveAndNegativeTestCasesAlias PositiveAndNegativeTestCases
-	return safejson.Marshal(PositiveAndNegativeTestCasesAlias(o))
+	out = append(out, ']')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o PositiveAndNegativeTestCases) JSONSize() (int, error) {
+	size := 25
+	size += 2
+	if len(o.Positive) > 1 {
+		size += len(o.Positive) - 1
+	}
+	for _, v := range o.Positive {
+		size += jsonStringSize(v)
+	}
+	size += 2
+	if len(o.Negative) > 1 {
+		size += len(o.Negative) - 1
+	}
+	for _, v := range o.Negative {
+		size += jsonStringSize(v)
+	}
+	return size, nil
+}
+
+func (o PositiveAndNegativeTestCases) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *PositiveAndNegativeTestCases) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v PositiveAndNegativeTestCases
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type PositiveAndNegativeTestCasesAlias PositiveAndNegativeTestCases
 	var rawPositiveAndNegativeTestCases PositiveAndNegativeTestCasesAlias
 	if err := safejson.Unmarshal(data, &rawPositiveAndNegativeTestCases); err != nil {
@@ -183,6 +1944,184 @@ func (o *PositiveAndNegativeTestCases) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *PositiveAndNegativeTestCases) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Positive == nil {
+			o.Positive = make([]string, 0)
+		}
+		if o.Negative == nil {
+			o.Negative = make([]string, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenPositive, seenNegative bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "positive":
+			if seenPositive {
+				ok = false
+				return false
+			}
+			seenPositive = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Positive = make([]string, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v string
+					if !unmarshalJSONString(elem, &v) {
+						ok1 = false
+						return false
+					}
+					o.Positive = append(o.Positive, v)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "negative":
+			if seenNegative {
+				ok = false
+				return false
+			}
+			seenNegative = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Negative = make([]string, 0)
+				ok2 := true
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v1 string
+					if !unmarshalJSONString(elem1, &v1) {
+						ok2 = false
+						return false
+					}
+					o.Negative = append(o.Negative, v1)
+					return true
+				})
+				if !ok2 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "positive", "negative") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Positive == nil {
+		o.Positive = make([]string, 0)
+	}
+	if o.Negative == nil {
+		o.Negative = make([]string, 0)
+	}
+	return true
+}
+
+func (o *PositiveAndNegativeTestCases) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *PositiveAndNegativeTestCases) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = PositiveAndNegativeTestCases{}
+	var seenPositive, seenNegative bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "positive":
+			if seenPositive {
+				err = fmt.Errorf("duplicate field \"positive\"")
+				return false
+			}
+			seenPositive = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"positive\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Positive = make([]string, 0)
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v string
+					v, err = decodeJSONString(elem)
+					if err != nil {
+						return false
+					}
+					o.Positive = append(o.Positive, v)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"positive\": %w", err)
+					return false
+				}
+			}
+		case "negative":
+			if seenNegative {
+				err = fmt.Errorf("duplicate field \"negative\"")
+				return false
+			}
+			seenNegative = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"negative\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Negative = make([]string, 0)
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v1 string
+					v1, err = decodeJSONString(elem1)
+					if err != nil {
+						return false
+					}
+					o.Negative = append(o.Negative, v1)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"negative\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if o.Positive == nil {
+		o.Positive = make([]string, 0)
+	}
+	if o.Negative == nil {
+		o.Negative = make([]string, 0)
+	}
+	return nil
+}
+
 func (o PositiveAndNegativeTestCases) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -199,10 +2138,146 @@ func (o *PositiveAndNegativeTestCases) UnmarshalYAML(unmarshal func(interface{})
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the PositiveAndNegativeTestCases is equal to other according to the Conjure semantics of its values.
+func (o PositiveAndNegativeTestCases) Equal(other PositiveAndNegativeTestCases) bool {
+	if len(o.Positive) != len(other.Positive) {
+		return false
+	}
+	for i := range o.Positive {
+		if o.Positive[i] != other.Positive[i] {
+			return false
+		}
+	}
+	if len(o.Negative) != len(other.Negative) {
+		return false
+	}
+	for i := range o.Negative {
+		if o.Negative[i] != other.Negative[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the PositiveAndNegativeTestCases.
+func (o PositiveAndNegativeTestCases) Clone() PositiveAndNegativeTestCases {
+	out := o
+	if o.Positive != nil {
+		out.Positive = make([]string, len(o.Positive))
+		copy(out.Positive, o.Positive)
+	}
+	if o.Negative != nil {
+		out.Negative = make([]string, len(o.Negative))
+		copy(out.Negative, o.Negative)
+	}
+	return out
+}
+
 type TestCases struct {
 	Client ClientTestCases `json:"client"`
 }
 
+func (o TestCases) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"client\":"...)
+	out, err = o.Client.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o TestCases) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 11
+	n, err = o.Client.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (o *TestCases) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenClient bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "client":
+			if seenClient {
+				ok = false
+				return false
+			}
+			seenClient = true
+			if !o.Client.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "client") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *TestCases) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *TestCases) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = TestCases{}
+	var seenClient bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "client":
+			if seenClient {
+				err = fmt.Errorf("duplicate field \"client\"")
+				return false
+			}
+			seenClient = true
+			err = o.Client.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"client\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenClient {
+		return fmt.Errorf("field \"client\" is required")
+	}
+	return nil
+}
+
 func (o TestCases) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -218,3 +2293,18 @@ func (o *TestCases) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	}
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
+
+// Equal returns true if the TestCases is equal to other according to the Conjure semantics of its values.
+func (o TestCases) Equal(other TestCases) bool {
+	if !o.Client.Equal(other.Client) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the TestCases.
+func (o TestCases) Clone() TestCases {
+	out := o
+	out.Client = o.Client.Clone()
+	return out
+}
@@ -3,6 +3,15 @@
 package types
 
 import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
 	"github.com/palantir/pkg/bearertoken"
 	"github.com/palantir/pkg/binary"
 	"github.com/palantir/pkg/boolean"
@@ -12,11 +21,57 @@ import (
 	"github.com/palantir/pkg/safelong"
 	"github.com/palantir/pkg/safeyaml"
 	"github.com/palantir/pkg/uuid"
+	"github.com/tidwall/gjson"
 )
 
 type AliasString string
+
+func (a *AliasString) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *AliasString) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v string
+	v, err = decodeJSONString(value)
+	if err != nil {
+		return err
+	}
+	*a = AliasString(v)
+	return nil
+}
+
+// Equal returns true if the AliasString is equal to other according to the Conjure semantics of its values.
+func (a AliasString) Equal(other AliasString) bool {
+	return string(a) == string(other)
+}
+
+// Clone returns a deep copy of the AliasString.
+func (a AliasString) Clone() AliasString {
+	return a
+}
+
 type BearerTokenAliasExample bearertoken.Token
 
+func (a BearerTokenAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = appendJSONString(out, string(bearertoken.Token(a)))
+	return out, nil
+}
+
+func (a BearerTokenAliasExample) JSONSize() (int, error) {
+	var size int
+	size += jsonStringSize(string(bearertoken.Token(a)))
+	return size, nil
+}
+
+func (a BearerTokenAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
 func (a BearerTokenAliasExample) String() string {
 	return bearertoken.Token(a).String()
 }
@@ -50,8 +105,49 @@ func (a *BearerTokenAliasExample) UnmarshalYAML(unmarshal func(interface{}) erro
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+func (a *BearerTokenAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *BearerTokenAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v bearertoken.Token
+	v, err = decodeJSONBearerToken(value)
+	if err != nil {
+		return err
+	}
+	*a = BearerTokenAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the BearerTokenAliasExample is equal to other according to the Conjure semantics of its values.
+func (a BearerTokenAliasExample) Equal(other BearerTokenAliasExample) bool {
+	return a == other
+}
+
+// Clone returns a deep copy of the BearerTokenAliasExample.
+func (a BearerTokenAliasExample) Clone() BearerTokenAliasExample {
+	return a
+}
+
 type BinaryAliasExample []byte
 
+func (a BinaryAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	return appendJSONBinary(out, a), nil
+}
+
+func (a BinaryAliasExample) JSONSize() (int, error) {
+	return base64.StdEncoding.EncodedLen(len(a)) + 2, nil
+}
+
+func (a BinaryAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
 func (a BinaryAliasExample) String() string {
 	return binary.New(a).String()
 }
@@ -85,9 +181,88 @@ func (a *BinaryAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) er
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+func (a *BinaryAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *BinaryAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []byte
+	v, err = decodeJSONBinary(value)
+	if err != nil {
+		return err
+	}
+	*a = BinaryAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the BinaryAliasExample is equal to other according to the Conjure semantics of its values.
+func (a BinaryAliasExample) Equal(other BinaryAliasExample) bool {
+	return bytes.Equal(a, other)
+}
+
+// Clone returns a deep copy of the BinaryAliasExample.
+func (a BinaryAliasExample) Clone() BinaryAliasExample {
+	var out BinaryAliasExample
+	if a != nil {
+		out = make(BinaryAliasExample, len(a))
+		copy(out, a)
+	}
+	return out
+}
+
 type BooleanAliasExample bool
+
+func (a *BooleanAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *BooleanAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v bool
+	v, err = decodeJSONBool(value)
+	if err != nil {
+		return err
+	}
+	*a = BooleanAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the BooleanAliasExample is equal to other according to the Conjure semantics of its values.
+func (a BooleanAliasExample) Equal(other BooleanAliasExample) bool {
+	return bool(a) == bool(other)
+}
+
+// Clone returns a deep copy of the BooleanAliasExample.
+func (a BooleanAliasExample) Clone() BooleanAliasExample {
+	return a
+}
+
 type DateTimeAliasExample datetime.DateTime
 
+func (a DateTimeAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = appendJSONDateTime(out, datetime.DateTime(a))
+	return out, nil
+}
+
+func (a DateTimeAliasExample) JSONSize() (int, error) {
+	var size int
+	size += jsonDateTimeSize(datetime.DateTime(a))
+	return size, nil
+}
+
+func (a DateTimeAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
 func (a DateTimeAliasExample) String() string {
 	return datetime.DateTime(a).String()
 }
@@ -121,56 +296,62 @@ func (a *DateTimeAliasExample) UnmarshalYAML(unmarshal func(interface{}) error)
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
-type DoubleAliasExample float64
-type IntegerAliasExample int
-type ListAnyAliasExample []interface{}
-type ListBearerTokenAliasExample []bearertoken.Token
-
-func (a ListBearerTokenAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal([]bearertoken.Token(a))
+func (a *DateTimeAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
 }
 
-func (a *ListBearerTokenAliasExample) UnmarshalJSON(data []byte) error {
-	var rawListBearerTokenAliasExample []bearertoken.Token
-	if err := safejson.Unmarshal(data, &rawListBearerTokenAliasExample); err != nil {
+func (a *DateTimeAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v datetime.DateTime
+	v, err = decodeJSONDateTime(value)
+	if err != nil {
 		return err
 	}
-	*a = ListBearerTokenAliasExample(rawListBearerTokenAliasExample)
+	*a = DateTimeAliasExample(v)
 	return nil
 }
 
-func (a ListBearerTokenAliasExample) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(a)
-	if err != nil {
-		return nil, err
-	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+// Equal returns true if the DateTimeAliasExample is equal to other according to the Conjure semantics of its values.
+func (a DateTimeAliasExample) Equal(other DateTimeAliasExample) bool {
+	return time.Time(a).Equal(time.Time(other))
 }
 
-func (a *ListBearerTokenAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
-	if err != nil {
-		return err
-	}
-	return safejson.Unmarshal(jsonBytes, *&a)
+// Clone returns a deep copy of the DateTimeAliasExample.
+func (a DateTimeAliasExample) Clone() DateTimeAliasExample {
+	return a
 }
 
-type ListBinaryAliasExample [][]byte
+type DoubleAliasExample float64
 
-func (a ListBinaryAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal([][]byte(a))
+func (a DoubleAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = appendJSONFloat64(out, float64(a))
+	return out, nil
 }
 
-func (a *ListBinaryAliasExample) UnmarshalJSON(data []byte) error {
-	var rawListBinaryAliasExample [][]byte
-	if err := safejson.Unmarshal(data, &rawListBinaryAliasExample); err != nil {
+func (a DoubleAliasExample) JSONSize() (int, error) {
+	var size int
+	size += jsonFloat64Size(float64(a))
+	return size, nil
+}
+
+func (a DoubleAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *DoubleAliasExample) UnmarshalJSON(data []byte) error {
+	var rawDoubleAliasExample float64
+	if err := safejson.Unmarshal(data, &rawDoubleAliasExample); err != nil {
 		return err
 	}
-	*a = ListBinaryAliasExample(rawListBinaryAliasExample)
+	*a = DoubleAliasExample(rawDoubleAliasExample)
 	return nil
 }
 
-func (a ListBinaryAliasExample) MarshalYAML() (interface{}, error) {
+func (a DoubleAliasExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
 		return nil, err
@@ -178,7 +359,7 @@ func (a ListBinaryAliasExample) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (a *ListBinaryAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (a *DoubleAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -186,88 +367,162 @@ func (a *ListBinaryAliasExample) UnmarshalYAML(unmarshal func(interface{}) error
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
-type ListBooleanAliasExample []bool
-type ListDateTimeAliasExample []datetime.DateTime
-
-func (a ListDateTimeAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal([]datetime.DateTime(a))
+func (a *DoubleAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
 }
 
-func (a *ListDateTimeAliasExample) UnmarshalJSON(data []byte) error {
-	var rawListDateTimeAliasExample []datetime.DateTime
-	if err := safejson.Unmarshal(data, &rawListDateTimeAliasExample); err != nil {
+func (a *DoubleAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v float64
+	v, err = decodeJSONFloat64(value)
+	if err != nil {
 		return err
 	}
-	*a = ListDateTimeAliasExample(rawListDateTimeAliasExample)
+	*a = DoubleAliasExample(v)
 	return nil
 }
 
-func (a ListDateTimeAliasExample) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(a)
+// Equal returns true if the DoubleAliasExample is equal to other according to the Conjure semantics of its values.
+func (a DoubleAliasExample) Equal(other DoubleAliasExample) bool {
+	return (float64(a) == float64(other) || math.IsNaN(float64(a)) && math.IsNaN(float64(other)))
+}
+
+// Clone returns a deep copy of the DoubleAliasExample.
+func (a DoubleAliasExample) Clone() DoubleAliasExample {
+	return a
+}
+
+type IntegerAliasExample int
+
+func (a *IntegerAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	return a.decodeJSONStrict(value)
 }
 
-func (a *ListDateTimeAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+func (a *IntegerAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v int
+	v, err = decodeJSONInt(value)
 	if err != nil {
 		return err
 	}
-	return safejson.Unmarshal(jsonBytes, *&a)
+	*a = IntegerAliasExample(v)
+	return nil
 }
 
-type ListDoubleAliasExample []float64
-type ListIntegerAliasExample []int
-type ListOptionalAnyAliasExample []*interface{}
-type ListRidAliasExample []rid.ResourceIdentifier
+// Equal returns true if the IntegerAliasExample is equal to other according to the Conjure semantics of its values.
+func (a IntegerAliasExample) Equal(other IntegerAliasExample) bool {
+	return a == other
+}
 
-func (a ListRidAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal([]rid.ResourceIdentifier(a))
+// Clone returns a deep copy of the IntegerAliasExample.
+func (a IntegerAliasExample) Clone() IntegerAliasExample {
+	return a
 }
 
-func (a *ListRidAliasExample) UnmarshalJSON(data []byte) error {
-	var rawListRidAliasExample []rid.ResourceIdentifier
-	if err := safejson.Unmarshal(data, &rawListRidAliasExample); err != nil {
+type ListAnyAliasExample []interface{}
+
+func (a *ListAnyAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
 		return err
 	}
-	*a = ListRidAliasExample(rawListRidAliasExample)
-	return nil
+	return a.decodeJSONStrict(value)
 }
 
-func (a ListRidAliasExample) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(a)
+func (a *ListAnyAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []interface{}
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]interface{}, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 interface{}
+		v1, err = decodeJSONAny(elem)
+		if err != nil {
+			return false
+		}
+		v = append(v, v1)
+		return true
+	})
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	*a = ListAnyAliasExample(v)
+	return nil
 }
 
-func (a *ListRidAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
-	if err != nil {
-		return err
+// Equal returns true if the ListAnyAliasExample is equal to other according to the Conjure semantics of its values.
+func (a ListAnyAliasExample) Equal(other ListAnyAliasExample) bool {
+	if len(a) != len(other) {
+		return false
 	}
-	return safejson.Unmarshal(jsonBytes, *&a)
+	for i := range a {
+		if !reflect.DeepEqual(a[i], other[i]) {
+			return false
+		}
+	}
+	return true
 }
 
-type ListSafeLongAliasExample []safelong.SafeLong
+// Clone returns a deep copy of the ListAnyAliasExample.
+func (a ListAnyAliasExample) Clone() ListAnyAliasExample {
+	var out ListAnyAliasExample
+	if a != nil {
+		out = make(ListAnyAliasExample, len(a))
+		copy(out, a)
+	}
+	return out
+}
 
-func (a ListSafeLongAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal([]safelong.SafeLong(a))
+type ListBearerTokenAliasExample []bearertoken.Token
+
+func (a ListBearerTokenAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '[')
+	for i, v := range []bearertoken.Token(a) {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, string(v))
+	}
+	out = append(out, ']')
+	return out, nil
 }
 
-func (a *ListSafeLongAliasExample) UnmarshalJSON(data []byte) error {
-	var rawListSafeLongAliasExample []safelong.SafeLong
-	if err := safejson.Unmarshal(data, &rawListSafeLongAliasExample); err != nil {
+func (a ListBearerTokenAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len([]bearertoken.Token(a)) > 1 {
+		size += len([]bearertoken.Token(a)) - 1
+	}
+	for _, v := range []bearertoken.Token(a) {
+		size += jsonStringSize(string(v))
+	}
+	return size, nil
+}
+
+func (a ListBearerTokenAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *ListBearerTokenAliasExample) UnmarshalJSON(data []byte) error {
+	var rawListBearerTokenAliasExample []bearertoken.Token
+	if err := safejson.Unmarshal(data, &rawListBearerTokenAliasExample); err != nil {
 		return err
 	}
-	*a = ListSafeLongAliasExample(rawListSafeLongAliasExample)
+	*a = ListBearerTokenAliasExample(rawListBearerTokenAliasExample)
 	return nil
 }
 
-func (a ListSafeLongAliasExample) MarshalYAML() (interface{}, error) {
+func (a ListBearerTokenAliasExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
 		return nil, err
@@ -275,7 +530,7 @@ func (a ListSafeLongAliasExample) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (a *ListSafeLongAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (a *ListBearerTokenAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -283,54 +538,108 @@ func (a *ListSafeLongAliasExample) UnmarshalYAML(unmarshal func(interface{}) err
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
-type ListStringAliasExample []string
-type ListUuidAliasExample []uuid.UUID
-
-func (a ListUuidAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal([]uuid.UUID(a))
+func (a *ListBearerTokenAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
 }
 
-func (a *ListUuidAliasExample) UnmarshalJSON(data []byte) error {
-	var rawListUuidAliasExample []uuid.UUID
-	if err := safejson.Unmarshal(data, &rawListUuidAliasExample); err != nil {
+func (a *ListBearerTokenAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []bearertoken.Token
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]bearertoken.Token, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 bearertoken.Token
+		v1, err = decodeJSONBearerToken(elem)
+		if err != nil {
+			return false
+		}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
 		return err
 	}
-	*a = ListUuidAliasExample(rawListUuidAliasExample)
+	*a = ListBearerTokenAliasExample(v)
 	return nil
 }
 
-func (a ListUuidAliasExample) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(a)
-	if err != nil {
-		return nil, err
+// Equal returns true if the ListBearerTokenAliasExample is equal to other according to the Conjure semantics of its values.
+func (a ListBearerTokenAliasExample) Equal(other ListBearerTokenAliasExample) bool {
+	if len(a) != len(other) {
+		return false
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	for i := range a {
+		if a[i] != other[i] {
+			return false
+		}
+	}
+	return true
 }
 
-func (a *ListUuidAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
-	if err != nil {
-		return err
+// Clone returns a deep copy of the ListBearerTokenAliasExample.
+func (a ListBearerTokenAliasExample) Clone() ListBearerTokenAliasExample {
+	var out ListBearerTokenAliasExample
+	if a != nil {
+		out = make(ListBearerTokenAliasExample, len(a))
+		copy(out, a)
 	}
-	return safejson.Unmarshal(jsonBytes, *&a)
+	return out
 }
 
-type MapBearerTokenAliasExample map[bearertoken.Token]bool
+type ListBinaryAliasExample [][]byte
 
-func (a MapBearerTokenAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal(map[bearertoken.Token]bool(a))
+func (a ListBinaryAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '[')
+	for i, v := range [][]byte(a) {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		if v == nil {
+			out = append(out, "null"...)
+		} else {
+			out = appendJSONBinary(out, v)
+		}
+	}
+	out = append(out, ']')
+	return out, nil
 }
 
-func (a *MapBearerTokenAliasExample) UnmarshalJSON(data []byte) error {
-	var rawMapBearerTokenAliasExample map[bearertoken.Token]bool
-	if err := safejson.Unmarshal(data, &rawMapBearerTokenAliasExample); err != nil {
+func (a ListBinaryAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len([][]byte(a)) > 1 {
+		size += len([][]byte(a)) - 1
+	}
+	for _, v := range [][]byte(a) {
+		if v == nil {
+			size += 4
+		} else {
+			size += base64.StdEncoding.EncodedLen(len(v)) + 2
+		}
+	}
+	return size, nil
+}
+
+func (a ListBinaryAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *ListBinaryAliasExample) UnmarshalJSON(data []byte) error {
+	var rawListBinaryAliasExample [][]byte
+	if err := safejson.Unmarshal(data, &rawListBinaryAliasExample); err != nil {
 		return err
 	}
-	*a = MapBearerTokenAliasExample(rawMapBearerTokenAliasExample)
+	*a = ListBinaryAliasExample(rawListBinaryAliasExample)
 	return nil
 }
 
-func (a MapBearerTokenAliasExample) MarshalYAML() (interface{}, error) {
+func (a ListBinaryAliasExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
 		return nil, err
@@ -338,7 +647,7 @@ func (a MapBearerTokenAliasExample) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (a *MapBearerTokenAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (a *ListBinaryAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -346,86 +655,161 @@ func (a *MapBearerTokenAliasExample) UnmarshalYAML(unmarshal func(interface{}) e
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
-type MapBinaryAliasExample map[binary.Binary]bool
-
-func (a MapBinaryAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal(map[binary.Binary]bool(a))
+func (a *ListBinaryAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
 }
 
-func (a *MapBinaryAliasExample) UnmarshalJSON(data []byte) error {
-	var rawMapBinaryAliasExample map[binary.Binary]bool
-	if err := safejson.Unmarshal(data, &rawMapBinaryAliasExample); err != nil {
+func (a *ListBinaryAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v [][]byte
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([][]byte, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 []byte
+		v1, err = decodeJSONBinary(elem)
+		if err != nil {
+			return false
+		}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
 		return err
 	}
-	*a = MapBinaryAliasExample(rawMapBinaryAliasExample)
+	*a = ListBinaryAliasExample(v)
 	return nil
 }
 
-func (a MapBinaryAliasExample) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(a)
-	if err != nil {
-		return nil, err
+// Equal returns true if the ListBinaryAliasExample is equal to other according to the Conjure semantics of its values.
+func (a ListBinaryAliasExample) Equal(other ListBinaryAliasExample) bool {
+	if len(a) != len(other) {
+		return false
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	for i := range a {
+		if !bytes.Equal(a[i], other[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the ListBinaryAliasExample.
+func (a ListBinaryAliasExample) Clone() ListBinaryAliasExample {
+	var out ListBinaryAliasExample
+	if a != nil {
+		out = make(ListBinaryAliasExample, len(a))
+		for i := range a {
+			if a[i] != nil {
+				out[i] = make([]byte, len(a[i]))
+				copy(out[i], a[i])
+			}
+		}
+	}
+	return out
 }
 
-func (a *MapBinaryAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+type ListBooleanAliasExample []bool
+
+func (a *ListBooleanAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
 	if err != nil {
 		return err
 	}
-	return safejson.Unmarshal(jsonBytes, *&a)
+	return a.decodeJSONStrict(value)
 }
 
-type MapBooleanAliasExample map[boolean.Boolean]bool
-type MapDateTimeAliasExample map[datetime.DateTime]bool
-
-func (a MapDateTimeAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal(map[datetime.DateTime]bool(a))
-}
-
-func (a *MapDateTimeAliasExample) UnmarshalJSON(data []byte) error {
-	var rawMapDateTimeAliasExample map[datetime.DateTime]bool
-	if err := safejson.Unmarshal(data, &rawMapDateTimeAliasExample); err != nil {
+func (a *ListBooleanAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []bool
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]bool, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 bool
+		v1, err = decodeJSONBool(elem)
+		if err != nil {
+			return false
+		}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
 		return err
 	}
-	*a = MapDateTimeAliasExample(rawMapDateTimeAliasExample)
+	*a = ListBooleanAliasExample(v)
 	return nil
 }
 
-func (a MapDateTimeAliasExample) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(a)
-	if err != nil {
-		return nil, err
+// Equal returns true if the ListBooleanAliasExample is equal to other according to the Conjure semantics of its values.
+func (a ListBooleanAliasExample) Equal(other ListBooleanAliasExample) bool {
+	if len(a) != len(other) {
+		return false
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	for i := range a {
+		if a[i] != other[i] {
+			return false
+		}
+	}
+	return true
 }
 
-func (a *MapDateTimeAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
-	if err != nil {
-		return err
+// Clone returns a deep copy of the ListBooleanAliasExample.
+func (a ListBooleanAliasExample) Clone() ListBooleanAliasExample {
+	var out ListBooleanAliasExample
+	if a != nil {
+		out = make(ListBooleanAliasExample, len(a))
+		copy(out, a)
 	}
-	return safejson.Unmarshal(jsonBytes, *&a)
+	return out
 }
 
-type MapDoubleAliasExample map[float64]bool
-type MapEnumExampleAlias map[EnumExample]string
+type ListDateTimeAliasExample []datetime.DateTime
 
-func (a MapEnumExampleAlias) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal(map[EnumExample]string(a))
+func (a ListDateTimeAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '[')
+	for i, v := range []datetime.DateTime(a) {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONDateTime(out, v)
+	}
+	out = append(out, ']')
+	return out, nil
 }
 
-func (a *MapEnumExampleAlias) UnmarshalJSON(data []byte) error {
-	var rawMapEnumExampleAlias map[EnumExample]string
-	if err := safejson.Unmarshal(data, &rawMapEnumExampleAlias); err != nil {
+func (a ListDateTimeAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len([]datetime.DateTime(a)) > 1 {
+		size += len([]datetime.DateTime(a)) - 1
+	}
+	for _, v := range []datetime.DateTime(a) {
+		size += jsonDateTimeSize(v)
+	}
+	return size, nil
+}
+
+func (a ListDateTimeAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *ListDateTimeAliasExample) UnmarshalJSON(data []byte) error {
+	var rawListDateTimeAliasExample []datetime.DateTime
+	if err := safejson.Unmarshal(data, &rawListDateTimeAliasExample); err != nil {
 		return err
 	}
-	*a = MapEnumExampleAlias(rawMapEnumExampleAlias)
+	*a = ListDateTimeAliasExample(rawListDateTimeAliasExample)
 	return nil
 }
 
-func (a MapEnumExampleAlias) MarshalYAML() (interface{}, error) {
+func (a ListDateTimeAliasExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
 		return nil, err
@@ -433,7 +817,7 @@ func (a MapEnumExampleAlias) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (a *MapEnumExampleAlias) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (a *ListDateTimeAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -441,54 +825,100 @@ func (a *MapEnumExampleAlias) UnmarshalYAML(unmarshal func(interface{}) error) e
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
-type MapIntegerAliasExample map[int]bool
-type MapRidAliasExample map[rid.ResourceIdentifier]bool
-
-func (a MapRidAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal(map[rid.ResourceIdentifier]bool(a))
+func (a *ListDateTimeAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
 }
 
-func (a *MapRidAliasExample) UnmarshalJSON(data []byte) error {
-	var rawMapRidAliasExample map[rid.ResourceIdentifier]bool
-	if err := safejson.Unmarshal(data, &rawMapRidAliasExample); err != nil {
+func (a *ListDateTimeAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []datetime.DateTime
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]datetime.DateTime, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 datetime.DateTime
+		v1, err = decodeJSONDateTime(elem)
+		if err != nil {
+			return false
+		}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
 		return err
 	}
-	*a = MapRidAliasExample(rawMapRidAliasExample)
+	*a = ListDateTimeAliasExample(v)
 	return nil
 }
 
-func (a MapRidAliasExample) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(a)
-	if err != nil {
-		return nil, err
+// Equal returns true if the ListDateTimeAliasExample is equal to other according to the Conjure semantics of its values.
+func (a ListDateTimeAliasExample) Equal(other ListDateTimeAliasExample) bool {
+	if len(a) != len(other) {
+		return false
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	for i := range a {
+		if !time.Time(a[i]).Equal(time.Time(other[i])) {
+			return false
+		}
+	}
+	return true
 }
 
-func (a *MapRidAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
-	if err != nil {
-		return err
+// Clone returns a deep copy of the ListDateTimeAliasExample.
+func (a ListDateTimeAliasExample) Clone() ListDateTimeAliasExample {
+	var out ListDateTimeAliasExample
+	if a != nil {
+		out = make(ListDateTimeAliasExample, len(a))
+		copy(out, a)
 	}
-	return safejson.Unmarshal(jsonBytes, *&a)
+	return out
 }
 
-type MapSafeLongAliasExample map[safelong.SafeLong]bool
+type ListDoubleAliasExample []float64
 
-func (a MapSafeLongAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal(map[safelong.SafeLong]bool(a))
+func (a ListDoubleAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '[')
+	for i, v := range []float64(a) {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONFloat64(out, v)
+	}
+	out = append(out, ']')
+	return out, nil
 }
 
-func (a *MapSafeLongAliasExample) UnmarshalJSON(data []byte) error {
-	var rawMapSafeLongAliasExample map[safelong.SafeLong]bool
-	if err := safejson.Unmarshal(data, &rawMapSafeLongAliasExample); err != nil {
+func (a ListDoubleAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len([]float64(a)) > 1 {
+		size += len([]float64(a)) - 1
+	}
+	for _, v := range []float64(a) {
+		size += jsonFloat64Size(v)
+	}
+	return size, nil
+}
+
+func (a ListDoubleAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *ListDoubleAliasExample) UnmarshalJSON(data []byte) error {
+	var rawListDoubleAliasExample []float64
+	if err := safejson.Unmarshal(data, &rawListDoubleAliasExample); err != nil {
 		return err
 	}
-	*a = MapSafeLongAliasExample(rawMapSafeLongAliasExample)
+	*a = ListDoubleAliasExample(rawListDoubleAliasExample)
 	return nil
 }
 
-func (a MapSafeLongAliasExample) MarshalYAML() (interface{}, error) {
+func (a ListDoubleAliasExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
 		return nil, err
@@ -496,7 +926,7 @@ func (a MapSafeLongAliasExample) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (a *MapSafeLongAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (a *ListDoubleAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -504,98 +934,236 @@ func (a *MapSafeLongAliasExample) UnmarshalYAML(unmarshal func(interface{}) erro
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
-type MapStringAliasExample map[string]bool
-type MapUuidAliasExample map[uuid.UUID]bool
-
-func (a MapUuidAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal(map[uuid.UUID]bool(a))
+func (a *ListDoubleAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
 }
 
-func (a *MapUuidAliasExample) UnmarshalJSON(data []byte) error {
-	var rawMapUuidAliasExample map[uuid.UUID]bool
-	if err := safejson.Unmarshal(data, &rawMapUuidAliasExample); err != nil {
+func (a *ListDoubleAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []float64
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]float64, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 float64
+		v1, err = decodeJSONFloat64(elem)
+		if err != nil {
+			return false
+		}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
 		return err
 	}
-	*a = MapUuidAliasExample(rawMapUuidAliasExample)
+	*a = ListDoubleAliasExample(v)
 	return nil
 }
 
-func (a MapUuidAliasExample) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(a)
-	if err != nil {
-		return nil, err
+// Equal returns true if the ListDoubleAliasExample is equal to other according to the Conjure semantics of its values.
+func (a ListDoubleAliasExample) Equal(other ListDoubleAliasExample) bool {
+	if len(a) != len(other) {
+		return false
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	for i := range a {
+		if !(a[i] == other[i] || math.IsNaN(a[i]) && math.IsNaN(other[i])) {
+			return false
+		}
+	}
+	return true
 }
 
-func (a *MapUuidAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+// Clone returns a deep copy of the ListDoubleAliasExample.
+func (a ListDoubleAliasExample) Clone() ListDoubleAliasExample {
+	var out ListDoubleAliasExample
+	if a != nil {
+		out = make(ListDoubleAliasExample, len(a))
+		copy(out, a)
+	}
+	return out
+}
+
+type ListIntegerAliasExample []int
+
+func (a *ListIntegerAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
 	if err != nil {
 		return err
 	}
-	return safejson.Unmarshal(jsonBytes, *&a)
+	return a.decodeJSONStrict(value)
 }
 
-type OptionalAnyAliasExample struct {
-	Value *interface{}
+func (a *ListIntegerAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []int
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]int, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 int
+		v1, err = decodeJSONInt(elem)
+		if err != nil {
+			return false
+		}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = ListIntegerAliasExample(v)
+	return nil
 }
 
-func (a OptionalAnyAliasExample) MarshalJSON() ([]byte, error) {
-	if a.Value == nil {
-		return []byte("null"), nil
+// Equal returns true if the ListIntegerAliasExample is equal to other according to the Conjure semantics of its values.
+func (a ListIntegerAliasExample) Equal(other ListIntegerAliasExample) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for i := range a {
+		if a[i] != other[i] {
+			return false
+		}
 	}
-	return safejson.Marshal(a.Value)
+	return true
 }
 
-func (a *OptionalAnyAliasExample) UnmarshalJSON(data []byte) error {
-	if a.Value == nil {
-		a.Value = new(interface{})
+// Clone returns a deep copy of the ListIntegerAliasExample.
+func (a ListIntegerAliasExample) Clone() ListIntegerAliasExample {
+	var out ListIntegerAliasExample
+	if a != nil {
+		out = make(ListIntegerAliasExample, len(a))
+		copy(out, a)
 	}
-	return safejson.Unmarshal(data, a.Value)
+	return out
 }
 
-func (a OptionalAnyAliasExample) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(a)
+type ListOptionalAnyAliasExample []*interface{}
+
+func (a *ListOptionalAnyAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	return a.decodeJSONStrict(value)
 }
 
-func (a *OptionalAnyAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+func (a *ListOptionalAnyAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []*interface{}
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]*interface{}, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 *interface{}
+		if elem.Type != gjson.Null {
+			var v2 interface{}
+			v2, err = decodeJSONAny(elem)
+			if err != nil {
+				return false
+			}
+			v1 = &v2
+		}
+		v = append(v, v1)
+		return true
+	})
 	if err != nil {
 		return err
 	}
-	return safejson.Unmarshal(jsonBytes, *&a)
+	*a = ListOptionalAnyAliasExample(v)
+	return nil
 }
 
-type OptionalBearerTokenAliasExample struct {
-	Value *bearertoken.Token
+// Equal returns true if the ListOptionalAnyAliasExample is equal to other according to the Conjure semantics of its values.
+func (a ListOptionalAnyAliasExample) Equal(other ListOptionalAnyAliasExample) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for i := range a {
+		if (a[i] == nil) != (other[i] == nil) {
+			return false
+		}
+		if a[i] != nil {
+			if !reflect.DeepEqual(*a[i], *other[i]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the ListOptionalAnyAliasExample.
+func (a ListOptionalAnyAliasExample) Clone() ListOptionalAnyAliasExample {
+	var out ListOptionalAnyAliasExample
+	if a != nil {
+		out = make(ListOptionalAnyAliasExample, len(a))
+		for i := range a {
+			if a[i] != nil {
+				v1 := *a[i]
+				out[i] = &v1
+			}
+		}
+	}
+	return out
 }
 
-func (a OptionalBearerTokenAliasExample) MarshalText() ([]byte, error) {
-	if a.Value == nil {
-		return nil, nil
+type ListRidAliasExample []rid.ResourceIdentifier
+
+func (a ListRidAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, '[')
+	for i, v := range []rid.ResourceIdentifier(a) {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = appendJSONText(out, v)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return a.Value.MarshalText()
+	out = append(out, ']')
+	return out, nil
 }
 
-func (a OptionalBearerTokenAliasExample) MarshalJSON() ([]byte, error) {
-	if a.Value == nil {
-		return []byte("null"), nil
+func (a ListRidAliasExample) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	size += 2
+	if len([]rid.ResourceIdentifier(a)) > 1 {
+		size += len([]rid.ResourceIdentifier(a)) - 1
+	}
+	for _, v := range []rid.ResourceIdentifier(a) {
+		n, err = jsonTextSize(v)
+		if err != nil {
+			return 0, err
+		}
+		size += n
 	}
-	return safejson.Marshal(a.Value)
+	return size, nil
 }
 
-func (a *OptionalBearerTokenAliasExample) UnmarshalText(data []byte) error {
-	if a.Value == nil {
-		a.Value = new(bearertoken.Token)
+func (a ListRidAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *ListRidAliasExample) UnmarshalJSON(data []byte) error {
+	var rawListRidAliasExample []rid.ResourceIdentifier
+	if err := safejson.Unmarshal(data, &rawListRidAliasExample); err != nil {
+		return err
 	}
-	return a.Value.UnmarshalText(data)
+	*a = ListRidAliasExample(rawListRidAliasExample)
+	return nil
 }
 
-func (a OptionalBearerTokenAliasExample) MarshalYAML() (interface{}, error) {
+func (a ListRidAliasExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
 		return nil, err
@@ -603,7 +1171,7 @@ func (a OptionalBearerTokenAliasExample) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (a *OptionalBearerTokenAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (a *ListRidAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -611,66 +1179,106 @@ func (a *OptionalBearerTokenAliasExample) UnmarshalYAML(unmarshal func(interface
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
-type OptionalBooleanAliasExample struct {
-	Value *bool
-}
-
-func (a OptionalBooleanAliasExample) MarshalJSON() ([]byte, error) {
-	if a.Value == nil {
-		return []byte("null"), nil
+func (a *ListRidAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
 	}
-	return safejson.Marshal(a.Value)
+	return a.decodeJSONStrict(value)
 }
 
-func (a *OptionalBooleanAliasExample) UnmarshalJSON(data []byte) error {
-	if a.Value == nil {
-		a.Value = new(bool)
+func (a *ListRidAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []rid.ResourceIdentifier
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
 	}
-	return safejson.Unmarshal(data, a.Value)
-}
-
-func (a OptionalBooleanAliasExample) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(a)
+	v = make([]rid.ResourceIdentifier, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 rid.ResourceIdentifier
+		v1, err = decodeJSONRID(elem)
+		if err != nil {
+			return false
+		}
+		v = append(v, v1)
+		return true
+	})
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	*a = ListRidAliasExample(v)
+	return nil
 }
 
-func (a *OptionalBooleanAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
-	if err != nil {
-		return err
+// Equal returns true if the ListRidAliasExample is equal to other according to the Conjure semantics of its values.
+func (a ListRidAliasExample) Equal(other ListRidAliasExample) bool {
+	if len(a) != len(other) {
+		return false
 	}
-	return safejson.Unmarshal(jsonBytes, *&a)
+	for i := range a {
+		if a[i] != other[i] {
+			return false
+		}
+	}
+	return true
 }
 
-type OptionalDateTimeAliasExample struct {
-	Value *datetime.DateTime
+// Clone returns a deep copy of the ListRidAliasExample.
+func (a ListRidAliasExample) Clone() ListRidAliasExample {
+	var out ListRidAliasExample
+	if a != nil {
+		out = make(ListRidAliasExample, len(a))
+		copy(out, a)
+	}
+	return out
 }
 
-func (a OptionalDateTimeAliasExample) MarshalText() ([]byte, error) {
-	if a.Value == nil {
-		return nil, nil
+type ListSafeLongAliasExample []safelong.SafeLong
+
+func (a ListSafeLongAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '[')
+	for i, v := range []safelong.SafeLong(a) {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		if _, err := safelong.NewSafeLong(int64(v)); err != nil {
+			return nil, err
+		}
+		out = strconv.AppendInt(out, int64(v), 10)
 	}
-	return a.Value.MarshalText()
+	out = append(out, ']')
+	return out, nil
 }
 
-func (a OptionalDateTimeAliasExample) MarshalJSON() ([]byte, error) {
-	if a.Value == nil {
-		return []byte("null"), nil
+func (a ListSafeLongAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len([]safelong.SafeLong(a)) > 1 {
+		size += len([]safelong.SafeLong(a)) - 1
 	}
-	return safejson.Marshal(a.Value)
+	for _, v := range []safelong.SafeLong(a) {
+		if _, err := safelong.NewSafeLong(int64(v)); err != nil {
+			return 0, err
+		}
+		size += jsonIntSize(int64(v))
+	}
+	return size, nil
 }
 
-func (a *OptionalDateTimeAliasExample) UnmarshalText(data []byte) error {
-	if a.Value == nil {
-		a.Value = new(datetime.DateTime)
+func (a ListSafeLongAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *ListSafeLongAliasExample) UnmarshalJSON(data []byte) error {
+	var rawListSafeLongAliasExample []safelong.SafeLong
+	if err := safejson.Unmarshal(data, &rawListSafeLongAliasExample); err != nil {
+		return err
 	}
-	return a.Value.UnmarshalText(data)
+	*a = ListSafeLongAliasExample(rawListSafeLongAliasExample)
+	return nil
 }
 
-func (a OptionalDateTimeAliasExample) MarshalYAML() (interface{}, error) {
+func (a ListSafeLongAliasExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
 		return nil, err
@@ -678,7 +1286,7 @@ func (a OptionalDateTimeAliasExample) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (a *OptionalDateTimeAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (a *ListSafeLongAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -686,25 +1294,2745 @@ func (a *OptionalDateTimeAliasExample) UnmarshalYAML(unmarshal func(interface{})
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
-type OptionalDoubleAliasExample struct {
-	Value *float64
+func (a *ListSafeLongAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
 }
 
-func (a OptionalDoubleAliasExample) MarshalJSON() ([]byte, error) {
-	if a.Value == nil {
-		return []byte("null"), nil
+func (a *ListSafeLongAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []safelong.SafeLong
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]safelong.SafeLong, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 safelong.SafeLong
+		v1, err = decodeJSONSafeLong(elem)
+		if err != nil {
+			return false
+		}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
+		return err
 	}
-	return safejson.Marshal(a.Value)
+	*a = ListSafeLongAliasExample(v)
+	return nil
 }
 
-func (a *OptionalDoubleAliasExample) UnmarshalJSON(data []byte) error {
-	if a.Value == nil {
-		a.Value = new(float64)
+// Equal returns true if the ListSafeLongAliasExample is equal to other according to the Conjure semantics of its values.
+func (a ListSafeLongAliasExample) Equal(other ListSafeLongAliasExample) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for i := range a {
+		if a[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the ListSafeLongAliasExample.
+func (a ListSafeLongAliasExample) Clone() ListSafeLongAliasExample {
+	var out ListSafeLongAliasExample
+	if a != nil {
+		out = make(ListSafeLongAliasExample, len(a))
+		copy(out, a)
+	}
+	return out
+}
+
+type ListStringAliasExample []string
+
+func (a *ListStringAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *ListStringAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []string
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]string, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 string
+		v1, err = decodeJSONString(elem)
+		if err != nil {
+			return false
+		}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = ListStringAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the ListStringAliasExample is equal to other according to the Conjure semantics of its values.
+func (a ListStringAliasExample) Equal(other ListStringAliasExample) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for i := range a {
+		if a[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the ListStringAliasExample.
+func (a ListStringAliasExample) Clone() ListStringAliasExample {
+	var out ListStringAliasExample
+	if a != nil {
+		out = make(ListStringAliasExample, len(a))
+		copy(out, a)
+	}
+	return out
+}
+
+type ListUuidAliasExample []uuid.UUID
+
+func (a ListUuidAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '[')
+	for i, v := range []uuid.UUID(a) {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONUUID(out, v)
+	}
+	out = append(out, ']')
+	return out, nil
+}
+
+func (a ListUuidAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len([]uuid.UUID(a)) > 0 {
+		size += len([]uuid.UUID(a))*39 - 1
+	}
+	return size, nil
+}
+
+func (a ListUuidAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *ListUuidAliasExample) UnmarshalJSON(data []byte) error {
+	var rawListUuidAliasExample []uuid.UUID
+	if err := safejson.Unmarshal(data, &rawListUuidAliasExample); err != nil {
+		return err
+	}
+	*a = ListUuidAliasExample(rawListUuidAliasExample)
+	return nil
+}
+
+func (a ListUuidAliasExample) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *ListUuidAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+func (a *ListUuidAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *ListUuidAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []uuid.UUID
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]uuid.UUID, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 uuid.UUID
+		v1, err = decodeJSONUUID(elem)
+		if err != nil {
+			return false
+		}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = ListUuidAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the ListUuidAliasExample is equal to other according to the Conjure semantics of its values.
+func (a ListUuidAliasExample) Equal(other ListUuidAliasExample) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for i := range a {
+		if a[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the ListUuidAliasExample.
+func (a ListUuidAliasExample) Clone() ListUuidAliasExample {
+	var out ListUuidAliasExample
+	if a != nil {
+		out = make(ListUuidAliasExample, len(a))
+		copy(out, a)
+	}
+	return out
+}
+
+type MapBearerTokenAliasExample map[bearertoken.Token]bool
+
+func (a MapBearerTokenAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value bool
+		}
+		entries := make([]mapEntry, 0, len(map[bearertoken.Token]bool(a)))
+		for k, v := range map[bearertoken.Token]bool(a) {
+			entries = append(entries, mapEntry{key: string(k), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = strconv.AppendBool(out, entry.value)
+		}
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (a MapBearerTokenAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len(map[bearertoken.Token]bool(a)) > 1 {
+		size += len(map[bearertoken.Token]bool(a)) - 1
+	}
+	for k, v := range map[bearertoken.Token]bool(a) {
+		size += jsonStringSize(string(k)) + 1
+		if v {
+			size += 4
+		} else {
+			size += 5
+		}
+	}
+	return size, nil
+}
+
+func (a MapBearerTokenAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *MapBearerTokenAliasExample) UnmarshalJSON(data []byte) error {
+	var rawMapBearerTokenAliasExample map[bearertoken.Token]bool
+	if err := safejson.Unmarshal(data, &rawMapBearerTokenAliasExample); err != nil {
+		return err
+	}
+	*a = MapBearerTokenAliasExample(rawMapBearerTokenAliasExample)
+	return nil
+}
+
+func (a MapBearerTokenAliasExample) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *MapBearerTokenAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+func (a *MapBearerTokenAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *MapBearerTokenAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v map[bearertoken.Token]bool
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	v = make(map[bearertoken.Token]bool)
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k bearertoken.Token
+		k, err = decodeJSONBearerToken(key)
+		if err != nil {
+			return false
+		}
+		if _, ok := v[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v1 bool
+		v1, err = decodeJSONBool(elem)
+		if err != nil {
+			return false
+		}
+		v[k] = v1
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = MapBearerTokenAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the MapBearerTokenAliasExample is equal to other according to the Conjure semantics of its values.
+func (a MapBearerTokenAliasExample) Equal(other MapBearerTokenAliasExample) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for k, v := range a {
+		otherV, ok := other[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the MapBearerTokenAliasExample.
+func (a MapBearerTokenAliasExample) Clone() MapBearerTokenAliasExample {
+	var out MapBearerTokenAliasExample
+	if a != nil {
+		out = make(MapBearerTokenAliasExample, len(a))
+		for k, v := range a {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+type MapBinaryAliasExample map[binary.Binary]bool
+
+func (a MapBinaryAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value bool
+		}
+		entries := make([]mapEntry, 0, len(map[binary.Binary]bool(a)))
+		for k, v := range map[binary.Binary]bool(a) {
+			entries = append(entries, mapEntry{key: string(k), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = strconv.AppendBool(out, entry.value)
+		}
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (a MapBinaryAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len(map[binary.Binary]bool(a)) > 1 {
+		size += len(map[binary.Binary]bool(a)) - 1
+	}
+	for k, v := range map[binary.Binary]bool(a) {
+		size += jsonStringSize(string(k)) + 1
+		if v {
+			size += 4
+		} else {
+			size += 5
+		}
+	}
+	return size, nil
+}
+
+func (a MapBinaryAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *MapBinaryAliasExample) UnmarshalJSON(data []byte) error {
+	var rawMapBinaryAliasExample map[binary.Binary]bool
+	if err := safejson.Unmarshal(data, &rawMapBinaryAliasExample); err != nil {
+		return err
+	}
+	*a = MapBinaryAliasExample(rawMapBinaryAliasExample)
+	return nil
+}
+
+func (a MapBinaryAliasExample) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *MapBinaryAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+func (a *MapBinaryAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *MapBinaryAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v map[binary.Binary]bool
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	v = make(map[binary.Binary]bool)
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k binary.Binary
+		k, err = decodeJSONBinaryKey(key)
+		if err != nil {
+			return false
+		}
+		if _, ok := v[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v1 bool
+		v1, err = decodeJSONBool(elem)
+		if err != nil {
+			return false
+		}
+		v[k] = v1
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = MapBinaryAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the MapBinaryAliasExample is equal to other according to the Conjure semantics of its values.
+func (a MapBinaryAliasExample) Equal(other MapBinaryAliasExample) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for k, v := range a {
+		otherV, ok := other[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the MapBinaryAliasExample.
+func (a MapBinaryAliasExample) Clone() MapBinaryAliasExample {
+	var out MapBinaryAliasExample
+	if a != nil {
+		out = make(MapBinaryAliasExample, len(a))
+		for k, v := range a {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+type MapBooleanAliasExample map[boolean.Boolean]bool
+
+func (a *MapBooleanAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *MapBooleanAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v map[boolean.Boolean]bool
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	v = make(map[boolean.Boolean]bool)
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k boolean.Boolean
+		k, err = decodeJSONBooleanKey(key)
+		if err != nil {
+			return false
+		}
+		if _, ok := v[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v1 bool
+		v1, err = decodeJSONBool(elem)
+		if err != nil {
+			return false
+		}
+		v[k] = v1
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = MapBooleanAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the MapBooleanAliasExample is equal to other according to the Conjure semantics of its values.
+func (a MapBooleanAliasExample) Equal(other MapBooleanAliasExample) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for k, v := range a {
+		otherV, ok := other[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the MapBooleanAliasExample.
+func (a MapBooleanAliasExample) Clone() MapBooleanAliasExample {
+	var out MapBooleanAliasExample
+	if a != nil {
+		out = make(MapBooleanAliasExample, len(a))
+		for k, v := range a {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+type MapDateTimeAliasExample map[datetime.DateTime]bool
+
+func (a MapDateTimeAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value bool
+		}
+		entries := make([]mapEntry, 0, len(map[datetime.DateTime]bool(a)))
+		for k, v := range map[datetime.DateTime]bool(a) {
+			entries = append(entries, mapEntry{key: k.String(), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = strconv.AppendBool(out, entry.value)
+		}
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (a MapDateTimeAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len(map[datetime.DateTime]bool(a)) > 1 {
+		size += len(map[datetime.DateTime]bool(a)) - 1
+	}
+	for k, v := range map[datetime.DateTime]bool(a) {
+		size += jsonStringSize(k.String()) + 1
+		if v {
+			size += 4
+		} else {
+			size += 5
+		}
+	}
+	return size, nil
+}
+
+func (a MapDateTimeAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *MapDateTimeAliasExample) UnmarshalJSON(data []byte) error {
+	var rawMapDateTimeAliasExample map[datetime.DateTime]bool
+	if err := safejson.Unmarshal(data, &rawMapDateTimeAliasExample); err != nil {
+		return err
+	}
+	*a = MapDateTimeAliasExample(rawMapDateTimeAliasExample)
+	return nil
+}
+
+func (a MapDateTimeAliasExample) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *MapDateTimeAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+func (a *MapDateTimeAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *MapDateTimeAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v map[datetime.DateTime]bool
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	v = make(map[datetime.DateTime]bool)
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k datetime.DateTime
+		k, err = decodeJSONDateTime(key)
+		if err != nil {
+			return false
+		}
+		if _, ok := v[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v1 bool
+		v1, err = decodeJSONBool(elem)
+		if err != nil {
+			return false
+		}
+		v[k] = v1
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = MapDateTimeAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the MapDateTimeAliasExample is equal to other according to the Conjure semantics of its values.
+func (a MapDateTimeAliasExample) Equal(other MapDateTimeAliasExample) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for k, v := range a {
+		otherV, ok := other[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the MapDateTimeAliasExample.
+func (a MapDateTimeAliasExample) Clone() MapDateTimeAliasExample {
+	var out MapDateTimeAliasExample
+	if a != nil {
+		out = make(MapDateTimeAliasExample, len(a))
+		for k, v := range a {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+type MapDoubleAliasExample map[float64]bool
+
+func (a MapDoubleAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value bool
+		}
+		entries := make([]mapEntry, 0, len(map[float64]bool(a)))
+		for k, v := range map[float64]bool(a) {
+			entries = append(entries, mapEntry{key: jsonFloat64Key(float64(k)), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = strconv.AppendBool(out, entry.value)
+		}
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (a MapDoubleAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len(map[float64]bool(a)) > 1 {
+		size += len(map[float64]bool(a)) - 1
+	}
+	for k, v := range map[float64]bool(a) {
+		size += jsonStringSize(jsonFloat64Key(float64(k))) + 1
+		if v {
+			size += 4
+		} else {
+			size += 5
+		}
+	}
+	return size, nil
+}
+
+func (a MapDoubleAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *MapDoubleAliasExample) UnmarshalJSON(data []byte) error {
+	var rawMapDoubleAliasExample map[float64]bool
+	if err := safejson.Unmarshal(data, &rawMapDoubleAliasExample); err != nil {
+		return err
+	}
+	*a = MapDoubleAliasExample(rawMapDoubleAliasExample)
+	return nil
+}
+
+func (a MapDoubleAliasExample) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *MapDoubleAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+func (a *MapDoubleAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *MapDoubleAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v map[float64]bool
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	v = make(map[float64]bool)
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k float64
+		k, err = decodeJSONFloat64(jsonNumberKey(key))
+		if err != nil {
+			return false
+		}
+		if _, ok := v[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v1 bool
+		v1, err = decodeJSONBool(elem)
+		if err != nil {
+			return false
+		}
+		v[k] = v1
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = MapDoubleAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the MapDoubleAliasExample is equal to other according to the Conjure semantics of its values.
+func (a MapDoubleAliasExample) Equal(other MapDoubleAliasExample) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for k, v := range a {
+		otherV, ok := other[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the MapDoubleAliasExample.
+func (a MapDoubleAliasExample) Clone() MapDoubleAliasExample {
+	var out MapDoubleAliasExample
+	if a != nil {
+		out = make(MapDoubleAliasExample, len(a))
+		for k, v := range a {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+type MapEnumExampleAlias map[EnumExample]string
+
+func (a MapEnumExampleAlias) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value string
+		}
+		entries := make([]mapEntry, 0, len(map[EnumExample]string(a)))
+		for k, v := range map[EnumExample]string(a) {
+			entries = append(entries, mapEntry{key: k.String(), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = appendJSONString(out, entry.value)
+		}
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (a MapEnumExampleAlias) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len(map[EnumExample]string(a)) > 1 {
+		size += len(map[EnumExample]string(a)) - 1
+	}
+	for k, v := range map[EnumExample]string(a) {
+		size += jsonStringSize(k.String()) + 1
+		size += jsonStringSize(v)
+	}
+	return size, nil
+}
+
+func (a MapEnumExampleAlias) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *MapEnumExampleAlias) UnmarshalJSON(data []byte) error {
+	var rawMapEnumExampleAlias map[EnumExample]string
+	if err := safejson.Unmarshal(data, &rawMapEnumExampleAlias); err != nil {
+		return err
+	}
+	*a = MapEnumExampleAlias(rawMapEnumExampleAlias)
+	return nil
+}
+
+func (a MapEnumExampleAlias) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *MapEnumExampleAlias) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+func (a *MapEnumExampleAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *MapEnumExampleAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v map[EnumExample]string
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	v = make(map[EnumExample]string)
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k EnumExample
+		err = k.decodeJSONStrict(key)
+		if err != nil {
+			return false
+		}
+		if _, ok := v[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v1 string
+		v1, err = decodeJSONString(elem)
+		if err != nil {
+			return false
+		}
+		v[k] = v1
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = MapEnumExampleAlias(v)
+	return nil
+}
+
+// Equal returns true if the MapEnumExampleAlias is equal to other according to the Conjure semantics of its values.
+func (a MapEnumExampleAlias) Equal(other MapEnumExampleAlias) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for k, v := range a {
+		otherV, ok := other[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the MapEnumExampleAlias.
+func (a MapEnumExampleAlias) Clone() MapEnumExampleAlias {
+	var out MapEnumExampleAlias
+	if a != nil {
+		out = make(MapEnumExampleAlias, len(a))
+		for k, v := range a {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+type MapIntegerAliasExample map[int]bool
+
+func (a *MapIntegerAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *MapIntegerAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v map[int]bool
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	v = make(map[int]bool)
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k int
+		k, err = decodeJSONInt(jsonNumberKey(key))
+		if err != nil {
+			return false
+		}
+		if _, ok := v[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v1 bool
+		v1, err = decodeJSONBool(elem)
+		if err != nil {
+			return false
+		}
+		v[k] = v1
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = MapIntegerAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the MapIntegerAliasExample is equal to other according to the Conjure semantics of its values.
+func (a MapIntegerAliasExample) Equal(other MapIntegerAliasExample) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for k, v := range a {
+		otherV, ok := other[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the MapIntegerAliasExample.
+func (a MapIntegerAliasExample) Clone() MapIntegerAliasExample {
+	var out MapIntegerAliasExample
+	if a != nil {
+		out = make(MapIntegerAliasExample, len(a))
+		for k, v := range a {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+type MapRidAliasExample map[rid.ResourceIdentifier]bool
+
+func (a MapRidAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value bool
+		}
+		entries := make([]mapEntry, 0, len(map[rid.ResourceIdentifier]bool(a)))
+		for k, v := range map[rid.ResourceIdentifier]bool(a) {
+			keyText, err := k.MarshalText()
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, mapEntry{key: string(keyText), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = strconv.AppendBool(out, entry.value)
+		}
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (a MapRidAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len(map[rid.ResourceIdentifier]bool(a)) > 1 {
+		size += len(map[rid.ResourceIdentifier]bool(a)) - 1
+	}
+	for k, v := range map[rid.ResourceIdentifier]bool(a) {
+		keyText, err := k.MarshalText()
+		if err != nil {
+			return 0, err
+		}
+		size += jsonStringSize(string(keyText)) + 1
+		if v {
+			size += 4
+		} else {
+			size += 5
+		}
+	}
+	return size, nil
+}
+
+func (a MapRidAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *MapRidAliasExample) UnmarshalJSON(data []byte) error {
+	var rawMapRidAliasExample map[rid.ResourceIdentifier]bool
+	if err := safejson.Unmarshal(data, &rawMapRidAliasExample); err != nil {
+		return err
+	}
+	*a = MapRidAliasExample(rawMapRidAliasExample)
+	return nil
+}
+
+func (a MapRidAliasExample) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *MapRidAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+func (a *MapRidAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *MapRidAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v map[rid.ResourceIdentifier]bool
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	v = make(map[rid.ResourceIdentifier]bool)
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k rid.ResourceIdentifier
+		k, err = decodeJSONRID(key)
+		if err != nil {
+			return false
+		}
+		if _, ok := v[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v1 bool
+		v1, err = decodeJSONBool(elem)
+		if err != nil {
+			return false
+		}
+		v[k] = v1
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = MapRidAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the MapRidAliasExample is equal to other according to the Conjure semantics of its values.
+func (a MapRidAliasExample) Equal(other MapRidAliasExample) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for k, v := range a {
+		otherV, ok := other[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the MapRidAliasExample.
+func (a MapRidAliasExample) Clone() MapRidAliasExample {
+	var out MapRidAliasExample
+	if a != nil {
+		out = make(MapRidAliasExample, len(a))
+		for k, v := range a {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+type MapSafeLongAliasExample map[safelong.SafeLong]bool
+
+func (a MapSafeLongAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value bool
+		}
+		entries := make([]mapEntry, 0, len(map[safelong.SafeLong]bool(a)))
+		for k, v := range map[safelong.SafeLong]bool(a) {
+			entries = append(entries, mapEntry{key: strconv.FormatInt(int64(k), 10), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = strconv.AppendBool(out, entry.value)
+		}
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (a MapSafeLongAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len(map[safelong.SafeLong]bool(a)) > 1 {
+		size += len(map[safelong.SafeLong]bool(a)) - 1
+	}
+	for k, v := range map[safelong.SafeLong]bool(a) {
+		size += jsonStringSize(strconv.FormatInt(int64(k), 10)) + 1
+		if v {
+			size += 4
+		} else {
+			size += 5
+		}
+	}
+	return size, nil
+}
+
+func (a MapSafeLongAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *MapSafeLongAliasExample) UnmarshalJSON(data []byte) error {
+	var rawMapSafeLongAliasExample map[safelong.SafeLong]bool
+	if err := safejson.Unmarshal(data, &rawMapSafeLongAliasExample); err != nil {
+		return err
+	}
+	*a = MapSafeLongAliasExample(rawMapSafeLongAliasExample)
+	return nil
+}
+
+func (a MapSafeLongAliasExample) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *MapSafeLongAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+func (a *MapSafeLongAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *MapSafeLongAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v map[safelong.SafeLong]bool
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	v = make(map[safelong.SafeLong]bool)
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k safelong.SafeLong
+		k, err = decodeJSONSafeLong(jsonNumberKey(key))
+		if err != nil {
+			return false
+		}
+		if _, ok := v[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v1 bool
+		v1, err = decodeJSONBool(elem)
+		if err != nil {
+			return false
+		}
+		v[k] = v1
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = MapSafeLongAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the MapSafeLongAliasExample is equal to other according to the Conjure semantics of its values.
+func (a MapSafeLongAliasExample) Equal(other MapSafeLongAliasExample) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for k, v := range a {
+		otherV, ok := other[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the MapSafeLongAliasExample.
+func (a MapSafeLongAliasExample) Clone() MapSafeLongAliasExample {
+	var out MapSafeLongAliasExample
+	if a != nil {
+		out = make(MapSafeLongAliasExample, len(a))
+		for k, v := range a {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+type MapStringAliasExample map[string]bool
+
+func (a *MapStringAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *MapStringAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v map[string]bool
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	v = make(map[string]bool)
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k string
+		k = key.Str
+		if _, ok := v[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v1 bool
+		v1, err = decodeJSONBool(elem)
+		if err != nil {
+			return false
+		}
+		v[k] = v1
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = MapStringAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the MapStringAliasExample is equal to other according to the Conjure semantics of its values.
+func (a MapStringAliasExample) Equal(other MapStringAliasExample) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for k, v := range a {
+		otherV, ok := other[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the MapStringAliasExample.
+func (a MapStringAliasExample) Clone() MapStringAliasExample {
+	var out MapStringAliasExample
+	if a != nil {
+		out = make(MapStringAliasExample, len(a))
+		for k, v := range a {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+type MapUuidAliasExample map[uuid.UUID]bool
+
+func (a MapUuidAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value bool
+		}
+		entries := make([]mapEntry, 0, len(map[uuid.UUID]bool(a)))
+		for k, v := range map[uuid.UUID]bool(a) {
+			entries = append(entries, mapEntry{key: k.String(), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = strconv.AppendBool(out, entry.value)
+		}
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (a MapUuidAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len(map[uuid.UUID]bool(a)) > 1 {
+		size += len(map[uuid.UUID]bool(a)) - 1
+	}
+	size += len(map[uuid.UUID]bool(a)) * 39
+	for _, v := range map[uuid.UUID]bool(a) {
+		if v {
+			size += 4
+		} else {
+			size += 5
+		}
+	}
+	return size, nil
+}
+
+func (a MapUuidAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *MapUuidAliasExample) UnmarshalJSON(data []byte) error {
+	var rawMapUuidAliasExample map[uuid.UUID]bool
+	if err := safejson.Unmarshal(data, &rawMapUuidAliasExample); err != nil {
+		return err
+	}
+	*a = MapUuidAliasExample(rawMapUuidAliasExample)
+	return nil
+}
+
+func (a MapUuidAliasExample) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *MapUuidAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+func (a *MapUuidAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *MapUuidAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v map[uuid.UUID]bool
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	v = make(map[uuid.UUID]bool)
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k uuid.UUID
+		k, err = decodeJSONUUID(key)
+		if err != nil {
+			return false
+		}
+		if _, ok := v[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v1 bool
+		v1, err = decodeJSONBool(elem)
+		if err != nil {
+			return false
+		}
+		v[k] = v1
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = MapUuidAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the MapUuidAliasExample is equal to other according to the Conjure semantics of its values.
+func (a MapUuidAliasExample) Equal(other MapUuidAliasExample) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for k, v := range a {
+		otherV, ok := other[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the MapUuidAliasExample.
+func (a MapUuidAliasExample) Clone() MapUuidAliasExample {
+	var out MapUuidAliasExample
+	if a != nil {
+		out = make(MapUuidAliasExample, len(a))
+		for k, v := range a {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+type OptionalAnyAliasExample struct {
+	Value *interface{}
+}
+
+func (a OptionalAnyAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	if a.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out, err = appendJSONMarshal(out, *a.Value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func (a OptionalAnyAliasExample) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	if a.Value == nil {
+		size += 4
+	} else {
+		n, err = jsonMarshalSize(*a.Value)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (a OptionalAnyAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *OptionalAnyAliasExample) UnmarshalJSON(data []byte) error {
+	if a.Value == nil {
+		a.Value = new(interface{})
+	}
+	return safejson.Unmarshal(data, a.Value)
+}
+
+func (a *OptionalAnyAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *OptionalAnyAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *interface{}
+	if value.Type != gjson.Null {
+		var v1 interface{}
+		v1, err = decodeJSONAny(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = OptionalAnyAliasExample{Value: v}
+	return nil
+}
+
+func (a OptionalAnyAliasExample) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *OptionalAnyAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+// Equal returns true if the OptionalAnyAliasExample is equal to other according to the Conjure semantics of its values.
+func (a OptionalAnyAliasExample) Equal(other OptionalAnyAliasExample) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if !reflect.DeepEqual(*a.Value, *other.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalAnyAliasExample.
+func (a OptionalAnyAliasExample) Clone() OptionalAnyAliasExample {
+	out := a
+	if a.Value != nil {
+		v := *a.Value
+		out.Value = &v
+	}
+	return out
+}
+
+type OptionalBearerTokenAliasExample struct {
+	Value *bearertoken.Token
+}
+
+func (a OptionalBearerTokenAliasExample) MarshalText() ([]byte, error) {
+	if a.Value == nil {
+		return nil, nil
+	}
+	return a.Value.MarshalText()
+}
+
+func (a OptionalBearerTokenAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	if a.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, string(*a.Value))
+	}
+	return out, nil
+}
+
+func (a OptionalBearerTokenAliasExample) JSONSize() (int, error) {
+	var size int
+	if a.Value == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(string(*a.Value))
+	}
+	return size, nil
+}
+
+func (a OptionalBearerTokenAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *OptionalBearerTokenAliasExample) UnmarshalText(data []byte) error {
+	if a.Value == nil {
+		a.Value = new(bearertoken.Token)
+	}
+	return a.Value.UnmarshalText(data)
+}
+
+func (a *OptionalBearerTokenAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *OptionalBearerTokenAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *bearertoken.Token
+	if value.Type != gjson.Null {
+		var v1 bearertoken.Token
+		v1, err = decodeJSONBearerToken(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = OptionalBearerTokenAliasExample{Value: v}
+	return nil
+}
+
+func (a OptionalBearerTokenAliasExample) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *OptionalBearerTokenAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+// Equal returns true if the OptionalBearerTokenAliasExample is equal to other according to the Conjure semantics of its values.
+func (a OptionalBearerTokenAliasExample) Equal(other OptionalBearerTokenAliasExample) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if *a.Value != *other.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalBearerTokenAliasExample.
+func (a OptionalBearerTokenAliasExample) Clone() OptionalBearerTokenAliasExample {
+	out := a
+	if a.Value != nil {
+		v := *a.Value
+		out.Value = &v
+	}
+	return out
+}
+
+type OptionalBooleanAliasExample struct {
+	Value *bool
+}
+
+func (a OptionalBooleanAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	if a.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out = strconv.AppendBool(out, *a.Value)
+	}
+	return out, nil
+}
+
+func (a OptionalBooleanAliasExample) JSONSize() (int, error) {
+	var size int
+	if a.Value == nil {
+		size += 4
+	} else {
+		if *a.Value {
+			size += 4
+		} else {
+			size += 5
+		}
+	}
+	return size, nil
+}
+
+func (a OptionalBooleanAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *OptionalBooleanAliasExample) UnmarshalJSON(data []byte) error {
+	if a.Value == nil {
+		a.Value = new(bool)
+	}
+	return safejson.Unmarshal(data, a.Value)
+}
+
+func (a *OptionalBooleanAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *OptionalBooleanAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *bool
+	if value.Type != gjson.Null {
+		var v1 bool
+		v1, err = decodeJSONBool(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = OptionalBooleanAliasExample{Value: v}
+	return nil
+}
+
+func (a OptionalBooleanAliasExample) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *OptionalBooleanAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+// Equal returns true if the OptionalBooleanAliasExample is equal to other according to the Conjure semantics of its values.
+func (a OptionalBooleanAliasExample) Equal(other OptionalBooleanAliasExample) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if *a.Value != *other.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalBooleanAliasExample.
+func (a OptionalBooleanAliasExample) Clone() OptionalBooleanAliasExample {
+	out := a
+	if a.Value != nil {
+		v := *a.Value
+		out.Value = &v
+	}
+	return out
+}
+
+type OptionalDateTimeAliasExample struct {
+	Value *datetime.DateTime
+}
+
+func (a OptionalDateTimeAliasExample) MarshalText() ([]byte, error) {
+	if a.Value == nil {
+		return nil, nil
+	}
+	return a.Value.MarshalText()
+}
+
+func (a OptionalDateTimeAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	if a.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONDateTime(out, *a.Value)
+	}
+	return out, nil
+}
+
+func (a OptionalDateTimeAliasExample) JSONSize() (int, error) {
+	var size int
+	if a.Value == nil {
+		size += 4
+	} else {
+		size += jsonDateTimeSize(*a.Value)
+	}
+	return size, nil
+}
+
+func (a OptionalDateTimeAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *OptionalDateTimeAliasExample) UnmarshalText(data []byte) error {
+	if a.Value == nil {
+		a.Value = new(datetime.DateTime)
+	}
+	return a.Value.UnmarshalText(data)
+}
+
+func (a *OptionalDateTimeAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *OptionalDateTimeAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *datetime.DateTime
+	if value.Type != gjson.Null {
+		var v1 datetime.DateTime
+		v1, err = decodeJSONDateTime(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = OptionalDateTimeAliasExample{Value: v}
+	return nil
+}
+
+func (a OptionalDateTimeAliasExample) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *OptionalDateTimeAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+// Equal returns true if the OptionalDateTimeAliasExample is equal to other according to the Conjure semantics of its values.
+func (a OptionalDateTimeAliasExample) Equal(other OptionalDateTimeAliasExample) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if !time.Time(*a.Value).Equal(time.Time(*other.Value)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalDateTimeAliasExample.
+func (a OptionalDateTimeAliasExample) Clone() OptionalDateTimeAliasExample {
+	out := a
+	if a.Value != nil {
+		v := *a.Value
+		out.Value = &v
+	}
+	return out
+}
+
+type OptionalDoubleAliasExample struct {
+	Value *float64
+}
+
+func (a OptionalDoubleAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	if a.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONFloat64(out, *a.Value)
+	}
+	return out, nil
+}
+
+func (a OptionalDoubleAliasExample) JSONSize() (int, error) {
+	var size int
+	if a.Value == nil {
+		size += 4
+	} else {
+		size += jsonFloat64Size(*a.Value)
+	}
+	return size, nil
+}
+
+func (a OptionalDoubleAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *OptionalDoubleAliasExample) UnmarshalJSON(data []byte) error {
+	if a.Value == nil {
+		a.Value = new(float64)
+	}
+	return safejson.Unmarshal(data, a.Value)
+}
+
+func (a *OptionalDoubleAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *OptionalDoubleAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *float64
+	if value.Type != gjson.Null {
+		var v1 float64
+		v1, err = decodeJSONFloat64(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = OptionalDoubleAliasExample{Value: v}
+	return nil
+}
+
+func (a OptionalDoubleAliasExample) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *OptionalDoubleAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+// Equal returns true if the OptionalDoubleAliasExample is equal to other according to the Conjure semantics of its values.
+func (a OptionalDoubleAliasExample) Equal(other OptionalDoubleAliasExample) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if !(*a.Value == *other.Value || math.IsNaN(*a.Value) && math.IsNaN(*other.Value)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalDoubleAliasExample.
+func (a OptionalDoubleAliasExample) Clone() OptionalDoubleAliasExample {
+	out := a
+	if a.Value != nil {
+		v := *a.Value
+		out.Value = &v
+	}
+	return out
+}
+
+type OptionalIntegerAliasExample struct {
+	Value *int
+}
+
+func (a OptionalIntegerAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	if a.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out = strconv.AppendInt(out, int64(*a.Value), 10)
+	}
+	return out, nil
+}
+
+func (a OptionalIntegerAliasExample) JSONSize() (int, error) {
+	var size int
+	if a.Value == nil {
+		size += 4
+	} else {
+		size += jsonIntSize(int64(*a.Value))
+	}
+	return size, nil
+}
+
+func (a OptionalIntegerAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *OptionalIntegerAliasExample) UnmarshalJSON(data []byte) error {
+	if a.Value == nil {
+		a.Value = new(int)
+	}
+	return safejson.Unmarshal(data, a.Value)
+}
+
+func (a *OptionalIntegerAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *OptionalIntegerAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *int
+	if value.Type != gjson.Null {
+		var v1 int
+		v1, err = decodeJSONInt(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = OptionalIntegerAliasExample{Value: v}
+	return nil
+}
+
+func (a OptionalIntegerAliasExample) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *OptionalIntegerAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+// Equal returns true if the OptionalIntegerAliasExample is equal to other according to the Conjure semantics of its values.
+func (a OptionalIntegerAliasExample) Equal(other OptionalIntegerAliasExample) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if *a.Value != *other.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalIntegerAliasExample.
+func (a OptionalIntegerAliasExample) Clone() OptionalIntegerAliasExample {
+	out := a
+	if a.Value != nil {
+		v := *a.Value
+		out.Value = &v
+	}
+	return out
+}
+
+type OptionalRidAliasExample struct {
+	Value *rid.ResourceIdentifier
+}
+
+func (a OptionalRidAliasExample) MarshalText() ([]byte, error) {
+	if a.Value == nil {
+		return nil, nil
+	}
+	return a.Value.MarshalText()
+}
+
+func (a OptionalRidAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	if a.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out, err = appendJSONText(out, *a.Value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func (a OptionalRidAliasExample) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	if a.Value == nil {
+		size += 4
+	} else {
+		n, err = jsonTextSize(*a.Value)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (a OptionalRidAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *OptionalRidAliasExample) UnmarshalText(data []byte) error {
+	if a.Value == nil {
+		a.Value = new(rid.ResourceIdentifier)
+	}
+	return a.Value.UnmarshalText(data)
+}
+
+func (a *OptionalRidAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *OptionalRidAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *rid.ResourceIdentifier
+	if value.Type != gjson.Null {
+		var v1 rid.ResourceIdentifier
+		v1, err = decodeJSONRID(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = OptionalRidAliasExample{Value: v}
+	return nil
+}
+
+func (a OptionalRidAliasExample) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *OptionalRidAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+// Equal returns true if the OptionalRidAliasExample is equal to other according to the Conjure semantics of its values.
+func (a OptionalRidAliasExample) Equal(other OptionalRidAliasExample) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if (*a.Value) != (*other.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalRidAliasExample.
+func (a OptionalRidAliasExample) Clone() OptionalRidAliasExample {
+	out := a
+	if a.Value != nil {
+		v := *a.Value
+		out.Value = &v
+	}
+	return out
+}
+
+type OptionalSafeLongAliasExample struct {
+	Value *safelong.SafeLong
+}
+
+func (a OptionalSafeLongAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	if a.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		if _, err := safelong.NewSafeLong(int64(*a.Value)); err != nil {
+			return nil, err
+		}
+		out = strconv.AppendInt(out, int64(*a.Value), 10)
+	}
+	return out, nil
+}
+
+func (a OptionalSafeLongAliasExample) JSONSize() (int, error) {
+	var size int
+	if a.Value == nil {
+		size += 4
+	} else {
+		if _, err := safelong.NewSafeLong(int64(*a.Value)); err != nil {
+			return 0, err
+		}
+		size += jsonIntSize(int64(*a.Value))
+	}
+	return size, nil
+}
+
+func (a OptionalSafeLongAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *OptionalSafeLongAliasExample) UnmarshalJSON(data []byte) error {
+	if a.Value == nil {
+		a.Value = new(safelong.SafeLong)
+	}
+	return safejson.Unmarshal(data, a.Value)
+}
+
+func (a *OptionalSafeLongAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *OptionalSafeLongAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *safelong.SafeLong
+	if value.Type != gjson.Null {
+		var v1 safelong.SafeLong
+		v1, err = decodeJSONSafeLong(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = OptionalSafeLongAliasExample{Value: v}
+	return nil
+}
+
+func (a OptionalSafeLongAliasExample) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *OptionalSafeLongAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+// Equal returns true if the OptionalSafeLongAliasExample is equal to other according to the Conjure semantics of its values.
+func (a OptionalSafeLongAliasExample) Equal(other OptionalSafeLongAliasExample) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if *a.Value != *other.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalSafeLongAliasExample.
+func (a OptionalSafeLongAliasExample) Clone() OptionalSafeLongAliasExample {
+	out := a
+	if a.Value != nil {
+		v := *a.Value
+		out.Value = &v
+	}
+	return out
+}
+
+type OptionalStringAliasExample struct {
+	Value *string
+}
+
+func (a OptionalStringAliasExample) MarshalText() ([]byte, error) {
+	if a.Value == nil {
+		return nil, nil
+	}
+	return []byte(*a.Value), nil
+}
+
+func (a OptionalStringAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	if a.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, *a.Value)
+	}
+	return out, nil
+}
+
+func (a OptionalStringAliasExample) JSONSize() (int, error) {
+	var size int
+	if a.Value == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(*a.Value)
+	}
+	return size, nil
+}
+
+func (a OptionalStringAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *OptionalStringAliasExample) UnmarshalText(data []byte) error {
+	rawOptionalStringAliasExample := string(data)
+	a.Value = &rawOptionalStringAliasExample
+	return nil
+}
+
+func (a *OptionalStringAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *OptionalStringAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *string
+	if value.Type != gjson.Null {
+		var v1 string
+		v1, err = decodeJSONString(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = OptionalStringAliasExample{Value: v}
+	return nil
+}
+
+func (a OptionalStringAliasExample) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *OptionalStringAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+// Equal returns true if the OptionalStringAliasExample is equal to other according to the Conjure semantics of its values.
+func (a OptionalStringAliasExample) Equal(other OptionalStringAliasExample) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if *a.Value != *other.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalStringAliasExample.
+func (a OptionalStringAliasExample) Clone() OptionalStringAliasExample {
+	out := a
+	if a.Value != nil {
+		v := *a.Value
+		out.Value = &v
+	}
+	return out
+}
+
+type OptionalUuidAliasExample struct {
+	Value *uuid.UUID
+}
+
+func (a OptionalUuidAliasExample) MarshalText() ([]byte, error) {
+	if a.Value == nil {
+		return nil, nil
+	}
+	return a.Value.MarshalText()
+}
+
+func (a OptionalUuidAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	if a.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONUUID(out, *a.Value)
+	}
+	return out, nil
+}
+
+func (a OptionalUuidAliasExample) JSONSize() (int, error) {
+	var size int
+	if a.Value == nil {
+		size += 4
+	} else {
+		size += 38
+	}
+	return size, nil
+}
+
+func (a OptionalUuidAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *OptionalUuidAliasExample) UnmarshalText(data []byte) error {
+	if a.Value == nil {
+		a.Value = new(uuid.UUID)
+	}
+	return a.Value.UnmarshalText(data)
+}
+
+func (a *OptionalUuidAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *OptionalUuidAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *uuid.UUID
+	if value.Type != gjson.Null {
+		var v1 uuid.UUID
+		v1, err = decodeJSONUUID(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = OptionalUuidAliasExample{Value: v}
+	return nil
+}
+
+func (a OptionalUuidAliasExample) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *OptionalUuidAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+// Equal returns true if the OptionalUuidAliasExample is equal to other according to the Conjure semantics of its values.
+func (a OptionalUuidAliasExample) Equal(other OptionalUuidAliasExample) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if (*a.Value) != (*other.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalUuidAliasExample.
+func (a OptionalUuidAliasExample) Clone() OptionalUuidAliasExample {
+	out := a
+	if a.Value != nil {
+		v := *a.Value
+		out.Value = &v
+	}
+	return out
+}
+
+type RawOptionalExample struct {
+	Value *int
+}
+
+func (a RawOptionalExample) AppendJSON(out []byte) ([]byte, error) {
+	if a.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out = strconv.AppendInt(out, int64(*a.Value), 10)
+	}
+	return out, nil
+}
+
+func (a RawOptionalExample) JSONSize() (int, error) {
+	var size int
+	if a.Value == nil {
+		size += 4
+	} else {
+		size += jsonIntSize(int64(*a.Value))
+	}
+	return size, nil
+}
+
+func (a RawOptionalExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *RawOptionalExample) UnmarshalJSON(data []byte) error {
+	if a.Value == nil {
+		a.Value = new(int)
 	}
 	return safejson.Unmarshal(data, a.Value)
 }
 
-func (a OptionalDoubleAliasExample) MarshalYAML() (interface{}, error) {
+func (a *RawOptionalExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *RawOptionalExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *int
+	if value.Type != gjson.Null {
+		var v1 int
+		v1, err = decodeJSONInt(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = RawOptionalExample{Value: v}
+	return nil
+}
+
+func (a RawOptionalExample) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *RawOptionalExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+// Equal returns true if the RawOptionalExample is equal to other according to the Conjure semantics of its values.
+func (a RawOptionalExample) Equal(other RawOptionalExample) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if *a.Value != *other.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the RawOptionalExample.
+func (a RawOptionalExample) Clone() RawOptionalExample {
+	out := a
+	if a.Value != nil {
+		v := *a.Value
+		out.Value = &v
+	}
+	return out
+}
+
+type ReferenceAliasExample AnyExample
+
+func (a ReferenceAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out, err = AnyExample(a).AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (a ReferenceAliasExample) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	n, err = AnyExample(a).JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (a ReferenceAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *ReferenceAliasExample) UnmarshalJSON(data []byte) error {
+	var rawReferenceAliasExample AnyExample
+	if err := safejson.Unmarshal(data, &rawReferenceAliasExample); err != nil {
+		return err
+	}
+	*a = ReferenceAliasExample(rawReferenceAliasExample)
+	return nil
+}
+
+func (a ReferenceAliasExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
 		return nil, err
@@ -712,7 +4040,7 @@ func (a OptionalDoubleAliasExample) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (a *OptionalDoubleAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (a *ReferenceAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -720,25 +4048,80 @@ func (a *OptionalDoubleAliasExample) UnmarshalYAML(unmarshal func(interface{}) e
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
-type OptionalIntegerAliasExample struct {
-	Value *int
+func (a *ReferenceAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
 }
 
-func (a OptionalIntegerAliasExample) MarshalJSON() ([]byte, error) {
-	if a.Value == nil {
-		return []byte("null"), nil
+func (a *ReferenceAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v AnyExample
+	err = v.decodeJSONStrict(value)
+	if err != nil {
+		return err
 	}
-	return safejson.Marshal(a.Value)
+	*a = ReferenceAliasExample(v)
+	return nil
 }
 
-func (a *OptionalIntegerAliasExample) UnmarshalJSON(data []byte) error {
-	if a.Value == nil {
-		a.Value = new(int)
+// Equal returns true if the ReferenceAliasExample is equal to other according to the Conjure semantics of its values.
+func (a ReferenceAliasExample) Equal(other ReferenceAliasExample) bool {
+	return AnyExample(a).Equal(AnyExample(other))
+}
+
+// Clone returns a deep copy of the ReferenceAliasExample.
+func (a ReferenceAliasExample) Clone() ReferenceAliasExample {
+	return a
+}
+
+type RidAliasExample rid.ResourceIdentifier
+
+func (a RidAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out, err = appendJSONText(out, rid.ResourceIdentifier(a))
+	if err != nil {
+		return nil, err
 	}
-	return safejson.Unmarshal(data, a.Value)
+	return out, nil
 }
 
-func (a OptionalIntegerAliasExample) MarshalYAML() (interface{}, error) {
+func (a RidAliasExample) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	n, err = jsonTextSize(rid.ResourceIdentifier(a))
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (a RidAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a RidAliasExample) String() string {
+	return rid.ResourceIdentifier(a).String()
+}
+
+func (a RidAliasExample) MarshalText() ([]byte, error) {
+	return rid.ResourceIdentifier(a).MarshalText()
+}
+
+func (a *RidAliasExample) UnmarshalText(data []byte) error {
+	var rawRidAliasExample rid.ResourceIdentifier
+	if err := rawRidAliasExample.UnmarshalText(data); err != nil {
+		return err
+	}
+	*a = RidAliasExample(rawRidAliasExample)
+	return nil
+}
+
+func (a RidAliasExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
 		return nil, err
@@ -746,7 +4129,7 @@ func (a OptionalIntegerAliasExample) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (a *OptionalIntegerAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (a *RidAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -754,32 +4137,68 @@ func (a *OptionalIntegerAliasExample) UnmarshalYAML(unmarshal func(interface{})
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
-type OptionalRidAliasExample struct {
-	Value *rid.ResourceIdentifier
+func (a *RidAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
 }
 
-func (a OptionalRidAliasExample) MarshalText() ([]byte, error) {
-	if a.Value == nil {
-		return nil, nil
+func (a *RidAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v rid.ResourceIdentifier
+	v, err = decodeJSONRID(value)
+	if err != nil {
+		return err
 	}
-	return a.Value.MarshalText()
+	*a = RidAliasExample(v)
+	return nil
 }
 
-func (a OptionalRidAliasExample) MarshalJSON() ([]byte, error) {
-	if a.Value == nil {
-		return []byte("null"), nil
+// Equal returns true if the RidAliasExample is equal to other according to the Conjure semantics of its values.
+func (a RidAliasExample) Equal(other RidAliasExample) bool {
+	return rid.ResourceIdentifier(a) == rid.ResourceIdentifier(other)
+}
+
+// Clone returns a deep copy of the RidAliasExample.
+func (a RidAliasExample) Clone() RidAliasExample {
+	return a
+}
+
+type SafeLongAliasExample safelong.SafeLong
+
+func (a SafeLongAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	if _, err := safelong.NewSafeLong(int64(safelong.SafeLong(a))); err != nil {
+		return nil, err
 	}
-	return safejson.Marshal(a.Value)
+	out = strconv.AppendInt(out, int64(safelong.SafeLong(a)), 10)
+	return out, nil
 }
 
-func (a *OptionalRidAliasExample) UnmarshalText(data []byte) error {
-	if a.Value == nil {
-		a.Value = new(rid.ResourceIdentifier)
+func (a SafeLongAliasExample) JSONSize() (int, error) {
+	var size int
+	if _, err := safelong.NewSafeLong(int64(safelong.SafeLong(a))); err != nil {
+		return 0, err
 	}
-	return a.Value.UnmarshalText(data)
+	size += jsonIntSize(int64(safelong.SafeLong(a)))
+	return size, nil
 }
 
-func (a OptionalRidAliasExample) MarshalYAML() (interface{}, error) {
+func (a SafeLongAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *SafeLongAliasExample) UnmarshalJSON(data []byte) error {
+	var rawSafeLongAliasExample safelong.SafeLong
+	if err := safejson.Unmarshal(data, &rawSafeLongAliasExample); err != nil {
+		return err
+	}
+	*a = SafeLongAliasExample(rawSafeLongAliasExample)
+	return nil
+}
+
+func (a SafeLongAliasExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
 		return nil, err
@@ -787,7 +4206,7 @@ func (a OptionalRidAliasExample) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (a *OptionalRidAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (a *SafeLongAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -795,65 +4214,145 @@ func (a *OptionalRidAliasExample) UnmarshalYAML(unmarshal func(interface{}) erro
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
-type OptionalSafeLongAliasExample struct {
-	Value *safelong.SafeLong
+func (a *SafeLongAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
 }
 
-func (a OptionalSafeLongAliasExample) MarshalJSON() ([]byte, error) {
-	if a.Value == nil {
-		return []byte("null"), nil
+func (a *SafeLongAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v safelong.SafeLong
+	v, err = decodeJSONSafeLong(value)
+	if err != nil {
+		return err
 	}
-	return safejson.Marshal(a.Value)
+	*a = SafeLongAliasExample(v)
+	return nil
 }
 
-func (a *OptionalSafeLongAliasExample) UnmarshalJSON(data []byte) error {
-	if a.Value == nil {
-		a.Value = new(safelong.SafeLong)
-	}
-	return safejson.Unmarshal(data, a.Value)
+// Equal returns true if the SafeLongAliasExample is equal to other according to the Conjure semantics of its values.
+func (a SafeLongAliasExample) Equal(other SafeLongAliasExample) bool {
+	return a == other
 }
 
-func (a OptionalSafeLongAliasExample) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(a)
+// Clone returns a deep copy of the SafeLongAliasExample.
+func (a SafeLongAliasExample) Clone() SafeLongAliasExample {
+	return a
+}
+
+type SetAnyAliasExample []interface{}
+
+func (a *SetAnyAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	return a.decodeJSONStrict(value)
 }
 
-func (a *OptionalSafeLongAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+func (a *SetAnyAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []interface{}
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]interface{}, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 interface{}
+		v1, err = decodeJSONAny(elem)
+		if err != nil {
+			return false
+		}
+		v = append(v, v1)
+		return true
+	})
 	if err != nil {
 		return err
 	}
-	return safejson.Unmarshal(jsonBytes, *&a)
+	*a = SetAnyAliasExample(v)
+	return nil
 }
 
-type OptionalStringAliasExample struct {
-	Value *string
+// Equal returns true if the SetAnyAliasExample is equal to other according to the Conjure semantics of its values.
+func (a SetAnyAliasExample) Equal(other SetAnyAliasExample) bool {
+	if !func() bool {
+		if len(a) != len(other) {
+			return false
+		}
+		matched := make([]bool, len(other))
+		for _, v := range a {
+			found := false
+			for j, otherV := range other {
+				if !matched[j] && reflect.DeepEqual(v, otherV) {
+					matched[j] = true
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}() {
+		return false
+	}
+	return true
 }
 
-func (a OptionalStringAliasExample) MarshalText() ([]byte, error) {
-	if a.Value == nil {
-		return nil, nil
+// Clone returns a deep copy of the SetAnyAliasExample.
+func (a SetAnyAliasExample) Clone() SetAnyAliasExample {
+	var out SetAnyAliasExample
+	if a != nil {
+		out = make(SetAnyAliasExample, len(a))
+		copy(out, a)
 	}
-	return []byte(*a.Value), nil
+	return out
 }
 
-func (a OptionalStringAliasExample) MarshalJSON() ([]byte, error) {
-	if a.Value == nil {
-		return []byte("null"), nil
+type SetBearerTokenAliasExample []bearertoken.Token
+
+func (a SetBearerTokenAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '[')
+	for i, v := range []bearertoken.Token(a) {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, string(v))
 	}
-	return safejson.Marshal(a.Value)
+	out = append(out, ']')
+	return out, nil
 }
 
-func (a *OptionalStringAliasExample) UnmarshalText(data []byte) error {
-	rawOptionalStringAliasExample := string(data)
-	a.Value = &rawOptionalStringAliasExample
+func (a SetBearerTokenAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len([]bearertoken.Token(a)) > 1 {
+		size += len([]bearertoken.Token(a)) - 1
+	}
+	for _, v := range []bearertoken.Token(a) {
+		size += jsonStringSize(string(v))
+	}
+	return size, nil
+}
+
+func (a SetBearerTokenAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *SetBearerTokenAliasExample) UnmarshalJSON(data []byte) error {
+	var rawSetBearerTokenAliasExample []bearertoken.Token
+	if err := safejson.Unmarshal(data, &rawSetBearerTokenAliasExample); err != nil {
+		return err
+	}
+	*a = SetBearerTokenAliasExample(rawSetBearerTokenAliasExample)
 	return nil
 }
 
-func (a OptionalStringAliasExample) MarshalYAML() (interface{}, error) {
+func (a SetBearerTokenAliasExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
 		return nil, err
@@ -861,7 +4360,7 @@ func (a OptionalStringAliasExample) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (a *OptionalStringAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (a *SetBearerTokenAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -869,32 +4368,124 @@ func (a *OptionalStringAliasExample) UnmarshalYAML(unmarshal func(interface{}) e
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
-type OptionalUuidAliasExample struct {
-	Value *uuid.UUID
+func (a *SetBearerTokenAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
 }
 
-func (a OptionalUuidAliasExample) MarshalText() ([]byte, error) {
-	if a.Value == nil {
-		return nil, nil
+func (a *SetBearerTokenAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []bearertoken.Token
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
 	}
-	return a.Value.MarshalText()
+	v = make([]bearertoken.Token, 0)
+	seen := make(map[bearertoken.Token]struct{})
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 bearertoken.Token
+		v1, err = decodeJSONBearerToken(elem)
+		if err != nil {
+			return false
+		}
+		if _, ok := seen[v1]; ok {
+			err = fmt.Errorf("duplicate set element %s", elem.Raw)
+			return false
+		}
+		seen[v1] = struct{}{}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = SetBearerTokenAliasExample(v)
+	return nil
 }
 
-func (a OptionalUuidAliasExample) MarshalJSON() ([]byte, error) {
-	if a.Value == nil {
-		return []byte("null"), nil
+// Equal returns true if the SetBearerTokenAliasExample is equal to other according to the Conjure semantics of its values.
+func (a SetBearerTokenAliasExample) Equal(other SetBearerTokenAliasExample) bool {
+	if !func() bool {
+		if len(a) != len(other) {
+			return false
+		}
+		counts := make(map[bearertoken.Token]int, len(a))
+		for _, v := range a {
+			counts[v]++
+		}
+		for _, v := range other {
+			if counts[v] == 0 {
+				return false
+			}
+			counts[v]--
+		}
+		return true
+	}() {
+		return false
 	}
-	return safejson.Marshal(a.Value)
+	return true
 }
 
-func (a *OptionalUuidAliasExample) UnmarshalText(data []byte) error {
-	if a.Value == nil {
-		a.Value = new(uuid.UUID)
+// Clone returns a deep copy of the SetBearerTokenAliasExample.
+func (a SetBearerTokenAliasExample) Clone() SetBearerTokenAliasExample {
+	var out SetBearerTokenAliasExample
+	if a != nil {
+		out = make(SetBearerTokenAliasExample, len(a))
+		copy(out, a)
 	}
-	return a.Value.UnmarshalText(data)
+	return out
 }
 
-func (a OptionalUuidAliasExample) MarshalYAML() (interface{}, error) {
+type SetBinaryAliasExample [][]byte
+
+func (a SetBinaryAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '[')
+	for i, v := range [][]byte(a) {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		if v == nil {
+			out = append(out, "null"...)
+		} else {
+			out = appendJSONBinary(out, v)
+		}
+	}
+	out = append(out, ']')
+	return out, nil
+}
+
+func (a SetBinaryAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len([][]byte(a)) > 1 {
+		size += len([][]byte(a)) - 1
+	}
+	for _, v := range [][]byte(a) {
+		if v == nil {
+			size += 4
+		} else {
+			size += base64.StdEncoding.EncodedLen(len(v)) + 2
+		}
+	}
+	return size, nil
+}
+
+func (a SetBinaryAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *SetBinaryAliasExample) UnmarshalJSON(data []byte) error {
+	var rawSetBinaryAliasExample [][]byte
+	if err := safejson.Unmarshal(data, &rawSetBinaryAliasExample); err != nil {
+		return err
+	}
+	*a = SetBinaryAliasExample(rawSetBinaryAliasExample)
+	return nil
+}
+
+func (a SetBinaryAliasExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
 		return nil, err
@@ -902,7 +4493,7 @@ func (a OptionalUuidAliasExample) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (a *OptionalUuidAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (a *SetBinaryAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -910,56 +4501,191 @@ func (a *OptionalUuidAliasExample) UnmarshalYAML(unmarshal func(interface{}) err
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
-type RawOptionalExample struct {
-	Value *int
+func (a *SetBinaryAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
 }
 
-func (a RawOptionalExample) MarshalJSON() ([]byte, error) {
-	if a.Value == nil {
-		return []byte("null"), nil
+func (a *SetBinaryAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v [][]byte
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([][]byte, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 []byte
+		v1, err = decodeJSONBinary(elem)
+		if err != nil {
+			return false
+		}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
+		return err
 	}
-	return safejson.Marshal(a.Value)
+	*a = SetBinaryAliasExample(v)
+	return nil
 }
 
-func (a *RawOptionalExample) UnmarshalJSON(data []byte) error {
-	if a.Value == nil {
-		a.Value = new(int)
+// Equal returns true if the SetBinaryAliasExample is equal to other according to the Conjure semantics of its values.
+func (a SetBinaryAliasExample) Equal(other SetBinaryAliasExample) bool {
+	if !func() bool {
+		if len(a) != len(other) {
+			return false
+		}
+		matched := make([]bool, len(other))
+		for _, v := range a {
+			found := false
+			for j, otherV := range other {
+				if !matched[j] && bytes.Equal(v, otherV) {
+					matched[j] = true
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}() {
+		return false
 	}
-	return safejson.Unmarshal(data, a.Value)
+	return true
+}
+
+// Clone returns a deep copy of the SetBinaryAliasExample.
+func (a SetBinaryAliasExample) Clone() SetBinaryAliasExample {
+	var out SetBinaryAliasExample
+	if a != nil {
+		out = make(SetBinaryAliasExample, len(a))
+		for i := range a {
+			if a[i] != nil {
+				out[i] = make([]byte, len(a[i]))
+				copy(out[i], a[i])
+			}
+		}
+	}
+	return out
 }
 
-func (a RawOptionalExample) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(a)
+type SetBooleanAliasExample []bool
+
+func (a *SetBooleanAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	return a.decodeJSONStrict(value)
 }
 
-func (a *RawOptionalExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+func (a *SetBooleanAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []bool
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]bool, 0)
+	seen := make(map[bool]struct{})
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 bool
+		v1, err = decodeJSONBool(elem)
+		if err != nil {
+			return false
+		}
+		if _, ok := seen[v1]; ok {
+			err = fmt.Errorf("duplicate set element %s", elem.Raw)
+			return false
+		}
+		seen[v1] = struct{}{}
+		v = append(v, v1)
+		return true
+	})
 	if err != nil {
 		return err
 	}
-	return safejson.Unmarshal(jsonBytes, *&a)
+	*a = SetBooleanAliasExample(v)
+	return nil
 }
 
-type ReferenceAliasExample AnyExample
+// Equal returns true if the SetBooleanAliasExample is equal to other according to the Conjure semantics of its values.
+func (a SetBooleanAliasExample) Equal(other SetBooleanAliasExample) bool {
+	if !func() bool {
+		if len(a) != len(other) {
+			return false
+		}
+		counts := make(map[bool]int, len(a))
+		for _, v := range a {
+			counts[v]++
+		}
+		for _, v := range other {
+			if counts[v] == 0 {
+				return false
+			}
+			counts[v]--
+		}
+		return true
+	}() {
+		return false
+	}
+	return true
+}
 
-func (a ReferenceAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal(AnyExample(a))
+// Clone returns a deep copy of the SetBooleanAliasExample.
+func (a SetBooleanAliasExample) Clone() SetBooleanAliasExample {
+	var out SetBooleanAliasExample
+	if a != nil {
+		out = make(SetBooleanAliasExample, len(a))
+		copy(out, a)
+	}
+	return out
+}
+
+type SetDateTimeAliasExample []datetime.DateTime
+
+func (a SetDateTimeAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '[')
+	for i, v := range []datetime.DateTime(a) {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONDateTime(out, v)
+	}
+	out = append(out, ']')
+	return out, nil
+}
+
+func (a SetDateTimeAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len([]datetime.DateTime(a)) > 1 {
+		size += len([]datetime.DateTime(a)) - 1
+	}
+	for _, v := range []datetime.DateTime(a) {
+		size += jsonDateTimeSize(v)
+	}
+	return size, nil
+}
+
+func (a SetDateTimeAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
 }
 
-func (a *ReferenceAliasExample) UnmarshalJSON(data []byte) error {
-	var rawReferenceAliasExample AnyExample
-	if err := safejson.Unmarshal(data, &rawReferenceAliasExample); err != nil {
+func (a *SetDateTimeAliasExample) UnmarshalJSON(data []byte) error {
+	var rawSetDateTimeAliasExample []datetime.DateTime
+	if err := safejson.Unmarshal(data, &rawSetDateTimeAliasExample); err != nil {
 		return err
 	}
-	*a = ReferenceAliasExample(rawReferenceAliasExample)
+	*a = SetDateTimeAliasExample(rawSetDateTimeAliasExample)
 	return nil
 }
 
-func (a ReferenceAliasExample) MarshalYAML() (interface{}, error) {
+func (a SetDateTimeAliasExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
 		return nil, err
@@ -967,7 +4693,7 @@ func (a ReferenceAliasExample) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (a *ReferenceAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (a *SetDateTimeAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -975,57 +4701,114 @@ func (a *ReferenceAliasExample) UnmarshalYAML(unmarshal func(interface{}) error)
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
-type RidAliasExample rid.ResourceIdentifier
-
-func (a RidAliasExample) String() string {
-	return rid.ResourceIdentifier(a).String()
-}
-
-func (a RidAliasExample) MarshalText() ([]byte, error) {
-	return rid.ResourceIdentifier(a).MarshalText()
+func (a *SetDateTimeAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
 }
 
-func (a *RidAliasExample) UnmarshalText(data []byte) error {
-	var rawRidAliasExample rid.ResourceIdentifier
-	if err := rawRidAliasExample.UnmarshalText(data); err != nil {
+func (a *SetDateTimeAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []datetime.DateTime
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]datetime.DateTime, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 datetime.DateTime
+		v1, err = decodeJSONDateTime(elem)
+		if err != nil {
+			return false
+		}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
 		return err
 	}
-	*a = RidAliasExample(rawRidAliasExample)
+	*a = SetDateTimeAliasExample(v)
 	return nil
 }
 
-func (a RidAliasExample) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(a)
-	if err != nil {
-		return nil, err
+// Equal returns true if the SetDateTimeAliasExample is equal to other according to the Conjure semantics of its values.
+func (a SetDateTimeAliasExample) Equal(other SetDateTimeAliasExample) bool {
+	if !func() bool {
+		if len(a) != len(other) {
+			return false
+		}
+		matched := make([]bool, len(other))
+		for _, v := range a {
+			found := false
+			for j, otherV := range other {
+				if !matched[j] && time.Time(v).Equal(time.Time(otherV)) {
+					matched[j] = true
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}() {
+		return false
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	return true
 }
 
-func (a *RidAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
-	if err != nil {
-		return err
+// Clone returns a deep copy of the SetDateTimeAliasExample.
+func (a SetDateTimeAliasExample) Clone() SetDateTimeAliasExample {
+	var out SetDateTimeAliasExample
+	if a != nil {
+		out = make(SetDateTimeAliasExample, len(a))
+		copy(out, a)
 	}
-	return safejson.Unmarshal(jsonBytes, *&a)
+	return out
 }
 
-type SafeLongAliasExample safelong.SafeLong
+type SetDoubleAliasExample []float64
 
-func (a SafeLongAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal(safelong.SafeLong(a))
+func (a SetDoubleAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '[')
+	for i, v := range []float64(a) {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONFloat64(out, v)
+	}
+	out = append(out, ']')
+	return out, nil
 }
 
-func (a *SafeLongAliasExample) UnmarshalJSON(data []byte) error {
-	var rawSafeLongAliasExample safelong.SafeLong
-	if err := safejson.Unmarshal(data, &rawSafeLongAliasExample); err != nil {
+func (a SetDoubleAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len([]float64(a)) > 1 {
+		size += len([]float64(a)) - 1
+	}
+	for _, v := range []float64(a) {
+		size += jsonFloat64Size(v)
+	}
+	return size, nil
+}
+
+func (a SetDoubleAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *SetDoubleAliasExample) UnmarshalJSON(data []byte) error {
+	var rawSetDoubleAliasExample []float64
+	if err := safejson.Unmarshal(data, &rawSetDoubleAliasExample); err != nil {
 		return err
 	}
-	*a = SafeLongAliasExample(rawSafeLongAliasExample)
+	*a = SetDoubleAliasExample(rawSetDoubleAliasExample)
 	return nil
 }
 
-func (a SafeLongAliasExample) MarshalYAML() (interface{}, error) {
+func (a SetDoubleAliasExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
 		return nil, err
@@ -1033,7 +4816,7 @@ func (a SafeLongAliasExample) MarshalYAML() (interface{}, error) {
 	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
 }
 
-func (a *SafeLongAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (a *SetDoubleAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
 	if err != nil {
 		return err
@@ -1041,108 +4824,279 @@ func (a *SafeLongAliasExample) UnmarshalYAML(unmarshal func(interface{}) error)
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
-type SetAnyAliasExample []interface{}
-type SetBearerTokenAliasExample []bearertoken.Token
-
-func (a SetBearerTokenAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal([]bearertoken.Token(a))
+func (a *SetDoubleAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
 }
 
-func (a *SetBearerTokenAliasExample) UnmarshalJSON(data []byte) error {
-	var rawSetBearerTokenAliasExample []bearertoken.Token
-	if err := safejson.Unmarshal(data, &rawSetBearerTokenAliasExample); err != nil {
+func (a *SetDoubleAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []float64
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]float64, 0)
+	seen := make(map[float64]struct{})
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 float64
+		v1, err = decodeJSONFloat64(elem)
+		if err != nil {
+			return false
+		}
+		if _, ok := seen[v1]; ok {
+			err = fmt.Errorf("duplicate set element %s", elem.Raw)
+			return false
+		}
+		seen[v1] = struct{}{}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
 		return err
 	}
-	*a = SetBearerTokenAliasExample(rawSetBearerTokenAliasExample)
+	*a = SetDoubleAliasExample(v)
 	return nil
 }
 
-func (a SetBearerTokenAliasExample) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(a)
-	if err != nil {
-		return nil, err
+// Equal returns true if the SetDoubleAliasExample is equal to other according to the Conjure semantics of its values.
+func (a SetDoubleAliasExample) Equal(other SetDoubleAliasExample) bool {
+	if !func() bool {
+		if len(a) != len(other) {
+			return false
+		}
+		matched := make([]bool, len(other))
+		for _, v := range a {
+			found := false
+			for j, otherV := range other {
+				if !matched[j] && (v == otherV || math.IsNaN(v) && math.IsNaN(otherV)) {
+					matched[j] = true
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}() {
+		return false
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	return true
 }
 
-func (a *SetBearerTokenAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
-	if err != nil {
-		return err
+// Clone returns a deep copy of the SetDoubleAliasExample.
+func (a SetDoubleAliasExample) Clone() SetDoubleAliasExample {
+	var out SetDoubleAliasExample
+	if a != nil {
+		out = make(SetDoubleAliasExample, len(a))
+		copy(out, a)
 	}
-	return safejson.Unmarshal(jsonBytes, *&a)
+	return out
 }
 
-type SetBinaryAliasExample [][]byte
+type SetIntegerAliasExample []int
 
-func (a SetBinaryAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal([][]byte(a))
+func (a *SetIntegerAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
 }
 
-func (a *SetBinaryAliasExample) UnmarshalJSON(data []byte) error {
-	var rawSetBinaryAliasExample [][]byte
-	if err := safejson.Unmarshal(data, &rawSetBinaryAliasExample); err != nil {
+func (a *SetIntegerAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []int
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]int, 0)
+	seen := make(map[int]struct{})
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 int
+		v1, err = decodeJSONInt(elem)
+		if err != nil {
+			return false
+		}
+		if _, ok := seen[v1]; ok {
+			err = fmt.Errorf("duplicate set element %s", elem.Raw)
+			return false
+		}
+		seen[v1] = struct{}{}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
 		return err
 	}
-	*a = SetBinaryAliasExample(rawSetBinaryAliasExample)
+	*a = SetIntegerAliasExample(v)
 	return nil
 }
 
-func (a SetBinaryAliasExample) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(a)
-	if err != nil {
-		return nil, err
+// Equal returns true if the SetIntegerAliasExample is equal to other according to the Conjure semantics of its values.
+func (a SetIntegerAliasExample) Equal(other SetIntegerAliasExample) bool {
+	if !func() bool {
+		if len(a) != len(other) {
+			return false
+		}
+		counts := make(map[int]int, len(a))
+		for _, v := range a {
+			counts[v]++
+		}
+		for _, v := range other {
+			if counts[v] == 0 {
+				return false
+			}
+			counts[v]--
+		}
+		return true
+	}() {
+		return false
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	return true
 }
 
-func (a *SetBinaryAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
-	if err != nil {
-		return err
+// Clone returns a deep copy of the SetIntegerAliasExample.
+func (a SetIntegerAliasExample) Clone() SetIntegerAliasExample {
+	var out SetIntegerAliasExample
+	if a != nil {
+		out = make(SetIntegerAliasExample, len(a))
+		copy(out, a)
 	}
-	return safejson.Unmarshal(jsonBytes, *&a)
+	return out
 }
 
-type SetBooleanAliasExample []bool
-type SetDateTimeAliasExample []datetime.DateTime
+type SetOptionalAnyAliasExample []*interface{}
 
-func (a SetDateTimeAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal([]datetime.DateTime(a))
+func (a *SetOptionalAnyAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
 }
 
-func (a *SetDateTimeAliasExample) UnmarshalJSON(data []byte) error {
-	var rawSetDateTimeAliasExample []datetime.DateTime
-	if err := safejson.Unmarshal(data, &rawSetDateTimeAliasExample); err != nil {
+func (a *SetOptionalAnyAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []*interface{}
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]*interface{}, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 *interface{}
+		if elem.Type != gjson.Null {
+			var v2 interface{}
+			v2, err = decodeJSONAny(elem)
+			if err != nil {
+				return false
+			}
+			v1 = &v2
+		}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
 		return err
 	}
-	*a = SetDateTimeAliasExample(rawSetDateTimeAliasExample)
+	*a = SetOptionalAnyAliasExample(v)
 	return nil
 }
 
-func (a SetDateTimeAliasExample) MarshalYAML() (interface{}, error) {
-	jsonBytes, err := safejson.Marshal(a)
-	if err != nil {
-		return nil, err
+// Equal returns true if the SetOptionalAnyAliasExample is equal to other according to the Conjure semantics of its values.
+func (a SetOptionalAnyAliasExample) Equal(other SetOptionalAnyAliasExample) bool {
+	if !func() bool {
+		if len(a) != len(other) {
+			return false
+		}
+		matched := make([]bool, len(other))
+		for _, v := range a {
+			found := false
+			for j, otherV := range other {
+				if !matched[j] && func() bool {
+					if (v == nil) != (otherV == nil) {
+						return false
+					}
+					if v != nil {
+						if !reflect.DeepEqual(*v, *otherV) {
+							return false
+						}
+					}
+					return true
+				}() {
+					matched[j] = true
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}() {
+		return false
 	}
-	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+	return true
+}
+
+// Clone returns a deep copy of the SetOptionalAnyAliasExample.
+func (a SetOptionalAnyAliasExample) Clone() SetOptionalAnyAliasExample {
+	var out SetOptionalAnyAliasExample
+	if a != nil {
+		out = make(SetOptionalAnyAliasExample, len(a))
+		for i := range a {
+			if a[i] != nil {
+				v1 := *a[i]
+				out[i] = &v1
+			}
+		}
+	}
+	return out
 }
 
-func (a *SetDateTimeAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
-	if err != nil {
-		return err
+type SetRidAliasExample []rid.ResourceIdentifier
+
+func (a SetRidAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, '[')
+	for i, v := range []rid.ResourceIdentifier(a) {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = appendJSONText(out, v)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return safejson.Unmarshal(jsonBytes, *&a)
+	out = append(out, ']')
+	return out, nil
 }
 
-type SetDoubleAliasExample []float64
-type SetIntegerAliasExample []int
-type SetOptionalAnyAliasExample []*interface{}
-type SetRidAliasExample []rid.ResourceIdentifier
+func (a SetRidAliasExample) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	size += 2
+	if len([]rid.ResourceIdentifier(a)) > 1 {
+		size += len([]rid.ResourceIdentifier(a)) - 1
+	}
+	for _, v := range []rid.ResourceIdentifier(a) {
+		n, err = jsonTextSize(v)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
 
 func (a SetRidAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal([]rid.ResourceIdentifier(a))
+	return a.AppendJSON(nil)
 }
 
 func (a *SetRidAliasExample) UnmarshalJSON(data []byte) error {
@@ -1170,10 +5124,110 @@ func (a *SetRidAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) er
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+func (a *SetRidAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *SetRidAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []rid.ResourceIdentifier
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]rid.ResourceIdentifier, 0)
+	seen := make(map[rid.ResourceIdentifier]struct{})
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 rid.ResourceIdentifier
+		v1, err = decodeJSONRID(elem)
+		if err != nil {
+			return false
+		}
+		if _, ok := seen[v1]; ok {
+			err = fmt.Errorf("duplicate set element %s", elem.Raw)
+			return false
+		}
+		seen[v1] = struct{}{}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = SetRidAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the SetRidAliasExample is equal to other according to the Conjure semantics of its values.
+func (a SetRidAliasExample) Equal(other SetRidAliasExample) bool {
+	if !func() bool {
+		if len(a) != len(other) {
+			return false
+		}
+		counts := make(map[rid.ResourceIdentifier]int, len(a))
+		for _, v := range a {
+			counts[v]++
+		}
+		for _, v := range other {
+			if counts[v] == 0 {
+				return false
+			}
+			counts[v]--
+		}
+		return true
+	}() {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the SetRidAliasExample.
+func (a SetRidAliasExample) Clone() SetRidAliasExample {
+	var out SetRidAliasExample
+	if a != nil {
+		out = make(SetRidAliasExample, len(a))
+		copy(out, a)
+	}
+	return out
+}
+
 type SetSafeLongAliasExample []safelong.SafeLong
 
+func (a SetSafeLongAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '[')
+	for i, v := range []safelong.SafeLong(a) {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		if _, err := safelong.NewSafeLong(int64(v)); err != nil {
+			return nil, err
+		}
+		out = strconv.AppendInt(out, int64(v), 10)
+	}
+	out = append(out, ']')
+	return out, nil
+}
+
+func (a SetSafeLongAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len([]safelong.SafeLong(a)) > 1 {
+		size += len([]safelong.SafeLong(a)) - 1
+	}
+	for _, v := range []safelong.SafeLong(a) {
+		if _, err := safelong.NewSafeLong(int64(v)); err != nil {
+			return 0, err
+		}
+		size += jsonIntSize(int64(v))
+	}
+	return size, nil
+}
+
 func (a SetSafeLongAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal([]safelong.SafeLong(a))
+	return a.AppendJSON(nil)
 }
 
 func (a *SetSafeLongAliasExample) UnmarshalJSON(data []byte) error {
@@ -1201,11 +5255,173 @@ func (a *SetSafeLongAliasExample) UnmarshalYAML(unmarshal func(interface{}) erro
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+func (a *SetSafeLongAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *SetSafeLongAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []safelong.SafeLong
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]safelong.SafeLong, 0)
+	seen := make(map[safelong.SafeLong]struct{})
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 safelong.SafeLong
+		v1, err = decodeJSONSafeLong(elem)
+		if err != nil {
+			return false
+		}
+		if _, ok := seen[v1]; ok {
+			err = fmt.Errorf("duplicate set element %s", elem.Raw)
+			return false
+		}
+		seen[v1] = struct{}{}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = SetSafeLongAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the SetSafeLongAliasExample is equal to other according to the Conjure semantics of its values.
+func (a SetSafeLongAliasExample) Equal(other SetSafeLongAliasExample) bool {
+	if !func() bool {
+		if len(a) != len(other) {
+			return false
+		}
+		counts := make(map[safelong.SafeLong]int, len(a))
+		for _, v := range a {
+			counts[v]++
+		}
+		for _, v := range other {
+			if counts[v] == 0 {
+				return false
+			}
+			counts[v]--
+		}
+		return true
+	}() {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the SetSafeLongAliasExample.
+func (a SetSafeLongAliasExample) Clone() SetSafeLongAliasExample {
+	var out SetSafeLongAliasExample
+	if a != nil {
+		out = make(SetSafeLongAliasExample, len(a))
+		copy(out, a)
+	}
+	return out
+}
+
 type SetStringAliasExample []string
+
+func (a *SetStringAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *SetStringAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []string
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]string, 0)
+	seen := make(map[string]struct{})
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 string
+		v1, err = decodeJSONString(elem)
+		if err != nil {
+			return false
+		}
+		if _, ok := seen[v1]; ok {
+			err = fmt.Errorf("duplicate set element %s", elem.Raw)
+			return false
+		}
+		seen[v1] = struct{}{}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = SetStringAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the SetStringAliasExample is equal to other according to the Conjure semantics of its values.
+func (a SetStringAliasExample) Equal(other SetStringAliasExample) bool {
+	if !func() bool {
+		if len(a) != len(other) {
+			return false
+		}
+		counts := make(map[string]int, len(a))
+		for _, v := range a {
+			counts[v]++
+		}
+		for _, v := range other {
+			if counts[v] == 0 {
+				return false
+			}
+			counts[v]--
+		}
+		return true
+	}() {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the SetStringAliasExample.
+func (a SetStringAliasExample) Clone() SetStringAliasExample {
+	var out SetStringAliasExample
+	if a != nil {
+		out = make(SetStringAliasExample, len(a))
+		copy(out, a)
+	}
+	return out
+}
+
 type SetUuidAliasExample []uuid.UUID
 
+func (a SetUuidAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '[')
+	for i, v := range []uuid.UUID(a) {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONUUID(out, v)
+	}
+	out = append(out, ']')
+	return out, nil
+}
+
+func (a SetUuidAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len([]uuid.UUID(a)) > 0 {
+		size += len([]uuid.UUID(a))*39 - 1
+	}
+	return size, nil
+}
+
 func (a SetUuidAliasExample) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal([]uuid.UUID(a))
+	return a.AppendJSON(nil)
 }
 
 func (a *SetUuidAliasExample) UnmarshalJSON(data []byte) error {
@@ -1233,9 +5449,124 @@ func (a *SetUuidAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) e
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+func (a *SetUuidAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *SetUuidAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []uuid.UUID
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]uuid.UUID, 0)
+	seen := make(map[uuid.UUID]struct{})
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 uuid.UUID
+		v1, err = decodeJSONUUID(elem)
+		if err != nil {
+			return false
+		}
+		if _, ok := seen[v1]; ok {
+			err = fmt.Errorf("duplicate set element %s", elem.Raw)
+			return false
+		}
+		seen[v1] = struct{}{}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = SetUuidAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the SetUuidAliasExample is equal to other according to the Conjure semantics of its values.
+func (a SetUuidAliasExample) Equal(other SetUuidAliasExample) bool {
+	if !func() bool {
+		if len(a) != len(other) {
+			return false
+		}
+		counts := make(map[uuid.UUID]int, len(a))
+		for _, v := range a {
+			counts[v]++
+		}
+		for _, v := range other {
+			if counts[v] == 0 {
+				return false
+			}
+			counts[v]--
+		}
+		return true
+	}() {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the SetUuidAliasExample.
+func (a SetUuidAliasExample) Clone() SetUuidAliasExample {
+	var out SetUuidAliasExample
+	if a != nil {
+		out = make(SetUuidAliasExample, len(a))
+		copy(out, a)
+	}
+	return out
+}
+
 type StringAliasExample string
+
+func (a *StringAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *StringAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v string
+	v, err = decodeJSONString(value)
+	if err != nil {
+		return err
+	}
+	*a = StringAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the StringAliasExample is equal to other according to the Conjure semantics of its values.
+func (a StringAliasExample) Equal(other StringAliasExample) bool {
+	return string(a) == string(other)
+}
+
+// Clone returns a deep copy of the StringAliasExample.
+func (a StringAliasExample) Clone() StringAliasExample {
+	return a
+}
+
 type UuidAliasExample uuid.UUID
 
+func (a UuidAliasExample) AppendJSON(out []byte) ([]byte, error) {
+	out = appendJSONUUID(out, uuid.UUID(a))
+	return out, nil
+}
+
+func (a UuidAliasExample) JSONSize() (int, error) {
+	var size int
+	size += 38
+	return size, nil
+}
+
+func (a UuidAliasExample) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
 func (a UuidAliasExample) String() string {
 	return uuid.UUID(a).String()
 }
@@ -1268,3 +5599,32 @@ func (a *UuidAliasExample) UnmarshalYAML(unmarshal func(interface{}) error) erro
 	}
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
+
+func (a *UuidAliasExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *UuidAliasExample) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v uuid.UUID
+	v, err = decodeJSONUUID(value)
+	if err != nil {
+		return err
+	}
+	*a = UuidAliasExample(v)
+	return nil
+}
+
+// Equal returns true if the UuidAliasExample is equal to other according to the Conjure semantics of its values.
+func (a UuidAliasExample) Equal(other UuidAliasExample) bool {
+	return a == other
+}
+
+// Clone returns a deep copy of the UuidAliasExample.
+func (a UuidAliasExample) Clone() UuidAliasExample {
+	return a
+}
@@ -4,6 +4,8 @@ package types
 
 import (
 	"strings"
+
+	"github.com/tidwall/gjson"
 )
 
 type Enum struct {
@@ -63,6 +65,36 @@ func (e *Enum) UnmarshalText(data []byte) error {
 	return nil
 }
 
+func (e Enum) AppendJSON(out []byte) ([]byte, error) {
+	return appendJSONString(out, string(e.val)), nil
+}
+
+func (e Enum) JSONSize() (int, error) {
+	return jsonStringSize(string(e.val)), nil
+}
+
+func (e *Enum) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return e.decodeJSONStrict(value)
+}
+
+func (e *Enum) decodeJSONStrict(value gjson.Result) error {
+	return decodeJSONEnum(value, e)
+}
+
+// Equal returns true if the Enum is equal to other according to the Conjure semantics of its values.
+func (e Enum) Equal(other Enum) bool {
+	return e.val == other.val
+}
+
+// Clone returns a deep copy of the Enum.
+func (e Enum) Clone() Enum {
+	return e
+}
+
 type EnumExample struct {
 	val EnumExample_Value
 }
@@ -122,3 +154,33 @@ func (e *EnumExample) UnmarshalText(data []byte) error {
 	}
 	return nil
 }
+
+func (e EnumExample) AppendJSON(out []byte) ([]byte, error) {
+	return appendJSONString(out, string(e.val)), nil
+}
+
+func (e EnumExample) JSONSize() (int, error) {
+	return jsonStringSize(string(e.val)), nil
+}
+
+func (e *EnumExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return e.decodeJSONStrict(value)
+}
+
+func (e *EnumExample) decodeJSONStrict(value gjson.Result) error {
+	return decodeJSONEnum(value, e)
+}
+
+// Equal returns true if the EnumExample is equal to other according to the Conjure semantics of its values.
+func (e EnumExample) Equal(other EnumExample) bool {
+	return e.val == other.val
+}
+
+// Clone returns a deep copy of the EnumExample.
+func (e EnumExample) Clone() EnumExample {
+	return e
+}
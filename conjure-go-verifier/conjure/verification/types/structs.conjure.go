@@ -3,6 +3,15 @@
 package types
 
 import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
 	"github.com/palantir/pkg/bearertoken"
 	"github.com/palantir/pkg/datetime"
 	"github.com/palantir/pkg/rid"
@@ -10,12 +19,114 @@ import (
 	"github.com/palantir/pkg/safelong"
 	"github.com/palantir/pkg/safeyaml"
 	"github.com/palantir/pkg/uuid"
+	"github.com/tidwall/gjson"
 )
 
 type AnyExample struct {
 	Value interface{} `json:"value"`
 }
 
+func (o AnyExample) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"value\":"...)
+	out, err = appendJSONMarshal(out, o.Value)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o AnyExample) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 10
+	n, err = jsonMarshalSize(o.Value)
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (o *AnyExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if !unmarshalJSONAny(field, &o.Value) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "value") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *AnyExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *AnyExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = AnyExample{}
+	var seenValue bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			o.Value, err = decodeJSONAny(field)
+			if err != nil {
+				err = fmt.Errorf("field \"value\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenValue {
+		return fmt.Errorf("field \"value\" is required")
+	}
+	return nil
+}
+
 func (o AnyExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -32,10 +143,118 @@ func (o *AnyExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the AnyExample is equal to other according to the Conjure semantics of its values.
+func (o AnyExample) Equal(other AnyExample) bool {
+	if !reflect.DeepEqual(o.Value, other.Value) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the AnyExample.
+func (o AnyExample) Clone() AnyExample {
+	out := o
+	return out
+}
+
 type BearerTokenExample struct {
 	Value bearertoken.Token `json:"value"`
 }
 
+func (o BearerTokenExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"value\":"...)
+	out = appendJSONString(out, string(o.Value))
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o BearerTokenExample) JSONSize() (int, error) {
+	size := 10
+	size += jsonStringSize(string(o.Value))
+	return size, nil
+}
+
+func (o *BearerTokenExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if field.Type != gjson.Null {
+				var s string
+				if !unmarshalJSONString(field, &s) || o.Value.UnmarshalText([]byte(s)) != nil {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "value") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *BearerTokenExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *BearerTokenExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = BearerTokenExample{}
+	var seenValue bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			o.Value, err = decodeJSONBearerToken(field)
+			if err != nil {
+				err = fmt.Errorf("field \"value\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenValue {
+		return fmt.Errorf("field \"value\" is required")
+	}
+	return nil
+}
+
 func (o BearerTokenExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -52,10 +271,123 @@ func (o *BearerTokenExample) UnmarshalYAML(unmarshal func(interface{}) error) er
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the BearerTokenExample is equal to other according to the Conjure semantics of its values.
+func (o BearerTokenExample) Equal(other BearerTokenExample) bool {
+	if o.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the BearerTokenExample.
+func (o BearerTokenExample) Clone() BearerTokenExample {
+	out := o
+	return out
+}
+
 type BinaryExample struct {
 	Value []byte `json:"value"`
 }
 
+func (o BinaryExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"value\":"...)
+	if o.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONBinary(out, o.Value)
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o BinaryExample) JSONSize() (int, error) {
+	size := 10
+	if o.Value == nil {
+		size += 4
+	} else {
+		size += base64.StdEncoding.EncodedLen(len(o.Value)) + 2
+	}
+	return size, nil
+}
+
+func (o *BinaryExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if !unmarshalJSONBinary(field, &o.Value) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "value") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *BinaryExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *BinaryExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = BinaryExample{}
+	var seenValue bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			o.Value, err = decodeJSONBinary(field)
+			if err != nil {
+				err = fmt.Errorf("field \"value\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenValue {
+		return fmt.Errorf("field \"value\" is required")
+	}
+	return nil
+}
+
 func (o BinaryExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -72,10 +404,123 @@ func (o *BinaryExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the BinaryExample is equal to other according to the Conjure semantics of its values.
+func (o BinaryExample) Equal(other BinaryExample) bool {
+	if !bytes.Equal(o.Value, other.Value) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the BinaryExample.
+func (o BinaryExample) Clone() BinaryExample {
+	out := o
+	if o.Value != nil {
+		out.Value = make([]byte, len(o.Value))
+		copy(out.Value, o.Value)
+	}
+	return out
+}
+
 type BooleanExample struct {
 	Value bool `json:"value"`
 }
 
+func (o BooleanExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"value\":"...)
+	out = strconv.AppendBool(out, o.Value)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o BooleanExample) JSONSize() (int, error) {
+	size := 10
+	if o.Value {
+		size += 4
+	} else {
+		size += 5
+	}
+	return size, nil
+}
+
+func (o *BooleanExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if !unmarshalJSONBool(field, &o.Value) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "value") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *BooleanExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *BooleanExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = BooleanExample{}
+	var seenValue bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			o.Value, err = decodeJSONBool(field)
+			if err != nil {
+				err = fmt.Errorf("field \"value\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenValue {
+		return fmt.Errorf("field \"value\" is required")
+	}
+	return nil
+}
+
 func (o BooleanExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -92,10 +537,118 @@ func (o *BooleanExample) UnmarshalYAML(unmarshal func(interface{}) error) error
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the BooleanExample is equal to other according to the Conjure semantics of its values.
+func (o BooleanExample) Equal(other BooleanExample) bool {
+	if o.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the BooleanExample.
+func (o BooleanExample) Clone() BooleanExample {
+	out := o
+	return out
+}
+
 type DateTimeExample struct {
 	Value datetime.DateTime `json:"value"`
 }
 
+func (o DateTimeExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"value\":"...)
+	out = appendJSONDateTime(out, o.Value)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o DateTimeExample) JSONSize() (int, error) {
+	size := 10
+	size += jsonDateTimeSize(o.Value)
+	return size, nil
+}
+
+func (o *DateTimeExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if field.Type != gjson.Null {
+				var s string
+				if !unmarshalJSONString(field, &s) || o.Value.UnmarshalText([]byte(s)) != nil {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "value") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *DateTimeExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *DateTimeExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = DateTimeExample{}
+	var seenValue bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			o.Value, err = decodeJSONDateTime(field)
+			if err != nil {
+				err = fmt.Errorf("field \"value\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenValue {
+		return fmt.Errorf("field \"value\" is required")
+	}
+	return nil
+}
+
 func (o DateTimeExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -112,10 +665,115 @@ func (o *DateTimeExample) UnmarshalYAML(unmarshal func(interface{}) error) error
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the DateTimeExample is equal to other according to the Conjure semantics of its values.
+func (o DateTimeExample) Equal(other DateTimeExample) bool {
+	if !time.Time(o.Value).Equal(time.Time(other.Value)) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the DateTimeExample.
+func (o DateTimeExample) Clone() DateTimeExample {
+	out := o
+	return out
+}
+
 type DoubleExample struct {
 	Value float64 `json:"value"`
 }
 
+func (o DoubleExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"value\":"...)
+	out = appendJSONFloat64(out, o.Value)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o DoubleExample) JSONSize() (int, error) {
+	size := 10
+	size += jsonFloat64Size(o.Value)
+	return size, nil
+}
+
+func (o *DoubleExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if !unmarshalJSONFloat64(field, &o.Value) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "value") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *DoubleExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *DoubleExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = DoubleExample{}
+	var seenValue bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			o.Value, err = decodeJSONFloat64(field)
+			if err != nil {
+				err = fmt.Errorf("field \"value\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenValue {
+		return fmt.Errorf("field \"value\" is required")
+	}
+	return nil
+}
+
 func (o DoubleExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -132,8 +790,63 @@ func (o *DoubleExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the DoubleExample is equal to other according to the Conjure semantics of its values.
+func (o DoubleExample) Equal(other DoubleExample) bool {
+	if !(o.Value == other.Value || math.IsNaN(o.Value) && math.IsNaN(other.Value)) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the DoubleExample.
+func (o DoubleExample) Clone() DoubleExample {
+	out := o
+	return out
+}
+
 type EmptyObjectExample struct{}
 
+func (o EmptyObjectExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{}"...)
+	return out, nil
+}
+
+func (o EmptyObjectExample) JSONSize() (int, error) {
+	size := 2
+	return size, nil
+}
+
+func (o *EmptyObjectExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	return value.IsObject()
+}
+
+func (o *EmptyObjectExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *EmptyObjectExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = EmptyObjectExample{}
+	var err error
+	value.ForEach(func(key, _ gjson.Result) bool {
+		err = fmt.Errorf("unknown field %q", key.Str)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (o EmptyObjectExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -150,10 +863,125 @@ func (o *EmptyObjectExample) UnmarshalYAML(unmarshal func(interface{}) error) er
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the EmptyObjectExample is equal to other according to the Conjure semantics of its values.
+func (o EmptyObjectExample) Equal(other EmptyObjectExample) bool {
+	return true
+}
+
+// Clone returns a deep copy of the EmptyObjectExample.
+func (o EmptyObjectExample) Clone() EmptyObjectExample {
+	out := o
+	return out
+}
+
 type EnumFieldExample struct {
 	Enum EnumExample `json:"enum"`
 }
 
+func (o EnumFieldExample) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"enum\":"...)
+	out, err = o.Enum.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o EnumFieldExample) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 9
+	n, err = o.Enum.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (o *EnumFieldExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenEnum bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "enum":
+			if seenEnum {
+				ok = false
+				return false
+			}
+			seenEnum = true
+			if field.Type != gjson.Null {
+				var s string
+				if !unmarshalJSONString(field, &s) || o.Enum.UnmarshalText([]byte(s)) != nil {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "enum") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *EnumFieldExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *EnumFieldExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = EnumFieldExample{}
+	var seenEnum bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "enum":
+			if seenEnum {
+				err = fmt.Errorf("duplicate field \"enum\"")
+				return false
+			}
+			seenEnum = true
+			err = o.Enum.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"enum\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenEnum {
+		return fmt.Errorf("field \"enum\" is required")
+	}
+	return nil
+}
+
 func (o EnumFieldExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -170,10 +998,115 @@ func (o *EnumFieldExample) UnmarshalYAML(unmarshal func(interface{}) error) erro
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the EnumFieldExample is equal to other according to the Conjure semantics of its values.
+func (o EnumFieldExample) Equal(other EnumFieldExample) bool {
+	if !o.Enum.Equal(other.Enum) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the EnumFieldExample.
+func (o EnumFieldExample) Clone() EnumFieldExample {
+	out := o
+	return out
+}
+
 type IntegerExample struct {
 	Value int `json:"value"`
 }
 
+func (o IntegerExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"value\":"...)
+	out = strconv.AppendInt(out, int64(o.Value), 10)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o IntegerExample) JSONSize() (int, error) {
+	size := 10
+	size += jsonIntSize(int64(o.Value))
+	return size, nil
+}
+
+func (o *IntegerExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if !unmarshalJSONInt(field, &o.Value) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "value") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *IntegerExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *IntegerExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = IntegerExample{}
+	var seenValue bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			o.Value, err = decodeJSONInt(field)
+			if err != nil {
+				err = fmt.Errorf("field \"value\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenValue {
+		return fmt.Errorf("field \"value\" is required")
+	}
+	return nil
+}
+
 func (o IntegerExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -190,10 +1123,115 @@ func (o *IntegerExample) UnmarshalYAML(unmarshal func(interface{}) error) error
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the IntegerExample is equal to other according to the Conjure semantics of its values.
+func (o IntegerExample) Equal(other IntegerExample) bool {
+	if o.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the IntegerExample.
+func (o IntegerExample) Clone() IntegerExample {
+	out := o
+	return out
+}
+
 type KebabCaseObjectExample struct {
 	KebabCasedField int `json:"kebab-cased-field"`
 }
 
+func (o KebabCaseObjectExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"kebab-cased-field\":"...)
+	out = strconv.AppendInt(out, int64(o.KebabCasedField), 10)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o KebabCaseObjectExample) JSONSize() (int, error) {
+	size := 22
+	size += jsonIntSize(int64(o.KebabCasedField))
+	return size, nil
+}
+
+func (o *KebabCaseObjectExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenKebabCasedField bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "kebab-cased-field":
+			if seenKebabCasedField {
+				ok = false
+				return false
+			}
+			seenKebabCasedField = true
+			if !unmarshalJSONInt(field, &o.KebabCasedField) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "kebab-cased-field") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *KebabCaseObjectExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *KebabCaseObjectExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = KebabCaseObjectExample{}
+	var seenKebabCasedField bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "kebab-cased-field":
+			if seenKebabCasedField {
+				err = fmt.Errorf("duplicate field \"kebab-cased-field\"")
+				return false
+			}
+			seenKebabCasedField = true
+			o.KebabCasedField, err = decodeJSONInt(field)
+			if err != nil {
+				err = fmt.Errorf("field \"kebab-cased-field\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenKebabCasedField {
+		return fmt.Errorf("field \"kebab-cased-field\" is required")
+	}
+	return nil
+}
+
 func (o KebabCaseObjectExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -210,19 +1248,62 @@ func (o *KebabCaseObjectExample) UnmarshalYAML(unmarshal func(interface{}) error
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the KebabCaseObjectExample is equal to other according to the Conjure semantics of its values.
+func (o KebabCaseObjectExample) Equal(other KebabCaseObjectExample) bool {
+	if o.KebabCasedField != other.KebabCasedField {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the KebabCaseObjectExample.
+func (o KebabCaseObjectExample) Clone() KebabCaseObjectExample {
+	out := o
+	return out
+}
+
 type ListExample struct {
 	Value []string `json:"value"`
 }
 
-func (o ListExample) MarshalJSON() ([]byte, error) {
-	if o.Value == nil {
-		o.Value = make([]string, 0)
+func (o ListExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"value\":"...)
+	out = append(out, '[')
+	for i, v := range o.Value {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
 	}
-	type ListExampleAlias ListExample
-	return safejson.Marshal(ListExampleAlias(o))
+	out = append(out, ']')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o ListExample) JSONSize() (int, error) {
+	size := 10
+	size += 2
+	if len(o.Value) > 1 {
+		size += len(o.Value) - 1
+	}
+	for _, v := range o.Value {
+		size += jsonStringSize(v)
+	}
+	return size, nil
+}
+
+func (o ListExample) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *ListExample) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v ListExample
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type ListExampleAlias ListExample
 	var rawListExample ListExampleAlias
 	if err := safejson.Unmarshal(data, &rawListExample); err != nil {
@@ -235,6 +1316,122 @@ func (o *ListExample) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *ListExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Value == nil {
+			o.Value = make([]string, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Value = make([]string, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v string
+					if !unmarshalJSONString(elem, &v) {
+						ok1 = false
+						return false
+					}
+					o.Value = append(o.Value, v)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "value") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Value == nil {
+		o.Value = make([]string, 0)
+	}
+	return true
+}
+
+func (o *ListExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *ListExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = ListExample{}
+	var seenValue bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"value\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Value = make([]string, 0)
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v string
+					v, err = decodeJSONString(elem)
+					if err != nil {
+						return false
+					}
+					o.Value = append(o.Value, v)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"value\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if o.Value == nil {
+		o.Value = make([]string, 0)
+	}
+	return nil
+}
+
 func (o ListExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -251,10 +1448,137 @@ func (o *ListExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the ListExample is equal to other according to the Conjure semantics of its values.
+func (o ListExample) Equal(other ListExample) bool {
+	if len(o.Value) != len(other.Value) {
+		return false
+	}
+	for i := range o.Value {
+		if o.Value[i] != other.Value[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the ListExample.
+func (o ListExample) Clone() ListExample {
+	out := o
+	if o.Value != nil {
+		out.Value = make([]string, len(o.Value))
+		copy(out.Value, o.Value)
+	}
+	return out
+}
+
 type LongFieldNameOptionalExample struct {
 	SomeLongName *string `json:"someLongName"`
 }
 
+func (o LongFieldNameOptionalExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"someLongName\":"...)
+	if o.SomeLongName == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, *o.SomeLongName)
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o LongFieldNameOptionalExample) JSONSize() (int, error) {
+	size := 17
+	if o.SomeLongName == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(*o.SomeLongName)
+	}
+	return size, nil
+}
+
+func (o *LongFieldNameOptionalExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenSomeLongName bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "someLongName":
+			if seenSomeLongName {
+				ok = false
+				return false
+			}
+			seenSomeLongName = true
+			if field.Type != gjson.Null {
+				var v string
+				if !unmarshalJSONString(field, &v) {
+					ok = false
+					return false
+				}
+				o.SomeLongName = &v
+			}
+		default:
+			if matchesJSONField(key.Str, "someLongName") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *LongFieldNameOptionalExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *LongFieldNameOptionalExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = LongFieldNameOptionalExample{}
+	var seenSomeLongName bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "someLongName":
+			if seenSomeLongName {
+				err = fmt.Errorf("duplicate field \"someLongName\"")
+				return false
+			}
+			seenSomeLongName = true
+			if field.Type != gjson.Null {
+				var v string
+				v, err = decodeJSONString(field)
+				if err != nil {
+					err = fmt.Errorf("field \"someLongName\": %w", err)
+					return false
+				}
+				o.SomeLongName = &v
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (o LongFieldNameOptionalExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -271,19 +1595,87 @@ func (o *LongFieldNameOptionalExample) UnmarshalYAML(unmarshal func(interface{})
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the LongFieldNameOptionalExample is equal to other according to the Conjure semantics of its values.
+func (o LongFieldNameOptionalExample) Equal(other LongFieldNameOptionalExample) bool {
+	if (o.SomeLongName == nil) != (other.SomeLongName == nil) {
+		return false
+	}
+	if o.SomeLongName != nil {
+		if *o.SomeLongName != *other.SomeLongName {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the LongFieldNameOptionalExample.
+func (o LongFieldNameOptionalExample) Clone() LongFieldNameOptionalExample {
+	out := o
+	if o.SomeLongName != nil {
+		v := *o.SomeLongName
+		out.SomeLongName = &v
+	}
+	return out
+}
+
 type MapExample struct {
 	Value map[string]string `json:"value"`
 }
 
+func (o MapExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"value\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value string
+		}
+		entries := make([]mapEntry, 0, len(o.Value))
+		for k, v := range o.Value {
+			entries = append(entries, mapEntry{key: k, value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = appendJSONString(out, entry.value)
+		}
+	}
+	out = append(out, '}')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o MapExample) JSONSize() (int, error) {
+	size := 10
+	size += 2
+	if len(o.Value) > 1 {
+		size += len(o.Value) - 1
+	}
+	for k, v := range o.Value {
+		size += jsonStringSize(k) + 1
+		size += jsonStringSize(v)
+	}
+	return size, nil
+}
+
 func (o MapExample) MarshalJSON() ([]byte, error) {
-	if o.Value == nil {
-		o.Value = make(map[string]string, 0)
-	}
-	type MapExampleAlias MapExample
-	return safejson.Marshal(MapExampleAlias(o))
+	return o.AppendJSON(nil)
 }
 
 func (o *MapExample) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v MapExample
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type MapExampleAlias MapExample
 	var rawMapExample MapExampleAlias
 	if err := safejson.Unmarshal(data, &rawMapExample); err != nil {
@@ -296,6 +1688,133 @@ func (o *MapExample) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *MapExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Value == nil {
+			o.Value = make(map[string]string, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.Value = make(map[string]string)
+				ok1 := true
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k string
+					if !unmarshalJSONString(key1, &k) {
+						ok1 = false
+						return false
+					}
+					var v string
+					if !unmarshalJSONString(elem, &v) {
+						ok1 = false
+						return false
+					}
+					o.Value[k] = v
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "value") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Value == nil {
+		o.Value = make(map[string]string, 0)
+	}
+	return true
+}
+
+func (o *MapExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *MapExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = MapExample{}
+	var seenValue bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"value\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.Value = make(map[string]string)
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k string
+					k = key1.Str
+					if _, ok := o.Value[k]; ok {
+						err = fmt.Errorf("duplicate map key %s", key1.Raw)
+						return false
+					}
+					var v string
+					v, err = decodeJSONString(elem)
+					if err != nil {
+						return false
+					}
+					o.Value[k] = v
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"value\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if o.Value == nil {
+		o.Value = make(map[string]string, 0)
+	}
+	return nil
+}
+
 func (o MapExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -312,7 +1831,36 @@ func (o *MapExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
-type ObjectExample struct {
+// Equal returns true if the MapExample is equal to other according to the Conjure semantics of its values.
+func (o MapExample) Equal(other MapExample) bool {
+	if len(o.Value) != len(other.Value) {
+		return false
+	}
+	for k, v := range o.Value {
+		otherV, ok := other.Value[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the MapExample.
+func (o MapExample) Clone() MapExample {
+	out := o
+	if o.Value != nil {
+		out.Value = make(map[string]string, len(o.Value))
+		for k, v := range o.Value {
+			out.Value[k] = v
+		}
+	}
+	return out
+}
+
+type ObjectExample struct {
 	String       string             `json:"string"`
 	Integer      int                `json:"integer"`
 	DoubleValue  float64            `json:"doubleValue"`
@@ -323,21 +1871,115 @@ type ObjectExample struct {
 	Alias        StringAliasExample `json:"alias"`
 }
 
+func (o ObjectExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"string\":"...)
+	out = appendJSONString(out, o.String)
+	out = append(out, ",\"integer\":"...)
+	out = strconv.AppendInt(out, int64(o.Integer), 10)
+	out = append(out, ",\"doubleValue\":"...)
+	out = appendJSONFloat64(out, o.DoubleValue)
+	out = append(out, ",\"optionalItem\":"...)
+	if o.OptionalItem == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, *o.OptionalItem)
+	}
+	out = append(out, ",\"items\":"...)
+	out = append(out, '[')
+	for i, v := range o.Items {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
+	}
+	out = append(out, ']')
+	out = append(out, ",\"set\":"...)
+	out = append(out, '[')
+	for i, v := range o.Set {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
+	}
+	out = append(out, ']')
+	out = append(out, ",\"map\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value string
+		}
+		entries := make([]mapEntry, 0, len(o.Map))
+		for k, v := range o.Map {
+			entries = append(entries, mapEntry{key: k, value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = appendJSONString(out, entry.value)
+		}
+	}
+	out = append(out, '}')
+	out = append(out, ",\"alias\":"...)
+	out = appendJSONString(out, string(o.Alias))
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o ObjectExample) JSONSize() (int, error) {
+	size := 85
+	size += jsonStringSize(o.String)
+	size += jsonIntSize(int64(o.Integer))
+	size += jsonFloat64Size(o.DoubleValue)
+	if o.OptionalItem == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(*o.OptionalItem)
+	}
+	size += 2
+	if len(o.Items) > 1 {
+		size += len(o.Items) - 1
+	}
+	for _, v := range o.Items {
+		size += jsonStringSize(v)
+	}
+	size += 2
+	if len(o.Set) > 1 {
+		size += len(o.Set) - 1
+	}
+	for _, v := range o.Set {
+		size += jsonStringSize(v)
+	}
+	size += 2
+	if len(o.Map) > 1 {
+		size += len(o.Map) - 1
+	}
+	for k, v := range o.Map {
+		size += jsonStringSize(k) + 1
+		size += jsonStringSize(v)
+	}
+	size += jsonStringSize(string(o.Alias))
+	return size, nil
+}
+
 func (o ObjectExample) MarshalJSON() ([]byte, error) {
-	if o.Items == nil {
-		o.Items = make([]string, 0)
-	}
-	if o.Set == nil {
-		o.Set = make([]string, 0)
-	}
-	if o.Map == nil {
-		o.Map = make(map[string]string, 0)
-	}
-	type ObjectExampleAlias ObjectExample
-	return safejson.Marshal(ObjectExampleAlias(o))
+	return o.AppendJSON(nil)
 }
 
 func (o *ObjectExample) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v ObjectExample
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type ObjectExampleAlias ObjectExample
 	var rawObjectExample ObjectExampleAlias
 	if err := safejson.Unmarshal(data, &rawObjectExample); err != nil {
@@ -356,6 +1998,390 @@ func (o *ObjectExample) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *ObjectExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Items == nil {
+			o.Items = make([]string, 0)
+		}
+		if o.Set == nil {
+			o.Set = make([]string, 0)
+		}
+		if o.Map == nil {
+			o.Map = make(map[string]string, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenString, seenInteger, seenDoubleValue, seenOptionalItem, seenItems, seenSet, seenMap, seenAlias bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "string":
+			if seenString {
+				ok = false
+				return false
+			}
+			seenString = true
+			if !unmarshalJSONString(field, &o.String) {
+				ok = false
+				return false
+			}
+		case "integer":
+			if seenInteger {
+				ok = false
+				return false
+			}
+			seenInteger = true
+			if !unmarshalJSONInt(field, &o.Integer) {
+				ok = false
+				return false
+			}
+		case "doubleValue":
+			if seenDoubleValue {
+				ok = false
+				return false
+			}
+			seenDoubleValue = true
+			if !unmarshalJSONFloat64(field, &o.DoubleValue) {
+				ok = false
+				return false
+			}
+		case "optionalItem":
+			if seenOptionalItem {
+				ok = false
+				return false
+			}
+			seenOptionalItem = true
+			if field.Type != gjson.Null {
+				var v string
+				if !unmarshalJSONString(field, &v) {
+					ok = false
+					return false
+				}
+				o.OptionalItem = &v
+			}
+		case "items":
+			if seenItems {
+				ok = false
+				return false
+			}
+			seenItems = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Items = make([]string, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v1 string
+					if !unmarshalJSONString(elem, &v1) {
+						ok1 = false
+						return false
+					}
+					o.Items = append(o.Items, v1)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "set":
+			if seenSet {
+				ok = false
+				return false
+			}
+			seenSet = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Set = make([]string, 0)
+				ok2 := true
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v2 string
+					if !unmarshalJSONString(elem1, &v2) {
+						ok2 = false
+						return false
+					}
+					o.Set = append(o.Set, v2)
+					return true
+				})
+				if !ok2 {
+					ok = false
+					return false
+				}
+			}
+		case "map":
+			if seenMap {
+				ok = false
+				return false
+			}
+			seenMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.Map = make(map[string]string)
+				ok3 := true
+				field.ForEach(func(key1, elem2 gjson.Result) bool {
+					var k string
+					if !unmarshalJSONString(key1, &k) {
+						ok3 = false
+						return false
+					}
+					var v3 string
+					if !unmarshalJSONString(elem2, &v3) {
+						ok3 = false
+						return false
+					}
+					o.Map[k] = v3
+					return true
+				})
+				if !ok3 {
+					ok = false
+					return false
+				}
+			}
+		case "alias":
+			if seenAlias {
+				ok = false
+				return false
+			}
+			seenAlias = true
+			var v4 string
+			if !unmarshalJSONString(field, &v4) {
+				ok = false
+				return false
+			}
+			o.Alias = StringAliasExample(v4)
+		default:
+			if matchesJSONField(key.Str, "string", "integer", "doubleValue", "optionalItem", "items", "set", "map", "alias") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Items == nil {
+		o.Items = make([]string, 0)
+	}
+	if o.Set == nil {
+		o.Set = make([]string, 0)
+	}
+	if o.Map == nil {
+		o.Map = make(map[string]string, 0)
+	}
+	return true
+}
+
+func (o *ObjectExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *ObjectExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = ObjectExample{}
+	var seenString, seenInteger, seenDoubleValue, seenOptionalItem, seenItems, seenSet, seenMap, seenAlias bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "string":
+			if seenString {
+				err = fmt.Errorf("duplicate field \"string\"")
+				return false
+			}
+			seenString = true
+			o.String, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"string\": %w", err)
+				return false
+			}
+		case "integer":
+			if seenInteger {
+				err = fmt.Errorf("duplicate field \"integer\"")
+				return false
+			}
+			seenInteger = true
+			o.Integer, err = decodeJSONInt(field)
+			if err != nil {
+				err = fmt.Errorf("field \"integer\": %w", err)
+				return false
+			}
+		case "doubleValue":
+			if seenDoubleValue {
+				err = fmt.Errorf("duplicate field \"doubleValue\"")
+				return false
+			}
+			seenDoubleValue = true
+			o.DoubleValue, err = decodeJSONFloat64(field)
+			if err != nil {
+				err = fmt.Errorf("field \"doubleValue\": %w", err)
+				return false
+			}
+		case "optionalItem":
+			if seenOptionalItem {
+				err = fmt.Errorf("duplicate field \"optionalItem\"")
+				return false
+			}
+			seenOptionalItem = true
+			if field.Type != gjson.Null {
+				var v string
+				v, err = decodeJSONString(field)
+				if err != nil {
+					err = fmt.Errorf("field \"optionalItem\": %w", err)
+					return false
+				}
+				o.OptionalItem = &v
+			}
+		case "items":
+			if seenItems {
+				err = fmt.Errorf("duplicate field \"items\"")
+				return false
+			}
+			seenItems = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"items\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Items = make([]string, 0)
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v1 string
+					v1, err = decodeJSONString(elem)
+					if err != nil {
+						return false
+					}
+					o.Items = append(o.Items, v1)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"items\": %w", err)
+					return false
+				}
+			}
+		case "set":
+			if seenSet {
+				err = fmt.Errorf("duplicate field \"set\"")
+				return false
+			}
+			seenSet = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"set\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Set = make([]string, 0)
+				seen := make(map[string]struct{})
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v2 string
+					v2, err = decodeJSONString(elem1)
+					if err != nil {
+						return false
+					}
+					if _, ok := seen[v2]; ok {
+						err = fmt.Errorf("duplicate set element %s", elem1.Raw)
+						return false
+					}
+					seen[v2] = struct{}{}
+					o.Set = append(o.Set, v2)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"set\": %w", err)
+					return false
+				}
+			}
+		case "map":
+			if seenMap {
+				err = fmt.Errorf("duplicate field \"map\"")
+				return false
+			}
+			seenMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"map\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.Map = make(map[string]string)
+				field.ForEach(func(key1, elem2 gjson.Result) bool {
+					var k string
+					k = key1.Str
+					if _, ok := o.Map[k]; ok {
+						err = fmt.Errorf("duplicate map key %s", key1.Raw)
+						return false
+					}
+					var v3 string
+					v3, err = decodeJSONString(elem2)
+					if err != nil {
+						return false
+					}
+					o.Map[k] = v3
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"map\": %w", err)
+					return false
+				}
+			}
+		case "alias":
+			if seenAlias {
+				err = fmt.Errorf("duplicate field \"alias\"")
+				return false
+			}
+			seenAlias = true
+			err = o.Alias.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"alias\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenString {
+		return fmt.Errorf("field \"string\" is required")
+	}
+	if !seenInteger {
+		return fmt.Errorf("field \"integer\" is required")
+	}
+	if !seenDoubleValue {
+		return fmt.Errorf("field \"doubleValue\" is required")
+	}
+	if o.Items == nil {
+		o.Items = make([]string, 0)
+	}
+	if o.Set == nil {
+		o.Set = make([]string, 0)
+	}
+	if o.Map == nil {
+		o.Map = make(map[string]string, 0)
+	}
+	if !seenAlias {
+		return fmt.Errorf("field \"alias\" is required")
+	}
+	return nil
+}
+
 func (o ObjectExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -372,10 +2398,205 @@ func (o *ObjectExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the ObjectExample is equal to other according to the Conjure semantics of its values.
+func (o ObjectExample) Equal(other ObjectExample) bool {
+	if o.String != other.String {
+		return false
+	}
+	if o.Integer != other.Integer {
+		return false
+	}
+	if !(o.DoubleValue == other.DoubleValue || math.IsNaN(o.DoubleValue) && math.IsNaN(other.DoubleValue)) {
+		return false
+	}
+	if (o.OptionalItem == nil) != (other.OptionalItem == nil) {
+		return false
+	}
+	if o.OptionalItem != nil {
+		if *o.OptionalItem != *other.OptionalItem {
+			return false
+		}
+	}
+	if len(o.Items) != len(other.Items) {
+		return false
+	}
+	for i := range o.Items {
+		if o.Items[i] != other.Items[i] {
+			return false
+		}
+	}
+	if !func() bool {
+		if len(o.Set) != len(other.Set) {
+			return false
+		}
+		counts := make(map[string]int, len(o.Set))
+		for _, v := range o.Set {
+			counts[v]++
+		}
+		for _, v := range other.Set {
+			if counts[v] == 0 {
+				return false
+			}
+			counts[v]--
+		}
+		return true
+	}() {
+		return false
+	}
+	if len(o.Map) != len(other.Map) {
+		return false
+	}
+	for k, v := range o.Map {
+		otherV, ok := other.Map[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	if !o.Alias.Equal(other.Alias) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the ObjectExample.
+func (o ObjectExample) Clone() ObjectExample {
+	out := o
+	if o.OptionalItem != nil {
+		v := *o.OptionalItem
+		out.OptionalItem = &v
+	}
+	if o.Items != nil {
+		out.Items = make([]string, len(o.Items))
+		copy(out.Items, o.Items)
+	}
+	if o.Set != nil {
+		out.Set = make([]string, len(o.Set))
+		copy(out.Set, o.Set)
+	}
+	if o.Map != nil {
+		out.Map = make(map[string]string, len(o.Map))
+		for k, v := range o.Map {
+			out.Map[k] = v
+		}
+	}
+	return out
+}
+
 type OptionalBooleanExample struct {
 	Value *bool `json:"value"`
 }
 
+func (o OptionalBooleanExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"value\":"...)
+	if o.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out = strconv.AppendBool(out, *o.Value)
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o OptionalBooleanExample) JSONSize() (int, error) {
+	size := 10
+	if o.Value == nil {
+		size += 4
+	} else {
+		if *o.Value {
+			size += 4
+		} else {
+			size += 5
+		}
+	}
+	return size, nil
+}
+
+func (o *OptionalBooleanExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if field.Type != gjson.Null {
+				var v bool
+				if !unmarshalJSONBool(field, &v) {
+					ok = false
+					return false
+				}
+				o.Value = &v
+			}
+		default:
+			if matchesJSONField(key.Str, "value") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *OptionalBooleanExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *OptionalBooleanExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = OptionalBooleanExample{}
+	var seenValue bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			if field.Type != gjson.Null {
+				var v bool
+				v, err = decodeJSONBool(field)
+				if err != nil {
+					err = fmt.Errorf("field \"value\": %w", err)
+					return false
+				}
+				o.Value = &v
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (o OptionalBooleanExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -392,10 +2613,137 @@ func (o *OptionalBooleanExample) UnmarshalYAML(unmarshal func(interface{}) error
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the OptionalBooleanExample is equal to other according to the Conjure semantics of its values.
+func (o OptionalBooleanExample) Equal(other OptionalBooleanExample) bool {
+	if (o.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if o.Value != nil {
+		if *o.Value != *other.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalBooleanExample.
+func (o OptionalBooleanExample) Clone() OptionalBooleanExample {
+	out := o
+	if o.Value != nil {
+		v := *o.Value
+		out.Value = &v
+	}
+	return out
+}
+
 type OptionalExample struct {
 	Value *string `json:"value"`
 }
 
+func (o OptionalExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"value\":"...)
+	if o.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, *o.Value)
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o OptionalExample) JSONSize() (int, error) {
+	size := 10
+	if o.Value == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(*o.Value)
+	}
+	return size, nil
+}
+
+func (o *OptionalExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if field.Type != gjson.Null {
+				var v string
+				if !unmarshalJSONString(field, &v) {
+					ok = false
+					return false
+				}
+				o.Value = &v
+			}
+		default:
+			if matchesJSONField(key.Str, "value") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *OptionalExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *OptionalExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = OptionalExample{}
+	var seenValue bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			if field.Type != gjson.Null {
+				var v string
+				v, err = decodeJSONString(field)
+				if err != nil {
+					err = fmt.Errorf("field \"value\": %w", err)
+					return false
+				}
+				o.Value = &v
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (o OptionalExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -412,10 +2760,137 @@ func (o *OptionalExample) UnmarshalYAML(unmarshal func(interface{}) error) error
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the OptionalExample is equal to other according to the Conjure semantics of its values.
+func (o OptionalExample) Equal(other OptionalExample) bool {
+	if (o.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if o.Value != nil {
+		if *o.Value != *other.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalExample.
+func (o OptionalExample) Clone() OptionalExample {
+	out := o
+	if o.Value != nil {
+		v := *o.Value
+		out.Value = &v
+	}
+	return out
+}
+
 type OptionalIntegerExample struct {
 	Value *int `json:"value"`
 }
 
+func (o OptionalIntegerExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"value\":"...)
+	if o.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out = strconv.AppendInt(out, int64(*o.Value), 10)
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o OptionalIntegerExample) JSONSize() (int, error) {
+	size := 10
+	if o.Value == nil {
+		size += 4
+	} else {
+		size += jsonIntSize(int64(*o.Value))
+	}
+	return size, nil
+}
+
+func (o *OptionalIntegerExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if field.Type != gjson.Null {
+				var v int
+				if !unmarshalJSONInt(field, &v) {
+					ok = false
+					return false
+				}
+				o.Value = &v
+			}
+		default:
+			if matchesJSONField(key.Str, "value") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *OptionalIntegerExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *OptionalIntegerExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = OptionalIntegerExample{}
+	var seenValue bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			if field.Type != gjson.Null {
+				var v int
+				v, err = decodeJSONInt(field)
+				if err != nil {
+					err = fmt.Errorf("field \"value\": %w", err)
+					return false
+				}
+				o.Value = &v
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (o OptionalIntegerExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -432,10 +2907,137 @@ func (o *OptionalIntegerExample) UnmarshalYAML(unmarshal func(interface{}) error
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the OptionalIntegerExample is equal to other according to the Conjure semantics of its values.
+func (o OptionalIntegerExample) Equal(other OptionalIntegerExample) bool {
+	if (o.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if o.Value != nil {
+		if *o.Value != *other.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalIntegerExample.
+func (o OptionalIntegerExample) Clone() OptionalIntegerExample {
+	out := o
+	if o.Value != nil {
+		v := *o.Value
+		out.Value = &v
+	}
+	return out
+}
+
 type RidExample struct {
 	Value rid.ResourceIdentifier `json:"value"`
 }
 
+func (o RidExample) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"value\":"...)
+	out, err = appendJSONText(out, o.Value)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o RidExample) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 10
+	n, err = jsonTextSize(o.Value)
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (o *RidExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if field.Type != gjson.Null {
+				var s string
+				if !unmarshalJSONString(field, &s) || o.Value.UnmarshalText([]byte(s)) != nil {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "value") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *RidExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *RidExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = RidExample{}
+	var seenValue bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			o.Value, err = decodeJSONRID(field)
+			if err != nil {
+				err = fmt.Errorf("field \"value\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenValue {
+		return fmt.Errorf("field \"value\" is required")
+	}
+	return nil
+}
+
 func (o RidExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -452,10 +3054,121 @@ func (o *RidExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the RidExample is equal to other according to the Conjure semantics of its values.
+func (o RidExample) Equal(other RidExample) bool {
+	if o.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the RidExample.
+func (o RidExample) Clone() RidExample {
+	out := o
+	return out
+}
+
 type SafeLongExample struct {
 	Value safelong.SafeLong `json:"value"`
 }
 
+func (o SafeLongExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"value\":"...)
+	if _, err := safelong.NewSafeLong(int64(o.Value)); err != nil {
+		return nil, err
+	}
+	out = strconv.AppendInt(out, int64(o.Value), 10)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o SafeLongExample) JSONSize() (int, error) {
+	size := 10
+	if _, err := safelong.NewSafeLong(int64(o.Value)); err != nil {
+		return 0, err
+	}
+	size += jsonIntSize(int64(o.Value))
+	return size, nil
+}
+
+func (o *SafeLongExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if !unmarshalJSONSafeLong(field, &o.Value) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "value") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *SafeLongExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *SafeLongExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = SafeLongExample{}
+	var seenValue bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			o.Value, err = decodeJSONSafeLong(field)
+			if err != nil {
+				err = fmt.Errorf("field \"value\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenValue {
+		return fmt.Errorf("field \"value\" is required")
+	}
+	return nil
+}
+
 func (o SafeLongExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -472,19 +3185,62 @@ func (o *SafeLongExample) UnmarshalYAML(unmarshal func(interface{}) error) error
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the SafeLongExample is equal to other according to the Conjure semantics of its values.
+func (o SafeLongExample) Equal(other SafeLongExample) bool {
+	if o.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the SafeLongExample.
+func (o SafeLongExample) Clone() SafeLongExample {
+	out := o
+	return out
+}
+
 type SetDoubleExample struct {
 	Value []float64 `json:"value"`
 }
 
-func (o SetDoubleExample) MarshalJSON() ([]byte, error) {
-	if o.Value == nil {
-		o.Value = make([]float64, 0)
+func (o SetDoubleExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"value\":"...)
+	out = append(out, '[')
+	for i, v := range o.Value {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONFloat64(out, v)
 	}
-	type SetDoubleExampleAlias SetDoubleExample
-	return safejson.Marshal(SetDoubleExampleAlias(o))
+	out = append(out, ']')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o SetDoubleExample) JSONSize() (int, error) {
+	size := 10
+	size += 2
+	if len(o.Value) > 1 {
+		size += len(o.Value) - 1
+	}
+	for _, v := range o.Value {
+		size += jsonFloat64Size(v)
+	}
+	return size, nil
+}
+
+func (o SetDoubleExample) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *SetDoubleExample) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v SetDoubleExample
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type SetDoubleExampleAlias SetDoubleExample
 	var rawSetDoubleExample SetDoubleExampleAlias
 	if err := safejson.Unmarshal(data, &rawSetDoubleExample); err != nil {
@@ -497,6 +3253,128 @@ func (o *SetDoubleExample) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *SetDoubleExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Value == nil {
+			o.Value = make([]float64, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Value = make([]float64, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v float64
+					if !unmarshalJSONFloat64(elem, &v) {
+						ok1 = false
+						return false
+					}
+					o.Value = append(o.Value, v)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "value") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Value == nil {
+		o.Value = make([]float64, 0)
+	}
+	return true
+}
+
+func (o *SetDoubleExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *SetDoubleExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = SetDoubleExample{}
+	var seenValue bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"value\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Value = make([]float64, 0)
+				seen := make(map[float64]struct{})
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v float64
+					v, err = decodeJSONFloat64(elem)
+					if err != nil {
+						return false
+					}
+					if _, ok := seen[v]; ok {
+						err = fmt.Errorf("duplicate set element %s", elem.Raw)
+						return false
+					}
+					seen[v] = struct{}{}
+					o.Value = append(o.Value, v)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"value\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if o.Value == nil {
+		o.Value = make([]float64, 0)
+	}
+	return nil
+}
+
 func (o SetDoubleExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -513,19 +3391,85 @@ func (o *SetDoubleExample) UnmarshalYAML(unmarshal func(interface{}) error) erro
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the SetDoubleExample is equal to other according to the Conjure semantics of its values.
+func (o SetDoubleExample) Equal(other SetDoubleExample) bool {
+	if !func() bool {
+		if len(o.Value) != len(other.Value) {
+			return false
+		}
+		matched := make([]bool, len(other.Value))
+		for _, v := range o.Value {
+			found := false
+			for j, otherV := range other.Value {
+				if !matched[j] && (v == otherV || math.IsNaN(v) && math.IsNaN(otherV)) {
+					matched[j] = true
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}() {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the SetDoubleExample.
+func (o SetDoubleExample) Clone() SetDoubleExample {
+	out := o
+	if o.Value != nil {
+		out.Value = make([]float64, len(o.Value))
+		copy(out.Value, o.Value)
+	}
+	return out
+}
+
 type SetStringExample struct {
 	Value []string `json:"value"`
 }
 
-func (o SetStringExample) MarshalJSON() ([]byte, error) {
-	if o.Value == nil {
-		o.Value = make([]string, 0)
+func (o SetStringExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"value\":"...)
+	out = append(out, '[')
+	for i, v := range o.Value {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
 	}
-	type SetStringExampleAlias SetStringExample
-	return safejson.Marshal(SetStringExampleAlias(o))
+	out = append(out, ']')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o SetStringExample) JSONSize() (int, error) {
+	size := 10
+	size += 2
+	if len(o.Value) > 1 {
+		size += len(o.Value) - 1
+	}
+	for _, v := range o.Value {
+		size += jsonStringSize(v)
+	}
+	return size, nil
+}
+
+func (o SetStringExample) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *SetStringExample) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v SetStringExample
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type SetStringExampleAlias SetStringExample
 	var rawSetStringExample SetStringExampleAlias
 	if err := safejson.Unmarshal(data, &rawSetStringExample); err != nil {
@@ -538,6 +3482,128 @@ func (o *SetStringExample) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *SetStringExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Value == nil {
+			o.Value = make([]string, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Value = make([]string, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v string
+					if !unmarshalJSONString(elem, &v) {
+						ok1 = false
+						return false
+					}
+					o.Value = append(o.Value, v)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "value") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Value == nil {
+		o.Value = make([]string, 0)
+	}
+	return true
+}
+
+func (o *SetStringExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *SetStringExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = SetStringExample{}
+	var seenValue bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"value\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Value = make([]string, 0)
+				seen := make(map[string]struct{})
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v string
+					v, err = decodeJSONString(elem)
+					if err != nil {
+						return false
+					}
+					if _, ok := seen[v]; ok {
+						err = fmt.Errorf("duplicate set element %s", elem.Raw)
+						return false
+					}
+					seen[v] = struct{}{}
+					o.Value = append(o.Value, v)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"value\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if o.Value == nil {
+		o.Value = make([]string, 0)
+	}
+	return nil
+}
+
 func (o SetStringExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -554,10 +3620,134 @@ func (o *SetStringExample) UnmarshalYAML(unmarshal func(interface{}) error) erro
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the SetStringExample is equal to other according to the Conjure semantics of its values.
+func (o SetStringExample) Equal(other SetStringExample) bool {
+	if !func() bool {
+		if len(o.Value) != len(other.Value) {
+			return false
+		}
+		counts := make(map[string]int, len(o.Value))
+		for _, v := range o.Value {
+			counts[v]++
+		}
+		for _, v := range other.Value {
+			if counts[v] == 0 {
+				return false
+			}
+			counts[v]--
+		}
+		return true
+	}() {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the SetStringExample.
+func (o SetStringExample) Clone() SetStringExample {
+	out := o
+	if o.Value != nil {
+		out.Value = make([]string, len(o.Value))
+		copy(out.Value, o.Value)
+	}
+	return out
+}
+
 type SnakeCaseObjectExample struct {
 	SnakeCasedField int `json:"snake_cased_field"`
 }
 
+func (o SnakeCaseObjectExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"snake_cased_field\":"...)
+	out = strconv.AppendInt(out, int64(o.SnakeCasedField), 10)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o SnakeCaseObjectExample) JSONSize() (int, error) {
+	size := 22
+	size += jsonIntSize(int64(o.SnakeCasedField))
+	return size, nil
+}
+
+func (o *SnakeCaseObjectExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenSnakeCasedField bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "snake_cased_field":
+			if seenSnakeCasedField {
+				ok = false
+				return false
+			}
+			seenSnakeCasedField = true
+			if !unmarshalJSONInt(field, &o.SnakeCasedField) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "snake_cased_field") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *SnakeCaseObjectExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *SnakeCaseObjectExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = SnakeCaseObjectExample{}
+	var seenSnakeCasedField bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "snake_cased_field":
+			if seenSnakeCasedField {
+				err = fmt.Errorf("duplicate field \"snake_cased_field\"")
+				return false
+			}
+			seenSnakeCasedField = true
+			o.SnakeCasedField, err = decodeJSONInt(field)
+			if err != nil {
+				err = fmt.Errorf("field \"snake_cased_field\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenSnakeCasedField {
+		return fmt.Errorf("field \"snake_cased_field\" is required")
+	}
+	return nil
+}
+
 func (o SnakeCaseObjectExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -574,10 +3764,115 @@ func (o *SnakeCaseObjectExample) UnmarshalYAML(unmarshal func(interface{}) error
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the SnakeCaseObjectExample is equal to other according to the Conjure semantics of its values.
+func (o SnakeCaseObjectExample) Equal(other SnakeCaseObjectExample) bool {
+	if o.SnakeCasedField != other.SnakeCasedField {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the SnakeCaseObjectExample.
+func (o SnakeCaseObjectExample) Clone() SnakeCaseObjectExample {
+	out := o
+	return out
+}
+
 type StringExample struct {
 	Value string `json:"value"`
 }
 
+func (o StringExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"value\":"...)
+	out = appendJSONString(out, o.Value)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o StringExample) JSONSize() (int, error) {
+	size := 10
+	size += jsonStringSize(o.Value)
+	return size, nil
+}
+
+func (o *StringExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if !unmarshalJSONString(field, &o.Value) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "value") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *StringExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *StringExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = StringExample{}
+	var seenValue bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			o.Value, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"value\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenValue {
+		return fmt.Errorf("field \"value\" is required")
+	}
+	return nil
+}
+
 func (o StringExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -594,10 +3889,118 @@ func (o *StringExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the StringExample is equal to other according to the Conjure semantics of its values.
+func (o StringExample) Equal(other StringExample) bool {
+	if o.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the StringExample.
+func (o StringExample) Clone() StringExample {
+	out := o
+	return out
+}
+
 type UuidExample struct {
 	Value uuid.UUID `json:"value"`
 }
 
+func (o UuidExample) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"value\":"...)
+	out = appendJSONUUID(out, o.Value)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o UuidExample) JSONSize() (int, error) {
+	size := 10
+	size += 38
+	return size, nil
+}
+
+func (o *UuidExample) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if field.Type != gjson.Null {
+				var s string
+				if !unmarshalJSONString(field, &s) || o.Value.UnmarshalText([]byte(s)) != nil {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "value") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *UuidExample) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *UuidExample) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = UuidExample{}
+	var seenValue bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			o.Value, err = decodeJSONUUID(field)
+			if err != nil {
+				err = fmt.Errorf("field \"value\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenValue {
+		return fmt.Errorf("field \"value\" is required")
+	}
+	return nil
+}
+
 func (o UuidExample) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -613,3 +4016,17 @@ func (o *UuidExample) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	}
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
+
+// Equal returns true if the UuidExample is equal to other according to the Conjure semantics of its values.
+func (o UuidExample) Equal(other UuidExample) bool {
+	if o.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the UuidExample.
+func (o UuidExample) Clone() UuidExample {
+	out := o
+	return out
+}
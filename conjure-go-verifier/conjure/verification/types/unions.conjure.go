@@ -5,9 +5,11 @@ package types
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 // A type which can either be a StringExample, a set of strings, or an integer.
@@ -37,7 +39,8 @@ func (u *unionDeserializer) toStruct() Union {
 	return Union{typ: u.Type, stringExample: u.StringExample, set: u.Set, thisFieldIsAnInteger: u.ThisFieldIsAnInteger, alsoAnInteger: u.AlsoAnInteger, if_: u.If, new: u.New, interface_: u.Interface}
 }
 
-func (u *Union) toSerializer() (interface{}, error) {
+func (u Union) AppendJSON(out []byte) ([]byte, error) {
+	var err error
 	switch u.typ {
 	default:
 		return nil, fmt.Errorf("unknown type %q", u.typ)
@@ -45,70 +48,134 @@ func (u *Union) toSerializer() (interface{}, error) {
 		if u.stringExample == nil {
 			return nil, fmt.Errorf("field \"stringExample\" is required")
 		}
-		return struct {
-			Type          string        `json:"type"`
-			StringExample StringExample `json:"stringExample"`
-		}{Type: "stringExample", StringExample: *u.stringExample}, nil
+		out = append(out, "{\"type\":\"stringExample\",\"stringExample\":"...)
+		out, err = u.stringExample.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "set":
 		if u.set == nil {
 			return nil, fmt.Errorf("field \"set\" is required")
 		}
-		return struct {
-			Type string   `json:"type"`
-			Set  []string `json:"set"`
-		}{Type: "set", Set: *u.set}, nil
+		out = append(out, "{\"type\":\"set\",\"set\":"...)
+		out = append(out, '[')
+		for i, v := range *u.set {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, v)
+		}
+		out = append(out, ']')
 	case "thisFieldIsAnInteger":
 		if u.thisFieldIsAnInteger == nil {
 			return nil, fmt.Errorf("field \"thisFieldIsAnInteger\" is required")
 		}
-		return struct {
-			Type                 string `json:"type"`
-			ThisFieldIsAnInteger int    `json:"thisFieldIsAnInteger"`
-		}{Type: "thisFieldIsAnInteger", ThisFieldIsAnInteger: *u.thisFieldIsAnInteger}, nil
+		out = append(out, "{\"type\":\"thisFieldIsAnInteger\",\"thisFieldIsAnInteger\":"...)
+		out = strconv.AppendInt(out, int64(*u.thisFieldIsAnInteger), 10)
 	case "alsoAnInteger":
 		if u.alsoAnInteger == nil {
 			return nil, fmt.Errorf("field \"alsoAnInteger\" is required")
 		}
-		return struct {
-			Type          string `json:"type"`
-			AlsoAnInteger int    `json:"alsoAnInteger"`
-		}{Type: "alsoAnInteger", AlsoAnInteger: *u.alsoAnInteger}, nil
+		out = append(out, "{\"type\":\"alsoAnInteger\",\"alsoAnInteger\":"...)
+		out = strconv.AppendInt(out, int64(*u.alsoAnInteger), 10)
 	case "if":
 		if u.if_ == nil {
 			return nil, fmt.Errorf("field \"if\" is required")
 		}
-		return struct {
-			Type string `json:"type"`
-			If   int    `json:"if"`
-		}{Type: "if", If: *u.if_}, nil
+		out = append(out, "{\"type\":\"if\",\"if\":"...)
+		out = strconv.AppendInt(out, int64(*u.if_), 10)
 	case "new":
 		if u.new == nil {
 			return nil, fmt.Errorf("field \"new\" is required")
 		}
-		return struct {
-			Type string `json:"type"`
-			New  int    `json:"new"`
-		}{Type: "new", New: *u.new}, nil
+		out = append(out, "{\"type\":\"new\",\"new\":"...)
+		out = strconv.AppendInt(out, int64(*u.new), 10)
 	case "interface":
 		if u.interface_ == nil {
 			return nil, fmt.Errorf("field \"interface\" is required")
 		}
-		return struct {
-			Type      string `json:"type"`
-			Interface int    `json:"interface"`
-		}{Type: "interface", Interface: *u.interface_}, nil
+		out = append(out, "{\"type\":\"interface\",\"interface\":"...)
+		out = strconv.AppendInt(out, int64(*u.interface_), 10)
 	}
+	out = append(out, '}')
+	return out, nil
 }
 
-func (u Union) MarshalJSON() ([]byte, error) {
-	ser, err := u.toSerializer()
-	if err != nil {
-		return nil, err
+func (u Union) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	switch u.typ {
+	default:
+		return 0, fmt.Errorf("unknown type %q", u.typ)
+	case "stringExample":
+		if u.stringExample == nil {
+			return 0, fmt.Errorf("field \"stringExample\" is required")
+		}
+		size = 41
+		n, err = u.stringExample.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "set":
+		if u.set == nil {
+			return 0, fmt.Errorf("field \"set\" is required")
+		}
+		size = 21
+		size += 2
+		if len(*u.set) > 1 {
+			size += len(*u.set) - 1
+		}
+		for _, v := range *u.set {
+			size += jsonStringSize(v)
+		}
+	case "thisFieldIsAnInteger":
+		if u.thisFieldIsAnInteger == nil {
+			return 0, fmt.Errorf("field \"thisFieldIsAnInteger\" is required")
+		}
+		size = 55
+		size += jsonIntSize(int64(*u.thisFieldIsAnInteger))
+	case "alsoAnInteger":
+		if u.alsoAnInteger == nil {
+			return 0, fmt.Errorf("field \"alsoAnInteger\" is required")
+		}
+		size = 41
+		size += jsonIntSize(int64(*u.alsoAnInteger))
+	case "if":
+		if u.if_ == nil {
+			return 0, fmt.Errorf("field \"if\" is required")
+		}
+		size = 19
+		size += jsonIntSize(int64(*u.if_))
+	case "new":
+		if u.new == nil {
+			return 0, fmt.Errorf("field \"new\" is required")
+		}
+		size = 21
+		size += jsonIntSize(int64(*u.new))
+	case "interface":
+		if u.interface_ == nil {
+			return 0, fmt.Errorf("field \"interface\" is required")
+		}
+		size = 33
+		size += jsonIntSize(int64(*u.interface_))
 	}
-	return safejson.Marshal(ser)
+	return size, nil
+}
+
+func (u Union) MarshalJSON() ([]byte, error) {
+	return u.AppendJSON(nil)
 }
 
 func (u *Union) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Union
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*u = v
+			return nil
+		}
+	}
 	var deser unionDeserializer
 	if err := safejson.Unmarshal(data, &deser); err != nil {
 		return err
@@ -147,6 +214,330 @@ func (u *Union) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (u *Union) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenType, seenStringExample, seenSet, seenThisFieldIsAnInteger, seenAlsoAnInteger, seenIf, seenNew, seenInterface bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !unmarshalJSONString(field, &u.typ) {
+				ok = false
+				return false
+			}
+		case "stringExample":
+			if seenStringExample {
+				ok = false
+				return false
+			}
+			seenStringExample = true
+			if field.Type != gjson.Null {
+				var v StringExample
+				if !v.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.stringExample = &v
+			}
+		case "set":
+			if seenSet {
+				ok = false
+				return false
+			}
+			seenSet = true
+			if field.Type != gjson.Null {
+				var v1 []string
+				if field.Type != gjson.Null {
+					if !field.IsArray() {
+						ok = false
+						return false
+					}
+					v1 = make([]string, 0)
+					ok1 := true
+					field.ForEach(func(_, elem gjson.Result) bool {
+						var v2 string
+						if !unmarshalJSONString(elem, &v2) {
+							ok1 = false
+							return false
+						}
+						v1 = append(v1, v2)
+						return true
+					})
+					if !ok1 {
+						ok = false
+						return false
+					}
+				}
+				u.set = &v1
+			}
+		case "thisFieldIsAnInteger":
+			if seenThisFieldIsAnInteger {
+				ok = false
+				return false
+			}
+			seenThisFieldIsAnInteger = true
+			if field.Type != gjson.Null {
+				var v3 int
+				if !unmarshalJSONInt(field, &v3) {
+					ok = false
+					return false
+				}
+				u.thisFieldIsAnInteger = &v3
+			}
+		case "alsoAnInteger":
+			if seenAlsoAnInteger {
+				ok = false
+				return false
+			}
+			seenAlsoAnInteger = true
+			if field.Type != gjson.Null {
+				var v4 int
+				if !unmarshalJSONInt(field, &v4) {
+					ok = false
+					return false
+				}
+				u.alsoAnInteger = &v4
+			}
+		case "if":
+			if seenIf {
+				ok = false
+				return false
+			}
+			seenIf = true
+			if field.Type != gjson.Null {
+				var v5 int
+				if !unmarshalJSONInt(field, &v5) {
+					ok = false
+					return false
+				}
+				u.if_ = &v5
+			}
+		case "new":
+			if seenNew {
+				ok = false
+				return false
+			}
+			seenNew = true
+			if field.Type != gjson.Null {
+				var v6 int
+				if !unmarshalJSONInt(field, &v6) {
+					ok = false
+					return false
+				}
+				u.new = &v6
+			}
+		case "interface":
+			if seenInterface {
+				ok = false
+				return false
+			}
+			seenInterface = true
+			if field.Type != gjson.Null {
+				var v7 int
+				if !unmarshalJSONInt(field, &v7) {
+					ok = false
+					return false
+				}
+				u.interface_ = &v7
+			}
+		default:
+			if matchesJSONField(key.Str, "type", "stringExample", "set", "thisFieldIsAnInteger", "alsoAnInteger", "if", "new", "interface") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	switch u.typ {
+	case "stringExample":
+		if u.stringExample == nil {
+			return false
+		}
+	case "set":
+		if u.set == nil {
+			return false
+		}
+	case "thisFieldIsAnInteger":
+		if u.thisFieldIsAnInteger == nil {
+			return false
+		}
+	case "alsoAnInteger":
+		if u.alsoAnInteger == nil {
+			return false
+		}
+	case "if":
+		if u.if_ == nil {
+			return false
+		}
+	case "new":
+		if u.new == nil {
+			return false
+		}
+	case "interface":
+		if u.interface_ == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *Union) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return u.decodeJSONStrict(value)
+}
+
+func (u *Union) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	var typ string
+	var variantName string
+	var variant gjson.Result
+	var seenType, seenVariant bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch {
+		case key.Str == "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			typ, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"type\": %w", err)
+				return false
+			}
+			return true
+		case seenVariant && key.Str == variantName:
+			err = fmt.Errorf("duplicate field %q", key.Str)
+			return false
+		case seenVariant:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		variantName, variant, seenVariant = key.Str, field, true
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenType {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	if seenVariant && variantName != typ {
+		return fmt.Errorf("unknown field %q", variantName)
+	}
+	*u = Union{typ: typ}
+	switch typ {
+	case "stringExample":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"stringExample\" is required")
+		}
+		var v StringExample
+		err = v.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"stringExample\": %w", err)
+		}
+		u.stringExample = &v
+	case "set":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"set\" is required")
+		}
+		var v1 []string
+		if !variant.IsArray() {
+			return fmt.Errorf("field \"set\": %w", jsonTypeError(variant, "array"))
+		}
+		v1 = make([]string, 0)
+		seen := make(map[string]struct{})
+		variant.ForEach(func(_, elem gjson.Result) bool {
+			var v2 string
+			v2, err = decodeJSONString(elem)
+			if err != nil {
+				return false
+			}
+			if _, ok := seen[v2]; ok {
+				err = fmt.Errorf("duplicate set element %s", elem.Raw)
+				return false
+			}
+			seen[v2] = struct{}{}
+			v1 = append(v1, v2)
+			return true
+		})
+		if err != nil {
+			return fmt.Errorf("field \"set\": %w", err)
+		}
+		u.set = &v1
+	case "thisFieldIsAnInteger":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"thisFieldIsAnInteger\" is required")
+		}
+		var v3 int
+		v3, err = decodeJSONInt(variant)
+		if err != nil {
+			return fmt.Errorf("field \"thisFieldIsAnInteger\": %w", err)
+		}
+		u.thisFieldIsAnInteger = &v3
+	case "alsoAnInteger":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"alsoAnInteger\" is required")
+		}
+		var v4 int
+		v4, err = decodeJSONInt(variant)
+		if err != nil {
+			return fmt.Errorf("field \"alsoAnInteger\": %w", err)
+		}
+		u.alsoAnInteger = &v4
+	case "if":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"if\" is required")
+		}
+		var v5 int
+		v5, err = decodeJSONInt(variant)
+		if err != nil {
+			return fmt.Errorf("field \"if\": %w", err)
+		}
+		u.if_ = &v5
+	case "new":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"new\" is required")
+		}
+		var v6 int
+		v6, err = decodeJSONInt(variant)
+		if err != nil {
+			return fmt.Errorf("field \"new\": %w", err)
+		}
+		u.new = &v6
+	case "interface":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"interface\" is required")
+		}
+		var v7 int
+		v7, err = decodeJSONInt(variant)
+		if err != nil {
+			return fmt.Errorf("field \"interface\": %w", err)
+		}
+		u.interface_ = &v7
+	}
+	return nil
+}
+
 func (u Union) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(u)
 	if err != nil {
@@ -302,3 +693,98 @@ func NewUnionFromNew(v int) Union {
 func NewUnionFromInterface(v int) Union {
 	return Union{typ: "interface", interface_: &v}
 }
+
+// Equal returns true if the Union is equal to other according to the Conjure semantics of its values.
+func (u Union) Equal(other Union) bool {
+	if u.typ != other.typ {
+		return false
+	}
+	if (u.stringExample == nil) != (other.stringExample == nil) {
+		return false
+	}
+	if u.stringExample != nil {
+		if !(*u.stringExample).Equal(*other.stringExample) {
+			return false
+		}
+	}
+	if (u.set == nil) != (other.set == nil) {
+		return false
+	}
+	if u.set != nil {
+		v, otherV := *u.set, *other.set
+		if !func() bool {
+			if len(v) != len(otherV) {
+				return false
+			}
+			counts1 := make(map[string]int, len(v))
+			for _, v1 := range v {
+				counts1[v1]++
+			}
+			for _, v1 := range otherV {
+				if counts1[v1] == 0 {
+					return false
+				}
+				counts1[v1]--
+			}
+			return true
+		}() {
+			return false
+		}
+	}
+	if (u.thisFieldIsAnInteger == nil) != (other.thisFieldIsAnInteger == nil) {
+		return false
+	}
+	if u.thisFieldIsAnInteger != nil {
+		if *u.thisFieldIsAnInteger != *other.thisFieldIsAnInteger {
+			return false
+		}
+	}
+	if (u.alsoAnInteger == nil) != (other.alsoAnInteger == nil) {
+		return false
+	}
+	if u.alsoAnInteger != nil {
+		if *u.alsoAnInteger != *other.alsoAnInteger {
+			return false
+		}
+	}
+	if (u.if_ == nil) != (other.if_ == nil) {
+		return false
+	}
+	if u.if_ != nil {
+		if *u.if_ != *other.if_ {
+			return false
+		}
+	}
+	if (u.new == nil) != (other.new == nil) {
+		return false
+	}
+	if u.new != nil {
+		if *u.new != *other.new {
+			return false
+		}
+	}
+	if (u.interface_ == nil) != (other.interface_ == nil) {
+		return false
+	}
+	if u.interface_ != nil {
+		if *u.interface_ != *other.interface_ {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Union.
+func (u Union) Clone() Union {
+	out := u
+	if u.set != nil {
+		elems := *u.set
+		var v []string
+		if elems != nil {
+			v = make([]string, len(elems))
+			copy(v, elems)
+		}
+		out.set = &v
+	}
+	return out
+}
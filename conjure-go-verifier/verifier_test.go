@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -28,6 +29,7 @@ import (
 
 	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
 	"github.com/palantir/conjure-go/v6/conjure-go-verifier/conjure/verification/server"
+	"github.com/palantir/conjure-go/v6/conjure-go-verifier/conjure/verification/types"
 	"github.com/palantir/conjure-go/v6/conjure/transforms"
 	"github.com/palantir/pkg/httpserver"
 	"github.com/pkg/errors"
@@ -200,6 +202,98 @@ func TestAutoDeserialize(t *testing.T) {
 	}
 }
 
+// TestAutoDeserializeStrict verifies that UnmarshalJSONStrict, which generated servers use to decode request bodies,
+// accepts the positive and rejects the negative auto-deserialize test cases. Unlike the generated clients, strict
+// decoding is expected to handle every test case correctly, so ignored-test-cases.yml does not apply.
+func TestAutoDeserializeStrict(t *testing.T) {
+	ctx := context.Background()
+	client := server.NewAutoDeserializeServiceClient(newHTTPClient(t, serverURI))
+	confirmClient := server.NewAutoDeserializeConfirmServiceClient(newHTTPClient(t, serverURI))
+
+	for endpointName, posAndNegTestCases := range testDefinitions.Client.AutoDeserialize {
+		// bodies are decoded into the type returned by the client, which is the type of the body received by the
+		// confirmation service. Binary aliases are returned as streams, so they are decoded as the alias type.
+		resultType := reflect.ValueOf(client).MethodByName(transforms.Export(string(endpointName))).Type().Out(0)
+		if resultType == reflect.TypeOf((*io.ReadCloser)(nil)).Elem() {
+			resultType = reflect.TypeOf(types.BinaryAliasExample(nil))
+		}
+		// indices are assigned as in TestAutoDeserialize.
+		i := 0
+		for _, casesAndType := range []struct {
+			cases    []string
+			positive bool
+		}{
+			{posAndNegTestCases.Positive, true},
+			{posAndNegTestCases.Negative, false},
+		} {
+			prefix := endpointName
+			if casesAndType.positive {
+				prefix += " pos"
+			} else {
+				prefix += " neg"
+			}
+			for _, val := range casesAndType.cases {
+				t.Run(fmt.Sprintf("%s %d", prefix, i), func(t *testing.T) {
+					result, err := unmarshalTestCaseStrict(endpointName, i, resultType)
+					ok := err == nil
+					if got, want := behaviors[ok], behaviors[casesAndType.positive]; got != want {
+						t.Errorf("%v %d incorrectly %s: input=%v result=%v err=%v", endpointName, i, got, val, result, err)
+					}
+					// if this is a positive case, send it to the confirmation endpoint to verify round-tripping
+					if ok && casesAndType.positive {
+						body, err := marshalTestCase(result)
+						require.NoError(t, err)
+						if err := confirmClient.Confirm(ctx, endpointName, i, body); err != nil {
+							t.Errorf("%v %d confirmation failed: input=%v result=%v err=%v", endpointName, i, val, result, err.Error())
+						}
+					}
+					i++
+				})
+			}
+		}
+	}
+}
+
+// marshalTestCase returns the body that confirms a test case decoded by unmarshalTestCaseStrict. Values that have an
+// AppendJSON method are encoded using it, which encodes non-finite doubles as the Conjure spec requires even for objects
+// that encoding/json encodes using reflection; other values are encoded by the client.
+func marshalTestCase(result interface{}) (interface{}, error) {
+	appender, ok := result.(interface{ AppendJSON([]byte) ([]byte, error) })
+	if !ok {
+		return result, nil
+	}
+	out, err := appender.AppendJSON(nil)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(out), nil
+}
+
+// unmarshalTestCaseStrict fetches the body of a test case from the verification server and decodes it into a new value
+// of resultType using UnmarshalJSONStrict.
+func unmarshalTestCaseStrict(endpointName server.EndpointName, i int, resultType reflect.Type) (interface{}, error) {
+	resp, err := http.Get(fmt.Sprintf("%s/body/%s/%d", serverURI, endpointName, i))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	result := reflect.New(resultType)
+	unmarshaler, ok := result.Interface().(interface{ UnmarshalJSONStrict([]byte) error })
+	if !ok {
+		return nil, errors.Errorf("%v does not implement UnmarshalJSONStrict", resultType)
+	}
+	if err := unmarshaler.UnmarshalJSONStrict(body); err != nil {
+		return nil, err
+	}
+	return result.Elem().Interface(), nil
+}
+
 func TestSingleHeader(t *testing.T) {
 	testSingleArg(t, server.NewSingleHeaderServiceClient(newHTTPClient(t, serverURI)), testDefinitions.Client.SingleHeaderService, ignoredTestCases.Client.SingleHeaderService)
 }
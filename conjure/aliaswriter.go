@@ -27,15 +27,15 @@ const (
 
 func aliasDotValue() *jen.Statement { return jen.Id(aliasReceiverName).Dot(aliasValueFieldName) }
 
-func writeAliasType(file *jen.Group, aliasDef *types.AliasType) {
+func writeAliasType(file *jen.Group, aliasDef *types.AliasType, jw *jsonWriter) {
 	if aliasDef.IsOptional() {
-		writeOptionalAliasType(file, aliasDef)
+		writeOptionalAliasType(file, aliasDef, jw)
 	} else {
-		writeNonOptionalAliasType(file, aliasDef)
+		writeNonOptionalAliasType(file, aliasDef, jw)
 	}
 }
 
-func writeOptionalAliasType(file *jen.Group, aliasDef *types.AliasType) {
+func writeOptionalAliasType(file *jen.Group, aliasDef *types.AliasType, jw *jsonWriter) {
 	typeName := aliasDef.Name
 	// Define the type
 	file.Add(aliasDef.Docs.CommentLine()).Type().Id(typeName).Struct(
@@ -53,7 +53,7 @@ func writeOptionalAliasType(file *jen.Group, aliasDef *types.AliasType) {
 	}
 
 	// Even TextMarshalers need MarshalJSON to emit 'null' in empty case.
-	file.Add(astForAliasOptionalJSONMarshal(typeName))
+	jw.writeAliasMethods(file, aliasDef)
 
 	// Unmarshal Method(s)
 	valueInit := aliasDef.Make()
@@ -70,17 +70,20 @@ func writeOptionalAliasType(file *jen.Group, aliasDef *types.AliasType) {
 		file.Add(astForAliasOptionalJSONUnmarshal(typeName, valueInit))
 	}
 
+	jw.writeAliasDecodeMethods(file, aliasDef)
+
 	file.Add(snip.MethodMarshalYAML(aliasReceiverName, aliasDef.Name))
 	file.Add(snip.MethodUnmarshalYAML(aliasReceiverName, aliasDef.Name))
 }
 
-func writeNonOptionalAliasType(file *jen.Group, aliasDef *types.AliasType) {
+func writeNonOptionalAliasType(file *jen.Group, aliasDef *types.AliasType, jw *jsonWriter) {
 	typeName := aliasDef.Name
 	// Define the type
 	file.Add(aliasDef.Docs.CommentLine()).Type().Id(typeName).Add(aliasDef.Item.Code())
 
 	if !isSimpleAliasType(aliasDef.Item) {
-		// Everything else gets MarshalJSON/UnmarshalJSON that delegate to the aliased type
+		// Everything else gets generated JSON encoding methods and an UnmarshalJSON that delegates to the aliased type
+		jw.writeAliasMethods(file, aliasDef)
 		if _, isBinary := aliasDef.Item.(types.Binary); isBinary {
 			file.Add(astForAliasString(typeName, snip.BinaryNew()))
 			file.Add(astForAliasTextMarshal(typeName, snip.BinaryNew()))
@@ -91,23 +94,25 @@ func writeNonOptionalAliasType(file *jen.Group, aliasDef *types.AliasType) {
 			file.Add(astForAliasTextMarshal(typeName, aliasDef.Item.Code()))
 			file.Add(astForAliasTextUnmarshal(typeName, aliasDef.Item.Code()))
 		} else {
-			// By default, we delegate json/yaml encoding to the aliased type.
-			file.Add(astForAliasJSONMarshal(typeName, aliasDef.Item.Code()))
+			// By default, we delegate json/yaml decoding to the aliased type.
 			file.Add(astForAliasJSONUnmarshal(typeName, aliasDef.Item.Code()))
 		}
 
 		file.Add(snip.MethodMarshalYAML(aliasReceiverName, aliasDef.Name))
 		file.Add(snip.MethodUnmarshalYAML(aliasReceiverName, aliasDef.Name))
 	}
+	jw.writeAliasDecodeMethods(file, aliasDef)
 }
 
 func isSimpleAliasType(t types.Type) bool {
 	switch v := t.(type) {
-	case types.Any, types.Boolean, types.Double, types.Integer, types.String:
+	case types.Any, types.Boolean, types.Integer, types.String:
 		// Plain builtins do not need encoding methods; do nothing.
 		return true
 	case *types.List:
 		return isSimpleAliasType(v.Item)
+	case *types.Set:
+		return v.Name == "" && isSimpleAliasType(v.Item)
 	case *types.Map:
 		return isSimpleAliasType(v.Key) && isSimpleAliasType(v.Val)
 	case *types.Optional:
@@ -121,6 +126,24 @@ func isSimpleAliasType(t types.Type) bool {
 	}
 }
 
+// aliasUnmarshalMethod returns the name of the method that encoding/json uses to decode values of the alias, which is
+// "UnmarshalJSON", "UnmarshalText" or "" if the alias does not have unmarshal methods.
+func aliasUnmarshalMethod(aliasDef *types.AliasType) string {
+	switch {
+	case aliasDef.IsOptional():
+		if aliasDef.IsText() {
+			return "UnmarshalText"
+		}
+		return "UnmarshalJSON"
+	case isSimpleAliasType(aliasDef.Item):
+		return ""
+	case aliasDef.IsText():
+		return "UnmarshalText"
+	default:
+		return "UnmarshalJSON"
+	}
+}
+
 func astForAliasString(typeName string, aliasGoType *jen.Statement) *jen.Statement {
 	return snip.MethodString(aliasReceiverName, typeName).Block(
 		jen.Return(aliasGoType.Call(jen.Id(aliasReceiverName)).Dot("String").Call()),
@@ -213,21 +236,6 @@ func astForAliasOptionalBinaryTextUnmarshal(typeName string) *jen.Statement {
 	)
 }
 
-func astForAliasJSONMarshal(typeName string, aliasGoType *jen.Statement) *jen.Statement {
-	return snip.MethodMarshalJSON(aliasReceiverName, typeName).Block(
-		jen.Return(snip.SafeJSONMarshal().Call(aliasGoType.Call(jen.Id(aliasReceiverName)))),
-	)
-}
-
-func astForAliasOptionalJSONMarshal(typeName string) *jen.Statement {
-	return snip.MethodMarshalJSON(aliasReceiverName, typeName).Block(
-		jen.If(aliasDotValue().Op("==").Nil()).Block(
-			jen.Return(jen.Op("[]").Byte().Call(jen.Lit("null")), jen.Nil()),
-		),
-		jen.Return(snip.SafeJSONMarshal().Call(aliasDotValue())),
-	)
-}
-
 func astForAliasJSONUnmarshal(typeName string, aliasGoType *jen.Statement) *jen.Statement {
 	rawVarName := "raw" + typeName
 	return snip.MethodUnmarshalJSON(aliasReceiverName, typeName).Block(
@@ -130,20 +130,10 @@ func TestAliasWriter(t *testing.T) {
 }`,
 		},
 		{
-			Name: "astForAliasJSONMarshal",
-			In:   astForAliasJSONMarshal("Foo", types.DateTime{}.Code()),
+			Name: "astForMarshalJSON",
+			In:   astForMarshalJSON(aliasReceiverName, "Foo"),
 			Out: `func (a Foo) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal(datetime.DateTime(a))
-}`,
-		},
-		{
-			Name: "astForAliasOptionalJSONMarshal",
-			In:   astForAliasOptionalJSONMarshal("Foo"),
-			Out: `func (a Foo) MarshalJSON() ([]byte, error) {
-	if a.Value == nil {
-		return []byte("null"), nil
-	}
-	return safejson.Marshal(a.Value)
+	return a.AppendJSON(nil)
 }`,
 		},
 		{
@@ -0,0 +1,1444 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/palantir/conjure-go/v6/conjure/snip"
+	"github.com/palantir/conjure-go/v6/conjure/transforms"
+	"github.com/palantir/conjure-go/v6/conjure/types"
+)
+
+const (
+	cborReaderVarName = "r"
+	cborKeyVarName    = "key"
+	cborValueVarName  = "value"
+	cborDecodeMethod  = "decodeCBOR"
+
+	appendCBORHeadFunc    = "appendCBORHead"
+	appendCBORStringFunc  = "appendCBORString"
+	appendCBORBytesFunc   = "appendCBORBytes"
+	appendCBORIntFunc     = "appendCBORInt"
+	appendCBORFloat64Func = "appendCBORFloat64"
+	appendCBORBoolFunc    = "appendCBORBool"
+	appendCBORAnyFunc     = "appendCBORAny"
+	cborTypeErrorFunc     = "cborTypeError"
+	cborIntegerFunc       = "cborInteger"
+	cborFloatFunc         = "cborFloat"
+	cborReaderType        = "cborReader"
+	cborContentTypeConst  = "cborContentType"
+	cborCodecType         = "cborCodec"
+	isCBORRequestFunc     = "isCBORRequest"
+	acceptsCBORFunc       = "acceptsCBOR"
+
+	// methods of cborReader that are only declared if they are used
+	cborDecodeStringMethod      = "decodeString"
+	cborDecodeBearerTokenMethod = "decodeBearerToken"
+	cborDecodeIntMethod         = "decodeInt"
+	cborDecodeSafeLongMethod    = "decodeSafeLong"
+	cborDecodeFloat64Method     = "decodeFloat64"
+	cborDecodeBoolMethod        = "decodeBool"
+	cborDecodeUUIDMethod        = "decodeUUID"
+	cborDecodeDateTimeMethod    = "decodeDateTime"
+	cborDecodeRIDMethod         = "decodeRID"
+	cborDecodeBinaryMethod      = "decodeBinary"
+	cborReadAnyMethod           = "readAny"
+	cborDecodeAnyMethod         = "decodeAny"
+	cborDecodeJSONMethod        = "decodeJSON"
+	cborDecodeUnmarshalerMethod = "decodeUnmarshaler"
+
+	cborMajorUnsigned = 0
+	cborMajorBytes    = 2
+	cborMajorText     = 3
+	cborMajorArray    = 4
+	cborMajorMap      = 5
+
+	// cborNull is the encoding of null.
+	cborNull = "\xf6"
+)
+
+// cborWriter writes the CBOR methods of the types of a package: AppendCBOR, MarshalCBOR and UnmarshalCBOR. Values are
+// encoded with the same structure as their JSON encoding: objects are maps from field names to values, unions are maps
+// with a "type" entry, enums, uuids, datetimes and rids are text strings, binary values are byte strings and doubles
+// are always encoded as double-precision floats. Values of types without CBOR methods, such as the types of external
+// packages and any, are converted to CBOR using their JSON encoding. Like jsonWriter, the writer records the helpers
+// used by the methods so that writeHelpers only declares the helpers that the package needs.
+type cborWriter struct {
+	*cborTypes
+	// local contains the named types of the package that is being written.
+	local   map[types.Type]struct{}
+	helpers map[string]struct{}
+}
+
+func newCBORWriter(cborTypes *cborTypes, pkg types.ConjurePackage) *cborWriter {
+	local := map[types.Type]struct{}{}
+	for _, alias := range pkg.Aliases {
+		local[alias] = struct{}{}
+	}
+	for _, enum := range pkg.Enums {
+		local[enum] = struct{}{}
+	}
+	for _, object := range pkg.Objects {
+		local[object] = struct{}{}
+	}
+	for _, union := range pkg.Unions {
+		local[union] = struct{}{}
+	}
+	return &cborWriter{
+		cborTypes: cborTypes,
+		local:     local,
+		helpers:   map[string]struct{}{},
+	}
+}
+
+// cborTypes records the named types of a Conjure definition that are generated with CBOR methods, which are the
+// aliases, enums, objects and unions of the packages that are generated with GenerateCBOR.
+type cborTypes struct {
+	encoded map[types.Type]struct{}
+}
+
+func newCBORTypes(def *types.ConjureDefinition, cfg OutputConfiguration) *cborTypes {
+	t := &cborTypes{encoded: map[types.Type]struct{}{}}
+	for _, pkg := range def.Packages {
+		if pkg.External || !cfg.ForPackage(pkg.ConjurePackage).GenerateCBOR {
+			continue
+		}
+		for _, alias := range pkg.Aliases {
+			if !isInterfaceAliasType(alias) {
+				t.encoded[alias] = struct{}{}
+			}
+		}
+		for _, enum := range pkg.Enums {
+			t.encoded[enum] = struct{}{}
+		}
+		for _, object := range pkg.Objects {
+			t.encoded[object] = struct{}{}
+		}
+		for _, union := range pkg.Unions {
+			t.encoded[union] = struct{}{}
+		}
+	}
+	return t
+}
+
+// hasMethods returns true if typ is a named type that is generated with CBOR methods. Named set types are declared in
+// the package that uses them, so they have CBOR methods whenever the writer is used.
+func (w *cborWriter) hasMethods(typ types.Type) bool {
+	if isNamedSet(typ) {
+		return true
+	}
+	_, ok := w.encoded[typ]
+	return ok
+}
+
+// isLocal returns true if typ is a named type of the package of the writer, whose unexported decodeCBOR method can be
+// called directly.
+func (w *cborWriter) isLocal(typ types.Type) bool {
+	if isNamedSet(typ) {
+		return true
+	}
+	_, ok := w.local[typ]
+	return ok
+}
+
+// negotiates returns true if request or response bodies of type typ are encoded as CBOR when the client and server
+// agree to do so. Returns false for a nil writer, which is used for packages that are generated without CBOR support.
+func (w *cborWriter) negotiates(typ types.Type) bool {
+	return w != nil && !typ.IsBinary() && w.hasMethods(typ)
+}
+
+// negotiatesEndpoint returns true if the request or response body of endpointDef negotiates its encoding.
+func (w *cborWriter) negotiatesEndpoint(endpointDef *types.EndpointDefinition) bool {
+	if body := endpointDef.BodyParam(); body != nil && w.negotiates(body.Type) {
+		return true
+	}
+	return endpointDef.Returns != nil && w.negotiates(*endpointDef.Returns)
+}
+
+// negotiatesService returns true if any endpoint of serviceDef negotiates the encoding of its bodies.
+func (w *cborWriter) negotiatesService(serviceDef *types.ServiceDefinition) bool {
+	for _, endpointDef := range serviceDef.Endpoints {
+		if w.negotiatesEndpoint(endpointDef) {
+			return true
+		}
+	}
+	return false
+}
+
+// helper returns the identifier of the helper with the provided name and records that it is used.
+func (w *cborWriter) helper(name string) string {
+	w.helpers[name] = struct{}{}
+	return name
+}
+
+func (w *cborWriter) writeObjectMethods(file *jen.Group, objectDef *types.ObjectType) {
+	w.writeAppendMethod(file, objReceiverName, objectDef.Name, func(m *cborMethod, body *jen.Group) {
+		literal := cborHead(cborMajorMap, len(objectDef.Fields))
+		for _, fieldDef := range objectDef.Fields {
+			m.appendLiteral(body, literal+cborText(fieldDef.Name))
+			literal = ""
+			m.value(body, jen.Id(objReceiverName).Dot(transforms.ExportedFieldName(fieldDef.Name)), fieldDef.Type, 0)
+		}
+		if literal != "" {
+			m.appendLiteral(body, literal)
+		}
+	})
+	w.writeDecodeMethod(file, objReceiverName, objectDef.Name, func(body *jen.Group) {
+		body.Op("*").Id(objReceiverName).Op("=").Id(objectDef.Name).Values()
+		if len(objectDef.Fields) == 0 {
+			body.Return(w.decodeEntries(func(g *jen.Group) {
+				g.Add(w.ifErr(w.reader().Dot("skip").Call()))
+				g.Return(w.reader().Dot("skip").Call())
+			}))
+			return
+		}
+		decodeFields := w.decodeEntries(func(g *jen.Group) {
+			g.Var().Id(cborKeyVarName).String()
+			g.Add(w.ifErr(w.reader().Dot(w.helper(cborDecodeStringMethod)).Call(jen.Op("&").Id(cborKeyVarName))))
+			g.Switch(jen.Id(cborKeyVarName)).BlockFunc(func(cases *jen.Group) {
+				for _, fieldDef := range objectDef.Fields {
+					cases.Case(jen.Lit(fieldDef.Name)).BlockFunc(func(g *jen.Group) {
+						w.decodeValue(g, jen.Id(objReceiverName).Dot(transforms.ExportedFieldName(fieldDef.Name)), fieldDef.Type, 0)
+					})
+				}
+				cases.Default().Block(jen.Return(w.reader().Dot("skip").Call()))
+			})
+			g.Return(jen.Nil())
+		})
+		if !hasCollectionField(objectDef.Fields) {
+			body.Return(decodeFields)
+			return
+		}
+		body.Add(w.ifErr(decodeFields))
+		writeStructMarshalInitDecls(body, objectDef.Fields, objReceiverName)
+		body.Return(jen.Nil())
+	})
+	w.writeMarshalMethods(file, objReceiverName, objectDef.Name)
+}
+
+func (w *cborWriter) writeUnionMethods(file *jen.Group, unionDef *types.UnionType) {
+	unknownType := jen.Return(jen.Nil(), snip.FmtErrorf().Call(jen.Lit("unknown type %q"), jen.Id(unionReceiverName).Dot("typ")))
+	if len(unionDef.Fields) == 0 {
+		file.Add(snip.MethodAppendCBOR(unionReceiverName, unionDef.Name).Block(unknownType))
+	} else {
+		w.writeAppendMethod(file, unionReceiverName, unionDef.Name, func(m *cborMethod, body *jen.Group) {
+			body.Switch(jen.Id(unionReceiverName).Dot("typ")).BlockFunc(func(cases *jen.Group) {
+				cases.Default().Block(unknownType.Clone())
+				for _, fieldDef := range unionDef.Fields {
+					cases.Case(jen.Lit(fieldDef.Name)).BlockFunc(func(caseBody *jen.Group) {
+						privateName := transforms.PrivateFieldName(fieldDef.Name)
+						selector := jen.Id(unionReceiverName).Dot(privateName)
+						if fieldDef.Type.IsOptional() {
+							// the variant holds a pointer to an optional; nil is encoded as null
+							caseBody.Var().Id(privateName).Add(fieldDef.Type.Code())
+							caseBody.If(selector.Clone().Op("!=").Nil()).Block(
+								jen.Id(privateName).Op("=").Op("*").Add(selector.Clone()),
+							)
+							selector = jen.Id(privateName)
+						} else {
+							caseBody.If(selector.Clone().Op("==").Nil()).Block(
+								jen.Return(jen.Nil(), snip.FmtErrorf().Call(jen.Lit(fmt.Sprintf("field %q is required", fieldDef.Name)))),
+							)
+							selector = m.deref(selector, fieldDef.Type)
+						}
+						m.appendLiteral(caseBody, cborHead(cborMajorMap, 2)+cborText("type")+cborText(fieldDef.Name)+cborText(fieldDef.Name))
+						m.value(caseBody, selector, fieldDef.Type, 0)
+					})
+				}
+			})
+		})
+	}
+	w.writeDecodeMethod(file, unionReceiverName, unionDef.Name, func(body *jen.Group) {
+		body.Op("*").Id(unionReceiverName).Op("=").Id(unionDef.Name).Values()
+		body.Add(w.ifErr(w.decodeEntries(func(g *jen.Group) {
+			g.Var().Id(cborKeyVarName).String()
+			g.Add(w.ifErr(w.reader().Dot(w.helper(cborDecodeStringMethod)).Call(jen.Op("&").Id(cborKeyVarName))))
+			g.Switch(jen.Id(cborKeyVarName)).BlockFunc(func(cases *jen.Group) {
+				cases.Case(jen.Lit("type")).Block(
+					w.ifErr(w.reader().Dot(cborDecodeStringMethod).Call(jen.Op("&").Id(unionReceiverName).Dot("typ"))),
+				)
+				for _, fieldDef := range unionDef.Fields {
+					cases.Case(jen.Lit(fieldDef.Name)).BlockFunc(func(g *jen.Group) {
+						g.Var().Id(cborValueVarName).Add(fieldDef.Type.Code())
+						w.decodeValue(g, jen.Id(cborValueVarName), fieldDef.Type, 0)
+						g.Id(unionReceiverName).Dot(transforms.PrivateFieldName(fieldDef.Name)).Op("=").Op("&").Id(cborValueVarName)
+					})
+				}
+				cases.Default().Block(jen.Return(w.reader().Dot("skip").Call()))
+			})
+			g.Return(jen.Nil())
+		})))
+		body.If(jen.Id(unionReceiverName).Dot("typ").Op("==").Lit("")).Block(
+			jen.Return(snip.FmtErrorf().Call(jen.Lit(`field "type" is required`))),
+		)
+		body.Return(jen.Nil())
+	})
+	w.writeMarshalMethods(file, unionReceiverName, unionDef.Name)
+}
+
+func (w *cborWriter) writeEnumMethods(file *jen.Group, enumDef *types.EnumType) {
+	file.Add(snip.MethodAppendCBOR(enumReceiverName, enumDef.Name).Block(
+		jen.Return(jen.Id(w.helper(appendCBORStringFunc)).Call(jen.Id(jsonOutVarName), jen.String().Call(jen.Id(enumReceiverName).Dot(enumStructFieldName))), jen.Nil()),
+	))
+	w.writeDecodeMethod(file, enumReceiverName, enumDef.Name, func(body *jen.Group) {
+		body.Var().Id(cborValueVarName).String()
+		body.Add(w.ifErr(w.reader().Dot(w.helper(cborDecodeStringMethod)).Call(jen.Op("&").Id(cborValueVarName))))
+		body.Return(jen.Id(enumReceiverName).Dot("UnmarshalText").Call(jen.Index().Byte().Call(jen.Id(cborValueVarName))))
+	})
+	w.writeMarshalMethods(file, enumReceiverName, enumDef.Name)
+}
+
+func (w *cborWriter) writeAliasMethods(file *jen.Group, aliasDef *types.AliasType) {
+	if isInterfaceAliasType(aliasDef) {
+		return
+	}
+	w.writeAppendMethod(file, aliasReceiverName, aliasDef.Name, func(m *cborMethod, body *jen.Group) {
+		if aliasDef.IsOptional() {
+			m.value(body, aliasDotValue(), aliasDef.Item, 0)
+		} else {
+			m.value(body, aliasDef.Item.Code().Call(jen.Id(aliasReceiverName)), aliasDef.Item, 0)
+		}
+	})
+	w.writeDecodeMethod(file, aliasReceiverName, aliasDef.Name, func(body *jen.Group) {
+		if aliasDef.IsOptional() {
+			body.Op("*").Id(aliasReceiverName).Op("=").Id(aliasDef.Name).Values()
+			w.decodeValue(body, aliasDotValue(), aliasDef.Item, 0)
+		} else {
+			body.Var().Id(cborValueVarName).Add(aliasDef.Item.Code())
+			w.decodeValue(body, jen.Id(cborValueVarName), aliasDef.Item, 0)
+			body.Op("*").Id(aliasReceiverName).Op("=").Id(aliasDef.Name).Call(jen.Id(cborValueVarName))
+		}
+		body.Return(jen.Nil())
+	})
+	w.writeMarshalMethods(file, aliasReceiverName, aliasDef.Name)
+}
+
+// writeSetMethods writes the CBOR methods of a named set type, which is encoded as an array of its elements in
+// ascending order.
+func (w *cborWriter) writeSetMethods(file *jen.Group, setDef *types.Set) {
+	w.writeAppendMethod(file, setReceiverName, setDef.Name, func(m *cborMethod, body *jen.Group) {
+		m.add(body, jen.Id(w.helper(appendCBORHeadFunc)).Call(jen.Id(jsonOutVarName), jen.Lit(cborMajorArray), jen.Uint64().Call(jen.Len(jen.Id(setReceiverName)))))
+		body.For(jen.List(jen.Id("_"), jen.Id(setElemVarName)).Op(":=").Range().Id(setReceiverName).Dot("Sorted").Call()).BlockFunc(func(loop *jen.Group) {
+			m.value(loop, jen.Id(setElemVarName), setDef.Item, 1)
+		})
+	})
+	w.writeDecodeMethod(file, setReceiverName, setDef.Name, func(body *jen.Group) {
+		body.Op("*").Id(setReceiverName).Op("=").Make(jen.Id(setDef.Name))
+		body.Return(w.reader().Dot("decodeArray").Call(jen.Func().Params().Error().BlockFunc(func(g *jen.Group) {
+			g.Var().Id(setElemVarName).Add(setDef.Item.Code())
+			w.decodeValue(g, jen.Id(setElemVarName), setDef.Item, 1)
+			g.Id(setReceiverName).Dot("Add").Call(jen.Id(setElemVarName))
+			g.Return(jen.Nil())
+		})))
+	})
+	w.writeMarshalMethods(file, setReceiverName, setDef.Name)
+}
+
+// writeMarshalMethods writes the MarshalCBOR and UnmarshalCBOR methods, which use the AppendCBOR and decodeCBOR
+// methods of the type.
+func (w *cborWriter) writeMarshalMethods(file *jen.Group, receiverName, typeName string) {
+	file.Add(snip.MethodMarshalCBOR(receiverName, typeName).Block(
+		jen.Return(jen.Id(receiverName).Dot("AppendCBOR").Call(jen.Nil())),
+	))
+	file.Add(snip.MethodUnmarshalCBOR(receiverName, typeName).Block(
+		jen.Id(cborReaderVarName).Op(":=").Id(w.helper(cborReaderType)).Values(jen.Id("data").Op(":").Id(dataVarName)),
+		w.ifErr(jen.Id(receiverName).Dot(cborDecodeMethod).Call(jen.Op("&").Id(cborReaderVarName))),
+		jen.Return(jen.Id(cborReaderVarName).Dot("end").Call()),
+	))
+}
+
+// writeDecodeMethod writes the decodeCBOR method, which decodes the next data item of the reader into the receiver.
+func (w *cborWriter) writeDecodeMethod(file *jen.Group, receiverName, typeName string, writeBody func(*jen.Group)) {
+	file.Func().Params(jen.Id(receiverName).Op("*").Id(typeName)).
+		Id(cborDecodeMethod).Params(jen.Id(cborReaderVarName).Op("*").Id(w.helper(cborReaderType))).Error().
+		BlockFunc(writeBody)
+}
+
+// writeAppendMethod writes the AppendCBOR method with the body written by writeBody, which ends with a return of out.
+func (w *cborWriter) writeAppendMethod(file *jen.Group, receiverName, typeName string, writeBody func(*cborMethod, *jen.Group)) {
+	// Write the body once to find out whether it uses the err variable.
+	m := &cborMethod{cborWriter: w}
+	jen.BlockFunc(func(body *jen.Group) { writeBody(m, body) })
+
+	file.Add(snip.MethodAppendCBOR(receiverName, typeName).BlockFunc(func(body *jen.Group) {
+		if m.usesErr {
+			body.Var().Err().Error()
+		}
+		writeBody(&cborMethod{cborWriter: w}, body)
+		body.Return(jen.Id(jsonOutVarName), jen.Nil())
+	}))
+}
+
+// cborMethod writes the statements of an AppendCBOR method, which append to the out parameter.
+type cborMethod struct {
+	*cborWriter
+	// usesErr records whether the written statements assign to the err variable.
+	usesErr bool
+}
+
+// appendLiteral appends the provided encoded CBOR to out.
+func (m *cborMethod) appendLiteral(g *jen.Group, literal string) {
+	g.Id(jsonOutVarName).Op("=").Append(jen.Id(jsonOutVarName), jen.Lit(literal).Op("..."))
+}
+
+// add writes the statement that assigns the result of the provided function call to out.
+func (m *cborMethod) add(g *jen.Group, call *jen.Statement) {
+	g.Id(jsonOutVarName).Op("=").Add(call)
+}
+
+// addErr is like add for function calls that also return an error.
+func (m *cborMethod) addErr(g *jen.Group, call *jen.Statement) {
+	m.usesErr = true
+	g.List(jen.Id(jsonOutVarName), jen.Err()).Op("=").Add(call)
+	g.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err()))
+}
+
+// deref returns the selector of the value that the pointer selector points to. Methods of named types are called
+// through the pointer.
+func (m *cborMethod) deref(selector *jen.Statement, typ types.Type) *jen.Statement {
+	if m.hasMethods(typ) {
+		return selector
+	}
+	switch typ.(type) {
+	case types.UUID, types.DateTime, types.RID:
+		// the value is the receiver of String
+		return jen.Parens(jen.Op("*").Add(selector))
+	}
+	return jen.Op("*").Add(selector)
+}
+
+// value writes the statements that encode the value of type typ selected by selector. depth is the number of enclosing
+// collections and is used to name the variables of loops.
+func (m *cborMethod) value(g *jen.Group, selector *jen.Statement, typ types.Type, depth int) {
+	switch t := typ.(type) {
+	case types.String:
+		m.add(g, jen.Id(m.helper(appendCBORStringFunc)).Call(jen.Id(jsonOutVarName), selector))
+	case types.Bearertoken:
+		m.add(g, jen.Id(m.helper(appendCBORStringFunc)).Call(jen.Id(jsonOutVarName), jen.String().Call(selector)))
+	case types.Integer:
+		m.add(g, jen.Id(m.helper(appendCBORIntFunc)).Call(jen.Id(jsonOutVarName), jen.Int64().Call(selector)))
+	case types.Safelong:
+		// safelong values must be safely representable in Javascript like they are in JSON
+		g.If(
+			jen.List(jen.Id("_"), jen.Err()).Op(":=").Add(snip.SafeLongNewSafeLong()).Call(jen.Int64().Call(selector.Clone())),
+			jen.Err().Op("!=").Nil(),
+		).Block(jen.Return(jen.Nil(), jen.Err()))
+		m.add(g, jen.Id(m.helper(appendCBORIntFunc)).Call(jen.Id(jsonOutVarName), jen.Int64().Call(selector)))
+	case types.Double:
+		m.add(g, jen.Id(m.helper(appendCBORFloat64Func)).Call(jen.Id(jsonOutVarName), selector))
+	case types.Boolean:
+		m.add(g, jen.Id(m.helper(appendCBORBoolFunc)).Call(jen.Id(jsonOutVarName), selector))
+	case types.UUID, types.DateTime, types.RID:
+		m.add(g, jen.Id(m.helper(appendCBORStringFunc)).Call(jen.Id(jsonOutVarName), jen.Add(selector).Dot("String").Call()))
+	case types.Binary:
+		m.add(g, jen.Id(m.helper(appendCBORBytesFunc)).Call(jen.Id(jsonOutVarName), selector))
+	case *types.Optional:
+		g.If(selector.Clone().Op("==").Nil()).BlockFunc(func(g *jen.Group) {
+			m.appendLiteral(g, cborNull)
+		}).Else().BlockFunc(func(g *jen.Group) {
+			m.value(g, m.deref(selector, t.Item), t.Item, depth)
+		})
+	case *types.List:
+		m.listValue(g, selector, t.Item, depth)
+	case *types.Set:
+		if t.Name != "" {
+			m.namedValue(g, selector)
+			return
+		}
+		m.listValue(g, selector, t.Item, depth)
+	case *types.Map:
+		m.mapValue(g, selector, t, depth)
+	case *types.AliasType, *types.EnumType, *types.ObjectType, *types.UnionType:
+		if m.hasMethods(t) {
+			m.namedValue(g, selector)
+		} else {
+			m.anyValue(g, selector)
+		}
+	default:
+		// any and external types
+		m.anyValue(g, selector)
+	}
+}
+
+func (m *cborMethod) namedValue(g *jen.Group, selector *jen.Statement) {
+	m.addErr(g, jen.Add(selector).Dot("AppendCBOR").Call(jen.Id(jsonOutVarName)))
+}
+
+func (m *cborMethod) anyValue(g *jen.Group, selector *jen.Statement) {
+	m.addErr(g, jen.Id(m.helper(appendCBORAnyFunc)).Call(jen.Id(jsonOutVarName), selector))
+}
+
+func (m *cborMethod) listValue(g *jen.Group, selector *jen.Statement, item types.Type, depth int) {
+	val := loopVarName("v", depth)
+	m.add(g, jen.Id(m.helper(appendCBORHeadFunc)).Call(jen.Id(jsonOutVarName), jen.Lit(cborMajorArray), jen.Uint64().Call(jen.Len(selector.Clone()))))
+	g.For(jen.List(jen.Id("_"), jen.Id(val)).Op(":=").Range().Add(selector)).BlockFunc(func(loop *jen.Group) {
+		m.value(loop, jen.Id(val), item, depth+1)
+	})
+}
+
+// mapValue writes the statements that encode a map. Keys are encoded like values, except that binary keys are text
+// strings like in JSON. Maps with keys that are not supported in JSON are converted using their JSON encoding.
+func (m *cborMethod) mapValue(g *jen.Group, selector *jen.Statement, mapType *types.Map, depth int) {
+	if !isJSONMapKey(mapType.Key) {
+		m.anyValue(g, selector)
+		return
+	}
+	key, val := loopVarName("k", depth), loopVarName("v", depth)
+	m.add(g, jen.Id(m.helper(appendCBORHeadFunc)).Call(jen.Id(jsonOutVarName), jen.Lit(cborMajorMap), jen.Uint64().Call(jen.Len(selector.Clone()))))
+	g.For(jen.List(jen.Id(key), jen.Id(val)).Op(":=").Range().Add(selector)).BlockFunc(func(loop *jen.Group) {
+		switch {
+		case mapType.Key.IsBinary():
+			m.add(loop, jen.Id(m.helper(appendCBORStringFunc)).Call(jen.Id(jsonOutVarName), jen.String().Call(jen.Id(key))))
+		case mapType.Key.IsBoolean():
+			m.add(loop, jen.Id(m.helper(appendCBORBoolFunc)).Call(jen.Id(jsonOutVarName), jen.Bool().Call(jen.Id(key))))
+		default:
+			m.value(loop, jen.Id(key), mapType.Key, depth+1)
+		}
+		m.value(loop, jen.Id(val), mapType.Val, depth+1)
+	})
+}
+
+// reader returns the identifier of the reader parameter of decodeCBOR methods.
+func (w *cborWriter) reader() *jen.Statement {
+	return jen.Id(cborReaderVarName)
+}
+
+// ifErr returns 'if err := <call>; err != nil { return err }'.
+func (w *cborWriter) ifErr(call *jen.Statement) *jen.Statement {
+	return jen.If(jen.Err().Op(":=").Add(call), jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err()))
+}
+
+// decodeEntries returns a call of the decodeMap method of the reader with a function that decodes each entry.
+func (w *cborWriter) decodeEntries(writeBody func(*jen.Group)) *jen.Statement {
+	return w.reader().Dot("decodeMap").Call(jen.Func().Params().Error().BlockFunc(writeBody))
+}
+
+// decodeValue writes the statements that decode the next data item into target, an addressable value of type typ.
+// depth is the number of enclosing collections and is used to name variables.
+func (w *cborWriter) decodeValue(g *jen.Group, target *jen.Statement, typ types.Type, depth int) {
+	decode := func(method string) {
+		g.Add(w.ifErr(w.reader().Dot(w.helper(method)).Call(jen.Op("&").Add(target))))
+	}
+	switch t := typ.(type) {
+	case types.String:
+		decode(cborDecodeStringMethod)
+	case types.Bearertoken:
+		decode(cborDecodeBearerTokenMethod)
+	case types.Integer:
+		decode(cborDecodeIntMethod)
+	case types.Safelong:
+		decode(cborDecodeSafeLongMethod)
+	case types.Double:
+		decode(cborDecodeFloat64Method)
+	case types.Boolean:
+		decode(cborDecodeBoolMethod)
+	case types.UUID:
+		decode(cborDecodeUUIDMethod)
+	case types.DateTime:
+		decode(cborDecodeDateTimeMethod)
+	case types.RID:
+		decode(cborDecodeRIDMethod)
+	case types.Binary:
+		decode(cborDecodeBinaryMethod)
+	case types.Any:
+		decode(cborDecodeAnyMethod)
+	case *types.Optional:
+		opt := loopVarName("opt", depth)
+		g.If(jen.Op("!").Add(w.reader()).Dot("decodeNull").Call()).BlockFunc(func(g *jen.Group) {
+			g.Var().Id(opt).Add(t.Item.Code())
+			w.decodeValue(g, jen.Id(opt), t.Item, depth+1)
+			g.Add(target).Op("=").Op("&").Id(opt)
+		})
+	case *types.List:
+		w.decodeList(g, target, t, t.Item, depth)
+	case *types.Set:
+		if t.Name != "" {
+			w.decodeNamed(g, target, t)
+			return
+		}
+		w.decodeList(g, target, t, t.Item, depth)
+	case *types.Map:
+		if !isJSONMapKey(t.Key) {
+			decode(cborDecodeJSONMethod)
+			return
+		}
+		key, val := loopVarName("k", depth), loopVarName("v", depth)
+		g.Add(target.Clone()).Op("=").Make(t.Code())
+		g.Add(w.ifErr(w.decodeEntries(func(entry *jen.Group) {
+			switch {
+			case t.Key.IsBinary():
+				// binary keys are base64 strings like in JSON
+				entry.Var().Id(key).Add(snip.BinaryBinary())
+				entry.Add(w.ifErr(w.reader().Dot(w.helper(cborDecodeStringMethod)).Call(jen.Parens(jen.Op("*").String()).Call(jen.Op("&").Id(key)))))
+			case t.Key.IsBoolean():
+				entry.Var().Id(key).Add(snip.BooleanBoolean())
+				entry.Add(w.ifErr(w.reader().Dot(w.helper(cborDecodeBoolMethod)).Call(jen.Parens(jen.Op("*").Bool()).Call(jen.Op("&").Id(key)))))
+			default:
+				entry.Var().Id(key).Add(t.Key.Code())
+				w.decodeValue(entry, jen.Id(key), t.Key, depth+1)
+			}
+			entry.Var().Id(val).Add(t.Val.Code())
+			w.decodeValue(entry, jen.Id(val), t.Val, depth+1)
+			entry.Add(target.Clone()).Index(jen.Id(key)).Op("=").Id(val)
+			entry.Return(jen.Nil())
+		})))
+	case *types.AliasType, *types.EnumType, *types.ObjectType, *types.UnionType:
+		w.decodeNamed(g, target, t)
+	default:
+		// external types
+		decode(cborDecodeJSONMethod)
+	}
+}
+
+// decodeNamed writes the statements that decode a value of a named type. Types of other packages are decoded using
+// their exported UnmarshalCBOR method and types without CBOR methods using their JSON encoding.
+func (w *cborWriter) decodeNamed(g *jen.Group, target *jen.Statement, typ types.Type) {
+	switch {
+	case w.isLocal(typ) && w.hasMethods(typ):
+		g.Add(w.ifErr(jen.Add(target).Dot(cborDecodeMethod).Call(w.reader())))
+	case w.hasMethods(typ):
+		g.Add(w.ifErr(w.reader().Dot(w.helper(cborDecodeUnmarshalerMethod)).Call(jen.Op("&").Add(target))))
+	default:
+		g.Add(w.ifErr(w.reader().Dot(w.helper(cborDecodeJSONMethod)).Call(jen.Op("&").Add(target))))
+	}
+}
+
+// decodeList writes the statements that decode an array into target, a slice of type typ. null is decoded as an empty
+// slice.
+func (w *cborWriter) decodeList(g *jen.Group, target *jen.Statement, typ, item types.Type, depth int) {
+	val := loopVarName("v", depth)
+	g.Add(target.Clone()).Op("=").Make(typ.Code(), jen.Lit(0))
+	g.Add(w.ifErr(w.reader().Dot("decodeArray").Call(jen.Func().Params().Error().BlockFunc(func(elem *jen.Group) {
+		elem.Var().Id(val).Add(item.Code())
+		w.decodeValue(elem, jen.Id(val), item, depth+1)
+		elem.Add(target.Clone()).Op("=").Append(target.Clone(), jen.Id(val))
+		elem.Return(jen.Nil())
+	}))))
+}
+
+// hasCollectionField returns true if writeStructMarshalInitDecls initializes any of the provided fields.
+func hasCollectionField(fields []*types.Field) bool {
+	for _, fieldDef := range fields {
+		if fieldDef.Type.Make() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// cborHead returns the encoding of the head of a data item with the provided major type and argument.
+func cborHead(major byte, n int) string {
+	switch {
+	case n < 24:
+		return string([]byte{major<<5 | byte(n)})
+	case n <= 0xff:
+		return string([]byte{major<<5 | 24, byte(n)})
+	default:
+		return string([]byte{major<<5 | 25, byte(n >> 8), byte(n)})
+	}
+}
+
+// cborText returns s encoded as a CBOR text string.
+func cborText(s string) string {
+	return cborHead(cborMajorText, len(s)) + s
+}
+
+// hexLit returns b as a hexadecimal literal, which is easier to read than a decimal literal for CBOR initial bytes.
+func hexLit(b uint64) *jen.Statement {
+	return jen.Op(fmt.Sprintf("%#02x", b))
+}
+
+// needsHelpers returns true if the methods written by the writer use any helpers.
+func (w *cborWriter) needsHelpers() bool {
+	return len(w.helpers) > 0
+}
+
+// writeHelpers writes the helpers used by the methods written by the writer.
+func (w *cborWriter) writeHelpers(file *jen.Group) {
+	// helpers that are implemented using other helpers
+	deps := map[string][]string{
+		appendCBORStringFunc:        {appendCBORHeadFunc},
+		appendCBORBytesFunc:         {appendCBORHeadFunc},
+		appendCBORIntFunc:           {appendCBORHeadFunc},
+		appendCBORAnyFunc:           {appendCBORHeadFunc, appendCBORStringFunc, appendCBORBytesFunc, appendCBORIntFunc, appendCBORFloat64Func, appendCBORBoolFunc},
+		cborReaderType:              {cborTypeErrorFunc, cborIntegerFunc},
+		cborDecodeStringMethod:      {cborReaderType},
+		cborDecodeBearerTokenMethod: {cborReaderType},
+		cborDecodeIntMethod:         {cborReaderType},
+		cborDecodeSafeLongMethod:    {cborReaderType},
+		cborDecodeFloat64Method:     {cborReaderType, cborFloatFunc},
+		cborDecodeBoolMethod:        {cborReaderType},
+		cborDecodeUUIDMethod:        {cborReaderType},
+		cborDecodeDateTimeMethod:    {cborReaderType},
+		cborDecodeRIDMethod:         {cborReaderType},
+		cborDecodeBinaryMethod:      {cborReaderType},
+		cborReadAnyMethod:           {cborReaderType, cborFloatFunc},
+		cborDecodeAnyMethod:         {cborReadAnyMethod},
+		cborDecodeJSONMethod:        {cborReadAnyMethod},
+		cborDecodeUnmarshalerMethod: {cborReaderType},
+		cborCodecType:               {cborContentTypeConst},
+		isCBORRequestFunc:           {cborContentTypeConst},
+		acceptsCBORFunc:             {cborContentTypeConst},
+	}
+	for added := true; added; {
+		added = false
+		for helper := range w.helpers {
+			for _, dep := range deps[helper] {
+				if _, ok := w.helpers[dep]; !ok {
+					w.helpers[dep] = struct{}{}
+					added = true
+				}
+			}
+		}
+	}
+	var names []string
+	for name := range w.helpers {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return cborHelperOrder[names[i]] < cborHelperOrder[names[j]]
+	})
+	for _, name := range names {
+		file.Add(cborHelpers[name]())
+	}
+}
+
+var cborHelperOrder = func() map[string]int {
+	order := map[string]int{}
+	for i, name := range []string{
+		appendCBORHeadFunc,
+		appendCBORStringFunc,
+		appendCBORBytesFunc,
+		appendCBORIntFunc,
+		appendCBORFloat64Func,
+		appendCBORBoolFunc,
+		appendCBORAnyFunc,
+		cborTypeErrorFunc,
+		cborIntegerFunc,
+		cborFloatFunc,
+		cborReaderType,
+		cborDecodeStringMethod,
+		cborDecodeBearerTokenMethod,
+		cborDecodeIntMethod,
+		cborDecodeSafeLongMethod,
+		cborDecodeFloat64Method,
+		cborDecodeBoolMethod,
+		cborDecodeUUIDMethod,
+		cborDecodeDateTimeMethod,
+		cborDecodeRIDMethod,
+		cborDecodeBinaryMethod,
+		cborReadAnyMethod,
+		cborDecodeAnyMethod,
+		cborDecodeJSONMethod,
+		cborDecodeUnmarshalerMethod,
+		cborContentTypeConst,
+		cborCodecType,
+		isCBORRequestFunc,
+		acceptsCBORFunc,
+	} {
+		order[name] = i
+	}
+	return order
+}()
+
+var cborHelpers = map[string]func() *jen.Statement{
+	appendCBORHeadFunc:          astForAppendCBORHead,
+	appendCBORStringFunc:        astForAppendCBORString,
+	appendCBORBytesFunc:         astForAppendCBORBytes,
+	appendCBORIntFunc:           astForAppendCBORInt,
+	appendCBORFloat64Func:       astForAppendCBORFloat64,
+	appendCBORBoolFunc:          astForAppendCBORBool,
+	appendCBORAnyFunc:           astForAppendCBORAny,
+	cborTypeErrorFunc:           astForCBORTypeError,
+	cborIntegerFunc:             astForCBORInteger,
+	cborFloatFunc:               astForCBORFloat,
+	cborReaderType:              astForCBORReader,
+	cborDecodeStringMethod:      astForCBORDecodeString,
+	cborDecodeBearerTokenMethod: astForCBORDecodeBearerToken,
+	cborDecodeIntMethod:         astForCBORDecodeInt,
+	cborDecodeSafeLongMethod:    astForCBORDecodeSafeLong,
+	cborDecodeFloat64Method:     astForCBORDecodeFloat64,
+	cborDecodeBoolMethod:        astForCBORDecodeBool,
+	cborDecodeUUIDMethod:        astForCBORDecodeUUID,
+	cborDecodeDateTimeMethod:    astForCBORDecodeDateTime,
+	cborDecodeRIDMethod:         astForCBORDecodeRID,
+	cborDecodeBinaryMethod:      astForCBORDecodeBinary,
+	cborReadAnyMethod:           astForCBORReadAny,
+	cborDecodeAnyMethod:         astForCBORDecodeAny,
+	cborDecodeJSONMethod:        astForCBORDecodeJSON,
+	cborDecodeUnmarshalerMethod: astForCBORDecodeUnmarshaler,
+	cborContentTypeConst:        astForCBORContentType,
+	cborCodecType:               astForCBORCodec,
+	isCBORRequestFunc:           astForIsCBORRequest,
+	acceptsCBORFunc:             astForAcceptsCBOR,
+}
+
+// cborReaderMethod returns the declaration of a method of cborReader.
+func cborReaderMethod(name string) *jen.Statement {
+	return jen.Func().Params(jen.Id(cborReaderVarName).Op("*").Id(cborReaderType)).Id(name)
+}
+
+// returnIfErr returns 'if err != nil { return <values>, err }'.
+func returnIfErr(values ...jen.Code) *jen.Statement {
+	return jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(append(values, jen.Err())...))
+}
+
+// cborIndefiniteLoop returns the loop over the elements of an array or map with the provided additional information
+// and argument: the loop ends at the break code of indefinite-length items and after arg elements otherwise.
+func cborIndefiniteLoop() *jen.Statement {
+	info, arg, i := jen.Id("info"), jen.Id("arg"), jen.Id("i")
+	return jen.For(
+		i.Clone().Op(":=").Uint64().Call(jen.Lit(0)),
+		info.Clone().Op("==").Lit(31).Op("&&").Op("!").Id(cborReaderVarName).Dot("atBreak").Call().Op("||").
+			Add(info.Clone()).Op("!=").Lit(31).Op("&&").Add(i.Clone()).Op("<").Add(arg.Clone()),
+		i.Clone().Op("++"),
+	)
+}
+
+func astForAppendCBORHead() *jen.Statement {
+	major, n := jen.Id("major"), jen.Id("n")
+	shift := func(bits int) jen.Code {
+		if bits == 0 {
+			return jen.Byte().Call(n.Clone())
+		}
+		return jen.Byte().Call(n.Clone().Op(">>").Lit(bits))
+	}
+	bytes := func(count int) []jen.Code {
+		var values []jen.Code
+		for i := count - 1; i >= 0; i-- {
+			values = append(values, shift(8*i))
+		}
+		return values
+	}
+	head := func(info int, count int) jen.Code {
+		return jen.Return(jen.Append(append([]jen.Code{jen.Id(jsonOutVarName), major.Clone().Op("<<").Lit(5).Op("|").Lit(info)}, bytes(count)...)...))
+	}
+	return jen.Commentf("%s appends the head of a data item with the provided major type and argument to out.", appendCBORHeadFunc).Line().
+		Func().Id(appendCBORHeadFunc).Params(outParam(), major.Clone().Byte(), n.Clone().Uint64()).Index().Byte().Block(
+		jen.Switch().Block(
+			jen.Case(n.Clone().Op("<").Lit(24)).Block(jen.Return(jen.Append(jen.Id(jsonOutVarName), major.Clone().Op("<<").Lit(5).Op("|").Byte().Call(n.Clone())))),
+			jen.Case(n.Clone().Op("<=").Add(hexLit(0xff))).Block(head(24, 1)),
+			jen.Case(n.Clone().Op("<=").Add(hexLit(0xffff))).Block(head(25, 2)),
+			jen.Case(n.Clone().Op("<=").Add(hexLit(0xffffffff))).Block(head(26, 4)),
+		),
+		head(27, 8),
+	)
+}
+
+func astForAppendCBORString() *jen.Statement {
+	return jen.Commentf("%s appends s encoded as a text string to out.", appendCBORStringFunc).Line().
+		Func().Id(appendCBORStringFunc).Params(outParam(), jen.Id("s").String()).Index().Byte().Block(
+		appendCBORHeadOut(cborMajorText, jen.Id("s")),
+		jen.Return(jen.Append(jen.Id(jsonOutVarName), jen.Id("s").Op("..."))),
+	)
+}
+
+func astForAppendCBORBytes() *jen.Statement {
+	return jen.Commentf("%s appends b encoded as a byte string to out.", appendCBORBytesFunc).Line().
+		Func().Id(appendCBORBytesFunc).Params(outParam(), jen.Id("b").Index().Byte()).Index().Byte().Block(
+		appendCBORHeadOut(cborMajorBytes, jen.Id("b")),
+		jen.Return(jen.Append(jen.Id(jsonOutVarName), jen.Id("b").Op("..."))),
+	)
+}
+
+// appendCBORHeadOut returns 'out = appendCBORHead(out, <major>, uint64(len(<value>)))'.
+func appendCBORHeadOut(major int, value *jen.Statement) *jen.Statement {
+	return jen.Id(jsonOutVarName).Op("=").Id(appendCBORHeadFunc).Call(jen.Id(jsonOutVarName), jen.Lit(major), jen.Uint64().Call(jen.Len(value)))
+}
+
+func astForAppendCBORInt() *jen.Statement {
+	v := jen.Id("v")
+	return jen.Commentf("%s appends v encoded as an integer to out.", appendCBORIntFunc).Line().
+		Func().Id(appendCBORIntFunc).Params(outParam(), v.Clone().Int64()).Index().Byte().Block(
+		jen.If(v.Clone().Op("<").Lit(0)).Block(
+			jen.Return(jen.Id(appendCBORHeadFunc).Call(jen.Id(jsonOutVarName), jen.Lit(1), jen.Uint64().Call(jen.Lit(-1).Op("-").Add(v.Clone())))),
+		),
+		jen.Return(jen.Id(appendCBORHeadFunc).Call(jen.Id(jsonOutVarName), jen.Lit(cborMajorUnsigned), jen.Uint64().Call(v.Clone()))),
+	)
+}
+
+func astForAppendCBORFloat64() *jen.Statement {
+	bits := jen.Id("bits")
+	values := []jen.Code{jen.Id(jsonOutVarName), hexLit(0xfb)}
+	for shift := 56; shift > 0; shift -= 8 {
+		values = append(values, jen.Byte().Call(bits.Clone().Op(">>").Lit(shift)))
+	}
+	values = append(values, jen.Byte().Call(bits.Clone()))
+	return jen.Commentf("%s appends v encoded as a double-precision float to out.", appendCBORFloat64Func).Line().
+		Func().Id(appendCBORFloat64Func).Params(outParam(), jen.Id("v").Float64()).Index().Byte().Block(
+		bits.Clone().Op(":=").Add(snip.MathFloat64bits()).Call(jen.Id("v")),
+		jen.Return(jen.Append(values...)),
+	)
+}
+
+func astForAppendCBORBool() *jen.Statement {
+	return jen.Commentf("%s appends v encoded as a simple value to out.", appendCBORBoolFunc).Line().
+		Func().Id(appendCBORBoolFunc).Params(outParam(), jen.Id("v").Bool()).Index().Byte().Block(
+		jen.If(jen.Id("v")).Block(jen.Return(jen.Append(jen.Id(jsonOutVarName), hexLit(0xf5)))),
+		jen.Return(jen.Append(jen.Id(jsonOutVarName), hexLit(0xf4))),
+	)
+}
+
+// astForAppendCBORAny returns a function that encodes the values of any fields and of types without CBOR methods.
+// Values that are not decoded JSON values are converted to them using their JSON encoding.
+func astForAppendCBORAny() *jen.Statement {
+	v, out, err := jen.Id("v"), jen.Id(jsonOutVarName), jen.Err()
+	appendElem := func(elem jen.Code) *jen.Statement {
+		return jen.If(
+			jen.List(out.Clone(), err.Clone()).Op("=").Id(appendCBORAnyFunc).Call(out.Clone(), elem),
+			err.Clone().Op("!=").Nil(),
+		).Block(jen.Return(jen.Nil(), err.Clone()))
+	}
+	return jen.Commentf("%s appends v to out. v is usually the value of an any field, which is encoded like it is", appendCBORAnyFunc).Line().
+		Comment("decoded from JSON; values of other types are converted to such values using their JSON encoding.").Line().
+		Func().Id(appendCBORAnyFunc).Params(outParam(), v.Clone().Interface()).Params(jen.Index().Byte(), jen.Error()).Block(
+		jen.Switch(v.Clone().Op(":=").Add(v.Clone()).Assert(jen.Type())).Block(
+			jen.Case(jen.Nil()).Block(jen.Return(jen.Append(out.Clone(), hexLit(0xf6)), jen.Nil())),
+			jen.Case(jen.Bool()).Block(jen.Return(jen.Id(appendCBORBoolFunc).Call(out.Clone(), v.Clone()), jen.Nil())),
+			jen.Case(jen.String()).Block(jen.Return(jen.Id(appendCBORStringFunc).Call(out.Clone(), v.Clone()), jen.Nil())),
+			jen.Case(jen.Index().Byte()).Block(jen.Return(jen.Id(appendCBORBytesFunc).Call(out.Clone(), v.Clone()), jen.Nil())),
+			jen.Case(jen.Int()).Block(jen.Return(jen.Id(appendCBORIntFunc).Call(out.Clone(), jen.Int64().Call(v.Clone())), jen.Nil())),
+			jen.Case(jen.Int64()).Block(jen.Return(jen.Id(appendCBORIntFunc).Call(out.Clone(), v.Clone()), jen.Nil())),
+			jen.Case(jen.Float64()).Block(jen.Return(jen.Id(appendCBORFloat64Func).Call(out.Clone(), v.Clone()), jen.Nil())),
+			jen.Case(snip.JSONNumber()).Block(
+				jen.If(jen.List(jen.Id("i"), err.Clone()).Op(":=").Add(v.Clone()).Dot("Int64").Call(), err.Clone().Op("==").Nil()).Block(
+					jen.Return(jen.Id(appendCBORIntFunc).Call(out.Clone(), jen.Id("i")), jen.Nil()),
+				),
+				jen.List(jen.Id("f"), err.Clone()).Op(":=").Add(v.Clone()).Dot("Float64").Call(),
+				returnIfErr(jen.Nil()),
+				jen.Return(jen.Id(appendCBORFloat64Func).Call(out.Clone(), jen.Id("f")), jen.Nil()),
+			),
+			jen.Case(jen.Index().Interface()).Block(
+				appendCBORHeadOut(cborMajorArray, v.Clone()),
+				jen.Var().Add(err.Clone()).Error(),
+				jen.For(jen.List(jen.Id("_"), jen.Id("elem")).Op(":=").Range().Add(v.Clone())).Block(appendElem(jen.Id("elem"))),
+				jen.Return(out.Clone(), jen.Nil()),
+			),
+			jen.Case(jen.Map(jen.String()).Interface()).Block(
+				jen.Id("keys").Op(":=").Make(jen.Index().String(), jen.Lit(0), jen.Len(v.Clone())),
+				jen.For(jen.Id("k").Op(":=").Range().Add(v.Clone())).Block(
+					jen.Id("keys").Op("=").Append(jen.Id("keys"), jen.Id("k")),
+				),
+				snip.SortStrings().Call(jen.Id("keys")),
+				appendCBORHeadOut(cborMajorMap, v.Clone()),
+				jen.Var().Add(err.Clone()).Error(),
+				jen.For(jen.List(jen.Id("_"), jen.Id("k")).Op(":=").Range().Id("keys")).Block(
+					out.Clone().Op("=").Id(appendCBORStringFunc).Call(out.Clone(), jen.Id("k")),
+					appendElem(v.Clone().Index(jen.Id("k"))),
+				),
+				jen.Return(out.Clone(), jen.Nil()),
+			),
+		),
+		jen.List(jen.Id("data"), err.Clone()).Op(":=").Add(snip.SafeJSONMarshal()).Call(v.Clone()),
+		returnIfErr(jen.Nil()),
+		jen.Var().Id("decoded").Interface(),
+		jen.If(err.Clone().Op(":=").Add(snip.SafeJSONUnmarshal()).Call(jen.Id("data"), jen.Op("&").Id("decoded")), err.Clone().Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), err.Clone()),
+		),
+		jen.Return(jen.Id(appendCBORAnyFunc).Call(out.Clone(), jen.Id("decoded"))),
+	)
+}
+
+func astForCBORTypeError() *jen.Statement {
+	return jen.Commentf("%s returns the error for a data item of the provided major type that is not of the wanted type.", cborTypeErrorFunc).Line().
+		Func().Id(cborTypeErrorFunc).Params(jen.Id("major").Byte(), jen.Id("want").String()).Error().Block(
+		jen.Return(snip.FmtErrorf().Call(jen.Lit("expected %s but found CBOR major type %d"), jen.Id("want"), jen.Id("major"))),
+	)
+}
+
+func astForCBORInteger() *jen.Statement {
+	major, arg, want := jen.Id("major"), jen.Id("arg"), jen.Id("want")
+	return jen.Commentf("%s returns the value of an integer with the provided major type and argument.", cborIntegerFunc).Line().
+		Func().Id(cborIntegerFunc).Params(major.Clone().Byte(), arg.Clone().Uint64(), want.Clone().String()).Params(jen.Int64(), jen.Error()).Block(
+		jen.Switch().Block(
+			jen.Case(major.Clone().Op(">").Lit(1)).Block(jen.Return(jen.Lit(0), jen.Id(cborTypeErrorFunc).Call(major.Clone(), want.Clone()))),
+			jen.Case(arg.Clone().Op(">").Add(snip.MathMaxInt64())).Block(
+				jen.Return(jen.Lit(0), snip.FmtErrorf().Call(jen.Lit("invalid %s: integer out of range"), want.Clone())),
+			),
+			jen.Case(major.Clone().Op("==").Lit(1)).Block(jen.Return(jen.Lit(-1).Op("-").Int64().Call(arg.Clone()), jen.Nil())),
+		),
+		jen.Return(jen.Int64().Call(arg.Clone()), jen.Nil()),
+	)
+}
+
+func astForCBORFloat() *jen.Statement {
+	info, arg, exp, mant, v := jen.Id("info"), jen.Id("arg"), jen.Id("exp"), jen.Id("mant"), jen.Id("v")
+	return jen.Commentf("%s returns the value of a half-, single- or double-precision float with the provided additional", cborFloatFunc).Line().
+		Comment("information and argument.").Line().
+		Func().Id(cborFloatFunc).Params(info.Clone().Byte(), arg.Clone().Uint64()).Float64().Block(
+		jen.Switch(info.Clone()).Block(
+			jen.Case(jen.Lit(25)).Block(
+				jen.List(exp.Clone(), mant.Clone()).Op(":=").List(
+					jen.Int().Call(arg.Clone().Op(">>").Lit(10).Op("&").Add(hexLit(0x1f))),
+					jen.Float64().Call(arg.Clone().Op("&").Add(hexLit(0x3ff))),
+				),
+				jen.Var().Add(v.Clone()).Float64(),
+				jen.Switch(exp.Clone()).Block(
+					jen.Case(jen.Lit(0)).Block(v.Clone().Op("=").Add(snip.MathLdexp()).Call(mant.Clone(), jen.Lit(-24))),
+					jen.Case(hexLit(0x1f)).Block(
+						v.Clone().Op("=").Add(snip.MathInf()).Call(jen.Lit(1)),
+						jen.If(mant.Clone().Op("!=").Lit(0)).Block(v.Clone().Op("=").Add(snip.MathNaN()).Call()),
+					),
+					jen.Default().Block(v.Clone().Op("=").Add(snip.MathLdexp()).Call(mant.Clone().Op("+").Lit(1024), exp.Clone().Op("-").Lit(25))),
+				),
+				jen.If(arg.Clone().Op("&").Add(hexLit(0x8000)).Op("!=").Lit(0)).Block(jen.Return(jen.Op("-").Add(v.Clone()))),
+				jen.Return(v.Clone()),
+			),
+			jen.Case(jen.Lit(26)).Block(jen.Return(jen.Float64().Call(snip.MathFloat32frombits().Call(jen.Uint32().Call(arg.Clone()))))),
+		),
+		jen.Return(snip.MathFloat64frombits().Call(arg.Clone())),
+	)
+}
+
+// astForCBORReader returns the declaration of cborReader and of the methods that are used by all decodeCBOR methods.
+func astForCBORReader() *jen.Statement {
+	r := jen.Id(cborReaderVarName)
+	data, pos := r.Clone().Dot("data"), r.Clone().Dot("pos")
+	major, info, arg, n, b, s, err := jen.Id("major"), jen.Id("info"), jen.Id("arg"), jen.Id("n"), jen.Id("b"), jen.Id("s"), jen.Err()
+	atByte := func(values ...byte) *jen.Statement {
+		cond := pos.Clone().Op("<").Len(data.Clone()).Op("&&")
+		if len(values) == 1 {
+			return cond.Add(data.Clone()).Index(pos.Clone()).Op("==").Add(hexLit(uint64(values[0])))
+		}
+		return cond.Parens(data.Clone().Index(pos.Clone()).Op("==").Add(hexLit(uint64(values[0]))).Op("||").
+			Add(data.Clone()).Index(pos.Clone()).Op("==").Add(hexLit(uint64(values[1]))))
+	}
+	readHead := jen.List(major.Clone(), info.Clone(), arg.Clone(), err.Clone()).Op(":=").Add(r.Clone()).Dot("head").Call()
+	return jen.Commentf("%s decodes the data items of data, which is read from pos.", cborReaderType).Line().
+		Type().Id(cborReaderType).Struct(
+		jen.Id("data").Index().Byte(),
+		jen.Id("pos").Int(),
+	).Line().Line().
+		Comment("head reads the head of the next data item and returns its major type, additional information and argument.").Line().
+		Comment("Tags are skipped.").Line().
+		Add(cborReaderMethod("head")).Params().Params(major.Clone().Byte(), info.Clone().Byte(), arg.Clone().Uint64(), err.Clone().Error()).Block(
+		jen.For().Block(
+			jen.If(pos.Clone().Op(">=").Len(data.Clone())).Block(jen.Return(jen.Lit(0), jen.Lit(0), jen.Lit(0), snip.IOErrUnexpectedEOF())),
+			b.Clone().Op(":=").Add(data.Clone()).Index(pos.Clone()),
+			pos.Clone().Op("++"),
+			jen.List(major.Clone(), info.Clone(), arg.Clone()).Op("=").List(b.Clone().Op(">>").Lit(5), b.Clone().Op("&").Add(hexLit(0x1f)), jen.Lit(0)),
+			jen.Switch().Block(
+				jen.Case(info.Clone().Op("<").Lit(24)).Block(arg.Clone().Op("=").Uint64().Call(info.Clone())),
+				jen.Case(info.Clone().Op("<=").Lit(27)).Block(
+					n.Clone().Op(":=").Lit(1).Op("<<").Parens(info.Clone().Op("-").Lit(24)),
+					jen.If(jen.Len(data.Clone()).Op("-").Add(pos.Clone()).Op("<").Add(n.Clone())).Block(
+						jen.Return(jen.Lit(0), jen.Lit(0), jen.Lit(0), snip.IOErrUnexpectedEOF()),
+					),
+					jen.For(jen.List(jen.Id("_"), jen.Id("c")).Op(":=").Range().Add(data.Clone()).Index(pos.Clone(), pos.Clone().Op("+").Add(n.Clone()))).Block(
+						arg.Clone().Op("=").Add(arg.Clone()).Op("<<").Lit(8).Op("|").Uint64().Call(jen.Id("c")),
+					),
+					pos.Clone().Op("+=").Add(n.Clone()),
+				),
+				jen.Comment("the indefinite length of strings, arrays and maps or the break code"),
+				jen.Case(info.Clone().Op("==").Lit(31).Op("&&").Add(major.Clone()).Op(">=").Lit(cborMajorBytes).Op("&&").Add(major.Clone()).Op("!=").Lit(6)),
+				jen.Default().Block(jen.Return(jen.Lit(0), jen.Lit(0), jen.Lit(0), snip.FmtErrorf().Call(jen.Lit("invalid CBOR additional information %d"), info.Clone()))),
+			),
+			jen.If(major.Clone().Op("!=").Lit(6)).Block(jen.Return(major.Clone(), info.Clone(), arg.Clone(), jen.Nil())),
+		),
+	).Line().Line().
+		Comment("atBreak returns true and consumes the next byte if it is the break code that ends an indefinite-length item.").Line().
+		Add(cborReaderMethod("atBreak")).Params().Bool().Block(
+		jen.If(atByte(0xff)).Block(
+			pos.Clone().Op("++"),
+			jen.Return(jen.True()),
+		),
+		jen.Return(jen.False()),
+	).Line().Line().
+		Comment("decodeNull returns true and consumes the next data item if it is null or undefined.").Line().
+		Add(cborReaderMethod("decodeNull")).Params().Bool().Block(
+		jen.If(atByte(0xf6, 0xf7)).Block(
+			pos.Clone().Op("++"),
+			jen.Return(jen.True()),
+		),
+		jen.Return(jen.False()),
+	).Line().Line().
+		Comment("end returns an error if there is data after the decoded data item.").Line().
+		Add(cborReaderMethod("end")).Params().Error().Block(
+		jen.If(pos.Clone().Op("!=").Len(data.Clone())).Block(
+			jen.Return(snip.FmtErrorf().Call(jen.Lit("unexpected data after CBOR data item"))),
+		),
+		jen.Return(jen.Nil()),
+	).Line().Line().
+		Comment("stringBody reads the content of a byte or text string with the provided major type, additional information").Line().
+		Comment("and argument. The chunks of indefinite-length strings are concatenated.").Line().
+		Add(cborReaderMethod("stringBody")).Params(major.Clone().Byte(), info.Clone().Byte(), arg.Clone().Uint64()).Params(jen.Index().Byte(), jen.Error()).Block(
+		jen.If(info.Clone().Op("!=").Lit(31)).Block(
+			jen.If(jen.Uint64().Call(jen.Len(data.Clone()).Op("-").Add(pos.Clone())).Op("<").Add(arg.Clone())).Block(
+				jen.Return(jen.Nil(), snip.IOErrUnexpectedEOF()),
+			),
+			s.Clone().Op(":=").Add(data.Clone()).Index(pos.Clone(), pos.Clone().Op("+").Int().Call(arg.Clone())),
+			pos.Clone().Op("+=").Int().Call(arg.Clone()),
+			jen.Return(s.Clone(), jen.Nil()),
+		),
+		jen.Var().Add(s.Clone()).Index().Byte(),
+		jen.For(jen.Op("!").Add(r.Clone()).Dot("atBreak").Call()).Block(
+			jen.List(jen.Id("chunkMajor"), jen.Id("chunkInfo"), jen.Id("chunkArg"), err.Clone()).Op(":=").Add(r.Clone()).Dot("head").Call(),
+			returnIfErr(jen.Nil()),
+			jen.If(jen.Id("chunkMajor").Op("!=").Add(major.Clone()).Op("||").Id("chunkInfo").Op("==").Lit(31)).Block(
+				jen.Return(jen.Nil(), snip.FmtErrorf().Call(jen.Lit("invalid chunk of indefinite-length CBOR string"))),
+			),
+			jen.List(jen.Id("chunk"), err.Clone()).Op(":=").Add(r.Clone()).Dot("stringBody").Call(major.Clone(), jen.Id("chunkInfo"), jen.Id("chunkArg")),
+			returnIfErr(jen.Nil()),
+			s.Clone().Op("=").Append(s.Clone(), jen.Id("chunk").Op("...")),
+		),
+		jen.Return(s.Clone(), jen.Nil()),
+	).Line().Line().
+		Comment("readString reads a byte or text string with the provided major type.").Line().
+		Add(cborReaderMethod("readString")).Params(jen.Id("want").Byte(), jen.Id("name").String()).Params(jen.Index().Byte(), jen.Error()).Block(
+		readHead.Clone(),
+		returnIfErr(jen.Nil()),
+		jen.If(major.Clone().Op("!=").Id("want")).Block(jen.Return(jen.Nil(), jen.Id(cborTypeErrorFunc).Call(major.Clone(), jen.Id("name")))),
+		jen.Return(r.Clone().Dot("stringBody").Call(major.Clone(), info.Clone(), arg.Clone())),
+	).Line().Line().
+		Comment("readInteger reads an integer.").Line().
+		Add(cborReaderMethod("readInteger")).Params(jen.Id("want").String()).Params(jen.Int64(), jen.Error()).Block(
+		jen.List(major.Clone(), jen.Id("_"), arg.Clone(), err.Clone()).Op(":=").Add(r.Clone()).Dot("head").Call(),
+		returnIfErr(jen.Lit(0)),
+		jen.Return(jen.Id(cborIntegerFunc).Call(major.Clone(), arg.Clone(), jen.Id("want"))),
+	).Line().Line().
+		Comment("skip skips the next data item.").Line().
+		Add(cborReaderMethod("skip")).Params().Error().Block(
+		readHead.Clone(),
+		jen.If(err.Clone().Op("!=").Nil()).Block(jen.Return(err.Clone())),
+		jen.Switch(major.Clone()).Block(
+			jen.Case(jen.Lit(cborMajorBytes), jen.Lit(cborMajorText)).Block(
+				jen.List(jen.Id("_"), err.Clone()).Op("=").Add(r.Clone()).Dot("stringBody").Call(major.Clone(), info.Clone(), arg.Clone()),
+				jen.Return(err.Clone()),
+			),
+			jen.Case(jen.Lit(cborMajorArray), jen.Lit(cborMajorMap)).Block(
+				jen.If(major.Clone().Op("==").Lit(cborMajorMap).Op("&&").Add(info.Clone()).Op("!=").Lit(31)).Block(
+					jen.Comment("the keys and values of the entries"),
+					arg.Clone().Op("*=").Lit(2),
+				),
+				cborIndefiniteLoop().Block(
+					jen.If(err.Clone().Op(":=").Add(r.Clone()).Dot("skip").Call(), err.Clone().Op("!=").Nil()).Block(jen.Return(err.Clone())),
+				),
+			),
+			jen.Case(jen.Lit(7)).Block(
+				jen.If(info.Clone().Op("==").Lit(31)).Block(jen.Return(snip.FmtErrorf().Call(jen.Lit("unexpected CBOR break code")))),
+			),
+		),
+		jen.Return(jen.Nil()),
+	).Line().Line().
+		Comment("decodeArray calls decodeElem for each element of the next data item, which must be an array. null is decoded as").Line().
+		Comment("an empty array.").Line().
+		Add(cborReaderMethod("decodeArray")).Params(jen.Id("decodeElem").Func().Params().Error()).Error().Block(
+		cborDecodeCollection(cborMajorArray, "array", jen.Id("decodeElem")),
+	).Line().Line().
+		Comment("decodeMap calls decodeEntry for each entry of the next data item, which must be a map. decodeEntry must decode").Line().
+		Comment("the key and the value of the entry. null is decoded as an empty map.").Line().
+		Add(cborReaderMethod("decodeMap")).Params(jen.Id("decodeEntry").Func().Params().Error()).Error().Block(
+		cborDecodeCollection(cborMajorMap, "map", jen.Id("decodeEntry")),
+	)
+}
+
+// cborDecodeCollection returns the body of decodeArray and decodeMap.
+func cborDecodeCollection(major int, name string, decode *jen.Statement) *jen.Statement {
+	r, err := jen.Id(cborReaderVarName), jen.Err()
+	return jen.If(r.Clone().Dot("decodeNull").Call()).Block(jen.Return(jen.Nil())).Line().
+		List(jen.Id("major"), jen.Id("info"), jen.Id("arg"), err.Clone()).Op(":=").Add(r.Clone()).Dot("head").Call().Line().
+		If(err.Clone().Op("!=").Nil()).Block(jen.Return(err.Clone())).Line().
+		If(jen.Id("major").Op("!=").Lit(major)).Block(jen.Return(jen.Id(cborTypeErrorFunc).Call(jen.Id("major"), jen.Lit(name)))).Line().
+		Add(cborIndefiniteLoop()).Block(
+		jen.If(err.Clone().Op(":=").Add(decode).Call(), err.Clone().Op("!=").Nil()).Block(jen.Return(err.Clone())),
+	).Line().
+		Return(jen.Nil())
+}
+
+// astForCBORDecodeText returns a method of cborReader that decodes a text string into the value of type valueType that
+// v points to. convert returns the statements that convert the string s and assign it to *v.
+func astForCBORDecodeText(method, comment, name string, valueType *jen.Statement, convert func(s *jen.Statement) []jen.Code) *jen.Statement {
+	body := []jen.Code{
+		jen.List(jen.Id("s"), jen.Err()).Op(":=").Id(cborReaderVarName).Dot("readString").Call(jen.Lit(cborMajorText), jen.Lit(name)),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+	}
+	body = append(body, convert(jen.Id("s"))...)
+	return jen.Commentf("%s %s", method, comment).Line().
+		Add(cborReaderMethod(method)).Params(jen.Id("v").Op("*").Add(valueType)).Error().Block(body...)
+}
+
+// parseText returns the statements that assign the result of parse(string(s)) to *v.
+func parseText(parse *jen.Statement) func(s *jen.Statement) []jen.Code {
+	return func(s *jen.Statement) []jen.Code {
+		return []jen.Code{
+			jen.List(jen.Id("parsed"), jen.Err()).Op(":=").Add(parse).Call(jen.String().Call(s)),
+			jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+			jen.Op("*").Id("v").Op("=").Id("parsed"),
+			jen.Return(jen.Nil()),
+		}
+	}
+}
+
+func astForCBORDecodeString() *jen.Statement {
+	return astForCBORDecodeText(cborDecodeStringMethod, "decodes a text string.", "string", jen.String(), func(s *jen.Statement) []jen.Code {
+		return []jen.Code{jen.Op("*").Id("v").Op("=").String().Call(s), jen.Return(jen.Nil())}
+	})
+}
+
+func astForCBORDecodeBearerToken() *jen.Statement {
+	return astForCBORDecodeText(cborDecodeBearerTokenMethod, "decodes a text string that is a bearer token.", "bearertoken", snip.BearerTokenToken(), func(s *jen.Statement) []jen.Code {
+		return []jen.Code{jen.Op("*").Id("v").Op("=").Add(snip.BearerTokenToken()).Call(s), jen.Return(jen.Nil())}
+	})
+}
+
+func astForCBORDecodeUUID() *jen.Statement {
+	return astForCBORDecodeText(cborDecodeUUIDMethod, "decodes a text string that is a uuid.", "uuid", snip.UUIDUUID(), parseText(snip.UUIDParseUUID()))
+}
+
+func astForCBORDecodeDateTime() *jen.Statement {
+	return astForCBORDecodeText(cborDecodeDateTimeMethod, "decodes a text string that is a datetime.", "datetime", snip.DateTimeDateTime(), parseText(snip.DateTimeParseDateTime()))
+}
+
+func astForCBORDecodeRID() *jen.Statement {
+	return astForCBORDecodeText(cborDecodeRIDMethod, "decodes a text string that is a rid.", "rid", snip.RIDResourceIdentifier(), parseText(snip.RIDParseRID()))
+}
+
+func astForCBORDecodeBinary() *jen.Statement {
+	r := jen.Id(cborReaderVarName)
+	return jen.Commentf("%s decodes a byte string. The decoded value does not share memory with the data of the reader.", cborDecodeBinaryMethod).Line().
+		Add(cborReaderMethod(cborDecodeBinaryMethod)).Params(jen.Id("v").Op("*").Index().Byte()).Error().Block(
+		jen.List(jen.Id("b"), jen.Err()).Op(":=").Add(r).Dot("readString").Call(jen.Lit(cborMajorBytes), jen.Lit("binary")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+		jen.Op("*").Id("v").Op("=").Append(jen.Index().Byte().Values(), jen.Id("b").Op("...")),
+		jen.Return(jen.Nil()),
+	)
+}
+
+func astForCBORDecodeInt() *jen.Statement {
+	i := jen.Id("i")
+	return jen.Commentf("%s decodes an integer that is a Conjure integer, which must fit in 32 bits.", cborDecodeIntMethod).Line().
+		Add(cborReaderMethod(cborDecodeIntMethod)).Params(jen.Id("v").Op("*").Int()).Error().Block(
+		jen.List(i.Clone(), jen.Err()).Op(":=").Id(cborReaderVarName).Dot("readInteger").Call(jen.Lit("integer")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+		jen.If(i.Clone().Op("<").Add(snip.MathMinInt32()).Op("||").Add(i.Clone()).Op(">").Add(snip.MathMaxInt32())).Block(
+			jen.Return(snip.FmtErrorf().Call(jen.Lit("invalid integer %d"), i.Clone())),
+		),
+		jen.Op("*").Id("v").Op("=").Int().Call(i.Clone()),
+		jen.Return(jen.Nil()),
+	)
+}
+
+func astForCBORDecodeSafeLong() *jen.Statement {
+	return jen.Commentf("%s decodes an integer that is a safelong.", cborDecodeSafeLongMethod).Line().
+		Add(cborReaderMethod(cborDecodeSafeLongMethod)).Params(jen.Id("v").Op("*").Add(snip.SafeLongSafeLong())).Error().Block(
+		jen.List(jen.Id("i"), jen.Err()).Op(":=").Id(cborReaderVarName).Dot("readInteger").Call(jen.Lit("safelong")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+		jen.List(jen.Id("parsed"), jen.Err()).Op(":=").Add(snip.SafeLongNewSafeLong()).Call(jen.Id("i")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+		jen.Op("*").Id("v").Op("=").Id("parsed"),
+		jen.Return(jen.Nil()),
+	)
+}
+
+func astForCBORDecodeFloat64() *jen.Statement {
+	major, info, arg, v := jen.Id("major"), jen.Id("info"), jen.Id("arg"), jen.Id("v")
+	return jen.Commentf("%s decodes a float or an integer.", cborDecodeFloat64Method).Line().
+		Add(cborReaderMethod(cborDecodeFloat64Method)).Params(v.Clone().Op("*").Float64()).Error().Block(
+		jen.List(major.Clone(), info.Clone(), arg.Clone(), jen.Err()).Op(":=").Id(cborReaderVarName).Dot("head").Call(),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+		jen.Switch().Block(
+			jen.Case(major.Clone().Op("<=").Lit(1)).Block(
+				jen.List(jen.Id("i"), jen.Err()).Op(":=").Id(cborIntegerFunc).Call(major.Clone(), arg.Clone(), jen.Lit("double")),
+				jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+				jen.Op("*").Add(v.Clone()).Op("=").Float64().Call(jen.Id("i")),
+			),
+			jen.Case(major.Clone().Op("==").Lit(7).Op("&&").Add(info.Clone()).Op(">=").Lit(25).Op("&&").Add(info.Clone()).Op("<=").Lit(27)).Block(
+				jen.Op("*").Add(v.Clone()).Op("=").Id(cborFloatFunc).Call(info.Clone(), arg.Clone()),
+			),
+			jen.Default().Block(jen.Return(jen.Id(cborTypeErrorFunc).Call(major.Clone(), jen.Lit("double")))),
+		),
+		jen.Return(jen.Nil()),
+	)
+}
+
+func astForCBORDecodeBool() *jen.Statement {
+	major, info := jen.Id("major"), jen.Id("info")
+	return jen.Commentf("%s decodes a boolean.", cborDecodeBoolMethod).Line().
+		Add(cborReaderMethod(cborDecodeBoolMethod)).Params(jen.Id("v").Op("*").Bool()).Error().Block(
+		jen.List(major.Clone(), info.Clone(), jen.Id("_"), jen.Err()).Op(":=").Id(cborReaderVarName).Dot("head").Call(),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+		jen.If(major.Clone().Op("!=").Lit(7).Op("||").Add(info.Clone()).Op("!=").Lit(20).Op("&&").Add(info.Clone()).Op("!=").Lit(21)).Block(
+			jen.Return(jen.Id(cborTypeErrorFunc).Call(major.Clone(), jen.Lit("boolean"))),
+		),
+		jen.Op("*").Id("v").Op("=").Add(info.Clone()).Op("==").Lit(21),
+		jen.Return(jen.Nil()),
+	)
+}
+
+// astForCBORReadAny returns a method of cborReader that decodes any data item into the values that safejson.Unmarshal
+// decodes JSON into, so that values of any fields decoded from CBOR and JSON are the same.
+func astForCBORReadAny() *jen.Statement {
+	r := jen.Id(cborReaderVarName)
+	major, info, arg, err := jen.Id("major"), jen.Id("info"), jen.Id("arg"), jen.Err()
+	return jen.Commentf("%s decodes the next data item into a value like the ones that safejson.Unmarshal decodes JSON into:", cborReadAnyMethod).Line().
+		Comment("arrays are decoded into []interface{}, maps into map[string]interface{} and numbers into json.Number, except").Line().
+		Comment("for NaN and infinite floats, which are decoded into float64.").Line().
+		Add(cborReaderMethod(cborReadAnyMethod)).Params().Params(jen.Interface(), jen.Error()).Block(
+		jen.If(r.Clone().Dot("decodeNull").Call()).Block(jen.Return(jen.Nil(), jen.Nil())),
+		jen.List(major.Clone(), info.Clone(), arg.Clone(), err.Clone()).Op(":=").Add(r.Clone()).Dot("head").Call(),
+		returnIfErr(jen.Nil()),
+		jen.Switch(major.Clone()).Block(
+			jen.Case(jen.Lit(0), jen.Lit(1)).Block(
+				jen.List(jen.Id("i"), err.Clone()).Op(":=").Id(cborIntegerFunc).Call(major.Clone(), arg.Clone(), jen.Lit("integer")),
+				returnIfErr(jen.Nil()),
+				jen.Return(snip.JSONNumber().Call(snip.StrconvFormatInt().Call(jen.Id("i"), jen.Lit(10))), jen.Nil()),
+			),
+			jen.Case(jen.Lit(cborMajorBytes), jen.Lit(cborMajorText)).Block(
+				jen.List(jen.Id("s"), err.Clone()).Op(":=").Add(r.Clone()).Dot("stringBody").Call(major.Clone(), info.Clone(), arg.Clone()),
+				returnIfErr(jen.Nil()),
+				jen.If(major.Clone().Op("==").Lit(cborMajorBytes)).Block(
+					jen.Return(jen.Append(jen.Index().Byte().Values(), jen.Id("s").Op("...")), jen.Nil()),
+				),
+				jen.Return(jen.String().Call(jen.Id("s")), jen.Nil()),
+			),
+			jen.Case(jen.Lit(cborMajorArray)).Block(
+				jen.Id("list").Op(":=").Make(jen.Index().Interface(), jen.Lit(0)),
+				cborIndefiniteLoop().Block(
+					jen.List(jen.Id("elem"), err.Clone()).Op(":=").Add(r.Clone()).Dot(cborReadAnyMethod).Call(),
+					returnIfErr(jen.Nil()),
+					jen.Id("list").Op("=").Append(jen.Id("list"), jen.Id("elem")),
+				),
+				jen.Return(jen.Id("list"), jen.Nil()),
+			),
+			jen.Case(jen.Lit(cborMajorMap)).Block(
+				jen.Id("m").Op(":=").Make(jen.Map(jen.String()).Interface()),
+				cborIndefiniteLoop().Block(
+					jen.List(jen.Id("key"), err.Clone()).Op(":=").Add(r.Clone()).Dot(cborReadAnyMethod).Call(),
+					returnIfErr(jen.Nil()),
+					jen.List(jen.Id("k"), jen.Id("ok")).Op(":=").Id("key").Assert(jen.String()),
+					jen.If(jen.Op("!").Id("ok")).Block(
+						jen.Return(jen.Nil(), snip.FmtErrorf().Call(jen.Lit("unsupported CBOR map key %v"), jen.Id("key"))),
+					),
+					jen.List(jen.Id("value"), err.Clone()).Op(":=").Add(r.Clone()).Dot(cborReadAnyMethod).Call(),
+					returnIfErr(jen.Nil()),
+					jen.Id("m").Index(jen.Id("k")).Op("=").Id("value"),
+				),
+				jen.Return(jen.Id("m"), jen.Nil()),
+			),
+			jen.Case(jen.Lit(7)).Block(
+				jen.Switch(info.Clone()).Block(
+					jen.Case(jen.Lit(20), jen.Lit(21)).Block(jen.Return(info.Clone().Op("==").Lit(21), jen.Nil())),
+					jen.Case(jen.Lit(25), jen.Lit(26), jen.Lit(27)).Block(
+						jen.Id("f").Op(":=").Id(cborFloatFunc).Call(info.Clone(), arg.Clone()),
+						jen.If(snip.MathIsNaN().Call(jen.Id("f")).Op("||").Add(snip.MathIsInf()).Call(jen.Id("f"), jen.Lit(0))).Block(
+							jen.Return(jen.Id("f"), jen.Nil()),
+						),
+						jen.Return(snip.JSONNumber().Call(snip.StrconvFormatFloat().Call(jen.Id("f"), jen.LitRune('g'), jen.Lit(-1), jen.Lit(64))), jen.Nil()),
+					),
+				),
+			),
+		),
+		jen.Return(jen.Nil(), jen.Id(cborTypeErrorFunc).Call(major.Clone(), jen.Lit("value"))),
+	)
+}
+
+func astForCBORDecodeAny() *jen.Statement {
+	return jen.Commentf("%s decodes any data item using %s.", cborDecodeAnyMethod, cborReadAnyMethod).Line().
+		Add(cborReaderMethod(cborDecodeAnyMethod)).Params(jen.Id("v").Op("*").Interface()).Error().Block(
+		jen.List(jen.Id("value"), jen.Err()).Op(":=").Id(cborReaderVarName).Dot(cborReadAnyMethod).Call(),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+		jen.Op("*").Id("v").Op("=").Id("value"),
+		jen.Return(jen.Nil()),
+	)
+}
+
+func astForCBORDecodeJSON() *jen.Statement {
+	return jen.Commentf("%s decodes the next data item into v, a value of a type without CBOR methods, using the JSON", cborDecodeJSONMethod).Line().
+		Comment("encoding of the item.").Line().
+		Add(cborReaderMethod(cborDecodeJSONMethod)).Params(jen.Id("v").Interface()).Error().Block(
+		jen.List(jen.Id("value"), jen.Err()).Op(":=").Id(cborReaderVarName).Dot(cborReadAnyMethod).Call(),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+		jen.List(jen.Id("data"), jen.Err()).Op(":=").Add(snip.SafeJSONMarshal()).Call(jen.Id("value")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+		jen.Return(snip.SafeJSONUnmarshal().Call(jen.Id("data"), jen.Id("v"))),
+	)
+}
+
+func astForCBORDecodeUnmarshaler() *jen.Statement {
+	r := jen.Id(cborReaderVarName)
+	return jen.Commentf("%s decodes the next data item into v, a value of a type of another package.", cborDecodeUnmarshalerMethod).Line().
+		Add(cborReaderMethod(cborDecodeUnmarshalerMethod)).Params(jen.Id("v").Interface(jen.Id("UnmarshalCBOR").Params(jen.Index().Byte()).Error())).Error().Block(
+		jen.Id("start").Op(":=").Add(r.Clone()).Dot("pos"),
+		jen.If(jen.Err().Op(":=").Add(r.Clone()).Dot("skip").Call(), jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+		jen.Return(jen.Id("v").Dot("UnmarshalCBOR").Call(r.Clone().Dot("data").Index(jen.Id("start"), r.Clone().Dot("pos")))),
+	)
+}
+
+func astForCBORContentType() *jen.Statement {
+	return jen.Commentf("%s is the media type of CBOR request and response bodies.", cborContentTypeConst).Line().
+		Const().Id(cborContentTypeConst).Op("=").Lit("application/cbor")
+}
+
+// astForCBORCodec returns a codecs.Codec for the types with CBOR methods, which is used by clients and handlers.
+func astForCBORCodec() *jen.Statement {
+	recv := func() *jen.Statement { return jen.Func().Params(jen.Id(cborCodecType)) }
+	recvC := func() *jen.Statement { return jen.Func().Params(jen.Id("c").Id(cborCodecType)) }
+	unmarshaler := jen.Interface(jen.Id("UnmarshalCBOR").Params(jen.Index().Byte()).Error())
+	marshaler := jen.Interface(jen.Id("MarshalCBOR").Params().Params(jen.Index().Byte(), jen.Error()))
+	return jen.Commentf("%s encodes and decodes values of types with MarshalCBOR and UnmarshalCBOR methods.", cborCodecType).Line().
+		Type().Id(cborCodecType).Struct().Line().Line().
+		Add(recv()).Id("Accept").Params().String().Block(jen.Return(jen.Id(cborContentTypeConst))).Line().Line().
+		Add(recvC()).Id("Decode").Params(jen.Id("r").Add(snip.IOReader()), jen.Id("v").Interface()).Error().Block(
+		jen.List(jen.Id("data"), jen.Err()).Op(":=").Add(snip.IOReadAll()).Call(jen.Id("r")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+		jen.Return(jen.Id("c").Dot("Unmarshal").Call(jen.Id("data"), jen.Id("v"))),
+	).Line().Line().
+		Add(recv()).Id("Unmarshal").Params(jen.Id("data").Index().Byte(), jen.Id("v").Interface()).Error().Block(
+		jen.List(jen.Id("u"), jen.Id("ok")).Op(":=").Id("v").Assert(unmarshaler),
+		jen.If(jen.Op("!").Id("ok")).Block(jen.Return(snip.FmtErrorf().Call(jen.Lit("%T does not implement UnmarshalCBOR"), jen.Id("v")))),
+		jen.Return(jen.Id("u").Dot("UnmarshalCBOR").Call(jen.Id("data"))),
+	).Line().Line().
+		Add(recv()).Id("ContentType").Params().String().Block(jen.Return(jen.Id(cborContentTypeConst))).Line().Line().
+		Add(recvC()).Id("Encode").Params(jen.Id("w").Add(snip.IOWriter()), jen.Id("v").Interface()).Error().Block(
+		jen.List(jen.Id("data"), jen.Err()).Op(":=").Id("c").Dot("Marshal").Call(jen.Id("v")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+		jen.List(jen.Id("_"), jen.Err()).Op("=").Id("w").Dot("Write").Call(jen.Id("data")),
+		jen.Return(jen.Err()),
+	).Line().Line().
+		Add(recv()).Id("Marshal").Params(jen.Id("v").Interface()).Params(jen.Index().Byte(), jen.Error()).Block(
+		jen.List(jen.Id("m"), jen.Id("ok")).Op(":=").Id("v").Assert(marshaler),
+		jen.If(jen.Op("!").Id("ok")).Block(jen.Return(jen.Nil(), snip.FmtErrorf().Call(jen.Lit("%T does not implement MarshalCBOR"), jen.Id("v")))),
+		jen.Return(jen.Id("m").Dot("MarshalCBOR").Call()),
+	)
+}
+
+func astForIsCBORRequest() *jen.Statement {
+	return jen.Commentf("%s returns true if the body of req is encoded as CBOR.", isCBORRequestFunc).Line().
+		Func().Id(isCBORRequestFunc).Params(jen.Id("req").Op("*").Add(snip.HTTPRequest())).Bool().Block(
+		jen.List(jen.Id("mediaType"), jen.Id("_"), jen.Err()).Op(":=").Add(snip.MIMEParseMediaType()).Call(jen.Id("req").Dot("Header").Dot("Get").Call(jen.Lit("Content-Type"))),
+		jen.Return(jen.Err().Op("==").Nil().Op("&&").Id("mediaType").Op("==").Id(cborContentTypeConst)),
+	)
+}
+
+func astForAcceptsCBOR() *jen.Statement {
+	return jen.Commentf("%s returns true if req prefers CBOR responses to JSON responses, which is the case if its Accept", acceptsCBORFunc).Line().
+		Comment("header lists application/cbor before application/json. Media ranges with a quality of 0 are ignored.").Line().
+		Func().Id(acceptsCBORFunc).Params(jen.Id("req").Op("*").Add(snip.HTTPRequest())).Bool().Block(
+		jen.For(jen.List(jen.Id("_"), jen.Id("accept")).Op(":=").Range().Id("req").Dot("Header").Dot("Values").Call(jen.Lit("Accept"))).Block(
+			jen.For(jen.List(jen.Id("_"), jen.Id("mediaRange")).Op(":=").Range().Add(snip.StringsSplit()).Call(jen.Id("accept"), jen.Lit(","))).Block(
+				jen.List(jen.Id("mediaType"), jen.Id("params"), jen.Err()).Op(":=").Add(snip.MIMEParseMediaType()).Call(jen.Id("mediaRange")),
+				jen.If(jen.Err().Op("!=").Nil().Op("||").Id("params").Index(jen.Lit("q")).Op("==").Lit("0")).Block(jen.Continue()),
+				jen.Switch(jen.Id("mediaType")).Block(
+					jen.Case(jen.Id(cborContentTypeConst)).Block(jen.Return(jen.True())),
+					jen.Case(jen.Lit("application/json")).Block(jen.Return(jen.False())),
+				),
+			),
+		),
+		jen.Return(jen.False()),
+	)
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/palantir/conjure-go/v6/conjure/snip"
+	"github.com/palantir/conjure-go/v6/conjure/transforms"
+	"github.com/palantir/conjure-go/v6/conjure/types"
+)
+
+const (
+	iterReceiverName = "i"
+	iterCtxField     = "ctx"
+	iterBodyField    = "body"
+	iterDecoderField = "decoder"
+	iterStartedField = "started"
+	iterValueField   = "value"
+	iterErrField     = "err"
+	iterStopMethod   = "stop"
+)
+
+// clientIterElemType returns the element type of the response of endpointDef and true if the client has an
+// <Endpoint>Iter method for the endpoint, which is the case for endpoints that return a list or set.
+func clientIterElemType(endpointDef *types.EndpointDefinition) (types.Type, bool) {
+	if endpointDef.Returns == nil {
+		return nil, false
+	}
+	switch t := (*endpointDef.Returns).(type) {
+	case *types.List:
+		return t.Item, true
+	case *types.Set:
+		return t.Item, true
+	}
+	return nil, false
+}
+
+func clientIterMethodName(endpointDef *types.EndpointDefinition) string {
+	return transforms.Export(endpointDef.EndpointName) + "Iter"
+}
+
+func clientIterTypeName(serviceName string, endpointDef *types.EndpointDefinition) string {
+	return transforms.Export(serviceName) + transforms.Export(endpointDef.EndpointName) + "Iterator"
+}
+
+// astForClientIterInterfaceMethod returns the declaration of the <Endpoint>Iter method of the client interface.
+func astForClientIterInterfaceMethod(serviceName string, endpointDef *types.EndpointDefinition, withAuth bool) *jen.Statement {
+	return jen.Commentf("%s is like %s, but returns an iterator over the elements of the response, which are decoded",
+		clientIterMethodName(endpointDef), transforms.Export(endpointDef.EndpointName)).Line().
+		Comment("from the response body as they are read.").Line().
+		Id(clientIterMethodName(endpointDef)).
+		ParamsFunc(func(args *jen.Group) {
+			astForEndpointArgsFunc(args, endpointDef, withAuth, false)
+		}).
+		Params(jen.Op("*").Id(clientIterTypeName(serviceName, endpointDef)), jen.Error())
+}
+
+// astForClientIterMethod returns the <Endpoint>Iter method of the client struct, which sends the request of the
+// endpoint and returns an iterator that decodes the response body.
+func astForClientIterMethod(serviceName string, endpointDef *types.EndpointDefinition, cw *cborWriter) *jen.Statement {
+	return jen.Func().
+		Params(jen.Id(clientReceiverName).Op("*").Id(clientStructTypeName(serviceName))).
+		Id(clientIterMethodName(endpointDef)).
+		ParamsFunc(func(args *jen.Group) {
+			astForEndpointArgsFunc(args, endpointDef, false, false)
+		}).
+		Params(jen.Op("*").Id(clientIterTypeName(serviceName, endpointDef)), jen.Error()).
+		BlockFunc(func(methodBody *jen.Group) {
+			astForEndpointMethodBodyRequestParams(methodBody, endpointDef, true, cw)
+			methodBody.List(jen.Id(respVar), jen.Err()).Op(":=").Id(clientReceiverName).Dot(clientStructFieldName).Dot("Do").Call(
+				jen.Id(ctxName),
+				jen.Id(requestParamsVar).Op("..."),
+			)
+			methodBody.If(jen.Err().Op("!=").Nil()).Block(jen.Return(
+				jen.Nil(),
+				snip.WerrorWrapContext().Call(jen.Id(ctxName), jen.Err(), jen.Lit(fmt.Sprintf("%s failed", endpointDef.EndpointName))),
+			))
+			methodBody.Return(jen.Op("&").Id(clientIterTypeName(serviceName, endpointDef)).Values(
+				jen.Id(iterCtxField).Op(":").Id(ctxName),
+				jen.Id(iterBodyField).Op(":").Id(respVar).Dot("Body"),
+				jen.Id(iterDecoderField).Op(":").Add(snip.SafeJSONDecoder()).Call(jen.Id(respVar).Dot("Body")),
+			), jen.Nil())
+		})
+}
+
+// astForClientIterAuthMethod returns the <Endpoint>Iter method of the client struct that provides the auth token of
+// the endpoint.
+func astForClientIterAuthMethod(serviceName string, endpointDef *types.EndpointDefinition) *jen.Statement {
+	return jen.Func().
+		Params(jen.Id(clientReceiverName).Op("*").Id(withAuthName(clientStructTypeName(serviceName)))).
+		Id(clientIterMethodName(endpointDef)).
+		ParamsFunc(func(args *jen.Group) {
+			astForEndpointArgsFunc(args, endpointDef, true, false)
+		}).
+		Params(jen.Op("*").Id(clientIterTypeName(serviceName, endpointDef)), jen.Error()).
+		BlockFunc(func(methodBody *jen.Group) {
+			astForEndpointAuthMethodBodyFunc(methodBody, endpointDef, clientIterMethodName(endpointDef))
+		})
+}
+
+// astForClientIterTokenServiceMethod returns the <Endpoint>Iter method of the client struct that gets the auth token of
+// the endpoint from a token provider.
+func astForClientIterTokenServiceMethod(serviceName string, endpointDef *types.EndpointDefinition) *jen.Statement {
+	hasAuth := endpointDef.HeaderAuth || endpointDef.CookieAuth != nil
+	return jen.Func().
+		Params(jen.Id(clientReceiverName).Op("*").Id(withTokenProviderName(clientStructTypeName(serviceName)))).
+		Id(clientIterMethodName(endpointDef)).
+		ParamsFunc(func(args *jen.Group) {
+			astForEndpointArgsFunc(args, endpointDef, hasAuth, false)
+		}).
+		Params(jen.Op("*").Id(clientIterTypeName(serviceName, endpointDef)), jen.Error()).
+		BlockFunc(func(methodBody *jen.Group) {
+			if hasAuth {
+				methodBody.List(jen.Id("token"), jen.Err()).Op(":=").Id(clientReceiverName).Dot(tokenProviderVar).Call(jen.Id(ctxName))
+				methodBody.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err()))
+			}
+			methodBody.Return(jen.Id(clientReceiverName).Dot(clientStructFieldName).Dot(clientIterMethodName(endpointDef)).
+				CallFunc(func(args *jen.Group) {
+					args.Id(ctxName)
+					if hasAuth {
+						args.Add(types.Bearertoken{}.Code()).Call(jen.Id("token"))
+					}
+					for _, paramDef := range endpointDef.Params {
+						args.Id(transforms.ArgName(paramDef.Name))
+					}
+				}))
+		})
+}
+
+// astForClientIterType returns the declaration and methods of the iterator returned by the <Endpoint>Iter method of
+// the client, which decodes the elements of the JSON array in the response body one at a time.
+func astForClientIterType(serviceName string, endpointDef *types.EndpointDefinition, elemType types.Type) *jen.Statement {
+	typeName := clientIterTypeName(serviceName, endpointDef)
+	receiver := jen.Id(iterReceiverName).Op("*").Id(typeName)
+	field := func(name string) *jen.Statement { return jen.Id(iterReceiverName).Dot(name) }
+	failed := jen.Lit(fmt.Sprintf("%s failed", endpointDef.EndpointName))
+	return jen.Commentf("%s iterates over the elements of the response of %s, which are decoded from the", typeName, transforms.Export(endpointDef.EndpointName)).Line().
+		Comment("response body as they are read. The body is closed when Next returns false or when Close is called.").Line().
+		Type().Id(typeName).Struct(
+		jen.Id(iterCtxField).Add(snip.Context()),
+		jen.Id(iterBodyField).Add(snip.IOReadCloser()),
+		jen.Id(iterDecoderField).Op("*").Add(snip.JSONDecoder()),
+		jen.Id(iterStartedField).Bool(),
+		jen.Id(iterValueField).Add(elemType.Code()),
+		jen.Id(iterErrField).Error(),
+	).Line().Line().
+		Comment("Next decodes the next element of the response and returns true if there is one. It returns false when all").Line().
+		Comment("elements have been read or if the response could not be decoded, in which case Err returns the error.").Line().
+		Func().Params(receiver.Clone()).Id("Next").Params().Bool().BlockFunc(func(g *jen.Group) {
+		g.If(field(iterDecoderField).Op("==").Nil()).Block(jen.Return(jen.False()))
+		g.If(jen.Op("!").Add(field(iterStartedField))).BlockFunc(func(g *jen.Group) {
+			g.Add(field(iterStartedField)).Op("=").True()
+			g.List(jen.Id("token"), jen.Err()).Op(":=").Add(field(iterDecoderField)).Dot("Token").Call()
+			g.Switch().Block(
+				jen.Case(jen.Err().Op("!=").Nil()).Block(
+					jen.Return(field(iterStopMethod).Call(snip.WerrorWrapContext().Call(field(iterCtxField), jen.Err(), failed.Clone()))),
+				),
+				jen.Case(jen.Id("token").Op("==").Nil()).Block(
+					jen.Return(field(iterStopMethod).Call(snip.WerrorErrorContext().Call(field(iterCtxField),
+						jen.Lit(fmt.Sprintf("%s response cannot be nil", endpointDef.EndpointName))))),
+				),
+				jen.Case(jen.Id("token").Op("!=").Add(snip.JSONDelim()).Call(jen.LitRune('['))).Block(
+					jen.Return(field(iterStopMethod).Call(snip.WerrorErrorContext().Call(field(iterCtxField),
+						jen.Lit(fmt.Sprintf("%s response must be a JSON array", endpointDef.EndpointName))))),
+				),
+			)
+		})
+		g.If(jen.Op("!").Add(field(iterDecoderField)).Dot("More").Call()).Block(
+			jen.Comment("consume the end of the array"),
+			jen.If(
+				jen.List(jen.Id("_"), jen.Err()).Op(":=").Add(field(iterDecoderField)).Dot("Token").Call(),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(field(iterStopMethod).Call(snip.WerrorWrapContext().Call(field(iterCtxField), jen.Err(), failed.Clone()))),
+			),
+			jen.Return(field(iterStopMethod).Call(jen.Nil())),
+		)
+		g.Var().Id(iterValueField).Add(elemType.Code())
+		g.If(
+			jen.Err().Op(":=").Add(field(iterDecoderField)).Dot("Decode").Call(jen.Op("&").Id(iterValueField)),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(field(iterStopMethod).Call(snip.WerrorWrapContext().Call(field(iterCtxField), jen.Err(), failed.Clone()))),
+		)
+		g.Add(field(iterValueField)).Op("=").Id(iterValueField)
+		g.Return(jen.True())
+	}).Line().Line().
+		Comment("Value returns the element decoded by the last call to Next.").Line().
+		Func().Params(receiver.Clone()).Id("Value").Params().Add(elemType.Code()).Block(
+		jen.Return(field(iterValueField)),
+	).Line().Line().
+		Comment("Err returns the error that stopped the iteration, if any.").Line().
+		Func().Params(receiver.Clone()).Id("Err").Params().Error().Block(
+		jen.Return(field(iterErrField)),
+	).Line().Line().
+		Comment("Close closes the response body. It must be called if the iteration is stopped before Next returns false.").Line().
+		Func().Params(receiver.Clone()).Id("Close").Params().Error().Block(
+		jen.If(field(iterDecoderField).Op("==").Nil()).Block(jen.Return(jen.Nil())),
+		field(iterDecoderField).Op("=").Nil(),
+		jen.Return(field(iterBodyField).Dot("Close").Call()),
+	).Line().Line().
+		Func().Params(receiver.Clone()).Id(iterStopMethod).Params(jen.Err().Error()).Bool().Block(
+		jen.Var().Id("zero").Add(elemType.Code()),
+		field(iterValueField).Op("=").Id("zero"),
+		field(iterErrField).Op("=").Err(),
+		jen.If(
+			jen.Id("closeErr").Op(":=").Add(field("Close")).Call(),
+			field(iterErrField).Op("==").Nil().Op("&&").Id("closeErr").Op("!=").Nil(),
+		).Block(
+			field(iterErrField).Op("=").Add(snip.WerrorWrapContext()).Call(field(iterCtxField), jen.Id("closeErr"), failed.Clone()),
+		),
+		jen.Return(jen.False()),
+	)
+}
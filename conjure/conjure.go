@@ -36,53 +36,128 @@ func Generate(conjureDefinition spec.ConjureDefinition, outputConfiguration Outp
 			return err
 		}
 	}
-	return nil
+	if outputConfiguration.KeepStaleFiles {
+		return nil
+	}
+	return removeStaleOutputFiles(files)
 }
 
 func GenerateOutputFiles(conjureDefinition spec.ConjureDefinition, cfg OutputConfiguration) ([]*OutputFile, error) {
-	def, err := types.NewConjureDefinition(cfg.OutputDir, conjureDefinition)
+	if err := cfg.validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid configuration")
+	}
+	def, err := types.NewConjureDefinitionWithOptions(conjureDefinition, types.Options{
+		OutputDir: cfg.OutputDir,
+		PackageOutputDir: func(conjurePkg string) string {
+			return cfg.ForPackage(conjurePkg).OutputDir
+		},
+		PackagePaths:     cfg.PackagePaths,
+		ExternalPackages: cfg.ExternalPackages,
+		SetTypes: func(conjurePkg string) bool {
+			return cfg.ForPackage(conjurePkg).GenerateSetTypes
+		},
+		EndpointErrors: func(conjurePkg string) bool {
+			return cfg.ForPackage(conjurePkg).GenerateErrorMapping
+		},
+	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "invalid configuration")
 	}
 
+	jsonTypes := newJSONTypes(def)
+	equalityTypes := newEqualityTypes(def, cfg)
+	cborTypes := newCBORTypes(def, cfg)
 	var files []*OutputFile
 	for _, pkg := range def.Packages {
+		if pkg.External {
+			continue
+		}
+		cfg := cfg.ForPackage(pkg.ConjurePackage)
+		jw := newJSONWriter(jsonTypes, pkg)
+		ew := newEqualityWriter(equalityTypes, cfg.GenerateHash)
+		var cw *cborWriter
+		if cfg.GenerateCBOR {
+			cw = newCBORWriter(cborTypes, pkg)
+		}
 		if len(pkg.Aliases) > 0 {
 			aliasFile := newJenFile(pkg, def)
 			for _, alias := range pkg.Aliases {
-				writeAliasType(aliasFile.Group, alias)
+				writeAliasType(aliasFile.Group, alias, jw)
+				ew.writeAliasMethods(aliasFile.Group, alias)
+				if cw != nil {
+					cw.writeAliasMethods(aliasFile.Group, alias)
+				}
 			}
 			files = append(files, newGoFile(filepath.Join(pkg.OutputDir, "aliases.conjure.go"), aliasFile))
 		}
 		if len(pkg.Enums) > 0 {
 			enumFile := newJenFile(pkg, def)
 			for _, enum := range pkg.Enums {
-				writeEnumType(enumFile.Group, enum)
+				writeEnumType(enumFile.Group, enum, jw)
+				ew.writeEnumMethods(enumFile.Group, enum)
+				if cw != nil {
+					cw.writeEnumMethods(enumFile.Group, enum)
+				}
 			}
 			files = append(files, newGoFile(filepath.Join(pkg.OutputDir, "enums.conjure.go"), enumFile))
 		}
 		if len(pkg.Objects) > 0 {
+			if cfg.GenerateBuilders {
+				if err := checkBuilderNames(pkg); err != nil {
+					return nil, err
+				}
+			}
 			objectFile := newJenFile(pkg, def)
 			for _, object := range pkg.Objects {
-				writeObjectType(objectFile.Group, object)
+				writeObjectType(objectFile.Group, object, cfg.GenerateBuilders, jw)
+				ew.writeObjectMethods(objectFile.Group, object)
+				if cw != nil {
+					cw.writeObjectMethods(objectFile.Group, object)
+				}
 			}
 			files = append(files, newGoFile(filepath.Join(pkg.OutputDir, "structs.conjure.go"), objectFile))
 		}
 		if len(pkg.Unions) > 0 {
+			if cfg.GenerateUnionHelpers {
+				if err := checkUnionHelperNames(pkg); err != nil {
+					return nil, err
+				}
+			}
 			unionFile := newJenFile(pkg, def)
 			goUnionGenericsFile := newJenFile(pkg, def)
 			goUnionGenericsFile.Comment("//go:build go1.18")
 			for _, union := range pkg.Unions {
-				writeUnionType(unionFile.Group, union, cfg.GenerateFuncsVisitor)
+				writeUnionType(unionFile.Group, union, cfg.GenerateFuncsVisitor, jw)
+				if cfg.GenerateUnionHelpers {
+					writeUnionHelpers(unionFile.Group, union)
+				}
+				ew.writeUnionMethods(unionFile.Group, union)
+				if cw != nil {
+					cw.writeUnionMethods(unionFile.Group, union)
+				}
 				writeUnionTypeWithGenerics(goUnionGenericsFile.Group, union)
+				if cfg.GenerateUnionHelpers {
+					unionMatchFunc(goUnionGenericsFile.Group, union)
+				}
 			}
 			files = append(files, newGoFile(filepath.Join(pkg.OutputDir, "unions.conjure.go"), unionFile))
 			files = append(files, newGoFile(filepath.Join(pkg.OutputDir, "unions_generics.conjure.go"), goUnionGenericsFile))
 		}
+		if len(pkg.Sets) > 0 {
+			setFile := newJenFile(pkg, def)
+			for _, set := range pkg.Sets {
+				writeSetType(setFile.Group, set, jw)
+				ew.writeSetMethods(setFile.Group, set)
+				if cw != nil {
+					cw.writeSetMethods(setFile.Group, set)
+				}
+			}
+			files = append(files, newGoFile(filepath.Join(pkg.OutputDir, "sets.conjure.go"), setFile))
+		}
 		if len(pkg.Errors) > 0 {
 			errorFile := newJenFile(pkg, def)
 			for _, errorDef := range pkg.Errors {
-				writeErrorType(errorFile.Group, errorDef)
+				writeErrorType(errorFile.Group, errorDef, cfg.GenerateErrorMapping, jw)
 			}
 			astErrorInitFunc(errorFile.Group, pkg.Errors)
 			files = append(files, newGoFile(filepath.Join(pkg.OutputDir, "errors.conjure.go"), errorFile))
@@ -90,7 +165,16 @@ func GenerateOutputFiles(conjureDefinition spec.ConjureDefinition, cfg OutputCon
 		if len(pkg.Services) > 0 {
 			serviceFile := newJenFile(pkg, def)
 			for _, service := range pkg.Services {
-				writeServiceType(serviceFile.Group, service)
+				writeServiceType(serviceFile.Group, service, cfg.GenerateClientIterators, cw)
+				if cfg.GenerateErrorMapping {
+					writeEndpointErrorFuncs(serviceFile.Group, service)
+				}
+				if cfg.GenerateEndpointMetadata {
+					writeEndpointMetadata(serviceFile.Group, service)
+				}
+			}
+			if cfg.GenerateEndpointMetadata {
+				writeEndpointMetadataTypes(serviceFile.Group)
 			}
 			files = append(files, newGoFile(filepath.Join(pkg.OutputDir, "services.conjure.go"), serviceFile))
 		}
@@ -102,10 +186,79 @@ func GenerateOutputFiles(conjureDefinition spec.ConjureDefinition, cfg OutputCon
 		if len(pkg.Services) > 0 && cfg.GenerateServer {
 			serverFile := newJenFile(pkg, def)
 			for _, server := range pkg.Services {
-				writeServerType(serverFile.Group, server)
+				writeServerType(serverFile.Group, server, serverOptions{
+					validateParams:     cfg.GenerateValidation,
+					validateAuth:       cfg.GenerateAuthValidator,
+					interceptEndpoints: cfg.GenerateEndpointInterceptors,
+					mapErrors:          cfg.GenerateErrorMapping,
+				}, jw, cw)
+				if cfg.GenerateErrorMapping {
+					writeServiceErrors(serverFile.Group, server)
+				}
+			}
+			if cfg.GenerateValidation {
+				writeServerValidateFunc(serverFile.Group)
+			}
+			if cfg.GenerateAuthValidator || cfg.GenerateEndpointInterceptors {
+				writeServerEndpointInfo(serverFile.Group)
+			}
+			if cfg.GenerateAuthValidator {
+				writeServerAuthValidator(serverFile.Group)
+			}
+			if cfg.GenerateEndpointInterceptors {
+				writeServerEndpointInterceptor(serverFile.Group)
+			}
+			if cfg.GenerateErrorMapping {
+				writeServerErrorMapper(serverFile.Group)
 			}
 			files = append(files, newGoFile(filepath.Join(pkg.OutputDir, "servers.conjure.go"), serverFile))
 		}
+		if len(pkg.Services) > 0 && cfg.GenerateFakes {
+			fakeFile := newJenFile(pkg, def)
+			for _, service := range pkg.Services {
+				if err := writeFakeType(fakeFile.Group, service, cfg.GenerateServer); err != nil {
+					return nil, err
+				}
+			}
+			files = append(files, newGoFile(filepath.Join(pkg.OutputDir, "fakes.conjure.go"), fakeFile))
+		}
+		// Written after all other files because handlers of servers use the helpers for decoding request bodies.
+		if jw.needsHelpers() {
+			jsonFile := newJenFile(pkg, def)
+			jw.writeHelpers(jsonFile.Group)
+			files = append(files, newGoFile(filepath.Join(pkg.OutputDir, "json.conjure.go"), jsonFile))
+		}
+		if cw != nil && cw.needsHelpers() {
+			cborFile := newJenFile(pkg, def)
+			cw.writeHelpers(cborFile.Group)
+			files = append(files, newGoFile(filepath.Join(pkg.OutputDir, "cbor.conjure.go"), cborFile))
+		}
+		if ew.needsHelpers() {
+			hashFile := newJenFile(pkg, def)
+			ew.writeHelpers(hashFile.Group)
+			files = append(files, newGoFile(filepath.Join(pkg.OutputDir, "hash.conjure.go"), hashFile))
+		}
+		if cfg.GenerateOpenAPI {
+			for _, service := range pkg.Services {
+				content, err := writeOpenAPIDocument(service, pkg.Errors, cfg.OpenAPIFormat)
+				if err != nil {
+					return nil, err
+				}
+				files = append(files, newRawFile(filepath.Join(pkg.OutputDir, openAPIFileName(service.Name, cfg.OpenAPIFormat)), content))
+			}
+		}
+		if len(pkg.Services) > 0 && cfg.GenerateServer && cfg.GenerateTestPairs {
+			testPkg, err := testPairPackage(pkg, def)
+			if err != nil {
+				return nil, err
+			}
+			testPairFile := newJenFile(testPkg, def)
+			writeTestPairOptionsType(testPairFile.Group)
+			for _, service := range pkg.Services {
+				writeTestPairType(testPairFile.Group, pkg.ImportPath, service)
+			}
+			files = append(files, newGoFile(filepath.Join(testPkg.OutputDir, "testpairs.conjure.go"), testPairFile))
+		}
 	}
 
 	sort.Slice(files, func(i, j int) bool {
@@ -118,6 +271,9 @@ func GenerateOutputFiles(conjureDefinition spec.ConjureDefinition, cfg OutputCon
 func newJenFile(pkg types.ConjurePackage, def *types.ConjureDefinition) *jen.File {
 	f := jen.NewFilePathName(pkg.ImportPath, pkg.PackageName)
 	f.ImportNames(snip.DefaultImportsToPackageNames)
+	for importPath, alias := range snip.DefaultImportsToAliases {
+		f.ImportAlias(importPath, alias)
+	}
 	for _, conjurePackage := range def.Packages {
 		if packageSuffixRequiresAlias(conjurePackage.ImportPath) {
 			f.ImportAlias(conjurePackage.ImportPath, conjurePackage.PackageName)
@@ -128,6 +284,27 @@ func newJenFile(pkg types.ConjurePackage, def *types.ConjureDefinition) *jen.Fil
 	return f
 }
 
+// packageTypeNames returns the names of the aliases, enums, objects, unions and errors of pkg.
+func packageTypeNames(pkg types.ConjurePackage) map[string]struct{} {
+	typeNames := map[string]struct{}{}
+	for _, aliasDef := range pkg.Aliases {
+		typeNames[aliasDef.Name] = struct{}{}
+	}
+	for _, enumDef := range pkg.Enums {
+		typeNames[enumDef.Name] = struct{}{}
+	}
+	for _, objectDef := range pkg.Objects {
+		typeNames[objectDef.Name] = struct{}{}
+	}
+	for _, unionDef := range pkg.Unions {
+		typeNames[unionDef.Name] = struct{}{}
+	}
+	for _, errorDef := range pkg.Errors {
+		typeNames[errorDef.Name] = struct{}{}
+	}
+	return typeNames
+}
+
 func newGoFile(filePath string, file *jen.File) *OutputFile {
 	return &OutputFile{
 		absPath: filePath,
@@ -135,6 +312,13 @@ func newGoFile(filePath string, file *jen.File) *OutputFile {
 	}
 }
 
+func newRawFile(filePath string, content []byte) *OutputFile {
+	return &OutputFile{
+		absPath: filePath,
+		content: content,
+	}
+}
+
 func packageSuffixRequiresAlias(importPath string) bool {
 	return regexp.MustCompile(`/v[0-9]+$`).MatchString(importPath)
 }
@@ -0,0 +1,93 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/palantir/conjure-go/v6/conjure/snip"
+	"github.com/palantir/conjure-go/v6/conjure/transforms"
+	"github.com/palantir/conjure-go/v6/conjure/types"
+)
+
+// writeEndpointErrorFuncs writes the Is<Service><Endpoint>Error and Match<Service><Endpoint>Error functions for the
+// endpoints of serviceDef that declare errors. Endpoints without declared errors have no functions.
+func writeEndpointErrorFuncs(file *jen.Group, serviceDef *types.ServiceDefinition) {
+	for _, endpointDef := range serviceDef.Endpoints {
+		if len(endpointDef.Errors) == 0 {
+			continue
+		}
+		astForIsEndpointErrorFunc(file, serviceDef.Name, endpointDef)
+		astForMatchEndpointErrorFunc(file, serviceDef.Name, endpointDef)
+	}
+}
+
+func endpointErrorFuncName(prefix, serviceName string, endpointDef *types.EndpointDefinition) string {
+	return prefix + transforms.Export(serviceName) + transforms.Export(endpointDef.EndpointName) + "Error"
+}
+
+func endpointErrorNames(endpointDef *types.EndpointDefinition) string {
+	names := make([]string, 0, len(endpointDef.Errors))
+	for _, errorDef := range endpointDef.Errors {
+		names = append(names, errorDef.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func astForIsEndpointErrorFunc(file *jen.Group, serviceName string, endpointDef *types.EndpointDefinition) {
+	funcName := endpointErrorFuncName("Is", serviceName, endpointDef)
+	file.Commentf("%s reports whether err is or wraps one of the Conjure errors that the %s endpoint of", funcName, endpointDef.EndpointName).Line().
+		Commentf("%s declares: %s.", serviceName, endpointErrorNames(endpointDef)).Line().
+		Func().Id(funcName).
+		Params(jen.Err().Error()).
+		Params(jen.Bool()).
+		Block(
+			jen.Switch(snip.CGRErrorsGetConjureError().Call(jen.Err()).Assert(jen.Type())).Block(
+				jen.CaseFunc(func(cases *jen.Group) {
+					for _, errorDef := range endpointDef.Errors {
+						cases.Op("*").Add(errorDef.Code())
+					}
+				}).Block(jen.Return(jen.True())),
+				jen.Default().Block(jen.Return(jen.False())),
+			),
+		)
+}
+
+func astForMatchEndpointErrorFunc(file *jen.Group, serviceName string, endpointDef *types.EndpointDefinition) {
+	funcName := endpointErrorFuncName("Match", serviceName, endpointDef)
+	file.Commentf("%s calls the function for the Conjure error that err is or wraps if it is one of the errors", funcName).Line().
+		Commentf("that the %s endpoint of %s declares, and otherFunc with err otherwise, including if err is nil.", endpointDef.EndpointName, serviceName).Line().
+		Comment("It returns the result of the function it calls.").Line().
+		Func().Id(funcName).
+		ParamsFunc(func(params *jen.Group) {
+			params.Err().Error()
+			for _, errorDef := range endpointDef.Errors {
+				params.Id(transforms.Private(errorDef.Name) + "Func").Func().Params(jen.Op("*").Add(errorDef.Code())).Error()
+			}
+			params.Id("otherFunc").Func().Params(jen.Error()).Error()
+		}).
+		Params(jen.Error()).
+		Block(
+			jen.Switch(jen.Id("conjureErr").Op(":=").Add(snip.CGRErrorsGetConjureError()).Call(jen.Err()).Assert(jen.Type())).BlockFunc(func(cases *jen.Group) {
+				for _, errorDef := range endpointDef.Errors {
+					cases.Case(jen.Op("*").Add(errorDef.Code())).Block(
+						jen.Return(jen.Id(transforms.Private(errorDef.Name) + "Func").Call(jen.Id("conjureErr"))),
+					)
+				}
+				cases.Default().Block(jen.Return(jen.Id("otherFunc").Call(jen.Err())))
+			}),
+		)
+}
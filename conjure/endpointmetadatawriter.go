@@ -0,0 +1,173 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/palantir/conjure-go/v6/conjure-api/conjure/spec"
+	"github.com/palantir/conjure-go/v6/conjure/transforms"
+	"github.com/palantir/conjure-go/v6/conjure/types"
+)
+
+const (
+	endpointMetadataType   = "EndpointMetadata"
+	paramMetadataType      = "EndpointParamMetadata"
+	paramKindType          = "EndpointParamKind"
+	endpointsVarNameSuffix = "Endpoints"
+)
+
+// paramKinds are the names of the EndpointParamKind constants and their values, by the kind of parameter they describe.
+var paramKinds = []struct {
+	kind  types.EndpointArgumentType
+	name  string
+	value string
+}{
+	{kind: types.PathParam, name: "Path", value: "path"},
+	{kind: types.QueryParam, name: "Query", value: "query"},
+	{kind: types.HeaderParam, name: "Header", value: "header"},
+	{kind: types.BodyParam, name: "Body", value: "body"},
+}
+
+// writeEndpointMetadataTypes writes the EndpointMetadata, EndpointParamMetadata and EndpointParamKind types used by the
+// <Service>Endpoints variables written by writeEndpointMetadata. It must be written once per package that contains
+// these variables.
+func writeEndpointMetadataTypes(file *jen.Group) {
+	file.Commentf("%s describes a Conjure endpoint.", endpointMetadataType).Line().
+		Type().Id(endpointMetadataType).Struct(
+		jen.Comment("Service is the name of the Conjure service of the endpoint."),
+		jen.Id("Service").String(),
+		jen.Comment("Name is the name of the endpoint."),
+		jen.Id("Name").String(),
+		jen.Comment("HTTPMethod is the HTTP method of the endpoint."),
+		jen.Id("HTTPMethod").String(),
+		jen.Comment("HTTPPath is the path template of the endpoint."),
+		jen.Id("HTTPPath").String(),
+		jen.Comment("Params are the parameters of the endpoint in declaration order."),
+		jen.Id("Params").Index().Id(paramMetadataType),
+		jen.Comment("Tags are the tags of the endpoint."),
+		jen.Id("Tags").Index().String(),
+		jen.Comment("Markers are the names of the markers of the endpoint, such as \"com.palantir.logsafe.Safe\"."),
+		jen.Id("Markers").Index().String(),
+		jen.Comment("Deprecated is the deprecation documentation of the endpoint, or empty if it is not deprecated."),
+		jen.Id("Deprecated").String(),
+	)
+	file.Commentf("%s describes a parameter of a Conjure endpoint.", paramMetadataType).Line().
+		Type().Id(paramMetadataType).Struct(
+		jen.Comment("Name is the name of the argument of the parameter."),
+		jen.Id("Name").String(),
+		jen.Comment("Kind is the location of the parameter in requests."),
+		jen.Id("Kind").Id(paramKindType),
+		jen.Comment("ParamID is the name of the path parameter, query parameter or header. It is the name of the argument for"),
+		jen.Comment("body parameters."),
+		jen.Id("ParamID").String(),
+		jen.Comment("Safety is the log safety of the parameter: \"SAFE\", \"UNSAFE\" or \"DO_NOT_LOG\", or empty if it is unknown."),
+		jen.Id("Safety").String(),
+		jen.Comment("Tags are the tags of the parameter."),
+		jen.Id("Tags").Index().String(),
+		jen.Comment("Markers are the names of the markers of the parameter."),
+		jen.Id("Markers").Index().String(),
+	)
+	file.Commentf("%s is the location of an endpoint parameter in requests.", paramKindType).Line().
+		Type().Id(paramKindType).String()
+	file.Const().DefsFunc(func(defs *jen.Group) {
+		for _, kind := range paramKinds {
+			defs.Id(paramKindType + kind.name).Id(paramKindType).Op("=").Lit(kind.value)
+		}
+	})
+}
+
+// writeEndpointMetadata writes the <Service>Endpoints variable that describes the endpoints of serviceDef. Its elements and
+// their fields are written on separate lines (each item of a literal starts with a line break and the last item is an
+// empty line break) to keep the variable readable.
+func writeEndpointMetadata(file *jen.Group, serviceDef *types.ServiceDefinition) {
+	varName := transforms.Export(serviceDef.Name) + endpointsVarNameSuffix
+	file.Commentf("%s describes the endpoints of %s in declaration order.", varName, serviceDef.Name).Line().
+		Var().Id(varName).Op("=").Index().Id(endpointMetadataType).ValuesFunc(func(endpoints *jen.Group) {
+		for _, endpointDef := range serviceDef.Endpoints {
+			endpoints.Line().Add(astForEndpointMetadata(serviceDef.Name, endpointDef))
+		}
+		endpoints.Line()
+	})
+}
+
+func astForEndpointMetadata(serviceName string, endpointDef *types.EndpointDefinition) *jen.Statement {
+	return jen.ValuesFunc(func(values *jen.Group) {
+		values.Line().Id("Service").Op(":").Lit(serviceName)
+		values.Line().Id("Name").Op(":").Lit(endpointDef.EndpointName)
+		values.Line().Id("HTTPMethod").Op(":").Lit(endpointDef.HTTPMethod.String())
+		values.Line().Id("HTTPPath").Op(":").Lit(endpointDef.HTTPPath)
+		if len(endpointDef.Params) > 0 {
+			values.Line().Id("Params").Op(":").Index().Id(paramMetadataType).ValuesFunc(func(params *jen.Group) {
+				for _, argDef := range endpointDef.Params {
+					params.Line().Add(astForParamMetadata(argDef))
+				}
+				params.Line()
+			})
+		}
+		if tags := astForStringSlice(endpointDef.Tags); tags != nil {
+			values.Line().Id("Tags").Op(":").Add(tags)
+		}
+		if markers := astForMarkerNames(endpointDef.Markers); markers != nil {
+			values.Line().Id("Markers").Op(":").Add(markers)
+		}
+		if endpointDef.Deprecated != "" {
+			values.Line().Id("Deprecated").Op(":").Lit(string(endpointDef.Deprecated))
+		}
+		values.Line()
+	})
+}
+
+func astForParamMetadata(argDef *types.EndpointArgumentDefinition) *jen.Statement {
+	return jen.ValuesFunc(func(values *jen.Group) {
+		values.Line().Id("Name").Op(":").Lit(argDef.Name)
+		for _, kind := range paramKinds {
+			if kind.kind == argDef.ParamType {
+				values.Line().Id("Kind").Op(":").Id(paramKindType + kind.name)
+			}
+		}
+		values.Line().Id("ParamID").Op(":").Lit(argDef.ParamID)
+		if safety := argDefLogSafety(argDef); safety.Value() != spec.LogSafety_UNKNOWN {
+			values.Line().Id("Safety").Op(":").Lit(string(safety.Value()))
+		}
+		if tags := astForStringSlice(argDef.Tags); tags != nil {
+			values.Line().Id("Tags").Op(":").Add(tags)
+		}
+		if markers := astForMarkerNames(argDef.Markers); markers != nil {
+			values.Line().Id("Markers").Op(":").Add(markers)
+		}
+		values.Line()
+	})
+}
+
+// astForStringSlice returns a []string literal that contains elems, or nil if elems is empty.
+func astForStringSlice(elems []string) *jen.Statement {
+	if len(elems) == 0 {
+		return nil
+	}
+	return jen.Index().String().ValuesFunc(func(values *jen.Group) {
+		for _, elem := range elems {
+			values.Lit(elem)
+		}
+	})
+}
+
+// astForMarkerNames returns a []string literal that contains the names of markers, or nil if there are none.
+func astForMarkerNames(markers []types.Type) *jen.Statement {
+	names := make([]string, 0, len(markers))
+	for _, marker := range markers {
+		names = append(names, markerName(marker))
+	}
+	return astForStringSlice(names)
+}
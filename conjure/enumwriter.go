@@ -27,7 +27,7 @@ const (
 	enumStructFieldName = "val"
 )
 
-func writeEnumType(file *jen.Group, enumDef *types.EnumType) {
+func writeEnumType(file *jen.Group, enumDef *types.EnumType, jw *jsonWriter) {
 	file.Add(enumDef.CommentLineWithDeprecation(enumDef.Deprecated)).Add(astForEnumTypeDecls(enumDef.Name))
 	file.Add(astForEnumValueConstants(enumDef.Name, enumDef.Values))
 	file.Add(astForEnumValuesFunction(enumDef.Name, enumDef.Values))
@@ -37,6 +37,8 @@ func writeEnumType(file *jen.Group, enumDef *types.EnumType) {
 	file.Add(astForEnumStringMethod(enumDef.Name))
 	file.Add(astForEnumMarshalText(enumDef.Name))
 	file.Add(astForEnumUnmarshalText(enumDef.Name, enumDef.Values))
+	jw.writeEnumMethods(file, enumDef)
+	jw.writeEnumDecodeMethods(file, enumDef)
 }
 
 func astForEnumTypeDecls(typeName string) *jen.Statement {
@@ -0,0 +1,888 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"sort"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/palantir/conjure-go/v6/conjure/snip"
+	"github.com/palantir/conjure-go/v6/conjure/transforms"
+	"github.com/palantir/conjure-go/v6/conjure/types"
+)
+
+const (
+	equalOtherVarName = "other"
+	cloneOutVarName   = "out"
+	hashVarName       = "h"
+
+	hashMixFunc     = "hashMix"
+	hashStringFunc  = "hashString"
+	hashBytesFunc   = "hashBytes"
+	hashFloat64Func = "hashFloat64"
+	hashBoolFunc    = "hashBool"
+
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// equalityWriter writes the Equal and Clone methods of the named types of a package and, if hash is true, their Hash
+// methods. The methods implement the Conjure semantics of the values: nil and empty collections are equal, sets are
+// compared regardless of the order of their elements, datetimes are compared by the instant they represent and NaN
+// doubles are equal to each other. Values of any fields are compared using reflect.DeepEqual and copied shallowly.
+// The writer records the helper functions used by the Hash methods so that writeHelpers only declares the helpers
+// that the package needs.
+type equalityWriter struct {
+	*equalityTypes
+	hash    bool
+	helpers map[string]struct{}
+}
+
+func newEqualityWriter(equalityTypes *equalityTypes, hash bool) *equalityWriter {
+	return &equalityWriter{
+		equalityTypes: equalityTypes,
+		hash:          hash,
+		helpers:       map[string]struct{}{},
+	}
+}
+
+// equalityTypes records the named types of a Conjure definition that are generated with Equal and Clone methods and
+// with Hash methods. Values of other named types, such as the types of external packages, are compared using
+// reflect.DeepEqual, copied shallowly and do not contribute to hashes.
+type equalityTypes struct {
+	// methods contains the named types that are generated with Equal and Clone methods.
+	methods map[types.Type]struct{}
+	// hashed contains the named types that are generated with Hash methods.
+	hashed map[types.Type]struct{}
+	// cloned caches whether the values of objects and unions need to be cloned.
+	cloned map[types.Type]bool
+}
+
+func newEqualityTypes(def *types.ConjureDefinition, cfg OutputConfiguration) *equalityTypes {
+	t := &equalityTypes{
+		methods: map[types.Type]struct{}{},
+		hashed:  map[types.Type]struct{}{},
+		cloned:  map[types.Type]bool{},
+	}
+	for _, pkg := range def.Packages {
+		if pkg.External {
+			continue
+		}
+		var named []types.Type
+		for _, alias := range pkg.Aliases {
+			if !isInterfaceAliasType(alias) {
+				named = append(named, alias)
+			}
+		}
+		for _, enum := range pkg.Enums {
+			named = append(named, enum)
+		}
+		for _, object := range pkg.Objects {
+			if !hasEqualityMethodField(object) {
+				named = append(named, object)
+			}
+		}
+		for _, union := range pkg.Unions {
+			named = append(named, union)
+		}
+		hash := cfg.ForPackage(pkg.ConjurePackage).GenerateHash
+		for _, typ := range named {
+			t.methods[typ] = struct{}{}
+			if hash {
+				t.hashed[typ] = struct{}{}
+			}
+		}
+	}
+	return t
+}
+
+// hasEqualityMethodField returns true if a field of objectDef has the name of the Equal, Clone or Hash method, which
+// the object therefore cannot have. Such objects are treated like the types of external packages.
+func hasEqualityMethodField(objectDef *types.ObjectType) bool {
+	for _, fieldDef := range objectDef.Fields {
+		switch transforms.ExportedFieldName(fieldDef.Name) {
+		case "Equal", "Clone", "Hash":
+			return true
+		}
+	}
+	return false
+}
+
+func (t *equalityTypes) hasMethods(typ types.Type) bool {
+	if isNamedSet(typ) {
+		return true
+	}
+	_, ok := t.methods[typ]
+	return ok
+}
+
+func (t *equalityTypes) hasHash(typ types.Type) bool {
+	_, ok := t.hashed[typ]
+	return ok
+}
+
+func (w *equalityWriter) writeObjectMethods(file *jen.Group, objectDef *types.ObjectType) {
+	if !w.hasMethods(objectDef) {
+		return
+	}
+	receiver := func(fieldDef *types.Field) *jen.Statement {
+		return jen.Id(objReceiverName).Dot(transforms.ExportedFieldName(fieldDef.Name))
+	}
+	other := func(fieldDef *types.Field) *jen.Statement {
+		return jen.Id(equalOtherVarName).Dot(transforms.ExportedFieldName(fieldDef.Name))
+	}
+	file.Add(astForEqualSignature(objReceiverName, objectDef.Name).BlockFunc(func(body *jen.Group) {
+		for _, fieldDef := range objectDef.Fields {
+			w.equal(body, receiver(fieldDef), other(fieldDef), fieldDef.Type, 0)
+		}
+		body.Return(jen.True())
+	}))
+	file.Add(astForCloneSignature(objReceiverName, objectDef.Name).BlockFunc(func(body *jen.Group) {
+		body.Id(cloneOutVarName).Op(":=").Id(objReceiverName)
+		for _, fieldDef := range objectDef.Fields {
+			if w.needsClone(fieldDef.Type) {
+				out := jen.Id(cloneOutVarName).Dot(transforms.ExportedFieldName(fieldDef.Name))
+				w.clone(body, out, receiver(fieldDef), fieldDef.Type.Code(), fieldDef.Type, 0)
+			}
+		}
+		body.Return(jen.Id(cloneOutVarName))
+	}))
+	if w.hash {
+		file.Add(astForHashSignature(objReceiverName, objectDef.Name).BlockFunc(func(body *jen.Group) {
+			body.Var().Id(hashVarName).Uint64()
+			for _, fieldDef := range objectDef.Fields {
+				w.hashValue(body, hashVarName, receiver(fieldDef), fieldDef.Type, 0)
+			}
+			body.Return(jen.Id(hashVarName))
+		}))
+	}
+}
+
+// writeUnionMethods writes the methods of a union. The variants of a union are stored in pointer fields of which at
+// most one is set, so they are handled as optional values.
+func (w *equalityWriter) writeUnionMethods(file *jen.Group, unionDef *types.UnionType) {
+	variant := func(receiverName string, fieldDef *types.Field) *jen.Statement {
+		return jen.Id(receiverName).Dot(transforms.PrivateFieldName(fieldDef.Name))
+	}
+	variantType := func(fieldDef *types.Field) *types.Optional {
+		return &types.Optional{Item: fieldDef.Type}
+	}
+	file.Add(astForEqualSignature(unionReceiverName, unionDef.Name).BlockFunc(func(body *jen.Group) {
+		body.If(jen.Id(unionReceiverName).Dot("typ").Op("!=").Id(equalOtherVarName).Dot("typ")).Block(jen.Return(jen.False()))
+		for _, fieldDef := range unionDef.Fields {
+			w.equal(body, variant(unionReceiverName, fieldDef), variant(equalOtherVarName, fieldDef), variantType(fieldDef), 0)
+		}
+		body.Return(jen.True())
+	}))
+	file.Add(astForCloneSignature(unionReceiverName, unionDef.Name).BlockFunc(func(body *jen.Group) {
+		var cloned []*types.Field
+		for _, fieldDef := range unionDef.Fields {
+			// Variants that do not need to be cloned are immutable, so the clone can share their pointers.
+			if w.needsClone(fieldDef.Type) {
+				cloned = append(cloned, fieldDef)
+			}
+		}
+		if len(cloned) == 0 {
+			body.Return(jen.Id(unionReceiverName))
+			return
+		}
+		body.Id(cloneOutVarName).Op(":=").Id(unionReceiverName)
+		for _, fieldDef := range cloned {
+			out := variant(cloneOutVarName, fieldDef)
+			w.clone(body, out, variant(unionReceiverName, fieldDef), variantType(fieldDef).Code(), variantType(fieldDef), 0)
+		}
+		body.Return(jen.Id(cloneOutVarName))
+	}))
+	if w.hash {
+		file.Add(astForHashSignature(unionReceiverName, unionDef.Name).BlockFunc(func(body *jen.Group) {
+			body.Id(hashVarName).Op(":=").Id(w.helper(hashStringFunc)).Call(jen.Id(unionReceiverName).Dot("typ"))
+			for _, fieldDef := range unionDef.Fields {
+				w.hashValue(body, hashVarName, variant(unionReceiverName, fieldDef), variantType(fieldDef), 0)
+			}
+			body.Return(jen.Id(hashVarName))
+		}))
+	}
+}
+
+func (w *equalityWriter) writeEnumMethods(file *jen.Group, enumDef *types.EnumType) {
+	value := func(receiverName string) *jen.Statement {
+		return jen.Id(receiverName).Dot(enumStructFieldName)
+	}
+	file.Add(astForEqualSignature(enumReceiverName, enumDef.Name).Block(
+		jen.Return(value(enumReceiverName).Op("==").Add(value(equalOtherVarName))),
+	))
+	file.Add(astForCloneSignature(enumReceiverName, enumDef.Name).Block(
+		jen.Return(jen.Id(enumReceiverName)),
+	))
+	if w.hash {
+		file.Add(astForHashSignature(enumReceiverName, enumDef.Name).Block(
+			jen.Return(jen.Id(w.helper(hashStringFunc)).Call(jen.String().Call(value(enumReceiverName)))),
+		))
+	}
+}
+
+// writeSetMethods writes the methods of a named set type. Sets are equal if they contain the same elements and their
+// hash is the sum of the hashes of their elements.
+func (w *equalityWriter) writeSetMethods(file *jen.Group, setDef *types.Set) {
+	file.Add(astForEqualSignature(setReceiverName, setDef.Name).Block(
+		jen.If(jen.Len(jen.Id(setReceiverName)).Op("!=").Len(jen.Id(equalOtherVarName))).Block(jen.Return(jen.False())),
+		jen.For(jen.Id(setElemVarName).Op(":=").Range().Id(setReceiverName)).Block(
+			jen.If(jen.Op("!").Id(equalOtherVarName).Dot("Contains").Call(jen.Id(setElemVarName))).Block(jen.Return(jen.False())),
+		),
+		jen.Return(jen.True()),
+	))
+	file.Add(astForCloneSignature(setReceiverName, setDef.Name).Block(
+		jen.If(jen.Id(setReceiverName).Op("==").Nil()).Block(jen.Return(jen.Nil())),
+		jen.Id(cloneOutVarName).Op(":=").Make(jen.Id(setDef.Name), jen.Len(jen.Id(setReceiverName))),
+		jen.For(jen.Id(setElemVarName).Op(":=").Range().Id(setReceiverName)).Block(
+			jen.Id(cloneOutVarName).Index(jen.Id(setElemVarName)).Op("=").Struct().Values(),
+		),
+		jen.Return(jen.Id(cloneOutVarName)),
+	))
+	if w.hash {
+		file.Add(astForHashSignature(setReceiverName, setDef.Name).BlockFunc(func(body *jen.Group) {
+			elemHash := loopVarName("elemHash", 0)
+			body.Var().Id(hashVarName).Uint64()
+			body.For(jen.Id(setElemVarName).Op(":=").Range().Id(setReceiverName)).BlockFunc(func(loop *jen.Group) {
+				loop.Var().Id(elemHash).Uint64()
+				w.hashValue(loop, elemHash, jen.Id(setElemVarName), setDef.Item, 1)
+				loop.Id(hashVarName).Op("+=").Id(elemHash)
+			})
+			body.Return(jen.Id(hashVarName))
+		}))
+	}
+}
+
+// writeAliasMethods writes the methods of an alias. Aliases of any cannot have methods, so no methods are written for
+// them.
+func (w *equalityWriter) writeAliasMethods(file *jen.Group, aliasDef *types.AliasType) {
+	if !w.hasMethods(aliasDef) {
+		return
+	}
+	// value returns the aliased value of the alias named receiverName.
+	value := func(receiverName string) *jen.Statement {
+		if aliasDef.IsOptional() {
+			return jen.Id(receiverName).Dot(aliasValueFieldName)
+		}
+		if w.hasMethods(aliasDef.Item) || isConvertedAliasItem(aliasDef.Item) {
+			return aliasDef.Item.Code().Call(jen.Id(receiverName))
+		}
+		return jen.Id(receiverName)
+	}
+	file.Add(astForEqualSignature(aliasReceiverName, aliasDef.Name).BlockFunc(func(body *jen.Group) {
+		if !isStatementType(aliasDef.Item) {
+			body.Return(w.equalExpr(value(aliasReceiverName), value(equalOtherVarName), aliasDef.Item, 0))
+			return
+		}
+		w.equal(body, value(aliasReceiverName), value(equalOtherVarName), aliasDef.Item, 0)
+		body.Return(jen.True())
+	}))
+	file.Add(astForCloneSignature(aliasReceiverName, aliasDef.Name).BlockFunc(func(body *jen.Group) {
+		switch {
+		case !w.needsClone(aliasDef.Item):
+			body.Return(jen.Id(aliasReceiverName))
+		case aliasDef.IsOptional():
+			body.Id(cloneOutVarName).Op(":=").Id(aliasReceiverName)
+			w.clone(body, jen.Id(cloneOutVarName).Dot(aliasValueFieldName), value(aliasReceiverName), aliasDef.Item.Code(), aliasDef.Item, 0)
+			body.Return(jen.Id(cloneOutVarName))
+		case w.hasMethods(aliasDef.Item):
+			body.Return(jen.Id(aliasDef.Name).Call(value(aliasReceiverName).Dot("Clone").Call()))
+		default:
+			body.Var().Id(cloneOutVarName).Id(aliasDef.Name)
+			w.clone(body, jen.Id(cloneOutVarName), value(aliasReceiverName), jen.Id(aliasDef.Name), aliasDef.Item, 0)
+			body.Return(jen.Id(cloneOutVarName))
+		}
+	}))
+	if w.hash {
+		file.Add(astForHashSignature(aliasReceiverName, aliasDef.Name).BlockFunc(func(body *jen.Group) {
+			if !isStatementType(aliasDef.Item) {
+				body.Return(w.hashExpr(value(aliasReceiverName), aliasDef.Item))
+				return
+			}
+			body.Var().Id(hashVarName).Uint64()
+			w.hashValue(body, hashVarName, value(aliasReceiverName), aliasDef.Item, 0)
+			body.Return(jen.Id(hashVarName))
+		}))
+	}
+}
+
+// isStatementType returns true if values of typ are compared and hashed using statements rather than a single
+// expression.
+func isStatementType(typ types.Type) bool {
+	switch typ.(type) {
+	case *types.Optional, *types.List, *types.Map:
+		return true
+	case *types.Set:
+		return !isNamedSet(typ)
+	}
+	return false
+}
+
+// isConvertedAliasItem returns true if the values of aliases of typ are converted to typ to call the functions and
+// methods that compare and hash them.
+func isConvertedAliasItem(typ types.Type) bool {
+	switch typ.(type) {
+	case types.String, types.Double, types.Boolean, types.RID:
+		return true
+	}
+	return false
+}
+
+func astForEqualSignature(receiverName, typeName string) *jen.Statement {
+	return jen.Commentf("Equal returns true if the %s is equal to other according to the Conjure semantics of its values.", typeName).Line().
+		Func().Params(jen.Id(receiverName).Id(typeName)).Id("Equal").Params(jen.Id(equalOtherVarName).Id(typeName)).Bool()
+}
+
+func astForCloneSignature(receiverName, typeName string) *jen.Statement {
+	return jen.Commentf("Clone returns a deep copy of the %s.", typeName).Line().
+		Func().Params(jen.Id(receiverName).Id(typeName)).Id("Clone").Params().Id(typeName)
+}
+
+func astForHashSignature(receiverName, typeName string) *jen.Statement {
+	return jen.Commentf("Hash returns a hash of the %s. Values that are Equal have the same hash.", typeName).Line().
+		Func().Params(jen.Id(receiverName).Id(typeName)).Id("Hash").Params().Uint64()
+}
+
+// derefValue returns the value pointed to by selector. The dereference is parenthesized if the value is used as the
+// operand of a selector or slice expression. Collections that are indexed are assigned to variables instead.
+func derefValue(selector *jen.Statement, typ types.Type) *jen.Statement {
+	switch typ.(type) {
+	case types.UUID, types.RID, *types.AliasType, *types.EnumType, *types.ObjectType, *types.UnionType:
+		return jen.Parens(jen.Op("*").Add(selector))
+	}
+	if isNamedSet(typ) {
+		return jen.Parens(jen.Op("*").Add(selector))
+	}
+	return jen.Op("*").Add(selector)
+}
+
+// isIndexedCollection returns true if values of typ are indexed when they are compared or cloned.
+func isIndexedCollection(typ types.Type) bool {
+	switch typ.(type) {
+	case *types.List, *types.Map:
+		return true
+	case *types.Set:
+		return !isNamedSet(typ)
+	}
+	return false
+}
+
+// derefArg returns the value pointed to by selector for use as the argument of the Equal method of another value of
+// typ, which does not need to be parenthesized.
+func derefArg(selector *jen.Statement, typ types.Type) *jen.Statement {
+	switch typ.(type) {
+	case *types.AliasType, *types.EnumType, *types.ObjectType, *types.UnionType:
+		return jen.Op("*").Add(selector)
+	}
+	if isNamedSet(typ) {
+		return jen.Op("*").Add(selector)
+	}
+	return derefValue(selector, typ)
+}
+
+// equal writes statements that return false if a and b, which are values of typ, are not equal.
+func (w *equalityWriter) equal(g *jen.Group, a, b *jen.Statement, typ types.Type, depth int) {
+	returnFalse := jen.Return(jen.False())
+	switch t := typ.(type) {
+	case *types.Optional:
+		g.If(jen.Parens(a.Clone().Op("==").Nil()).Op("!=").Parens(b.Clone().Op("==").Nil())).Block(returnFalse.Clone())
+		g.If(a.Clone().Op("!=").Nil()).BlockFunc(func(inner *jen.Group) {
+			if isIndexedCollection(t.Item) {
+				v, otherV := loopVarName("v", depth), loopVarName("otherV", depth)
+				inner.List(jen.Id(v), jen.Id(otherV)).Op(":=").List(jen.Op("*").Add(a.Clone()), jen.Op("*").Add(b.Clone()))
+				w.equal(inner, jen.Id(v), jen.Id(otherV), t.Item, depth+1)
+				return
+			}
+			w.equal(inner, derefValue(a, t.Item), derefArg(b, t.Item), t.Item, depth+1)
+		})
+	case *types.List:
+		i := loopVarName("i", depth)
+		g.If(jen.Len(a.Clone()).Op("!=").Len(b.Clone())).Block(returnFalse.Clone())
+		g.For(jen.Id(i).Op(":=").Range().Add(a.Clone())).BlockFunc(func(inner *jen.Group) {
+			w.equal(inner, a.Clone().Index(jen.Id(i)), b.Clone().Index(jen.Id(i)), t.Item, depth+1)
+		})
+	case *types.Set:
+		if t.Name != "" {
+			g.If(jen.Op("!").Add(a).Dot("Equal").Call(b)).Block(returnFalse)
+			return
+		}
+		g.If(jen.Op("!").Add(w.equalSetFunc(a, b, t.Item, depth))).Block(returnFalse)
+	case *types.Map:
+		k, v, ok := loopVarName("k", depth), loopVarName("v", depth), loopVarName("ok", depth)
+		otherV := loopVarName("otherV", depth)
+		g.If(jen.Len(a.Clone()).Op("!=").Len(b.Clone())).Block(returnFalse.Clone())
+		g.For(jen.List(jen.Id(k), jen.Id(v)).Op(":=").Range().Add(a.Clone())).BlockFunc(func(inner *jen.Group) {
+			inner.List(jen.Id(otherV), jen.Id(ok)).Op(":=").Add(b.Clone()).Index(jen.Id(k))
+			inner.If(jen.Op("!").Id(ok)).Block(returnFalse.Clone())
+			w.equal(inner, jen.Id(v), jen.Id(otherV), t.Val, depth+1)
+		})
+	case types.String, types.Bearertoken, types.Integer, types.Safelong, types.Boolean, types.UUID, types.RID:
+		g.If(a.Clone().Op("!=").Add(b.Clone())).Block(returnFalse)
+	default:
+		g.If(jen.Op("!").Add(w.equalExpr(a, b, typ, depth))).Block(returnFalse)
+	}
+}
+
+// equalSetFunc returns a function literal, called immediately, that returns true if the sets a and b are equal.
+// Elements of comparable types are counted in a map; other elements are matched pairwise.
+func (w *equalityWriter) equalSetFunc(a, b *jen.Statement, item types.Type, depth int) *jen.Statement {
+	returnFalse := jen.Return(jen.False())
+	v := loopVarName("v", depth)
+	return jen.Func().Params().Bool().BlockFunc(func(body *jen.Group) {
+		body.If(jen.Len(a.Clone()).Op("!=").Len(b.Clone())).Block(returnFalse.Clone())
+		if isCountableSetItem(item) {
+			counts := loopVarName("counts", depth)
+			body.Id(counts).Op(":=").Make(jen.Map(item.Code()).Int(), jen.Len(a.Clone()))
+			body.For(jen.List(jen.Id("_"), jen.Id(v)).Op(":=").Range().Add(a.Clone())).Block(
+				jen.Id(counts).Index(jen.Id(v)).Op("++"),
+			)
+			body.For(jen.List(jen.Id("_"), jen.Id(v)).Op(":=").Range().Add(b.Clone())).Block(
+				jen.If(jen.Id(counts).Index(jen.Id(v)).Op("==").Lit(0)).Block(returnFalse.Clone()),
+				jen.Id(counts).Index(jen.Id(v)).Op("--"),
+			)
+		} else {
+			matched, j, otherV, found := loopVarName("matched", depth), loopVarName("j", depth), loopVarName("otherV", depth), loopVarName("found", depth)
+			body.Id(matched).Op(":=").Make(jen.Index().Bool(), jen.Len(b.Clone()))
+			body.For(jen.List(jen.Id("_"), jen.Id(v)).Op(":=").Range().Add(a.Clone())).Block(
+				jen.Id(found).Op(":=").False(),
+				jen.For(jen.List(jen.Id(j), jen.Id(otherV)).Op(":=").Range().Add(b.Clone())).Block(
+					jen.If(jen.Op("!").Id(matched).Index(jen.Id(j)).Op("&&").Add(w.equalExpr(jen.Id(v), jen.Id(otherV), item, depth+1))).Block(
+						jen.Id(matched).Index(jen.Id(j)).Op("=").True(),
+						jen.Id(found).Op("=").True(),
+						jen.Break(),
+					),
+				),
+				jen.If(jen.Op("!").Id(found)).Block(returnFalse.Clone()),
+			)
+		}
+		body.Return(jen.True())
+	}).Call()
+}
+
+// isCountableSetItem returns true if equal elements of a set of typ can be counted using a Go map. Doubles are not
+// countable because NaN is not equal to itself in Go.
+func isCountableSetItem(typ types.Type) bool {
+	item := typ
+	for {
+		alias, ok := item.(*types.AliasType)
+		if !ok {
+			break
+		}
+		item = alias.Item
+	}
+	if _, isDouble := item.(types.Double); isDouble {
+		return false
+	}
+	return isComparableSetItem(typ)
+}
+
+// equalExpr returns an expression that is true if a and b, which are values of typ, are equal.
+func (w *equalityWriter) equalExpr(a, b *jen.Statement, typ types.Type, depth int) *jen.Statement {
+	switch t := typ.(type) {
+	case types.String, types.Bearertoken, types.Integer, types.Safelong, types.Boolean, types.UUID, types.RID:
+		return a.Clone().Op("==").Add(b.Clone())
+	case types.Double:
+		return jen.Parens(a.Clone().Op("==").Add(b.Clone()).Op("||").
+			Add(snip.MathIsNaN()).Call(a.Clone()).Op("&&").
+			Add(snip.MathIsNaN()).Call(b.Clone()))
+	case types.DateTime:
+		return snip.TimeTime().Call(a.Clone()).Dot("Equal").Call(snip.TimeTime().Call(b.Clone()))
+	case types.Binary:
+		return snip.BytesEqual().Call(a.Clone(), b.Clone())
+	case *types.AliasType, *types.EnumType, *types.ObjectType, *types.UnionType:
+		if w.hasMethods(typ) {
+			return a.Clone().Dot("Equal").Call(b.Clone())
+		}
+	case *types.Set:
+		if t.Name != "" {
+			return a.Clone().Dot("Equal").Call(b.Clone())
+		}
+		return w.equalSetFunc(a, b, t.Item, depth)
+	case *types.Optional, *types.List, *types.Map:
+		// Collections are compared by a function literal that is called immediately.
+		return jen.Func().Params().Bool().BlockFunc(func(body *jen.Group) {
+			w.equal(body, a, b, typ, depth)
+			body.Return(jen.True())
+		}).Call()
+	}
+	return snip.ReflectDeepEqual().Call(a.Clone(), b.Clone())
+}
+
+// needsClone returns true if values of typ contain references that must be copied by Clone.
+func (w *equalityWriter) needsClone(typ types.Type) bool {
+	switch typ.(type) {
+	case *types.ObjectType, *types.UnionType:
+		if result, ok := w.cloned[typ]; ok {
+			return result
+		}
+		result := w.containsReferences(typ, map[types.Type]struct{}{})
+		w.cloned[typ] = result
+		return result
+	}
+	return w.containsReferences(typ, map[types.Type]struct{}{})
+}
+
+// containsReferences returns true if values of typ contain slices, maps or pointers, either directly or in the fields
+// of the named types that they contain. Unions can refer to the types that contain them, so visited records the
+// objects and unions that are already being checked.
+func (w *equalityWriter) containsReferences(typ types.Type, visited map[types.Type]struct{}) bool {
+	var fields []*types.Field
+	switch t := typ.(type) {
+	case types.Binary, *types.Optional, *types.List, *types.Set, *types.Map:
+		return true
+	case *types.AliasType:
+		return w.hasMethods(t) && w.containsReferences(t.Item, visited)
+	case *types.ObjectType:
+		fields = t.Fields
+	case *types.UnionType:
+		fields = t.Fields
+	default:
+		return false
+	}
+	if _, ok := visited[typ]; ok || !w.hasMethods(typ) {
+		return false
+	}
+	visited[typ] = struct{}{}
+	for _, fieldDef := range fields {
+		if w.containsReferences(fieldDef.Type, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// clone writes statements that assign a deep copy of src, a value of typ, to dst. valueType is the Go type of src,
+// which differs from the type returned by typ.Code() if src is the value of an alias. Nil collections remain nil.
+func (w *equalityWriter) clone(g *jen.Group, dst, src *jen.Statement, valueType jen.Code, typ types.Type, depth int) {
+	if !w.needsClone(typ) {
+		g.Add(dst.Clone()).Op("=").Add(src.Clone())
+		return
+	}
+	switch t := typ.(type) {
+	case types.Binary:
+		g.If(src.Clone().Op("!=").Nil()).Block(
+			dst.Clone().Op("=").Make(valueType, jen.Len(src.Clone())),
+			jen.Copy(dst.Clone(), src.Clone()),
+		)
+	case *types.Optional:
+		v := loopVarName("v", depth)
+		g.If(src.Clone().Op("!=").Nil()).BlockFunc(func(inner *jen.Group) {
+			switch {
+			case !w.needsClone(t.Item):
+				inner.Id(v).Op(":=").Op("*").Add(src.Clone())
+			case isClonedByMethod(t.Item):
+				inner.Id(v).Op(":=").Add(derefValue(src, t.Item)).Dot("Clone").Call()
+			default:
+				value := derefValue(src, t.Item)
+				if isIndexedCollection(t.Item) {
+					elems := loopVarName("elems", depth)
+					inner.Id(elems).Op(":=").Add(value)
+					value = jen.Id(elems)
+				}
+				inner.Var().Id(v).Add(t.Item.Code())
+				w.clone(inner, jen.Id(v), value, t.Item.Code(), t.Item, depth+1)
+			}
+			inner.Add(dst.Clone()).Op("=").Op("&").Id(v)
+		})
+	case *types.List:
+		w.cloneList(g, dst, src, valueType, t.Item, depth)
+	case *types.Set:
+		if t.Name != "" {
+			g.Add(dst.Clone()).Op("=").Add(src.Clone()).Dot("Clone").Call()
+			return
+		}
+		w.cloneList(g, dst, src, valueType, t.Item, depth)
+	case *types.Map:
+		k, v, elem := loopVarName("k", depth), loopVarName("v", depth), loopVarName("elem", depth)
+		g.If(src.Clone().Op("!=").Nil()).Block(
+			dst.Clone().Op("=").Make(valueType, jen.Len(src.Clone())),
+			jen.For(jen.List(jen.Id(k), jen.Id(v)).Op(":=").Range().Add(src.Clone())).BlockFunc(func(inner *jen.Group) {
+				switch {
+				case !w.needsClone(t.Val):
+					inner.Add(dst.Clone()).Index(jen.Id(k)).Op("=").Id(v)
+					return
+				case isClonedByMethod(t.Val):
+					inner.Add(dst.Clone()).Index(jen.Id(k)).Op("=").Id(v).Dot("Clone").Call()
+					return
+				}
+				inner.Var().Id(elem).Add(t.Val.Code())
+				w.clone(inner, jen.Id(elem), jen.Id(v), t.Val.Code(), t.Val, depth+1)
+				inner.Add(dst.Clone()).Index(jen.Id(k)).Op("=").Id(elem)
+			}),
+		)
+	default:
+		g.Add(dst.Clone()).Op("=").Add(src.Clone()).Dot("Clone").Call()
+	}
+}
+
+// isClonedByMethod returns true if values of typ that need to be cloned are cloned by their Clone method.
+func isClonedByMethod(typ types.Type) bool {
+	switch typ.(type) {
+	case *types.AliasType, *types.ObjectType, *types.UnionType:
+		return true
+	}
+	return isNamedSet(typ)
+}
+
+func (w *equalityWriter) cloneList(g *jen.Group, dst, src *jen.Statement, valueType jen.Code, item types.Type, depth int) {
+	i := loopVarName("i", depth)
+	g.If(src.Clone().Op("!=").Nil()).BlockFunc(func(inner *jen.Group) {
+		inner.Add(dst.Clone()).Op("=").Make(valueType, jen.Len(src.Clone()))
+		if !w.needsClone(item) {
+			inner.Copy(dst.Clone(), src.Clone())
+			return
+		}
+		inner.For(jen.Id(i).Op(":=").Range().Add(src.Clone())).BlockFunc(func(loop *jen.Group) {
+			w.clone(loop, dst.Clone().Index(jen.Id(i)), src.Clone().Index(jen.Id(i)), item.Code(), item, depth+1)
+		})
+	})
+}
+
+// hashValue writes statements that mix the hash of value, a value of typ, into the hash variable named acc. The hashes
+// of the elements of sets and of the entries of maps are added to acc so that it does not depend on their order.
+func (w *equalityWriter) hashValue(g *jen.Group, acc string, value *jen.Statement, typ types.Type, depth int) {
+	switch t := typ.(type) {
+	case *types.Optional:
+		g.If(value.Clone().Op("!=").Nil()).BlockFunc(func(inner *jen.Group) {
+			w.hashValue(inner, acc, derefValue(value, t.Item), t.Item, depth+1)
+		})
+	case *types.List:
+		if !w.hashUsesValue(t.Item) {
+			// the elements cannot be hashed, so only their number contributes to the hash
+			g.Id(acc).Op("=").Id(w.helper(hashMixFunc)).Call(jen.Id(acc), jen.Uint64().Call(jen.Len(value.Clone())))
+			return
+		}
+		v := loopVarName("v", depth)
+		g.For(jen.List(jen.Id("_"), jen.Id(v)).Op(":=").Range().Add(value.Clone())).BlockFunc(func(inner *jen.Group) {
+			w.hashValue(inner, acc, jen.Id(v), t.Item, depth+1)
+		})
+	case *types.Set:
+		if t.Name != "" {
+			g.Id(acc).Op("=").Id(w.helper(hashMixFunc)).Call(jen.Id(acc), w.hashExpr(value, typ))
+			return
+		}
+		if !w.hashUsesValue(t.Item) {
+			g.Id(acc).Op("=").Id(w.helper(hashMixFunc)).Call(jen.Id(acc), jen.Uint64().Call(jen.Len(value.Clone())))
+			return
+		}
+		v, elemHash := loopVarName("v", depth), loopVarName("elemHash", depth)
+		g.For(jen.List(jen.Id("_"), jen.Id(v)).Op(":=").Range().Add(value.Clone())).BlockFunc(func(inner *jen.Group) {
+			inner.Var().Id(elemHash).Uint64()
+			w.hashValue(inner, elemHash, jen.Id(v), t.Item, depth+1)
+			inner.Id(acc).Op("+=").Id(elemHash)
+		})
+	case *types.Map:
+		// Keys are hashed as their underlying type because binary and boolean keys are stored as binary.Binary and
+		// boolean.Boolean values (even if the key type is an alias), which neither hashBytes nor hashBool accept.
+		k, v, elemHash := loopVarName("k", depth), loopVarName("v", depth), loopVarName("elemHash", depth)
+		keyHash := w.hashExpr(jen.Id(k), t.Key)
+		switch {
+		case t.Key.IsBinary():
+			keyHash = jen.Id(w.helper(hashStringFunc)).Call(jen.String().Call(jen.Id(k)))
+		case t.Key.IsBoolean():
+			keyHash = jen.Id(w.helper(hashBoolFunc)).Call(jen.Bool().Call(jen.Id(k)))
+		}
+		keyVar, valVar := k, v
+		if !w.hashUsesValue(t.Key) && !t.Key.IsBinary() && !t.Key.IsBoolean() {
+			keyVar = "_"
+		}
+		if !w.hashUsesValue(t.Val) {
+			valVar = "_"
+		}
+		g.For(jen.List(jen.Id(keyVar), jen.Id(valVar)).Op(":=").Range().Add(value.Clone())).BlockFunc(func(inner *jen.Group) {
+			inner.Var().Id(elemHash).Uint64()
+			inner.Id(elemHash).Op("=").Id(w.helper(hashMixFunc)).Call(jen.Id(elemHash), keyHash)
+			w.hashValue(inner, elemHash, jen.Id(v), t.Val, depth+1)
+			inner.Id(acc).Op("+=").Id(elemHash)
+		})
+	default:
+		g.Id(acc).Op("=").Id(w.helper(hashMixFunc)).Call(jen.Id(acc), w.hashExpr(value, typ))
+	}
+}
+
+// hashExpr returns the uint64 hash of value, a value of typ that is not an optional or a collection other than a named
+// set.
+func (w *equalityWriter) hashExpr(value *jen.Statement, typ types.Type) *jen.Statement {
+	switch t := typ.(type) {
+	case types.String:
+		return jen.Id(w.helper(hashStringFunc)).Call(value.Clone())
+	case types.Bearertoken:
+		return jen.Id(w.helper(hashStringFunc)).Call(jen.String().Call(value.Clone()))
+	case types.Integer, types.Safelong:
+		return jen.Uint64().Call(value.Clone())
+	case types.Double:
+		return jen.Id(w.helper(hashFloat64Func)).Call(value.Clone())
+	case types.Boolean:
+		return jen.Id(w.helper(hashBoolFunc)).Call(value.Clone())
+	case types.DateTime:
+		return jen.Uint64().Call(snip.TimeTime().Call(value.Clone()).Dot("UnixNano").Call())
+	case types.UUID:
+		return jen.Id(w.helper(hashBytesFunc)).Call(value.Clone().Index(jen.Empty(), jen.Empty()))
+	case types.RID:
+		return jen.Id(w.helper(hashStringFunc)).Call(value.Clone().Dot("String").Call())
+	case types.Binary:
+		return jen.Id(w.helper(hashBytesFunc)).Call(value.Clone())
+	case types.Any:
+		return jen.Id(w.helper(hashStringFunc)).Call(snip.FmtSprint().Call(value.Clone()))
+	case *types.AliasType, *types.EnumType, *types.ObjectType, *types.UnionType:
+		if w.hasHash(typ) {
+			return value.Clone().Dot("Hash").Call()
+		}
+		if isAnyAlias(typ) {
+			// aliases of any cannot have methods
+			return jen.Id(w.helper(hashStringFunc)).Call(snip.FmtSprint().Call(value.Clone()))
+		}
+	case *types.Set:
+		// named set types are declared in the package of the writer
+		if w.hash {
+			return value.Clone().Dot("Hash").Call()
+		}
+	case *types.External:
+		if !t.ExternalHasGoType() {
+			return w.hashExpr(value, t.Fallback)
+		}
+		return jen.Id(w.helper(hashStringFunc)).Call(snip.FmtSprint().Call(value.Clone()))
+	}
+	return jen.Lit(0)
+}
+
+// hashUsesValue returns false if the hash of values of typ written by hashValue does not depend on the value, which is
+// the case for types declared without Hash methods. Loops over such values must not declare a variable for them.
+func (w *equalityWriter) hashUsesValue(typ types.Type) bool {
+	switch t := typ.(type) {
+	case *types.Optional:
+		return w.hashUsesValue(t.Item)
+	case *types.List:
+		return true
+	case *types.Set:
+		return t.Name == "" || w.hash
+	case *types.Map:
+		return true
+	case *types.AliasType, *types.EnumType, *types.ObjectType, *types.UnionType:
+		return w.hasHash(typ) || isAnyAlias(typ)
+	}
+	return true
+}
+
+// isAnyAlias returns true if typ is an alias of any, possibly through other aliases or external types without a Go
+// type whose fallback is any.
+func isAnyAlias(typ types.Type) bool {
+	alias, ok := typ.(*types.AliasType)
+	if !ok {
+		return false
+	}
+	item := alias.Item
+	for {
+		switch t := item.(type) {
+		case types.Any:
+			return true
+		case *types.AliasType:
+			item = t.Item
+		case *types.External:
+			if t.ExternalHasGoType() {
+				return false
+			}
+			item = t.Fallback
+		default:
+			return false
+		}
+	}
+}
+
+// helper returns the identifier of the helper function with the provided name and records that it is used.
+func (w *equalityWriter) helper(name string) string {
+	w.helpers[name] = struct{}{}
+	return name
+}
+
+// needsHelpers returns true if the methods written by the writer use any helper functions.
+func (w *equalityWriter) needsHelpers() bool {
+	return len(w.helpers) > 0
+}
+
+// writeHelpers writes the helper functions used by the methods written by the writer.
+func (w *equalityWriter) writeHelpers(file *jen.Group) {
+	var names []string
+	for name := range w.helpers {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return hashHelperOrder[names[i]] < hashHelperOrder[names[j]]
+	})
+	for _, name := range names {
+		file.Add(hashHelpers[name]())
+	}
+}
+
+var hashHelperOrder = map[string]int{
+	hashMixFunc:     0,
+	hashStringFunc:  1,
+	hashBytesFunc:   2,
+	hashFloat64Func: 3,
+	hashBoolFunc:    4,
+}
+
+var hashHelpers = map[string]func() *jen.Statement{
+	hashMixFunc:     astForHashMix,
+	hashStringFunc:  astForHashString,
+	hashBytesFunc:   astForHashBytes,
+	hashFloat64Func: astForHashFloat64,
+	hashBoolFunc:    astForHashBool,
+}
+
+func astForHashMix() *jen.Statement {
+	return jen.Commentf("%s returns the hash h combined with the hash v.", hashMixFunc).Line().
+		Func().Id(hashMixFunc).Params(jen.List(jen.Id("h"), jen.Id("v")).Uint64()).Uint64().Block(
+		jen.Return(jen.Parens(jen.Id("h").Op("^").Id("v")).Op("*").Lit(fnvPrime64)),
+	)
+}
+
+// astForFNVHash returns a function that returns the FNV-1a hash of the bytes of its parameter.
+func astForFNVHash(name, paramName string, paramType jen.Code) *jen.Statement {
+	return jen.Commentf("%s returns the FNV-1a hash of %s.", name, paramName).Line().
+		Func().Id(name).Params(jen.Id(paramName).Add(paramType)).Uint64().Block(
+		jen.Id("h").Op(":=").Lit(uint64(fnvOffset64)),
+		jen.For(jen.Id("i").Op(":=").Lit(0), jen.Id("i").Op("<").Len(jen.Id(paramName)), jen.Id("i").Op("++")).Block(
+			jen.Id("h").Op("^=").Uint64().Call(jen.Id(paramName).Index(jen.Id("i"))),
+			jen.Id("h").Op("*=").Lit(fnvPrime64),
+		),
+		jen.Return(jen.Id("h")),
+	)
+}
+
+func astForHashString() *jen.Statement {
+	return astForFNVHash(hashStringFunc, "s", jen.String())
+}
+
+func astForHashBytes() *jen.Statement {
+	return astForFNVHash(hashBytesFunc, "b", jen.Index().Byte())
+}
+
+func astForHashFloat64() *jen.Statement {
+	return jen.Commentf("%s returns the hash of f. Zero and negative zero have the same hash, as do all NaNs.", hashFloat64Func).Line().
+		Func().Id(hashFloat64Func).Params(jen.Id("f").Float64()).Uint64().Block(
+		jen.If(jen.Id("f").Op("==").Lit(0)).Block(jen.Return(jen.Lit(0))),
+		jen.If(snip.MathIsNaN().Call(jen.Id("f"))).Block(jen.Return(snip.MathFloat64bits().Call(snip.MathNaN().Call()))),
+		jen.Return(snip.MathFloat64bits().Call(jen.Id("f"))),
+	)
+}
+
+func astForHashBool() *jen.Statement {
+	return jen.Commentf("%s returns the hash of b.", hashBoolFunc).Line().
+		Func().Id(hashBoolFunc).Params(jen.Id("b").Bool()).Uint64().Block(
+		jen.If(jen.Id("b")).Block(jen.Return(jen.Lit(1))),
+		jen.Return(jen.Lit(0)),
+	)
+}
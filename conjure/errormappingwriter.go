@@ -0,0 +1,175 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/palantir/conjure-go/v6/conjure/snip"
+	"github.com/palantir/conjure-go/v6/conjure/transforms"
+	"github.com/palantir/conjure-go/v6/conjure/types"
+)
+
+const (
+	errorMapperType           = "ErrorMapper"
+	errorMappersParamFunc     = "WithErrorMappers"
+	errorMappersCtxKeyType    = "errorMappersContextKey"
+	mapEndpointErrorsFuncName = "mapEndpointErrors"
+	errorMapperFuncPrefix     = "MapTo"
+	serviceErrorsVarSuffix    = "Errors"
+	verifyServiceErrorPrefix  = "Verify"
+	defaultErrorNamePrefix    = "Default:"
+)
+
+// astErrorMapperFunc writes the MapTo<Error> function that returns an ErrorMapper (written by writeServerErrorMapper)
+// which maps errors that match a sentinel error to def.
+func astErrorMapperFunc(file *jen.Group, def *types.ErrorDefinition) {
+	allArgs := append(append([]*types.Field{}, def.SafeArgs...), def.UnsafeArgs...)
+	funcName := errorMapperFuncPrefix + def.Name
+	file.Commentf("%s returns a function that maps errors that match sentinel, as reported by errors.Is, to a new", funcName).Line().
+		Commentf("%s error with the provided parameters that wraps them. The function returns nil for all other errors.", def.Name).Line().
+		Commentf("It can be passed to the %s route parameter of generated servers.", errorMappersParamFunc).Line().
+		Func().Id(funcName).
+		ParamsFunc(func(params *jen.Group) {
+			params.Id("sentinel").Error()
+			for _, fieldDef := range allArgs {
+				params.Id(transforms.ArgName(fieldDef.Name)).Add(fieldDef.Type.Code())
+			}
+		}).
+		Params(jen.Func().Params(jen.Error()).Error()).
+		Block(
+			jen.Return(jen.Func().Params(jen.Err().Error()).Error().Block(
+				jen.If(jen.Op("!").Add(snip.ErrorsIs()).Call(jen.Err(), jen.Id("sentinel"))).Block(
+					jen.Return(jen.Nil()),
+				),
+				jen.Return(jen.Id("WrapWith"+def.Name).CallFunc(func(args *jen.Group) {
+					args.Err()
+					for _, fieldDef := range allArgs {
+						args.Id(transforms.ArgName(fieldDef.Name))
+					}
+				})),
+			)),
+		)
+}
+
+// writeServerErrorMapper writes the ErrorMapper type, the WithErrorMappers route parameter and the function that wraps
+// handlers to map the errors returned by endpoint implementations. It must be written once per file that contains
+// handlers which map their errors.
+func writeServerErrorMapper(file *jen.Group) {
+	handleFunc := func() *jen.Statement {
+		return jen.Func().Params(snip.HTTPResponseWriter(), jen.Op("*").Add(snip.HTTPRequest())).Error()
+	}
+	mappersFromCtx := jen.List(jen.Id("mappers"), jen.Id("_")).Op(":=").Add(reqCtxExpr.Clone()).Dot("Value").Call(jen.Id(errorMappersCtxKeyType).Values()).Assert(jen.Index().Id(errorMapperType))
+	file.Commentf("%s maps an error returned by an endpoint implementation to the error that is returned to the", errorMapperType).Line().
+		Comment("client, such as a Conjure error declared by the endpoint. It returns nil if it does not map err.").Line().
+		Type().Id(errorMapperType).Func().Params(jen.Err().Error()).Error()
+	file.Commentf("%s returns a route parameter that configures the handlers registered by the RegisterRoutes", errorMappersParamFunc).Line().
+		Comment("functions of this package to map the errors returned by endpoint implementations using mappers. The first").Line().
+		Comment("mapper that maps an error determines the returned error. Errors that no mapper maps are returned as-is.").Line().
+		Func().Id(errorMappersParamFunc).
+		Params(jen.Id("mappers").Op("...").Id(errorMapperType)).
+		Params(snip.WrouterRouteParam()).
+		Block(
+			jen.Return(snip.WrouterRouteMiddleware().Call(
+				jen.Func().
+					Params(
+						jen.Id(responseWriterVarName).Add(snip.HTTPResponseWriter()),
+						jen.Id(reqName).Op("*").Add(snip.HTTPRequest()),
+						jen.Id("reqVals").Add(snip.WrouterRequestVals()),
+						jen.Id("next").Add(snip.WrouterRouteRequestHandler()),
+					).
+					Block(
+						jen.List(jen.Id("configured"), jen.Id("_")).Op(":=").Add(reqCtxExpr.Clone()).Dot("Value").Call(jen.Id(errorMappersCtxKeyType).Values()).Assert(jen.Index().Id(errorMapperType)),
+						// Limit the capacity so that routes never share the appended slice
+						jen.Id("configured").Op("=").Append(
+							jen.Id("configured").Index(jen.Op(":").Len(jen.Id("configured")).Op(":").Len(jen.Id("configured"))),
+							jen.Id("mappers").Op("..."),
+						),
+						jen.Id("ctx").Op(":=").Add(snip.ContextWithValue()).Call(reqCtxExpr.Clone(), jen.Id(errorMappersCtxKeyType).Values(), jen.Id("configured")),
+						jen.Id("next").Call(jen.Id(responseWriterVarName), jen.Id(reqName).Dot("WithContext").Call(jen.Id("ctx")), jen.Id("reqVals")),
+					),
+			)),
+		)
+	file.Type().Id(errorMappersCtxKeyType).Struct()
+	file.Commentf("%s returns a function that calls handle and maps the errors it returns using the mappers", mapEndpointErrorsFuncName).Line().
+		Commentf("configured using %s, if any.", errorMappersParamFunc).Line().
+		Func().Id(mapEndpointErrorsFuncName).
+		Params(jen.Id("handle").Add(handleFunc())).
+		Params(handleFunc()).
+		Block(
+			jen.Return(jen.Func().
+				Params(jen.Id(responseWriterVarName).Add(snip.HTTPResponseWriter()), jen.Id(reqName).Op("*").Add(snip.HTTPRequest())).
+				Error().
+				Block(
+					jen.Err().Op(":=").Id("handle").Call(jen.Id(responseWriterVarName), jen.Id(reqName)),
+					jen.If(jen.Err().Op("==").Nil()).Block(jen.Return(jen.Nil())),
+					mappersFromCtx,
+					jen.For(jen.List(jen.Id("_"), jen.Id("mapper")).Op(":=").Range().Id("mappers")).Block(
+						jen.If(jen.Id("mappedErr").Op(":=").Id("mapper").Call(jen.Err()), jen.Id("mappedErr").Op("!=").Nil()).Block(
+							jen.Return(jen.Id("mappedErr")),
+						),
+					),
+					jen.Return(jen.Err()),
+				),
+			),
+		)
+}
+
+// writeServiceErrors writes the <Service>Errors variable that maps the endpoints of serviceDef to the names of the
+// errors they declare and the Verify<Service>Error function that checks returned errors against it.
+func writeServiceErrors(file *jen.Group, serviceDef *types.ServiceDefinition) {
+	varName := transforms.Export(serviceDef.Name) + serviceErrorsVarSuffix
+	funcName := verifyServiceErrorPrefix + transforms.Export(serviceDef.Name) + "Error"
+	file.Commentf("%s maps the names of the endpoints of %s to the names of the Conjure errors they declare", varName, serviceDef.Name).Line().
+		Commentf("using %q tags.", types.ErrorTagPrefix+"<namespace>:<name>").Line().
+		Var().Id(varName).Op("=").Map(jen.String()).Index().String().ValuesFunc(func(endpoints *jen.Group) {
+		for _, endpointDef := range serviceDef.Endpoints {
+			if len(endpointDef.Errors) == 0 {
+				continue
+			}
+			endpoints.Line().Lit(endpointDef.EndpointName).Op(":").ValuesFunc(func(names *jen.Group) {
+				for _, errorDef := range endpointDef.Errors {
+					names.Lit(errorDef.WireName())
+				}
+			})
+		}
+		if serviceDef.HasEndpointErrors() {
+			endpoints.Line()
+		}
+	})
+	file.Commentf("%s returns an error if err is a Conjure error that the endpoint of %s with the provided", funcName, serviceDef.Name).Line().
+		Commentf("name does not declare in %s. It returns nil if err is nil, is not a Conjure error or has a default", varName).Line().
+		Commentf("Conjure error name, such as %q.", defaultErrorNamePrefix+"NotFound").Line().
+		Func().Id(funcName).
+		Params(jen.Id("endpointName").String(), jen.Err().Error()).
+		Params(jen.Error()).
+		Block(
+			jen.Id("conjureErr").Op(":=").Add(snip.CGRErrorsGetConjureError()).Call(jen.Err()),
+			jen.If(jen.Id("conjureErr").Op("==").Nil().Op("||").Add(snip.StringsHasPrefix()).Call(jen.Id("conjureErr").Dot("Name").Call(), jen.Lit(defaultErrorNamePrefix))).Block(
+				jen.Return(jen.Nil()),
+			),
+			jen.For(jen.List(jen.Id("_"), jen.Id("errorName")).Op(":=").Range().Id(varName).Index(jen.Id("endpointName"))).Block(
+				jen.If(jen.Id("conjureErr").Dot("Name").Call().Op("==").Id("errorName")).Block(
+					jen.Return(jen.Nil()),
+				),
+			),
+			jen.Return(snip.WerrorError().Call(
+				jen.Lit("endpoint returned an undeclared Conjure error"),
+				jen.Line().Add(snip.WerrorSafeParam()).Call(jen.Lit("service"), jen.Lit(serviceDef.Name)),
+				jen.Line().Add(snip.WerrorSafeParam()).Call(jen.Lit("endpoint"), jen.Id("endpointName")),
+				jen.Line().Add(snip.WerrorSafeParam()).Call(jen.Lit("errorName"), jen.Id("conjureErr").Dot("Name").Call()),
+				jen.Line(),
+			)),
+		)
+}
@@ -33,9 +33,12 @@ const (
 	errorNameParam       = "errorName"
 )
 
-func writeErrorType(file *jen.Group, def *types.ErrorDefinition) {
-	astErrorInternalStructType(file, def)
+func writeErrorType(file *jen.Group, def *types.ErrorDefinition, mapErrors bool, jw *jsonWriter) {
+	astErrorInternalStructType(file, def, jw)
 	astErrorConstructorFuncs(file, def)
+	if mapErrors {
+		astErrorMapperFunc(file, def)
+	}
 	astErrorExportedStructType(file, def)
 	astIsErrorTypeFunc(file, def)
 	astErrorErrorMethod(file, def)
@@ -56,10 +59,10 @@ func writeErrorType(file *jen.Group, def *types.ErrorDefinition) {
 }
 
 // Create private *myInternal object containing known params.
-func astErrorInternalStructType(file *jen.Group, def *types.ErrorDefinition) {
+func astErrorInternalStructType(file *jen.Group, def *types.ErrorDefinition, jw *jsonWriter) {
 	allArgs := append(append([]*types.Field{}, def.SafeArgs...), def.UnsafeArgs...)
 	// Use object generator to create a struct implementing JSON encoding for the error.
-	writeObjectType(file, &types.ObjectType{Name: transforms.Private(def.Name), Fields: allArgs})
+	writeObjectType(file, &types.ObjectType{Name: transforms.Private(def.Name), Fields: allArgs}, false, jw)
 }
 
 // Declare New and Wrap constructors
@@ -251,7 +254,7 @@ func astErrorNameMethod(file *jen.Group, def *types.ErrorDefinition) {
 		Params().
 		Params(jen.String()).
 		Block(
-			jen.Return(jen.Lit(fmt.Sprintf("%s:%s", def.ErrorNamespace, def.Name))),
+			jen.Return(jen.Lit(def.WireName())),
 		)
 }
 
@@ -437,7 +440,7 @@ func astErrorMarshalJSON(file *jen.Group, def *types.ErrorDefinition) {
 		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err())),
 		jen.Return(snip.SafeJSONMarshal().Call(snip.CGRErrorsSerializableError().Values(
 			jen.Id("ErrorCode").Op(":").Add(selectorForErrorCode(def.ErrorCode)),
-			jen.Id("ErrorName").Op(":").Lit(fmt.Sprintf("%s:%s", def.ErrorNamespace, def.Name)),
+			jen.Id("ErrorName").Op(":").Lit(def.WireName()),
 			jen.Id("ErrorInstanceID").Op(":").Id(errorReceiverName).Dot(errorInstanceIDField),
 			jen.Id("Parameters").Op(":").Qual("encoding/json", "RawMessage").Call(jen.Id("parameters")),
 		))),
@@ -490,7 +493,7 @@ func astErrorInitFunc(file *jen.Group, defs []*types.ErrorDefinition) {
 	file.Func().Id("init").Params().BlockFunc(func(funcBody *jen.Group) {
 		for _, def := range defs {
 			funcBody.Add(snip.CGRErrorsRegisterErrorType()).Call(
-				jen.Lit(fmt.Sprintf("%s:%s", def.ErrorNamespace, def.Name)),
+				jen.Lit(def.WireName()),
 				snip.ReflectTypeOf().Call(jen.Id(def.Name).Values()),
 			)
 		}
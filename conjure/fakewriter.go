@@ -0,0 +1,259 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/palantir/conjure-go/v6/conjure/snip"
+	"github.com/palantir/conjure-go/v6/conjure/transforms"
+	"github.com/palantir/conjure-go/v6/conjure/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	fakeReceiverName     = "f"
+	fakeMutexFieldName   = "mu"
+	fakeDefaultErrField  = "DefaultErr"
+	fakeDefaultErrMethod = "defaultErr"
+	fakeEndpointVarName  = "endpoint"
+)
+
+// writeFakeType writes an in-memory implementation of the server interface for serviceDef.
+// If assertServerInterface is true, a compile-time assertion that the fake implements the
+// server interface is also written (the interface only exists when server generation is enabled).
+// Returns an error if the names of the fields and methods of the fake or of its recorded calls conflict.
+func writeFakeType(file *jen.Group, serviceDef *types.ServiceDefinition, assertServerInterface bool) error {
+	if err := checkFakeNames(serviceDef); err != nil {
+		return err
+	}
+	file.Add(astForFakeStructDecl(serviceDef))
+	if assertServerInterface {
+		file.Var().Id("_").Id(interfaceTypeName(serviceDef.Name)).Op("=").
+			Parens(jen.Op("*").Id(fakeTypeName(serviceDef.Name))).Parens(jen.Nil())
+	}
+	for _, endpointDef := range serviceDef.Endpoints {
+		file.Add(astForFakeCallStructDecl(serviceDef.Name, endpointDef))
+		file.Add(astForFakeEndpointMethod(serviceDef.Name, endpointDef))
+		file.Add(astForFakeCallsMethod(serviceDef.Name, endpointDef))
+	}
+	file.Add(astForFakeDefaultErrMethod(serviceDef.Name))
+	return nil
+}
+
+// checkFakeNames returns an error if two of the fields and methods written by writeFakeType for the fake of serviceDef
+// or for one of its recorded call types have the same name. Because the fixed names of the fake and of the recorded
+// auth tokens are valid names of fields and methods derived from endpoints and parameters, they can conflict.
+func checkFakeNames(serviceDef *types.ServiceDefinition) error {
+	members := map[string]string{
+		fakeDefaultErrField:  "the default error field",
+		fakeDefaultErrMethod: "the default error method",
+		fakeMutexFieldName:   "the mutex field",
+	}
+	addMember := func(name, owner string) error {
+		if other, ok := members[name]; ok {
+			return errors.Errorf("%s of %s conflicts with %s: both are named %s", owner, fakeTypeName(serviceDef.Name), other, name)
+		}
+		members[name] = owner
+		return nil
+	}
+	for _, endpointDef := range serviceDef.Endpoints {
+		for _, member := range []struct{ name, kind string }{
+			{name: transforms.Export(endpointDef.EndpointName), kind: "method"},
+			{name: fakeFuncFieldName(endpointDef), kind: "func field"},
+			{name: fakeCallsFieldName(endpointDef), kind: "calls field"},
+			{name: fakeCallsMethodName(endpointDef), kind: "calls method"},
+		} {
+			if err := addMember(member.name, fmt.Sprintf("the %s of endpoint %s", member.kind, endpointDef.EndpointName)); err != nil {
+				return err
+			}
+		}
+		fields := map[string]struct{}{}
+		for _, arg := range fakeCallArgs(endpointDef) {
+			if _, ok := fields[arg.field]; ok {
+				return errors.Errorf("%s has more than one field named %s", fakeCallTypeName(serviceDef.Name, endpointDef), arg.field)
+			}
+			fields[arg.field] = struct{}{}
+		}
+	}
+	return nil
+}
+
+func astForFakeStructDecl(serviceDef *types.ServiceDefinition) *jen.Statement {
+	name := fakeTypeName(serviceDef.Name)
+	return jen.
+		Commentf("%s is an in-memory implementation of %s for use in tests.", name, interfaceTypeName(serviceDef.Name)).Line().
+		Comment("Each endpoint records its arguments and then invokes the corresponding <Endpoint>Func field.").Line().
+		Commentf("If the field is nil, the endpoint returns %s (or a Conjure Internal error if %s is nil).", fakeDefaultErrField, fakeDefaultErrField).Line().
+		Comment("The zero value is ready to use and all methods are safe for concurrent use.").Line().
+		Type().Id(name).StructFunc(func(fields *jen.Group) {
+		for _, endpointDef := range serviceDef.Endpoints {
+			fields.Commentf("%s is invoked by %s if non-nil.", fakeFuncFieldName(endpointDef), transforms.Export(endpointDef.EndpointName))
+			fields.Id(fakeFuncFieldName(endpointDef)).Func().
+				ParamsFunc(func(args *jen.Group) {
+					astForEndpointArgsFunc(args, endpointDef, false, true)
+				}).
+				ParamsFunc(func(args *jen.Group) {
+					astForEndpointReturnsFunc(args, endpointDef, true)
+				})
+		}
+		fields.Commentf("%s is returned by endpoints whose func field is nil.", fakeDefaultErrField)
+		fields.Id(fakeDefaultErrField).Error()
+		fields.Line()
+		fields.Id(fakeMutexFieldName).Add(snip.SyncMutex())
+		for _, endpointDef := range serviceDef.Endpoints {
+			fields.Id(fakeCallsFieldName(endpointDef)).Index().Id(fakeCallTypeName(serviceDef.Name, endpointDef))
+		}
+	})
+}
+
+func astForFakeCallStructDecl(serviceName string, endpointDef *types.EndpointDefinition) *jen.Statement {
+	name := fakeCallTypeName(serviceName, endpointDef)
+	return jen.
+		Commentf("%s records the arguments of a call to %s.%s.", name, fakeTypeName(serviceName), transforms.Export(endpointDef.EndpointName)).Line().
+		Type().Id(name).StructFunc(func(fields *jen.Group) {
+		for _, arg := range fakeCallArgs(endpointDef) {
+			fields.Id(arg.field).Add(arg.typ)
+		}
+	})
+}
+
+func astForFakeEndpointMethod(serviceName string, endpointDef *types.EndpointDefinition) *jen.Statement {
+	funcField := jen.Id(fakeReceiverName).Dot(fakeFuncFieldName(endpointDef))
+	_, streamed := streamedResponseItem(endpointDef)
+	return jen.Func().
+		Params(jen.Id(fakeReceiverName).Op("*").Id(fakeTypeName(serviceName))).
+		Id(transforms.Export(endpointDef.EndpointName)).
+		ParamsFunc(func(args *jen.Group) {
+			astForEndpointArgsFunc(args, endpointDef, false, true)
+		}).
+		ParamsFunc(func(args *jen.Group) {
+			astForEndpointReturnsFunc(args, endpointDef, true)
+		}).
+		BlockFunc(func(methodBody *jen.Group) {
+			methodBody.Id(fakeReceiverName).Dot(fakeMutexFieldName).Dot("Lock").Call()
+			methodBody.Id(fakeReceiverName).Dot(fakeCallsFieldName(endpointDef)).Op("=").Append(
+				jen.Id(fakeReceiverName).Dot(fakeCallsFieldName(endpointDef)),
+				jen.Id(fakeCallTypeName(serviceName, endpointDef)).ValuesFunc(func(values *jen.Group) {
+					for _, arg := range fakeCallArgs(endpointDef) {
+						values.Id(arg.field).Op(":").Id(arg.varName)
+					}
+				}),
+			)
+			methodBody.Id(fakeReceiverName).Dot(fakeMutexFieldName).Dot("Unlock").Call()
+			methodBody.If(funcField.Clone().Op("!=").Nil()).Block(
+				jen.Return(funcField.Clone().CallFunc(func(args *jen.Group) {
+					args.Id(ctxName)
+					for _, arg := range fakeCallArgs(endpointDef) {
+						args.Id(arg.varName)
+					}
+					if streamed {
+						args.Id(streamWriterVarName)
+					}
+				})),
+			)
+			defaultErr := jen.Id(fakeReceiverName).Dot(fakeDefaultErrMethod).Call(jen.Lit(endpointDef.EndpointName))
+			if endpointDef.Returns == nil || streamed {
+				methodBody.Return(defaultErr)
+				return
+			}
+			methodBody.Var().Id(defaultReturnValVar).Add(astForEndpointReturnType(*endpointDef.Returns))
+			methodBody.Return(jen.Id(defaultReturnValVar), defaultErr)
+		})
+}
+
+func astForFakeCallsMethod(serviceName string, endpointDef *types.EndpointDefinition) *jen.Statement {
+	methodName := fakeCallsMethodName(endpointDef)
+	callType := fakeCallTypeName(serviceName, endpointDef)
+	return jen.
+		Commentf("%s returns the arguments of every call made to %s, in call order.", methodName, transforms.Export(endpointDef.EndpointName)).Line().
+		Func().
+		Params(jen.Id(fakeReceiverName).Op("*").Id(fakeTypeName(serviceName))).
+		Id(methodName).
+		Params().
+		Params(jen.Index().Id(callType)).
+		Block(
+			jen.Id(fakeReceiverName).Dot(fakeMutexFieldName).Dot("Lock").Call(),
+			jen.Defer().Id(fakeReceiverName).Dot(fakeMutexFieldName).Dot("Unlock").Call(),
+			jen.Return(jen.Append(
+				jen.Index().Id(callType).Parens(jen.Nil()),
+				jen.Id(fakeReceiverName).Dot(fakeCallsFieldName(endpointDef)).Op("..."),
+			)),
+		)
+}
+
+func astForFakeDefaultErrMethod(serviceName string) *jen.Statement {
+	return jen.Func().
+		Params(jen.Id(fakeReceiverName).Op("*").Id(fakeTypeName(serviceName))).
+		Id(fakeDefaultErrMethod).
+		Params(jen.Id(fakeEndpointVarName).String()).
+		Params(jen.Error()).
+		Block(
+			jen.If(jen.Id(fakeReceiverName).Dot(fakeDefaultErrField).Op("!=").Nil()).Block(
+				jen.Return(jen.Id(fakeReceiverName).Dot(fakeDefaultErrField)),
+			),
+			jen.Return(snip.CGRErrorsNewInternal().Call(
+				snip.WparamsNewSafeParamStorer().Call(jen.Map(jen.String()).Interface().Values(jen.Dict{
+					jen.Lit("fakeEndpoint"): jen.Id(fakeEndpointVarName),
+				})),
+			)),
+		)
+}
+
+type fakeCallArg struct {
+	field   string
+	varName string
+	typ     *jen.Statement
+}
+
+// fakeCallArgs returns the arguments (other than the context and the writer of streamed responses) passed to the server
+// implementation of endpointDef.
+func fakeCallArgs(endpointDef *types.EndpointDefinition) []fakeCallArg {
+	var args []fakeCallArg
+	if endpointDef.HeaderAuth {
+		args = append(args, fakeCallArg{field: transforms.Export(authHeaderVar), varName: authHeaderVar, typ: types.Bearertoken{}.Code()})
+	} else if endpointDef.CookieAuth != nil {
+		args = append(args, fakeCallArg{field: transforms.Export(cookieTokenVar), varName: cookieTokenVar, typ: types.Bearertoken{}.Code()})
+	}
+	for _, paramDef := range endpointDef.Params {
+		args = append(args, fakeCallArg{
+			field:   transforms.Export(paramDef.Name),
+			varName: transforms.ArgName(paramDef.Name),
+			typ:     astForEndpointParameterType(paramDef, true),
+		})
+	}
+	return args
+}
+
+func fakeTypeName(serviceName string) string {
+	return "Fake" + transforms.Export(serviceName)
+}
+
+func fakeCallTypeName(serviceName string, endpointDef *types.EndpointDefinition) string {
+	return fakeTypeName(serviceName) + transforms.Export(endpointDef.EndpointName) + "Call"
+}
+
+func fakeFuncFieldName(endpointDef *types.EndpointDefinition) string {
+	return transforms.Export(endpointDef.EndpointName) + "Func"
+}
+
+func fakeCallsFieldName(endpointDef *types.EndpointDefinition) string {
+	return transforms.Private(endpointDef.EndpointName) + "Calls"
+}
+
+func fakeCallsMethodName(endpointDef *types.EndpointDefinition) string {
+	return transforms.Export(endpointDef.EndpointName) + "Calls"
+}
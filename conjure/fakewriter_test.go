@@ -0,0 +1,65 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"testing"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/palantir/conjure-go/v6/conjure/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteFakeTypeNameConflicts(t *testing.T) {
+	for _, test := range []struct {
+		name      string
+		endpoints []*types.EndpointDefinition
+		err       string
+	}{
+		{
+			name: "no conflicts",
+			endpoints: []*types.EndpointDefinition{
+				{EndpointName: "getFoo", HeaderAuth: true, Params: []*types.EndpointArgumentDefinition{{Name: "fooId", Type: types.String{}, ParamType: types.PathParam}}},
+				{EndpointName: "mu"},
+			},
+		},
+		{
+			name:      "endpoint named like the default error field",
+			endpoints: []*types.EndpointDefinition{{EndpointName: "defaultErr"}},
+			err:       "the method of endpoint defaultErr of FakeFooService conflicts with the default error field: both are named DefaultErr",
+		},
+		{
+			name:      "endpoint named like the calls method of another endpoint",
+			endpoints: []*types.EndpointDefinition{{EndpointName: "getFoo"}, {EndpointName: "getFooCalls"}},
+			err:       "the method of endpoint getFooCalls of FakeFooService conflicts with the calls method of endpoint getFoo: both are named GetFooCalls",
+		},
+		{
+			name: "parameter named like the auth header field",
+			endpoints: []*types.EndpointDefinition{
+				{EndpointName: "getFoo", HeaderAuth: true, Params: []*types.EndpointArgumentDefinition{{Name: "authHeader", Type: types.String{}, ParamType: types.QueryParam}}},
+			},
+			err: "FakeFooServiceGetFooCall has more than one field named AuthHeader",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := writeFakeType(jen.NewFile("api").Group, &types.ServiceDefinition{Name: "FooService", Endpoints: test.endpoints}, false)
+			if test.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, test.err)
+		})
+	}
+}
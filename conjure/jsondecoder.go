@@ -0,0 +1,774 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/palantir/conjure-go/v6/conjure/snip"
+	"github.com/palantir/conjure-go/v6/conjure/transforms"
+	"github.com/palantir/conjure-go/v6/conjure/types"
+)
+
+const (
+	jsonValueVarName       = "value"
+	decodeJSONStrictMethod = "decodeJSONStrict"
+
+	parseJSONStrictFunc       = "parseJSONStrict"
+	jsonTypeErrorFunc         = "jsonTypeError"
+	jsonNumberKeyFunc         = "jsonNumberKey"
+	decodeJSONStringFunc      = "decodeJSONString"
+	decodeJSONIntFunc         = "decodeJSONInt"
+	decodeJSONSafeLongFunc    = "decodeJSONSafeLong"
+	decodeJSONFloat64Func     = "decodeJSONFloat64"
+	decodeJSONBoolFunc        = "decodeJSONBool"
+	decodeJSONUUIDFunc        = "decodeJSONUUID"
+	decodeJSONRIDFunc         = "decodeJSONRID"
+	decodeJSONDateTimeFunc    = "decodeJSONDateTime"
+	decodeJSONBinaryFunc      = "decodeJSONBinary"
+	decodeJSONBearerTokenFunc = "decodeJSONBearerToken"
+	decodeJSONAnyFunc         = "decodeJSONAny"
+	decodeJSONEnumFunc        = "decodeJSONEnum"
+	decodeJSONBinaryKeyFunc   = "decodeJSONBinaryKey"
+	decodeJSONBooleanKeyFunc  = "decodeJSONBooleanKey"
+)
+
+// The UnmarshalJSONStrict methods decode values according to the Conjure wire spec, which is stricter than
+// safejson.Unmarshal: objects must not contain unknown or duplicate fields, required fields must be present and not
+// null, sets must not contain duplicate elements, maps must not contain duplicate keys, numbers must fit their type
+// exactly and strings must be valid values of their type (e.g. enum values, bearer tokens and datetimes). Doubles are
+// decoded from numbers or from the strings "NaN", "Infinity" and "-Infinity". Missing and null collections are decoded
+// as empty collections.
+//
+// UnmarshalJSONStrict parses the input using gjson and calls the decodeJSONStrict method, which decodes a gjson.Result
+// so that nested values of the same package are decoded without parsing them again.
+
+func (e *jsonWriter) decodes(typ types.Type) bool {
+	if isNamedSet(typ) {
+		return true
+	}
+	_, ok := e.decoded[typ]
+	return ok
+}
+
+func (e *jsonWriter) writeObjectDecodeMethods(file *jen.Group, objectDef *types.ObjectType) {
+	if !e.decodes(objectDef) {
+		return
+	}
+	e.writeDecodeMethods(file, objReceiverName, objectDef.Name, func(d *jsonDecoder, body *jen.Group) {
+		value := jen.Id(jsonValueVarName)
+		body.If(jen.Op("!").Add(value).Dot("IsObject").Call()).Block(
+			jen.Return(jen.Id(d.helper(jsonTypeErrorFunc)).Call(value, jen.Lit("object"))),
+		)
+		body.Op("*").Id(objReceiverName).Op("=").Id(objectDef.Name).Values()
+		seen := make([]jen.Code, len(objectDef.Fields))
+		for i, fieldDef := range objectDef.Fields {
+			seen[i] = jen.Id(d.newVar("seen" + transforms.ExportedFieldName(fieldDef.Name)))
+		}
+		if len(seen) > 0 {
+			body.Var().List(seen...).Bool()
+		}
+		body.Var().Err().Error()
+		key, field := jen.Id(d.newVar("key")), jen.Id(d.newVar("field"))
+		if len(objectDef.Fields) == 0 {
+			field = jen.Id("_")
+		}
+		inner := d.inClosure()
+		body.Add(value).Dot("ForEach").Call(jen.Func().Params(jen.List(key, field).Add(snip.GJSONResult())).Bool().BlockFunc(func(loop *jen.Group) {
+			unknownField := func(g *jen.Group) {
+				inner.fail(g, snip.FmtErrorf().Call(jen.Lit("unknown field %q"), jen.Add(key).Dot("Str")))
+			}
+			if len(objectDef.Fields) == 0 {
+				unknownField(loop)
+				return
+			}
+			loop.Switch(jen.Add(key).Dot("Str")).BlockFunc(func(cases *jen.Group) {
+				for i, fieldDef := range objectDef.Fields {
+					i, fieldDef := i, fieldDef
+					cases.Case(jen.Lit(fieldDef.Name)).BlockFunc(func(caseBody *jen.Group) {
+						caseBody.If(seen[i]).BlockFunc(func(g *jen.Group) {
+							inner.fail(g, snip.FmtErrorf().Call(jen.Lit(fmt.Sprintf("duplicate field %q", fieldDef.Name))))
+						})
+						caseBody.Add(seen[i]).Op("=").True()
+						fieldDecoder := inner.inField(fieldDef.Name)
+						target := jen.Id(objReceiverName).Dot(transforms.ExportedFieldName(fieldDef.Name))
+						if fieldDef.Type.Make() != nil {
+							// collections may be null
+							caseBody.If(jen.Add(field).Dot("Type").Op("!=").Add(snip.GJSONNull())).BlockFunc(func(g *jen.Group) {
+								fieldDecoder.value(g, target, field, fieldDef.Type)
+							})
+						} else {
+							fieldDecoder.value(caseBody, target, field, fieldDef.Type)
+						}
+					})
+				}
+				cases.Default().BlockFunc(unknownField)
+			})
+			loop.Return(jen.True())
+		}))
+		d.check(body)
+		for i, fieldDef := range objectDef.Fields {
+			if fieldDef.Type.IsOptional() {
+				continue
+			}
+			if collInit := fieldDef.Type.Make(); collInit != nil {
+				selector := jen.Id(objReceiverName).Dot(transforms.ExportedFieldName(fieldDef.Name))
+				body.If(selector.Clone().Op("==").Nil()).Block(selector.Clone().Op("=").Add(collInit))
+				continue
+			}
+			body.If(jen.Op("!").Add(seen[i])).Block(
+				jen.Return(snip.FmtErrorf().Call(jen.Lit(fmt.Sprintf("field %q is required", fieldDef.Name)))),
+			)
+		}
+		body.Return(jen.Nil())
+	})
+}
+
+func (e *jsonWriter) writeUnionDecodeMethods(file *jen.Group, unionDef *types.UnionType) {
+	if !e.decodes(unionDef) {
+		return
+	}
+	e.writeDecodeMethods(file, unionReceiverName, unionDef.Name, func(d *jsonDecoder, body *jen.Group) {
+		value, typ := jen.Id(jsonValueVarName), jen.Id("typ")
+		seenType, variantName, variant, seenVariant := jen.Id("seenType"), jen.Id("variantName"), jen.Id("variant"), jen.Id("seenVariant")
+		key, field := jen.Id(d.newVar("key")), jen.Id(d.newVar("field"))
+		inner := d.inClosure()
+		body.If(jen.Op("!").Add(value).Dot("IsObject").Call()).Block(
+			jen.Return(jen.Id(d.helper(jsonTypeErrorFunc)).Call(value, jen.Lit("object"))),
+		)
+		body.Var().Add(typ).String()
+		body.Var().Add(variantName).String()
+		body.Var().Add(variant).Add(snip.GJSONResult())
+		body.Var().List(seenType, seenVariant).Bool()
+		body.Var().Err().Error()
+		body.Add(value).Dot("ForEach").Call(jen.Func().Params(jen.List(key, field).Add(snip.GJSONResult())).Bool().BlockFunc(func(loop *jen.Group) {
+			loop.Switch().Block(
+				jen.Case(jen.Add(key).Dot("Str").Op("==").Lit("type")).BlockFunc(func(g *jen.Group) {
+					g.If(seenType).BlockFunc(func(g *jen.Group) {
+						inner.fail(g, snip.FmtErrorf().Call(jen.Lit(`duplicate field "type"`)))
+					})
+					g.Add(seenType).Op("=").True()
+					inner.inField("type").value(g, typ, field, types.String{})
+					g.Return(jen.True())
+				}),
+				jen.Case(seenVariant.Clone().Op("&&").Add(key).Dot("Str").Op("==").Add(variantName)).BlockFunc(func(g *jen.Group) {
+					inner.fail(g, snip.FmtErrorf().Call(jen.Lit("duplicate field %q"), jen.Add(key).Dot("Str")))
+				}),
+				jen.Case(seenVariant).BlockFunc(func(g *jen.Group) {
+					inner.fail(g, snip.FmtErrorf().Call(jen.Lit("unknown field %q"), jen.Add(key).Dot("Str")))
+				}),
+			)
+			loop.List(variantName, variant, seenVariant).Op("=").List(jen.Add(key).Dot("Str"), field, jen.True())
+			loop.Return(jen.True())
+		}))
+		d.check(body)
+		body.If(jen.Op("!").Add(seenType)).Block(
+			jen.Return(snip.FmtErrorf().Call(jen.Lit(`field "type" is required`))),
+		)
+		body.If(seenVariant.Clone().Op("&&").Add(variantName).Op("!=").Add(typ)).Block(
+			jen.Return(snip.FmtErrorf().Call(jen.Lit("unknown field %q"), variantName)),
+		)
+		body.Op("*").Id(unionReceiverName).Op("=").Id(unionDef.Name).Values(jen.Id("typ").Op(":").Add(typ))
+		if len(unionDef.Fields) == 0 {
+			body.Return(jen.Nil())
+			return
+		}
+		body.Switch(typ).BlockFunc(func(cases *jen.Group) {
+			for _, fieldDef := range unionDef.Fields {
+				fieldDef := fieldDef
+				cases.Case(jen.Lit(fieldDef.Name)).BlockFunc(func(caseBody *jen.Group) {
+					if !fieldDef.Type.IsOptional() {
+						caseBody.If(jen.Add(variant).Dot("Type").Op("==").Add(snip.GJSONNull())).Block(
+							jen.Return(snip.FmtErrorf().Call(jen.Lit(fmt.Sprintf("field %q is required", fieldDef.Name)))),
+						)
+					}
+					v := d.newVar("v")
+					caseBody.Var().Id(v).Add(fieldDef.Type.Code())
+					d.inField(fieldDef.Name).value(caseBody, jen.Id(v), variant, fieldDef.Type)
+					caseBody.Id(unionReceiverName).Dot(transforms.PrivateFieldName(fieldDef.Name)).Op("=").Op("&").Id(v)
+				})
+			}
+		})
+		body.Return(jen.Nil())
+	})
+}
+
+func (e *jsonWriter) writeEnumDecodeMethods(file *jen.Group, enumDef *types.EnumType) {
+	if !e.decodes(enumDef) {
+		return
+	}
+	e.writeDecodeMethods(file, enumReceiverName, enumDef.Name, func(d *jsonDecoder, body *jen.Group) {
+		body.Return(jen.Id(d.helper(decodeJSONEnumFunc)).Call(jen.Id(jsonValueVarName), jen.Id(enumReceiverName)))
+	})
+}
+
+func (e *jsonWriter) writeAliasDecodeMethods(file *jen.Group, aliasDef *types.AliasType) {
+	if !e.decodes(aliasDef) {
+		return
+	}
+	e.writeDecodeMethods(file, aliasReceiverName, aliasDef.Name, func(d *jsonDecoder, body *jen.Group) {
+		v := d.newVar("v")
+		body.Var().Err().Error()
+		body.Var().Id(v).Add(aliasDef.Item.Code())
+		d.value(body, jen.Id(v), jen.Id(jsonValueVarName), aliasDef.Item)
+		if aliasDef.IsOptional() {
+			body.Op("*").Id(aliasReceiverName).Op("=").Id(aliasDef.Name).Values(jen.Id(aliasValueFieldName).Op(":").Id(v))
+		} else {
+			body.Op("*").Id(aliasReceiverName).Op("=").Id(aliasDef.Name).Call(jen.Id(v))
+		}
+		body.Return(jen.Nil())
+	})
+}
+
+// writeDecodeMethods writes the UnmarshalJSONStrict method, which parses its input and calls decodeJSONStrict, and the
+// decodeJSONStrict method with the body written by writeBody.
+func (e *jsonWriter) writeDecodeMethods(file *jen.Group, receiverName, typeName string, writeBody func(*jsonDecoder, *jen.Group)) {
+	file.Add(snip.MethodUnmarshalJSONStrict(receiverName, typeName).Block(
+		jen.List(jen.Id(jsonValueVarName), jen.Err()).Op(":=").Id(e.helper(parseJSONStrictFunc)).Call(jen.Id(dataVarName)),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+		jen.Return(jen.Id(receiverName).Dot(decodeJSONStrictMethod).Call(jen.Id(jsonValueVarName))),
+	))
+	file.Func().Params(jen.Id(receiverName).Op("*").Id(typeName)).
+		Id(decodeJSONStrictMethod).Params(jen.Id(jsonValueVarName).Add(snip.GJSONResult())).Error().
+		BlockFunc(func(body *jen.Group) {
+			writeBody(newJSONDecoder(e, func(g *jen.Group, err *jen.Statement) {
+				if err == nil {
+					err = jen.Err()
+				}
+				g.Return(err)
+			}), body)
+		})
+}
+
+// writeDecodeBody writes the statements of a server handler that decode the JSON request body in the data variable into
+// target. The statements return decoding errors as Conjure InvalidArgument errors.
+func (e *jsonWriter) writeDecodeBody(g *jen.Group, target *jen.Statement, typ types.Type) {
+	d := newJSONDecoder(e, func(g *jen.Group, err *jen.Statement) {
+		if err == nil {
+			err = jen.Err()
+		}
+		g.Return(snip.CGRErrorsWrapWithInvalidArgument().Call(err))
+	})
+	if d.decodes(typ) {
+		g.If(
+			jen.Err().Op(":=").Add(target).Dot("UnmarshalJSONStrict").Call(jen.Id(dataVarName)),
+			jen.Err().Op("!=").Nil(),
+		).BlockFunc(func(g *jen.Group) {
+			d.fail(g, nil)
+		})
+		return
+	}
+	g.List(jen.Id(jsonValueVarName), jen.Err()).Op(":=").Id(e.helper(parseJSONStrictFunc)).Call(jen.Id(dataVarName))
+	d.check(g)
+	d.value(g, target, jen.Id(jsonValueVarName), typ)
+}
+
+// jsonDecoder writes the statements of a decodeJSONStrict method. The statements assign decoded values to their
+// targets and assign errors to the err variable.
+type jsonDecoder struct {
+	*jsonWriter
+	// onErr writes the statements that handle the provided error.
+	onErr func(g *jen.Group, err *jen.Statement)
+	// vars counts the variables declared by the method by name.
+	vars map[string]int
+}
+
+func newJSONDecoder(w *jsonWriter, onErr func(g *jen.Group, err *jen.Statement)) *jsonDecoder {
+	return &jsonDecoder{jsonWriter: w, onErr: onErr, vars: map[string]int{}}
+}
+
+// inClosure returns a decoder for the body of a ForEach callback, which stops the iteration on errors.
+func (d *jsonDecoder) inClosure() *jsonDecoder {
+	return &jsonDecoder{jsonWriter: d.jsonWriter, vars: d.vars, onErr: func(g *jen.Group, err *jen.Statement) {
+		if err != nil {
+			g.Err().Op("=").Add(err)
+		}
+		g.Return(jen.False())
+	}}
+}
+
+// inField returns a decoder that adds the name of the provided field to errors.
+func (d *jsonDecoder) inField(name string) *jsonDecoder {
+	return &jsonDecoder{jsonWriter: d.jsonWriter, vars: d.vars, onErr: func(g *jen.Group, err *jen.Statement) {
+		if err == nil {
+			err = jen.Err()
+		}
+		d.onErr(g, snip.FmtErrorf().Call(jen.Lit(fmt.Sprintf("field %q: %%w", name)), err))
+	}}
+}
+
+// newVar returns a variable name that starts with name and is unique in the method.
+func (d *jsonDecoder) newVar(name string) string {
+	n := d.vars[name]
+	d.vars[name]++
+	return loopVarName(name, n)
+}
+
+// fail writes the statements that handle the provided error, or the error in the err variable if err is nil.
+func (d *jsonDecoder) fail(g *jen.Group, err *jen.Statement) {
+	d.onErr(g, err)
+}
+
+// check writes 'if err != nil { <fail> }'.
+func (d *jsonDecoder) check(g *jen.Group) {
+	g.If(jen.Err().Op("!=").Nil()).BlockFunc(func(g *jen.Group) {
+		d.fail(g, nil)
+	})
+}
+
+// call writes 'target, err = <helper>(value)' followed by a check of err.
+func (d *jsonDecoder) call(g *jen.Group, target, value *jen.Statement, helper string) {
+	g.List(jen.Add(target), jen.Err()).Op("=").Id(d.helper(helper)).Call(jen.Add(value))
+	d.check(g)
+}
+
+// value writes the statements that decode the gjson.Result selected by value into target, which is a variable or field of
+// the Go type of typ that holds its zero value.
+func (d *jsonDecoder) value(g *jen.Group, target, value *jen.Statement, typ types.Type) {
+	switch t := typ.(type) {
+	case types.String:
+		d.call(g, target, value, decodeJSONStringFunc)
+	case types.Bearertoken:
+		d.call(g, target, value, decodeJSONBearerTokenFunc)
+	case types.Integer:
+		d.call(g, target, value, decodeJSONIntFunc)
+	case types.Safelong:
+		d.call(g, target, value, decodeJSONSafeLongFunc)
+	case types.Double:
+		d.call(g, target, value, decodeJSONFloat64Func)
+	case types.Boolean:
+		d.call(g, target, value, decodeJSONBoolFunc)
+	case types.UUID:
+		d.call(g, target, value, decodeJSONUUIDFunc)
+	case types.RID:
+		d.call(g, target, value, decodeJSONRIDFunc)
+	case types.DateTime:
+		d.call(g, target, value, decodeJSONDateTimeFunc)
+	case types.Binary:
+		d.call(g, target, value, decodeJSONBinaryFunc)
+	case types.Any:
+		d.call(g, target, value, decodeJSONAnyFunc)
+	case *types.Optional:
+		g.If(jen.Add(value).Dot("Type").Op("!=").Add(snip.GJSONNull())).BlockFunc(func(g *jen.Group) {
+			v := d.newVar("v")
+			g.Var().Id(v).Add(t.Item.Code())
+			d.value(g, jen.Id(v), value, t.Item)
+			g.Add(target).Op("=").Op("&").Id(v)
+		})
+	case *types.List:
+		d.listValue(g, target, value, t.Code(), t.Item, false)
+	case *types.Set:
+		if t.Name != "" {
+			d.namedValue(g, target, value, t)
+			return
+		}
+		d.listValue(g, target, value, t.Code(), t.Item, true)
+	case *types.Map:
+		d.mapValue(g, target, value, t)
+	case *types.AliasType, *types.EnumType, *types.ObjectType, *types.UnionType:
+		d.namedValue(g, target, value, t)
+	default:
+		// external types
+		d.unmarshalValue(g, target, value)
+	}
+}
+
+// namedValue writes the statements that decode a value of a named type using its decodeJSONStrict method if the type
+// is declared in the package of the decoder and its UnmarshalJSONStrict method otherwise.
+func (d *jsonDecoder) namedValue(g *jen.Group, target, value *jen.Statement, typ types.Type) {
+	switch {
+	case !d.decodes(typ):
+		d.unmarshalValue(g, target, value)
+	case d.isLocal(typ):
+		g.Err().Op("=").Add(target).Dot(decodeJSONStrictMethod).Call(jen.Add(value))
+		d.check(g)
+	default:
+		g.Err().Op("=").Add(target).Dot("UnmarshalJSONStrict").Call(jen.Index().Byte().Call(jen.Add(value).Dot("Raw")))
+		d.check(g)
+	}
+}
+
+func (d *jsonDecoder) unmarshalValue(g *jen.Group, target, value *jen.Statement) {
+	g.Err().Op("=").Add(snip.SafeJSONUnmarshal()).Call(jen.Index().Byte().Call(jen.Add(value).Dot("Raw")), jen.Op("&").Add(target))
+	d.check(g)
+}
+
+func (d *jsonDecoder) listValue(g *jen.Group, target, value, code *jen.Statement, item types.Type, isSet bool) {
+	g.If(jen.Op("!").Add(value).Dot("IsArray").Call()).BlockFunc(func(g *jen.Group) {
+		d.fail(g, jen.Id(d.helper(jsonTypeErrorFunc)).Call(jen.Add(value), jen.Lit("array")))
+	})
+	g.Add(target).Op("=").Make(code, jen.Lit(0))
+	var seen string
+	if isSet && isComparableSetItem(item) {
+		seen = d.newVar("seen")
+		g.Id(seen).Op(":=").Make(jen.Map(item.Code()).Struct())
+	}
+	elem, v := d.newVar("elem"), d.newVar("v")
+	inner := d.inClosure()
+	g.Add(value).Dot("ForEach").Call(jen.Func().Params(jen.Id("_"), jen.Id(elem).Add(snip.GJSONResult())).Bool().BlockFunc(func(loop *jen.Group) {
+		loop.Var().Id(v).Add(item.Code())
+		inner.value(loop, jen.Id(v), jen.Id(elem), item)
+		if seen != "" {
+			loop.If(jen.List(jen.Id("_"), jen.Id("ok")).Op(":=").Id(seen).Index(jen.Id(v)), jen.Id("ok")).BlockFunc(func(g *jen.Group) {
+				inner.fail(g, snip.FmtErrorf().Call(jen.Lit("duplicate set element %s"), jen.Id(elem).Dot("Raw")))
+			})
+			loop.Id(seen).Index(jen.Id(v)).Op("=").Struct().Values()
+		}
+		loop.Add(target).Op("=").Append(jen.Add(target), jen.Id(v))
+		loop.Return(jen.True())
+	}))
+	d.check(g)
+}
+
+func (d *jsonDecoder) mapValue(g *jen.Group, target, value *jen.Statement, mapType *types.Map) {
+	g.If(jen.Op("!").Add(value).Dot("IsObject").Call()).BlockFunc(func(g *jen.Group) {
+		d.fail(g, jen.Id(d.helper(jsonTypeErrorFunc)).Call(jen.Add(value), jen.Lit("object")))
+	})
+	g.Add(target).Op("=").Make(mapType.Code())
+	key, elem, k, v := d.newVar("key"), d.newVar("elem"), d.newVar("k"), d.newVar("v")
+	inner := d.inClosure()
+	g.Add(value).Dot("ForEach").Call(jen.Func().Params(jen.List(jen.Id(key), jen.Id(elem)).Add(snip.GJSONResult())).Bool().BlockFunc(func(loop *jen.Group) {
+		loop.Var().Id(k).Add(jsonMapKeyCode(mapType.Key))
+		inner.keyValue(loop, jen.Id(k), jen.Id(key), mapType.Key)
+		loop.If(jen.List(jen.Id("_"), jen.Id("ok")).Op(":=").Add(target).Index(jen.Id(k)), jen.Id("ok")).BlockFunc(func(g *jen.Group) {
+			inner.fail(g, snip.FmtErrorf().Call(jen.Lit("duplicate map key %s"), jen.Id(key).Dot("Raw")))
+		})
+		loop.Var().Id(v).Add(mapType.Val.Code())
+		inner.value(loop, jen.Id(v), jen.Id(elem), mapType.Val)
+		loop.Add(target).Index(jen.Id(k)).Op("=").Id(v)
+		loop.Return(jen.True())
+	}))
+	d.check(g)
+}
+
+// keyValue writes the statements that decode the JSON object key selected by key into target, which is a variable of the
+// type returned by jsonMapKeyCode.
+func (d *jsonDecoder) keyValue(g *jen.Group, target, key *jen.Statement, keyType types.Type) {
+	switch {
+	case keyType.IsBinary():
+		d.call(g, target, key, decodeJSONBinaryKeyFunc)
+		return
+	case keyType.IsBoolean():
+		d.call(g, target, key, decodeJSONBooleanKeyFunc)
+		return
+	}
+	switch t := keyType.(type) {
+	case types.String:
+		g.Add(target).Op("=").Add(key).Dot("Str")
+	case types.Integer, types.Safelong, types.Double:
+		d.value(g, target, jen.Id(d.helper(jsonNumberKeyFunc)).Call(key), keyType)
+	case *types.AliasType:
+		k := d.newVar("k")
+		g.Var().Id(k).Add(jsonMapKeyCode(t.Item))
+		d.keyValue(g, jen.Id(k), key, t.Item)
+		g.Add(target).Op("=").Add(t.Code()).Call(jen.Id(k))
+	default:
+		// keys are JSON strings, which are decoded like string values of the key type
+		d.value(g, target, key, keyType)
+	}
+}
+
+// jsonMapKeyCode returns the Go type of the keys of a map with keys of type keyType (see types.Map).
+func jsonMapKeyCode(keyType types.Type) *jen.Statement {
+	switch {
+	case keyType.IsBinary():
+		return snip.BinaryBinary()
+	case keyType.IsBoolean():
+		return snip.BooleanBoolean()
+	default:
+		return keyType.Code()
+	}
+}
+
+// isComparableSetItem returns true if values of typ are comparable and equal values encode the same Conjure value, so
+// that duplicate elements of sets can be detected using a Go map.
+func isComparableSetItem(typ types.Type) bool {
+	switch t := typ.(type) {
+	case types.String, types.Bearertoken, types.Integer, types.Safelong, types.Double, types.Boolean, types.UUID,
+		types.RID, *types.EnumType:
+		return true
+	case *types.AliasType:
+		return !t.IsOptional() && isComparableSetItem(t.Item)
+	default:
+		return false
+	}
+}
+
+func gjsonResultParam(name string) *jen.Statement { return jen.Id(name).Add(snip.GJSONResult()) }
+
+func astForParseJSONStrict() *jen.Statement {
+	return jen.Commentf("%s parses data, which must contain a single valid JSON value.", parseJSONStrictFunc).Line().
+		Func().Id(parseJSONStrictFunc).Params(jen.Id(dataVarName).Index().Byte()).Params(snip.GJSONResult(), jen.Error()).Block(
+		jen.If(jen.Op("!").Add(snip.GJSONValidBytes()).Call(jen.Id(dataVarName))).Block(
+			jen.Return(snip.GJSONResult().Values(), snip.FmtErrorf().Call(jen.Lit("invalid JSON"))),
+		),
+		jen.Return(snip.GJSONParseBytes().Call(jen.Id(dataVarName)), jen.Nil()),
+	)
+}
+
+func astForJSONTypeError() *jen.Statement {
+	value := jen.Id(jsonValueVarName)
+	return jen.Commentf("%s returns the error for a value that is not of the expected kind.", jsonTypeErrorFunc).Line().
+		Func().Id(jsonTypeErrorFunc).Params(gjsonResultParam(jsonValueVarName), jen.Id("want").String()).Error().Block(
+		jen.Var().Id("got").String(),
+		jen.Switch(value.Clone().Dot("Type")).Block(
+			jen.Case(snip.GJSONNull()).Block(jen.Id("got").Op("=").Lit("null")),
+			jen.Case(snip.GJSONFalse(), snip.GJSONTrue()).Block(jen.Id("got").Op("=").Lit("boolean")),
+			jen.Case(snip.GJSONNumber()).Block(jen.Id("got").Op("=").Lit("number")),
+			jen.Case(snip.GJSONString()).Block(jen.Id("got").Op("=").Lit("string")),
+			jen.Default().Block(
+				jen.If(value.Clone().Dot("IsArray").Call()).Block(
+					jen.Id("got").Op("=").Lit("array"),
+				).Else().Block(
+					jen.Id("got").Op("=").Lit("object"),
+				),
+			),
+		),
+		jen.Return(snip.FmtErrorf().Call(jen.Lit("expected %s but found %s"), jen.Id("want"), jen.Id("got"))),
+	)
+}
+
+func astForJSONNumberKey() *jen.Statement {
+	key, value := jen.Id("key"), jen.Id(jsonValueVarName)
+	return jen.Commentf("%s returns the number encoded by a JSON object key, or the key itself if it does not encode a", jsonNumberKeyFunc).Line().
+		Comment("number.").Line().
+		Func().Id(jsonNumberKeyFunc).Params(gjsonResultParam("key")).Add(snip.GJSONResult()).Block(
+		jen.If(snip.GJSONValid().Call(key.Clone().Dot("Str"))).Block(
+			jen.If(
+				value.Clone().Op(":=").Add(snip.GJSONParse()).Call(key.Clone().Dot("Str")),
+				value.Clone().Dot("Type").Op("==").Add(snip.GJSONNumber()),
+			).Block(jen.Return(value.Clone())),
+		),
+		jen.Return(key.Clone()),
+	)
+}
+
+// astForDecodeJSONFunc returns a function that decodes a value of type goType from a JSON value of kind want.
+func astForDecodeJSONFunc(name, doc string, goType *jen.Statement, zero *jen.Statement, want string, gjsonType *jen.Statement, body ...jen.Code) *jen.Statement {
+	value := jen.Id(jsonValueVarName)
+	return jen.Comment(name+" "+doc).Line().
+		Func().Id(name).Params(gjsonResultParam(jsonValueVarName)).Params(goType, jen.Error()).BlockFunc(func(g *jen.Group) {
+		g.If(value.Clone().Dot("Type").Op("!=").Add(gjsonType)).Block(
+			jen.Return(zero.Clone(), jen.Id(jsonTypeErrorFunc).Call(value.Clone(), jen.Lit(want))),
+		)
+		for _, stmt := range body {
+			g.Add(stmt)
+		}
+	})
+}
+
+func astForDecodeJSONString() *jen.Statement {
+	return astForDecodeJSONFunc(decodeJSONStringFunc, "decodes a JSON string.", jen.String(), jen.Lit(""), "string", snip.GJSONString(),
+		jen.Return(jen.Id(jsonValueVarName).Dot("Str"), jen.Nil()),
+	)
+}
+
+func astForDecodeJSONInt() *jen.Statement {
+	value := jen.Id(jsonValueVarName)
+	return astForDecodeJSONFunc(decodeJSONIntFunc, "decodes a JSON number that is a 32-bit integer.", jen.Int(), jen.Lit(0), "integer", snip.GJSONNumber(),
+		jen.List(jen.Id("v"), jen.Err()).Op(":=").Add(snip.StrconvParseInt()).Call(value.Clone().Dot("Raw"), jen.Lit(10), jen.Lit(32)),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Lit(0), snip.FmtErrorf().Call(jen.Lit("invalid integer %s"), value.Clone().Dot("Raw"))),
+		),
+		jen.Return(jen.Int().Call(jen.Id("v")), jen.Nil()),
+	)
+}
+
+func astForDecodeJSONSafeLong() *jen.Statement {
+	value := jen.Id(jsonValueVarName)
+	return astForDecodeJSONFunc(decodeJSONSafeLongFunc, "decodes a JSON number that is a safe long.", snip.SafeLongSafeLong(), jen.Lit(0), "safelong", snip.GJSONNumber(),
+		jen.List(jen.Id("v"), jen.Err()).Op(":=").Add(snip.StrconvParseInt()).Call(value.Clone().Dot("Raw"), jen.Lit(10), jen.Lit(64)),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Lit(0), snip.FmtErrorf().Call(jen.Lit("invalid safelong %s"), value.Clone().Dot("Raw"))),
+		),
+		jen.Return(snip.SafeLongNewSafeLong().Call(jen.Id("v"))),
+	)
+}
+
+func astForDecodeJSONFloat64() *jen.Statement {
+	value := jen.Id(jsonValueVarName)
+	return jen.Commentf("%s decodes a JSON number or one of the strings \"NaN\", \"Infinity\" and \"-Infinity\".", decodeJSONFloat64Func).Line().
+		Func().Id(decodeJSONFloat64Func).Params(gjsonResultParam(jsonValueVarName)).Params(jen.Float64(), jen.Error()).Block(
+		jen.Switch(value.Clone().Dot("Type")).Block(
+			jen.Case(snip.GJSONNumber()).Block(
+				jen.List(jen.Id("v"), jen.Err()).Op(":=").Add(snip.StrconvParseFloat()).Call(value.Clone().Dot("Raw"), jen.Lit(64)),
+				jen.If(jen.Err().Op("!=").Nil()).Block(
+					jen.Return(jen.Lit(0), snip.FmtErrorf().Call(jen.Lit("invalid double %s"), value.Clone().Dot("Raw"))),
+				),
+				jen.Return(jen.Id("v"), jen.Nil()),
+			),
+			jen.Case(snip.GJSONString()).Block(
+				jen.Switch(value.Clone().Dot("Str")).Block(
+					jen.Case(jen.Lit("NaN")).Block(jen.Return(snip.MathNaN().Call(), jen.Nil())),
+					jen.Case(jen.Lit("Infinity")).Block(jen.Return(snip.MathInf().Call(jen.Lit(1)), jen.Nil())),
+					jen.Case(jen.Lit("-Infinity")).Block(jen.Return(snip.MathInf().Call(jen.Lit(-1)), jen.Nil())),
+				),
+				jen.Return(jen.Lit(0), snip.FmtErrorf().Call(jen.Lit("invalid double %q"), value.Clone().Dot("Str"))),
+			),
+		),
+		jen.Return(jen.Lit(0), jen.Id(jsonTypeErrorFunc).Call(value.Clone(), jen.Lit("double"))),
+	)
+}
+
+func astForDecodeJSONBool() *jen.Statement {
+	value := jen.Id(jsonValueVarName)
+	return jen.Commentf("%s decodes a JSON boolean.", decodeJSONBoolFunc).Line().
+		Func().Id(decodeJSONBoolFunc).Params(gjsonResultParam(jsonValueVarName)).Params(jen.Bool(), jen.Error()).Block(
+		jen.Switch(value.Clone().Dot("Type")).Block(
+			jen.Case(snip.GJSONTrue()).Block(jen.Return(jen.True(), jen.Nil())),
+			jen.Case(snip.GJSONFalse()).Block(jen.Return(jen.False(), jen.Nil())),
+		),
+		jen.Return(jen.False(), jen.Id(jsonTypeErrorFunc).Call(value.Clone(), jen.Lit("boolean"))),
+	)
+}
+
+func astForDecodeJSONUUID() *jen.Statement {
+	return astForDecodeJSONFunc(decodeJSONUUIDFunc, "decodes a JSON string that is a UUID.", snip.UUIDUUID(), snip.UUIDUUID().Values(), "uuid", snip.GJSONString(),
+		jen.Return(snip.UUIDParseUUID().Call(jen.Id(jsonValueVarName).Dot("Str"))),
+	)
+}
+
+func astForDecodeJSONRID() *jen.Statement {
+	return astForDecodeJSONFunc(decodeJSONRIDFunc, "decodes a JSON string that is a resource identifier.", snip.RIDResourceIdentifier(), snip.RIDResourceIdentifier().Values(), "rid", snip.GJSONString(),
+		jen.Return(snip.RIDParseRID().Call(jen.Id(jsonValueVarName).Dot("Str"))),
+	)
+}
+
+func astForDecodeJSONDateTime() *jen.Statement {
+	value, s, i, n := jen.Id(jsonValueVarName), jen.Id("s"), jen.Id("i"), jen.Id("n")
+	zero := snip.DateTimeDateTime().Values()
+	return astForDecodeJSONFunc(decodeJSONDateTimeFunc, "decodes a JSON string that is an ISO 8601 datetime with at most nanosecond precision.", snip.DateTimeDateTime(), zero.Clone(), "datetime", snip.GJSONString(),
+		s.Clone().Op(":=").Add(value.Clone()).Dot("Str"),
+		jen.Comment("time.Parse accepts fractional seconds with more than 9 digits"),
+		jen.For(i.Clone().Op(":=").Lit(0), i.Clone().Op("<").Len(s.Clone()), i.Clone().Op("++")).Block(
+			jen.If(s.Clone().Index(i.Clone()).Op("!=").LitRune('.')).Block(jen.Continue()),
+			n.Clone().Op(":=").Lit(0),
+			jen.For(i.Clone().Op("+").Add(n.Clone()).Op("+").Lit(1).Op("<").Len(s.Clone()).Op("&&").
+				Add(s.Clone()).Index(i.Clone().Op("+").Add(n.Clone()).Op("+").Lit(1)).Op(">=").LitRune('0').Op("&&").
+				Add(s.Clone()).Index(i.Clone().Op("+").Add(n.Clone()).Op("+").Lit(1)).Op("<=").LitRune('9')).Block(
+				n.Clone().Op("++"),
+			),
+			jen.If(n.Clone().Op(">").Lit(9)).Block(
+				jen.Return(zero.Clone(), snip.FmtErrorf().Call(jen.Lit("invalid datetime %q"), s.Clone())),
+			),
+		),
+		jen.List(jen.Id("t"), jen.Err()).Op(":=").Add(snip.TimeParse()).Call(snip.TimeRFC3339Nano(), s.Clone()),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(zero.Clone(), snip.FmtErrorf().Call(jen.Lit("invalid datetime %q"), s.Clone())),
+		),
+		jen.Return(snip.DateTimeDateTime().Call(jen.Id("t")), jen.Nil()),
+	)
+}
+
+func astForDecodeJSONBinary() *jen.Statement {
+	return astForDecodeJSONFunc(decodeJSONBinaryFunc, "decodes a JSON string that is base64 encoded binary data.", jen.Index().Byte(), jen.Nil(), "binary", snip.GJSONString(),
+		jen.Return(snip.Base64StdEncoding().Dot("DecodeString").Call(jen.Id(jsonValueVarName).Dot("Str"))),
+	)
+}
+
+func astForDecodeJSONBearerToken() *jen.Statement {
+	s, i, c, n := jen.Id("s"), jen.Id("i"), jen.Id("c"), jen.Id("n")
+	invalid := jen.Return(jen.Lit(""), snip.FmtErrorf().Call(jen.Lit("invalid bearer token")))
+	return astForDecodeJSONFunc(decodeJSONBearerTokenFunc, `decodes a JSON string that is a bearer token, which must match ^[A-Za-z0-9\-\._~\+/]+=*$.`, snip.BearerTokenToken(), jen.Lit(""), "bearertoken", snip.GJSONString(),
+		s.Clone().Op(":=").Id(jsonValueVarName).Dot("Str"),
+		n.Clone().Op(":=").Len(s.Clone()),
+		jen.For(n.Clone().Op(">").Lit(0).Op("&&").Add(s.Clone()).Index(n.Clone().Op("-").Lit(1)).Op("==").LitRune('=')).Block(
+			n.Clone().Op("--"),
+		),
+		jen.If(n.Clone().Op("==").Lit(0)).Block(invalid.Clone()),
+		jen.For(i.Clone().Op(":=").Lit(0), i.Clone().Op("<").Add(n.Clone()), i.Clone().Op("++")).Block(
+			jen.Switch(c.Clone().Op(":=").Add(s.Clone()).Index(i.Clone()), jen.Empty()).Block(
+				jen.Case(
+					c.Clone().Op(">=").LitRune('a').Op("&&").Add(c.Clone()).Op("<=").LitRune('z'),
+					c.Clone().Op(">=").LitRune('A').Op("&&").Add(c.Clone()).Op("<=").LitRune('Z'),
+					c.Clone().Op(">=").LitRune('0').Op("&&").Add(c.Clone()).Op("<=").LitRune('9'),
+					c.Clone().Op("==").LitRune('-').Op("||").Add(c.Clone()).Op("==").LitRune('.').Op("||").
+						Add(c.Clone()).Op("==").LitRune('_').Op("||").Add(c.Clone()).Op("==").LitRune('~').Op("||").
+						Add(c.Clone()).Op("==").LitRune('+').Op("||").Add(c.Clone()).Op("==").LitRune('/'),
+				),
+				jen.Default().Block(invalid.Clone()),
+			),
+		),
+		jen.Return(snip.BearerTokenToken().Call(s.Clone()), jen.Nil()),
+	)
+}
+
+func astForDecodeJSONAny() *jen.Statement {
+	value := jen.Id(jsonValueVarName)
+	return jen.Commentf("%s decodes a JSON value that is not null using safejson.Unmarshal.", decodeJSONAnyFunc).Line().
+		Func().Id(decodeJSONAnyFunc).Params(gjsonResultParam(jsonValueVarName)).Params(jen.Interface(), jen.Error()).Block(
+		jen.If(value.Clone().Dot("Type").Op("==").Add(snip.GJSONNull())).Block(
+			jen.Return(jen.Nil(), jen.Id(jsonTypeErrorFunc).Call(value.Clone(), jen.Lit("value"))),
+		),
+		jen.Var().Id("v").Interface(),
+		jen.If(
+			jen.Err().Op(":=").Add(snip.SafeJSONUnmarshal()).Call(jen.Index().Byte().Call(value.Clone().Dot("Raw")), jen.Op("&").Id("v")),
+			jen.Err().Op("!=").Nil(),
+		).Block(jen.Return(jen.Nil(), jen.Err())),
+		jen.Return(jen.Id("v"), jen.Nil()),
+	)
+}
+
+func astForDecodeJSONEnum() *jen.Statement {
+	value, s, i, c := jen.Id(jsonValueVarName), jen.Id("s"), jen.Id("i"), jen.Id("c")
+	invalid := jen.Return(snip.FmtErrorf().Call(jen.Lit("invalid enum value %q"), s.Clone()))
+	return jen.Commentf("%s decodes a JSON string that matches ^[A-Z][A-Z0-9]*(_[A-Z0-9]+)*$ into v.", decodeJSONEnumFunc).Line().
+		Func().Id(decodeJSONEnumFunc).Params(gjsonResultParam(jsonValueVarName), jen.Id("v").Add(snip.EncodingTextUnmarshaler())).Error().Block(
+		jen.If(value.Clone().Dot("Type").Op("!=").Add(snip.GJSONString())).Block(
+			jen.Return(jen.Id(jsonTypeErrorFunc).Call(value.Clone(), jen.Lit("enum"))),
+		),
+		s.Clone().Op(":=").Add(value.Clone()).Dot("Str"),
+		jen.If(s.Clone().Op("==").Lit("")).Block(invalid.Clone()),
+		jen.For(i.Clone().Op(":=").Lit(0), i.Clone().Op("<").Len(s.Clone()), i.Clone().Op("++")).Block(
+			jen.Switch(c.Clone().Op(":=").Add(s.Clone()).Index(i.Clone()), jen.Empty()).Block(
+				jen.Case(c.Clone().Op(">=").LitRune('A').Op("&&").Add(c.Clone()).Op("<=").LitRune('Z')),
+				jen.Case(c.Clone().Op(">=").LitRune('0').Op("&&").Add(c.Clone()).Op("<=").LitRune('9')).Block(
+					jen.If(i.Clone().Op("==").Lit(0)).Block(invalid.Clone()),
+				),
+				jen.Case(c.Clone().Op("==").LitRune('_')).Block(
+					jen.If(i.Clone().Op("==").Lit(0).Op("||").Add(i.Clone()).Op("==").Len(s.Clone()).Op("-").Lit(1).Op("||").
+						Add(s.Clone()).Index(i.Clone().Op("-").Lit(1)).Op("==").LitRune('_')).Block(invalid.Clone()),
+				),
+				jen.Default().Block(invalid.Clone()),
+			),
+		),
+		jen.Return(jen.Id("v").Dot("UnmarshalText").Call(jen.Index().Byte().Call(s.Clone()))),
+	)
+}
+
+func astForDecodeJSONBinaryKey() *jen.Statement {
+	key := jen.Id("key")
+	return jen.Commentf("%s decodes a JSON object key that is base64 encoded binary data.", decodeJSONBinaryKeyFunc).Line().
+		Func().Id(decodeJSONBinaryKeyFunc).Params(gjsonResultParam("key")).Params(snip.BinaryBinary(), jen.Error()).Block(
+		jen.If(
+			jen.List(jen.Id("_"), jen.Err()).Op(":=").Add(snip.Base64StdEncoding()).Dot("DecodeString").Call(key.Clone().Dot("Str")),
+			jen.Err().Op("!=").Nil(),
+		).Block(jen.Return(jen.Lit(""), jen.Err())),
+		jen.Return(snip.BinaryBinary().Call(key.Clone().Dot("Str")), jen.Nil()),
+	)
+}
+
+func astForDecodeJSONBooleanKey() *jen.Statement {
+	key := jen.Id("key")
+	return jen.Commentf("%s decodes a JSON object key that is a boolean.", decodeJSONBooleanKeyFunc).Line().
+		Func().Id(decodeJSONBooleanKeyFunc).Params(gjsonResultParam("key")).Params(snip.BooleanBoolean(), jen.Error()).Block(
+		jen.Switch(key.Clone().Dot("Str")).Block(
+			jen.Case(jen.Lit("true")).Block(jen.Return(jen.True(), jen.Nil())),
+			jen.Case(jen.Lit("false")).Block(jen.Return(jen.False(), jen.Nil())),
+		),
+		jen.Return(jen.False(), snip.FmtErrorf().Call(jen.Lit("invalid boolean %q"), key.Clone().Dot("Str"))),
+	)
+}
@@ -0,0 +1,613 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/palantir/conjure-go/v6/conjure/snip"
+	"github.com/palantir/conjure-go/v6/conjure/transforms"
+	"github.com/palantir/conjure-go/v6/conjure/types"
+)
+
+const (
+	unmarshalJSONValueMethod = "unmarshalJSONValue"
+
+	unmarshalJSONStringFunc      = "unmarshalJSONString"
+	unmarshalJSONIntFunc         = "unmarshalJSONInt"
+	unmarshalJSONSafeLongFunc    = "unmarshalJSONSafeLong"
+	unmarshalJSONFloat64Func     = "unmarshalJSONFloat64"
+	unmarshalJSONBoolFunc        = "unmarshalJSONBool"
+	unmarshalJSONBinaryFunc      = "unmarshalJSONBinary"
+	unmarshalJSONAnyFunc         = "unmarshalJSONAny"
+	unmarshalJSONIntKeyFunc      = "unmarshalJSONIntKey"
+	unmarshalJSONSafeLongKeyFunc = "unmarshalJSONSafeLongKey"
+	matchesJSONFieldFunc         = "matchesJSONField"
+)
+
+// The UnmarshalJSON methods of objects and unions decode their input without reflection: they parse it using gjson and
+// call the unmarshalJSONValue method, which decodes a gjson.Result into the fields of the value. unmarshalJSONValue
+// returns false if its result could differ from the result of encoding/json, e.g. for values of the wrong kind,
+// duplicate fields, keys that only match a field case-insensitively and strings that gjson unescapes differently. In
+// that case, UnmarshalJSON decodes the input using safejson.Unmarshal so that decoded values and errors are the same as
+// those of the reflection-based implementation.
+
+// writeObjectUnmarshalMethods writes the unmarshalJSONValue method of an object and, if the object contains a
+// collection, the UnmarshalJSON method. Objects without collections are decoded by encoding/json without an
+// UnmarshalJSON method, but their unmarshalJSONValue method is still used to decode them as values of other types.
+// writeFallback writes the statements of UnmarshalJSON that decode the data variable into the receiver using
+// safejson.Unmarshal.
+func (e *jsonWriter) writeObjectUnmarshalMethods(file *jen.Group, objectDef *types.ObjectType, writeFallback func(*jen.Group)) {
+	if !objectHasJSONMethods(objectDef) {
+		writeFallback = nil
+	}
+	e.writeUnmarshalMethods(file, objReceiverName, objectDef.Name, writeFallback, func(u *jsonUnmarshaler, body *jen.Group) {
+		value := jen.Id(jsonValueVarName)
+		// encoding/json calls UnmarshalJSON with null, which decodes the zero value with initialized collections
+		body.If(jen.Add(value).Dot("Type").Op("==").Add(snip.GJSONNull())).BlockFunc(func(g *jen.Group) {
+			writeStructMarshalInitDecls(g, objectDef.Fields, objReceiverName)
+			g.Return(jen.True())
+		})
+		if len(objectDef.Fields) == 0 {
+			body.Return(jen.Add(value).Dot("IsObject").Call())
+			return
+		}
+		fields := make([]jsonUnmarshalField, len(objectDef.Fields))
+		for i, fieldDef := range objectDef.Fields {
+			fields[i] = jsonUnmarshalField{
+				name:   fieldDef.Name,
+				target: jen.Id(objReceiverName).Dot(transforms.ExportedFieldName(fieldDef.Name)),
+				typ:    fieldDef.Type,
+			}
+		}
+		u.fields(body, value, fields)
+		writeStructMarshalInitDecls(body, objectDef.Fields, objReceiverName)
+		body.Return(jen.True())
+	})
+}
+
+// writeUnionUnmarshalMethods writes the UnmarshalJSON and unmarshalJSONValue methods of a union. writeFallback writes
+// the statements of UnmarshalJSON that decode the data variable into the receiver using safejson.Unmarshal.
+func (e *jsonWriter) writeUnionUnmarshalMethods(file *jen.Group, unionDef *types.UnionType, writeFallback func(*jen.Group)) {
+	e.writeUnmarshalMethods(file, unionReceiverName, unionDef.Name, writeFallback, func(u *jsonUnmarshaler, body *jen.Group) {
+		value := jen.Id(jsonValueVarName)
+		body.If(jen.Add(value).Dot("Type").Op("==").Add(snip.GJSONNull())).Block(jen.Return(jen.True()))
+		// the fields of the deserializer struct decoded by safejson.Unmarshal
+		fields := []jsonUnmarshalField{{name: "type", target: jen.Id(unionReceiverName).Dot("typ"), typ: types.String{}}}
+		var required []*types.Field
+		for _, fieldDef := range unionDef.Fields {
+			fields = append(fields, jsonUnmarshalField{
+				name:   fieldDef.Name,
+				target: jen.Id(unionReceiverName).Dot(transforms.PrivateFieldName(fieldDef.Name)),
+				typ:    &types.Optional{Item: fieldDef.Type},
+			})
+			if !fieldDef.Type.IsOptional() {
+				required = append(required, fieldDef)
+			}
+		}
+		u.fields(body, value, fields)
+		if len(required) > 0 {
+			body.Switch(jen.Id(unionReceiverName).Dot("typ")).BlockFunc(func(cases *jen.Group) {
+				for _, fieldDef := range required {
+					cases.Case(jen.Lit(fieldDef.Name)).Block(
+						jen.If(jen.Id(unionReceiverName).Dot(transforms.PrivateFieldName(fieldDef.Name)).Op("==").Nil()).Block(
+							jen.Return(jen.False()),
+						),
+					)
+				}
+			})
+		}
+		body.Return(jen.True())
+	})
+}
+
+// writeUnmarshalMethods writes the UnmarshalJSON method, which decodes its input using unmarshalJSONValue or the
+// statements written by writeFallback, and the unmarshalJSONValue method with the body written by writeBody. The
+// UnmarshalJSON method is omitted if writeFallback is nil.
+func (e *jsonWriter) writeUnmarshalMethods(file *jen.Group, receiverName, typeName string, writeFallback func(*jen.Group), writeBody func(*jsonUnmarshaler, *jen.Group)) {
+	if writeFallback != nil {
+		file.Add(snip.MethodUnmarshalJSON(receiverName, typeName).BlockFunc(func(methodBody *jen.Group) {
+			methodBody.If(snip.GJSONValidBytes().Call(jen.Id(dataVarName))).Block(
+				jen.Var().Id("v").Id(typeName),
+				jen.If(jen.Id("v").Dot(unmarshalJSONValueMethod).Call(snip.GJSONParseBytes().Call(jen.Id(dataVarName)))).Block(
+					jen.Op("*").Id(receiverName).Op("=").Id("v"),
+					jen.Return(jen.Nil()),
+				),
+			)
+			writeFallback(methodBody)
+		}))
+	}
+	file.Func().Params(jen.Id(receiverName).Op("*").Id(typeName)).
+		Id(unmarshalJSONValueMethod).Params(gjsonResultParam(jsonValueVarName)).Bool().
+		BlockFunc(func(body *jen.Group) {
+			writeBody(&jsonUnmarshaler{
+				jsonWriter: e,
+				fail:       func(g *jen.Group) { g.Return(jen.False()) },
+				vars:       map[string]int{},
+			}, body)
+		})
+}
+
+// jsonUnmarshaler writes the statements of an unmarshalJSONValue method. The statements assign decoded values to their
+// targets, which hold the zero values of their types.
+type jsonUnmarshaler struct {
+	*jsonWriter
+	// fail writes the statements that stop decoding and make the method return false.
+	fail func(g *jen.Group)
+	// vars counts the variables declared by the method by name.
+	vars map[string]int
+}
+
+type jsonUnmarshalField struct {
+	name   string
+	target *jen.Statement
+	typ    types.Type
+}
+
+// newVar returns a variable name that starts with name and is unique in the method.
+func (u *jsonUnmarshaler) newVar(name string) string {
+	n := u.vars[name]
+	u.vars[name]++
+	return loopVarName(name, n)
+}
+
+// forEach writes a call of value.ForEach with a callback that binds key and elem and contains the statements written
+// by writeBody. Failures in the callback stop the iteration and fail after it.
+func (u *jsonUnmarshaler) forEach(g *jen.Group, value, key, elem *jen.Statement, writeBody func(inner *jsonUnmarshaler, loop *jen.Group)) {
+	ok := u.newVar("ok")
+	inner := &jsonUnmarshaler{jsonWriter: u.jsonWriter, vars: u.vars, fail: func(g *jen.Group) {
+		g.Id(ok).Op("=").False()
+		g.Return(jen.False())
+	}}
+	g.Id(ok).Op(":=").True()
+	g.Add(value).Dot("ForEach").Call(jen.Func().Params(jen.List(key, elem).Add(snip.GJSONResult())).Bool().BlockFunc(func(loop *jen.Group) {
+		writeBody(inner, loop)
+		loop.Return(jen.True())
+	}))
+	g.If(jen.Op("!").Id(ok)).BlockFunc(u.fail)
+}
+
+// fields writes the statements that decode the JSON object selected by value into the provided fields like
+// encoding/json decodes it into a struct.
+func (u *jsonUnmarshaler) fields(g *jen.Group, value *jen.Statement, fields []jsonUnmarshalField) {
+	g.If(jen.Op("!").Add(value).Dot("IsObject").Call()).BlockFunc(u.fail)
+	seen := make([]jen.Code, len(fields))
+	names := make([]jen.Code, len(fields))
+	for i, field := range fields {
+		seen[i] = jen.Id(u.newVar("seen" + transforms.ExportedFieldName(field.name)))
+		names[i] = jen.Lit(field.name)
+	}
+	g.Var().List(seen...).Bool()
+	key, elem := jen.Id(u.newVar("key")), jen.Id(u.newVar("field"))
+	u.forEach(g, value, key, elem, func(inner *jsonUnmarshaler, loop *jen.Group) {
+		loop.Switch(jen.Add(key).Dot("Str")).BlockFunc(func(cases *jen.Group) {
+			for i, field := range fields {
+				i, field := i, field
+				cases.Case(jen.Lit(field.name)).BlockFunc(func(caseBody *jen.Group) {
+					// encoding/json decodes duplicate fields into the value decoded from the previous field
+					caseBody.If(seen[i]).BlockFunc(inner.fail)
+					caseBody.Add(seen[i]).Op("=").True()
+					inner.value(caseBody, field.target, elem, field.typ)
+				})
+			}
+			cases.Default().Block(
+				jen.If(jen.Id(u.helper(matchesJSONFieldFunc)).Call(append([]jen.Code{jen.Add(key).Dot("Str")}, names...)...)).BlockFunc(inner.fail),
+			)
+		})
+	})
+}
+
+// value writes the statements that decode the gjson.Result selected by value into target like encoding/json decodes it
+// into a value of the Go type of typ.
+func (u *jsonUnmarshaler) value(g *jen.Group, target, value *jen.Statement, typ types.Type) {
+	switch t := typ.(type) {
+	case types.String:
+		u.call(g, target, value, unmarshalJSONStringFunc)
+	case types.Integer:
+		u.call(g, target, value, unmarshalJSONIntFunc)
+	case types.Safelong:
+		u.call(g, target, value, unmarshalJSONSafeLongFunc)
+	case types.Double:
+		u.call(g, target, value, unmarshalJSONFloat64Func)
+	case types.Boolean:
+		u.call(g, target, value, unmarshalJSONBoolFunc)
+	case types.Binary:
+		u.call(g, target, value, unmarshalJSONBinaryFunc)
+	case types.Any:
+		u.call(g, target, value, unmarshalJSONAnyFunc)
+	case types.Bearertoken, types.UUID, types.RID, types.DateTime:
+		u.text(g, target, value)
+	case *types.Optional:
+		g.If(jen.Add(value).Dot("Type").Op("!=").Add(snip.GJSONNull())).BlockFunc(func(g *jen.Group) {
+			v := u.newVar("v")
+			g.Var().Id(v).Add(t.Item.Code())
+			u.value(g, jen.Id(v), value, t.Item)
+			g.Add(target).Op("=").Op("&").Id(v)
+		})
+	case *types.List:
+		u.listValue(g, target, value, t.Code(), t.Item)
+	case *types.Set:
+		if t.Name != "" {
+			u.namedValue(g, target, value, t, true)
+			return
+		}
+		u.listValue(g, target, value, t.Code(), t.Item)
+	case *types.Map:
+		u.mapValue(g, target, value, t)
+	case *types.AliasType:
+		u.aliasValue(g, target, value, t)
+	case *types.EnumType:
+		if !u.decodes(t) {
+			u.unmarshalValue(g, target, value)
+			return
+		}
+		u.text(g, target, value)
+	case *types.ObjectType:
+		u.namedValue(g, target, value, t, objectHasJSONMethods(t))
+	case *types.UnionType:
+		u.namedValue(g, target, value, t, true)
+	default:
+		// external types
+		u.unmarshalValue(g, target, value)
+	}
+}
+
+// namedValue writes the statements that decode a value of an object, union or named set type, which has an
+// UnmarshalJSON method if hasUnmarshalJSON is true.
+func (u *jsonUnmarshaler) namedValue(g *jen.Group, target, value *jen.Statement, typ types.Type, hasUnmarshalJSON bool) {
+	switch {
+	case !u.decodes(typ):
+		u.unmarshalValue(g, target, value)
+	case u.isLocal(typ):
+		g.If(jen.Op("!").Add(target).Dot(unmarshalJSONValueMethod).Call(value)).BlockFunc(u.fail)
+	case hasUnmarshalJSON:
+		u.unmarshalJSON(g, target, value)
+	default:
+		// encoding/json decodes the fields of the object using reflection
+		u.unmarshalValue(g, target, value)
+	}
+}
+
+// call writes 'if !<helper>(value, &target) { <fail> }'.
+func (u *jsonUnmarshaler) call(g *jen.Group, target, value *jen.Statement, helper string) {
+	g.If(jen.Op("!").Id(u.helper(helper)).Call(value, jen.Op("&").Add(target))).BlockFunc(u.fail)
+}
+
+// text writes the statements that decode a value of a type that implements encoding.TextUnmarshaler, which
+// encoding/json calls with the contents of JSON strings.
+func (u *jsonUnmarshaler) text(g *jen.Group, target, value *jen.Statement) {
+	g.If(jen.Add(value).Dot("Type").Op("!=").Add(snip.GJSONNull())).BlockFunc(func(g *jen.Group) {
+		u.textKey(g, target, value)
+	})
+}
+
+// textKey writes the statements that decode a JSON string or object key into target using UnmarshalText.
+func (u *jsonUnmarshaler) textKey(g *jen.Group, target, value *jen.Statement) {
+	s := u.newVar("s")
+	g.Var().Id(s).String()
+	g.If(
+		jen.Op("!").Id(u.helper(unmarshalJSONStringFunc)).Call(value, jen.Op("&").Id(s)).Op("||").
+			Add(target).Dot("UnmarshalText").Call(jen.Index().Byte().Call(jen.Id(s))).Op("!=").Nil(),
+	).BlockFunc(u.fail)
+}
+
+// unmarshalJSON writes the statements that decode value using the UnmarshalJSON method of target.
+func (u *jsonUnmarshaler) unmarshalJSON(g *jen.Group, target, value *jen.Statement) {
+	g.If(jen.Add(target).Dot("UnmarshalJSON").Call(jen.Index().Byte().Call(jen.Add(value).Dot("Raw"))).Op("!=").Nil()).BlockFunc(u.fail)
+}
+
+// unmarshalValue writes the statements that decode value using safejson.Unmarshal.
+func (u *jsonUnmarshaler) unmarshalValue(g *jen.Group, target, value *jen.Statement) {
+	g.If(snip.SafeJSONUnmarshal().Call(jen.Index().Byte().Call(jen.Add(value).Dot("Raw")), jen.Op("&").Add(target)).Op("!=").Nil()).BlockFunc(u.fail)
+}
+
+func (u *jsonUnmarshaler) aliasValue(g *jen.Group, target, value *jen.Statement, aliasDef *types.AliasType) {
+	if !u.decodes(aliasDef) {
+		u.unmarshalValue(g, target, value)
+		return
+	}
+	switch aliasUnmarshalMethod(aliasDef) {
+	case "UnmarshalText":
+		u.text(g, target, value)
+		return
+	case "UnmarshalJSON":
+		if !u.isLocal(aliasDef) {
+			u.unmarshalJSON(g, target, value)
+			return
+		}
+		if opt, ok := aliasDef.Item.(*types.Optional); ok {
+			// UnmarshalJSON decodes into a new value even if the JSON value is null
+			v := u.newVar("v")
+			g.Var().Id(v).Add(opt.Item.Code())
+			u.value(g, jen.Id(v), value, opt.Item)
+			g.Add(target).Dot(aliasValueFieldName).Op("=").Op("&").Id(v)
+			return
+		}
+	default:
+		if _, ok := aliasDef.Item.(*types.External); ok {
+			// the alias does not have the methods of the external type
+			u.unmarshalValue(g, target, value)
+			return
+		}
+	}
+	v := u.newVar("v")
+	g.Var().Id(v).Add(aliasDef.Item.Code())
+	u.value(g, jen.Id(v), value, aliasDef.Item)
+	g.Add(target).Op("=").Add(aliasDef.Code()).Call(jen.Id(v))
+}
+
+func (u *jsonUnmarshaler) listValue(g *jen.Group, target, value, code *jen.Statement, item types.Type) {
+	g.If(jen.Add(value).Dot("Type").Op("!=").Add(snip.GJSONNull())).BlockFunc(func(g *jen.Group) {
+		g.If(jen.Op("!").Add(value).Dot("IsArray").Call()).BlockFunc(u.fail)
+		g.Add(target).Op("=").Make(code, jen.Lit(0))
+		elem := u.newVar("elem")
+		u.forEach(g, value, jen.Id("_"), jen.Id(elem), func(inner *jsonUnmarshaler, loop *jen.Group) {
+			v := u.newVar("v")
+			loop.Var().Id(v).Add(item.Code())
+			inner.value(loop, jen.Id(v), jen.Id(elem), item)
+			loop.Add(target).Op("=").Append(jen.Add(target), jen.Id(v))
+		})
+	})
+}
+
+func (u *jsonUnmarshaler) mapValue(g *jen.Group, target, value *jen.Statement, mapType *types.Map) {
+	g.If(jen.Add(value).Dot("Type").Op("!=").Add(snip.GJSONNull())).BlockFunc(func(g *jen.Group) {
+		if !u.decodesKey(mapType.Key) {
+			// encoding/json returns an error for maps with keys of this type
+			u.fail(g)
+			return
+		}
+		g.If(jen.Op("!").Add(value).Dot("IsObject").Call()).BlockFunc(u.fail)
+		g.Add(target).Op("=").Make(mapType.Code())
+		key, elem := u.newVar("key"), u.newVar("elem")
+		u.forEach(g, value, jen.Id(key), jen.Id(elem), func(inner *jsonUnmarshaler, loop *jen.Group) {
+			k, v := u.newVar("k"), u.newVar("v")
+			loop.Var().Id(k).Add(jsonMapKeyCode(mapType.Key))
+			inner.keyValue(loop, jen.Id(k), jen.Id(key), mapType.Key)
+			loop.Var().Id(v).Add(mapType.Val.Code())
+			inner.value(loop, jen.Id(v), jen.Id(elem), mapType.Val)
+			loop.Add(target).Index(jen.Id(k)).Op("=").Id(v)
+		})
+	})
+}
+
+// decodesKey returns true if keyValue can decode map keys of type keyType.
+func (u *jsonUnmarshaler) decodesKey(keyType types.Type) bool {
+	if keyType.IsBinary() || keyType.IsBoolean() {
+		return true
+	}
+	switch t := keyType.(type) {
+	case types.String, types.Integer, types.Safelong, types.UUID, types.RID, types.DateTime, types.Bearertoken:
+		return true
+	case *types.EnumType:
+		return u.decodes(t)
+	case *types.AliasType:
+		if !u.decodes(t) || t.IsOptional() {
+			return false
+		}
+		return aliasUnmarshalMethod(t) == "UnmarshalText" || u.decodesKey(t.Item)
+	default:
+		return false
+	}
+}
+
+// keyValue writes the statements that decode the JSON object key selected by key into target, which is a variable of the
+// type returned by jsonMapKeyCode. encoding/json decodes keys using UnmarshalText if the key type implements
+// encoding.TextUnmarshaler and according to the kind of the key type otherwise.
+func (u *jsonUnmarshaler) keyValue(g *jen.Group, target, key *jen.Statement, keyType types.Type) {
+	if keyType.IsBinary() || keyType.IsBoolean() {
+		u.textKey(g, target, key)
+		return
+	}
+	switch t := keyType.(type) {
+	case types.String:
+		u.call(g, target, key, unmarshalJSONStringFunc)
+	case types.Integer:
+		u.call(g, target, key, unmarshalJSONIntKeyFunc)
+	case types.Safelong:
+		u.call(g, target, key, unmarshalJSONSafeLongKeyFunc)
+	case *types.AliasType:
+		if aliasUnmarshalMethod(t) == "UnmarshalText" {
+			u.textKey(g, target, key)
+			return
+		}
+		k := u.newVar("k")
+		g.Var().Id(k).Add(jsonMapKeyCode(t.Item))
+		u.keyValue(g, jen.Id(k), key, t.Item)
+		g.Add(target).Op("=").Add(t.Code()).Call(jen.Id(k))
+	default:
+		u.textKey(g, target, key)
+	}
+}
+
+// astForUnmarshalJSONFunc returns a helper function that decodes value into v using the statements of body.
+func astForUnmarshalJSONFunc(name, doc string, goType *jen.Statement, body ...jen.Code) *jen.Statement {
+	return jen.Comment(name+" "+doc).Line().
+		Func().Id(name).Params(gjsonResultParam(jsonValueVarName), jen.Id("v").Op("*").Add(goType)).Bool().Block(body...)
+}
+
+func astForUnmarshalJSONString() *jen.Statement {
+	value := jen.Id(jsonValueVarName)
+	return jen.Commentf("%s decodes a JSON string or null into v like encoding/json. It returns false for other values", unmarshalJSONStringFunc).Line().
+		Comment("and for strings that gjson may unescape differently, which are strings that are not valid UTF-8 or that").Line().
+		Comment("contain escaped surrogates.").Line().
+		Func().Id(unmarshalJSONStringFunc).Params(gjsonResultParam(jsonValueVarName), jen.Id("v").Op("*").String()).Bool().Block(
+		jen.Switch(value.Clone().Dot("Type")).Block(
+			jen.Case(snip.GJSONNull()).Block(jen.Return(jen.True())),
+			jen.Case(snip.GJSONString()).Block(
+				jen.If(
+					jen.Op("!").Add(snip.UTF8ValidString()).Call(value.Clone().Dot("Raw")).Op("||").
+						Add(snip.StringsContains()).Call(value.Clone().Dot("Raw"), jen.Lit(`\ud`)).Op("||").
+						Add(snip.StringsContains()).Call(value.Clone().Dot("Raw"), jen.Lit(`\uD`)),
+				).Block(jen.Return(jen.False())),
+				jen.Op("*").Id("v").Op("=").Add(value.Clone()).Dot("Str"),
+				jen.Return(jen.True()),
+			),
+		),
+		jen.Return(jen.False()),
+	)
+}
+
+// astForUnmarshalJSONNumberFunc returns a helper function that decodes a JSON number or null into v using the statements
+// of parse, which decode the string n.
+func astForUnmarshalJSONNumberFunc(name string, goType *jen.Statement, parse ...jen.Code) *jen.Statement {
+	value := jen.Id(jsonValueVarName)
+	return astForUnmarshalJSONFunc(name, "decodes a JSON number or null into v like encoding/json.", goType,
+		jen.Switch(value.Clone().Dot("Type")).Block(
+			jen.Case(snip.GJSONNull()).Block(jen.Return(jen.True())),
+			jen.Case(snip.GJSONNumber()).Block(
+				append([]jen.Code{jen.Id("n").Op(":=").Add(value.Clone()).Dot("Raw")}, parse...)...,
+			),
+		),
+		jen.Return(jen.False()),
+	)
+}
+
+// astForParseJSONNumber returns the statements that parse n using parse, return false on errors and otherwise store
+// the result converted by convert in v.
+func astForParseJSONNumber(parse *jen.Statement, convert func(*jen.Statement) *jen.Statement) []jen.Code {
+	return []jen.Code{
+		jen.List(jen.Id("parsed"), jen.Err()).Op(":=").Add(parse),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.False())),
+		jen.Op("*").Id("v").Op("=").Add(convert(jen.Id("parsed"))),
+		jen.Return(jen.True()),
+	}
+}
+
+func astForUnmarshalJSONInt() *jen.Statement {
+	return astForUnmarshalJSONNumberFunc(unmarshalJSONIntFunc, jen.Int(),
+		astForParseJSONNumber(snip.StrconvAtoi().Call(jen.Id("n")), func(s *jen.Statement) *jen.Statement { return s })...)
+}
+
+func astForUnmarshalJSONFloat64() *jen.Statement {
+	return astForUnmarshalJSONNumberFunc(unmarshalJSONFloat64Func, jen.Float64(),
+		astForParseJSONNumber(snip.StrconvParseFloat().Call(jen.Id("n"), jen.Lit(64)), func(s *jen.Statement) *jen.Statement { return s })...)
+}
+
+func astForUnmarshalJSONSafeLong() *jen.Statement {
+	return astForUnmarshalJSONNumberFunc(unmarshalJSONSafeLongFunc, snip.SafeLongSafeLong(),
+		jen.List(jen.Id("parsed"), jen.Err()).Op(":=").Add(snip.StrconvParseInt()).Call(jen.Id("n"), jen.Lit(10), jen.Lit(64)),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.False())),
+		jen.List(jen.Id("s"), jen.Err()).Op(":=").Add(snip.SafeLongNewSafeLong()).Call(jen.Id("parsed")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.False())),
+		jen.Op("*").Id("v").Op("=").Id("s"),
+		jen.Return(jen.True()),
+	)
+}
+
+func astForUnmarshalJSONBool() *jen.Statement {
+	value := jen.Id(jsonValueVarName)
+	return astForUnmarshalJSONFunc(unmarshalJSONBoolFunc, "decodes a JSON boolean or null into v like encoding/json.", jen.Bool(),
+		jen.Switch(value.Clone().Dot("Type")).Block(
+			jen.Case(snip.GJSONNull()).Block(jen.Return(jen.True())),
+			jen.Case(snip.GJSONTrue()).Block(jen.Op("*").Id("v").Op("=").True(), jen.Return(jen.True())),
+			jen.Case(snip.GJSONFalse()).Block(jen.Op("*").Id("v").Op("=").False(), jen.Return(jen.True())),
+		),
+		jen.Return(jen.False()),
+	)
+}
+
+func astForUnmarshalJSONBinary() *jen.Statement {
+	value := jen.Id(jsonValueVarName)
+	return astForUnmarshalJSONFunc(unmarshalJSONBinaryFunc, "decodes a JSON string that is base64 encoded binary data or null into v like encoding/json.", jen.Index().Byte(),
+		jen.If(value.Clone().Dot("Type").Op("==").Add(snip.GJSONNull())).Block(jen.Return(jen.True())),
+		jen.Var().Id("s").String(),
+		jen.If(jen.Op("!").Id(unmarshalJSONStringFunc).Call(value.Clone(), jen.Op("&").Id("s"))).Block(jen.Return(jen.False())),
+		jen.List(jen.Id("b"), jen.Err()).Op(":=").Add(snip.Base64StdEncoding()).Dot("DecodeString").Call(jen.Id("s")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.False())),
+		jen.Op("*").Id("v").Op("=").Id("b"),
+		jen.Return(jen.True()),
+	)
+}
+
+func astForUnmarshalJSONAny() *jen.Statement {
+	value := jen.Id(jsonValueVarName)
+	return jen.Commentf("%s decodes a JSON value into v like safejson, which decodes JSON numbers as json.Number.", unmarshalJSONAnyFunc).Line().
+		Func().Id(unmarshalJSONAnyFunc).Params(gjsonResultParam(jsonValueVarName), jen.Id("v").Op("*").Interface()).Bool().Block(
+		jen.Switch(value.Clone().Dot("Type")).Block(
+			jen.Case(snip.GJSONNull()).Block(jen.Op("*").Id("v").Op("=").Nil()),
+			jen.Case(snip.GJSONFalse()).Block(jen.Op("*").Id("v").Op("=").False()),
+			jen.Case(snip.GJSONTrue()).Block(jen.Op("*").Id("v").Op("=").True()),
+			jen.Case(snip.GJSONNumber()).Block(jen.Op("*").Id("v").Op("=").Add(snip.JSONNumber()).Call(value.Clone().Dot("Raw"))),
+			jen.Case(snip.GJSONString()).Block(
+				jen.Var().Id("s").String(),
+				jen.If(jen.Op("!").Id(unmarshalJSONStringFunc).Call(value.Clone(), jen.Op("&").Id("s"))).Block(jen.Return(jen.False())),
+				jen.Op("*").Id("v").Op("=").Id("s"),
+			),
+			jen.Default().Block(
+				jen.Id("ok").Op(":=").True(),
+				jen.If(value.Clone().Dot("IsArray").Call()).Block(
+					jen.Id("a").Op(":=").Make(jen.Index().Interface(), jen.Lit(0)),
+					// elements are decoded in place so that they are not allocated separately
+					value.Clone().Dot("ForEach").Call(jen.Func().Params(jen.Id("_"), jen.Id("elem").Add(snip.GJSONResult())).Bool().Block(
+						jen.Id("a").Op("=").Append(jen.Id("a"), jen.Nil()),
+						jen.Id("ok").Op("=").Id(unmarshalJSONAnyFunc).Call(jen.Id("elem"), jen.Op("&").Id("a").Index(jen.Len(jen.Id("a")).Op("-").Lit(1))),
+						jen.Return(jen.Id("ok")),
+					)),
+					jen.Op("*").Id("v").Op("=").Id("a"),
+				).Else().Block(
+					jen.Id("m").Op(":=").Make(jen.Map(jen.String()).Interface()),
+					// e is shared by all entries so that it is allocated once per object rather than once per entry
+					jen.Var().Id("e").Interface(),
+					value.Clone().Dot("ForEach").Call(jen.Func().Params(jen.Id("key"), jen.Id("elem").Add(snip.GJSONResult())).Bool().Block(
+						jen.Var().Id("k").String(),
+						jen.Id("e").Op("=").Nil(),
+						jen.Id("ok").Op("=").Id(unmarshalJSONStringFunc).Call(jen.Id("key"), jen.Op("&").Id("k")).Op("&&").
+							Id(unmarshalJSONAnyFunc).Call(jen.Id("elem"), jen.Op("&").Id("e")),
+						jen.Id("m").Index(jen.Id("k")).Op("=").Id("e"),
+						jen.Return(jen.Id("ok")),
+					)),
+					jen.Op("*").Id("v").Op("=").Id("m"),
+				),
+				jen.Return(jen.Id("ok")),
+			),
+		),
+		jen.Return(jen.True()),
+	)
+}
+
+// astForUnmarshalJSONKeyFunc returns a helper function that decodes a JSON object key into v using the statements of
+// parse, which decode the string n.
+func astForUnmarshalJSONKeyFunc(name, doc string, goType *jen.Statement, parse ...jen.Code) *jen.Statement {
+	return jen.Comment(name+" "+doc).Line().
+		Func().Id(name).Params(jen.Id("key").Add(snip.GJSONResult()), jen.Id("v").Op("*").Add(goType)).Bool().Block(
+		append([]jen.Code{
+			jen.Var().Id("n").String(),
+			jen.If(jen.Op("!").Id(unmarshalJSONStringFunc).Call(jen.Id("key"), jen.Op("&").Id("n"))).Block(jen.Return(jen.False())),
+		}, parse...)...,
+	)
+}
+
+func astForUnmarshalJSONIntKey() *jen.Statement {
+	return astForUnmarshalJSONKeyFunc(unmarshalJSONIntKeyFunc, "decodes a JSON object key into v like encoding/json.", jen.Int(),
+		astForParseJSONNumber(snip.StrconvAtoi().Call(jen.Id("n")), func(s *jen.Statement) *jen.Statement { return s })...)
+}
+
+func astForUnmarshalJSONSafeLongKey() *jen.Statement {
+	return astForUnmarshalJSONKeyFunc(unmarshalJSONSafeLongKeyFunc, "decodes a JSON object key into v like encoding/json, which does not check its range.", snip.SafeLongSafeLong(),
+		astForParseJSONNumber(snip.StrconvParseInt().Call(jen.Id("n"), jen.Lit(10), jen.Lit(64)), func(s *jen.Statement) *jen.Statement {
+			return snip.SafeLongSafeLong().Call(s)
+		})...)
+}
+
+func astForMatchesJSONField() *jen.Statement {
+	return jen.Commentf("%s returns true if encoding/json decodes the object key into one of the fields with the", matchesJSONFieldFunc).Line().
+		Comment("provided names, which it matches case-insensitively.").Line().
+		Func().Id(matchesJSONFieldFunc).Params(jen.Id("key").String(), jen.Id("names").Op("...").String()).Bool().Block(
+		jen.For(jen.List(jen.Id("_"), jen.Id("name")).Op(":=").Range().Id("names")).Block(
+			jen.If(snip.StringsEqualFold().Call(jen.Id("key"), jen.Id("name"))).Block(jen.Return(jen.True())),
+		),
+		jen.Return(jen.False()),
+	)
+}
@@ -0,0 +1,1122 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/palantir/conjure-go/v6/conjure/snip"
+	"github.com/palantir/conjure-go/v6/conjure/transforms"
+	"github.com/palantir/conjure-go/v6/conjure/types"
+)
+
+const (
+	jsonOutVarName  = "out"
+	jsonSizeVarName = "size"
+	jsonLenVarName  = "n"
+
+	appendJSONStringFunc   = "appendJSONString"
+	jsonStringSizeFunc     = "jsonStringSize"
+	jsonIntSizeFunc        = "jsonIntSize"
+	appendJSONFloat64Func  = "appendJSONFloat64"
+	jsonFloat64SizeFunc    = "jsonFloat64Size"
+	jsonFloat64KeyFunc     = "jsonFloat64Key"
+	appendJSONBinaryFunc   = "appendJSONBinary"
+	appendJSONUUIDFunc     = "appendJSONUUID"
+	appendJSONDateTimeFunc = "appendJSONDateTime"
+	jsonDateTimeSizeFunc   = "jsonDateTimeSize"
+	appendJSONTextFunc     = "appendJSONText"
+	jsonTextSizeFunc       = "jsonTextSize"
+	appendJSONMarshalFunc  = "appendJSONMarshal"
+	jsonMarshalSizeFunc    = "jsonMarshalSize"
+
+	// jsonUUIDSize is the length of a quoted UUID string.
+	jsonUUIDSize = 38
+)
+
+// jsonWriter writes the JSON methods of the types of a package: AppendJSON and JSONSize, which encode values exactly
+// like safejson.Marshal except that nil lists, sets and maps are always encoded as empty collections and that NaN and
+// infinite doubles are encoded as strings, and UnmarshalJSONStrict, which decodes values according to the Conjure wire
+// spec (see jsondecoder.go). The writer records the helper functions used by the methods so that writeHelpers only
+// declares the helpers that the package needs.
+type jsonWriter struct {
+	*jsonTypes
+	// local contains the named types of the package that is being written.
+	local   map[types.Type]struct{}
+	helpers map[string]struct{}
+}
+
+func newJSONWriter(jsonTypes *jsonTypes, pkg types.ConjurePackage) *jsonWriter {
+	local := map[types.Type]struct{}{}
+	for _, alias := range pkg.Aliases {
+		local[alias] = struct{}{}
+	}
+	for _, enum := range pkg.Enums {
+		local[enum] = struct{}{}
+	}
+	for _, object := range pkg.Objects {
+		local[object] = struct{}{}
+	}
+	for _, union := range pkg.Unions {
+		local[union] = struct{}{}
+	}
+	return &jsonWriter{
+		jsonTypes: jsonTypes,
+		local:     local,
+		helpers:   map[string]struct{}{},
+	}
+}
+
+// jsonTypes records the named types of a Conjure definition that are generated with JSON methods. Values of other named
+// types, such as the types of external packages, are encoded using safejson.Marshal and decoded using safejson.Unmarshal.
+type jsonTypes struct {
+	// encoded contains the named types that are generated with AppendJSON and JSONSize methods.
+	encoded map[types.Type]struct{}
+	// decoded contains the named types that are generated with UnmarshalJSONStrict methods.
+	decoded map[types.Type]struct{}
+}
+
+func newJSONTypes(def *types.ConjureDefinition) *jsonTypes {
+	t := &jsonTypes{
+		encoded: map[types.Type]struct{}{},
+		decoded: map[types.Type]struct{}{},
+	}
+	for _, pkg := range def.Packages {
+		if pkg.External {
+			continue
+		}
+		for _, alias := range pkg.Aliases {
+			if aliasHasJSONMethods(alias) {
+				t.encoded[alias] = struct{}{}
+			}
+			if !isInterfaceAliasType(alias) {
+				t.decoded[alias] = struct{}{}
+			}
+		}
+		for _, enum := range pkg.Enums {
+			t.encoded[enum] = struct{}{}
+			t.decoded[enum] = struct{}{}
+		}
+		for _, object := range pkg.Objects {
+			t.encoded[object] = struct{}{}
+			t.decoded[object] = struct{}{}
+		}
+		for _, union := range pkg.Unions {
+			t.encoded[union] = struct{}{}
+			t.decoded[union] = struct{}{}
+		}
+	}
+	return t
+}
+
+// aliasHasJSONMethods returns false for aliases of builtin types, which are encoded by converting them to the aliased
+// type instead.
+func aliasHasJSONMethods(aliasDef *types.AliasType) bool {
+	return aliasDef.IsOptional() || !isSimpleAliasType(aliasDef.Item)
+}
+
+// isInterfaceAliasType returns true if the Go type of aliasDef is interface{}, which cannot have methods.
+func isInterfaceAliasType(aliasDef *types.AliasType) bool {
+	if aliasDef.IsOptional() {
+		return false
+	}
+	item := aliasDef.Item
+	for {
+		switch t := item.(type) {
+		case types.Any:
+			return true
+		case *types.AliasType:
+			return isInterfaceAliasType(t)
+		case *types.External:
+			if t.ExternalHasGoType() {
+				return false
+			}
+			item = t.Fallback
+		default:
+			return false
+		}
+	}
+}
+
+// writeMarshalJSON writes a MarshalJSON method that encodes the receiver using AppendJSON. It does not size the buffer
+// using JSONSize, which would encode values such as any and rid fields twice.
+func writeMarshalJSON(file *jen.Group, receiverName, typeName string) {
+	file.Add(astForMarshalJSON(receiverName, typeName))
+}
+
+func astForMarshalJSON(receiverName, typeName string) *jen.Statement {
+	return snip.MethodMarshalJSON(receiverName, typeName).Block(
+		jen.Return(jen.Id(receiverName).Dot("AppendJSON").Call(jen.Nil())),
+	)
+}
+
+func (e *jsonWriter) writeObjectMethods(file *jen.Group, objectDef *types.ObjectType) {
+	fieldKeys := make([]string, len(objectDef.Fields))
+	for i, fieldDef := range objectDef.Fields {
+		fieldKeys[i] = jsonQuote(fieldDef.Name) + ":"
+	}
+	e.writeMethod(file, objReceiverName, objectDef.Name, false, func(m *jsonMethod, body *jen.Group) {
+		if len(objectDef.Fields) == 0 {
+			m.appendLiteral(body, "{}")
+			return
+		}
+		for i, fieldDef := range objectDef.Fields {
+			prefix := ","
+			if i == 0 {
+				prefix = "{"
+			}
+			m.appendLiteral(body, prefix+fieldKeys[i])
+			m.value(body, jen.Id(objReceiverName).Dot(transforms.ExportedFieldName(fieldDef.Name)), fieldDef.Type, 0)
+		}
+		m.appendLiteral(body, "}")
+	})
+	e.writeMethod(file, objReceiverName, objectDef.Name, true, func(m *jsonMethod, body *jen.Group) {
+		size := 2
+		for _, fieldKey := range fieldKeys {
+			size += len(fieldKey)
+		}
+		if len(fieldKeys) > 1 {
+			size += len(fieldKeys) - 1
+		}
+		body.Id(jsonSizeVarName).Op(":=").Lit(size)
+		for _, fieldDef := range objectDef.Fields {
+			m.value(body, jen.Id(objReceiverName).Dot(transforms.ExportedFieldName(fieldDef.Name)), fieldDef.Type, 0)
+		}
+	})
+	if objectHasJSONMethods(objectDef) {
+		writeMarshalJSON(file, objReceiverName, objectDef.Name)
+	}
+}
+
+func (e *jsonWriter) writeUnionMethods(file *jen.Group, unionDef *types.UnionType) {
+	unknownType := func(zero *jen.Statement) *jen.Statement {
+		return jen.Return(zero, snip.FmtErrorf().Call(jen.Lit("unknown type %q"), jen.Id(unionReceiverName).Dot("typ")))
+	}
+	if len(unionDef.Fields) == 0 {
+		file.Add(snip.MethodAppendJSON(unionReceiverName, unionDef.Name).Block(unknownType(jen.Nil())))
+		file.Add(snip.MethodJSONSize(unionReceiverName, unionDef.Name).Block(unknownType(jen.Lit(0))))
+		writeMarshalJSON(file, unionReceiverName, unionDef.Name)
+		return
+	}
+	for _, size := range []bool{false, true} {
+		size := size
+		e.writeMethod(file, unionReceiverName, unionDef.Name, size, func(m *jsonMethod, body *jen.Group) {
+			if size {
+				body.Var().Id(jsonSizeVarName).Int()
+			}
+			body.Switch(jen.Id(unionReceiverName).Dot("typ")).BlockFunc(func(cases *jen.Group) {
+				cases.Default().Block(unknownType(m.zero()))
+				for _, fieldDef := range unionDef.Fields {
+					fieldDef := fieldDef
+					prefix := `{"type":` + jsonQuote(fieldDef.Name) + "," + jsonQuote(fieldDef.Name) + ":"
+					cases.Case(jen.Lit(fieldDef.Name)).BlockFunc(func(caseBody *jen.Group) {
+						privateName := transforms.PrivateFieldName(fieldDef.Name)
+						selector := jen.Id(unionReceiverName).Dot(privateName)
+						if fieldDef.Type.IsOptional() {
+							// the variant holds a pointer to an optional; nil is encoded as null
+							caseBody.Var().Id(privateName).Add(fieldDef.Type.Code())
+							caseBody.If(selector.Clone().Op("!=").Nil()).Block(
+								jen.Id(privateName).Op("=").Op("*").Add(selector.Clone()),
+							)
+							selector = jen.Id(privateName)
+						} else {
+							caseBody.If(selector.Clone().Op("==").Nil()).Block(
+								jen.Return(m.zero(), snip.FmtErrorf().Call(jen.Lit(fmt.Sprintf("field %q is required", fieldDef.Name)))),
+							)
+							selector = m.deref(selector, fieldDef.Type)
+						}
+						if size {
+							caseBody.Id(jsonSizeVarName).Op("=").Lit(len(prefix) + 1)
+						} else {
+							m.appendLiteral(caseBody, prefix)
+						}
+						m.value(caseBody, selector, fieldDef.Type, 0)
+					})
+				}
+			})
+			if !size {
+				m.appendLiteral(body, "}")
+			}
+		})
+	}
+	writeMarshalJSON(file, unionReceiverName, unionDef.Name)
+}
+
+func (e *jsonWriter) writeEnumMethods(file *jen.Group, enumDef *types.EnumType) {
+	value := jen.String().Call(jen.Id(enumReceiverName).Dot(enumStructFieldName))
+	file.Add(snip.MethodAppendJSON(enumReceiverName, enumDef.Name).Block(
+		jen.Return(jen.Id(e.helper(appendJSONStringFunc)).Call(jen.Id(jsonOutVarName), value.Clone()), jen.Nil()),
+	))
+	file.Add(snip.MethodJSONSize(enumReceiverName, enumDef.Name).Block(
+		jen.Return(jen.Id(e.helper(jsonStringSizeFunc)).Call(value.Clone()), jen.Nil()),
+	))
+	// enums are encoded by encoding/json using their MarshalText method
+}
+
+func (e *jsonWriter) writeAliasMethods(file *jen.Group, aliasDef *types.AliasType) {
+	if _, isBinary := aliasDef.Item.(types.Binary); isBinary {
+		// Binary aliases are encoded using their MarshalText method, which encodes nil as an empty string.
+		file.Add(snip.MethodAppendJSON(aliasReceiverName, aliasDef.Name).Block(
+			jen.Return(jen.Id(e.helper(appendJSONBinaryFunc)).Call(jen.Id(jsonOutVarName), jen.Id(aliasReceiverName)), jen.Nil()),
+		))
+		file.Add(snip.MethodJSONSize(aliasReceiverName, aliasDef.Name).Block(
+			jen.Return(snip.Base64StdEncoding().Dot("EncodedLen").Call(jen.Len(jen.Id(aliasReceiverName))).Op("+").Lit(2), jen.Nil()),
+		))
+		writeMarshalJSON(file, aliasReceiverName, aliasDef.Name)
+		return
+	}
+	selector := func() *jen.Statement {
+		if aliasDef.IsOptional() {
+			return aliasDotValue()
+		}
+		return aliasDef.Item.Code().Call(jen.Id(aliasReceiverName))
+	}
+	e.writeMethod(file, aliasReceiverName, aliasDef.Name, false, func(m *jsonMethod, body *jen.Group) {
+		m.value(body, selector(), aliasDef.Item, 0)
+	})
+	e.writeMethod(file, aliasReceiverName, aliasDef.Name, true, func(m *jsonMethod, body *jen.Group) {
+		body.Var().Id(jsonSizeVarName).Int()
+		m.value(body, selector(), aliasDef.Item, 0)
+	})
+	writeMarshalJSON(file, aliasReceiverName, aliasDef.Name)
+}
+
+// writeMethod writes the AppendJSON method (or the JSONSize method if size is true) with the body written by writeBody.
+// JSONSize bodies declare the size variable; both methods end with a return of the encoded value or its size.
+func (e *jsonWriter) writeMethod(file *jen.Group, receiverName, typeName string, size bool, writeBody func(*jsonMethod, *jen.Group)) {
+	// Write the body once to find out which variables it uses.
+	m := &jsonMethod{jsonWriter: e, size: size}
+	jen.BlockFunc(func(body *jen.Group) { writeBody(m, body) })
+
+	signature := snip.MethodAppendJSON(receiverName, typeName)
+	if size {
+		signature = snip.MethodJSONSize(receiverName, typeName)
+	}
+	file.Add(signature.BlockFunc(func(body *jen.Group) {
+		if m.usesLen {
+			body.Var().Id(jsonLenVarName).Int()
+		}
+		if m.usesErr {
+			body.Var().Err().Error()
+		}
+		writeBody(&jsonMethod{jsonWriter: e, size: size}, body)
+		if size {
+			body.Return(jen.Id(jsonSizeVarName), jen.Nil())
+		} else {
+			body.Return(jen.Id(jsonOutVarName), jen.Nil())
+		}
+	}))
+}
+
+// jsonMethod writes the statements of an AppendJSON method, which append to the out parameter, or of a JSONSize
+// method, which add to the size variable.
+type jsonMethod struct {
+	*jsonWriter
+	size bool
+	// usesErr and usesLen record whether the written statements assign to the err and n variables.
+	usesErr bool
+	usesLen bool
+}
+
+// zero returns the value returned with an error.
+func (m *jsonMethod) zero() *jen.Statement {
+	if m.size {
+		return jen.Lit(0)
+	}
+	return jen.Nil()
+}
+
+// isLocal returns true if typ is a named type of the package of the writer. Named set types are declared in the
+// package of the definitions that contain them, so they are always local.
+func (e *jsonWriter) isLocal(typ types.Type) bool {
+	if isNamedSet(typ) {
+		return true
+	}
+	_, ok := e.local[typ]
+	return ok
+}
+
+// helper returns the identifier of the helper function with the provided name and records that it is used.
+func (e *jsonWriter) helper(name string) string {
+	e.helpers[name] = struct{}{}
+	return name
+}
+
+// returnErr returns 'if err != nil { return <zero>, err }'.
+func (m *jsonMethod) returnErr() *jen.Statement {
+	return jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(m.zero(), jen.Err()))
+}
+
+// appendLiteral appends the provided JSON literal to out. Does nothing for JSONSize methods.
+func (m *jsonMethod) appendLiteral(g *jen.Group, literal string) {
+	if m.size {
+		return
+	}
+	if len(literal) == 1 {
+		g.Id(jsonOutVarName).Op("=").Append(jen.Id(jsonOutVarName), jen.LitRune(rune(literal[0])))
+		return
+	}
+	g.Id(jsonOutVarName).Op("=").Append(jen.Id(jsonOutVarName), jen.Lit(literal).Op("..."))
+}
+
+// add writes the statement that appends the result of the provided function call to out, or adds its result to size.
+func (m *jsonMethod) add(g *jen.Group, call *jen.Statement) {
+	if m.size {
+		g.Id(jsonSizeVarName).Op("+=").Add(call)
+	} else {
+		g.Id(jsonOutVarName).Op("=").Add(call)
+	}
+}
+
+// addErr is like add for function calls that also return an error.
+func (m *jsonMethod) addErr(g *jen.Group, call *jen.Statement) {
+	m.usesErr = true
+	if m.size {
+		m.usesLen = true
+		g.List(jen.Id(jsonLenVarName), jen.Err()).Op("=").Add(call)
+		g.Add(m.returnErr())
+		g.Id(jsonSizeVarName).Op("+=").Id(jsonLenVarName)
+		return
+	}
+	g.List(jen.Id(jsonOutVarName), jen.Err()).Op("=").Add(call)
+	g.Add(m.returnErr())
+}
+
+// addNull writes 'if selector == nil { <null> } else { <value> }'.
+func (m *jsonMethod) addNull(g *jen.Group, selector *jen.Statement, writeValue func(*jen.Group)) {
+	var null *jen.Statement
+	if m.size {
+		null = jen.Id(jsonSizeVarName).Op("+=").Lit(len("null"))
+	} else {
+		null = jen.Id(jsonOutVarName).Op("=").Append(jen.Id(jsonOutVarName), jen.Lit("null").Op("..."))
+	}
+	g.If(selector.Clone().Op("==").Nil()).Block(null).Else().BlockFunc(writeValue)
+}
+
+// hasMethods returns true if typ is a named type that is generated with AppendJSON and JSONSize methods.
+func (m *jsonMethod) hasMethods(typ types.Type) bool {
+	if isNamedSet(typ) {
+		return true
+	}
+	_, ok := m.encoded[typ]
+	return ok
+}
+
+// deref returns the selector of the value that the pointer selector points to. Methods of named types are called
+// through the pointer.
+func (m *jsonMethod) deref(selector *jen.Statement, typ types.Type) *jen.Statement {
+	if m.hasMethods(typ) {
+		return selector
+	}
+	return jen.Op("*").Add(selector)
+}
+
+// value writes the statements that encode the value of type typ selected by selector. depth is the number of enclosing
+// collections and is used to name the variables of loops.
+func (m *jsonMethod) value(g *jen.Group, selector *jen.Statement, typ types.Type, depth int) {
+	switch t := typ.(type) {
+	case types.String:
+		m.stringValue(g, selector)
+	case types.Bearertoken:
+		m.stringValue(g, jen.String().Call(selector))
+	case types.Integer:
+		m.intValue(g, jen.Int64().Call(selector))
+	case types.Safelong:
+		// safelong.SafeLong.MarshalJSON rejects values that are not safely representable in Javascript
+		g.If(
+			jen.List(jen.Id("_"), jen.Err()).Op(":=").Add(snip.SafeLongNewSafeLong()).Call(jen.Int64().Call(selector.Clone())),
+			jen.Err().Op("!=").Nil(),
+		).Block(jen.Return(m.zero(), jen.Err()))
+		m.intValue(g, jen.Int64().Call(selector))
+	case types.Double:
+		if m.size {
+			m.add(g, jen.Id(m.helper(jsonFloat64SizeFunc)).Call(selector))
+		} else {
+			m.add(g, jen.Id(m.helper(appendJSONFloat64Func)).Call(jen.Id(jsonOutVarName), selector))
+		}
+	case types.Boolean:
+		if m.size {
+			g.If(selector).Block(
+				jen.Id(jsonSizeVarName).Op("+=").Lit(len("true")),
+			).Else().Block(
+				jen.Id(jsonSizeVarName).Op("+=").Lit(len("false")),
+			)
+		} else {
+			m.add(g, snip.StrconvAppendBool().Call(jen.Id(jsonOutVarName), selector))
+		}
+	case types.UUID:
+		if m.size {
+			m.add(g, jen.Lit(jsonUUIDSize))
+		} else {
+			m.add(g, jen.Id(m.helper(appendJSONUUIDFunc)).Call(jen.Id(jsonOutVarName), selector))
+		}
+	case types.DateTime:
+		if m.size {
+			m.add(g, jen.Id(m.helper(jsonDateTimeSizeFunc)).Call(selector))
+		} else {
+			m.add(g, jen.Id(m.helper(appendJSONDateTimeFunc)).Call(jen.Id(jsonOutVarName), selector))
+		}
+	case types.RID:
+		if m.size {
+			m.addErr(g, jen.Id(m.helper(jsonTextSizeFunc)).Call(selector))
+		} else {
+			m.addErr(g, jen.Id(m.helper(appendJSONTextFunc)).Call(jen.Id(jsonOutVarName), selector))
+		}
+	case types.Binary:
+		// encoding/json encodes nil byte slices as null
+		m.addNull(g, selector, func(g *jen.Group) {
+			if m.size {
+				m.add(g, snip.Base64StdEncoding().Dot("EncodedLen").Call(jen.Len(selector)).Op("+").Lit(2))
+			} else {
+				m.add(g, jen.Id(m.helper(appendJSONBinaryFunc)).Call(jen.Id(jsonOutVarName), selector))
+			}
+		})
+	case *types.Optional:
+		m.addNull(g, selector, func(g *jen.Group) {
+			m.value(g, m.deref(selector, t.Item), t.Item, depth)
+		})
+	case *types.List:
+		m.listValue(g, selector, t.Item, depth)
+	case *types.Set:
+		if t.Name != "" {
+			m.namedValue(g, selector)
+			return
+		}
+		m.listValue(g, selector, t.Item, depth)
+	case *types.Map:
+		m.mapValue(g, selector, t, depth)
+	case *types.AliasType:
+		switch {
+		case m.hasMethods(t):
+			m.namedValue(g, selector)
+		case !aliasHasJSONMethods(t):
+			m.value(g, t.Item.Code().Call(selector), t.Item, depth)
+		default:
+			m.marshalValue(g, selector)
+		}
+	case *types.EnumType, *types.ObjectType, *types.UnionType:
+		if m.hasMethods(t) {
+			m.namedValue(g, selector)
+		} else {
+			m.marshalValue(g, selector)
+		}
+	default:
+		// any and external types
+		m.marshalValue(g, selector)
+	}
+}
+
+func (m *jsonMethod) stringValue(g *jen.Group, selector *jen.Statement) {
+	if m.size {
+		m.add(g, jen.Id(m.helper(jsonStringSizeFunc)).Call(selector))
+	} else {
+		m.add(g, jen.Id(m.helper(appendJSONStringFunc)).Call(jen.Id(jsonOutVarName), selector))
+	}
+}
+
+func (m *jsonMethod) intValue(g *jen.Group, selector *jen.Statement) {
+	if m.size {
+		m.add(g, jen.Id(m.helper(jsonIntSizeFunc)).Call(selector))
+	} else {
+		m.add(g, snip.StrconvAppendInt().Call(jen.Id(jsonOutVarName), selector, jen.Lit(10)))
+	}
+}
+
+func (m *jsonMethod) namedValue(g *jen.Group, selector *jen.Statement) {
+	if m.size {
+		m.addErr(g, jen.Add(selector).Dot("JSONSize").Call())
+	} else {
+		m.addErr(g, jen.Add(selector).Dot("AppendJSON").Call(jen.Id(jsonOutVarName)))
+	}
+}
+
+func (m *jsonMethod) marshalValue(g *jen.Group, selector *jen.Statement) {
+	if m.size {
+		m.addErr(g, jen.Id(m.helper(jsonMarshalSizeFunc)).Call(selector))
+	} else {
+		m.addErr(g, jen.Id(m.helper(appendJSONMarshalFunc)).Call(jen.Id(jsonOutVarName), selector))
+	}
+}
+
+func (m *jsonMethod) listValue(g *jen.Group, selector *jen.Statement, item types.Type, depth int) {
+	idx, val := loopVarName("i", depth), loopVarName("v", depth)
+	if m.size {
+		g.Id(jsonSizeVarName).Op("+=").Lit(2)
+		if _, isUUID := item.(types.UUID); isUUID {
+			// all elements have the same size
+			g.If(jen.Len(selector.Clone()).Op(">").Lit(0)).Block(
+				jen.Id(jsonSizeVarName).Op("+=").Len(selector.Clone()).Op("*").Lit(jsonUUIDSize + 1).Op("-").Lit(1),
+			)
+			return
+		}
+		g.If(jen.Len(selector.Clone()).Op(">").Lit(1)).Block(
+			jen.Id(jsonSizeVarName).Op("+=").Len(selector.Clone()).Op("-").Lit(1),
+		)
+		g.For(jen.List(jen.Id("_"), jen.Id(val)).Op(":=").Range().Add(selector)).BlockFunc(func(loop *jen.Group) {
+			m.value(loop, jen.Id(val), item, depth+1)
+		})
+		return
+	}
+	m.appendLiteral(g, "[")
+	g.For(jen.List(jen.Id(idx), jen.Id(val)).Op(":=").Range().Add(selector)).BlockFunc(func(loop *jen.Group) {
+		loop.If(jen.Id(idx).Op(">").Lit(0)).BlockFunc(func(g *jen.Group) {
+			m.appendLiteral(g, ",")
+		})
+		m.value(loop, jen.Id(val), item, depth+1)
+	})
+	m.appendLiteral(g, "]")
+}
+
+// mapValue writes the statements that encode a map. Like encoding/json, entries are sorted by the string form of their
+// keys. Maps with keys that encoding/json does not support are encoded using safejson.Marshal.
+func (m *jsonMethod) mapValue(g *jen.Group, selector *jen.Statement, mapType *types.Map, depth int) {
+	if !isJSONMapKey(mapType.Key) {
+		m.marshalValue(g, selector)
+		return
+	}
+	key, val := loopVarName("k", depth), loopVarName("v", depth)
+	if m.size {
+		g.Id(jsonSizeVarName).Op("+=").Lit(2)
+		g.If(jen.Len(selector.Clone()).Op(">").Lit(1)).Block(
+			jen.Id(jsonSizeVarName).Op("+=").Len(selector.Clone()).Op("-").Lit(1),
+		)
+		_, isUUIDVal := mapType.Val.(types.UUID)
+		if isUUIDMapKey(mapType.Key) {
+			// the size of the keys only depends on the number of entries
+			g.Id(jsonSizeVarName).Op("+=").Len(selector.Clone()).Op("*").Lit(jsonUUIDSize + 1)
+			key = "_"
+		}
+		if isUUIDVal {
+			g.Id(jsonSizeVarName).Op("+=").Len(selector.Clone()).Op("*").Lit(jsonUUIDSize)
+			val = "_"
+		}
+		if key == "_" && val == "_" {
+			return
+		}
+		rangeVars := jen.List(jen.Id(key), jen.Id(val))
+		if val == "_" {
+			rangeVars = jen.Id(key)
+		}
+		g.For(rangeVars.Op(":=").Range().Add(selector)).BlockFunc(func(loop *jen.Group) {
+			if key != "_" {
+				keyString := m.mapKeyString(loop, jen.Id(key), mapType.Key, depth)
+				loop.Id(jsonSizeVarName).Op("+=").Id(m.helper(jsonStringSizeFunc)).Call(keyString).Op("+").Lit(1)
+			}
+			if val != "_" {
+				m.value(loop, jen.Id(val), mapType.Val, depth+1)
+			}
+		})
+		return
+	}
+	entryType, entries, entry := loopVarName("mapEntry", depth), loopVarName("entries", depth), loopVarName("entry", depth)
+	idx := loopVarName("i", depth)
+	m.appendLiteral(g, "{")
+	g.BlockFunc(func(block *jen.Group) {
+		block.Type().Id(entryType).Struct(
+			jen.Id("key").String(),
+			jen.Id("value").Add(mapType.Val.Code()),
+		)
+		block.Id(entries).Op(":=").Make(jen.Index().Id(entryType), jen.Lit(0), jen.Len(selector.Clone()))
+		block.For(jen.List(jen.Id(key), jen.Id(val)).Op(":=").Range().Add(selector)).BlockFunc(func(loop *jen.Group) {
+			keyString := m.mapKeyString(loop, jen.Id(key), mapType.Key, depth)
+			loop.Id(entries).Op("=").Append(jen.Id(entries), jen.Id(entryType).Values(
+				jen.Id("key").Op(":").Add(keyString),
+				jen.Id("value").Op(":").Id(val),
+			))
+		})
+		block.Add(snip.SortSlice()).Call(jen.Id(entries), jen.Func().Params(jen.List(jen.Id("i"), jen.Id("j")).Int()).Bool().Block(
+			jen.Return(jen.Id(entries).Index(jen.Id("i")).Dot("key").Op("<").Id(entries).Index(jen.Id("j")).Dot("key")),
+		))
+		block.For(jen.List(jen.Id(idx), jen.Id(entry)).Op(":=").Range().Id(entries)).BlockFunc(func(loop *jen.Group) {
+			loop.If(jen.Id(idx).Op(">").Lit(0)).BlockFunc(func(g *jen.Group) {
+				m.appendLiteral(g, ",")
+			})
+			m.stringValue(loop, jen.Id(entry).Dot("key"))
+			m.appendLiteral(loop, ":")
+			m.value(loop, jen.Id(entry).Dot("value"), mapType.Val, depth+1)
+		})
+	})
+	m.appendLiteral(g, "}")
+}
+
+// mapKeyString returns an expression for the string that encoding/json uses for the provided map key. It may write
+// statements that compute the string.
+func (m *jsonMethod) mapKeyString(g *jen.Group, selector *jen.Statement, keyType types.Type, depth int) *jen.Statement {
+	switch t := keyType.(type) {
+	case types.String:
+		return selector
+	case types.Bearertoken, types.Binary:
+		return jen.String().Call(selector)
+	case types.Boolean:
+		return snip.StrconvFormatBool().Call(jen.Bool().Call(selector))
+	case types.Integer:
+		return snip.StrconvItoa().Call(jen.Int().Call(selector))
+	case types.Safelong:
+		return snip.StrconvFormatInt().Call(jen.Int64().Call(selector), jen.Lit(10))
+	case types.Double:
+		return jen.Id(m.helper(jsonFloat64KeyFunc)).Call(jen.Float64().Call(selector))
+	case types.UUID, types.DateTime, *types.EnumType:
+		return jen.Add(selector).Dot("String").Call()
+	case types.RID:
+		keyText := loopVarName("keyText", depth)
+		g.List(jen.Id(keyText), jen.Err()).Op(":=").Add(selector).Dot("MarshalText").Call()
+		g.Add(m.returnErr())
+		return jen.String().Call(jen.Id(keyText))
+	case *types.AliasType:
+		return m.mapKeyString(g, t.Item.Code().Call(selector), t.Item, depth)
+	default:
+		panic("unsupported map key type " + keyType.String())
+	}
+}
+
+// isJSONMapKey returns true if the values of typ are encoded as JSON object keys by mapKeyString.
+func isJSONMapKey(typ types.Type) bool {
+	switch t := typ.(type) {
+	case types.String, types.Bearertoken, types.Binary, types.Boolean, types.Integer, types.Safelong, types.Double,
+		types.UUID, types.DateTime, types.RID, *types.EnumType:
+		return true
+	case *types.AliasType:
+		return !t.IsOptional() && isJSONMapKey(t.Item)
+	default:
+		return false
+	}
+}
+
+func isUUIDMapKey(typ types.Type) bool {
+	switch t := typ.(type) {
+	case types.UUID:
+		return true
+	case *types.AliasType:
+		return isUUIDMapKey(t.Item)
+	default:
+		return false
+	}
+}
+
+// loopVarName returns name for loops that are not nested in another collection, and name followed by the depth
+// otherwise.
+func loopVarName(name string, depth int) string {
+	if depth == 0 {
+		return name
+	}
+	return name + strconv.Itoa(depth)
+}
+
+// jsonQuote returns s encoded as a JSON string.
+func jsonQuote(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		panic(err)
+	}
+	return string(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+}
+
+// needsHelpers returns true if the methods written by the encoder use any helper functions.
+func (e *jsonWriter) needsHelpers() bool {
+	return len(e.helpers) > 0
+}
+
+// writeHelpers writes the helper functions used by the methods written by the encoder.
+func (e *jsonWriter) writeHelpers(file *jen.Group) {
+	// helpers that are implemented using other helpers
+	for helper, deps := range map[string][]string{
+		jsonFloat64SizeFunc:          {appendJSONFloat64Func},
+		jsonFloat64KeyFunc:           {appendJSONFloat64Func},
+		jsonDateTimeSizeFunc:         {appendJSONDateTimeFunc},
+		appendJSONTextFunc:           {appendJSONStringFunc},
+		jsonTextSizeFunc:             {jsonStringSizeFunc},
+		decodeJSONStringFunc:         {jsonTypeErrorFunc},
+		decodeJSONIntFunc:            {jsonTypeErrorFunc},
+		decodeJSONSafeLongFunc:       {jsonTypeErrorFunc},
+		decodeJSONFloat64Func:        {jsonTypeErrorFunc},
+		decodeJSONBoolFunc:           {jsonTypeErrorFunc},
+		decodeJSONUUIDFunc:           {jsonTypeErrorFunc},
+		decodeJSONRIDFunc:            {jsonTypeErrorFunc},
+		decodeJSONDateTimeFunc:       {jsonTypeErrorFunc},
+		decodeJSONBinaryFunc:         {jsonTypeErrorFunc},
+		decodeJSONBearerTokenFunc:    {jsonTypeErrorFunc},
+		decodeJSONAnyFunc:            {jsonTypeErrorFunc},
+		decodeJSONEnumFunc:           {jsonTypeErrorFunc},
+		unmarshalJSONBinaryFunc:      {unmarshalJSONStringFunc},
+		unmarshalJSONAnyFunc:         {unmarshalJSONStringFunc},
+		unmarshalJSONIntKeyFunc:      {unmarshalJSONStringFunc},
+		unmarshalJSONSafeLongKeyFunc: {unmarshalJSONStringFunc},
+	} {
+		if _, ok := e.helpers[helper]; ok {
+			for _, dep := range deps {
+				e.helpers[dep] = struct{}{}
+			}
+		}
+	}
+	var names []string
+	for name := range e.helpers {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return jsonHelperOrder[names[i]] < jsonHelperOrder[names[j]]
+	})
+	for _, name := range names {
+		file.Add(jsonHelpers[name]())
+	}
+}
+
+var jsonHelperOrder = func() map[string]int {
+	order := map[string]int{}
+	for i, name := range []string{
+		appendJSONStringFunc,
+		jsonStringSizeFunc,
+		jsonIntSizeFunc,
+		appendJSONFloat64Func,
+		jsonFloat64SizeFunc,
+		jsonFloat64KeyFunc,
+		appendJSONBinaryFunc,
+		appendJSONUUIDFunc,
+		appendJSONDateTimeFunc,
+		jsonDateTimeSizeFunc,
+		appendJSONTextFunc,
+		jsonTextSizeFunc,
+		appendJSONMarshalFunc,
+		jsonMarshalSizeFunc,
+		parseJSONStrictFunc,
+		jsonTypeErrorFunc,
+		jsonNumberKeyFunc,
+		decodeJSONStringFunc,
+		decodeJSONIntFunc,
+		decodeJSONSafeLongFunc,
+		decodeJSONFloat64Func,
+		decodeJSONBoolFunc,
+		decodeJSONUUIDFunc,
+		decodeJSONRIDFunc,
+		decodeJSONDateTimeFunc,
+		decodeJSONBinaryFunc,
+		decodeJSONBearerTokenFunc,
+		decodeJSONAnyFunc,
+		decodeJSONEnumFunc,
+		decodeJSONBinaryKeyFunc,
+		decodeJSONBooleanKeyFunc,
+		unmarshalJSONStringFunc,
+		unmarshalJSONIntFunc,
+		unmarshalJSONSafeLongFunc,
+		unmarshalJSONFloat64Func,
+		unmarshalJSONBoolFunc,
+		unmarshalJSONBinaryFunc,
+		unmarshalJSONAnyFunc,
+		unmarshalJSONIntKeyFunc,
+		unmarshalJSONSafeLongKeyFunc,
+		matchesJSONFieldFunc,
+		jsonArrayWriterType,
+	} {
+		order[name] = i
+	}
+	return order
+}()
+
+var jsonHelpers = map[string]func() *jen.Statement{
+	appendJSONStringFunc:         astForAppendJSONString,
+	jsonStringSizeFunc:           astForJSONStringSize,
+	jsonIntSizeFunc:              astForJSONIntSize,
+	appendJSONFloat64Func:        astForAppendJSONFloat64,
+	jsonFloat64SizeFunc:          astForJSONFloat64Size,
+	jsonFloat64KeyFunc:           astForJSONFloat64Key,
+	appendJSONBinaryFunc:         astForAppendJSONBinary,
+	appendJSONUUIDFunc:           astForAppendJSONUUID,
+	appendJSONDateTimeFunc:       astForAppendJSONDateTime,
+	jsonDateTimeSizeFunc:         astForJSONDateTimeSize,
+	appendJSONTextFunc:           astForAppendJSONText,
+	jsonTextSizeFunc:             astForJSONTextSize,
+	appendJSONMarshalFunc:        astForAppendJSONMarshal,
+	jsonMarshalSizeFunc:          astForJSONMarshalSize,
+	parseJSONStrictFunc:          astForParseJSONStrict,
+	jsonTypeErrorFunc:            astForJSONTypeError,
+	jsonNumberKeyFunc:            astForJSONNumberKey,
+	decodeJSONStringFunc:         astForDecodeJSONString,
+	decodeJSONIntFunc:            astForDecodeJSONInt,
+	decodeJSONSafeLongFunc:       astForDecodeJSONSafeLong,
+	decodeJSONFloat64Func:        astForDecodeJSONFloat64,
+	decodeJSONBoolFunc:           astForDecodeJSONBool,
+	decodeJSONUUIDFunc:           astForDecodeJSONUUID,
+	decodeJSONRIDFunc:            astForDecodeJSONRID,
+	decodeJSONDateTimeFunc:       astForDecodeJSONDateTime,
+	decodeJSONBinaryFunc:         astForDecodeJSONBinary,
+	decodeJSONBearerTokenFunc:    astForDecodeJSONBearerToken,
+	decodeJSONAnyFunc:            astForDecodeJSONAny,
+	decodeJSONEnumFunc:           astForDecodeJSONEnum,
+	decodeJSONBinaryKeyFunc:      astForDecodeJSONBinaryKey,
+	decodeJSONBooleanKeyFunc:     astForDecodeJSONBooleanKey,
+	unmarshalJSONStringFunc:      astForUnmarshalJSONString,
+	unmarshalJSONIntFunc:         astForUnmarshalJSONInt,
+	unmarshalJSONSafeLongFunc:    astForUnmarshalJSONSafeLong,
+	unmarshalJSONFloat64Func:     astForUnmarshalJSONFloat64,
+	unmarshalJSONBoolFunc:        astForUnmarshalJSONBool,
+	unmarshalJSONBinaryFunc:      astForUnmarshalJSONBinary,
+	unmarshalJSONAnyFunc:         astForUnmarshalJSONAny,
+	unmarshalJSONIntKeyFunc:      astForUnmarshalJSONIntKey,
+	unmarshalJSONSafeLongKeyFunc: astForUnmarshalJSONSafeLongKey,
+	matchesJSONFieldFunc:         astForMatchesJSONField,
+	jsonArrayWriterType:          astForJSONArrayWriter,
+}
+
+func outParam() *jen.Statement { return jen.Id(jsonOutVarName).Index().Byte() }
+
+func appendOut(values ...jen.Code) *jen.Statement {
+	return jen.Id(jsonOutVarName).Op("=").Append(append([]jen.Code{jen.Id(jsonOutVarName)}, values...)...)
+}
+
+// astForAppendJSONString returns a function that appends a string to out like encoding/json with HTML escaping
+// disabled: quotes, backslashes and control characters are escaped, invalid UTF-8 is replaced with U+FFFD and U+2028
+// and U+2029 are escaped.
+func astForAppendJSONString() *jen.Statement {
+	s, i, b, start, r, size := jen.Id("s"), jen.Id("i"), jen.Id("b"), jen.Id("start"), jen.Id("r"), jen.Id("size")
+	flush := appendOut(s.Clone().Index(start.Clone(), i.Clone()).Op("..."))
+	return jen.Commentf("%s appends s encoded as a JSON string to out.", appendJSONStringFunc).Line().
+		Func().Id(appendJSONStringFunc).Params(outParam(), jen.Id("s").String()).Index().Byte().Block(
+		jen.Const().Id("hex").Op("=").Lit("0123456789abcdef"),
+		appendOut(jen.LitRune('"')),
+		start.Clone().Op(":=").Lit(0),
+		jen.For(i.Clone().Op(":=").Lit(0), i.Clone().Op("<").Len(s.Clone()), jen.Empty()).Block(
+			jen.If(b.Clone().Op(":=").Add(s.Clone()).Index(i.Clone()), b.Clone().Op("<").Add(snip.UTF8RuneSelf())).Block(
+				jen.If(b.Clone().Op(">=").LitRune(' ').Op("&&").Add(b.Clone()).Op("!=").LitRune('"').Op("&&").Add(b.Clone()).Op("!=").LitRune('\\')).Block(
+					i.Clone().Op("++"),
+					jen.Continue(),
+				),
+				flush.Clone(),
+				jen.Switch(b.Clone()).Block(
+					jen.Case(jen.LitRune('\\'), jen.LitRune('"')).Block(appendOut(jen.LitRune('\\'), b.Clone())),
+					jen.Case(jen.LitRune('\b')).Block(appendOut(jen.LitRune('\\'), jen.LitRune('b'))),
+					jen.Case(jen.LitRune('\f')).Block(appendOut(jen.LitRune('\\'), jen.LitRune('f'))),
+					jen.Case(jen.LitRune('\n')).Block(appendOut(jen.LitRune('\\'), jen.LitRune('n'))),
+					jen.Case(jen.LitRune('\r')).Block(appendOut(jen.LitRune('\\'), jen.LitRune('r'))),
+					jen.Case(jen.LitRune('\t')).Block(appendOut(jen.LitRune('\\'), jen.LitRune('t'))),
+					jen.Default().Block(appendOut(jen.LitRune('\\'), jen.LitRune('u'), jen.LitRune('0'), jen.LitRune('0'),
+						jen.Id("hex").Index(b.Clone().Op(">>").Lit(4)), jen.Id("hex").Index(b.Clone().Op("&").Lit(0xF)))),
+				),
+				i.Clone().Op("++"),
+				start.Clone().Op("=").Add(i.Clone()),
+				jen.Continue(),
+			),
+			jen.List(r.Clone(), size.Clone()).Op(":=").Add(snip.UTF8DecodeRuneInString()).Call(s.Clone().Index(i.Clone(), jen.Empty())),
+			jen.Switch().Block(
+				jen.Case(r.Clone().Op("==").Add(snip.UTF8RuneError()).Op("&&").Add(size.Clone()).Op("==").Lit(1)).Block(
+					flush.Clone(),
+					appendOut(jen.Lit(`\ufffd`).Op("...")),
+					start.Clone().Op("=").Add(i.Clone()).Op("+").Add(size.Clone()),
+				),
+				jen.Case(r.Clone().Op("==").LitRune('\u2028').Op("||").Add(r.Clone()).Op("==").LitRune('\u2029')).Block(
+					flush.Clone(),
+					appendOut(jen.LitRune('\\'), jen.LitRune('u'), jen.LitRune('2'), jen.LitRune('0'), jen.LitRune('2'),
+						jen.Id("hex").Index(r.Clone().Op("&").Lit(0xF))),
+					start.Clone().Op("=").Add(i.Clone()).Op("+").Add(size.Clone()),
+				),
+			),
+			i.Clone().Op("+=").Add(size.Clone()),
+		),
+		appendOut(s.Clone().Index(start.Clone(), jen.Empty()).Op("...")),
+		jen.Return(jen.Append(jen.Id(jsonOutVarName), jen.LitRune('"'))),
+	)
+}
+
+// astForJSONStringSize returns a function that returns the length of the output of appendJSONString.
+func astForJSONStringSize() *jen.Statement {
+	s, i, b, r, n, size := jen.Id("s"), jen.Id("i"), jen.Id("b"), jen.Id("r"), jen.Id("n"), jen.Id(jsonSizeVarName)
+	return jen.Commentf("%s returns the length of s encoded as a JSON string.", jsonStringSizeFunc).Line().
+		Func().Id(jsonStringSizeFunc).Params(jen.Id("s").String()).Int().Block(
+		size.Clone().Op(":=").Len(s.Clone()).Op("+").Lit(2),
+		jen.For(i.Clone().Op(":=").Lit(0), i.Clone().Op("<").Len(s.Clone()), jen.Empty()).Block(
+			jen.If(b.Clone().Op(":=").Add(s.Clone()).Index(i.Clone()), b.Clone().Op("<").Add(snip.UTF8RuneSelf())).Block(
+				jen.Switch().Block(
+					jen.Case(b.Clone().Op("==").LitRune('\\').Op("||").Add(b.Clone()).Op("==").LitRune('"').Op("||").
+						Add(b.Clone()).Op("==").LitRune('\b').Op("||").Add(b.Clone()).Op("==").LitRune('\f').Op("||").
+						Add(b.Clone()).Op("==").LitRune('\n').Op("||").Add(b.Clone()).Op("==").LitRune('\r').Op("||").
+						Add(b.Clone()).Op("==").LitRune('\t')).Block(
+						size.Clone().Op("++"),
+					),
+					jen.Case(b.Clone().Op("<").LitRune(' ')).Block(
+						size.Clone().Op("+=").Lit(5),
+					),
+				),
+				i.Clone().Op("++"),
+				jen.Continue(),
+			),
+			jen.List(r.Clone(), n.Clone()).Op(":=").Add(snip.UTF8DecodeRuneInString()).Call(s.Clone().Index(i.Clone(), jen.Empty())),
+			jen.Switch().Block(
+				jen.Case(r.Clone().Op("==").Add(snip.UTF8RuneError()).Op("&&").Add(n.Clone()).Op("==").Lit(1)).Block(
+					jen.Comment(`replaced with \ufffd`),
+					size.Clone().Op("+=").Lit(5),
+				),
+				jen.Case(r.Clone().Op("==").LitRune('\u2028').Op("||").Add(r.Clone()).Op("==").LitRune('\u2029')).Block(
+					jen.Comment(`escaped as \u2028 or \u2029`),
+					size.Clone().Op("+=").Lit(3),
+				),
+			),
+			i.Clone().Op("+=").Add(n.Clone()),
+		),
+		jen.Return(size.Clone()),
+	)
+}
+
+func astForJSONIntSize() *jen.Statement {
+	return jen.Commentf("%s returns the length of the decimal representation of v.", jsonIntSizeFunc).Line().
+		Func().Id(jsonIntSizeFunc).Params(jen.Id("v").Int64()).Int().Block(
+		jen.Var().Id("buf").Index(jen.Lit(20)).Byte(),
+		jen.Return(jen.Len(snip.StrconvAppendInt().Call(jen.Id("buf").Index(jen.Empty(), jen.Lit(0)), jen.Id("v"), jen.Lit(10)))),
+	)
+}
+
+// astForAppendJSONFloat64 returns a function that appends a float64 to out like encoding/json. NaN and infinite values,
+// which encoding/json does not support, are encoded as the strings "NaN", "Infinity" and "-Infinity".
+func astForAppendJSONFloat64() *jen.Statement {
+	v, format, abs, n := jen.Id("v"), jen.Id("format"), jen.Id("abs"), jen.Id("n")
+	out := jen.Id(jsonOutVarName)
+	return jen.Commentf("%s appends v to out using the same format as encoding/json. NaN and infinite values are", appendJSONFloat64Func).Line().
+		Comment(`encoded as the strings "NaN", "Infinity" and "-Infinity".`).Line().
+		Func().Id(appendJSONFloat64Func).Params(outParam(), jen.Id("v").Float64()).Index().Byte().Block(
+		jen.Switch().Block(
+			jen.Case(snip.MathIsNaN().Call(v.Clone())).Block(jen.Return(jen.Append(out.Clone(), jen.Lit(`"NaN"`).Op("...")))),
+			jen.Case(snip.MathIsInf().Call(v.Clone(), jen.Lit(1))).Block(jen.Return(jen.Append(out.Clone(), jen.Lit(`"Infinity"`).Op("...")))),
+			jen.Case(snip.MathIsInf().Call(v.Clone(), jen.Lit(-1))).Block(jen.Return(jen.Append(out.Clone(), jen.Lit(`"-Infinity"`).Op("...")))),
+		),
+		format.Clone().Op(":=").Byte().Call(jen.LitRune('f')),
+		jen.If(
+			abs.Clone().Op(":=").Add(snip.MathAbs()).Call(v.Clone()),
+			abs.Clone().Op("!=").Lit(0).Op("&&").Parens(abs.Clone().Op("<").Lit(1e-6).Op("||").Add(abs.Clone()).Op(">=").Lit(1e21)),
+		).Block(
+			format.Clone().Op("=").LitRune('e'),
+		),
+		out.Clone().Op("=").Add(snip.StrconvAppendFloat()).Call(out.Clone(), v.Clone(), format.Clone(), jen.Lit(-1), jen.Lit(64)),
+		jen.If(format.Clone().Op("==").LitRune('e')).Block(
+			jen.Comment("clean up e-09 to e-9"),
+			jen.If(
+				n.Clone().Op(":=").Len(out.Clone()),
+				n.Clone().Op(">=").Lit(4).Op("&&").
+					Add(out.Clone()).Index(n.Clone().Op("-").Lit(4)).Op("==").LitRune('e').Op("&&").
+					Add(out.Clone()).Index(n.Clone().Op("-").Lit(3)).Op("==").LitRune('-').Op("&&").
+					Add(out.Clone()).Index(n.Clone().Op("-").Lit(2)).Op("==").LitRune('0'),
+			).Block(
+				out.Clone().Index(n.Clone().Op("-").Lit(2)).Op("=").Add(out.Clone()).Index(n.Clone().Op("-").Lit(1)),
+				out.Clone().Op("=").Add(out.Clone()).Index(jen.Empty(), n.Clone().Op("-").Lit(1)),
+			),
+		),
+		jen.Return(out.Clone()),
+	)
+}
+
+func astForJSONFloat64Size() *jen.Statement {
+	return jen.Commentf("%s returns the length of the output of %s.", jsonFloat64SizeFunc, appendJSONFloat64Func).Line().
+		Func().Id(jsonFloat64SizeFunc).Params(jen.Id("v").Float64()).Int().Block(
+		jen.Var().Id("buf").Index(jen.Lit(32)).Byte(),
+		jen.Return(jen.Len(jen.Id(appendJSONFloat64Func).Call(jen.Id("buf").Index(jen.Empty(), jen.Lit(0)), jen.Id("v")))),
+	)
+}
+
+// astForJSONFloat64Key returns a function that returns the JSON object key of a float64 map key, which is the output of
+// appendJSONFloat64 without quotes.
+func astForJSONFloat64Key() *jen.Statement {
+	v := jen.Id("v")
+	return jen.Commentf("%s returns the string form of v used as a JSON object key.", jsonFloat64KeyFunc).Line().
+		Func().Id(jsonFloat64KeyFunc).Params(jen.Id("v").Float64()).String().Block(
+		jen.Switch().Block(
+			jen.Case(snip.MathIsNaN().Call(v.Clone())).Block(jen.Return(jen.Lit("NaN"))),
+			jen.Case(snip.MathIsInf().Call(v.Clone(), jen.Lit(1))).Block(jen.Return(jen.Lit("Infinity"))),
+			jen.Case(snip.MathIsInf().Call(v.Clone(), jen.Lit(-1))).Block(jen.Return(jen.Lit("-Infinity"))),
+		),
+		jen.Var().Id("buf").Index(jen.Lit(32)).Byte(),
+		jen.Return(jen.String().Call(jen.Id(appendJSONFloat64Func).Call(jen.Id("buf").Index(jen.Empty(), jen.Lit(0)), v.Clone()))),
+	)
+}
+
+func astForAppendJSONBinary() *jen.Statement {
+	return jen.Commentf("%s appends b encoded as a base64 JSON string to out.", appendJSONBinaryFunc).Line().
+		Func().Id(appendJSONBinaryFunc).Params(outParam(), jen.Id("b").Index().Byte()).Index().Byte().Block(
+		appendOut(jen.LitRune('"')),
+		jen.Id("start").Op(":=").Len(jen.Id(jsonOutVarName)),
+		appendOut(jen.Make(jen.Index().Byte(), snip.Base64StdEncoding().Dot("EncodedLen").Call(jen.Len(jen.Id("b")))).Op("...")),
+		snip.Base64StdEncoding().Dot("Encode").Call(jen.Id(jsonOutVarName).Index(jen.Id("start"), jen.Empty()), jen.Id("b")),
+		jen.Return(jen.Append(jen.Id(jsonOutVarName), jen.LitRune('"'))),
+	)
+}
+
+func astForAppendJSONUUID() *jen.Statement {
+	return jen.Commentf("%s appends the string form of v as a JSON string to out.", appendJSONUUIDFunc).Line().
+		Func().Id(appendJSONUUIDFunc).Params(outParam(), jen.Id("v").Add(snip.UUIDUUID())).Index().Byte().Block(
+		jen.Const().Id("hex").Op("=").Lit("0123456789abcdef"),
+		appendOut(jen.LitRune('"')),
+		jen.For(jen.List(jen.Id("i"), jen.Id("b")).Op(":=").Range().Id("v")).Block(
+			jen.If(jen.Id("i").Op("==").Lit(4).Op("||").Id("i").Op("==").Lit(6).Op("||").Id("i").Op("==").Lit(8).Op("||").Id("i").Op("==").Lit(10)).Block(
+				appendOut(jen.LitRune('-')),
+			),
+			appendOut(jen.Id("hex").Index(jen.Id("b").Op(">>").Lit(4)), jen.Id("hex").Index(jen.Id("b").Op("&").Lit(0xF))),
+		),
+		jen.Return(jen.Append(jen.Id(jsonOutVarName), jen.LitRune('"'))),
+	)
+}
+
+func astForAppendJSONDateTime() *jen.Statement {
+	return jen.Commentf("%s appends the string form of v as a JSON string to out.", appendJSONDateTimeFunc).Line().
+		Func().Id(appendJSONDateTimeFunc).Params(outParam(), jen.Id("v").Add(snip.DateTimeDateTime())).Index().Byte().Block(
+		appendOut(jen.LitRune('"')),
+		jen.Id(jsonOutVarName).Op("=").Add(snip.TimeTime()).Call(jen.Id("v")).Dot("AppendFormat").Call(jen.Id(jsonOutVarName), snip.TimeRFC3339Nano()),
+		jen.Return(jen.Append(jen.Id(jsonOutVarName), jen.LitRune('"'))),
+	)
+}
+
+func astForJSONDateTimeSize() *jen.Statement {
+	return jen.Commentf("%s returns the length of the output of %s.", jsonDateTimeSizeFunc, appendJSONDateTimeFunc).Line().
+		Func().Id(jsonDateTimeSizeFunc).Params(jen.Id("v").Add(snip.DateTimeDateTime())).Int().Block(
+		jen.Var().Id("buf").Index(jen.Lit(64)).Byte(),
+		jen.Return(jen.Len(jen.Id(appendJSONDateTimeFunc).Call(jen.Id("buf").Index(jen.Empty(), jen.Lit(0)), jen.Id("v")))),
+	)
+}
+
+func astForAppendJSONText() *jen.Statement {
+	return jen.Commentf("%s appends the text form of v as a JSON string to out.", appendJSONTextFunc).Line().
+		Func().Id(appendJSONTextFunc).Params(outParam(), jen.Id("v").Add(snip.EncodingTextMarshaler())).Params(jen.Index().Byte(), jen.Error()).Block(
+		jen.List(jen.Id("text"), jen.Err()).Op(":=").Id("v").Dot("MarshalText").Call(),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err())),
+		jen.Return(jen.Id(appendJSONStringFunc).Call(jen.Id(jsonOutVarName), jen.String().Call(jen.Id("text"))), jen.Nil()),
+	)
+}
+
+func astForJSONTextSize() *jen.Statement {
+	return jen.Commentf("%s returns the length of the output of %s.", jsonTextSizeFunc, appendJSONTextFunc).Line().
+		Func().Id(jsonTextSizeFunc).Params(jen.Id("v").Add(snip.EncodingTextMarshaler())).Params(jen.Int(), jen.Error()).Block(
+		jen.List(jen.Id("text"), jen.Err()).Op(":=").Id("v").Dot("MarshalText").Call(),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Lit(0), jen.Err())),
+		jen.Return(jen.Id(jsonStringSizeFunc).Call(jen.String().Call(jen.Id("text"))), jen.Nil()),
+	)
+}
+
+func astForAppendJSONMarshal() *jen.Statement {
+	return jen.Commentf("%s appends v encoded using safejson.Marshal to out.", appendJSONMarshalFunc).Line().
+		Func().Id(appendJSONMarshalFunc).Params(outParam(), jen.Id("v").Interface()).Params(jen.Index().Byte(), jen.Error()).Block(
+		jen.List(jen.Id("data"), jen.Err()).Op(":=").Add(snip.SafeJSONMarshal()).Call(jen.Id("v")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err())),
+		jen.Return(jen.Append(jen.Id(jsonOutVarName), jen.Id("data").Op("...")), jen.Nil()),
+	)
+}
+
+func astForJSONMarshalSize() *jen.Statement {
+	return jen.Commentf("%s returns the length of the output of %s.", jsonMarshalSizeFunc, appendJSONMarshalFunc).Line().
+		Func().Id(jsonMarshalSizeFunc).Params(jen.Id("v").Interface()).Params(jen.Int(), jen.Error()).Block(
+		jen.List(jen.Id("data"), jen.Err()).Op(":=").Add(snip.SafeJSONMarshal()).Call(jen.Id("v")),
+		jen.Return(jen.Len(jen.Id("data")), jen.Err()),
+	)
+}
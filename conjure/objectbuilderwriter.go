@@ -0,0 +1,215 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/palantir/conjure-go/v6/conjure/snip"
+	"github.com/palantir/conjure-go/v6/conjure/transforms"
+	"github.com/palantir/conjure-go/v6/conjure/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	missingFieldsVarName = "missing"
+	validatorVarName     = "validator"
+	// validateRequiredMethodName is not Validate so that it does not conflict with the Validate method that users
+	// implement for the server handlers generated with --validation.
+	validateRequiredMethodName = "ValidateRequired"
+)
+
+// writeObjectBuilders writes the New<Object> constructor, which takes the required fields of objectDef and initializes
+// its collections, a With<Field> setter for each field that is not required and a ValidateRequired method that reports
+// required fields that are missing.
+func writeObjectBuilders(file *jen.Group, objectDef *types.ObjectType) {
+	file.Add(astForObjectConstructor(objectDef))
+	for _, fieldDef := range objectDef.Fields {
+		if !isRequiredField(fieldDef) {
+			file.Add(astForObjectFieldSetter(objectDef, fieldDef))
+		}
+	}
+	file.Add(astForObjectValidateRequired(objectDef))
+}
+
+// checkBuilderNames returns an error if a New<Object> constructor written by writeObjectBuilders has the same name as
+// a type of pkg or if a With<Field> setter or the ValidateRequired method of an object has the same name as a field of
+// the object.
+func checkBuilderNames(pkg types.ConjurePackage) error {
+	typeNames := packageTypeNames(pkg)
+	for _, objectDef := range pkg.Objects {
+		if _, ok := typeNames[objectConstructorName(objectDef)]; ok {
+			return errors.Errorf("the constructor of object %s conflicts with the type of package %s: both are named %s", objectDef.Name, pkg.ConjurePackage, objectConstructorName(objectDef))
+		}
+		fields := map[string]string{}
+		for _, fieldDef := range objectDef.Fields {
+			fields[transforms.ExportedFieldName(fieldDef.Name)] = fieldDef.Name
+		}
+		if field, ok := fields[validateRequiredMethodName]; ok {
+			return errors.Errorf("the %s method of object %s conflicts with the field %s: both are named %s", validateRequiredMethodName, objectDef.Name, field, validateRequiredMethodName)
+		}
+		for _, fieldDef := range objectDef.Fields {
+			if isRequiredField(fieldDef) {
+				continue
+			}
+			if field, ok := fields[objectFieldSetterName(fieldDef)]; ok {
+				return errors.Errorf("the setter of field %s of object %s conflicts with the field %s: both are named %s", fieldDef.Name, objectDef.Name, field, objectFieldSetterName(fieldDef))
+			}
+		}
+	}
+	return nil
+}
+
+func objectConstructorName(objectDef *types.ObjectType) string {
+	return "New" + objectDef.Name
+}
+
+func objectFieldSetterName(fieldDef *types.Field) string {
+	return "With" + transforms.ExportedFieldName(fieldDef.Name)
+}
+
+// isRequiredField returns true if the field must be present in the JSON representation of its object: fields that are
+// optional or collections may be omitted.
+func isRequiredField(fieldDef *types.Field) bool {
+	return !fieldDef.Type.IsOptional() && fieldDef.Type.Make() == nil
+}
+
+func astForObjectConstructor(objectDef *types.ObjectType) *jen.Statement {
+	funcName := objectConstructorName(objectDef)
+	return jen.Commentf("%s returns a %s with the provided required fields and empty collections.", funcName, objectDef.Name).Line().
+		Func().Id(funcName).
+		ParamsFunc(func(params *jen.Group) {
+			for _, fieldDef := range objectDef.Fields {
+				if isRequiredField(fieldDef) {
+					params.Id(builderArgName(fieldDef)).Add(fieldDef.Type.Code())
+				}
+			}
+		}).
+		Id(objectDef.Name).
+		Block(
+			jen.Return(jen.Id(objectDef.Name).ValuesFunc(func(values *jen.Group) {
+				for _, fieldDef := range objectDef.Fields {
+					fieldName := transforms.ExportedFieldName(fieldDef.Name)
+					if isRequiredField(fieldDef) {
+						values.Id(fieldName).Op(":").Id(builderArgName(fieldDef))
+					} else if collInit := fieldDef.Type.Make(); collInit != nil {
+						values.Id(fieldName).Op(":").Add(collInit)
+					}
+				}
+			})),
+		)
+}
+
+// builderArgName returns the name of the argument of constructors and setters for fieldDef. Field names are converted to
+// valid identifiers (such as "kebab-case" to "kebabCase") before the suffix that keeps them from shadowing packages is
+// added, which also makes the names safe for fields named with Go keywords.
+func builderArgName(fieldDef *types.Field) string {
+	return transforms.ArgName(strings.TrimSuffix(transforms.PrivateFieldName(fieldDef.Name), "_"))
+}
+
+// astForObjectFieldSetter returns the With<Field> method of fieldDef. The method has a value receiver and returns a
+// copy of the object with the field set so that calls can be chained on the result of the constructor. Setters of
+// optional fields take the value of the optional.
+func astForObjectFieldSetter(objectDef *types.ObjectType, fieldDef *types.Field) *jen.Statement {
+	fieldName := transforms.ExportedFieldName(fieldDef.Name)
+	methodName := objectFieldSetterName(fieldDef)
+	argName := builderArgName(fieldDef)
+	argType, value := fieldDef.Type.Code(), jen.Id(argName)
+	if optional, ok := fieldDef.Type.(*types.Optional); ok {
+		argType, value = optional.Item.Code(), jen.Op("&").Id(argName)
+	}
+	stmt := jen.Commentf("%s returns a copy of the %s with the %q field set to the provided value.", methodName, objectDef.Name, fieldDef.Name).Line()
+	if fieldDef.Deprecated != "" {
+		stmt = stmt.Comment("").Line().Commentf("Deprecated: %s", fieldDef.Deprecated).Line()
+	}
+	return stmt.Func().
+		Params(jen.Id(objReceiverName).Id(objectDef.Name)).
+		Id(methodName).
+		Params(jen.Id(argName).Add(argType)).
+		Id(objectDef.Name).
+		Block(
+			jen.Id(objReceiverName).Dot(fieldName).Op("=").Add(value),
+			jen.Return(jen.Id(objReceiverName)),
+		)
+}
+
+// astForObjectValidateRequired returns the ValidateRequired method of objectDef. A required field is missing if its value is the
+// zero value of a type whose zero value is not valid in the JSON representation of the object: binary and any values
+// that are nil, and enums, unions and rids (or aliases of them) that are not set. Required fields of other types are
+// valid if they implement ValidateRequired() error and their ValidateRequired method succeeds.
+func astForObjectValidateRequired(objectDef *types.ObjectType) *jen.Statement {
+	return jen.Commentf("%s returns an error if a required field of the %s is missing.", validateRequiredMethodName, objectDef.Name).Line().
+		Func().
+		Params(jen.Id(objReceiverName).Op("*").Id(objectDef.Name)).
+		Id(validateRequiredMethodName).
+		Params().
+		Error().
+		BlockFunc(func(methodBody *jen.Group) {
+			var checked, nested []*types.Field
+			for _, fieldDef := range objectDef.Fields {
+				if !isRequiredField(fieldDef) {
+					continue
+				}
+				if astForMissingFieldCond(jen.Empty(), fieldDef.Type, fieldDef.Type) != nil {
+					checked = append(checked, fieldDef)
+				} else if fieldDef.Type.IsNamed() {
+					nested = append(nested, fieldDef)
+				}
+			}
+			if len(checked) > 0 {
+				methodBody.Var().Id(missingFieldsVarName).Index().String()
+				for _, fieldDef := range checked {
+					value := jen.Id(objReceiverName).Dot(transforms.ExportedFieldName(fieldDef.Name))
+					methodBody.If(astForMissingFieldCond(value, fieldDef.Type, fieldDef.Type)).Block(
+						jen.Id(missingFieldsVarName).Op("=").Append(jen.Id(missingFieldsVarName), jen.Lit(fieldDef.Name)),
+					)
+				}
+				methodBody.If(jen.Len(jen.Id(missingFieldsVarName)).Op(">").Lit(0)).Block(
+					jen.Return(snip.FmtErrorf().Call(
+						jen.Lit("missing required fields: %s"),
+						snip.StringsJoin().Call(jen.Id(missingFieldsVarName), jen.Lit(", ")),
+					)),
+				)
+			}
+			for _, fieldDef := range nested {
+				value := jen.Op("&").Id(objReceiverName).Dot(transforms.ExportedFieldName(fieldDef.Name))
+				methodBody.If(
+					jen.List(jen.Id(validatorVarName), jen.Id("ok")).Op(":=").Interface().Call(value).Assert(jen.Interface(jen.Id(validateRequiredMethodName).Params().Error())),
+					jen.Id("ok"),
+				).Block(
+					jen.If(jen.Err().Op(":=").Id(validatorVarName).Dot(validateRequiredMethodName).Call(), jen.Err().Op("!=").Nil()).Block(
+						jen.Return(snip.FmtErrorf().Call(jen.Lit(fmt.Sprintf("field %q: %%w", fieldDef.Name)), jen.Err())),
+					),
+				)
+			}
+			methodBody.Return(jen.Nil())
+		})
+}
+
+// astForMissingFieldCond returns the condition that is true if value, of type valueType, is missing, or nil if no
+// value of typ is missing. typ is valueType or the type that valueType aliases.
+func astForMissingFieldCond(value *jen.Statement, valueType, typ types.Type) *jen.Statement {
+	switch t := typ.(type) {
+	case types.Any, types.Binary:
+		return value.Clone().Op("==").Nil()
+	case types.RID, *types.EnumType, *types.UnionType:
+		return value.Clone().Op("==").Parens(valueType.Code().Values())
+	case *types.AliasType:
+		return astForMissingFieldCond(value, valueType, t.Item)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"testing"
+
+	"github.com/palantir/conjure-go/v6/conjure/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckBuilderNames(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		pkg     types.ConjurePackage
+		wantErr string
+	}{
+		{
+			name: "no conflicts",
+			pkg: types.ConjurePackage{
+				Objects: []*types.ObjectType{{
+					Name: "Widget",
+					Fields: []*types.Field{
+						{Name: "validate", Type: types.String{}},
+						{Name: "withBar", Type: types.String{}},
+						{Name: "bar", Type: types.String{}},
+					},
+				}},
+			},
+		},
+		{
+			name: "setter and field",
+			pkg: types.ConjurePackage{
+				Objects: []*types.ObjectType{{
+					Name: "Widget",
+					Fields: []*types.Field{
+						{Name: "withBar", Type: types.String{}},
+						{Name: "bar", Type: &types.Optional{Item: types.String{}}},
+					},
+				}},
+			},
+			wantErr: "the setter of field bar of object Widget conflicts with the field withBar: both are named WithBar",
+		},
+		{
+			name: "validate method and field",
+			pkg: types.ConjurePackage{
+				Objects: []*types.ObjectType{{
+					Name:   "Widget",
+					Fields: []*types.Field{{Name: "validateRequired", Type: types.Boolean{}}},
+				}},
+			},
+			wantErr: "the ValidateRequired method of object Widget conflicts with the field validateRequired: both are named ValidateRequired",
+		},
+		{
+			name: "constructor and type",
+			pkg: types.ConjurePackage{
+				ConjurePackage: "com.palantir.foo",
+				Objects:        []*types.ObjectType{{Name: "Widget"}},
+				Aliases:        []*types.AliasType{{Name: "NewWidget", Item: types.String{}}},
+			},
+			wantErr: "the constructor of object Widget conflicts with the type of package com.palantir.foo: both are named NewWidget",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkBuilderNames(tc.pkg)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -28,9 +28,10 @@ const (
 	dataVarName     = "data"
 )
 
-func writeObjectType(file *jen.Group, objectDef *types.ObjectType) {
+// writeObjectType writes the struct of objectDef and its methods. If builders is true, the constructor, setters and
+// Validate method written by writeObjectBuilders are written after the struct.
+func writeObjectType(file *jen.Group, objectDef *types.ObjectType, builders bool, jw *jsonWriter) {
 	// Declare struct type with fields
-	containsCollection := false // If contains collection, we need JSON methods to initialize empty values.
 	file.Add(objectDef.Docs.CommentLine()).Type().Id(objectDef.Name).StructFunc(func(structDecl *jen.Group) {
 		for _, fieldDef := range objectDef.Fields {
 			fieldName := fieldDef.Name
@@ -42,42 +43,53 @@ func writeObjectType(file *jen.Group, objectDef *types.ObjectType) {
 				// double quotes instead.
 				fieldTags["conjure-docs"] = strings.Replace(strings.TrimSpace(string(fieldDef.Docs)), "`", `"`, -1)
 			}
-			if fieldDef.Type.Make() != nil {
-				containsCollection = true
-			}
 			structDecl.Add(fieldDef.Docs.CommentLineWithDeprecation(fieldDef.Deprecated)).Id(transforms.ExportedFieldName(fieldName)).Add(fieldDef.Type.Code()).Tag(fieldTags)
 		}
 	})
 
+	if builders {
+		writeObjectBuilders(file, objectDef)
+	}
+
+	// Declare AppendJSON, JSONSize and, if the object contains a collection, MarshalJSON, which encodes nil collections as
+	// empty collections
+	jw.writeObjectMethods(file, objectDef)
+
 	// If there are no collections, we can defer to the default json behavior
-	// Otherwise we need to override MarshalJSON and UnmarshalJSON
-	if containsCollection {
+	// Otherwise we need to override UnmarshalJSON
+	jw.writeObjectUnmarshalMethods(file, objectDef, func(methodBody *jen.Group) {
 		tmpAliasName := objectDef.Name + "Alias"
-		// Declare MarshalJSON
-		file.Add(snip.MethodMarshalJSON(objReceiverName, objectDef.Name).BlockFunc(func(methodBody *jen.Group) {
-			writeStructMarshalInitDecls(methodBody, objectDef.Fields, objReceiverName)
-			methodBody.Type().Id(tmpAliasName).Id(objectDef.Name)
-			methodBody.Return(snip.SafeJSONMarshal().Call(jen.Id(tmpAliasName).Call(jen.Id(objReceiverName))))
-		}))
-		// Declare UnmarshalJSON
-		file.Add(snip.MethodUnmarshalJSON(objReceiverName, objectDef.Name).BlockFunc(func(methodBody *jen.Group) {
-			rawVarName := "raw" + objectDef.Name
-			methodBody.Type().Id(tmpAliasName).Id(objectDef.Name)
-			methodBody.Var().Id(rawVarName).Id(tmpAliasName)
-			methodBody.If(jen.Err().Op(":=").Add(snip.SafeJSONUnmarshal()).Call(jen.Id(dataVarName), jen.Op("&").Id(rawVarName)),
-				jen.Err().Op("!=").Nil()).Block(
-				jen.Return(jen.Err()),
-			)
-			writeStructMarshalInitDecls(methodBody, objectDef.Fields, rawVarName)
-			methodBody.Op("*").Id(objReceiverName).Op("=").Id(objectDef.Name).Call(jen.Id(rawVarName))
-			methodBody.Return(jen.Nil())
-		}))
-	}
+		rawVarName := "raw" + objectDef.Name
+		methodBody.Type().Id(tmpAliasName).Id(objectDef.Name)
+		methodBody.Var().Id(rawVarName).Id(tmpAliasName)
+		methodBody.If(jen.Err().Op(":=").Add(snip.SafeJSONUnmarshal()).Call(jen.Id(dataVarName), jen.Op("&").Id(rawVarName)),
+			jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		)
+		writeStructMarshalInitDecls(methodBody, objectDef.Fields, rawVarName)
+		methodBody.Op("*").Id(objReceiverName).Op("=").Id(objectDef.Name).Call(jen.Id(rawVarName))
+		methodBody.Return(jen.Nil())
+	})
+
+	jw.writeObjectDecodeMethods(file, objectDef)
 
 	file.Add(snip.MethodMarshalYAML(objReceiverName, objectDef.Name))
 	file.Add(snip.MethodUnmarshalYAML(objReceiverName, objectDef.Name))
 }
 
+// objectHasJSONMethods returns true if the object contains a collection, so that it needs a MarshalJSON method to encode
+// nil collections as empty collections and an UnmarshalJSON method to initialize empty values. Other objects are
+// encoded and decoded by encoding/json without these methods, which is faster than calling a MarshalJSON method because
+// encoding/json validates and compacts the output of MarshalJSON.
+func objectHasJSONMethods(objectDef *types.ObjectType) bool {
+	for _, fieldDef := range objectDef.Fields {
+		if fieldDef.Type.Make() != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func writeStructMarshalInitDecls(methodBody *jen.Group, fields []*types.Field, rawVarName string) {
 	for _, fieldDef := range fields {
 		if collInit := fieldDef.Type.Make(); collInit != nil {
@@ -0,0 +1,556 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/palantir/conjure-go/v6/conjure/types"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// OpenAPIFormatJSON writes OpenAPI documents as JSON.
+	OpenAPIFormatJSON = "json"
+	// OpenAPIFormatYAML writes OpenAPI documents as YAML.
+	OpenAPIFormatYAML = "yaml"
+
+	openAPIVersion = "3.1.0"
+	// openAPIInfoVersion is the version of the API in the info object of generated documents. Conjure IR does not
+	// record the version of the definitions, so a placeholder is used.
+	openAPIInfoVersion = "0.0.0"
+
+	openAPISerializableErrorSchema = "SerializableError"
+	openAPIBearerAuthScheme        = "BearerAuth"
+	openAPIJSONMediaType           = "application/json"
+	openAPIBinaryMediaType         = "application/octet-stream"
+	openAPIUnionDiscriminator      = "type"
+)
+
+var openAPIPathParamRegexp = regexp.MustCompile(`\{([^}:*]+)[^}]*}`)
+
+// openAPIFileName returns the name of the OpenAPI document for the provided service.
+func openAPIFileName(serviceName, format string) string {
+	if format == "" {
+		format = OpenAPIFormatJSON
+	}
+	return serviceName + ".openapi." + format
+}
+
+// writeOpenAPIDocument returns the OpenAPI 3.1 document for serviceDef in the provided format. Every named type that is
+// reachable from the endpoints of the service is a schema in the components of the document, as are the provided
+// error definitions. Unions are modelled as a oneOf discriminated on the "type" property and errors are modelled as
+// the SerializableError returned by Conjure servers.
+func writeOpenAPIDocument(serviceDef *types.ServiceDefinition, errorDefs []*types.ErrorDefinition, format string) ([]byte, error) {
+	w := &openAPIWriter{
+		schemas:    map[string]*openAPISchema{},
+		namedTypes: map[string]interface{}{},
+	}
+	doc, err := w.document(serviceDef, errorDefs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create OpenAPI document for service %s", serviceDef.Name)
+	}
+	out, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal OpenAPI document for service %s", serviceDef.Name)
+	}
+	switch format {
+	case "", OpenAPIFormatJSON:
+		return append(out, '\n'), nil
+	case OpenAPIFormatYAML:
+		// JSON is valid YAML: unmarshal into a MapSlice to keep the order of the keys of the JSON document.
+		var ordered yaml.MapSlice
+		if err := yaml.Unmarshal(out, &ordered); err != nil {
+			return nil, errors.Wrapf(err, "failed to convert OpenAPI document for service %s to YAML", serviceDef.Name)
+		}
+		return yaml.Marshal(ordered)
+	default:
+		return nil, errors.Errorf("unsupported OpenAPI format %q", format)
+	}
+}
+
+type openAPIWriter struct {
+	schemas map[string]*openAPISchema
+	// namedTypes records the Conjure type of every schema in schemas, which must have unique names.
+	namedTypes map[string]interface{}
+}
+
+func (w *openAPIWriter) document(serviceDef *types.ServiceDefinition, errorDefs []*types.ErrorDefinition) (*openAPIDocument, error) {
+	doc := &openAPIDocument{
+		OpenAPI:   openAPIVersion,
+		Generated: generatedFileHeader,
+		Info: openAPIInfo{
+			Title:       serviceDef.Name,
+			Description: string(serviceDef.Docs),
+			Version:     openAPIInfoVersion,
+		},
+		Paths: map[string]openAPIPathItem{},
+	}
+	w.schemas[openAPISerializableErrorSchema] = openAPISerializableError()
+	for _, errorDef := range errorDefs {
+		if err := w.addErrorSchema(errorDef); err != nil {
+			return nil, err
+		}
+	}
+	securitySchemes := map[string]openAPISecurityScheme{}
+	for _, endpointDef := range serviceDef.Endpoints {
+		op, err := w.operation(serviceDef.Name, endpointDef)
+		if err != nil {
+			return nil, errors.Wrapf(err, "endpoint %s", endpointDef.EndpointName)
+		}
+		if endpointDef.HeaderAuth {
+			securitySchemes[openAPIBearerAuthScheme] = openAPISecurityScheme{Type: "http", Scheme: "bearer"}
+		} else if endpointDef.CookieAuth != nil {
+			securitySchemes[openAPICookieAuthScheme(*endpointDef.CookieAuth)] = openAPISecurityScheme{Type: "apiKey", In: "cookie", Name: *endpointDef.CookieAuth}
+		}
+		path := openAPIPathParamRegexp.ReplaceAllString(endpointDef.HTTPPath, "{$1}")
+		pathItem, ok := doc.Paths[path]
+		if !ok {
+			pathItem = openAPIPathItem{}
+			doc.Paths[path] = pathItem
+		}
+		pathItem[strings.ToLower(endpointDef.HTTPMethod.String())] = op
+	}
+	doc.Components = openAPIComponents{
+		Schemas:         w.schemas,
+		SecuritySchemes: securitySchemes,
+	}
+	return doc, nil
+}
+
+func (w *openAPIWriter) operation(serviceName string, endpointDef *types.EndpointDefinition) (*openAPIOperation, error) {
+	op := &openAPIOperation{
+		OperationID: endpointDef.EndpointName,
+		Tags:        []string{serviceName},
+		Description: string(endpointDef.Docs),
+		Deprecated:  endpointDef.Deprecated != "",
+		Responses:   map[string]openAPIResponse{},
+	}
+	if endpointDef.HeaderAuth {
+		op.Security = []map[string][]string{{openAPIBearerAuthScheme: {}}}
+	} else if endpointDef.CookieAuth != nil {
+		op.Security = []map[string][]string{{openAPICookieAuthScheme(*endpointDef.CookieAuth): {}}}
+	}
+	for _, paramDef := range endpointDef.Params {
+		var in string
+		switch paramDef.ParamType {
+		case types.PathParam:
+			in = "path"
+		case types.QueryParam:
+			in = "query"
+		case types.HeaderParam:
+			in = "header"
+		case types.BodyParam:
+			body, err := w.requestBody(paramDef)
+			if err != nil {
+				return nil, err
+			}
+			op.RequestBody = body
+			continue
+		default:
+			return nil, errors.Errorf("unsupported parameter type %v for argument %s", paramDef.ParamType, paramDef.Name)
+		}
+		schema, err := w.schema(paramDef.Type, true)
+		if err != nil {
+			return nil, errors.Wrapf(err, "argument %s", paramDef.Name)
+		}
+		op.Parameters = append(op.Parameters, openAPIParameter{
+			Name:        paramDef.ParamID,
+			In:          in,
+			Description: string(paramDef.Docs),
+			Required:    paramDef.ParamType == types.PathParam || openAPIRequired(paramDef.Type),
+			Schema:      schema,
+		})
+	}
+	if endpointDef.Returns == nil {
+		op.Responses["204"] = openAPIResponse{Description: "Success"}
+	} else {
+		returnType := *endpointDef.Returns
+		mediaType, schema, err := w.contentSchema(returnType)
+		if err != nil {
+			return nil, errors.Wrapf(err, "return type")
+		}
+		op.Responses["200"] = openAPIResponse{
+			Description: "Success",
+			Content:     map[string]openAPIMediaType{mediaType: {Schema: schema}},
+		}
+		if returnType.IsOptional() {
+			op.Responses["204"] = openAPIResponse{Description: "Empty optional"}
+		}
+	}
+	op.Responses["default"] = openAPIResponse{
+		Description: "Conjure error",
+		Content: map[string]openAPIMediaType{
+			openAPIJSONMediaType: {Schema: openAPIRef(openAPISerializableErrorSchema)},
+		},
+	}
+	return op, nil
+}
+
+func (w *openAPIWriter) requestBody(paramDef *types.EndpointArgumentDefinition) (*openAPIRequestBody, error) {
+	mediaType, schema, err := w.contentSchema(paramDef.Type)
+	if err != nil {
+		return nil, errors.Wrapf(err, "argument %s", paramDef.Name)
+	}
+	return &openAPIRequestBody{
+		Description: string(paramDef.Docs),
+		Required:    !paramDef.Type.IsOptional(),
+		Content:     map[string]openAPIMediaType{mediaType: {Schema: schema}},
+	}, nil
+}
+
+// contentSchema returns the media type and schema of a request or response body of the provided type. Binary bodies
+// are sent as raw bytes rather than as base64-encoded JSON strings.
+func (w *openAPIWriter) contentSchema(typ types.Type) (string, *openAPISchema, error) {
+	if typ.IsBinary() {
+		return openAPIBinaryMediaType, &openAPISchema{Type: "string", Format: "binary"}, nil
+	}
+	schema, err := w.schema(typ, true)
+	if err != nil {
+		return "", nil, err
+	}
+	return openAPIJSONMediaType, schema, nil
+}
+
+// schema returns the schema of typ. If unwrapOptional is true, the schema of an optional type is the schema of its
+// item, which is appropriate for values whose presence is described separately (for example, by the required fields
+// of an object). Named types are added to the components of the document and referenced.
+func (w *openAPIWriter) schema(typ types.Type, unwrapOptional bool) (*openAPISchema, error) {
+	switch t := typ.(type) {
+	case types.Any:
+		return &openAPISchema{}, nil
+	case types.Bearertoken, types.String:
+		return &openAPISchema{Type: "string"}, nil
+	case types.Binary:
+		return &openAPISchema{Type: "string", Format: "binary", ContentEncoding: "base64"}, nil
+	case types.Boolean:
+		return &openAPISchema{Type: "boolean"}, nil
+	case types.DateTime:
+		return &openAPISchema{Type: "string", Format: "date-time"}, nil
+	case types.Double:
+		return &openAPISchema{Type: "number", Format: "double"}, nil
+	case types.Integer:
+		return &openAPISchema{Type: "integer", Format: "int32"}, nil
+	case types.RID:
+		return &openAPISchema{Type: "string", Format: "rid"}, nil
+	case types.Safelong:
+		minSafeLong, maxSafeLong := int64(-(1<<53 - 1)), int64(1<<53-1)
+		return &openAPISchema{Type: "integer", Format: "int64", Minimum: &minSafeLong, Maximum: &maxSafeLong}, nil
+	case types.UUID:
+		return &openAPISchema{Type: "string", Format: "uuid"}, nil
+	case *types.Optional:
+		item, err := w.schema(t.Item, false)
+		if err != nil {
+			return nil, err
+		}
+		if unwrapOptional {
+			return item, nil
+		}
+		return &openAPISchema{AnyOf: []*openAPISchema{item, {Type: "null"}}}, nil
+	case *types.List:
+		item, err := w.schema(t.Item, false)
+		if err != nil {
+			return nil, err
+		}
+		return &openAPISchema{Type: "array", Items: item}, nil
+	case *types.Set:
+		item, err := w.schema(t.Item, false)
+		if err != nil {
+			return nil, err
+		}
+		return &openAPISchema{Type: "array", Items: item, UniqueItems: true}, nil
+	case *types.Map:
+		val, err := w.schema(t.Val, false)
+		if err != nil {
+			return nil, err
+		}
+		return &openAPISchema{Type: "object", AdditionalProperties: val}, nil
+	case *types.External:
+		return w.schema(t.Fallback, unwrapOptional)
+	case *types.AliasType:
+		return w.namedSchema(t.Name, t, func() (*openAPISchema, error) {
+			schema, err := w.schema(t.Item, false)
+			if err != nil {
+				return nil, err
+			}
+			return withDescription(schema, t.Docs), nil
+		})
+	case *types.EnumType:
+		return w.namedSchema(t.Name, t, func() (*openAPISchema, error) {
+			schema := &openAPISchema{Type: "string", Description: string(t.Docs), Deprecated: t.Deprecated != ""}
+			for _, value := range t.Values {
+				schema.Enum = append(schema.Enum, value.Name)
+			}
+			return schema, nil
+		})
+	case *types.ObjectType:
+		return w.namedSchema(t.Name, t, func() (*openAPISchema, error) {
+			return w.objectSchema(t.Docs, t.Fields)
+		})
+	case *types.UnionType:
+		return w.namedSchema(t.Name, t, func() (*openAPISchema, error) {
+			return w.unionSchema(t)
+		})
+	default:
+		return nil, errors.Errorf("unsupported type %s", typ)
+	}
+}
+
+// namedSchema adds the schema returned by create to the components of the document, unless it was already added, and
+// returns a reference to it. The schema is registered before create is called so that recursive types terminate.
+func (w *openAPIWriter) namedSchema(name string, typ interface{}, create func() (*openAPISchema, error)) (*openAPISchema, error) {
+	if existing, ok := w.namedTypes[name]; ok {
+		if existing != typ {
+			return nil, errors.Errorf("multiple types are named %s", name)
+		}
+		return openAPIRef(name), nil
+	}
+	w.namedTypes[name] = typ
+	schema, err := create()
+	if err != nil {
+		return nil, errors.Wrapf(err, "type %s", name)
+	}
+	w.schemas[name] = schema
+	return openAPIRef(name), nil
+}
+
+func (w *openAPIWriter) objectSchema(docs types.Docs, fields []*types.Field) (*openAPISchema, error) {
+	schema := &openAPISchema{
+		Type:        "object",
+		Description: string(docs),
+		Properties:  map[string]*openAPISchema{},
+	}
+	for _, field := range fields {
+		fieldSchema, err := w.fieldSchema(field)
+		if err != nil {
+			return nil, err
+		}
+		schema.Properties[field.Name] = fieldSchema
+		if openAPIRequired(field.Type) {
+			schema.Required = append(schema.Required, field.Name)
+		}
+	}
+	return schema, nil
+}
+
+// unionSchema returns a oneOf of one schema per variant. Each variant schema is added to the components of the
+// document as <Union>_<variant> so that the discriminator can map the value of the "type" property to it.
+func (w *openAPIWriter) unionSchema(t *types.UnionType) (*openAPISchema, error) {
+	schema := &openAPISchema{
+		Description: string(t.Docs),
+		Discriminator: &openAPIDiscriminator{
+			PropertyName: openAPIUnionDiscriminator,
+			Mapping:      map[string]string{},
+		},
+	}
+	for _, field := range t.Fields {
+		variantName := t.Name + "_" + field.Name
+		if _, ok := w.namedTypes[variantName]; ok {
+			return nil, errors.Errorf("multiple types are named %s", variantName)
+		}
+		w.namedTypes[variantName] = field
+		fieldSchema, err := w.fieldSchema(field)
+		if err != nil {
+			return nil, err
+		}
+		w.schemas[variantName] = &openAPISchema{
+			Type:     "object",
+			Required: []string{openAPIUnionDiscriminator, field.Name},
+			Properties: map[string]*openAPISchema{
+				openAPIUnionDiscriminator: {Type: "string", Const: field.Name},
+				field.Name:                fieldSchema,
+			},
+		}
+		ref := openAPIRef(variantName)
+		schema.OneOf = append(schema.OneOf, ref)
+		schema.Discriminator.Mapping[field.Name] = ref.Ref
+	}
+	return schema, nil
+}
+
+func (w *openAPIWriter) fieldSchema(field *types.Field) (*openAPISchema, error) {
+	schema, err := w.schema(field.Type, true)
+	if err != nil {
+		return nil, errors.Wrapf(err, "field %s", field.Name)
+	}
+	schema = withDescription(schema, field.Docs)
+	if field.Deprecated != "" {
+		schema = copySchema(schema)
+		schema.Deprecated = true
+	}
+	return schema, nil
+}
+
+// addErrorSchema adds the schema of the SerializableError for errorDef to the components of the document.
+func (w *openAPIWriter) addErrorSchema(errorDef *types.ErrorDefinition) error {
+	_, err := w.namedSchema(errorDef.Name, errorDef, func() (*openAPISchema, error) {
+		params, err := w.objectSchema("", append(append([]*types.Field{}, errorDef.SafeArgs...), errorDef.UnsafeArgs...))
+		if err != nil {
+			return nil, err
+		}
+		return &openAPISchema{
+			Description: string(errorDef.Docs),
+			AllOf: []*openAPISchema{
+				openAPIRef(openAPISerializableErrorSchema),
+				{
+					Type: "object",
+					Properties: map[string]*openAPISchema{
+						"errorCode":  {Type: "string", Const: errorDef.ErrorCode.String()},
+						"errorName":  {Type: "string", Const: string(errorDef.ErrorNamespace) + ":" + errorDef.Name},
+						"parameters": params,
+					},
+				},
+			},
+		}, nil
+	})
+	return err
+}
+
+func openAPISerializableError() *openAPISchema {
+	return &openAPISchema{
+		Type:        "object",
+		Description: "The body of the error responses of Conjure servers.",
+		Required:    []string{"errorCode", "errorName", "errorInstanceId"},
+		Properties: map[string]*openAPISchema{
+			"errorCode":       {Type: "string"},
+			"errorName":       {Type: "string"},
+			"errorInstanceId": {Type: "string", Format: "uuid"},
+			"parameters":      {Type: "object", AdditionalProperties: &openAPISchema{}},
+		},
+	}
+}
+
+// openAPIRequired returns true if a value of the provided type must be present. Optional values may be omitted and
+// collections that are omitted are empty.
+func openAPIRequired(typ types.Type) bool {
+	return !typ.IsOptional() && !typ.IsCollection()
+}
+
+func openAPICookieAuthScheme(cookieName string) string {
+	return "CookieAuth_" + cookieName
+}
+
+func openAPIRef(schemaName string) *openAPISchema {
+	return &openAPISchema{Ref: "#/components/schemas/" + schemaName}
+}
+
+func withDescription(schema *openAPISchema, docs types.Docs) *openAPISchema {
+	if docs == "" {
+		return schema
+	}
+	schema = copySchema(schema)
+	schema.Description = string(docs)
+	return schema
+}
+
+// copySchema returns a shallow copy of schema so that references can be annotated without modifying shared schemas.
+func copySchema(schema *openAPISchema) *openAPISchema {
+	c := *schema
+	return &c
+}
+
+type openAPIDocument struct {
+	OpenAPI string `json:"openapi"`
+	// Generated is an extension that identifies documents written by the generator (see isGeneratedFile).
+	Generated  string                     `json:"x-generated"`
+	Info       openAPIInfo                `json:"info"`
+	Paths      map[string]openAPIPathItem `json:"paths"`
+	Components openAPIComponents          `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// openAPIPathItem maps lower-case HTTP methods to operations.
+type openAPIPathItem map[string]*openAPIOperation
+
+type openAPIOperation struct {
+	OperationID string                     `json:"operationId"`
+	Tags        []string                   `json:"tags,omitempty"`
+	Description string                     `json:"description,omitempty"`
+	Deprecated  bool                       `json:"deprecated,omitempty"`
+	Security    []map[string][]string      `json:"security,omitempty"`
+	Parameters  []openAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name        string         `json:"name"`
+	In          string         `json:"in"`
+	Description string         `json:"description,omitempty"`
+	Required    bool           `json:"required,omitempty"`
+	Schema      *openAPISchema `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Description string                      `json:"description,omitempty"`
+	Required    bool                        `json:"required,omitempty"`
+	Content     map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `json:"schema"`
+}
+
+type openAPIComponents struct {
+	Schemas         map[string]*openAPISchema        `json:"schemas,omitempty"`
+	SecuritySchemes map[string]openAPISecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+type openAPISecurityScheme struct {
+	Type   string `json:"type"`
+	Scheme string `json:"scheme,omitempty"`
+	In     string `json:"in,omitempty"`
+	Name   string `json:"name,omitempty"`
+}
+
+type openAPIDiscriminator struct {
+	PropertyName string            `json:"propertyName"`
+	Mapping      map[string]string `json:"mapping,omitempty"`
+}
+
+type openAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	ContentEncoding      string                    `json:"contentEncoding,omitempty"`
+	Description          string                    `json:"description,omitempty"`
+	Deprecated           bool                      `json:"deprecated,omitempty"`
+	Const                string                    `json:"const,omitempty"`
+	Enum                 []string                  `json:"enum,omitempty"`
+	Minimum              *int64                    `json:"minimum,omitempty"`
+	Maximum              *int64                    `json:"maximum,omitempty"`
+	Items                *openAPISchema            `json:"items,omitempty"`
+	UniqueItems          bool                      `json:"uniqueItems,omitempty"`
+	Required             []string                  `json:"required,omitempty"`
+	Properties           map[string]*openAPISchema `json:"properties,omitempty"`
+	AdditionalProperties *openAPISchema            `json:"additionalProperties,omitempty"`
+	AllOf                []*openAPISchema          `json:"allOf,omitempty"`
+	AnyOf                []*openAPISchema          `json:"anyOf,omitempty"`
+	OneOf                []*openAPISchema          `json:"oneOf,omitempty"`
+	Discriminator        *openAPIDiscriminator     `json:"discriminator,omitempty"`
+}
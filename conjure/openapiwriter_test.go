@@ -0,0 +1,188 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/palantir/conjure-go/v6/conjure-api/conjure/spec"
+	"github.com/palantir/conjure-go/v6/conjure/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func TestWriteOpenAPIDocument(t *testing.T) {
+	object := &types.ObjectType{
+		Name: "Widget",
+		Fields: []*types.Field{
+			{Name: "id", Type: types.UUID{}},
+			{Name: "size", Type: &types.Optional{Item: types.Safelong{}}},
+			{Name: "labels", Type: &types.List{Item: types.String{}}},
+		},
+	}
+	union := &types.UnionType{
+		Name: "Shape",
+		Fields: []*types.Field{
+			{Name: "widget", Type: object},
+			{Name: "radius", Type: types.Double{}},
+		},
+	}
+	serviceDef := &types.ServiceDefinition{
+		Name: "WidgetService",
+		Endpoints: []*types.EndpointDefinition{
+			{
+				EndpointName: "getShape",
+				HTTPMethod:   spec.New_HttpMethod(spec.HttpMethod_GET),
+				HTTPPath:     "/shapes/{shapeId}/{path:.+}",
+				HeaderAuth:   true,
+				Params: []*types.EndpointArgumentDefinition{
+					{Name: "shapeId", Type: types.String{}, ParamType: types.PathParam, ParamID: "shapeId"},
+					{Name: "path", Type: types.String{}, ParamType: types.PathParam, ParamID: "path"},
+					{Name: "limit", Type: &types.Optional{Item: types.Integer{}}, ParamType: types.QueryParam, ParamID: "limit"},
+				},
+				Returns: typePtr(&types.Optional{Item: union}),
+			},
+			{
+				EndpointName: "putWidget",
+				HTTPMethod:   spec.New_HttpMethod(spec.HttpMethod_PUT),
+				HTTPPath:     "/widgets",
+				Params: []*types.EndpointArgumentDefinition{
+					{Name: "widget", Type: object, ParamType: types.BodyParam, ParamID: "widget"},
+				},
+			},
+		},
+	}
+	errorDef := &types.ErrorDefinition{
+		Name:           "WidgetNotFound",
+		ErrorNamespace: "Widgets",
+		ErrorCode:      spec.New_ErrorCode(spec.ErrorCode_NOT_FOUND),
+		SafeArgs:       []*types.Field{{Name: "widgetId", Type: types.UUID{}}},
+	}
+
+	out, err := writeOpenAPIDocument(serviceDef, []*types.ErrorDefinition{errorDef}, OpenAPIFormatJSON)
+	require.NoError(t, err)
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(out, &doc))
+	assert.Equal(t, "3.1.0", doc["openapi"])
+	assert.Equal(t, generatedFileHeader, doc["x-generated"])
+
+	var getShape map[string]interface{}
+	require.NoError(t, jsonPath(doc, &getShape, "paths", "/shapes/{shapeId}/{path}", "get"))
+	assert.Equal(t, "getShape", getShape["operationId"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"BearerAuth": []interface{}{}}}, getShape["security"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "shapeId", "in": "path", "required": true, "schema": map[string]interface{}{"type": "string"}},
+		map[string]interface{}{"name": "path", "in": "path", "required": true, "schema": map[string]interface{}{"type": "string"}},
+		map[string]interface{}{"name": "limit", "in": "query", "schema": map[string]interface{}{"type": "integer", "format": "int32"}},
+	}, getShape["parameters"])
+	var getShapeOK map[string]interface{}
+	require.NoError(t, jsonPath(getShape, &getShapeOK, "responses", "200", "content", "application/json", "schema"))
+	assert.Equal(t, map[string]interface{}{"$ref": "#/components/schemas/Shape"}, getShapeOK)
+	assert.Contains(t, getShape["responses"], "204")
+
+	var schemas map[string]interface{}
+	require.NoError(t, jsonPath(doc, &schemas, "components", "schemas"))
+	assert.Equal(t, map[string]interface{}{
+		"type":     "object",
+		"required": []interface{}{"id"},
+		"properties": map[string]interface{}{
+			"id":     map[string]interface{}{"type": "string", "format": "uuid"},
+			"size":   map[string]interface{}{"type": "integer", "format": "int64", "minimum": float64(-(1<<53 - 1)), "maximum": float64(1<<53 - 1)},
+			"labels": map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}},
+		},
+	}, schemas["Widget"])
+	assert.Equal(t, map[string]interface{}{
+		"oneOf": []interface{}{
+			map[string]interface{}{"$ref": "#/components/schemas/Shape_widget"},
+			map[string]interface{}{"$ref": "#/components/schemas/Shape_radius"},
+		},
+		"discriminator": map[string]interface{}{
+			"propertyName": "type",
+			"mapping": map[string]interface{}{
+				"widget": "#/components/schemas/Shape_widget",
+				"radius": "#/components/schemas/Shape_radius",
+			},
+		},
+	}, schemas["Shape"])
+	assert.Equal(t, map[string]interface{}{
+		"type":     "object",
+		"required": []interface{}{"type", "radius"},
+		"properties": map[string]interface{}{
+			"type":   map[string]interface{}{"type": "string", "const": "radius"},
+			"radius": map[string]interface{}{"type": "number", "format": "double"},
+		},
+	}, schemas["Shape_radius"])
+	var errorName map[string]interface{}
+	require.NoError(t, jsonPath(schemas, &errorName, "WidgetNotFound", "allOf", "1", "properties", "errorName"))
+	assert.Equal(t, "Widgets:WidgetNotFound", errorName["const"])
+	assert.Contains(t, schemas, "SerializableError")
+
+	yamlOut, err := writeOpenAPIDocument(serviceDef, []*types.ErrorDefinition{errorDef}, OpenAPIFormatYAML)
+	require.NoError(t, err)
+	var yamlDoc yaml.MapSlice
+	require.NoError(t, yaml.Unmarshal(yamlOut, &yamlDoc))
+	require.NotEmpty(t, yamlDoc)
+	assert.Equal(t, yaml.MapItem{Key: "openapi", Value: "3.1.0"}, yamlDoc[0])
+}
+
+func TestWriteOpenAPIDocumentDuplicateNames(t *testing.T) {
+	serviceDef := &types.ServiceDefinition{
+		Name: "WidgetService",
+		Endpoints: []*types.EndpointDefinition{
+			{
+				EndpointName: "getWidget",
+				HTTPMethod:   spec.New_HttpMethod(spec.HttpMethod_GET),
+				HTTPPath:     "/widget",
+				Returns:      typePtr(&types.ObjectType{Name: "Widget"}),
+			},
+			{
+				EndpointName: "getOtherWidget",
+				HTTPMethod:   spec.New_HttpMethod(spec.HttpMethod_GET),
+				HTTPPath:     "/other-widget",
+				Returns:      typePtr(&types.EnumType{Name: "Widget"}),
+			},
+		},
+	}
+	_, err := writeOpenAPIDocument(serviceDef, nil, OpenAPIFormatJSON)
+	assert.EqualError(t, err, "failed to create OpenAPI document for service WidgetService: endpoint getOtherWidget: return type: multiple types are named Widget")
+}
+
+func typePtr(t types.Type) *types.Type {
+	return &t
+}
+
+// jsonPath sets out to the value at the provided path of object keys or array indexes of the unmarshalled JSON in.
+func jsonPath(in interface{}, out interface{}, path ...string) error {
+	current := in
+	for _, key := range path {
+		switch v := current.(type) {
+		case map[string]interface{}:
+			current = v[key]
+		case []interface{}:
+			var idx int
+			if err := json.Unmarshal([]byte(key), &idx); err != nil {
+				return err
+			}
+			current = v[idx]
+		}
+	}
+	b, err := json.Marshal(current)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
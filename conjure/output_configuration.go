@@ -14,9 +14,171 @@
 
 package conjure
 
+import (
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/palantir/conjure-go/v6/conjure/types"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// OutputConfiguration configures the generator. It can be read from a YAML or JSON configuration file using
+// OutputConfigurationFromFile; the keys of the file match the flags of the conjure-go command.
 type OutputConfiguration struct {
-	GenerateFuncsVisitor bool
-	GenerateServer       bool
-	GenerateCLI          bool
-	OutputDir            string
+	GenerateFuncsVisitor         bool   `yaml:"funcs-visitor,omitempty"`
+	GenerateServer               bool   `yaml:"server,omitempty"`
+	GenerateCLI                  bool   `yaml:"cli,omitempty"`
+	GenerateFakes                bool   `yaml:"fakes,omitempty"`
+	GenerateTestPairs            bool   `yaml:"test-pairs,omitempty"`
+	GenerateOpenAPI              bool   `yaml:"openapi,omitempty"`
+	GenerateValidation           bool   `yaml:"validation,omitempty"`
+	GenerateBuilders             bool   `yaml:"builders,omitempty"`
+	GenerateHash                 bool   `yaml:"hash,omitempty"`
+	GenerateSetTypes             bool   `yaml:"set-types,omitempty"`
+	GenerateCBOR                 bool   `yaml:"cbor,omitempty"`
+	GenerateAuthValidator        bool   `yaml:"auth-validator,omitempty"`
+	GenerateEndpointInterceptors bool   `yaml:"endpoint-interceptors,omitempty"`
+	GenerateEndpointMetadata     bool   `yaml:"endpoint-metadata,omitempty"`
+	GenerateErrorMapping         bool   `yaml:"error-mapping,omitempty"`
+	GenerateClientIterators      bool   `yaml:"client-iterators,omitempty"`
+	GenerateUnionHelpers         bool   `yaml:"union-helpers,omitempty"`
+	OutputDir                    string `yaml:"output,omitempty"`
+	// OpenAPIFormat is the format of the OpenAPI documents written if GenerateOpenAPI is true: OpenAPIFormatJSON (the
+	// default) or OpenAPIFormatYAML.
+	OpenAPIFormat string `yaml:"openapi-format,omitempty"`
+	// Packages overrides the configuration for the Conjure packages that match their patterns.
+	Packages []PackageConfiguration `yaml:"packages,omitempty"`
+	// PackagePaths overrides the Go package paths of the Conjure packages that match their rules.
+	PackagePaths []types.PackagePathRule `yaml:"package-paths,omitempty"`
+	// ExternalPackages maps Conjure packages to the import paths of existing Go packages that contain the generated
+	// code for them. References to these packages use the existing Go packages and no files are generated for them.
+	ExternalPackages map[string]string `yaml:"external-packages,omitempty"`
+	// KeepStaleFiles disables the removal of "*.conjure.go" files that were written by the generator into the
+	// directory of a generated package but are no longer generated.
+	KeepStaleFiles bool `yaml:"keep-stale-files,omitempty"`
+}
+
+// PackageConfiguration overrides the OutputConfiguration for every Conjure package that matches Pattern.
+// Unset fields inherit the value of the enclosing OutputConfiguration. If multiple entries match a package,
+// they are applied in order, so later entries take precedence over earlier ones.
+type PackageConfiguration struct {
+	// Pattern is matched against Conjure package names using path.Match. Because Conjure packages do not contain
+	// slashes, "*" matches any sequence of characters: for example, "com.palantir.*" matches "com.palantir.foo.bar".
+	Pattern                      string `yaml:"pattern"`
+	GenerateFuncsVisitor         *bool  `yaml:"funcs-visitor,omitempty"`
+	GenerateServer               *bool  `yaml:"server,omitempty"`
+	GenerateCLI                  *bool  `yaml:"cli,omitempty"`
+	GenerateFakes                *bool  `yaml:"fakes,omitempty"`
+	GenerateTestPairs            *bool  `yaml:"test-pairs,omitempty"`
+	GenerateOpenAPI              *bool  `yaml:"openapi,omitempty"`
+	GenerateValidation           *bool  `yaml:"validation,omitempty"`
+	GenerateBuilders             *bool  `yaml:"builders,omitempty"`
+	GenerateHash                 *bool  `yaml:"hash,omitempty"`
+	GenerateSetTypes             *bool  `yaml:"set-types,omitempty"`
+	GenerateCBOR                 *bool  `yaml:"cbor,omitempty"`
+	GenerateAuthValidator        *bool  `yaml:"auth-validator,omitempty"`
+	GenerateEndpointInterceptors *bool  `yaml:"endpoint-interceptors,omitempty"`
+	GenerateEndpointMetadata     *bool  `yaml:"endpoint-metadata,omitempty"`
+	GenerateErrorMapping         *bool  `yaml:"error-mapping,omitempty"`
+	GenerateClientIterators      *bool  `yaml:"client-iterators,omitempty"`
+	GenerateUnionHelpers         *bool  `yaml:"union-helpers,omitempty"`
+	// OutputDir is the base directory into which the matching packages are written (the package path
+	// is appended to it in the same manner as for OutputConfiguration.OutputDir).
+	OutputDir string `yaml:"output,omitempty"`
+}
+
+// ForPackage returns the configuration for the provided Conjure package with all matching package overrides applied.
+// The returned configuration has no package overrides.
+func (cfg OutputConfiguration) ForPackage(conjurePkg string) OutputConfiguration {
+	pkgCfg := cfg
+	pkgCfg.Packages = nil
+	for _, override := range cfg.Packages {
+		if matched, _ := path.Match(override.Pattern, conjurePkg); !matched {
+			continue
+		}
+		for _, field := range []struct {
+			override *bool
+			dst      *bool
+		}{
+			{override: override.GenerateFuncsVisitor, dst: &pkgCfg.GenerateFuncsVisitor},
+			{override: override.GenerateServer, dst: &pkgCfg.GenerateServer},
+			{override: override.GenerateCLI, dst: &pkgCfg.GenerateCLI},
+			{override: override.GenerateFakes, dst: &pkgCfg.GenerateFakes},
+			{override: override.GenerateTestPairs, dst: &pkgCfg.GenerateTestPairs},
+			{override: override.GenerateOpenAPI, dst: &pkgCfg.GenerateOpenAPI},
+			{override: override.GenerateValidation, dst: &pkgCfg.GenerateValidation},
+			{override: override.GenerateBuilders, dst: &pkgCfg.GenerateBuilders},
+			{override: override.GenerateHash, dst: &pkgCfg.GenerateHash},
+			{override: override.GenerateSetTypes, dst: &pkgCfg.GenerateSetTypes},
+			{override: override.GenerateCBOR, dst: &pkgCfg.GenerateCBOR},
+			{override: override.GenerateAuthValidator, dst: &pkgCfg.GenerateAuthValidator},
+			{override: override.GenerateEndpointInterceptors, dst: &pkgCfg.GenerateEndpointInterceptors},
+			{override: override.GenerateEndpointMetadata, dst: &pkgCfg.GenerateEndpointMetadata},
+			{override: override.GenerateErrorMapping, dst: &pkgCfg.GenerateErrorMapping},
+			{override: override.GenerateClientIterators, dst: &pkgCfg.GenerateClientIterators},
+			{override: override.GenerateUnionHelpers, dst: &pkgCfg.GenerateUnionHelpers},
+		} {
+			if field.override != nil {
+				*field.dst = *field.override
+			}
+		}
+		if override.OutputDir != "" {
+			pkgCfg.OutputDir = override.OutputDir
+		}
+	}
+	return pkgCfg
+}
+
+func (cfg OutputConfiguration) validate() error {
+	switch cfg.OpenAPIFormat {
+	case "", OpenAPIFormatJSON, OpenAPIFormatYAML:
+	default:
+		return errors.Errorf("invalid OpenAPI format %q: must be %q or %q", cfg.OpenAPIFormat, OpenAPIFormatJSON, OpenAPIFormatYAML)
+	}
+	for _, override := range cfg.Packages {
+		if _, err := path.Match(override.Pattern, ""); err != nil {
+			return errors.Wrapf(err, "invalid package pattern %q", override.Pattern)
+		}
+	}
+	return nil
+}
+
+// OutputConfigurationFromFile reads the YAML or JSON configuration file at the provided path (see
+// OutputConfigurationFromBytes). Relative output directories in the file, including those of package overrides, are
+// resolved against the directory that contains the file rather than the working directory.
+func OutputConfigurationFromFile(file string) (OutputConfiguration, error) {
+	bytes, err := os.ReadFile(file)
+	if err != nil {
+		return OutputConfiguration{}, errors.Wrapf(err, "failed to read output configuration from file %s", file)
+	}
+	cfg, err := OutputConfigurationFromBytes(bytes)
+	if err != nil {
+		return OutputConfiguration{}, err
+	}
+	resolveOutputDir := func(outputDir string) string {
+		if outputDir == "" || filepath.IsAbs(outputDir) {
+			return outputDir
+		}
+		return filepath.Join(filepath.Dir(file), outputDir)
+	}
+	cfg.OutputDir = resolveOutputDir(cfg.OutputDir)
+	for i := range cfg.Packages {
+		cfg.Packages[i].OutputDir = resolveOutputDir(cfg.Packages[i].OutputDir)
+	}
+	return cfg, nil
+}
+
+// OutputConfigurationFromBytes parses the provided YAML or JSON bytes as an OutputConfiguration.
+// Unknown keys are rejected.
+func OutputConfigurationFromBytes(cfgBytes []byte) (OutputConfiguration, error) {
+	var cfg OutputConfiguration
+	if err := yaml.UnmarshalStrict(cfgBytes, &cfg); err != nil {
+		return OutputConfiguration{}, errors.Wrapf(err, "failed to unmarshal output configuration")
+	}
+	if err := cfg.validate(); err != nil {
+		return OutputConfiguration{}, err
+	}
+	return cfg, nil
 }
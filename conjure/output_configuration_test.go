@@ -0,0 +1,212 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/palantir/conjure-go/v6/conjure/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputConfigurationFromBytes(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		in       string
+		expected OutputConfiguration
+		err      string
+	}{
+		{
+			name: "YAML",
+			in: `
+output: generated
+server: true
+cli: true
+funcs-visitor: true
+fakes: true
+test-pairs: true
+keep-stale-files: true
+openapi: true
+openapi-format: yaml
+validation: true
+builders: true
+hash: true
+set-types: true
+cbor: true
+auth-validator: true
+endpoint-interceptors: true
+endpoint-metadata: true
+error-mapping: true
+client-iterators: true
+union-helpers: true
+`,
+			expected: OutputConfiguration{
+				GenerateFuncsVisitor:         true,
+				GenerateServer:               true,
+				GenerateCLI:                  true,
+				GenerateFakes:                true,
+				GenerateTestPairs:            true,
+				OutputDir:                    "generated",
+				KeepStaleFiles:               true,
+				GenerateOpenAPI:              true,
+				OpenAPIFormat:                OpenAPIFormatYAML,
+				GenerateValidation:           true,
+				GenerateBuilders:             true,
+				GenerateHash:                 true,
+				GenerateSetTypes:             true,
+				GenerateCBOR:                 true,
+				GenerateAuthValidator:        true,
+				GenerateEndpointInterceptors: true,
+				GenerateEndpointMetadata:     true,
+				GenerateErrorMapping:         true,
+				GenerateClientIterators:      true,
+				GenerateUnionHelpers:         true,
+			},
+		},
+		{
+			name: "JSON",
+			in:   `{"output": "generated", "server": true}`,
+			expected: OutputConfiguration{
+				GenerateServer: true,
+				OutputDir:      "generated",
+			},
+		},
+		{
+			name: "package overrides",
+			in: `
+server: true
+packages:
+  - pattern: com.palantir.*
+    server: false
+    output: other
+`,
+			expected: OutputConfiguration{
+				GenerateServer: true,
+				Packages: []PackageConfiguration{
+					{Pattern: "com.palantir.*", GenerateServer: boolPtr(false), OutputDir: "other"},
+				},
+			},
+		},
+		{
+			name: "package paths",
+			in: `
+package-paths:
+  - conjure-package: com.company.product
+    path: product
+  - conjure-package: com.company.shared
+    import-path: github.com/company/shared/conjure
+`,
+			expected: OutputConfiguration{
+				PackagePaths: []types.PackagePathRule{
+					{ConjurePackage: "com.company.product", Path: "product"},
+					{ConjurePackage: "com.company.shared", ImportPath: "github.com/company/shared/conjure"},
+				},
+			},
+		},
+		{
+			name: "invalid package pattern",
+			in: `
+packages:
+  - pattern: "com.palantir.["
+`,
+			err: `invalid package pattern "com.palantir.["`,
+		},
+		{
+			name: "invalid OpenAPI format",
+			in:   `openapi-format: xml`,
+			err:  `invalid OpenAPI format "xml": must be "json" or "yaml"`,
+		},
+		{
+			name: "unknown key",
+			in:   `servers: true`,
+			err:  "failed to unmarshal output configuration",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := OutputConfigurationFromBytes([]byte(tc.in))
+			if tc.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, cfg)
+		})
+	}
+}
+
+func TestOutputConfigurationFromFile(t *testing.T) {
+	dir := t.TempDir()
+	absOutputDir := filepath.Join(dir, "abs")
+	file := filepath.Join(dir, "config", "conjure.yml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(file), 0755))
+	require.NoError(t, os.WriteFile(file, []byte(`output: generated
+server: true
+packages:
+  - pattern: com.palantir.foo.*
+    output: ../foo
+  - pattern: com.palantir.bar.*
+    output: `+absOutputDir+`
+  - pattern: com.palantir.baz.*
+    cli: true
+`), 0644))
+
+	cfg, err := OutputConfigurationFromFile(file)
+	require.NoError(t, err)
+	// relative output directories are resolved against the directory of the file
+	assert.Equal(t, OutputConfiguration{
+		GenerateServer: true,
+		OutputDir:      filepath.Join(dir, "config", "generated"),
+		Packages: []PackageConfiguration{
+			{Pattern: "com.palantir.foo.*", OutputDir: filepath.Join(dir, "foo")},
+			{Pattern: "com.palantir.bar.*", OutputDir: absOutputDir},
+			{Pattern: "com.palantir.baz.*", GenerateCLI: boolPtr(true)},
+		},
+	}, cfg)
+
+	_, err = OutputConfigurationFromFile(filepath.Join(dir, "missing.yml"))
+	assert.Error(t, err)
+}
+
+func TestOutputConfigurationForPackage(t *testing.T) {
+	cfg := OutputConfiguration{
+		GenerateServer: true,
+		OutputDir:      "out",
+		Packages: []PackageConfiguration{
+			{Pattern: "com.palantir.*", GenerateServer: boolPtr(false), GenerateCLI: boolPtr(true)},
+			{Pattern: "com.palantir.foo.*", GenerateServer: boolPtr(true), OutputDir: "foo"},
+		},
+	}
+	assert.Equal(t, OutputConfiguration{
+		GenerateServer: true,
+		OutputDir:      "out",
+	}, cfg.ForPackage("com.example.api"))
+	assert.Equal(t, OutputConfiguration{
+		GenerateCLI: true,
+		OutputDir:   "out",
+	}, cfg.ForPackage("com.palantir.bar"))
+	assert.Equal(t, OutputConfiguration{
+		GenerateServer: true,
+		GenerateCLI:    true,
+		OutputDir:      "foo",
+	}, cfg.ForPackage("com.palantir.foo.api"))
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
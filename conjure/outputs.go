@@ -26,9 +26,15 @@ import (
 	"github.com/pkg/errors"
 )
 
+// generatedFileHeader is the comment on the first line of every Go file written by the generator. It identifies the
+// files that are owned by the generator.
+const generatedFileHeader = "This file was generated by Conjure and should not be manually edited."
+
 type OutputFile struct {
 	absPath string
 	file    *jen.File
+	// content is the content of a file that is not a Go file. Only used if file is nil.
+	content []byte
 }
 
 func (f *OutputFile) AbsPath() string {
@@ -50,7 +56,10 @@ func (f *OutputFile) Write() error {
 }
 
 func (f *OutputFile) Render() ([]byte, error) {
-	f.file.HeaderComment("This file was generated by Conjure and should not be manually edited.")
+	if f.file == nil {
+		return f.content, nil
+	}
+	f.file.HeaderComment(generatedFileHeader)
 
 	buf := &bytes.Buffer{}
 	if err := f.file.Render(buf); err != nil {
@@ -46,20 +46,264 @@ const (
 
 	// Request
 	reqName = "req"
+
+	// Validation
+	validateParamFuncName  = "validateRequestParam"
+	validateFieldPathParam = "fieldPath"
+
+	// Auth validation
+	authValidatorType       = "AuthValidator"
+	authValidatorParamFunc  = "WithAuthValidator"
+	authValidatorCtxKeyType = "authValidatorContextKey"
+	validateAuthFuncName    = "validateRequestAuth"
+
+	// Endpoint interceptors
+	interceptorType           = "EndpointInterceptor"
+	interceptorParamFunc      = "WithEndpointInterceptor"
+	interceptorsCtxKeyType    = "endpointInterceptorsContextKey"
+	interceptEndpointFuncName = "interceptEndpoint"
+	endpointInfoType          = "EndpointInfo"
+	authCtxVarName            = "authCtx"
+
+	// Streaming
+	serverStreamingTag   = "server-streaming"
+	streamWriterVarName  = "writeItem"
+	arrayWriterVarName   = "arrayWriter"
+	jsonArrayWriterType  = "jsonArrayWriter"
+	jsonArrayWriterWrite = "writeElem"
+	jsonArrayWriterClose = "close"
+	jsonArrayWriterFail  = "fail"
+	streamedItemVarName  = "item"
 )
 
 var (
 	reqCtxExpr = jen.Id(reqName).Dot("Context").Call()
 )
 
-func writeServerType(file *jen.Group, serviceDef *types.ServiceDefinition) {
-	file.Add(astForServiceInterface(serviceDef, false, true))
-	file.Add(astForRouteRegistration(serviceDef))
+// serverOptions configures the server code written by writeServerType.
+type serverOptions struct {
+	// validateParams is true if the handlers validate decoded parameters using the function written by
+	// writeServerValidateFunc.
+	validateParams bool
+	// validateAuth is true if the handlers of authenticated endpoints pass the parsed token to the function written by
+	// writeServerAuthValidator.
+	validateAuth bool
+	// interceptEndpoints is true if the handlers are registered using the function written by
+	// writeServerEndpointInterceptor.
+	interceptEndpoints bool
+	// mapErrors is true if the errors returned by the handlers are mapped using the function written by
+	// writeServerErrorMapper.
+	mapErrors bool
+}
+
+// writeServerType writes the server interface, route registration and handlers of serviceDef as configured by opts.
+// Request bodies are decoded using the methods and helpers written by jw. If cw is non-nil, the handlers also accept
+// and return CBOR bodies for endpoints that negotiate CBOR.
+func writeServerType(file *jen.Group, serviceDef *types.ServiceDefinition, opts serverOptions, jw *jsonWriter, cw *cborWriter) {
+	file.Add(astForServiceInterface(serviceDef, false, true, false))
+	file.Add(astForRouteRegistration(serviceDef, opts))
 	file.Add(astForHandlerStructDecl(serviceDef.Name))
-	file.Add(astForHandlerMethods(serviceDef))
+	file.Add(astForHandlerMethods(serviceDef, opts, jw, cw))
+}
+
+// writeServerValidateFunc writes the function called by handlers to validate decoded parameters.
+// It must be written once per file that contains handlers which validate their parameters.
+func writeServerValidateFunc(file *jen.Group) {
+	file.Commentf("%s returns a Conjure InvalidArgument error with the provided field path as the safe %q", validateParamFuncName, validateFieldPathParam).Line().
+		Comment("parameter if value implements Validate() error and its Validate method returns an error. Implement").Line().
+		Comment("Validate on Conjure types (in a non-generated file of their package) to reject invalid requests before").Line().
+		Comment("they are passed to the server implementation.").Line().
+		Func().Id(validateParamFuncName).
+		Params(jen.Id("fieldPath").String(), jen.Id("value").Interface()).
+		Params(jen.Error()).
+		Block(
+			jen.List(jen.Id("validator"), jen.Id("ok")).Op(":=").Id("value").Assert(jen.Interface(jen.Id("Validate").Params().Error())),
+			jen.If(jen.Op("!").Id("ok")).Block(jen.Return(jen.Nil())),
+			jen.If(jen.Err().Op(":=").Id("validator").Dot("Validate").Call(), jen.Err().Op("!=").Nil()).Block(
+				jen.Return(snip.CGRErrorsWrapWithInvalidArgument().Call(
+					jen.Err(),
+					snip.WparamsNewSafeParam().Call(jen.Lit(validateFieldPathParam), jen.Id("fieldPath")),
+				)),
+			),
+			jen.Return(jen.Nil()),
+		)
+}
+
+// writeServerEndpointInfo writes the EndpointInfo type that describes the endpoint of a request to the AuthValidator and
+// EndpointInterceptor functions. It must be written once per file that contains either of them.
+func writeServerEndpointInfo(file *jen.Group) {
+	file.Commentf("%s describes the Conjure endpoint that handles a request.", endpointInfoType).Line().
+		Type().Id(endpointInfoType).Struct(
+		jen.Comment("Service is the name of the Conjure service of the endpoint."),
+		jen.Id("Service").String(),
+		jen.Comment("Name is the name of the endpoint."),
+		jen.Id("Name").String(),
+		jen.Comment("HTTPMethod is the HTTP method of the endpoint."),
+		jen.Id("HTTPMethod").String(),
+		jen.Comment("HTTPPath is the path template of the endpoint."),
+		jen.Id("HTTPPath").String(),
+		jen.Comment("AuthCookie is the name of the cookie that contains the token of the request. It is empty if the token is"),
+		jen.Comment("provided in the Authorization header or the endpoint does not require authentication."),
+		jen.Id("AuthCookie").String(),
+		jen.Comment("Tags are the tags of the endpoint."),
+		jen.Id("Tags").Index().String(),
+		jen.Comment("Markers are the names of the markers of the endpoint, such as \"com.palantir.logsafe.Safe\"."),
+		jen.Id("Markers").Index().String(),
+	)
+}
+
+// astForEndpointInfo returns an EndpointInfo literal that describes endpointDef.
+func astForEndpointInfo(serviceName string, endpointDef *types.EndpointDefinition) *jen.Statement {
+	return jen.Id(endpointInfoType).ValuesFunc(func(values *jen.Group) {
+		values.Id("Service").Op(":").Lit(serviceName)
+		values.Id("Name").Op(":").Lit(endpointDef.EndpointName)
+		values.Id("HTTPMethod").Op(":").Lit(endpointDef.HTTPMethod.String())
+		values.Id("HTTPPath").Op(":").Lit(endpointDef.HTTPPath)
+		if endpointDef.CookieAuth != nil {
+			values.Id("AuthCookie").Op(":").Lit(*endpointDef.CookieAuth)
+		}
+		if tags := astForStringSlice(endpointDef.Tags); tags != nil {
+			values.Id("Tags").Op(":").Add(tags)
+		}
+		if markers := astForMarkerNames(endpointDef.Markers); markers != nil {
+			values.Id("Markers").Op(":").Add(markers)
+		}
+	})
+}
+
+// markerName returns the Conjure name of marker: the qualified name for imported types, which most markers are,
+// and the type name otherwise.
+func markerName(marker types.Type) string {
+	switch t := marker.(type) {
+	case *types.External:
+		return t.Spec.Package + "." + t.Spec.Name
+	case *types.AliasType:
+		return t.Name
+	default:
+		return marker.String()
+	}
+}
+
+// writeServerAuthValidator writes the AuthValidator type, the WithAuthValidator route parameter and the function called
+// by handlers to validate parsed tokens. It must be written once per file that contains handlers which validate their
+// tokens, together with writeServerEndpointInfo.
+func writeServerAuthValidator(file *jen.Group) {
+	file.Commentf("%s validates the token of a request to an authenticated endpoint before the endpoint implementation is", authValidatorType).Line().
+		Comment("called. The returned context is passed to the implementation, which allows validators to store information about").Line().
+		Comment("the authenticated caller. Requests are rejected if the validator returns an error: Conjure errors are returned").Line().
+		Comment("as-is, other errors are returned as PermissionDenied errors.").Line().
+		Type().Id(authValidatorType).Func().
+		Params(jen.Id("ctx").Add(snip.Context()), jen.Id("token").Add(snip.BearerTokenToken()), jen.Id("endpoint").Id(endpointInfoType)).
+		Params(snip.Context(), jen.Error())
+	file.Commentf("%s returns a route parameter that configures the handlers registered by the RegisterRoutes", authValidatorParamFunc).Line().
+		Comment("functions of this package to validate the tokens of requests using validator.").Line().
+		Func().Id(authValidatorParamFunc).
+		Params(jen.Id("validator").Id(authValidatorType)).
+		Params(snip.WrouterRouteParam()).
+		Block(
+			jen.Return(snip.WrouterRouteMiddleware().Call(
+				jen.Func().
+					Params(
+						jen.Id(responseWriterVarName).Add(snip.HTTPResponseWriter()),
+						jen.Id(reqName).Op("*").Add(snip.HTTPRequest()),
+						jen.Id("reqVals").Add(snip.WrouterRequestVals()),
+						jen.Id("next").Add(snip.WrouterRouteRequestHandler()),
+					).
+					Block(
+						jen.Id("ctx").Op(":=").Add(snip.ContextWithValue()).Call(reqCtxExpr.Clone(), jen.Id(authValidatorCtxKeyType).Values(), jen.Id("validator")),
+						jen.Id("next").Call(jen.Id(responseWriterVarName), jen.Id(reqName).Dot("WithContext").Call(jen.Id("ctx")), jen.Id("reqVals")),
+					),
+			)),
+		)
+	file.Type().Id(authValidatorCtxKeyType).Struct()
+	file.Commentf("%s calls the %s configured using %s, if any, and returns the context", validateAuthFuncName, authValidatorType, authValidatorParamFunc).Line().
+		Comment("with which the endpoint implementation is called.").Line().
+		Func().Id(validateAuthFuncName).
+		Params(jen.Id(reqName).Op("*").Add(snip.HTTPRequest()), jen.Id("token").Add(snip.BearerTokenToken()), jen.Id("endpoint").Id(endpointInfoType)).
+		Params(snip.Context(), jen.Error()).
+		Block(
+			jen.List(jen.Id("validator"), jen.Id("ok")).Op(":=").Add(reqCtxExpr.Clone()).Dot("Value").Call(jen.Id(authValidatorCtxKeyType).Values()).Assert(jen.Id(authValidatorType)),
+			jen.If(jen.Op("!").Id("ok").Op("||").Id("validator").Op("==").Nil()).Block(jen.Return(reqCtxExpr.Clone(), jen.Nil())),
+			jen.List(jen.Id("ctx"), jen.Err()).Op(":=").Id("validator").Call(reqCtxExpr.Clone(), jen.Id("token"), jen.Id("endpoint")),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.If(snip.CGRErrorsGetConjureError().Call(jen.Err()).Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err())),
+				jen.Return(jen.Nil(), snip.CGRErrorsWrapWithPermissionDenied().Call(jen.Err())),
+			),
+			jen.If(jen.Id("ctx").Op("==").Nil()).Block(jen.Return(reqCtxExpr.Clone(), jen.Nil())),
+			jen.Return(jen.Id("ctx"), jen.Nil()),
+		)
 }
 
-func astForRouteRegistration(serviceDef *types.ServiceDefinition) *jen.Statement {
+// writeServerEndpointInterceptor writes the EndpointInterceptor type, the WithEndpointInterceptor route parameter and
+// the function that wraps handlers to call the configured interceptors. It must be written once per file that contains
+// handlers which are intercepted, together with writeServerEndpointInfo.
+func writeServerEndpointInterceptor(file *jen.Group) {
+	handleFunc := func() *jen.Statement {
+		return jen.Func().Params(snip.HTTPResponseWriter(), jen.Op("*").Add(snip.HTTPRequest())).Error()
+	}
+	interceptorsFromCtx := jen.List(jen.Id("interceptors"), jen.Id("_")).Op(":=").Add(reqCtxExpr.Clone()).Dot("Value").Call(jen.Id(interceptorsCtxKeyType).Values()).Assert(jen.Index().Id(interceptorType))
+	file.Commentf("%s intercepts the requests to the routes it is configured for using %s.", interceptorType, interceptorParamFunc).Line().
+		Comment("It is called before the request is decoded and must either call next, with ctx or a context derived from it,").Line().
+		Comment("to handle the request or return an error to reject it. Errors are returned to the client like errors of").Line().
+		Comment("endpoint implementations.").Line().
+		Type().Id(interceptorType).Func().
+		Params(jen.Id("ctx").Add(snip.Context()), jen.Id("endpoint").Id(endpointInfoType), jen.Id("next").Func().Params(jen.Id("ctx").Add(snip.Context())).Error()).
+		Error()
+	file.Commentf("%s returns a route parameter that configures the handlers registered by the RegisterRoutes", interceptorParamFunc).Line().
+		Comment("functions of this package to call interceptor. If multiple interceptors are configured, they are called in the").Line().
+		Comment("order in which they are provided.").Line().
+		Func().Id(interceptorParamFunc).
+		Params(jen.Id("interceptor").Id(interceptorType)).
+		Params(snip.WrouterRouteParam()).
+		Block(
+			jen.Return(snip.WrouterRouteMiddleware().Call(
+				jen.Func().
+					Params(
+						jen.Id(responseWriterVarName).Add(snip.HTTPResponseWriter()),
+						jen.Id(reqName).Op("*").Add(snip.HTTPRequest()),
+						jen.Id("reqVals").Add(snip.WrouterRequestVals()),
+						jen.Id("next").Add(snip.WrouterRouteRequestHandler()),
+					).
+					Block(
+						interceptorsFromCtx.Clone(),
+						// Limit the capacity so that routes never share the appended slice
+						jen.Id("interceptors").Op("=").Append(
+							jen.Id("interceptors").Index(jen.Op(":").Len(jen.Id("interceptors")).Op(":").Len(jen.Id("interceptors"))),
+							jen.Id("interceptor"),
+						),
+						jen.Id("ctx").Op(":=").Add(snip.ContextWithValue()).Call(reqCtxExpr.Clone(), jen.Id(interceptorsCtxKeyType).Values(), jen.Id("interceptors")),
+						jen.Id("next").Call(jen.Id(responseWriterVarName), jen.Id(reqName).Dot("WithContext").Call(jen.Id("ctx")), jen.Id("reqVals")),
+					),
+			)),
+		)
+	file.Type().Id(interceptorsCtxKeyType).Struct()
+	file.Commentf("%s returns a function that calls the interceptors configured using %s,", interceptEndpointFuncName, interceptorParamFunc).Line().
+		Comment("if any, before it calls handle.").Line().
+		Func().Id(interceptEndpointFuncName).
+		Params(jen.Id("endpoint").Id(endpointInfoType), jen.Id("handle").Add(handleFunc())).
+		Params(handleFunc()).
+		Block(
+			jen.Return(jen.Func().
+				Params(jen.Id(responseWriterVarName).Add(snip.HTTPResponseWriter()), jen.Id(reqName).Op("*").Add(snip.HTTPRequest())).
+				Error().
+				Block(
+					interceptorsFromCtx.Clone(),
+					jen.Id("next").Op(":=").Func().Params(jen.Id("ctx").Add(snip.Context())).Error().Block(
+						jen.Return(jen.Id("handle").Call(jen.Id(responseWriterVarName), jen.Id(reqName).Dot("WithContext").Call(jen.Id("ctx")))),
+					),
+					jen.For(jen.Id("i").Op(":=").Len(jen.Id("interceptors")).Op("-").Lit(1), jen.Id("i").Op(">=").Lit(0), jen.Id("i").Op("--")).Block(
+						jen.List(jen.Id("interceptor"), jen.Id("inner")).Op(":=").List(jen.Id("interceptors").Index(jen.Id("i")), jen.Id("next")),
+						jen.Id("next").Op("=").Func().Params(jen.Id("ctx").Add(snip.Context())).Error().Block(
+							jen.Return(jen.Id("interceptor").Call(jen.Id("ctx"), jen.Id("endpoint"), jen.Id("inner"))),
+						),
+					),
+					jen.Return(jen.Id("next").Call(reqCtxExpr.Clone())),
+				),
+			),
+		)
+}
+
+func astForRouteRegistration(serviceDef *types.ServiceDefinition, opts serverOptions) *jen.Statement {
 	funcName := routeRegistrationFuncName(serviceDef.Name)
 	ifaceType := transforms.Export(serviceDef.Name)
 	return jen.
@@ -82,7 +326,7 @@ func astForRouteRegistration(serviceDef *types.ServiceDefinition) *jen.Statement
 			for _, endpointDef := range serviceDef.Endpoints {
 				methodBody.If(
 					jen.Err().Op(":=").Id(resourceName).Dot(wresourceMethod(endpointDef.HTTPMethod)).CallFunc(func(args *jen.Group) {
-						astForWrouterRegisterArgsFunc(args, endpointDef)
+						astForWrouterRegisterArgsFunc(args, serviceDef.Name, endpointDef, opts)
 					}),
 					jen.Err().Op("!=").Nil(),
 				).Block(
@@ -95,11 +339,19 @@ func astForRouteRegistration(serviceDef *types.ServiceDefinition) *jen.Statement
 		})
 }
 
-func astForWrouterRegisterArgsFunc(args *jen.Group, endpointDef *types.EndpointDefinition) {
+func astForWrouterRegisterArgsFunc(args *jen.Group, serviceName string, endpointDef *types.EndpointDefinition, opts serverOptions) {
 	args.Lit(strings.Title(endpointDef.EndpointName))
 	args.Lit(endpointDef.HTTPPath)
+	handleFunc := jen.Id(handlerName).Dot(handleFuncName(endpointDef.EndpointName))
+	if opts.mapErrors {
+		// Map errors before interceptors see them so that they observe the errors returned to the client
+		handleFunc = jen.Id(mapEndpointErrorsFuncName).Call(handleFunc)
+	}
+	if opts.interceptEndpoints {
+		handleFunc = jen.Id(interceptEndpointFuncName).Call(astForEndpointInfo(serviceName, endpointDef), handleFunc)
+	}
 	args.Add(snip.CGRHTTPServerNewJSONHandler()).Call(
-		jen.Id(handlerName).Dot(handleFuncName(endpointDef.EndpointName)),
+		handleFunc,
 		snip.CGRHTTPServerStatusCodeMapper(),
 		snip.CGRHTTPServerErrHandler(),
 	)
@@ -144,7 +396,7 @@ func astForHandlerStructDecl(serviceName string) *jen.Statement {
 	return jen.Type().Id(handlerStuctName(serviceName)).Struct(jen.Id(implName).Id(serviceName))
 }
 
-func astForHandlerMethods(serviceDef *types.ServiceDefinition) *jen.Statement {
+func astForHandlerMethods(serviceDef *types.ServiceDefinition, opts serverOptions, jw *jsonWriter, cw *cborWriter) *jen.Statement {
 	stmt := jen.Empty()
 	for _, endpointDef := range serviceDef.Endpoints {
 		stmt = stmt.Func().
@@ -153,23 +405,32 @@ func astForHandlerMethods(serviceDef *types.ServiceDefinition) *jen.Statement {
 			Params(jen.Id(responseWriterVarName).Add(snip.HTTPResponseWriter()), jen.Id(reqName).Op("*").Add(snip.HTTPRequest())).
 			Params(jen.Error()).
 			BlockFunc(func(methodBody *jen.Group) {
-				astForHandlerMethodBody(methodBody, serviceDef.Name, endpointDef)
+				astForHandlerMethodBody(methodBody, serviceDef.Name, endpointDef, opts, jw, cw)
 			}).
 			Line()
 	}
 	return stmt
 }
 
-func astForHandlerMethodBody(methodBody *jen.Group, serviceName string, endpointDef *types.EndpointDefinition) {
+func astForHandlerMethodBody(methodBody *jen.Group, serviceName string, endpointDef *types.EndpointDefinition, opts serverOptions, jw *jsonWriter, cw *cborWriter) {
 	// decode auth header
 	astForHandlerMethodAuthParams(methodBody, endpointDef)
+	if opts.validateAuth {
+		astForHandlerMethodValidateAuth(methodBody, serviceName, endpointDef)
+	}
 	// decode arguments
 	astForHandlerMethodPathParams(methodBody, endpointDef.PathParams())
 	astForHandlerMethodQueryParams(methodBody, endpointDef.QueryParams())
 	astForHandlerMethodHeaderParams(methodBody, endpointDef.HeaderParams())
-	astForHandlerMethodDecodeBody(methodBody, endpointDef.BodyParam())
+	astForHandlerMethodDecodeBody(methodBody, endpointDef.BodyParam(), jw, cw)
+	// validate arguments
+	if opts.validateParams {
+		for _, paramDef := range endpointDef.Params {
+			astForHandlerMethodValidateParam(methodBody, paramDef)
+		}
+	}
 	// call impl handler & return
-	astForHandlerExecImplAndReturn(methodBody, serviceName, endpointDef)
+	astForHandlerExecImplAndReturn(methodBody, serviceName, endpointDef, opts.validateAuth, jw, cw)
 }
 
 func astForHandlerMethodAuthParams(methodBody *jen.Group, endpointDef *types.EndpointDefinition) {
@@ -194,6 +455,30 @@ func astForHandlerMethodAuthParams(methodBody *jen.Group, endpointDef *types.End
 	}
 }
 
+// astForHandlerMethodValidateAuth passes the token parsed by astForHandlerMethodAuthParams to the validator configured
+// for the request. The context it returns is stored in authCtx.
+func astForHandlerMethodValidateAuth(methodBody *jen.Group, serviceName string, endpointDef *types.EndpointDefinition) {
+	var token jen.Code
+	switch {
+	case endpointDef.HeaderAuth:
+		token = snip.BearerTokenToken().Call(jen.Id(authHeaderVar))
+	case endpointDef.CookieAuth != nil:
+		token = jen.Id(cookieTokenVar)
+	default:
+		return
+	}
+	//	authCtx, err := validateRequestAuth(req, bearertoken.Token(authHeader), EndpointInfo{...})
+	//	if err != nil {
+	//		return err
+	//	}
+	methodBody.List(jen.Id(authCtxVarName), jen.Err()).Op(":=").Id(validateAuthFuncName).Call(
+		jen.Id(reqName),
+		token,
+		astForEndpointInfo(serviceName, endpointDef),
+	)
+	methodBody.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err()))
+}
+
 func astForHandlerMethodPathParams(methodBody *jen.Group, pathParams []*types.EndpointArgumentDefinition) {
 	if len(pathParams) == 0 {
 		return
@@ -264,7 +549,7 @@ func astForHandlerMethodQueryParam(methodBody *jen.Group, argDef *types.Endpoint
 	astForDecodeHTTPParam(methodBody, argDef.Name, argDef.Type, transforms.ArgName(argDef.Name), reqCtxExpr, queryVar)
 }
 
-func astForHandlerMethodDecodeBody(methodBody *jen.Group, argDef *types.EndpointArgumentDefinition) {
+func astForHandlerMethodDecodeBody(methodBody *jen.Group, argDef *types.EndpointArgumentDefinition, jw *jsonWriter, cw *cborWriter) {
 	if argDef == nil {
 		return
 	}
@@ -286,23 +571,83 @@ func astForHandlerMethodDecodeBody(methodBody *jen.Group, argDef *types.Endpoint
 		}
 		return
 	}
-	// If the request is not binary, it is JSON. Unmarshal the req.Body.
-	decodeJSON := jen.If(
-		jen.Err().Op(":=").Add(snip.CGRCodecsJSON().Dot("Decode")).Call(
-			jen.Id(reqName).Dot("Body"),
-			jen.Op("&").Id(varName),
-		),
-		jen.Err().Op("!=").Nil(),
-	).Block(jen.Return(snip.CGRErrorsWrapWithInvalidArgument().Call(jen.Err())))
+	// If the request is not binary, it is JSON (or CBOR if negotiated). Read the req.Body and decode it according to the
+	// Conjure wire spec.
+	decodeJSON := func(g *jen.Group) {
+		g.List(jen.Id(dataVarName), jen.Err()).Op(":=").Add(snip.IOReadAll()).Call(jen.Id(reqName).Dot("Body"))
+		g.If(jen.Err().Op("!=").Nil()).Block(jen.Return(snip.CGRErrorsWrapWithInvalidArgument().Call(jen.Err())))
+		if !cw.negotiates(argDef.Type) {
+			jw.writeDecodeBody(g, jen.Id(varName), argDef.Type)
+			return
+		}
+		g.If(jen.Id(cw.helper(isCBORRequestFunc)).Call(jen.Id(reqName))).Block(
+			jen.If(
+				jen.Err().Op(":=").Id(varName).Dot("UnmarshalCBOR").Call(jen.Id(dataVarName)),
+				jen.Err().Op("!=").Nil(),
+			).Block(jen.Return(snip.CGRErrorsWrapWithInvalidArgument().Call(jen.Err()))),
+		).Else().BlockFunc(func(g *jen.Group) {
+			jw.writeDecodeBody(g, jen.Id(varName), argDef.Type)
+		})
+	}
 
 	methodBody.Var().Id(varName).Add(argDef.Type.Code())
 	if argDef.Type.IsOptional() {
-		methodBody.If(emptyBodyCondition).Block(decodeJSON)
+		methodBody.If(emptyBodyCondition).BlockFunc(decodeJSON)
 	} else {
-		methodBody.Add(decodeJSON)
+		decodeJSON(methodBody)
+	}
+}
+
+// astForHandlerMethodValidateParam validates the decoded value of argDef if its type is a named type or an optional,
+// list or set of a named type. Elements of lists and sets are validated with their index appended to the field path.
+// The values of maps are not validated.
+func astForHandlerMethodValidateParam(methodBody *jen.Group, argDef *types.EndpointArgumentDefinition) {
+	if argDef.Type.IsBinary() {
+		return
+	}
+	varName := transforms.ArgName(argDef.Name)
+	validate := func(fieldPath, value jen.Code) *jen.Statement {
+		return jen.If(
+			jen.Err().Op(":=").Id(validateParamFuncName).Call(fieldPath, value),
+			jen.Err().Op("!=").Nil(),
+		).Block(jen.Return(jen.Err()))
+	}
+	fieldPath := jen.Lit(argDef.Name)
+	switch t := argDef.Type.(type) {
+	case *types.Optional:
+		if t.Item.IsNamed() {
+			methodBody.If(jen.Id(varName).Op("!=").Nil()).Block(validate(fieldPath, jen.Id(varName)))
+		}
+	case *types.List:
+		astForHandlerMethodValidateElements(methodBody, argDef.Name, varName, t.Item, validate)
+	case *types.Set:
+		if t.Name != "" && t.Item.IsNamed() {
+			// the elements of named set types are not addressable, so the sorted elements are validated
+			elemsVarName := varName + "Elems"
+			methodBody.Id(elemsVarName).Op(":=").Id(varName).Dot("Sorted").Call()
+			astForHandlerMethodValidateElements(methodBody, argDef.Name, elemsVarName, t.Item, validate)
+			return
+		}
+		astForHandlerMethodValidateElements(methodBody, argDef.Name, varName, t.Item, validate)
+	default:
+		if t.IsNamed() {
+			methodBody.Add(validate(fieldPath, jen.Op("&").Id(varName)))
+		}
 	}
 }
 
+func astForHandlerMethodValidateElements(methodBody *jen.Group, argName, varName string, itemType types.Type, validate func(fieldPath, value jen.Code) *jen.Statement) {
+	if !itemType.IsNamed() {
+		return
+	}
+	methodBody.For(jen.Id("i").Op(":=").Range().Id(varName)).Block(
+		validate(
+			jen.Lit(argName+"[").Op("+").Add(snip.StrconvItoa()).Call(jen.Id("i")).Op("+").Lit("]"),
+			jen.Op("&").Id(varName).Index(jen.Id("i")),
+		),
+	)
+}
+
 func astForDecodeHTTPParam(methodBody *jen.Group, argName string, argType types.Type, outVarName string, ctxExpr jen.Code, inStrExpr jen.Code) {
 	astForDecodeHTTPParamInternal(methodBody, argName, argType, outVarName, ctxExpr, inStrExpr, 0)
 }
@@ -373,7 +718,17 @@ func astForDecodeHTTPParamInternal(methodBody *jen.Group, argName string, argTyp
 			})
 		}
 	case *types.Set:
-		if _, isString := typVal.Item.(types.String); isString {
+		if typVal.Name != "" {
+			methodBody.Var().Id(outVarName).Add(typVal.Code())
+			if _, isString := typVal.Item.(types.String); isString {
+				methodBody.Id(outVarName).Dot("Add").Call(jen.Add(inStrExpr).Op("..."))
+			} else {
+				methodBody.For(jen.List(jen.Id("_"), jen.Id("v")).Op(":=").Range().Add(inStrExpr)).BlockFunc(func(g *jen.Group) {
+					astForDecodeHTTPParamInternal(g, argName, typVal.Item, "convertedVal", ctxExpr, jen.Id("v"), depth+1)
+					g.Id(outVarName).Dot("Add").Call(jen.Id("convertedVal"))
+				})
+			}
+		} else if _, isString := typVal.Item.(types.String); isString {
 			expr = inStrExpr
 		} else {
 			methodBody.Var().Id(outVarName).Add(typVal.Code())
@@ -430,9 +785,14 @@ func astForDecodeHTTPParamInternal(methodBody *jen.Group, argName string, argTyp
 	}
 }
 
-func astForHandlerExecImplAndReturn(g *jen.Group, serviceName string, endpointDef *types.EndpointDefinition) {
+func astForHandlerExecImplAndReturn(g *jen.Group, serviceName string, endpointDef *types.EndpointDefinition, validateAuth bool, jw *jsonWriter, cw *cborWriter) {
+	itemType, streamed := streamedResponseItem(endpointDef)
 	callFunc := jen.Id(handlerReceiverName(serviceName)).Dot(implName).Dot(strings.Title(endpointDef.EndpointName)).CallFunc(func(g *jen.Group) {
-		g.Id(reqName).Dot("Context").Call()
+		if validateAuth && (endpointDef.HeaderAuth || endpointDef.CookieAuth != nil) {
+			g.Id(authCtxVarName)
+		} else {
+			g.Id(reqName).Dot("Context").Call()
+		}
 		if endpointDef.HeaderAuth {
 			g.Add(snip.BearerTokenToken()).Call(jen.Id(authHeaderVar))
 		} else if endpointDef.CookieAuth != nil {
@@ -441,8 +801,24 @@ func astForHandlerExecImplAndReturn(g *jen.Group, serviceName string, endpointDe
 		for _, paramDef := range endpointDef.Params {
 			g.Id(transforms.ArgName(paramDef.Name))
 		}
+		if streamed {
+			g.Func().Params(jen.Id(streamedItemVarName).Add(itemType.Code())).Error().Block(
+				jen.Return(jen.Id(arrayWriterVarName).Dot(jsonArrayWriterWrite).Call(jen.Id(streamedItemVarName))),
+			)
+		}
 	})
 
+	if streamed {
+		// The impl writes the elements of the response, which are streamed to the client as a JSON array
+		g.Id(arrayWriterVarName).Op(":=").Op("&").Id(jw.helper(jsonArrayWriterType)).Values(jen.Id("rw").Op(":").Id(responseWriterVarName))
+		g.If(
+			jen.Err().Op(":=").Add(callFunc),
+			jen.Err().Op("!=").Nil(),
+		).Block(jen.Return(jen.Id(arrayWriterVarName).Dot(jsonArrayWriterFail).Call(jen.Err())))
+		g.Return(jen.Id(arrayWriterVarName).Dot(jsonArrayWriterClose).Call())
+		return
+	}
+
 	if endpointDef.Returns == nil {
 		// The endpoint doesn't return anything, just make the interface call and return 204 if no error
 		g.If(
@@ -476,6 +852,12 @@ func astForHandlerExecImplAndReturn(g *jen.Group, serviceName string, endpointDe
 		)
 	}
 
+	if cw.negotiates(*endpointDef.Returns) {
+		g.If(jen.Id(cw.helper(acceptsCBORFunc)).Call(jen.Id(reqName))).Block(
+			jen.Id(responseWriterVarName).Dot("Header").Call().Dot("Add").Call(jen.Lit("Content-Type"), jen.Id(cw.helper(cborContentTypeConst))),
+			jen.Return(jen.Id(cw.helper(cborCodecType)).Values().Dot("Encode").Call(jen.Id(responseWriterVarName), respArg.Clone())),
+		)
+	}
 	codec := snip.CGRCodecsJSON()
 	if (*endpointDef.Returns).IsBinary() {
 		codec = snip.CGRCodecsBinary()
@@ -487,6 +869,94 @@ func astForHandlerExecImplAndReturn(g *jen.Group, serviceName string, endpointDe
 	g.Return(codec.Clone().Dot("Encode").Call(jen.Id(responseWriterVarName), respArg.Clone()))
 }
 
+// streamedResponseItem returns the element type of the response of endpointDef and true if the server implementation
+// of endpointDef streams its response, which is the case for endpoints with the server-streaming tag that return a list
+// or set. Other endpoints are not affected by the tag.
+func streamedResponseItem(endpointDef *types.EndpointDefinition) (types.Type, bool) {
+	if endpointDef.Returns == nil || !hasTag(endpointDef.Tags, serverStreamingTag) {
+		return nil, false
+	}
+	switch t := (*endpointDef.Returns).(type) {
+	case *types.List:
+		return t.Item, true
+	case *types.Set:
+		return t.Item, true
+	}
+	return nil, false
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// astForJSONArrayWriter returns the type used by the handlers of streamed endpoints to write their response.
+func astForJSONArrayWriter() *jen.Statement {
+	w := jen.Id("w")
+	addContentType := w.Clone().Dot("rw").Dot("Header").Call().Dot("Add").Call(jen.Lit("Content-Type"), snip.CGRCodecsJSON().Dot("ContentType").Call())
+	return jen.Commentf("%s writes a JSON array to rw one element at a time, flushing each element. Nothing is written until the", jsonArrayWriterType).Line().
+		Comment("first element, so handlers can still return an error response if the server implementation fails before").Line().
+		Comment("writing any elements.").Line().
+		Type().Id(jsonArrayWriterType).Struct(
+		jen.Id("rw").Add(snip.HTTPResponseWriter()),
+		jen.Id("buf").Index().Byte(),
+		jen.Id("started").Bool(),
+	).Line().Line().
+		Commentf("%s writes the JSON encoding of elem as the next element of the array.", jsonArrayWriterWrite).Line().
+		Func().Params(w.Clone().Op("*").Id(jsonArrayWriterType)).Id(jsonArrayWriterWrite).Params(jen.Id("elem").Interface()).Error().BlockFunc(func(g *jen.Group) {
+		g.Add(w.Clone()).Dot("buf").Op("=").Append(w.Clone().Dot("buf").Index(jen.Empty(), jen.Lit(0)), jen.LitRune(','))
+		g.If(jen.Op("!").Add(w.Clone()).Dot("started")).Block(
+			w.Clone().Dot("buf").Index(jen.Lit(0)).Op("=").LitRune('['),
+		)
+		g.Var().Err().Error()
+		g.If(
+			jen.List(jen.Id("appender"), jen.Id("ok")).Op(":=").Id("elem").Assert(jen.Interface(jen.Id("AppendJSON").Params(jen.Index().Byte()).Params(jen.Index().Byte(), jen.Error()))),
+			jen.Id("ok"),
+		).Block(
+			jen.List(w.Clone().Dot("buf"), jen.Err()).Op("=").Id("appender").Dot("AppendJSON").Call(w.Clone().Dot("buf")),
+		).Else().Block(
+			jen.Var().Id("data").Index().Byte(),
+			jen.List(jen.Id("data"), jen.Err()).Op("=").Add(snip.SafeJSONMarshal()).Call(jen.Id("elem")),
+			w.Clone().Dot("buf").Op("=").Append(w.Clone().Dot("buf"), jen.Id("data").Op("...")),
+		)
+		g.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err()))
+		g.If(jen.Op("!").Add(w.Clone()).Dot("started")).BlockFunc(func(g *jen.Group) {
+			g.Add(addContentType.Clone())
+			g.Add(w.Clone()).Dot("started").Op("=").True()
+		})
+		g.If(
+			jen.List(jen.Id("_"), jen.Err()).Op(":=").Add(w.Clone()).Dot("rw").Dot("Write").Call(w.Clone().Dot("buf")),
+			jen.Err().Op("!=").Nil(),
+		).Block(jen.Return(jen.Err()))
+		g.If(
+			jen.List(jen.Id("flusher"), jen.Id("ok")).Op(":=").Add(w.Clone()).Dot("rw").Assert(snip.HTTPFlusher()),
+			jen.Id("ok"),
+		).Block(jen.Id("flusher").Dot("Flush").Call())
+		g.Return(jen.Nil())
+	}).Line().Line().
+		Commentf("%s writes the end of the array, which is empty if no elements were written.", jsonArrayWriterClose).Line().
+		Func().Params(w.Clone().Op("*").Id(jsonArrayWriterType)).Id(jsonArrayWriterClose).Params().Error().BlockFunc(func(g *jen.Group) {
+		g.If(jen.Op("!").Add(w.Clone()).Dot("started")).BlockFunc(func(g *jen.Group) {
+			g.Add(addContentType.Clone())
+			g.List(jen.Id("_"), jen.Err()).Op(":=").Add(snip.IOWriteString()).Call(w.Clone().Dot("rw"), jen.Lit("[]"))
+			g.Return(jen.Err())
+		})
+		g.List(jen.Id("_"), jen.Err()).Op(":=").Add(snip.IOWriteString()).Call(w.Clone().Dot("rw"), jen.Lit("]"))
+		g.Return(jen.Err())
+	}).Line().Line().
+		Commentf("%s returns err if no elements were written, so that it is returned to the client as usual. Otherwise the", jsonArrayWriterFail).Line().
+		Comment("status and part of the array have already been sent, so it aborts the response without ending the array, which").Line().
+		Comment("clients report as an unexpected end of the response body.").Line().
+		Func().Params(w.Clone().Op("*").Id(jsonArrayWriterType)).Id(jsonArrayWriterFail).Params(jen.Err().Error()).Error().Block(
+		jen.If(jen.Op("!").Add(w.Clone()).Dot("started")).Block(jen.Return(jen.Err())),
+		jen.Panic(snip.HTTPErrAbortHandler()),
+	)
+}
+
 func routeRegistrationFuncName(serviceName string) string {
 	return "RegisterRoutes" + strings.Title(serviceName)
 }
@@ -529,7 +529,7 @@ func TestServerASTDecodeHTTPParam(t *testing.T) {
 				case types.QueryParam:
 					astForHandlerMethodQueryParam(g, &test.Arg)
 				case types.BodyParam:
-					astForHandlerMethodDecodeBody(g, &test.Arg)
+					astForHandlerMethodDecodeBody(g, &test.Arg, newJSONWriter(newJSONTypes(&types.ConjureDefinition{}), types.ConjurePackage{}), nil)
 				}
 			})
 			var buf bytes.Buffer
@@ -36,6 +36,7 @@ const (
 
 	defaultReturnValVar = "defaultReturnVal"
 	returnValVar        = "returnVal"
+	clientCBORFieldName = "cbor"
 	respVar             = "resp"
 
 	requestParamsVar = "requestParams"
@@ -46,20 +47,34 @@ var (
 	pathParamRegexp = regexp.MustCompile(regexp.QuoteMeta("{") + "[^}]+" + regexp.QuoteMeta("}"))
 )
 
-func writeServiceType(file *jen.Group, serviceDef *types.ServiceDefinition) {
-	file.Add(astForServiceInterface(serviceDef, false, false))
-	file.Add(astForClientStructDecl(serviceDef.Name))
+// writeServiceType writes the client of serviceDef. If iterators is true, the client has an <Endpoint>Iter method for
+// every endpoint that returns a list or set (see clientIterElemType). If cw is non-nil, the request and response bodies
+// of endpoints that negotiate CBOR are encoded as CBOR by the clients returned by New<Service>CBORClient.
+func writeServiceType(file *jen.Group, serviceDef *types.ServiceDefinition, iterators bool, cw *cborWriter) {
+	negotiatesCBOR := cw.negotiatesService(serviceDef)
+	file.Add(astForServiceInterface(serviceDef, false, false, iterators))
+	file.Add(astForClientStructDecl(serviceDef.Name, negotiatesCBOR))
 	file.Add(astForNewClientFunc(serviceDef.Name))
+	if negotiatesCBOR {
+		file.Add(astForNewCBORClientFunc(serviceDef.Name))
+	}
 	for _, endpointDef := range serviceDef.Endpoints {
-		file.Add(astForEndpointMethod(serviceDef.Name, endpointDef, false))
+		file.Add(astForEndpointMethod(serviceDef.Name, endpointDef, false, cw))
+		if elemType, ok := clientIterElemType(endpointDef); ok && iterators {
+			file.Add(astForClientIterMethod(serviceDef.Name, endpointDef, cw))
+			file.Add(astForClientIterType(serviceDef.Name, endpointDef, elemType))
+		}
 	}
 	if serviceDef.HasHeaderAuth() || serviceDef.HasCookieAuth() {
 		// at least one endpoint uses authentication: define decorator structures
-		file.Add(astForServiceInterface(serviceDef, true, false))
+		file.Add(astForServiceInterface(serviceDef, true, false, iterators))
 		file.Add(astForNewServiceFuncWithAuth(serviceDef))
 		file.Add(astForClientStructDeclWithAuth(serviceDef))
 		for _, endpointDef := range serviceDef.Endpoints {
-			file.Add(astForEndpointMethod(serviceDef.Name, endpointDef, true))
+			file.Add(astForEndpointMethod(serviceDef.Name, endpointDef, true, nil))
+			if _, ok := clientIterElemType(endpointDef); ok && iterators {
+				file.Add(astForClientIterAuthMethod(serviceDef.Name, endpointDef))
+			}
 		}
 
 		// Return true if all endpoints that require authentication are of the same auth type (header or cookie) and at least
@@ -70,12 +85,17 @@ func writeServiceType(file *jen.Group, serviceDef *types.ServiceDefinition) {
 			file.Add(astForTokenServiceStructDecl(serviceDef.Name))
 			for _, endpointDef := range serviceDef.Endpoints {
 				file.Add(astForTokenServiceEndpointMethod(serviceDef.Name, endpointDef))
+				if _, ok := clientIterElemType(endpointDef); ok && iterators {
+					file.Add(astForClientIterTokenServiceMethod(serviceDef.Name, endpointDef))
+				}
 			}
 		}
 	}
 }
 
-func astForServiceInterface(serviceDef *types.ServiceDefinition, withAuth, isServer bool) *jen.Statement {
+// astForServiceInterface returns the server interface of serviceDef if isServer is true and its client interface
+// otherwise. If iterators is true, the client interface has the <Endpoint>Iter methods written by writeServiceType.
+func astForServiceInterface(serviceDef *types.ServiceDefinition, withAuth, isServer, iterators bool) *jen.Statement {
 	name := interfaceTypeName(serviceDef.Name)
 	if !isServer {
 		name = clientInterfaceTypeName(serviceDef.Name)
@@ -94,8 +114,11 @@ func astForServiceInterface(serviceDef *types.ServiceDefinition, withAuth, isSer
 						astForEndpointArgsFunc(args, endpointDef, withAuth, isServer)
 					}).
 					ParamsFunc(func(args *jen.Group) {
-						astForEndpointReturnsFunc(args, endpointDef)
+						astForEndpointReturnsFunc(args, endpointDef, isServer)
 					})
+				if _, ok := clientIterElemType(endpointDef); ok && iterators && !isServer {
+					methods.Add(astForClientIterInterfaceMethod(serviceDef.Name, endpointDef, withAuth))
+				}
 			}
 		})
 }
@@ -112,9 +135,17 @@ func astForEndpointArgsFunc(args *jen.Group, endpointDef *types.EndpointDefiniti
 	for _, paramDef := range endpointDef.Params {
 		args.Add(astForEndpointParameterArg(paramDef, isServer))
 	}
+	if itemType, ok := streamedResponseItem(endpointDef); ok && isServer {
+		// the server implementation of a streamed endpoint writes the elements of its response instead of returning them
+		args.Id(streamWriterVarName).Func().Params(itemType.Code()).Error()
+	}
 }
 
 func astForEndpointParameterArg(argDef *types.EndpointArgumentDefinition, isServer bool) *jen.Statement {
+	return jen.Id(transforms.ArgName(argDef.Name)).Add(astForEndpointParameterType(argDef, isServer))
+}
+
+func astForEndpointParameterType(argDef *types.EndpointArgumentDefinition, isServer bool) *jen.Statement {
 	argType := argDef.Type.Code()
 	if argDef.Type.IsBinary() {
 		// special case: "binary" types resolve to []byte, but this indicates a streaming parameter when
@@ -132,31 +163,39 @@ func astForEndpointParameterArg(argDef *types.EndpointArgumentDefinition, isServ
 			argType = snip.FuncIOReadCloser()
 		}
 	}
-	return jen.Id(transforms.ArgName(argDef.Name)).Add(argType)
+	return argType
 }
 
-func astForEndpointReturnsFunc(args *jen.Group, endpointDef *types.EndpointDefinition) {
+func astForEndpointReturnsFunc(args *jen.Group, endpointDef *types.EndpointDefinition, isServer bool) {
+	if _, ok := streamedResponseItem(endpointDef); ok && isServer {
+		args.Error()
+		return
+	}
 	if endpointDef.Returns != nil {
-		r := *endpointDef.Returns
-		if !r.IsBinary() {
-			args.Add(r.Code())
-		} else {
-			// special case: "binary" type resolves to []byte in structs, but indicates a streaming response when
-			// specified as the return type of a service, so replace all nested references with "io.ReadCloser".
-			if r.IsOptional() {
-				args.Op("*").Add(snip.IOReadCloser())
-			} else {
-				args.Add(snip.IOReadCloser())
-			}
-		}
+		args.Add(astForEndpointReturnType(*endpointDef.Returns))
 	}
 	args.Error()
 }
 
-func astForClientStructDecl(serviceName string) *jen.Statement {
-	return jen.Type().Id(clientStructTypeName(serviceName)).Struct(
-		jen.Id(clientStructFieldName).Add(snip.CGRClientClient()),
-	)
+func astForEndpointReturnType(r types.Type) *jen.Statement {
+	if !r.IsBinary() {
+		return r.Code()
+	}
+	// special case: "binary" type resolves to []byte in structs, but indicates a streaming response when
+	// specified as the return type of a service, so replace all nested references with "io.ReadCloser".
+	if r.IsOptional() {
+		return jen.Op("*").Add(snip.IOReadCloser())
+	}
+	return snip.IOReadCloser()
+}
+
+func astForClientStructDecl(serviceName string, negotiatesCBOR bool) *jen.Statement {
+	return jen.Type().Id(clientStructTypeName(serviceName)).StructFunc(func(structDecls *jen.Group) {
+		structDecls.Id(clientStructFieldName).Add(snip.CGRClientClient())
+		if negotiatesCBOR {
+			structDecls.Id(clientCBORFieldName).Bool()
+		}
+	})
 }
 
 func astForClientStructDeclWithAuth(serviceDef *types.ServiceDefinition) *jen.Statement {
@@ -182,6 +221,21 @@ func astForNewClientFunc(serviceName string) *jen.Statement {
 		))
 }
 
+// astForNewCBORClientFunc returns the constructor of clients that encode request and response bodies as CBOR.
+func astForNewCBORClientFunc(serviceName string) *jen.Statement {
+	return jen.Commentf("New%sCBORClient returns a client that encodes the request and response bodies of Conjure types as CBOR. The", serviceName).Line().
+		Comment("server must support CBOR.").Line().
+		Func().Id("New" + serviceName + "CBORClient").
+		Params(jen.Id(wrappedClientVar).Add(snip.CGRClientClient())).
+		Params(jen.Id(clientInterfaceTypeName(serviceName))).
+		Block(jen.Return(
+			jen.Op("&").Id(clientStructTypeName(serviceName)).Values(
+				jen.Id(clientStructFieldName).Op(":").Id(wrappedClientVar),
+				jen.Id(clientCBORFieldName).Op(":").True(),
+			),
+		))
+}
+
 func astForNewServiceFuncWithAuth(serviceDef *types.ServiceDefinition) *jen.Statement {
 	return jen.Func().Id(withAuthName("New" + clientInterfaceTypeName(serviceDef.Name))).
 		ParamsFunc(func(args *jen.Group) {
@@ -207,7 +261,7 @@ func astForNewServiceFuncWithAuth(serviceDef *types.ServiceDefinition) *jen.Stat
 		))
 }
 
-func astForEndpointMethod(serviceName string, endpointDef *types.EndpointDefinition, withAuth bool) *jen.Statement {
+func astForEndpointMethod(serviceName string, endpointDef *types.EndpointDefinition, withAuth bool, cw *cborWriter) *jen.Statement {
 	return jen.Func().
 		ParamsFunc(func(receiver *jen.Group) {
 			if withAuth {
@@ -221,18 +275,18 @@ func astForEndpointMethod(serviceName string, endpointDef *types.EndpointDefinit
 			astForEndpointArgsFunc(args, endpointDef, withAuth, false)
 		}).
 		ParamsFunc(func(args *jen.Group) {
-			astForEndpointReturnsFunc(args, endpointDef)
+			astForEndpointReturnsFunc(args, endpointDef, false)
 		}).
 		BlockFunc(func(methodBody *jen.Group) {
 			if withAuth {
-				astForEndpointAuthMethodBodyFunc(methodBody, endpointDef)
+				astForEndpointAuthMethodBodyFunc(methodBody, endpointDef, transforms.Export(endpointDef.EndpointName))
 			} else {
-				astForEndpointMethodBodyFunc(methodBody, endpointDef)
+				astForEndpointMethodBodyFunc(methodBody, endpointDef, cw)
 			}
 		})
 }
 
-func astForEndpointMethodBodyFunc(methodBody *jen.Group, endpointDef *types.EndpointDefinition) {
+func astForEndpointMethodBodyFunc(methodBody *jen.Group, endpointDef *types.EndpointDefinition, cw *cborWriter) {
 	var (
 		hasReturnVal         = endpointDef.Returns != nil
 		returnsBinary        = hasReturnVal && (*endpointDef.Returns).IsBinary()
@@ -266,7 +320,7 @@ func astForEndpointMethodBodyFunc(methodBody *jen.Group, endpointDef *types.Endp
 	}
 
 	// build requestParams
-	astForEndpointMethodBodyRequestParams(methodBody, endpointDef)
+	astForEndpointMethodBodyRequestParams(methodBody, endpointDef, false, cw)
 
 	// execute request
 	callStmt := jen.Id(clientReceiverName).Dot(clientStructFieldName).Dot("Do").Call(
@@ -323,7 +377,10 @@ func astForEndpointMethodBodyFunc(methodBody *jen.Group, endpointDef *types.Endp
 	}
 }
 
-func astForEndpointMethodBodyRequestParams(methodBody *jen.Group, endpointDef *types.EndpointDefinition) {
+// astForEndpointMethodBodyRequestParams writes the request params of endpointDef. The response body is decoded into the
+// return value unless rawResponseBody is true or the endpoint returns binary. Bodies that negotiate CBOR with cw are
+// encoded as CBOR if the cbor field of the client is true.
+func astForEndpointMethodBodyRequestParams(methodBody *jen.Group, endpointDef *types.EndpointDefinition, rawResponseBody bool, cw *cborWriter) {
 	methodBody.Var().Id(requestParamsVar).Op("[]").Add(snip.CGRClientRequestParam())
 
 	// helper for the statement "requestParams = append(requestParams, {code})"
@@ -353,6 +410,17 @@ func astForEndpointMethodBodyRequestParams(methodBody *jen.Group, endpointDef *t
 	// body params
 	if body := endpointDef.BodyParam(); body != nil {
 		bodyArg := transforms.ArgName(body.Name)
+		appendJSONRequest := func(methodBody *jen.Group) {
+			if !cw.negotiates(body.Type) {
+				appendRequestParams(methodBody, snip.CGRClientWithJSONRequest().Call(jen.Id(bodyArg)))
+				return
+			}
+			methodBody.If(jen.Id(clientReceiverName).Dot(clientCBORFieldName)).BlockFunc(func(ifBody *jen.Group) {
+				appendRequestParams(ifBody, snip.CGRClientWithRequestBody().Call(jen.Id(bodyArg), jen.Id(cw.helper(cborCodecType)).Values()))
+			}).Else().BlockFunc(func(elseBody *jen.Group) {
+				appendRequestParams(elseBody, snip.CGRClientWithJSONRequest().Call(jen.Id(bodyArg)))
+			})
+		}
 		if body.Type.IsOptional() {
 			bodyVal := jen.Id(bodyArg)
 			if body.Type.IsNamed() && !body.Type.IsBinary() {
@@ -363,13 +431,13 @@ func astForEndpointMethodBodyRequestParams(methodBody *jen.Group, endpointDef *t
 				if body.Type.IsBinary() {
 					appendRequestParams(ifBody, snip.CGRClientWithRawRequestBodyProvider().Call(jen.Id(bodyArg)))
 				} else {
-					appendRequestParams(ifBody, snip.CGRClientWithJSONRequest().Call(jen.Id(bodyArg)))
+					appendJSONRequest(ifBody)
 				}
 			})
 		} else if body.Type.IsBinary() {
 			appendRequestParams(methodBody, snip.CGRClientWithRawRequestBodyProvider().Call(jen.Id(bodyArg)))
 		} else {
-			appendRequestParams(methodBody, snip.CGRClientWithJSONRequest().Call(jen.Id(bodyArg)))
+			appendJSONRequest(methodBody)
 		}
 	}
 	// header params
@@ -408,6 +476,14 @@ func astForEndpointMethodBodyRequestParams(methodBody *jen.Group, endpointDef *t
 					ifBody.Id(queryParamsVar).Dot("Set").Call(jen.Lit(param.ParamID),
 						snip.FmtSprint().Call(jen.Op("*").Add(selector())))
 				})
+			} else if set, ok := aliasedNamedSet(param.Type); ok {
+				elems := set.Code().Call(jen.Id(argName)).Dot("Sorted").Call()
+				if set == param.Type {
+					elems = jen.Id(argName).Dot("Sorted").Call()
+				}
+				methodBody.For(jen.List(jen.Id("_"), jen.Id("v")).Op(":=").Range().Add(elems)).Block(
+					jen.Id(queryParamsVar).Dot("Add").Call(jen.Lit(param.ParamID), snip.FmtSprint().Call(jen.Id("v"))),
+				)
 			} else if param.Type.IsList() {
 				methodBody.For(jen.List(jen.Id("_"), jen.Id("v")).Op(":=").Range().Id(argName)).Block(
 					jen.Id(queryParamsVar).Dot("Add").Call(jen.Lit(param.ParamID), snip.FmtSprint().Call(jen.Id("v"))),
@@ -421,19 +497,33 @@ func astForEndpointMethodBodyRequestParams(methodBody *jen.Group, endpointDef *t
 	}
 	// response
 	if endpointDef.Returns != nil {
-		if (*endpointDef.Returns).IsBinary() {
+		if (*endpointDef.Returns).IsBinary() || rawResponseBody {
 			appendRequestParams(methodBody, snip.CGRClientWithRawResponseBody().Call())
+		} else if returnType := *endpointDef.Returns; cw.negotiates(returnType) {
+			methodBody.If(jen.Id(clientReceiverName).Dot(clientCBORFieldName)).BlockFunc(func(ifBody *jen.Group) {
+				if !returnType.IsCollection() && !returnType.IsOptional() {
+					// returnVal is a nil pointer
+					ifBody.Id(returnValVar).Op("=").New(returnType.Code())
+					appendRequestParams(ifBody, snip.CGRClientWithResponseBody().Call(jen.Id(returnValVar), jen.Id(cw.helper(cborCodecType)).Values()))
+				} else {
+					appendRequestParams(ifBody, snip.CGRClientWithResponseBody().Call(jen.Op("&").Id(returnValVar), jen.Id(cw.helper(cborCodecType)).Values()))
+				}
+			}).Else().BlockFunc(func(elseBody *jen.Group) {
+				appendRequestParams(elseBody, snip.CGRClientWithJSONResponse().Call(jen.Op("&").Id(returnValVar)))
+			})
 		} else {
 			appendRequestParams(methodBody, snip.CGRClientWithJSONResponse().Call(jen.Op("&").Id(returnValVar)))
 		}
 	}
 }
 
-func astForEndpointAuthMethodBodyFunc(methodBody *jen.Group, endpointDef *types.EndpointDefinition) {
+// astForEndpointAuthMethodBodyFunc writes the body of a method of the client that provides auth tokens, which calls
+// the method with the provided name of the wrapped client.
+func astForEndpointAuthMethodBodyFunc(methodBody *jen.Group, endpointDef *types.EndpointDefinition, methodName string) {
 	methodBody.Return(
 		jen.Id(clientReceiverName).
 			Dot(clientStructFieldName).
-			Dot(transforms.Export(endpointDef.EndpointName)).
+			Dot(methodName).
 			CallFunc(func(args *jen.Group) {
 				args.Id("ctx")
 				if endpointDef.HeaderAuth {
@@ -518,7 +608,7 @@ func astForTokenServiceEndpointMethod(serviceName string, endpointDef *types.End
 			astForEndpointArgsFunc(args, endpointDef, hasAuth, false)
 		}).
 		ParamsFunc(func(args *jen.Group) {
-			astForEndpointReturnsFunc(args, endpointDef)
+			astForEndpointReturnsFunc(args, endpointDef, false)
 		}).
 		BlockFunc(func(methodBody *jen.Group) {
 			astForTokenServiceEndpointMethodBody(methodBody, endpointDef, hasAuth)
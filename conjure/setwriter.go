@@ -0,0 +1,179 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/palantir/conjure-go/v6/conjure/snip"
+	"github.com/palantir/conjure-go/v6/conjure/types"
+)
+
+const (
+	setReceiverName = "s"
+	setElemVarName  = "elem"
+	setElemsVarName = "elems"
+)
+
+// isNamedSet returns true if typ is a set that is represented by a named set type rather than a slice.
+func isNamedSet(typ types.Type) bool {
+	set, ok := typ.(*types.Set)
+	return ok && set.Name != ""
+}
+
+// aliasedNamedSet returns the named set type that typ is or aliases and true if there is one.
+func aliasedNamedSet(typ types.Type) (*types.Set, bool) {
+	for {
+		switch t := typ.(type) {
+		case *types.Set:
+			return t, t.Name != ""
+		case *types.AliasType:
+			typ = t.Item
+		default:
+			return nil, false
+		}
+	}
+}
+
+// writeSetType writes the declaration of a named set type, a map from the elements of the set to struct{}, with its
+// constructor, its Add, Contains, Remove and Sorted methods and its encoding methods. Sets are encoded as JSON arrays of
+// their elements in ascending order. UnmarshalJSON ignores duplicate elements like the slice representation of sets
+// does; UnmarshalJSONStrict rejects them.
+func writeSetType(file *jen.Group, setDef *types.Set, jw *jsonWriter) {
+	name, item := setDef.Name, setDef.Item
+	file.Commentf("%s is a set of %s values. The zero value is an empty set to which elements can be added.", name, item.String()).Line().
+		Type().Id(name).Map(item.Code()).Struct()
+
+	constructor := "New" + name
+	file.Commentf("%s returns a %s that contains the provided elements.", constructor, name).Line().
+		Func().Id(constructor).Params(jen.Id(setElemsVarName).Op("...").Add(item.Code())).Id(name).Block(
+		jen.Id(setReceiverName).Op(":=").Make(jen.Id(name), jen.Len(jen.Id(setElemsVarName))),
+		jen.Id(setReceiverName).Dot("Add").Call(jen.Id(setElemsVarName).Op("...")),
+		jen.Return(jen.Id(setReceiverName)),
+	)
+	file.Comment("Add adds the provided elements to the set. Adding elements to a nil set initializes it.").Line().
+		Func().Params(jen.Id(setReceiverName).Op("*").Id(name)).Id("Add").Params(jen.Id(setElemsVarName).Op("...").Add(item.Code())).Block(
+		jen.If(jen.Op("*").Id(setReceiverName).Op("==").Nil()).Block(
+			jen.Op("*").Id(setReceiverName).Op("=").Make(jen.Id(name), jen.Len(jen.Id(setElemsVarName))),
+		),
+		jen.For(jen.List(jen.Id("_"), jen.Id(setElemVarName)).Op(":=").Range().Id(setElemsVarName)).Block(
+			jen.Parens(jen.Op("*").Id(setReceiverName)).Index(jen.Id(setElemVarName)).Op("=").Struct().Values(),
+		),
+	)
+	file.Comment("Contains returns true if the set contains elem.").Line().
+		Func().Params(jen.Id(setReceiverName).Id(name)).Id("Contains").Params(jen.Id(setElemVarName).Add(item.Code())).Bool().Block(
+		jen.List(jen.Id("_"), jen.Id("ok")).Op(":=").Id(setReceiverName).Index(jen.Id(setElemVarName)),
+		jen.Return(jen.Id("ok")),
+	)
+	file.Comment("Remove removes the provided elements from the set.").Line().
+		Func().Params(jen.Id(setReceiverName).Id(name)).Id("Remove").Params(jen.Id(setElemsVarName).Op("...").Add(item.Code())).Block(
+		jen.For(jen.List(jen.Id("_"), jen.Id(setElemVarName)).Op(":=").Range().Id(setElemsVarName)).Block(
+			jen.Delete(jen.Id(setReceiverName), jen.Id(setElemVarName)),
+		),
+	)
+	file.Comment("Sorted returns the elements of the set in ascending order.").Line().
+		Func().Params(jen.Id(setReceiverName).Id(name)).Id("Sorted").Params().Index().Add(item.Code()).Block(
+		jen.Id(setElemsVarName).Op(":=").Make(jen.Index().Add(item.Code()), jen.Lit(0), jen.Len(jen.Id(setReceiverName))),
+		jen.For(jen.Id(setElemVarName).Op(":=").Range().Id(setReceiverName)).Block(
+			jen.Id(setElemsVarName).Op("=").Append(jen.Id(setElemsVarName), jen.Id(setElemVarName)),
+		),
+		snip.SortSlice().Call(jen.Id(setElemsVarName), jen.Func().Params(jen.List(jen.Id("i"), jen.Id("j")).Int()).Bool().Block(
+			jen.Return(setElemLess(jen.Id(setElemsVarName).Index(jen.Id("i")), jen.Id(setElemsVarName).Index(jen.Id("j")), item)),
+		)),
+		jen.Return(jen.Id(setElemsVarName)),
+	)
+
+	jw.writeSetMethods(file, setDef)
+	file.Add(snip.MethodMarshalYAML(setReceiverName, name))
+	file.Add(snip.MethodUnmarshalYAML(setReceiverName, name))
+}
+
+// setElemLess returns an expression that is true if the set element a sorts before b. Elements that are not ordered by
+// Go are ordered by their bytes (uuids) or by their string representation (rids and enums).
+func setElemLess(a, b *jen.Statement, item types.Type) *jen.Statement {
+	elemType := item
+	for {
+		alias, ok := elemType.(*types.AliasType)
+		if !ok {
+			break
+		}
+		elemType = alias.Item
+	}
+	switch elemType.(type) {
+	case types.Boolean:
+		return jen.Op("!").Add(a).Op("&&").Add(b)
+	case types.UUID:
+		return snip.BytesCompare().Call(a.Clone().Index(jen.Empty(), jen.Empty()), b.Clone().Index(jen.Empty(), jen.Empty())).Op("<").Lit(0)
+	case types.RID, *types.EnumType:
+		if elemType != item {
+			// aliases do not have the String method of the aliased type
+			a, b = elemType.Code().Call(a), elemType.Code().Call(b)
+		}
+		return a.Dot("String").Call().Op("<").Add(b.Dot("String").Call())
+	default:
+		return a.Op("<").Add(b)
+	}
+}
+
+// writeSetMethods writes the JSON methods of a named set type.
+func (e *jsonWriter) writeSetMethods(file *jen.Group, setDef *types.Set) {
+	name, item := setDef.Name, setDef.Item
+	elems := &types.List{Item: item}
+	e.writeMethod(file, setReceiverName, name, false, func(m *jsonMethod, body *jen.Group) {
+		body.Id(setElemsVarName).Op(":=").Id(setReceiverName).Dot("Sorted").Call()
+		m.value(body, jen.Id(setElemsVarName), elems, 0)
+	})
+	e.writeMethod(file, setReceiverName, name, true, func(m *jsonMethod, body *jen.Group) {
+		// The size does not depend on the order of the elements, so they are not sorted.
+		body.Var().Id(jsonSizeVarName).Int()
+		body.Id(jsonSizeVarName).Op("+=").Lit(2)
+		if _, isUUID := item.(types.UUID); isUUID {
+			// all elements have the same size
+			body.If(jen.Len(jen.Id(setReceiverName)).Op(">").Lit(0)).Block(
+				jen.Id(jsonSizeVarName).Op("+=").Len(jen.Id(setReceiverName)).Op("*").Lit(jsonUUIDSize + 1).Op("-").Lit(1),
+			)
+			return
+		}
+		body.If(jen.Len(jen.Id(setReceiverName)).Op(">").Lit(1)).Block(
+			jen.Id(jsonSizeVarName).Op("+=").Len(jen.Id(setReceiverName)).Op("-").Lit(1),
+		)
+		body.For(jen.Id(setElemVarName).Op(":=").Range().Id(setReceiverName)).BlockFunc(func(loop *jen.Group) {
+			m.value(loop, jen.Id(setElemVarName), item, 1)
+		})
+	})
+	writeMarshalJSON(file, setReceiverName, name)
+
+	// newSet assigns the set of the decoded elements to the receiver.
+	newSet := jen.Op("*").Id(setReceiverName).Op("=").Id("New" + name).Call(jen.Id(setElemsVarName).Op("..."))
+	e.writeUnmarshalMethods(file, setReceiverName, name, func(g *jen.Group) {
+		g.Var().Id(setElemsVarName).Add(elems.Code())
+		g.If(jen.Err().Op(":=").Add(snip.SafeJSONUnmarshal()).Call(jen.Id(dataVarName), jen.Op("&").Id(setElemsVarName)), jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		)
+		g.Add(newSet.Clone())
+		g.Return(jen.Nil())
+	}, func(u *jsonUnmarshaler, body *jen.Group) {
+		body.Var().Id(setElemsVarName).Add(elems.Code())
+		u.value(body, jen.Id(setElemsVarName), jen.Id(jsonValueVarName), elems)
+		body.Add(newSet.Clone())
+		body.Return(jen.True())
+	})
+	e.writeDecodeMethods(file, setReceiverName, name, func(d *jsonDecoder, body *jen.Group) {
+		body.Var().Err().Error()
+		body.Var().Id(setElemsVarName).Add(elems.Code())
+		d.listValue(body, jen.Id(setElemsVarName), jen.Id(jsonValueVarName), elems.Code(), item, true)
+		body.Add(newSet.Clone())
+		body.Return(jen.Nil())
+	})
+}
@@ -50,23 +50,36 @@ var DefaultImportsToPackageNames = map[string]string{
 	pal + "witchcraft-go-tracing/wzipkin":  "wzipkin",
 	wgs + "witchcraft/wresource":           "wresource",
 	wgs + "wrouter":                        "wrouter",
+	wgs + "wrouter/whttprouter":            "whttprouter",
 	"github.com/tidwall/gjson":             "gjson",
 	"gopkg.in/yaml.v3":                     "yaml",
 	"github.com/spf13/cobra":               "cobra",
 }
 
+// DefaultImportsToAliases are the aliases of imported packages whose names conflict with DefaultImportsToPackageNames.
+var DefaultImportsToAliases = map[string]string{
+	"errors": "stderrors",
+}
+
 // A set of imported references included in generated code.
 // Each entry is a func() *jen.Statement, typically the Clone method.
 // This ensures there are no side effects caused by mutating the global variables.
 var (
 	ByteReader          = jen.Qual("bytes", "NewReader").Clone
+	BytesCompare        = jen.Qual("bytes", "Compare").Clone
+	BytesEqual          = jen.Qual("bytes", "Equal").Clone
 	Context             = jen.Qual("context", "Context").Clone
 	ContextTODO         = jen.Qual("context", "TODO").Clone
 	ContextBackground   = jen.Qual("context", "Background").Clone
+	ContextWithValue    = jen.Qual("context", "WithValue").Clone
 	ContextVar          = jen.Id("ctx").Qual("context", "Context").Clone
 	Base64NewDecoder    = jen.Qual("encoding/base64", "NewDecoder").Clone
 	Base64StdEncoding   = jen.Qual("encoding/base64", "StdEncoding").Clone
+	JSONDecoder         = jen.Qual("encoding/json", "Decoder").Clone
+	JSONDelim           = jen.Qual("encoding/json", "Delim").Clone
 	JSONMarshalIndent   = jen.Qual("encoding/json", "MarshalIndent").Clone
+	JSONNumber          = jen.Qual("encoding/json", "Number").Clone
+	ErrorsIs            = jen.Qual("errors", "Is").Clone
 	FmtErrorf           = jen.Qual("fmt", "Errorf").Clone
 	FmtPrintf           = jen.Qual("fmt", "Printf").Clone
 	FmtFprintf          = jen.Qual("fmt", "Fprintf").Clone
@@ -77,16 +90,32 @@ var (
 	IOCopy              = jen.Qual("io", "Copy").Clone
 	IODiscard           = jen.Qual("io", "Discard").Clone
 	IOReader            = jen.Qual("io", "Reader").Clone
+	IOReadAll           = jen.Qual("io", "ReadAll").Clone
+	IOErrUnexpectedEOF  = jen.Qual("io", "ErrUnexpectedEOF").Clone
+	IOWriter            = jen.Qual("io", "Writer").Clone
+	IOWriteString       = jen.Qual("io", "WriteString").Clone
 	JSONMarshaler       = jen.Qual("encoding/json", "Marshaler").Clone
 	JSONUnmarshaler     = jen.Qual("encoding/json", "Unmarshaler").Clone
 	MathIsInf           = jen.Qual("math", "IsInf").Clone
+	MathAbs             = jen.Qual("math", "Abs").Clone
 	MathIsNaN           = jen.Qual("math", "IsNaN").Clone
 	MathInf             = jen.Qual("math", "Inf").Clone
 	MathNaN             = jen.Qual("math", "NaN").Clone
+	MathFloat64bits     = jen.Qual("math", "Float64bits").Clone
+	MathFloat64frombits = jen.Qual("math", "Float64frombits").Clone
+	MathFloat32frombits = jen.Qual("math", "Float32frombits").Clone
+	MathLdexp           = jen.Qual("math", "Ldexp").Clone
+	MathMaxInt32        = jen.Qual("math", "MaxInt32").Clone
+	MathMinInt32        = jen.Qual("math", "MinInt32").Clone
+	MathMaxInt64        = jen.Qual("math", "MaxInt64").Clone
+	MIMEParseMediaType  = jen.Qual("mime", "ParseMediaType").Clone
+	HTTPErrAbortHandler = jen.Qual("net/http", "ErrAbortHandler").Clone
+	HTTPFlusher         = jen.Qual("net/http", "Flusher").Clone
 	HTTPNoBody          = jen.Qual("net/http", "NoBody").Clone
 	HTTPStatusNoContent = jen.Qual("net/http", "StatusNoContent").Clone
 	HTTPRequest         = jen.Qual("net/http", "Request").Clone
 	HTTPResponseWriter  = jen.Qual("net/http", "ResponseWriter").Clone
+	HTTPTestNewServer   = jen.Qual("net/http/httptest", "NewServer").Clone
 	URLPathEscape       = jen.Qual("net/url", "PathEscape").Clone
 	URLValues           = jen.Qual("net/url", "Values").Clone
 	OSStdin             = jen.Qual("os", "Stdin").Clone
@@ -94,21 +123,47 @@ var (
 	OSReadFile          = jen.Qual("os", "ReadFile").Clone
 	OSOpen              = jen.Qual("os", "Open").Clone
 	ReflectTypeOf       = jen.Qual("reflect", "TypeOf").Clone
+	ReflectDeepEqual    = jen.Qual("reflect", "DeepEqual").Clone
 	StringsToUpper      = jen.Qual("strings", "ToUpper").Clone
 	StringsHasPrefix    = jen.Qual("strings", "HasPrefix").Clone
 	StringsTrimSpace    = jen.Qual("strings", "TrimSpace").Clone
+	StringsContains     = jen.Qual("strings", "Contains").Clone
+	StringsEqualFold    = jen.Qual("strings", "EqualFold").Clone
+	StringsJoin         = jen.Qual("strings", "Join").Clone
+	StringsSplit        = jen.Qual("strings", "Split").Clone
+	SortSlice           = jen.Qual("sort", "Slice").Clone
+	SortStrings         = jen.Qual("sort", "Strings").Clone
 	StrconvAppendFloat  = jen.Qual("strconv", "AppendFloat").Clone
+	StrconvAppendBool   = jen.Qual("strconv", "AppendBool").Clone
 	StrconvAppendInt    = jen.Qual("strconv", "AppendInt").Clone
 	StrconvAtoi         = jen.Qual("strconv", "Atoi").Clone
+	StrconvFormatBool   = jen.Qual("strconv", "FormatBool").Clone
+	StrconvFormatFloat  = jen.Qual("strconv", "FormatFloat").Clone
+	StrconvFormatInt    = jen.Qual("strconv", "FormatInt").Clone
 	StrconvItoa         = jen.Qual("strconv", "Itoa").Clone
 	StrconvParseBool    = jen.Qual("strconv", "ParseBool").Clone
 	StrconvParseFloat   = jen.Qual("strconv", "ParseFloat").Clone
+	StrconvParseInt     = jen.Qual("strconv", "ParseInt").Clone
 	StrconvQuote        = jen.Qual("strconv", "Quote").Clone
+	SyncMutex           = jen.Qual("sync", "Mutex").Clone
+	TestingTB           = jen.Qual("testing", "TB").Clone
+	TimeTime            = jen.Qual("time", "Time").Clone
+	TimeRFC3339Nano     = jen.Qual("time", "RFC3339Nano").Clone
+	TimeParse           = jen.Qual("time", "Parse").Clone
+	UTF8RuneError       = jen.Qual("unicode/utf8", "RuneError").Clone
+	UTF8RuneSelf        = jen.Qual("unicode/utf8", "RuneSelf").Clone
 	FuncIOReadCloser    = jen.Func().Params().Params(IOReadCloser()).Clone // 'func() io.ReadCloser', the type of to http.Request.GetBody.
 
+	EncodingTextMarshaler   = jen.Qual("encoding", "TextMarshaler").Clone
+	EncodingTextUnmarshaler = jen.Qual("encoding", "TextUnmarshaler").Clone
+	UTF8DecodeRuneInString  = jen.Qual("unicode/utf8", "DecodeRuneInString").Clone
+	UTF8ValidString         = jen.Qual("unicode/utf8", "ValidString").Clone
+
 	CGRClientClient                     = jen.Qual(cgr+"conjure-go-client/httpclient", "Client").Clone
 	CGRClientNewClient                  = jen.Qual(cgr+"conjure-go-client/httpclient", "NewClient").Clone
 	CGRClientClientConfig               = jen.Qual(cgr+"conjure-go-client/httpclient", "ClientConfig").Clone
+	CGRClientClientParam                = jen.Qual(cgr+"conjure-go-client/httpclient", "ClientParam").Clone
+	CGRClientWithBaseURLs               = jen.Qual(cgr+"conjure-go-client/httpclient", "WithBaseURLs").Clone
 	CGRClientWithConfig                 = jen.Qual(cgr+"conjure-go-client/httpclient", "WithConfig").Clone
 	CGRClientRequestParam               = jen.Qual(cgr+"conjure-go-client/httpclient", "RequestParam").Clone
 	CGRClientTokenProvider              = jen.Qual(cgr+"conjure-go-client/httpclient", "TokenProvider").Clone
@@ -122,6 +177,8 @@ var (
 	CGRClientWithRawResponseBody        = jen.Qual(cgr+"conjure-go-client/httpclient", "WithRawResponseBody").Clone
 	CGRClientWithRequiredResponse       = jen.Qual(cgr+"conjure-go-client/httpclient", "WithRequiredResponse").Clone
 	CGRClientWithRequestMethod          = jen.Qual(cgr+"conjure-go-client/httpclient", "WithRequestMethod").Clone
+	CGRClientWithRequestBody            = jen.Qual(cgr+"conjure-go-client/httpclient", "WithRequestBody").Clone
+	CGRClientWithResponseBody           = jen.Qual(cgr+"conjure-go-client/httpclient", "WithResponseBody").Clone
 	CGRClientWithRequestAppendFunc      = jen.Qual(cgr+"conjure-go-client/httpclient", "WithRequestAppendFunc").Clone
 	CGRClientWithResponseUnmarshalFunc  = jen.Qual(cgr+"conjure-go-client/httpclient", "WithResponseUnmarshalFunc").Clone
 	CGRCodecsBinary                     = jen.Qual(cgr+"conjure-go-contract/codecs", "Binary").Clone
@@ -164,6 +221,7 @@ var (
 	SafeLongSafeLong               = jen.Qual(pal+"pkg/safelong", "SafeLong").Clone
 	SafeJSONAppendFunc             = jen.Qual(pal+"pkg/safejson", "AppendFunc").Clone
 	SafeJSONAppendQuotedString     = jen.Qual(pal+"pkg/safejson", "AppendQuotedString").Clone
+	SafeJSONDecoder                = jen.Qual(pal+"pkg/safejson", "Decoder").Clone
 	SafeJSONMarshal                = jen.Qual(pal+"pkg/safejson", "Marshal").Clone
 	SafeJSONQuotedStringLength     = jen.Qual(pal+"pkg/safejson", "QuotedStringLength").Clone
 	SafeJSONQuoteString            = jen.Qual(pal+"pkg/safejson", "QuoteString").Clone
@@ -174,6 +232,7 @@ var (
 	UUIDNewUUID                    = jen.Qual(pal+"pkg/uuid", "NewUUID").Clone
 	UUIDParseUUID                  = jen.Qual(pal+"pkg/uuid", "ParseUUID").Clone
 
+	WerrorError           = jen.Qual(pal+"witchcraft-go-error", "Error").Clone
 	WerrorErrorContext    = jen.Qual(pal+"witchcraft-go-error", "ErrorWithContextParams").Clone
 	WerrorFormat          = jen.Qual(pal+"witchcraft-go-error", "Format").Clone
 	WerrorNewStackTrace   = jen.Qual(pal+"witchcraft-go-error", "NewStackTrace").Clone
@@ -184,6 +243,9 @@ var (
 	WerrorWrap            = jen.Qual(pal+"witchcraft-go-error", "Wrap").Clone
 	WerrorWrapContext     = jen.Qual(pal+"witchcraft-go-error", "WrapWithContextParams").Clone
 
+	WparamsNewSafeParam       = jen.Qual(pal+"witchcraft-go-params", "NewSafeParam").Clone
+	WparamsNewSafeParamStorer = jen.Qual(pal+"witchcraft-go-params", "NewSafeParamStorer").Clone
+
 	WGLLogSetDefaultLoggerProvider = jen.Qual(wgl+"wlog", "SetDefaultLoggerProvider").Clone
 	WGLLogNoopLoggerProvider       = jen.Qual(wgl+"wlog", "NewNoopLoggerProvider").Clone
 	WGLLogDebugLevel               = jen.Qual(wgl+"wlog", "DebugLevel").Clone
@@ -198,8 +260,12 @@ var (
 	WGTZipkinNewTracer             = jen.Qual(pal+"witchcraft-go-tracing/wzipkin", "NewTracer").Clone
 
 	WresourceNew                 = jen.Qual(wgs+"witchcraft/wresource", "New").Clone
+	WrouterNew                   = jen.Qual(wgs+"wrouter", "New").Clone
 	WrouterPathParams            = jen.Qual(wgs+"wrouter", "PathParams").Clone
 	WrouterRouteParam            = jen.Qual(wgs+"wrouter", "RouteParam").Clone
+	WrouterRouteMiddleware       = jen.Qual(wgs+"wrouter", "RouteMiddleware").Clone
+	WrouterRouteRequestHandler   = jen.Qual(wgs+"wrouter", "RouteRequestHandler").Clone
+	WrouterRequestVals           = jen.Qual(wgs+"wrouter", "RequestVals").Clone
 	WrouterRouter                = jen.Qual(wgs+"wrouter", "Router").Clone
 	WrouterForbiddenHeaderParams = jen.Qual(wgs+"wrouter", "ForbiddenHeaderParams").Clone
 	WrouterForbiddenPathParams   = jen.Qual(wgs+"wrouter", "ForbiddenPathParams").Clone
@@ -207,6 +273,7 @@ var (
 	WrouterSafeHeaderParams      = jen.Qual(wgs+"wrouter", "SafeHeaderParams").Clone
 	WrouterSafePathParams        = jen.Qual(wgs+"wrouter", "SafePathParams").Clone
 	WrouterSafeQueryParams       = jen.Qual(wgs+"wrouter", "SafeQueryParams").Clone
+	WhttprouterNew               = jen.Qual(wgs+"wrouter/whttprouter", "New").Clone
 
 	GJSONNull       = jen.Qual("github.com/tidwall/gjson", "Null").Clone
 	GJSONFalse      = jen.Qual("github.com/tidwall/gjson", "False").Clone
@@ -60,6 +60,24 @@ func MethodUnmarshalJSONStrict(receiverName, receiverType string) *jen.Statement
 		Id("UnmarshalJSONStrict").Params(jen.Id("data").Id("[]byte")).Params(jen.Error())
 }
 
+// MethodAppendCBOR returns 'func (o Foo) AppendCBOR(out []byte) ([]byte, error)'
+func MethodAppendCBOR(receiverName, receiverType string) *jen.Statement {
+	return jen.Func().Params(jen.Id(receiverName).Id(receiverType)).
+		Id("AppendCBOR").Params(jen.Id("out").Id("[]byte")).Params(jen.Id("[]byte"), jen.Error())
+}
+
+// MethodMarshalCBOR returns 'func (o Foo) MarshalCBOR() ([]byte, error)'
+func MethodMarshalCBOR(receiverName, receiverType string) *jen.Statement {
+	return jen.Func().Params(jen.Id(receiverName).Id(receiverType)).
+		Id("MarshalCBOR").Params().Params(jen.Id("[]byte"), jen.Error())
+}
+
+// MethodUnmarshalCBOR returns 'func (o *Foo) UnmarshalCBOR(data []byte) error'
+func MethodUnmarshalCBOR(receiverName, receiverType string) *jen.Statement {
+	return jen.Func().Params(jen.Id(receiverName).Op("*").Id(receiverType)).
+		Id("UnmarshalCBOR").Params(jen.Id("data").Id("[]byte")).Params(jen.Error())
+}
+
 // MethodUnmarshalString returns 'func (o *Foo) UnmarshalString(data string) error'
 func MethodUnmarshalString(receiverName, receiverType string) *jen.Statement {
 	return jen.Func().Params(jen.Id(receiverName).Op("*").Id(receiverType)).
@@ -0,0 +1,117 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"path"
+	"path/filepath"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/palantir/conjure-go/v6/conjure/snip"
+	"github.com/palantir/conjure-go/v6/conjure/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	testPairTestingVar      = "t"
+	testPairServerVar       = "server"
+	testPairOptionsVar      = "opts"
+	testPairOptionsTypeName = "TestPairOptions"
+	testPairPackageSuffix   = "test"
+)
+
+// testPairPackage returns the package into which the test pairs of the services of pkg are written. Like
+// net/http/httptest, it is a subpackage named "<pkg>test" so that the testing and net/http/httptest packages are not
+// imported by the generated package itself. Returns an error if a Conjure package is already generated into it.
+func testPairPackage(pkg types.ConjurePackage, def *types.ConjureDefinition) (types.ConjurePackage, error) {
+	name := pkg.PackageName + testPairPackageSuffix
+	testPkg := types.ConjurePackage{
+		ImportPath:  path.Join(pkg.ImportPath, name),
+		OutputDir:   filepath.Join(pkg.OutputDir, name),
+		PackageName: name,
+	}
+	for _, other := range def.Packages {
+		if other.ImportPath == testPkg.ImportPath {
+			return types.ConjurePackage{}, errors.Errorf("test pairs of Conjure package %s would be written to Go package %s of Conjure package %s", pkg.ConjurePackage, testPkg.ImportPath, other.ConjurePackage)
+		}
+	}
+	return testPkg, nil
+}
+
+// writeTestPairOptionsType writes the TestPairOptions type accepted by the New<Service>TestPair functions of a test pair
+// package. It is written once per package, before the functions.
+func writeTestPairOptionsType(file *jen.Group) {
+	file.Commentf("%s configures the server and client of a test pair. The zero value registers the routes", testPairOptionsTypeName).Line().
+		Comment("without route params and creates the client with only the base URL of the server.").Line().
+		Type().Id(testPairOptionsTypeName).Struct(
+			jen.Comment("RouteParams are passed to the route registration function of the service."),
+			jen.Id("RouteParams").Index().Add(snip.WrouterRouteParam()),
+			jen.Comment("ClientParams are applied after the base URL of the server."),
+			jen.Id("ClientParams").Index().Add(snip.CGRClientClientParam()),
+		)
+}
+
+// writeTestPairType writes a New<Service>TestPair function which serves an implementation of the service
+// from an httptest.Server and returns a client connected to it. The function is written into the package returned by
+// testPairPackage, so the server and client types are qualified by serviceImportPath, the import path of the package of
+// serviceDef. Requires the server types to be generated.
+func writeTestPairType(file *jen.Group, serviceImportPath string, serviceDef *types.ServiceDefinition) {
+	funcName := testPairFuncName(serviceDef.Name)
+	tFatalf := func(format string) *jen.Statement {
+		return jen.Id(testPairTestingVar).Dot("Fatalf").Call(jen.Lit(format), jen.Err())
+	}
+	file.Commentf("%s starts an httptest.Server serving impl using the routes registered by %s", funcName, routeRegistrationFuncName(serviceDef.Name)).Line().
+		Commentf("and returns a %s that sends requests to it, configured by opts. The server is closed when", clientInterfaceTypeName(serviceDef.Name)).Line().
+		Comment("the test completes.").Line().
+		Func().Id(funcName).
+		Params(
+			jen.Id(testPairTestingVar).Add(snip.TestingTB()),
+			jen.Id(implName).Qual(serviceImportPath, interfaceTypeName(serviceDef.Name)),
+			jen.Id(testPairOptionsVar).Id(testPairOptionsTypeName),
+		).
+		Params(jen.Qual(serviceImportPath, clientInterfaceTypeName(serviceDef.Name))).
+		Block(
+			jen.Id(testPairTestingVar).Dot("Helper").Call(),
+			jen.Id(routerVarName).Op(":=").Add(snip.WrouterNew()).Call(snip.WhttprouterNew().Call()),
+			jen.If(
+				jen.Err().Op(":=").Qual(serviceImportPath, routeRegistrationFuncName(serviceDef.Name)).Call(
+					jen.Id(routerVarName),
+					jen.Id(implName),
+					jen.Id(testPairOptionsVar).Dot("RouteParams").Op("..."),
+				),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				tFatalf("failed to register "+serviceDef.Name+" routes: %v"),
+			),
+			jen.Id(testPairServerVar).Op(":=").Add(snip.HTTPTestNewServer()).Call(jen.Id(routerVarName)),
+			jen.Id(testPairTestingVar).Dot("Cleanup").Call(jen.Id(testPairServerVar).Dot("Close")),
+			jen.List(jen.Id(wrappedClientVar), jen.Err()).Op(":=").Add(snip.CGRClientNewClient()).Call(
+				jen.Append(
+					jen.Index().Add(snip.CGRClientClientParam()).Values(
+						snip.CGRClientWithBaseURLs().Call(jen.Index().String().Values(jen.Id(testPairServerVar).Dot("URL"))),
+					),
+					jen.Id(testPairOptionsVar).Dot("ClientParams").Op("..."),
+				).Op("..."),
+			),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				tFatalf("failed to create "+serviceDef.Name+" client: %v"),
+			),
+			jen.Return(jen.Qual(serviceImportPath, "New"+clientInterfaceTypeName(serviceDef.Name)).Call(jen.Id(wrappedClientVar))),
+		)
+}
+
+func testPairFuncName(serviceName string) string {
+	return "New" + interfaceTypeName(serviceName) + "TestPair"
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/palantir/conjure-go/v6/conjure/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestPairPackage(t *testing.T) {
+	pkg := types.ConjurePackage{
+		ConjurePackage: "com.palantir.foo",
+		ImportPath:     "github.com/palantir/foo/api",
+		OutputDir:      filepath.Join("out", "api"),
+		PackageName:    "api",
+	}
+	def := &types.ConjureDefinition{Packages: map[string]types.ConjurePackage{pkg.ConjurePackage: pkg}}
+	testPkg, err := testPairPackage(pkg, def)
+	require.NoError(t, err)
+	assert.Equal(t, types.ConjurePackage{
+		ImportPath:  "github.com/palantir/foo/api/apitest",
+		OutputDir:   filepath.Join("out", "api", "apitest"),
+		PackageName: "apitest",
+	}, testPkg)
+
+	def.Packages["com.palantir.foo.apitest"] = types.ConjurePackage{ConjurePackage: "com.palantir.foo.apitest", ImportPath: "github.com/palantir/foo/api/apitest"}
+	_, err = testPairPackage(pkg, def)
+	assert.EqualError(t, err, "test pairs of Conjure package com.palantir.foo would be written to Go package github.com/palantir/foo/api/apitest of Conjure package com.palantir.foo.apitest")
+}
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"path"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 	"unicode"
@@ -41,6 +42,10 @@ type ConjurePackage struct {
 	ImportPath     string
 	OutputDir      string
 	PackageName    string
+	// External is true if the Go package for this Conjure package already exists (see Options.ExternalPackages and
+	// PackagePathRule.ImportPath).
+	// Generated code refers to the types of external packages, but no files are generated for them.
+	External bool
 
 	Aliases  []*AliasType
 	Enums    []*EnumType
@@ -48,15 +53,47 @@ type ConjurePackage struct {
 	Unions   []*UnionType
 	Errors   []*ErrorDefinition
 	Services []*ServiceDefinition
+	// Sets contains the named set types of the package, one per element type (see Options.SetTypes).
+	Sets []*Set
+}
+
+// Options configures how NewConjureDefinitionWithOptions translates Conjure packages into Go packages.
+type Options struct {
+	// OutputDir is the base directory into which generated packages are written.
+	OutputDir string
+	// PackageOutputDir optionally returns the base directory into which the provided Conjure package is written.
+	// If it is nil or returns an empty string, OutputDir is used.
+	PackageOutputDir func(conjurePkg string) string
+	// PackagePaths overrides the default mapping from Conjure packages to Go packages (see transforms.PackagePath)
+	// for the packages that match a rule.
+	PackagePaths []PackagePathRule
+	// ExternalPackages maps Conjure packages to the import paths of existing Go packages that already contain the
+	// generated code for them, for example because they are defined in a shared IR that is generated in another
+	// module. Generated code refers to the types in these packages, but no files are generated for them.
+	// ExternalPackages take precedence over PackagePaths.
+	ExternalPackages map[string]string
+	// SetTypes optionally returns true if the sets of the provided Conjure package are represented by named set types
+	// instead of slices. Named set types are only used for sets of elements that Go can compare using ==: strings,
+	// bearertokens, integers, safelongs, booleans, uuids, rids, enums and aliases of them. Sets of other elements and
+	// the arguments of errors are always represented as slices.
+	SetTypes func(conjurePkg string) bool
+	// EndpointErrors optionally returns true if the endpoints of the services of the provided Conjure package resolve
+	// the errors they declare using tags with ErrorTagPrefix. Declared errors must be defined by the definition.
+	// If it is nil or returns false, the tags are ignored and EndpointDefinition.Errors is empty.
+	EndpointErrors func(conjurePkg string) bool
 }
 
 func NewConjureDefinition(outputBaseDir string, def spec.ConjureDefinition) (*ConjureDefinition, error) {
+	return NewConjureDefinitionWithOptions(def, Options{OutputDir: outputBaseDir})
+}
+
+func NewConjureDefinitionWithOptions(def spec.ConjureDefinition, opts Options) (*ConjureDefinition, error) {
 	def, err := cycles.RemovePackageCycles(def)
 	if err != nil {
 		return nil, werror.Wrap(err, "failed to remove package cycles")
 	}
 
-	paths, err := newPathTranslator(outputBaseDir)
+	paths, err := newPackagePathTranslator(def, opts.OutputDir, opts.PackageOutputDir, opts.PackagePaths, opts.ExternalPackages)
 	if err != nil {
 		return nil, err
 	}
@@ -142,9 +179,9 @@ func NewConjureDefinition(outputBaseDir string, def spec.ConjureDefinition) (*Co
 
 	// Types are finished, move on to errors and services
 
+	errorsByWireName := make(map[string]*ErrorDefinition, len(def.Errors))
 	for _, def := range def.Errors {
-		pkgTypes := packages[def.ErrorName.Package]
-		pkgTypes.Errors = append(pkgTypes.Errors, &ErrorDefinition{
+		errorDef := &ErrorDefinition{
 			Docs:           Docs(transforms.Documentation(def.Docs)),
 			Name:           def.ErrorName.Name,
 			ErrorNamespace: def.Namespace,
@@ -153,14 +190,21 @@ func NewConjureDefinition(outputBaseDir string, def spec.ConjureDefinition) (*Co
 			UnsafeArgs:     newFields(names, def.UnsafeArgs, nil),
 			conjurePkg:     def.ErrorName.Package,
 			importPath:     paths.conjurePkgToGoPkg(def.ErrorName.Package),
-		})
+		}
+		errorsByWireName[errorDef.WireName()] = errorDef
+		pkgTypes := packages[def.ErrorName.Package]
+		pkgTypes.Errors = append(pkgTypes.Errors, errorDef)
 		packages[def.ErrorName.Package] = pkgTypes
 	}
 
 	for _, def := range def.Services {
 		var endpoints []*EndpointDefinition
+		endpointErrors := errorsByWireName
+		if opts.EndpointErrors == nil || !opts.EndpointErrors(def.ServiceName.Package) {
+			endpointErrors = nil
+		}
 		for _, endpointDef := range def.Endpoints {
-			endpoint, err := newEndpointDefinition(names, endpointDef)
+			endpoint, err := newEndpointDefinition(names, endpointErrors, endpointDef)
 			if err != nil {
 				return nil, err
 			}
@@ -182,8 +226,26 @@ func NewConjureDefinition(outputBaseDir string, def spec.ConjureDefinition) (*Co
 		pkg.ImportPath = paths.conjurePkgToGoPkg(pkgName)
 		pkg.OutputDir = paths.conjurePkgToFilePath(pkgName)
 		pkg.PackageName = sanitizePackageName(pkg.ImportPath)
+		pkg.External = paths.isExternal(pkgName)
 		packages[pkgName] = pkg
 	}
+	pkgNames := make([]string, 0, len(packages))
+	for pkgName := range packages {
+		pkgNames = append(pkgNames, pkgName)
+	}
+	sort.Strings(pkgNames)
+	importPathPackages := map[string]string{}
+	for _, pkgName := range pkgNames {
+		pkg := packages[pkgName]
+		if other, ok := importPathPackages[pkg.ImportPath]; ok {
+			return nil, errors.Errorf("Conjure packages %s and %s both map to Go package %s", other, pkgName, pkg.ImportPath)
+		}
+		importPathPackages[pkg.ImportPath] = pkgName
+		if !pkg.External && opts.SetTypes != nil && opts.SetTypes(pkgName) {
+			assignSetTypes(&pkg)
+			packages[pkgName] = pkg
+		}
+	}
 	return &ConjureDefinition{
 		Version:    def.Version,
 		Packages:   packages,
@@ -253,7 +315,7 @@ func (t *namedTypes) GetBySpec(typ spec.Type) (out Type) {
 			return nil
 		},
 		func(set spec.SetType) error {
-			out = &List{Item: t.GetBySpec(set.ItemType)}
+			out = &Set{Item: t.GetBySpec(set.ItemType)}
 			return nil
 		},
 		func(map_ spec.MapType) error {
@@ -301,6 +363,16 @@ func (t *namedTypes) resolveType(typeI Type) error {
 		} else if err := t.resolveType(v.Item); err != nil {
 			return err
 		}
+	case *Set:
+		if unresolved, ok := v.Item.(unresolvedReferencePlaceholder); ok {
+			if resolved := t.GetByName(unresolved.Ref); resolved != nil {
+				v.Item = resolved
+			} else {
+				return errors.Errorf("Unresolved set item type reference %s %s", unresolved.Ref.Package, unresolved.Ref.Name)
+			}
+		} else if err := t.resolveType(v.Item); err != nil {
+			return err
+		}
 	case *Map:
 		if unresolved, ok := v.Key.(unresolvedReferencePlaceholder); ok {
 			if resolved := t.GetByName(unresolved.Ref); resolved != nil {
@@ -414,7 +486,9 @@ func newFields(names *namedTypes, structDefs []spec.FieldDefinition, enumDefs []
 	return fields
 }
 
-func newEndpointDefinition(names *namedTypes, def spec.EndpointDefinition) (*EndpointDefinition, error) {
+// newEndpointDefinition returns the definition of def. If errorsByWireName is nil, the errors that def declares are not
+// resolved.
+func newEndpointDefinition(names *namedTypes, errorsByWireName map[string]*ErrorDefinition, def spec.EndpointDefinition) (*EndpointDefinition, error) {
 	endpoint := &EndpointDefinition{
 		Docs:         Docs(transforms.Documentation(def.Docs)),
 		Deprecated:   Docs(transforms.Documentation(def.Deprecated)),
@@ -424,6 +498,16 @@ func newEndpointDefinition(names *namedTypes, def spec.EndpointDefinition) (*End
 		Markers:      newMarkers(names, def.Markers),
 		Tags:         def.Tags,
 	}
+	for _, tag := range def.Tags {
+		if errorsByWireName == nil || !strings.HasPrefix(tag, ErrorTagPrefix) {
+			continue
+		}
+		errorDef, ok := errorsByWireName[strings.TrimPrefix(tag, ErrorTagPrefix)]
+		if !ok {
+			return nil, fmt.Errorf("endpoint %s declares unknown error %q", def.EndpointName, strings.TrimPrefix(tag, ErrorTagPrefix))
+		}
+		endpoint.Errors = append(endpoint.Errors, errorDef)
+	}
 	if def.Auth != nil {
 		if err := def.Auth.AcceptFuncs(
 			func(spec.HeaderAuthType) error {
@@ -918,6 +918,226 @@ func TestRecursiveTypeDefinition(t *testing.T) {
 	require.Equal(t, want, out)
 }
 
+func TestNewConjureDefinitionWithOptions_PackageOutputDir(t *testing.T) {
+	def := spec.ConjureDefinition{
+		Version: 1,
+		Types: []spec.TypeDefinition{
+			spec.NewTypeDefinitionFromAlias(spec.AliasDefinition{
+				TypeName: spec.TypeName{Package: "com.palantir.foo", Name: "Foo"},
+				Alias:    spec.NewTypeFromPrimitive(spec.New_PrimitiveType(spec.PrimitiveType_STRING)),
+			}),
+			spec.NewTypeDefinitionFromAlias(spec.AliasDefinition{
+				TypeName: spec.TypeName{Package: "com.palantir.bar", Name: "Bar"},
+				Alias:    spec.NewTypeFromReference(spec.TypeName{Package: "com.palantir.foo", Name: "Foo"}),
+			}),
+		},
+	}
+	out, err := NewConjureDefinitionWithOptions(def, Options{
+		OutputDir: ".",
+		PackageOutputDir: func(conjurePkg string) string {
+			if conjurePkg == "com.palantir.foo" {
+				return "./test"
+			}
+			return ""
+		},
+	})
+	require.NoError(t, err)
+
+	fooPkg := out.Packages["com.palantir.foo"]
+	assert.Equal(t, "github.com/palantir/conjure-go/v6/conjure/types/test/com/palantir/foo", fooPkg.ImportPath)
+	assert.Equal(t, "test/com/palantir/foo", fooPkg.OutputDir)
+	barPkg := out.Packages["com.palantir.bar"]
+	assert.Equal(t, "github.com/palantir/conjure-go/v6/conjure/types/com/palantir/bar", barPkg.ImportPath)
+	assert.Equal(t, "com/palantir/bar", barPkg.OutputDir)
+	// references to the relocated package use its import path
+	require.Len(t, barPkg.Aliases, 1)
+	assert.Equal(t, fooPkg.ImportPath, barPkg.Aliases[0].Item.(*AliasType).importPath)
+}
+
+func TestNewConjureDefinitionWithOptions_PackagePaths(t *testing.T) {
+	stringType := spec.NewTypeFromPrimitive(spec.New_PrimitiveType(spec.PrimitiveType_STRING))
+	aliasDef := func(pkg, name string, alias spec.Type) spec.TypeDefinition {
+		return spec.NewTypeDefinitionFromAlias(spec.AliasDefinition{
+			TypeName: spec.TypeName{Package: pkg, Name: name},
+			Alias:    alias,
+		})
+	}
+	def := spec.ConjureDefinition{
+		Version: 1,
+		Types: []spec.TypeDefinition{
+			aliasDef("com.company.product", "Product", stringType),
+			aliasDef("com.company.product.area.internal", "Area", stringType),
+			aliasDef("com.company.shared.api", "Shared", stringType),
+			aliasDef("com.company.other.api", "Other", spec.NewTypeFromReference(spec.TypeName{Package: "com.company.shared.api", Name: "Shared"})),
+		},
+	}
+	out, err := NewConjureDefinitionWithOptions(def, Options{
+		OutputDir: ".",
+		PackagePaths: []PackagePathRule{
+			{ConjurePackage: "com.company", Path: "company"},
+			{ConjurePackage: "com.company.product", Path: "product"},
+			{ConjurePackage: "com.company.shared", ImportPath: "github.com/company/shared/conjure"},
+		},
+	})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		conjurePkg string
+		importPath string
+		outputDir  string
+		external   bool
+	}{
+		{
+			conjurePkg: "com.company.product",
+			importPath: "github.com/palantir/conjure-go/v6/conjure/types/product",
+			outputDir:  "product",
+		},
+		{
+			conjurePkg: "com.company.product.area.internal",
+			importPath: "github.com/palantir/conjure-go/v6/conjure/types/product/area/internal_",
+			outputDir:  "product/area/internal_",
+		},
+		{
+			conjurePkg: "com.company.other.api",
+			importPath: "github.com/palantir/conjure-go/v6/conjure/types/company/other/api",
+			outputDir:  "company/other/api",
+		},
+		{
+			conjurePkg: "com.company.shared.api",
+			importPath: "github.com/company/shared/conjure/api",
+			external:   true,
+		},
+	} {
+		pkg := out.Packages[tc.conjurePkg]
+		assert.Equal(t, tc.importPath, pkg.ImportPath, tc.conjurePkg)
+		assert.Equal(t, tc.outputDir, pkg.OutputDir, tc.conjurePkg)
+		assert.Equal(t, tc.external, pkg.External, tc.conjurePkg)
+	}
+	// references to external packages use the import path of the existing package
+	otherAlias := out.Packages["com.company.other.api"].Aliases[0]
+	assert.Equal(t, "github.com/company/shared/conjure/api", otherAlias.Item.(*AliasType).importPath)
+}
+
+func TestNewConjureDefinitionWithOptions_ExternalPackages(t *testing.T) {
+	def := spec.ConjureDefinition{
+		Version: 1,
+		Types: []spec.TypeDefinition{
+			spec.NewTypeDefinitionFromObject(spec.ObjectDefinition{
+				TypeName: spec.TypeName{Package: "com.company.shared.api", Name: "Shared"},
+			}),
+			spec.NewTypeDefinitionFromAlias(spec.AliasDefinition{
+				TypeName: spec.TypeName{Package: "com.company.product.api", Name: "Product"},
+				Alias:    spec.NewTypeFromReference(spec.TypeName{Package: "com.company.shared.api", Name: "Shared"}),
+			}),
+		},
+	}
+	out, err := NewConjureDefinitionWithOptions(def, Options{
+		OutputDir: ".",
+		PackagePaths: []PackagePathRule{
+			{ConjurePackage: "com.company", Path: "company"},
+		},
+		ExternalPackages: map[string]string{
+			"com.company.shared.api": "github.com/company/shared/api",
+		},
+	})
+	require.NoError(t, err)
+
+	sharedPkg := out.Packages["com.company.shared.api"]
+	assert.True(t, sharedPkg.External)
+	assert.Equal(t, "github.com/company/shared/api", sharedPkg.ImportPath)
+	assert.Equal(t, "", sharedPkg.OutputDir)
+	productPkg := out.Packages["com.company.product.api"]
+	assert.False(t, productPkg.External)
+	assert.Equal(t, "github.com/palantir/conjure-go/v6/conjure/types/company/product/api", productPkg.ImportPath)
+	assert.Equal(t, "github.com/company/shared/api", productPkg.Aliases[0].Item.(*ObjectType).importPath)
+}
+
+func TestNewConjureDefinitionWithOptions_PackagePathsErrors(t *testing.T) {
+	def := spec.ConjureDefinition{
+		Version: 1,
+		Types: []spec.TypeDefinition{
+			spec.NewTypeDefinitionFromAlias(spec.AliasDefinition{
+				TypeName: spec.TypeName{Package: "com.company.foo", Name: "Foo"},
+				Alias:    spec.NewTypeFromPrimitive(spec.New_PrimitiveType(spec.PrimitiveType_STRING)),
+			}),
+			spec.NewTypeDefinitionFromAlias(spec.AliasDefinition{
+				TypeName: spec.TypeName{Package: "com.company.bar", Name: "Bar"},
+				Alias:    spec.NewTypeFromPrimitive(spec.New_PrimitiveType(spec.PrimitiveType_STRING)),
+			}),
+		},
+	}
+	for _, tc := range []struct {
+		name  string
+		rules []PackagePathRule
+		err   string
+	}{
+		{
+			name:  "path and import path",
+			rules: []PackagePathRule{{ConjurePackage: "com.company.foo", Path: "foo", ImportPath: "github.com/company/foo"}},
+			err:   "package path rule for com.company.foo must specify exactly one of path and import path",
+		},
+		{
+			name:  "path outside of output directory",
+			rules: []PackagePathRule{{ConjurePackage: "com.company.foo", Path: "../foo"}},
+			err:   `package path rule for com.company.foo must specify a clean relative path, was "../foo"`,
+		},
+		{
+			name: "colliding packages",
+			rules: []PackagePathRule{
+				{ConjurePackage: "com.company.foo", Path: "api"},
+				{ConjurePackage: "com.company.bar", Path: "api"},
+			},
+			err: "Conjure packages com.company.bar and com.company.foo both map to Go package github.com/palantir/conjure-go/v6/conjure/types/api",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewConjureDefinitionWithOptions(def, Options{OutputDir: ".", PackagePaths: tc.rules})
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestNewConjureDefinition_EndpointErrors(t *testing.T) {
+	newDef := func(tags ...string) spec.ConjureDefinition {
+		return spec.ConjureDefinition{
+			Version: 1,
+			Errors: []spec.ErrorDefinition{{
+				ErrorName: spec.TypeName{Package: "com.palantir.foo", Name: "FooNotFound"},
+				Namespace: "Foo",
+				Code:      spec.New_ErrorCode(spec.ErrorCode_NOT_FOUND),
+			}},
+			Services: []spec.ServiceDefinition{{
+				ServiceName: spec.TypeName{Package: "com.palantir.foo", Name: "FooService"},
+				Endpoints: []spec.EndpointDefinition{{
+					EndpointName: "getFoo",
+					HttpMethod:   spec.New_HttpMethod(spec.HttpMethod_GET),
+					HttpPath:     "/foo",
+					Tags:         tags,
+				}},
+			}},
+		}
+	}
+	opts := Options{
+		EndpointErrors: func(conjurePkg string) bool {
+			return conjurePkg == "com.palantir.foo"
+		},
+	}
+	out, err := NewConjureDefinitionWithOptions(newDef("read", "error:Foo:FooNotFound"), opts)
+	require.NoError(t, err)
+	pkg := out.Packages["com.palantir.foo"]
+	require.Len(t, pkg.Services, 1)
+	assert.Equal(t, []*ErrorDefinition{pkg.Errors[0]}, pkg.Services[0].Endpoints[0].Errors)
+	assert.Equal(t, "Foo:FooNotFound", pkg.Errors[0].WireName())
+
+	_, err = NewConjureDefinitionWithOptions(newDef("error:Foo:BarNotFound"), opts)
+	require.EqualError(t, err, `endpoint getFoo declares unknown error "Foo:BarNotFound"`)
+
+	// without EndpointErrors, error tags are ignored
+	out, err = NewConjureDefinition("", newDef("error:Foo:BarNotFound"))
+	require.NoError(t, err)
+	assert.Empty(t, out.Packages["com.palantir.foo"].Services[0].Endpoints[0].Errors)
+}
+
 func TestNewConjureDefinition_ConjureAPI(t *testing.T) {
 	apiBody, err := ioutil.ReadFile("../../conjure-api/conjure-api-4.35.0.conjure.json")
 	require.NoError(t, err)
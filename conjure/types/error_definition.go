@@ -15,6 +15,8 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/dave/jennifer/jen"
 	"github.com/palantir/conjure-go/v6/conjure-api/conjure/spec"
 )
@@ -33,3 +35,8 @@ type ErrorDefinition struct {
 func (t *ErrorDefinition) Code() *jen.Statement {
 	return jen.Qual(t.importPath, t.Name)
 }
+
+// WireName returns the name that identifies the error in serialized Conjure errors, "<namespace>:<name>".
+func (t *ErrorDefinition) WireName() string {
+	return fmt.Sprintf("%s:%s", t.ErrorNamespace, t.Name)
+}
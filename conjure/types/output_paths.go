@@ -22,6 +22,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/palantir/conjure-go/v6/conjure-api/conjure/spec"
 	"github.com/palantir/conjure-go/v6/conjure/transforms"
 	"github.com/pkg/errors"
 	"golang.org/x/tools/go/packages"
@@ -44,6 +45,175 @@ type pathTranslator struct {
 	outputPkgBasePath string
 }
 
+// PackagePathRule maps a Conjure package, and all of the packages nested under it, to a Go package.
+type PackagePathRule struct {
+	// ConjurePackage is the Conjure package matched by the rule, e.g. "com.company.product". The rule also matches
+	// nested packages such as "com.company.product.api". If multiple rules match a package, the most specific is used.
+	ConjurePackage string `yaml:"conjure-package"`
+	// Path is the slash-delimited path of the Go package for ConjurePackage relative to the base output directory.
+	// Nested packages are generated in subdirectories of Path: for example, if ConjurePackage is "com.company.product"
+	// and Path is "product", then "com.company.product.api" is generated in "product/api".
+	Path string `yaml:"path,omitempty"`
+	// ImportPath is the import path of an existing Go package that already contains the generated code for
+	// ConjurePackage (nested packages map to sub-packages of ImportPath). Generated code refers to such packages, but
+	// no files are generated for them. Exactly one of Path and ImportPath must be set.
+	ImportPath string `yaml:"import-path,omitempty"`
+}
+
+func (r PackagePathRule) validate() error {
+	switch {
+	case r.ConjurePackage == "":
+		return errors.New("package path rule must specify a Conjure package")
+	case (r.Path == "") == (r.ImportPath == ""):
+		return errors.Errorf("package path rule for %s must specify exactly one of path and import path", r.ConjurePackage)
+	case r.Path != "" && (path.IsAbs(r.Path) || path.Clean(r.Path) != r.Path || strings.HasPrefix(r.Path, "..")):
+		return errors.Errorf("package path rule for %s must specify a clean relative path, was %q", r.ConjurePackage, r.Path)
+	}
+	return nil
+}
+
+// packagePathTranslator translates Conjure packages using the pathTranslator for the output directory of each package
+// and the most specific matching PackagePathRule, if any. Packages in externalPackages are resolved to the provided
+// import paths.
+type packagePathTranslator struct {
+	defaultPaths     pathTranslator
+	packagePaths     map[string]pathTranslator
+	rules            []PackagePathRule
+	externalPackages map[string]string
+}
+
+// newPackagePathTranslator returns a packagePathTranslator for all of the packages in def. The base output directory of
+// each package is the value returned by packageOutputDir, or outputDir if packageOutputDir is nil or returns "".
+func newPackagePathTranslator(def spec.ConjureDefinition, outputDir string, packageOutputDir func(conjurePkg string) string, rules []PackagePathRule, externalPackages map[string]string) (packagePathTranslator, error) {
+	for _, rule := range rules {
+		if err := rule.validate(); err != nil {
+			return packagePathTranslator{}, err
+		}
+	}
+	for conjurePkg, importPath := range externalPackages {
+		if importPath == "" {
+			return packagePathTranslator{}, errors.Errorf("external package %s must specify an import path", conjurePkg)
+		}
+	}
+	defaultPaths, err := newPathTranslator(outputDir)
+	if err != nil {
+		return packagePathTranslator{}, err
+	}
+	dirPaths := map[string]pathTranslator{outputDir: defaultPaths}
+	packagePaths := map[string]pathTranslator{}
+	for _, conjurePkg := range conjurePackages(def) {
+		dir := outputDir
+		if packageOutputDir != nil {
+			if pkgDir := packageOutputDir(conjurePkg); pkgDir != "" {
+				dir = pkgDir
+			}
+		}
+		paths, ok := dirPaths[dir]
+		if !ok {
+			paths, err = newPathTranslator(dir)
+			if err != nil {
+				return packagePathTranslator{}, errors.Wrapf(err, "failed to determine output path for package %s", conjurePkg)
+			}
+			dirPaths[dir] = paths
+		}
+		packagePaths[conjurePkg] = paths
+	}
+	return packagePathTranslator{
+		defaultPaths:     defaultPaths,
+		packagePaths:     packagePaths,
+		rules:            rules,
+		externalPackages: externalPackages,
+	}, nil
+}
+
+func (p packagePathTranslator) paths(conjurePkg string) pathTranslator {
+	if paths, ok := p.packagePaths[conjurePkg]; ok {
+		return paths
+	}
+	return p.defaultPaths
+}
+
+// rule returns the most specific rule that matches conjurePkg and the Go path of conjurePkg relative to the path
+// specified by the rule.
+func (p packagePathTranslator) rule(conjurePkg string) (PackagePathRule, string, bool) {
+	var match PackagePathRule
+	var matched bool
+	for _, rule := range p.rules {
+		if conjurePkg != rule.ConjurePackage && !strings.HasPrefix(conjurePkg, rule.ConjurePackage+".") {
+			continue
+		}
+		if !matched || len(rule.ConjurePackage) > len(match.ConjurePackage) {
+			match, matched = rule, true
+		}
+	}
+	if !matched || conjurePkg == match.ConjurePackage {
+		return match, "", matched
+	}
+	return match, transforms.JoinPackagePathParts(strings.Split(strings.TrimPrefix(conjurePkg, match.ConjurePackage+"."), ".")...), true
+}
+
+// isExternal returns true if the Go package for conjurePkg already exists and should not be generated.
+func (p packagePathTranslator) isExternal(conjurePkg string) bool {
+	if _, ok := p.externalPackages[conjurePkg]; ok {
+		return true
+	}
+	rule, _, ok := p.rule(conjurePkg)
+	return ok && rule.ImportPath != ""
+}
+
+func (p packagePathTranslator) conjurePkgToGoPkg(conjurePkg string) string {
+	if importPath, ok := p.externalPackages[conjurePkg]; ok {
+		return importPath
+	}
+	paths := p.paths(conjurePkg)
+	rule, relPath, ok := p.rule(conjurePkg)
+	switch {
+	case !ok:
+		return paths.conjurePkgToGoPkg(conjurePkg)
+	case rule.ImportPath != "":
+		return path.Join(rule.ImportPath, relPath)
+	default:
+		return path.Join(paths.outputPkgBasePath, rule.Path, relPath)
+	}
+}
+
+// conjurePkgToFilePath returns the directory into which the files for conjurePkg are generated, or an empty string if
+// the package is external.
+func (p packagePathTranslator) conjurePkgToFilePath(conjurePkg string) string {
+	if p.isExternal(conjurePkg) {
+		return ""
+	}
+	return p.paths(conjurePkg).goPkgToFilePath(p.conjurePkgToGoPkg(conjurePkg))
+}
+
+// conjurePackages returns the names of all of the packages that contain types, errors or services in def.
+func conjurePackages(def spec.ConjureDefinition) []string {
+	seen := map[string]struct{}{}
+	var pkgs []string
+	add := func(pkg string) {
+		if _, ok := seen[pkg]; !ok {
+			seen[pkg] = struct{}{}
+			pkgs = append(pkgs, pkg)
+		}
+	}
+	for _, typeDef := range def.Types {
+		_ = typeDef.AcceptFuncs(
+			func(def spec.AliasDefinition) error { add(def.TypeName.Package); return nil },
+			func(def spec.EnumDefinition) error { add(def.TypeName.Package); return nil },
+			func(def spec.ObjectDefinition) error { add(def.TypeName.Package); return nil },
+			func(def spec.UnionDefinition) error { add(def.TypeName.Package); return nil },
+			func(string) error { return nil },
+		)
+	}
+	for _, errorDef := range def.Errors {
+		add(errorDef.ErrorName.Package)
+	}
+	for _, serviceDef := range def.Services {
+		add(serviceDef.ServiceName.Package)
+	}
+	return pkgs
+}
+
 func (p pathTranslator) conjurePkgToGoPkg(conjurePkg string) string {
 	return path.Join(p.outputPkgBasePath, transforms.PackagePath(conjurePkg))
 }
@@ -28,6 +28,10 @@ const (
 	BodyParam
 )
 
+// ErrorTagPrefix is the prefix of endpoint tags that declare the errors an endpoint may return. The rest of the tag is
+// the name of the error in serialized Conjure errors, "<namespace>:<name>", such as "error:MyNamespace:MyNotFound".
+const ErrorTagPrefix = "error:"
+
 type ServiceDefinition struct {
 	Docs
 	Name       string
@@ -45,6 +49,15 @@ func (d ServiceDefinition) HasHeaderAuth() bool {
 	return false
 }
 
+func (d ServiceDefinition) HasEndpointErrors() bool {
+	for _, endpoint := range d.Endpoints {
+		if len(endpoint.Errors) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func (d ServiceDefinition) HasCookieAuth() bool {
 	for _, endpoint := range d.Endpoints {
 		if endpoint.CookieAuth != nil {
@@ -67,6 +80,7 @@ type EndpointDefinition struct {
 	Returns      *Type // nil if no return
 	Markers      []Type
 	Tags         []string
+	Errors       []*ErrorDefinition // errors declared using tags with the ErrorTagPrefix prefix
 }
 
 type EndpointArgumentDefinition struct {
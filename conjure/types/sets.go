@@ -0,0 +1,121 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// assignSetTypes names the sets contained in the aliases, objects, unions and services of pkg whose elements can be
+// compared using == and records the named set types in pkg.Sets. The set type of the elements of type T is named
+// <T>Set. A set remains a slice if that name is already used by a type of the package or by the set type of other
+// elements.
+func assignSetTypes(pkg *ConjurePackage) {
+	declared := map[string]struct{}{}
+	for _, alias := range pkg.Aliases {
+		declared[alias.Name] = struct{}{}
+	}
+	for _, enum := range pkg.Enums {
+		declared[enum.Name] = struct{}{}
+	}
+	for _, object := range pkg.Objects {
+		declared[object.Name] = struct{}{}
+	}
+	for _, union := range pkg.Unions {
+		declared[union.Name] = struct{}{}
+	}
+	for _, errorDef := range pkg.Errors {
+		declared[errorDef.Name] = struct{}{}
+	}
+	sets := map[string]*Set{}
+	var visit func(typ Type)
+	visit = func(typ Type) {
+		switch t := typ.(type) {
+		case *Optional:
+			visit(t.Item)
+		case *List:
+			visit(t.Item)
+		case *Map:
+			visit(t.Key)
+			visit(t.Val)
+		case *Set:
+			visit(t.Item)
+			elemName, ok := setElemName(t.Item)
+			if !ok {
+				return
+			}
+			name := elemName + "Set"
+			if _, ok := declared[name]; ok {
+				return
+			}
+			if prev, ok := sets[name]; ok {
+				if prev.Item == t.Item {
+					t.Name, t.importPath = name, pkg.ImportPath
+				}
+				return
+			}
+			t.Name, t.importPath = name, pkg.ImportPath
+			sets[name] = t
+			pkg.Sets = append(pkg.Sets, t)
+		}
+	}
+	for _, alias := range pkg.Aliases {
+		visit(alias.Item)
+	}
+	for _, object := range pkg.Objects {
+		for _, fieldDef := range object.Fields {
+			visit(fieldDef.Type)
+		}
+	}
+	for _, union := range pkg.Unions {
+		for _, fieldDef := range union.Fields {
+			visit(fieldDef.Type)
+		}
+	}
+	for _, service := range pkg.Services {
+		for _, endpoint := range service.Endpoints {
+			for _, param := range endpoint.Params {
+				visit(param.Type)
+			}
+			if endpoint.Returns != nil {
+				visit(*endpoint.Returns)
+			}
+		}
+	}
+}
+
+// setElemName returns the name of typ used in the name of its set type and true if sets of typ can be represented by
+// a named set type.
+func setElemName(typ Type) (string, bool) {
+	switch t := typ.(type) {
+	case String:
+		return "String", true
+	case Bearertoken:
+		return "BearerToken", true
+	case Integer:
+		return "Integer", true
+	case Safelong:
+		return "SafeLong", true
+	case Boolean:
+		return "Boolean", true
+	case UUID:
+		return "UUID", true
+	case RID:
+		return "RID", true
+	case *EnumType:
+		return t.Name, true
+	case *AliasType:
+		if _, ok := setElemName(t.Item); ok && !t.IsOptional() {
+			return t.Name, true
+		}
+	}
+	return "", false
+}
@@ -159,18 +159,29 @@ func (t *List) Make() *jen.Statement {
 
 func (t *List) Safety() spec.LogSafety { return t.Item.Safety() }
 
+// Set is a Conjure set. Sets are represented as slices unless Name is set, in which case they are represented by the
+// named set type with that name, a map from the elements of the set to struct{} that is declared in the package of the
+// definition that contains the set (see Options.SetTypes).
 type Set struct {
-	Item Type
+	Item       Type
+	Name       string
+	importPath string
 	base
 }
 
 func (t *Set) Code() *jen.Statement {
+	if t.Name != "" {
+		return jen.Qual(t.importPath, t.Name)
+	}
 	return jen.Op("[]").Add(t.Item.Code())
 }
 func (t *Set) String() string { return fmt.Sprintf("set<%s>", t.Item) }
 
 func (*Set) IsCollection() bool { return true }
 
+// IsList returns true if the set is represented as a slice.
+func (t *Set) IsList() bool { return t.Name == "" }
+
 func (t *Set) Make() *jen.Statement {
 	return jen.Make(t.Code(), jen.Lit(0))
 }
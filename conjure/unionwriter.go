@@ -21,6 +21,7 @@ import (
 	"github.com/palantir/conjure-go/v6/conjure/snip"
 	"github.com/palantir/conjure-go/v6/conjure/transforms"
 	"github.com/palantir/conjure-go/v6/conjure/types"
+	"github.com/pkg/errors"
 )
 
 const (
@@ -28,7 +29,7 @@ const (
 	withContextSuffix = "WithContext"
 )
 
-func writeUnionType(file *jen.Group, unionDef *types.UnionType, genAcceptFuncs bool) {
+func writeUnionType(file *jen.Group, unionDef *types.UnionType, genAcceptFuncs bool, jw *jsonWriter) {
 	// Declare exported union struct type
 	file.Add(unionDef.CommentLine()).
 		Type().
@@ -65,50 +66,18 @@ func writeUnionType(file *jen.Group, unionDef *types.UnionType, genAcceptFuncs b
 			}
 		})))
 
-	// Declare toSerializer method
-	file.Func().
-		Params(jen.Id(unionReceiverName).Op("*").Id(unionDef.Name)).
-		Id("toSerializer").
-		Params().
-		Params(jen.Interface(), jen.Error()).
-		Block(jen.Switch(jen.Id(unionReceiverName).Dot("typ")).BlockFunc(func(cases *jen.Group) {
-			cases.Default().Block(jen.Return(
-				jen.Nil(), snip.FmtErrorf().Call(jen.Lit("unknown type %q"), jen.Id(unionReceiverName).Dot("typ"))))
-			for _, fieldDef := range unionDef.Fields {
-				cases.Case(jen.Lit(fieldDef.Name)).BlockFunc(func(caseBody *jen.Group) {
-					fieldSelector := unionDerefPossibleOptional(caseBody, fieldDef, jen.Nil())
-					caseBody.Return(
-						jen.Struct(
-							jen.Id("Type").String().Tag(map[string]string{"json": "type"}),
-							jen.Id(transforms.ExportedFieldName(fieldDef.Name)).Add(fieldDef.Type.Code()).Tag(map[string]string{"json": fieldDef.Name}),
-						).Values(
-							jen.Id("Type").Op(":").Lit(fieldDef.Name),
-							jen.Id(transforms.ExportedFieldName(fieldDef.Name)).Op(":").Add(fieldSelector),
-						),
-						jen.Nil(),
-					)
-				})
-			}
-		}))
-
-	// Declare MarshalJSON method
-	file.Add(snip.MethodMarshalJSON(unionReceiverName, unionDef.Name).Block(
-		jen.List(jen.Id("ser"), jen.Err()).Op(":=").Id(unionReceiverName).Dot("toSerializer").Call(),
-		jen.If(jen.Err().Op("!=").Nil()).Block(
-			jen.Return(jen.Nil(), jen.Err()),
-		),
-		jen.Return(snip.SafeJSONMarshal().Call(jen.Id("ser"))),
-	))
+	// Declare AppendJSON, JSONSize and MarshalJSON methods
+	jw.writeUnionMethods(file, unionDef)
 
 	// Declare UnmarshalJSON method
-	file.Add(snip.MethodUnmarshalJSON(unionReceiverName, unionDef.Name).Block(
-		jen.Var().Id("deser").Id(unionDeserializerStructName(unionDef.Name)),
-		jen.If(
+	jw.writeUnionUnmarshalMethods(file, unionDef, func(methodBody *jen.Group) {
+		methodBody.Var().Id("deser").Id(unionDeserializerStructName(unionDef.Name))
+		methodBody.If(
 			jen.Err().Op(":=").Add(snip.SafeJSONUnmarshal().Call(jen.Id(dataVarName), jen.Op("&").Id("deser"))),
 			jen.Err().Op("!=").Nil(),
-		).Block(jen.Return(jen.Err())),
-		jen.Op("*").Id(unionReceiverName).Op("=").Id("deser").Dot("toStruct").Call(),
-		jen.Switch(jen.Id(unionReceiverName).Dot("typ")).BlockFunc(func(cases *jen.Group) {
+		).Block(jen.Return(jen.Err()))
+		methodBody.Op("*").Id(unionReceiverName).Op("=").Id("deser").Dot("toStruct").Call()
+		methodBody.Switch(jen.Id(unionReceiverName).Dot("typ")).BlockFunc(func(cases *jen.Group) {
 			for _, fieldDef := range unionDef.Fields {
 				cases.Case(jen.Lit(fieldDef.Name)).BlockFunc(func(caseBody *jen.Group) {
 					if !fieldDef.Type.IsOptional() {
@@ -119,9 +88,10 @@ func writeUnionType(file *jen.Group, unionDef *types.UnionType, genAcceptFuncs b
 					}
 				})
 			}
-		}),
-		jen.Return(jen.Nil()),
-	))
+		})
+		methodBody.Return(jen.Nil())
+	})
+	jw.writeUnionDecodeMethods(file, unionDef)
 
 	// Declare yaml methods
 	file.Add(snip.MethodMarshalYAML(unionReceiverName, unionDef.Name))
@@ -251,6 +221,95 @@ func writeUnionType(file *jen.Group, unionDef *types.UnionType, genAcceptFuncs b
 	}
 }
 
+// checkUnionHelperNames returns an error if the <Union>Variant types, their constants or the Match<Union> functions
+// written for the unions of pkg by writeUnionHelpers and unionMatchFunc have the same name as a type of pkg.
+func checkUnionHelperNames(pkg types.ConjurePackage) error {
+	typeNames := packageTypeNames(pkg)
+	for _, unionDef := range pkg.Unions {
+		names := []string{unionVariantTypeName(unionDef.Name), unionMatchFuncName(unionDef.Name)}
+		for _, fieldDef := range unionDef.Fields {
+			names = append(names, unionVariantTypeName(unionDef.Name)+transforms.ExportedFieldName(fieldDef.Name))
+		}
+		for _, name := range names {
+			if _, ok := typeNames[name]; ok {
+				return errors.Errorf("%s generated for union %s conflicts with the type %s of package %s", name, unionDef.Name, name, pkg.ConjurePackage)
+			}
+		}
+	}
+	return nil
+}
+
+// writeUnionHelpers writes the <Union>Variant type, the Type method and the As<Variant> accessors of unionDef. The
+// Match<Union> function is written into the generics file by unionMatchFunc.
+func writeUnionHelpers(file *jen.Group, unionDef *types.UnionType) {
+	unionVariantType(file, unionDef)
+	unionAsMethods(file, unionDef)
+}
+
+func unionMatchFuncName(unionTypeName string) string {
+	return "Match" + unionTypeName
+}
+
+func unionVariantTypeName(unionTypeName string) string {
+	return unionTypeName + "Variant"
+}
+
+// unionVariantType writes the string type that identifies the variants of unionDef, its constants and the Type method
+// of the union that returns it.
+func unionVariantType(file *jen.Group, unionDef *types.UnionType) {
+	variantType := unionVariantTypeName(unionDef.Name)
+	file.Commentf("%s identifies the variants of %s.", variantType, unionDef.Name).Line().
+		Type().Id(variantType).String()
+	if len(unionDef.Fields) > 0 {
+		file.Const().DefsFunc(func(defs *jen.Group) {
+			for _, fieldDef := range unionDef.Fields {
+				defs.Id(variantType + transforms.ExportedFieldName(fieldDef.Name)).Id(variantType).Op("=").Lit(fieldDef.Name)
+			}
+		})
+	}
+	file.Commentf("Type returns the variant of the union. For variants that are unknown to this version of %s, it", unionDef.Name).Line().
+		Comment("returns the name of the variant, which does not match any of the declared constants.").Line().
+		Func().
+		Params(jen.Id(unionReceiverName).Op("*").Id(unionDef.Name)).
+		Id("Type").
+		Params().
+		Params(jen.Id(variantType)).
+		Block(jen.Return(jen.Id(variantType).Call(jen.Id(unionReceiverName).Dot("typ"))))
+}
+
+// unionAsMethods writes an As<Variant> method for each variant of unionDef, which returns the value of the variant
+// and whether the union is set to it.
+func unionAsMethods(file *jen.Group, unionDef *types.UnionType) {
+	for _, fieldDef := range unionDef.Fields {
+		privateName := transforms.PrivateFieldName(fieldDef.Name)
+		selector := jen.Id(unionReceiverName).Dot(privateName)
+		methodName := "As" + transforms.ExportedFieldName(fieldDef.Name)
+		file.Commentf("%s returns the value of the %s variant and true if the union is set to it.", methodName, fieldDef.Name).Line().
+			Func().
+			Params(jen.Id(unionReceiverName).Op("*").Id(unionDef.Name)).
+			Id(methodName).
+			Params().
+			Params(jen.Id("v").Add(fieldDef.Type.Code()), jen.Id("ok").Bool()).
+			BlockFunc(func(methodBody *jen.Group) {
+				if fieldDef.Type.IsOptional() {
+					// the variant may be set to an empty optional
+					methodBody.If(jen.Id(unionReceiverName).Dot("typ").Op("!=").Lit(fieldDef.Name)).Block(
+						jen.Return(jen.Id("v"), jen.False()),
+					)
+					methodBody.If(selector.Clone().Op("!=").Nil()).Block(
+						jen.Id("v").Op("=").Op("*").Add(selector.Clone()),
+					)
+					methodBody.Return(jen.Id("v"), jen.True())
+					return
+				}
+				methodBody.If(jen.Id(unionReceiverName).Dot("typ").Op("!=").Lit(fieldDef.Name).Op("||").Add(selector.Clone()).Op("==").Nil()).Block(
+					jen.Return(jen.Id("v"), jen.False()),
+				)
+				methodBody.Return(jen.Op("*").Add(selector.Clone()), jen.True())
+			})
+	}
+}
+
 func unionDerefPossibleOptional(caseBody *jen.Group, fieldDef *types.Field, returnVal jen.Code) *jen.Statement {
 	privateName := transforms.PrivateFieldName(fieldDef.Name)
 	var fieldSelector *jen.Statement
@@ -337,3 +396,39 @@ func unionVisitorWithT(file *jen.Group, union *types.UnionType) {
 				Params(jen.Id("T"), jen.Error())
 		})
 }
+
+// unionMatchFunc writes the Match<Union> function, which calls the function for the variant of the union with its value.
+// Unlike visitors, the functions are parameters, so calls of Match<Union> fail to compile when a variant is added.
+func unionMatchFunc(file *jen.Group, union *types.UnionType) {
+	funcName := unionMatchFuncName(union.Name)
+	file.Commentf("%s calls the function for the variant of %s with the value of the variant and returns its result.", funcName, unionReceiverName).Line().
+		Comment("unknownFunc is called with the name of the variant for variants that are unknown to this version of the union.").Line().
+		Func().
+		Id(funcName).
+		Add(snip.TAny()).
+		ParamsFunc(func(args *jen.Group) {
+			args.Id(unionReceiverName).Id(union.Name)
+			for _, fieldDef := range union.Fields {
+				args.Id(transforms.PrivateFieldName(fieldDef.Name)+"Func").Func().Params(fieldDef.Type.Code()).Params(jen.Id("T"), jen.Error())
+			}
+			args.Id("unknownFunc").Func().Params(jen.String()).Params(jen.Id("T"), jen.Error())
+		}).
+		Params(jen.Id("T"), jen.Error()).
+		Block(
+			jen.Var().Id("result").Id("T"),
+			jen.Switch(jen.Id(unionReceiverName).Dot("typ")).BlockFunc(func(cases *jen.Group) {
+				cases.Default().Block(
+					jen.If(jen.Id(unionReceiverName).Dot("typ").Op("==").Lit("")).Block(
+						jen.Return(jen.Id("result"), snip.FmtErrorf().Call(jen.Lit("invalid value in union type"))),
+					),
+					jen.Return(jen.Id("unknownFunc").Call(jen.Id(unionReceiverName).Dot("typ"))),
+				)
+				for _, fieldDef := range union.Fields {
+					cases.Case(jen.Lit(fieldDef.Name)).BlockFunc(func(caseBody *jen.Group) {
+						selector := unionDerefPossibleOptional(caseBody, fieldDef, jen.Id("result"))
+						caseBody.Return(jen.Id(transforms.PrivateFieldName(fieldDef.Name) + "Func").Call(selector))
+					})
+				}
+			}),
+		)
+}
@@ -97,3 +97,53 @@ func (u *MyUnionWithT[T]) Accept(ctx context.Context, v MyUnionVisitorWithT[T])
 }
 `, buf.String())
 }
+
+func TestUnionWriter_unionMatchFunc(t *testing.T) {
+	f := jen.NewFile("testpkg")
+	unionMatchFunc(f.Group, testUnionType)
+	var buf bytes.Buffer
+	assert.NoError(t, f.Render(&buf))
+	assert.Equal(t, `package testpkg
+
+import "fmt"
+
+// MatchMyUnion calls the function for the variant of u with the value of the variant and returns its result.
+// unknownFunc is called with the name of the variant for variants that are unknown to this version of the union.
+func MatchMyUnion[T any](u MyUnion, stringValFunc func(string) (T, error), boolValFunc func(bool) (T, error), unknownFunc func(string) (T, error)) (T, error) {
+	var result T
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return result, fmt.Errorf("invalid value in union type")
+		}
+		return unknownFunc(u.typ)
+	case "stringVal":
+		if u.stringVal == nil {
+			return result, fmt.Errorf("field \"stringVal\" is required")
+		}
+		return stringValFunc(*u.stringVal)
+	case "boolVal":
+		if u.boolVal == nil {
+			return result, fmt.Errorf("field \"boolVal\" is required")
+		}
+		return boolValFunc(*u.boolVal)
+	}
+}
+`, buf.String())
+}
+
+func TestCheckUnionHelperNames(t *testing.T) {
+	pkg := types.ConjurePackage{
+		ConjurePackage: "com.palantir.foo",
+		Unions:         []*types.UnionType{testUnionType},
+		Objects:        []*types.ObjectType{{Name: "MyUnionVariants"}},
+	}
+	assert.NoError(t, checkUnionHelperNames(pkg))
+
+	pkg.Objects = append(pkg.Objects, &types.ObjectType{Name: "MyUnionVariant"})
+	assert.EqualError(t, checkUnionHelperNames(pkg), "MyUnionVariant generated for union MyUnion conflicts with the type MyUnionVariant of package com.palantir.foo")
+
+	pkg.Objects = nil
+	pkg.Aliases = []*types.AliasType{{Name: "MyUnionVariantStringVal"}}
+	assert.EqualError(t, checkUnionHelperNames(pkg), "MyUnionVariantStringVal generated for union MyUnion conflicts with the type MyUnionVariantStringVal of package com.palantir.foo")
+}
@@ -0,0 +1,230 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/palantir/conjure-go/v6/conjure-api/conjure/spec"
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"gopkg.in/yaml.v2"
+)
+
+// FileStatus describes how a file on disk differs from the generated output.
+type FileStatus string
+
+const (
+	// FileAdded indicates that a generated file does not exist on disk.
+	FileAdded FileStatus = "added"
+	// FileChanged indicates that the content of a file on disk differs from the generated file.
+	FileChanged FileStatus = "changed"
+	// FileStale indicates that a file on disk was written by the generator but is no longer generated.
+	FileStale FileStatus = "stale"
+)
+
+// FileDiff is a difference between a generated file and the file on disk.
+type FileDiff struct {
+	Path   string
+	Status FileStatus
+	// Diff is a unified diff from the content on disk to the generated content.
+	Diff string
+}
+
+// Verify renders the files that Generate would write for the provided definition and configuration and returns how
+// the files on disk differ from them. Returns an empty slice if the files on disk are up-to-date. Stale files are not
+// reported if the configuration keeps them.
+func Verify(conjureDefinition spec.ConjureDefinition, outputConfiguration OutputConfiguration) ([]FileDiff, error) {
+	files, err := GenerateOutputFiles(conjureDefinition, outputConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := VerifyOutputFiles(files)
+	if err != nil {
+		return nil, err
+	}
+	if !outputConfiguration.KeepStaleFiles {
+		return diffs, nil
+	}
+	var filtered []FileDiff
+	for _, diff := range diffs {
+		if diff.Status != FileStale {
+			filtered = append(filtered, diff)
+		}
+	}
+	return filtered, nil
+}
+
+// VerifyOutputFiles renders the provided files and returns how the files on disk differ from them, including files in
+// the same directories that were written by the generator but are not part of files. The returned diffs are sorted by
+// path.
+func VerifyOutputFiles(files []*OutputFile) ([]FileDiff, error) {
+	var diffs []FileDiff
+	for _, file := range files {
+		want, err := file.Render()
+		if err != nil {
+			return nil, err
+		}
+		got, err := os.ReadFile(file.AbsPath())
+		switch {
+		case os.IsNotExist(err):
+			diff, err := unifiedDiff(file.AbsPath(), nil, want)
+			if err != nil {
+				return nil, err
+			}
+			diffs = append(diffs, FileDiff{Path: file.AbsPath(), Status: FileAdded, Diff: diff})
+		case err != nil:
+			return nil, errors.Wrapf(err, "failed to read %s", file.AbsPath())
+		case !bytes.Equal(got, want):
+			diff, err := unifiedDiff(file.AbsPath(), got, want)
+			if err != nil {
+				return nil, err
+			}
+			diffs = append(diffs, FileDiff{Path: file.AbsPath(), Status: FileChanged, Diff: diff})
+		}
+	}
+	stalePaths, err := staleOutputFiles(files)
+	if err != nil {
+		return nil, err
+	}
+	for _, stalePath := range stalePaths {
+		got, err := os.ReadFile(stalePath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s", stalePath)
+		}
+		diff, err := unifiedDiff(stalePath, got, nil)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, FileDiff{Path: stalePath, Status: FileStale, Diff: diff})
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Path < diffs[j].Path
+	})
+	return diffs, nil
+}
+
+// staleOutputFilePatterns are the patterns of the names of the files that the generator writes.
+var staleOutputFilePatterns = []string{"*.conjure.go", "*.openapi." + OpenAPIFormatJSON, "*.openapi." + OpenAPIFormatYAML}
+
+// staleOutputFiles returns the paths of the files matching staleOutputFilePatterns written by the generator that are
+// in the directories of the provided files but are not one of the provided files. Only the directories of generated packages are
+// considered, so the files of packages that are no longer generated at all are not reported.
+func staleOutputFiles(files []*OutputFile) ([]string, error) {
+	generated := map[string]struct{}{}
+	dirs := map[string]struct{}{}
+	for _, file := range files {
+		generated[filepath.Clean(file.AbsPath())] = struct{}{}
+		dirs[filepath.Dir(file.AbsPath())] = struct{}{}
+	}
+	var stale []string
+	for dir := range dirs {
+		for _, pattern := range staleOutputFilePatterns {
+			matches, err := filepath.Glob(filepath.Join(dir, pattern))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to list generated files in %s", dir)
+			}
+			for _, match := range matches {
+				if _, ok := generated[filepath.Clean(match)]; ok {
+					continue
+				}
+				owned, err := isGeneratedFile(match)
+				if err != nil {
+					return nil, err
+				}
+				if owned {
+					stale = append(stale, match)
+				}
+			}
+		}
+	}
+	sort.Strings(stale)
+	return stale, nil
+}
+
+// removeStaleOutputFiles removes the files returned by staleOutputFiles for the provided files.
+func removeStaleOutputFiles(files []*OutputFile) error {
+	stalePaths, err := staleOutputFiles(files)
+	if err != nil {
+		return err
+	}
+	for _, stalePath := range stalePaths {
+		if err := os.Remove(stalePath); err != nil {
+			return errors.Wrapf(err, "failed to remove stale generated file %s", stalePath)
+		}
+	}
+	return nil
+}
+
+// isGeneratedFile returns true if the file at the provided path was written by the generator. Go files are identified
+// by the header on their first line and OpenAPI documents by their "x-generated" extension.
+func isGeneratedFile(path string) (bool, error) {
+	if !strings.HasSuffix(path, ".go") {
+		return isGeneratedOpenAPIDocument(path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to open %s", path)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	firstLine, err := bufio.NewReader(f).ReadString('\n')
+	if err != nil && firstLine == "" {
+		return false, nil
+	}
+	return strings.TrimSpace(firstLine) == "// "+generatedFileHeader, nil
+}
+
+func isGeneratedOpenAPIDocument(path string) (bool, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to read %s", path)
+	}
+	// JSON documents are valid YAML documents
+	var doc struct {
+		Generated string `yaml:"x-generated"`
+	}
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return false, nil
+	}
+	return doc.Generated == generatedFileHeader, nil
+}
+
+func unifiedDiff(path string, got, want []byte) (string, error) {
+	fromFile, toFile := path, path
+	if got == nil {
+		fromFile = os.DevNull
+	}
+	if want == nil {
+		toFile = os.DevNull
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(got)),
+		B:        difflib.SplitLines(string(want)),
+		FromFile: fromFile,
+		ToFile:   toFile,
+		Context:  3,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to compute diff for %s", path)
+	}
+	return diff, nil
+}
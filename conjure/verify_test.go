@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conjure
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/palantir/conjure-go/v6/conjure/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyOutputFiles(t *testing.T) {
+	dir := t.TempDir()
+	// Render adds the header comment to the file, so each call uses new output files.
+	outputFiles := func() []*OutputFile {
+		var files []*OutputFile
+		for _, name := range []string{"aliases.conjure.go", "structs.conjure.go"} {
+			f := jen.NewFile("api")
+			f.Type().Id("Foo").String()
+			files = append(files, &OutputFile{absPath: filepath.Join(dir, name), file: f})
+		}
+		return files
+	}
+	aliasesPath := filepath.Join(dir, "aliases.conjure.go")
+	structsPath := filepath.Join(dir, "structs.conjure.go")
+
+	diffs, err := VerifyOutputFiles(outputFiles())
+	require.NoError(t, err)
+	require.Len(t, diffs, 2)
+	assert.Equal(t, aliasesPath, diffs[0].Path)
+	assert.Equal(t, FileAdded, diffs[0].Status)
+	assert.Contains(t, diffs[0].Diff, "--- "+os.DevNull)
+	assert.Contains(t, diffs[0].Diff, "+type Foo string")
+	assert.Equal(t, structsPath, diffs[1].Path)
+	assert.Equal(t, FileAdded, diffs[1].Status)
+
+	for _, f := range outputFiles() {
+		require.NoError(t, f.Write())
+	}
+	diffs, err = VerifyOutputFiles(outputFiles())
+	require.NoError(t, err)
+	assert.Empty(t, diffs)
+
+	content, err := os.ReadFile(structsPath)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(structsPath, append(content, []byte("\ntype Bar string\n")...), 0644))
+	// files in the output directory that were not written by the generator are ignored
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "custom.conjure.go"), []byte("package api\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "unions.conjure.go"), content, 0644))
+
+	diffs, err = VerifyOutputFiles(outputFiles())
+	require.NoError(t, err)
+	require.Len(t, diffs, 2)
+	assert.Equal(t, structsPath, diffs[0].Path)
+	assert.Equal(t, FileChanged, diffs[0].Status)
+	assert.Contains(t, diffs[0].Diff, "-type Bar string")
+	assert.Equal(t, filepath.Join(dir, "unions.conjure.go"), diffs[1].Path)
+	assert.Equal(t, FileStale, diffs[1].Status)
+	assert.Contains(t, diffs[1].Diff, "+++ "+os.DevNull)
+}
+
+func TestRemoveStaleOutputFiles(t *testing.T) {
+	dir := t.TempDir()
+	f := jen.NewFile("api")
+	f.Type().Id("Foo").String()
+	structs := &OutputFile{absPath: filepath.Join(dir, "structs.conjure.go"), file: f}
+	content, err := structs.Render()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(structs.AbsPath(), content, 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "unions.conjure.go"), content, 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "custom.conjure.go"), []byte("package api\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "unions.go"), content, 0644))
+
+	require.NoError(t, removeStaleOutputFiles([]*OutputFile{structs}))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	assert.Equal(t, []string{"custom.conjure.go", "structs.conjure.go", "unions.go"}, names)
+}
+
+func TestStaleOutputFilesOpenAPI(t *testing.T) {
+	dir := t.TempDir()
+	f := jen.NewFile("api")
+	f.Type().Id("Foo").String()
+	structs := &OutputFile{absPath: filepath.Join(dir, "structs.conjure.go"), file: f}
+	serviceDef := &types.ServiceDefinition{Name: "FooService"}
+	for _, format := range []string{OpenAPIFormatJSON, OpenAPIFormatYAML} {
+		content, err := writeOpenAPIDocument(serviceDef, nil, format)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, openAPIFileName(serviceDef.Name, format)), content, 0644))
+	}
+	// documents that were not written by the generator are ignored
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Custom.openapi.json"), []byte(`{"openapi":"3.1.0"}`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Custom.openapi.yaml"), []byte("openapi: 3.1.0\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Invalid.openapi.json"), []byte(`{`), 0644))
+
+	stale, err := staleOutputFiles([]*OutputFile{structs})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "FooService.openapi.json"),
+		filepath.Join(dir, "FooService.openapi.yaml"),
+	}, stale)
+
+	content, err := writeOpenAPIDocument(serviceDef, nil, OpenAPIFormatJSON)
+	require.NoError(t, err)
+	openAPI := newRawFile(filepath.Join(dir, "FooService.openapi.json"), content)
+	stale, err = staleOutputFiles([]*OutputFile{structs, openAPI})
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "FooService.openapi.yaml")}, stale)
+}
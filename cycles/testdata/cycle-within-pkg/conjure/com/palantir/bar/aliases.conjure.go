@@ -3,14 +3,51 @@
 package bar
 
 import (
+	"strconv"
+
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type Type1 []Type3
 
+func (a Type1) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, '[')
+	for i, v := range []Type3(a) {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	return out, nil
+}
+
+func (a Type1) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	size += 2
+	if len([]Type3(a)) > 1 {
+		size += len([]Type3(a)) - 1
+	}
+	for _, v := range []Type3(a) {
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
 func (a Type1) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal([]Type3(a))
+	return a.AppendJSON(nil)
 }
 
 func (a *Type1) UnmarshalJSON(data []byte) error {
@@ -38,15 +75,85 @@ func (a *Type1) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+func (a *Type1) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *Type1) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []Type3
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]Type3, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 Type3
+		err = v1.decodeJSONStrict(elem)
+		if err != nil {
+			return false
+		}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = Type1(v)
+	return nil
+}
+
+// Equal returns true if the Type1 is equal to other according to the Conjure semantics of its values.
+func (a Type1) Equal(other Type1) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(other[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Type1.
+func (a Type1) Clone() Type1 {
+	var out Type1
+	if a != nil {
+		out = make(Type1, len(a))
+		copy(out, a)
+	}
+	return out
+}
+
 type Type2 struct {
 	Value *int
 }
 
-func (a Type2) MarshalJSON() ([]byte, error) {
+func (a Type2) AppendJSON(out []byte) ([]byte, error) {
 	if a.Value == nil {
-		return []byte("null"), nil
+		out = append(out, "null"...)
+	} else {
+		out = strconv.AppendInt(out, int64(*a.Value), 10)
 	}
-	return safejson.Marshal(a.Value)
+	return out, nil
+}
+
+func (a Type2) JSONSize() (int, error) {
+	var size int
+	if a.Value == nil {
+		size += 4
+	} else {
+		size += jsonIntSize(int64(*a.Value))
+	}
+	return size, nil
+}
+
+func (a Type2) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
 }
 
 func (a *Type2) UnmarshalJSON(data []byte) error {
@@ -56,6 +163,29 @@ func (a *Type2) UnmarshalJSON(data []byte) error {
 	return safejson.Unmarshal(data, a.Value)
 }
 
+func (a *Type2) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *Type2) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *int
+	if value.Type != gjson.Null {
+		var v1 int
+		v1, err = decodeJSONInt(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = Type2{Value: v}
+	return nil
+}
+
 func (a Type2) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
@@ -71,3 +201,26 @@ func (a *Type2) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	}
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
+
+// Equal returns true if the Type2 is equal to other according to the Conjure semantics of its values.
+func (a Type2) Equal(other Type2) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if *a.Value != *other.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Type2.
+func (a Type2) Clone() Type2 {
+	out := a
+	if a.Value != nil {
+		v := *a.Value
+		out.Value = &v
+	}
+	return out
+}
@@ -3,15 +3,122 @@
 package bar
 
 import (
+	"fmt"
+
 	"github.com/palantir/conjure-go/v6/cycles/testdata/cycle-within-pkg/conjure/com/palantir/buzz"
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type Type3 struct {
 	Field1 buzz.Type1 `json:"field1"`
 }
 
+func (o Type3) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"field1\":"...)
+	out, err = o.Field1.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Type3) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 11
+	n, err = o.Field1.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (o *Type3) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenField1 bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "field1":
+			if seenField1 {
+				ok = false
+				return false
+			}
+			seenField1 = true
+			if field.Type != gjson.Null {
+				var s string
+				if !unmarshalJSONString(field, &s) || o.Field1.UnmarshalText([]byte(s)) != nil {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "field1") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *Type3) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Type3) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Type3{}
+	var seenField1 bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "field1":
+			if seenField1 {
+				err = fmt.Errorf("duplicate field \"field1\"")
+				return false
+			}
+			seenField1 = true
+			err = o.Field1.UnmarshalJSONStrict([]byte(field.Raw))
+			if err != nil {
+				err = fmt.Errorf("field \"field1\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenField1 {
+		return fmt.Errorf("field \"field1\" is required")
+	}
+	return nil
+}
+
 func (o Type3) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -27,3 +134,17 @@ func (o *Type3) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	}
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
+
+// Equal returns true if the Type3 is equal to other according to the Conjure semantics of its values.
+func (o Type3) Equal(other Type3) bool {
+	if !o.Field1.Equal(other.Field1) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Type3.
+func (o Type3) Clone() Type3 {
+	out := o
+	return out
+}
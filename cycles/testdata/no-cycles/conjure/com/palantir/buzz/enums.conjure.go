@@ -4,6 +4,8 @@ package buzz
 
 import (
 	"strings"
+
+	"github.com/tidwall/gjson"
 )
 
 type Type1 struct {
@@ -62,3 +64,33 @@ func (e *Type1) UnmarshalText(data []byte) error {
 	}
 	return nil
 }
+
+func (e Type1) AppendJSON(out []byte) ([]byte, error) {
+	return appendJSONString(out, string(e.val)), nil
+}
+
+func (e Type1) JSONSize() (int, error) {
+	return jsonStringSize(string(e.val)), nil
+}
+
+func (e *Type1) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return e.decodeJSONStrict(value)
+}
+
+func (e *Type1) decodeJSONStrict(value gjson.Result) error {
+	return decodeJSONEnum(value, e)
+}
+
+// Equal returns true if the Type1 is equal to other according to the Conjure semantics of its values.
+func (e Type1) Equal(other Type1) bool {
+	return e.val == other.val
+}
+
+// Clone returns a deep copy of the Type1.
+func (e Type1) Clone() Type1 {
+	return e
+}
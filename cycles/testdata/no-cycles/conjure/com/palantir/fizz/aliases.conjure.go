@@ -2,4 +2,37 @@
 
 package fizz
 
+import (
+	"github.com/tidwall/gjson"
+)
+
 type Type1 string
+
+func (a *Type1) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *Type1) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v string
+	v, err = decodeJSONString(value)
+	if err != nil {
+		return err
+	}
+	*a = Type1(v)
+	return nil
+}
+
+// Equal returns true if the Type1 is equal to other according to the Conjure semantics of its values.
+func (a Type1) Equal(other Type1) bool {
+	return string(a) == string(other)
+}
+
+// Clone returns a deep copy of the Type1.
+func (a Type1) Clone() Type1 {
+	return a
+}
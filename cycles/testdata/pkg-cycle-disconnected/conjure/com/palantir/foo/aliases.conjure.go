@@ -3,7 +3,78 @@
 package foo
 
 import (
+	"fmt"
+
 	"github.com/palantir/conjure-go/v6/cycles/testdata/pkg-cycle-disconnected/conjure/com/palantir/fizz"
+	"github.com/tidwall/gjson"
 )
 
 type Type2 map[fizz.Type1]int
+
+func (a *Type2) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *Type2) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v map[fizz.Type1]int
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	v = make(map[fizz.Type1]int)
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k fizz.Type1
+		var k1 string
+		k1 = key.Str
+		k = fizz.Type1(k1)
+		if _, ok := v[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v1 int
+		v1, err = decodeJSONInt(elem)
+		if err != nil {
+			return false
+		}
+		v[k] = v1
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = Type2(v)
+	return nil
+}
+
+// Equal returns true if the Type2 is equal to other according to the Conjure semantics of its values.
+func (a Type2) Equal(other Type2) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for k, v := range a {
+		otherV, ok := other[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Type2.
+func (a Type2) Clone() Type2 {
+	var out Type2
+	if a != nil {
+		out = make(Type2, len(a))
+		for k, v := range a {
+			out[k] = v
+		}
+	}
+	return out
+}
@@ -3,24 +3,74 @@
 package foo
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+
 	"github.com/palantir/conjure-go/v6/cycles/testdata/pkg-cycle-disconnected/conjure/com/palantir/fizz"
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type Type4 struct {
 	Field1 Type2 `json:"field1"`
 }
 
-func (o Type4) MarshalJSON() ([]byte, error) {
-	if o.Field1 == nil {
-		o.Field1 = make(map[fizz.Type1]int, 0)
+func (o Type4) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"field1\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value int
+		}
+		entries := make([]mapEntry, 0, len(map[fizz.Type1]int(o.Field1)))
+		for k, v := range map[fizz.Type1]int(o.Field1) {
+			entries = append(entries, mapEntry{key: string(k), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = strconv.AppendInt(out, int64(entry.value), 10)
+		}
 	}
-	type Type4Alias Type4
-	return safejson.Marshal(Type4Alias(o))
+	out = append(out, '}')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Type4) JSONSize() (int, error) {
+	size := 11
+	size += 2
+	if len(map[fizz.Type1]int(o.Field1)) > 1 {
+		size += len(map[fizz.Type1]int(o.Field1)) - 1
+	}
+	for k, v := range map[fizz.Type1]int(o.Field1) {
+		size += jsonStringSize(string(k)) + 1
+		size += jsonIntSize(int64(v))
+	}
+	return size, nil
+}
+
+func (o Type4) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *Type4) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Type4
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type Type4Alias Type4
 	var rawType4 Type4Alias
 	if err := safejson.Unmarshal(data, &rawType4); err != nil {
@@ -33,6 +83,118 @@ func (o *Type4) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *Type4) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Field1 == nil {
+			o.Field1 = make(map[fizz.Type1]int, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenField1 bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "field1":
+			if seenField1 {
+				ok = false
+				return false
+			}
+			seenField1 = true
+			var v map[fizz.Type1]int
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				v = make(map[fizz.Type1]int)
+				ok1 := true
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k fizz.Type1
+					var k1 string
+					if !unmarshalJSONString(key1, &k1) {
+						ok1 = false
+						return false
+					}
+					k = fizz.Type1(k1)
+					var v1 int
+					if !unmarshalJSONInt(elem, &v1) {
+						ok1 = false
+						return false
+					}
+					v[k] = v1
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+			o.Field1 = Type2(v)
+		default:
+			if matchesJSONField(key.Str, "field1") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Field1 == nil {
+		o.Field1 = make(map[fizz.Type1]int, 0)
+	}
+	return true
+}
+
+func (o *Type4) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Type4) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Type4{}
+	var seenField1 bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "field1":
+			if seenField1 {
+				err = fmt.Errorf("duplicate field \"field1\"")
+				return false
+			}
+			seenField1 = true
+			if field.Type != gjson.Null {
+				err = o.Field1.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"field1\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if o.Field1 == nil {
+		o.Field1 = make(map[fizz.Type1]int, 0)
+	}
+	return nil
+}
+
 func (o Type4) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -48,3 +210,18 @@ func (o *Type4) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	}
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
+
+// Equal returns true if the Type4 is equal to other according to the Conjure semantics of its values.
+func (o Type4) Equal(other Type4) bool {
+	if !o.Field1.Equal(other.Field1) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Type4.
+func (o Type4) Clone() Type4 {
+	out := o
+	out.Field1 = o.Field1.Clone()
+	return out
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/palantir/conjure-go/v6/cycles/testdata/pkg-cycle-disconnected/conjure/com/palantir/bar"
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type Type3 struct {
@@ -25,7 +26,8 @@ func (u *type3Deserializer) toStruct() Type3 {
 	return Type3{typ: u.Type, field3: u.Field3}
 }
 
-func (u *Type3) toSerializer() (interface{}, error) {
+func (u Type3) AppendJSON(out []byte) ([]byte, error) {
+	var err error
 	switch u.typ {
 	default:
 		return nil, fmt.Errorf("unknown type %q", u.typ)
@@ -33,22 +35,62 @@ func (u *Type3) toSerializer() (interface{}, error) {
 		if u.field3 == nil {
 			return nil, fmt.Errorf("field \"field3\" is required")
 		}
-		return struct {
-			Type   string    `json:"type"`
-			Field3 bar.Type1 `json:"field3"`
-		}{Type: "field3", Field3: *u.field3}, nil
+		out = append(out, "{\"type\":\"field3\",\"field3\":"...)
+		out = append(out, '[')
+		for i, v := range []bar.Type2(*u.field3) {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out, err = v.AppendJSON(out)
+			if err != nil {
+				return nil, err
+			}
+		}
+		out = append(out, ']')
 	}
+	out = append(out, '}')
+	return out, nil
 }
 
-func (u Type3) MarshalJSON() ([]byte, error) {
-	ser, err := u.toSerializer()
-	if err != nil {
-		return nil, err
+func (u Type3) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	switch u.typ {
+	default:
+		return 0, fmt.Errorf("unknown type %q", u.typ)
+	case "field3":
+		if u.field3 == nil {
+			return 0, fmt.Errorf("field \"field3\" is required")
+		}
+		size = 27
+		size += 2
+		if len([]bar.Type2(*u.field3)) > 1 {
+			size += len([]bar.Type2(*u.field3)) - 1
+		}
+		for _, v := range []bar.Type2(*u.field3) {
+			n, err = v.JSONSize()
+			if err != nil {
+				return 0, err
+			}
+			size += n
+		}
 	}
-	return safejson.Marshal(ser)
+	return size, nil
+}
+
+func (u Type3) MarshalJSON() ([]byte, error) {
+	return u.AppendJSON(nil)
 }
 
 func (u *Type3) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Type3
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*u = v
+			return nil
+		}
+	}
 	var deser type3Deserializer
 	if err := safejson.Unmarshal(data, &deser); err != nil {
 		return err
@@ -63,6 +105,146 @@ func (u *Type3) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (u *Type3) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenType, seenField3 bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !unmarshalJSONString(field, &u.typ) {
+				ok = false
+				return false
+			}
+		case "field3":
+			if seenField3 {
+				ok = false
+				return false
+			}
+			seenField3 = true
+			if field.Type != gjson.Null {
+				var v bar.Type1
+				var v1 []bar.Type2
+				if field.Type != gjson.Null {
+					if !field.IsArray() {
+						ok = false
+						return false
+					}
+					v1 = make([]bar.Type2, 0)
+					ok1 := true
+					field.ForEach(func(_, elem gjson.Result) bool {
+						var v2 bar.Type2
+						if v2.UnmarshalJSON([]byte(elem.Raw)) != nil {
+							ok1 = false
+							return false
+						}
+						v1 = append(v1, v2)
+						return true
+					})
+					if !ok1 {
+						ok = false
+						return false
+					}
+				}
+				v = bar.Type1(v1)
+				u.field3 = &v
+			}
+		default:
+			if matchesJSONField(key.Str, "type", "field3") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	switch u.typ {
+	case "field3":
+		if u.field3 == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *Type3) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return u.decodeJSONStrict(value)
+}
+
+func (u *Type3) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	var typ string
+	var variantName string
+	var variant gjson.Result
+	var seenType, seenVariant bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch {
+		case key.Str == "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			typ, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"type\": %w", err)
+				return false
+			}
+			return true
+		case seenVariant && key.Str == variantName:
+			err = fmt.Errorf("duplicate field %q", key.Str)
+			return false
+		case seenVariant:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		variantName, variant, seenVariant = key.Str, field, true
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenType {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	if seenVariant && variantName != typ {
+		return fmt.Errorf("unknown field %q", variantName)
+	}
+	*u = Type3{typ: typ}
+	switch typ {
+	case "field3":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"field3\" is required")
+		}
+		var v bar.Type1
+		err = v.UnmarshalJSONStrict([]byte(variant.Raw))
+		if err != nil {
+			return fmt.Errorf("field \"field3\": %w", err)
+		}
+		u.field3 = &v
+	}
+	return nil
+}
+
 func (u Type3) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(u)
 	if err != nil {
@@ -145,3 +327,29 @@ type Type3VisitorWithContext interface {
 func NewType3FromField3(v bar.Type1) Type3 {
 	return Type3{typ: "field3", field3: &v}
 }
+
+// Equal returns true if the Type3 is equal to other according to the Conjure semantics of its values.
+func (u Type3) Equal(other Type3) bool {
+	if u.typ != other.typ {
+		return false
+	}
+	if (u.field3 == nil) != (other.field3 == nil) {
+		return false
+	}
+	if u.field3 != nil {
+		if !(*u.field3).Equal(*other.field3) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Type3.
+func (u Type3) Clone() Type3 {
+	out := u
+	if u.field3 != nil {
+		v := (*u.field3).Clone()
+		out.field3 = &v
+	}
+	return out
+}
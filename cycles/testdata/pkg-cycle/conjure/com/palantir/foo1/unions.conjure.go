@@ -10,6 +10,7 @@ import (
 	"github.com/palantir/conjure-go/v6/cycles/testdata/pkg-cycle/conjure/com/palantir/foo"
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type Type3 struct {
@@ -30,7 +31,8 @@ func (u *type3Deserializer) toStruct() Type3 {
 	return Type3{typ: u.Type, field1: u.Field1, field2: u.Field2, field3: u.Field3}
 }
 
-func (u *Type3) toSerializer() (interface{}, error) {
+func (u Type3) AppendJSON(out []byte) ([]byte, error) {
+	var err error
 	switch u.typ {
 	default:
 		return nil, fmt.Errorf("unknown type %q", u.typ)
@@ -38,38 +40,87 @@ func (u *Type3) toSerializer() (interface{}, error) {
 		if u.field1 == nil {
 			return nil, fmt.Errorf("field \"field1\" is required")
 		}
-		return struct {
-			Type   string    `json:"type"`
-			Field1 foo.Type2 `json:"field1"`
-		}{Type: "field1", Field1: *u.field1}, nil
+		out = append(out, "{\"type\":\"field1\",\"field1\":"...)
+		out, err = u.field1.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "field2":
 		if u.field2 == nil {
 			return nil, fmt.Errorf("field \"field2\" is required")
 		}
-		return struct {
-			Type   string    `json:"type"`
-			Field2 foo.Type4 `json:"field2"`
-		}{Type: "field2", Field2: *u.field2}, nil
+		out = append(out, "{\"type\":\"field2\",\"field2\":"...)
+		out, err = u.field2.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "field3":
 		if u.field3 == nil {
 			return nil, fmt.Errorf("field \"field3\" is required")
 		}
-		return struct {
-			Type   string    `json:"type"`
-			Field3 bar.Type1 `json:"field3"`
-		}{Type: "field3", Field3: *u.field3}, nil
+		out = append(out, "{\"type\":\"field3\",\"field3\":"...)
+		out, err = u.field3.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	}
+	out = append(out, '}')
+	return out, nil
 }
 
-func (u Type3) MarshalJSON() ([]byte, error) {
-	ser, err := u.toSerializer()
-	if err != nil {
-		return nil, err
+func (u Type3) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	switch u.typ {
+	default:
+		return 0, fmt.Errorf("unknown type %q", u.typ)
+	case "field1":
+		if u.field1 == nil {
+			return 0, fmt.Errorf("field \"field1\" is required")
+		}
+		size = 27
+		n, err = u.field1.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "field2":
+		if u.field2 == nil {
+			return 0, fmt.Errorf("field \"field2\" is required")
+		}
+		size = 27
+		n, err = u.field2.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "field3":
+		if u.field3 == nil {
+			return 0, fmt.Errorf("field \"field3\" is required")
+		}
+		size = 27
+		n, err = u.field3.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
 	}
-	return safejson.Marshal(ser)
+	return size, nil
+}
+
+func (u Type3) MarshalJSON() ([]byte, error) {
+	return u.AppendJSON(nil)
 }
 
 func (u *Type3) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Type3
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*u = v
+			return nil
+		}
+	}
 	var deser type3Deserializer
 	if err := safejson.Unmarshal(data, &deser); err != nil {
 		return err
@@ -92,6 +143,183 @@ func (u *Type3) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (u *Type3) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenType, seenField1, seenField2, seenField3 bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !unmarshalJSONString(field, &u.typ) {
+				ok = false
+				return false
+			}
+		case "field1":
+			if seenField1 {
+				ok = false
+				return false
+			}
+			seenField1 = true
+			if field.Type != gjson.Null {
+				var v foo.Type2
+				if v.UnmarshalJSON([]byte(field.Raw)) != nil {
+					ok = false
+					return false
+				}
+				u.field1 = &v
+			}
+		case "field2":
+			if seenField2 {
+				ok = false
+				return false
+			}
+			seenField2 = true
+			if field.Type != gjson.Null {
+				var v1 foo.Type4
+				if safejson.Unmarshal([]byte(field.Raw), &v1) != nil {
+					ok = false
+					return false
+				}
+				u.field2 = &v1
+			}
+		case "field3":
+			if seenField3 {
+				ok = false
+				return false
+			}
+			seenField3 = true
+			if field.Type != gjson.Null {
+				var v2 bar.Type1
+				if v2.UnmarshalJSON([]byte(field.Raw)) != nil {
+					ok = false
+					return false
+				}
+				u.field3 = &v2
+			}
+		default:
+			if matchesJSONField(key.Str, "type", "field1", "field2", "field3") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	switch u.typ {
+	case "field1":
+		if u.field1 == nil {
+			return false
+		}
+	case "field2":
+		if u.field2 == nil {
+			return false
+		}
+	case "field3":
+		if u.field3 == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *Type3) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return u.decodeJSONStrict(value)
+}
+
+func (u *Type3) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	var typ string
+	var variantName string
+	var variant gjson.Result
+	var seenType, seenVariant bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch {
+		case key.Str == "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			typ, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"type\": %w", err)
+				return false
+			}
+			return true
+		case seenVariant && key.Str == variantName:
+			err = fmt.Errorf("duplicate field %q", key.Str)
+			return false
+		case seenVariant:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		variantName, variant, seenVariant = key.Str, field, true
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenType {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	if seenVariant && variantName != typ {
+		return fmt.Errorf("unknown field %q", variantName)
+	}
+	*u = Type3{typ: typ}
+	switch typ {
+	case "field1":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"field1\" is required")
+		}
+		var v foo.Type2
+		err = v.UnmarshalJSONStrict([]byte(variant.Raw))
+		if err != nil {
+			return fmt.Errorf("field \"field1\": %w", err)
+		}
+		u.field1 = &v
+	case "field2":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"field2\" is required")
+		}
+		var v1 foo.Type4
+		err = v1.UnmarshalJSONStrict([]byte(variant.Raw))
+		if err != nil {
+			return fmt.Errorf("field \"field2\": %w", err)
+		}
+		u.field2 = &v1
+	case "field3":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"field3\" is required")
+		}
+		var v2 bar.Type1
+		err = v2.UnmarshalJSONStrict([]byte(variant.Raw))
+		if err != nil {
+			return fmt.Errorf("field \"field3\": %w", err)
+		}
+		u.field3 = &v2
+	}
+	return nil
+}
+
 func (u Type3) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(u)
 	if err != nil {
@@ -224,3 +452,49 @@ func NewType3FromField2(v foo.Type4) Type3 {
 func NewType3FromField3(v bar.Type1) Type3 {
 	return Type3{typ: "field3", field3: &v}
 }
+
+// Equal returns true if the Type3 is equal to other according to the Conjure semantics of its values.
+func (u Type3) Equal(other Type3) bool {
+	if u.typ != other.typ {
+		return false
+	}
+	if (u.field1 == nil) != (other.field1 == nil) {
+		return false
+	}
+	if u.field1 != nil {
+		if !(*u.field1).Equal(*other.field1) {
+			return false
+		}
+	}
+	if (u.field2 == nil) != (other.field2 == nil) {
+		return false
+	}
+	if u.field2 != nil {
+		if !(*u.field2).Equal(*other.field2) {
+			return false
+		}
+	}
+	if (u.field3 == nil) != (other.field3 == nil) {
+		return false
+	}
+	if u.field3 != nil {
+		if !(*u.field3).Equal(*other.field3) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Type3.
+func (u Type3) Clone() Type3 {
+	out := u
+	if u.field1 != nil {
+		v := (*u.field1).Clone()
+		out.field1 = &v
+	}
+	if u.field3 != nil {
+		v := (*u.field3).Clone()
+		out.field3 = &v
+	}
+	return out
+}
@@ -3,15 +3,69 @@
 package barfoo
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/palantir/conjure-go/v6/cycles/testdata/type-cycle/conjure/com/palantir/fizz"
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type Type2 map[fizz.Type1]Type4
 
+func (a Type2) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value Type4
+		}
+		entries := make([]mapEntry, 0, len(map[fizz.Type1]Type4(a)))
+		for k, v := range map[fizz.Type1]Type4(a) {
+			entries = append(entries, mapEntry{key: string(k), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out, err = entry.value.AppendJSON(out)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (a Type2) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	size += 2
+	if len(map[fizz.Type1]Type4(a)) > 1 {
+		size += len(map[fizz.Type1]Type4(a)) - 1
+	}
+	for k, v := range map[fizz.Type1]Type4(a) {
+		size += jsonStringSize(string(k)) + 1
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
 func (a Type2) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal(map[fizz.Type1]Type4(a))
+	return a.AppendJSON(nil)
 }
 
 func (a *Type2) UnmarshalJSON(data []byte) error {
@@ -39,10 +93,112 @@ func (a *Type2) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+func (a *Type2) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *Type2) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v map[fizz.Type1]Type4
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	v = make(map[fizz.Type1]Type4)
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k fizz.Type1
+		var k1 string
+		k1 = key.Str
+		k = fizz.Type1(k1)
+		if _, ok := v[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v1 Type4
+		err = v1.decodeJSONStrict(elem)
+		if err != nil {
+			return false
+		}
+		v[k] = v1
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = Type2(v)
+	return nil
+}
+
+// Equal returns true if the Type2 is equal to other according to the Conjure semantics of its values.
+func (a Type2) Equal(other Type2) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for k, v := range a {
+		otherV, ok := other[k]
+		if !ok {
+			return false
+		}
+		if !v.Equal(otherV) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Type2.
+func (a Type2) Clone() Type2 {
+	var out Type2
+	if a != nil {
+		out = make(Type2, len(a))
+		for k, v := range a {
+			out[k] = v.Clone()
+		}
+	}
+	return out
+}
+
 type Type1 []BarType3
 
+func (a Type1) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, '[')
+	for i, v := range []BarType3(a) {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	return out, nil
+}
+
+func (a Type1) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	size += 2
+	if len([]BarType3(a)) > 1 {
+		size += len([]BarType3(a)) - 1
+	}
+	for _, v := range []BarType3(a) {
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
 func (a Type1) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal([]BarType3(a))
+	return a.AppendJSON(nil)
 }
 
 func (a *Type1) UnmarshalJSON(data []byte) error {
@@ -69,3 +225,59 @@ func (a *Type1) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	}
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
+
+func (a *Type1) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *Type1) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []BarType3
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]BarType3, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 BarType3
+		err = v1.decodeJSONStrict(elem)
+		if err != nil {
+			return false
+		}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = Type1(v)
+	return nil
+}
+
+// Equal returns true if the Type1 is equal to other according to the Conjure semantics of its values.
+func (a Type1) Equal(other Type1) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(other[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Type1.
+func (a Type1) Clone() Type1 {
+	var out Type1
+	if a != nil {
+		out = make(Type1, len(a))
+		for i := range a {
+			out[i] = a[i].Clone()
+		}
+	}
+	return out
+}
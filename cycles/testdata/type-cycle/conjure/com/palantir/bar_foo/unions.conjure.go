@@ -6,8 +6,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/palantir/conjure-go/v6/cycles/testdata/type-cycle/conjure/com/palantir/fizz"
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type FooType3 struct {
@@ -26,7 +28,8 @@ func (u *fooType3Deserializer) toStruct() FooType3 {
 	return FooType3{typ: u.Type, field1: u.Field1, field3: u.Field3}
 }
 
-func (u *FooType3) toSerializer() (interface{}, error) {
+func (u FooType3) AppendJSON(out []byte) ([]byte, error) {
+	var err error
 	switch u.typ {
 	default:
 		return nil, fmt.Errorf("unknown type %q", u.typ)
@@ -34,30 +37,68 @@ func (u *FooType3) toSerializer() (interface{}, error) {
 		if u.field1 == nil {
 			return nil, fmt.Errorf("field \"field1\" is required")
 		}
-		return struct {
-			Type   string `json:"type"`
-			Field1 Type2  `json:"field1"`
-		}{Type: "field1", Field1: *u.field1}, nil
+		out = append(out, "{\"type\":\"field1\",\"field1\":"...)
+		out, err = u.field1.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "field3":
 		if u.field3 == nil {
 			return nil, fmt.Errorf("field \"field3\" is required")
 		}
-		return struct {
-			Type   string `json:"type"`
-			Field3 Type1  `json:"field3"`
-		}{Type: "field3", Field3: *u.field3}, nil
+		out = append(out, "{\"type\":\"field3\",\"field3\":"...)
+		out, err = u.field3.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	}
+	out = append(out, '}')
+	return out, nil
 }
 
-func (u FooType3) MarshalJSON() ([]byte, error) {
-	ser, err := u.toSerializer()
-	if err != nil {
-		return nil, err
+func (u FooType3) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	switch u.typ {
+	default:
+		return 0, fmt.Errorf("unknown type %q", u.typ)
+	case "field1":
+		if u.field1 == nil {
+			return 0, fmt.Errorf("field \"field1\" is required")
+		}
+		size = 27
+		n, err = u.field1.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "field3":
+		if u.field3 == nil {
+			return 0, fmt.Errorf("field \"field3\" is required")
+		}
+		size = 27
+		n, err = u.field3.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
 	}
-	return safejson.Marshal(ser)
+	return size, nil
+}
+
+func (u FooType3) MarshalJSON() ([]byte, error) {
+	return u.AppendJSON(nil)
 }
 
 func (u *FooType3) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v FooType3
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*u = v
+			return nil
+		}
+	}
 	var deser fooType3Deserializer
 	if err := safejson.Unmarshal(data, &deser); err != nil {
 		return err
@@ -76,6 +117,200 @@ func (u *FooType3) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (u *FooType3) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenType, seenField1, seenField3 bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !unmarshalJSONString(field, &u.typ) {
+				ok = false
+				return false
+			}
+		case "field1":
+			if seenField1 {
+				ok = false
+				return false
+			}
+			seenField1 = true
+			if field.Type != gjson.Null {
+				var v Type2
+				var v1 map[fizz.Type1]Type4
+				if field.Type != gjson.Null {
+					if !field.IsObject() {
+						ok = false
+						return false
+					}
+					v1 = make(map[fizz.Type1]Type4)
+					ok1 := true
+					field.ForEach(func(key1, elem gjson.Result) bool {
+						var k fizz.Type1
+						var k1 string
+						if !unmarshalJSONString(key1, &k1) {
+							ok1 = false
+							return false
+						}
+						k = fizz.Type1(k1)
+						var v2 Type4
+						if !v2.unmarshalJSONValue(elem) {
+							ok1 = false
+							return false
+						}
+						v1[k] = v2
+						return true
+					})
+					if !ok1 {
+						ok = false
+						return false
+					}
+				}
+				v = Type2(v1)
+				u.field1 = &v
+			}
+		case "field3":
+			if seenField3 {
+				ok = false
+				return false
+			}
+			seenField3 = true
+			if field.Type != gjson.Null {
+				var v3 Type1
+				var v4 []BarType3
+				if field.Type != gjson.Null {
+					if !field.IsArray() {
+						ok = false
+						return false
+					}
+					v4 = make([]BarType3, 0)
+					ok2 := true
+					field.ForEach(func(_, elem1 gjson.Result) bool {
+						var v5 BarType3
+						if !v5.unmarshalJSONValue(elem1) {
+							ok2 = false
+							return false
+						}
+						v4 = append(v4, v5)
+						return true
+					})
+					if !ok2 {
+						ok = false
+						return false
+					}
+				}
+				v3 = Type1(v4)
+				u.field3 = &v3
+			}
+		default:
+			if matchesJSONField(key.Str, "type", "field1", "field3") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	switch u.typ {
+	case "field1":
+		if u.field1 == nil {
+			return false
+		}
+	case "field3":
+		if u.field3 == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *FooType3) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return u.decodeJSONStrict(value)
+}
+
+func (u *FooType3) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	var typ string
+	var variantName string
+	var variant gjson.Result
+	var seenType, seenVariant bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch {
+		case key.Str == "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			typ, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"type\": %w", err)
+				return false
+			}
+			return true
+		case seenVariant && key.Str == variantName:
+			err = fmt.Errorf("duplicate field %q", key.Str)
+			return false
+		case seenVariant:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		variantName, variant, seenVariant = key.Str, field, true
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenType {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	if seenVariant && variantName != typ {
+		return fmt.Errorf("unknown field %q", variantName)
+	}
+	*u = FooType3{typ: typ}
+	switch typ {
+	case "field1":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"field1\" is required")
+		}
+		var v Type2
+		err = v.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"field1\": %w", err)
+		}
+		u.field1 = &v
+	case "field3":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"field3\" is required")
+		}
+		var v1 Type1
+		err = v1.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"field3\": %w", err)
+		}
+		u.field3 = &v1
+	}
+	return nil
+}
+
 func (u FooType3) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(u)
 	if err != nil {
@@ -183,3 +418,41 @@ func NewFooType3FromField1(v Type2) FooType3 {
 func NewFooType3FromField3(v Type1) FooType3 {
 	return FooType3{typ: "field3", field3: &v}
 }
+
+// Equal returns true if the FooType3 is equal to other according to the Conjure semantics of its values.
+func (u FooType3) Equal(other FooType3) bool {
+	if u.typ != other.typ {
+		return false
+	}
+	if (u.field1 == nil) != (other.field1 == nil) {
+		return false
+	}
+	if u.field1 != nil {
+		if !(*u.field1).Equal(*other.field1) {
+			return false
+		}
+	}
+	if (u.field3 == nil) != (other.field3 == nil) {
+		return false
+	}
+	if u.field3 != nil {
+		if !(*u.field3).Equal(*other.field3) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the FooType3.
+func (u FooType3) Clone() FooType3 {
+	out := u
+	if u.field1 != nil {
+		v := (*u.field1).Clone()
+		out.field1 = &v
+	}
+	if u.field3 != nil {
+		v := (*u.field3).Clone()
+		out.field3 = &v
+	}
+	return out
+}
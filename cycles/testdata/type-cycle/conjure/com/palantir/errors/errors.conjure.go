@@ -14,6 +14,7 @@ import (
 	"github.com/palantir/pkg/safeyaml"
 	"github.com/palantir/pkg/uuid"
 	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/tidwall/gjson"
 )
 
 type myError struct {
@@ -22,15 +23,61 @@ type myError struct {
 	UnsafeArg3 barfoo.BarType3 `json:"unsafeArg3"`
 }
 
-func (o myError) MarshalJSON() ([]byte, error) {
-	if o.SafeArg1 == nil {
-		o.SafeArg1 = make([]barfoo.BarType3, 0)
+func (o myError) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"safeArg1\":"...)
+	out, err = o.SafeArg1.AppendJSON(out)
+	if err != nil {
+		return nil, err
 	}
-	type myErrorAlias myError
-	return safejson.Marshal(myErrorAlias(o))
+	out = append(out, ",\"safeArg2\":"...)
+	out, err = o.SafeArg2.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"unsafeArg3\":"...)
+	out, err = o.UnsafeArg3.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o myError) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 39
+	n, err = o.SafeArg1.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.SafeArg2.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.UnsafeArg3.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (o myError) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *myError) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v myError
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type myErrorAlias myError
 	var rawmyError myErrorAlias
 	if err := safejson.Unmarshal(data, &rawmyError); err != nil {
@@ -43,6 +90,67 @@ func (o *myError) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *myError) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.SafeArg1 == nil {
+			o.SafeArg1 = make([]barfoo.BarType3, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenSafeArg1, seenSafeArg2, seenUnsafeArg3 bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "safeArg1":
+			if seenSafeArg1 {
+				ok = false
+				return false
+			}
+			seenSafeArg1 = true
+			if o.SafeArg1.UnmarshalJSON([]byte(field.Raw)) != nil {
+				ok = false
+				return false
+			}
+		case "safeArg2":
+			if seenSafeArg2 {
+				ok = false
+				return false
+			}
+			seenSafeArg2 = true
+			if o.SafeArg2.UnmarshalJSON([]byte(field.Raw)) != nil {
+				ok = false
+				return false
+			}
+		case "unsafeArg3":
+			if seenUnsafeArg3 {
+				ok = false
+				return false
+			}
+			seenUnsafeArg3 = true
+			if safejson.Unmarshal([]byte(field.Raw), &o.UnsafeArg3) != nil {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "safeArg1", "safeArg2", "unsafeArg3") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.SafeArg1 == nil {
+		o.SafeArg1 = make([]barfoo.BarType3, 0)
+	}
+	return true
+}
+
 func (o myError) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -0,0 +1,18 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package errors
+
+import (
+	"strings"
+)
+
+// matchesJSONField returns true if encoding/json decodes the object key into one of the fields with the
+// provided names, which it matches case-insensitively.
+func matchesJSONField(key string, names ...string) bool {
+	for _, name := range names {
+		if strings.EqualFold(key, name) {
+			return true
+		}
+	}
+	return false
+}
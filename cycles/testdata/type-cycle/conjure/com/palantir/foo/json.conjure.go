@@ -0,0 +1,51 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package foo
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// parseJSONStrict parses data, which must contain a single valid JSON value.
+func parseJSONStrict(data []byte) (gjson.Result, error) {
+	if !gjson.ValidBytes(data) {
+		return gjson.Result{}, fmt.Errorf("invalid JSON")
+	}
+	return gjson.ParseBytes(data), nil
+}
+
+// jsonTypeError returns the error for a value that is not of the expected kind.
+func jsonTypeError(value gjson.Result, want string) error {
+	var got string
+	switch value.Type {
+	case gjson.Null:
+		got = "null"
+	case gjson.False, gjson.True:
+		got = "boolean"
+	case gjson.Number:
+		got = "number"
+	case gjson.String:
+		got = "string"
+	default:
+		if value.IsArray() {
+			got = "array"
+		} else {
+			got = "object"
+		}
+	}
+	return fmt.Errorf("expected %s but found %s", want, got)
+}
+
+// matchesJSONField returns true if encoding/json decodes the object key into one of the fields with the
+// provided names, which it matches case-insensitively.
+func matchesJSONField(key string, names ...string) bool {
+	for _, name := range names {
+		if strings.EqualFold(key, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -3,10 +3,13 @@
 package foo
 
 import (
+	"fmt"
+
 	barfoo "github.com/palantir/conjure-go/v6/cycles/testdata/type-cycle/conjure/com/palantir/bar_foo"
 	"github.com/palantir/conjure-go/v6/cycles/testdata/type-cycle/conjure/com/palantir/fizz"
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type Type1 struct {
@@ -14,15 +17,51 @@ type Type1 struct {
 	Field2 barfoo.FooType3 `json:"field2"`
 }
 
-func (o Type1) MarshalJSON() ([]byte, error) {
-	if o.Field1 == nil {
-		o.Field1 = make(map[fizz.Type1]barfoo.Type4, 0)
+func (o Type1) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"field1\":"...)
+	out, err = o.Field1.AppendJSON(out)
+	if err != nil {
+		return nil, err
 	}
-	type Type1Alias Type1
-	return safejson.Marshal(Type1Alias(o))
+	out = append(out, ",\"field2\":"...)
+	out, err = o.Field2.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Type1) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 21
+	n, err = o.Field1.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.Field2.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (o Type1) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *Type1) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Type1
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type Type1Alias Type1
 	var rawType1 Type1Alias
 	if err := safejson.Unmarshal(data, &rawType1); err != nil {
@@ -35,6 +74,116 @@ func (o *Type1) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *Type1) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Field1 == nil {
+			o.Field1 = make(map[fizz.Type1]barfoo.Type4, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenField1, seenField2 bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "field1":
+			if seenField1 {
+				ok = false
+				return false
+			}
+			seenField1 = true
+			if o.Field1.UnmarshalJSON([]byte(field.Raw)) != nil {
+				ok = false
+				return false
+			}
+		case "field2":
+			if seenField2 {
+				ok = false
+				return false
+			}
+			seenField2 = true
+			if o.Field2.UnmarshalJSON([]byte(field.Raw)) != nil {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "field1", "field2") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Field1 == nil {
+		o.Field1 = make(map[fizz.Type1]barfoo.Type4, 0)
+	}
+	return true
+}
+
+func (o *Type1) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Type1) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Type1{}
+	var seenField1, seenField2 bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "field1":
+			if seenField1 {
+				err = fmt.Errorf("duplicate field \"field1\"")
+				return false
+			}
+			seenField1 = true
+			if field.Type != gjson.Null {
+				err = o.Field1.UnmarshalJSONStrict([]byte(field.Raw))
+				if err != nil {
+					err = fmt.Errorf("field \"field1\": %w", err)
+					return false
+				}
+			}
+		case "field2":
+			if seenField2 {
+				err = fmt.Errorf("duplicate field \"field2\"")
+				return false
+			}
+			seenField2 = true
+			err = o.Field2.UnmarshalJSONStrict([]byte(field.Raw))
+			if err != nil {
+				err = fmt.Errorf("field \"field2\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if o.Field1 == nil {
+		o.Field1 = make(map[fizz.Type1]barfoo.Type4, 0)
+	}
+	if !seenField2 {
+		return fmt.Errorf("field \"field2\" is required")
+	}
+	return nil
+}
+
 func (o Type1) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -50,3 +199,22 @@ func (o *Type1) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	}
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
+
+// Equal returns true if the Type1 is equal to other according to the Conjure semantics of its values.
+func (o Type1) Equal(other Type1) bool {
+	if !o.Field1.Equal(other.Field1) {
+		return false
+	}
+	if !o.Field2.Equal(other.Field2) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Type1.
+func (o Type1) Clone() Type1 {
+	out := o
+	out.Field1 = o.Field1.Clone()
+	out.Field2 = o.Field2.Clone()
+	return out
+}
@@ -0,0 +1,94 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package apitest
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/auth/api"
+	"github.com/palantir/witchcraft-go-server/v2/wrouter"
+	"github.com/palantir/witchcraft-go-server/v2/wrouter/whttprouter"
+)
+
+// TestPairOptions configures the server and client of a test pair. The zero value registers the routes
+// without route params and creates the client with only the base URL of the server.
+type TestPairOptions struct {
+	// RouteParams are passed to the route registration function of the service.
+	RouteParams []wrouter.RouteParam
+	// ClientParams are applied after the base URL of the server.
+	ClientParams []httpclient.ClientParam
+}
+
+// NewBothAuthServiceTestPair starts an httptest.Server serving impl using the routes registered by RegisterRoutesBothAuthService
+// and returns a BothAuthServiceClient that sends requests to it, configured by opts. The server is closed when
+// the test completes.
+func NewBothAuthServiceTestPair(t testing.TB, impl api.BothAuthService, opts TestPairOptions) api.BothAuthServiceClient {
+	t.Helper()
+	router := wrouter.New(whttprouter.New())
+	if err := api.RegisterRoutesBothAuthService(router, impl, opts.RouteParams...); err != nil {
+		t.Fatalf("failed to register BothAuthService routes: %v", err)
+	}
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	client, err := httpclient.NewClient(append([]httpclient.ClientParam{httpclient.WithBaseURLs([]string{server.URL})}, opts.ClientParams...)...)
+	if err != nil {
+		t.Fatalf("failed to create BothAuthService client: %v", err)
+	}
+	return api.NewBothAuthServiceClient(client)
+}
+
+// NewCookieAuthServiceTestPair starts an httptest.Server serving impl using the routes registered by RegisterRoutesCookieAuthService
+// and returns a CookieAuthServiceClient that sends requests to it, configured by opts. The server is closed when
+// the test completes.
+func NewCookieAuthServiceTestPair(t testing.TB, impl api.CookieAuthService, opts TestPairOptions) api.CookieAuthServiceClient {
+	t.Helper()
+	router := wrouter.New(whttprouter.New())
+	if err := api.RegisterRoutesCookieAuthService(router, impl, opts.RouteParams...); err != nil {
+		t.Fatalf("failed to register CookieAuthService routes: %v", err)
+	}
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	client, err := httpclient.NewClient(append([]httpclient.ClientParam{httpclient.WithBaseURLs([]string{server.URL})}, opts.ClientParams...)...)
+	if err != nil {
+		t.Fatalf("failed to create CookieAuthService client: %v", err)
+	}
+	return api.NewCookieAuthServiceClient(client)
+}
+
+// NewHeaderAuthServiceTestPair starts an httptest.Server serving impl using the routes registered by RegisterRoutesHeaderAuthService
+// and returns a HeaderAuthServiceClient that sends requests to it, configured by opts. The server is closed when
+// the test completes.
+func NewHeaderAuthServiceTestPair(t testing.TB, impl api.HeaderAuthService, opts TestPairOptions) api.HeaderAuthServiceClient {
+	t.Helper()
+	router := wrouter.New(whttprouter.New())
+	if err := api.RegisterRoutesHeaderAuthService(router, impl, opts.RouteParams...); err != nil {
+		t.Fatalf("failed to register HeaderAuthService routes: %v", err)
+	}
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	client, err := httpclient.NewClient(append([]httpclient.ClientParam{httpclient.WithBaseURLs([]string{server.URL})}, opts.ClientParams...)...)
+	if err != nil {
+		t.Fatalf("failed to create HeaderAuthService client: %v", err)
+	}
+	return api.NewHeaderAuthServiceClient(client)
+}
+
+// NewSomeHeaderAuthServiceTestPair starts an httptest.Server serving impl using the routes registered by RegisterRoutesSomeHeaderAuthService
+// and returns a SomeHeaderAuthServiceClient that sends requests to it, configured by opts. The server is closed when
+// the test completes.
+func NewSomeHeaderAuthServiceTestPair(t testing.TB, impl api.SomeHeaderAuthService, opts TestPairOptions) api.SomeHeaderAuthServiceClient {
+	t.Helper()
+	router := wrouter.New(whttprouter.New())
+	if err := api.RegisterRoutesSomeHeaderAuthService(router, impl, opts.RouteParams...); err != nil {
+		t.Fatalf("failed to register SomeHeaderAuthService routes: %v", err)
+	}
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	client, err := httpclient.NewClient(append([]httpclient.ClientParam{httpclient.WithBaseURLs([]string{server.URL})}, opts.ClientParams...)...)
+	if err != nil {
+		t.Fatalf("failed to create SomeHeaderAuthService client: %v", err)
+	}
+	return api.NewSomeHeaderAuthServiceClient(client)
+}
@@ -0,0 +1,345 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/pkg/bearertoken"
+	wparams "github.com/palantir/witchcraft-go-params"
+)
+
+// FakeBothAuthService is an in-memory implementation of BothAuthService for use in tests.
+// Each endpoint records its arguments and then invokes the corresponding <Endpoint>Func field.
+// If the field is nil, the endpoint returns DefaultErr (or a Conjure Internal error if DefaultErr is nil).
+// The zero value is ready to use and all methods are safe for concurrent use.
+type FakeBothAuthService struct {
+	// DefaultFunc is invoked by Default if non-nil.
+	DefaultFunc func(ctx context.Context, authHeader bearertoken.Token) (string, error)
+	// CookieFunc is invoked by Cookie if non-nil.
+	CookieFunc func(ctx context.Context, cookieToken bearertoken.Token) error
+	// NoneFunc is invoked by None if non-nil.
+	NoneFunc func(ctx context.Context) error
+	// WithArgFunc is invoked by WithArg if non-nil.
+	WithArgFunc func(ctx context.Context, authHeader bearertoken.Token, argArg string) error
+	// DefaultErr is returned by endpoints whose func field is nil.
+	DefaultErr error
+
+	mu           sync.Mutex
+	defaultCalls []FakeBothAuthServiceDefaultCall
+	cookieCalls  []FakeBothAuthServiceCookieCall
+	noneCalls    []FakeBothAuthServiceNoneCall
+	withArgCalls []FakeBothAuthServiceWithArgCall
+}
+
+var _ BothAuthService = (*FakeBothAuthService)(nil)
+
+// FakeBothAuthServiceDefaultCall records the arguments of a call to FakeBothAuthService.Default.
+type FakeBothAuthServiceDefaultCall struct {
+	AuthHeader bearertoken.Token
+}
+
+func (f *FakeBothAuthService) Default(ctx context.Context, authHeader bearertoken.Token) (string, error) {
+	f.mu.Lock()
+	f.defaultCalls = append(f.defaultCalls, FakeBothAuthServiceDefaultCall{AuthHeader: authHeader})
+	f.mu.Unlock()
+	if f.DefaultFunc != nil {
+		return f.DefaultFunc(ctx, authHeader)
+	}
+	var defaultReturnVal string
+	return defaultReturnVal, f.defaultErr("default")
+}
+
+// DefaultCalls returns the arguments of every call made to Default, in call order.
+func (f *FakeBothAuthService) DefaultCalls() []FakeBothAuthServiceDefaultCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeBothAuthServiceDefaultCall(nil), f.defaultCalls...)
+}
+
+// FakeBothAuthServiceCookieCall records the arguments of a call to FakeBothAuthService.Cookie.
+type FakeBothAuthServiceCookieCall struct {
+	CookieToken bearertoken.Token
+}
+
+func (f *FakeBothAuthService) Cookie(ctx context.Context, cookieToken bearertoken.Token) error {
+	f.mu.Lock()
+	f.cookieCalls = append(f.cookieCalls, FakeBothAuthServiceCookieCall{CookieToken: cookieToken})
+	f.mu.Unlock()
+	if f.CookieFunc != nil {
+		return f.CookieFunc(ctx, cookieToken)
+	}
+	return f.defaultErr("cookie")
+}
+
+// CookieCalls returns the arguments of every call made to Cookie, in call order.
+func (f *FakeBothAuthService) CookieCalls() []FakeBothAuthServiceCookieCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeBothAuthServiceCookieCall(nil), f.cookieCalls...)
+}
+
+// FakeBothAuthServiceNoneCall records the arguments of a call to FakeBothAuthService.None.
+type FakeBothAuthServiceNoneCall struct{}
+
+func (f *FakeBothAuthService) None(ctx context.Context) error {
+	f.mu.Lock()
+	f.noneCalls = append(f.noneCalls, FakeBothAuthServiceNoneCall{})
+	f.mu.Unlock()
+	if f.NoneFunc != nil {
+		return f.NoneFunc(ctx)
+	}
+	return f.defaultErr("none")
+}
+
+// NoneCalls returns the arguments of every call made to None, in call order.
+func (f *FakeBothAuthService) NoneCalls() []FakeBothAuthServiceNoneCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeBothAuthServiceNoneCall(nil), f.noneCalls...)
+}
+
+// FakeBothAuthServiceWithArgCall records the arguments of a call to FakeBothAuthService.WithArg.
+type FakeBothAuthServiceWithArgCall struct {
+	AuthHeader bearertoken.Token
+	Arg        string
+}
+
+func (f *FakeBothAuthService) WithArg(ctx context.Context, authHeader bearertoken.Token, argArg string) error {
+	f.mu.Lock()
+	f.withArgCalls = append(f.withArgCalls, FakeBothAuthServiceWithArgCall{AuthHeader: authHeader, Arg: argArg})
+	f.mu.Unlock()
+	if f.WithArgFunc != nil {
+		return f.WithArgFunc(ctx, authHeader, argArg)
+	}
+	return f.defaultErr("withArg")
+}
+
+// WithArgCalls returns the arguments of every call made to WithArg, in call order.
+func (f *FakeBothAuthService) WithArgCalls() []FakeBothAuthServiceWithArgCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeBothAuthServiceWithArgCall(nil), f.withArgCalls...)
+}
+
+func (f *FakeBothAuthService) defaultErr(endpoint string) error {
+	if f.DefaultErr != nil {
+		return f.DefaultErr
+	}
+	return errors.NewInternal(wparams.NewSafeParamStorer(map[string]interface{}{"fakeEndpoint": endpoint}))
+}
+
+// FakeCookieAuthService is an in-memory implementation of CookieAuthService for use in tests.
+// Each endpoint records its arguments and then invokes the corresponding <Endpoint>Func field.
+// If the field is nil, the endpoint returns DefaultErr (or a Conjure Internal error if DefaultErr is nil).
+// The zero value is ready to use and all methods are safe for concurrent use.
+type FakeCookieAuthService struct {
+	// CookieFunc is invoked by Cookie if non-nil.
+	CookieFunc func(ctx context.Context, cookieToken bearertoken.Token) error
+	// DefaultErr is returned by endpoints whose func field is nil.
+	DefaultErr error
+
+	mu          sync.Mutex
+	cookieCalls []FakeCookieAuthServiceCookieCall
+}
+
+var _ CookieAuthService = (*FakeCookieAuthService)(nil)
+
+// FakeCookieAuthServiceCookieCall records the arguments of a call to FakeCookieAuthService.Cookie.
+type FakeCookieAuthServiceCookieCall struct {
+	CookieToken bearertoken.Token
+}
+
+func (f *FakeCookieAuthService) Cookie(ctx context.Context, cookieToken bearertoken.Token) error {
+	f.mu.Lock()
+	f.cookieCalls = append(f.cookieCalls, FakeCookieAuthServiceCookieCall{CookieToken: cookieToken})
+	f.mu.Unlock()
+	if f.CookieFunc != nil {
+		return f.CookieFunc(ctx, cookieToken)
+	}
+	return f.defaultErr("cookie")
+}
+
+// CookieCalls returns the arguments of every call made to Cookie, in call order.
+func (f *FakeCookieAuthService) CookieCalls() []FakeCookieAuthServiceCookieCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCookieAuthServiceCookieCall(nil), f.cookieCalls...)
+}
+
+func (f *FakeCookieAuthService) defaultErr(endpoint string) error {
+	if f.DefaultErr != nil {
+		return f.DefaultErr
+	}
+	return errors.NewInternal(wparams.NewSafeParamStorer(map[string]interface{}{"fakeEndpoint": endpoint}))
+}
+
+// FakeHeaderAuthService is an in-memory implementation of HeaderAuthService for use in tests.
+// Each endpoint records its arguments and then invokes the corresponding <Endpoint>Func field.
+// If the field is nil, the endpoint returns DefaultErr (or a Conjure Internal error if DefaultErr is nil).
+// The zero value is ready to use and all methods are safe for concurrent use.
+type FakeHeaderAuthService struct {
+	// DefaultFunc is invoked by Default if non-nil.
+	DefaultFunc func(ctx context.Context, authHeader bearertoken.Token) (string, error)
+	// BinaryFunc is invoked by Binary if non-nil.
+	BinaryFunc func(ctx context.Context, authHeader bearertoken.Token) (io.ReadCloser, error)
+	// BinaryOptionalFunc is invoked by BinaryOptional if non-nil.
+	BinaryOptionalFunc func(ctx context.Context, authHeader bearertoken.Token) (*io.ReadCloser, error)
+	// DefaultErr is returned by endpoints whose func field is nil.
+	DefaultErr error
+
+	mu                  sync.Mutex
+	defaultCalls        []FakeHeaderAuthServiceDefaultCall
+	binaryCalls         []FakeHeaderAuthServiceBinaryCall
+	binaryOptionalCalls []FakeHeaderAuthServiceBinaryOptionalCall
+}
+
+var _ HeaderAuthService = (*FakeHeaderAuthService)(nil)
+
+// FakeHeaderAuthServiceDefaultCall records the arguments of a call to FakeHeaderAuthService.Default.
+type FakeHeaderAuthServiceDefaultCall struct {
+	AuthHeader bearertoken.Token
+}
+
+func (f *FakeHeaderAuthService) Default(ctx context.Context, authHeader bearertoken.Token) (string, error) {
+	f.mu.Lock()
+	f.defaultCalls = append(f.defaultCalls, FakeHeaderAuthServiceDefaultCall{AuthHeader: authHeader})
+	f.mu.Unlock()
+	if f.DefaultFunc != nil {
+		return f.DefaultFunc(ctx, authHeader)
+	}
+	var defaultReturnVal string
+	return defaultReturnVal, f.defaultErr("default")
+}
+
+// DefaultCalls returns the arguments of every call made to Default, in call order.
+func (f *FakeHeaderAuthService) DefaultCalls() []FakeHeaderAuthServiceDefaultCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeHeaderAuthServiceDefaultCall(nil), f.defaultCalls...)
+}
+
+// FakeHeaderAuthServiceBinaryCall records the arguments of a call to FakeHeaderAuthService.Binary.
+type FakeHeaderAuthServiceBinaryCall struct {
+	AuthHeader bearertoken.Token
+}
+
+func (f *FakeHeaderAuthService) Binary(ctx context.Context, authHeader bearertoken.Token) (io.ReadCloser, error) {
+	f.mu.Lock()
+	f.binaryCalls = append(f.binaryCalls, FakeHeaderAuthServiceBinaryCall{AuthHeader: authHeader})
+	f.mu.Unlock()
+	if f.BinaryFunc != nil {
+		return f.BinaryFunc(ctx, authHeader)
+	}
+	var defaultReturnVal io.ReadCloser
+	return defaultReturnVal, f.defaultErr("binary")
+}
+
+// BinaryCalls returns the arguments of every call made to Binary, in call order.
+func (f *FakeHeaderAuthService) BinaryCalls() []FakeHeaderAuthServiceBinaryCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeHeaderAuthServiceBinaryCall(nil), f.binaryCalls...)
+}
+
+// FakeHeaderAuthServiceBinaryOptionalCall records the arguments of a call to FakeHeaderAuthService.BinaryOptional.
+type FakeHeaderAuthServiceBinaryOptionalCall struct {
+	AuthHeader bearertoken.Token
+}
+
+func (f *FakeHeaderAuthService) BinaryOptional(ctx context.Context, authHeader bearertoken.Token) (*io.ReadCloser, error) {
+	f.mu.Lock()
+	f.binaryOptionalCalls = append(f.binaryOptionalCalls, FakeHeaderAuthServiceBinaryOptionalCall{AuthHeader: authHeader})
+	f.mu.Unlock()
+	if f.BinaryOptionalFunc != nil {
+		return f.BinaryOptionalFunc(ctx, authHeader)
+	}
+	var defaultReturnVal *io.ReadCloser
+	return defaultReturnVal, f.defaultErr("binaryOptional")
+}
+
+// BinaryOptionalCalls returns the arguments of every call made to BinaryOptional, in call order.
+func (f *FakeHeaderAuthService) BinaryOptionalCalls() []FakeHeaderAuthServiceBinaryOptionalCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeHeaderAuthServiceBinaryOptionalCall(nil), f.binaryOptionalCalls...)
+}
+
+func (f *FakeHeaderAuthService) defaultErr(endpoint string) error {
+	if f.DefaultErr != nil {
+		return f.DefaultErr
+	}
+	return errors.NewInternal(wparams.NewSafeParamStorer(map[string]interface{}{"fakeEndpoint": endpoint}))
+}
+
+// FakeSomeHeaderAuthService is an in-memory implementation of SomeHeaderAuthService for use in tests.
+// Each endpoint records its arguments and then invokes the corresponding <Endpoint>Func field.
+// If the field is nil, the endpoint returns DefaultErr (or a Conjure Internal error if DefaultErr is nil).
+// The zero value is ready to use and all methods are safe for concurrent use.
+type FakeSomeHeaderAuthService struct {
+	// DefaultFunc is invoked by Default if non-nil.
+	DefaultFunc func(ctx context.Context, authHeader bearertoken.Token) (string, error)
+	// NoneFunc is invoked by None if non-nil.
+	NoneFunc func(ctx context.Context) error
+	// DefaultErr is returned by endpoints whose func field is nil.
+	DefaultErr error
+
+	mu           sync.Mutex
+	defaultCalls []FakeSomeHeaderAuthServiceDefaultCall
+	noneCalls    []FakeSomeHeaderAuthServiceNoneCall
+}
+
+var _ SomeHeaderAuthService = (*FakeSomeHeaderAuthService)(nil)
+
+// FakeSomeHeaderAuthServiceDefaultCall records the arguments of a call to FakeSomeHeaderAuthService.Default.
+type FakeSomeHeaderAuthServiceDefaultCall struct {
+	AuthHeader bearertoken.Token
+}
+
+func (f *FakeSomeHeaderAuthService) Default(ctx context.Context, authHeader bearertoken.Token) (string, error) {
+	f.mu.Lock()
+	f.defaultCalls = append(f.defaultCalls, FakeSomeHeaderAuthServiceDefaultCall{AuthHeader: authHeader})
+	f.mu.Unlock()
+	if f.DefaultFunc != nil {
+		return f.DefaultFunc(ctx, authHeader)
+	}
+	var defaultReturnVal string
+	return defaultReturnVal, f.defaultErr("default")
+}
+
+// DefaultCalls returns the arguments of every call made to Default, in call order.
+func (f *FakeSomeHeaderAuthService) DefaultCalls() []FakeSomeHeaderAuthServiceDefaultCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeSomeHeaderAuthServiceDefaultCall(nil), f.defaultCalls...)
+}
+
+// FakeSomeHeaderAuthServiceNoneCall records the arguments of a call to FakeSomeHeaderAuthService.None.
+type FakeSomeHeaderAuthServiceNoneCall struct{}
+
+func (f *FakeSomeHeaderAuthService) None(ctx context.Context) error {
+	f.mu.Lock()
+	f.noneCalls = append(f.noneCalls, FakeSomeHeaderAuthServiceNoneCall{})
+	f.mu.Unlock()
+	if f.NoneFunc != nil {
+		return f.NoneFunc(ctx)
+	}
+	return f.defaultErr("none")
+}
+
+// NoneCalls returns the arguments of every call made to None, in call order.
+func (f *FakeSomeHeaderAuthService) NoneCalls() []FakeSomeHeaderAuthServiceNoneCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeSomeHeaderAuthServiceNoneCall(nil), f.noneCalls...)
+}
+
+func (f *FakeSomeHeaderAuthService) defaultErr(endpoint string) error {
+	if f.DefaultErr != nil {
+		return f.DefaultErr
+	}
+	return errors.NewInternal(wparams.NewSafeParamStorer(map[string]interface{}{"fakeEndpoint": endpoint}))
+}
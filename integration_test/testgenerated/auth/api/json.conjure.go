@@ -0,0 +1,47 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"fmt"
+
+	"github.com/tidwall/gjson"
+)
+
+// parseJSONStrict parses data, which must contain a single valid JSON value.
+func parseJSONStrict(data []byte) (gjson.Result, error) {
+	if !gjson.ValidBytes(data) {
+		return gjson.Result{}, fmt.Errorf("invalid JSON")
+	}
+	return gjson.ParseBytes(data), nil
+}
+
+// jsonTypeError returns the error for a value that is not of the expected kind.
+func jsonTypeError(value gjson.Result, want string) error {
+	var got string
+	switch value.Type {
+	case gjson.Null:
+		got = "null"
+	case gjson.False, gjson.True:
+		got = "boolean"
+	case gjson.Number:
+		got = "number"
+	case gjson.String:
+		got = "string"
+	default:
+		if value.IsArray() {
+			got = "array"
+		} else {
+			got = "object"
+		}
+	}
+	return fmt.Errorf("expected %s but found %s", want, got)
+}
+
+// decodeJSONString decodes a JSON string.
+func decodeJSONString(value gjson.Result) (string, error) {
+	if value.Type != gjson.String {
+		return "", jsonTypeError(value, "string")
+	}
+	return value.Str, nil
+}
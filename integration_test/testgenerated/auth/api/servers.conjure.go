@@ -54,7 +54,11 @@ func (b *bothAuthServiceHandler) HandleDefault(rw http.ResponseWriter, req *http
 	if err != nil {
 		return errors.WrapWithPermissionDenied(err)
 	}
-	respArg, err := b.impl.Default(req.Context(), bearertoken.Token(authHeader))
+	authCtx, err := validateRequestAuth(req, bearertoken.Token(authHeader), EndpointInfo{Service: "BothAuthService", Name: "default", HTTPMethod: "GET", HTTPPath: "/default"})
+	if err != nil {
+		return err
+	}
+	respArg, err := b.impl.Default(authCtx, bearertoken.Token(authHeader))
 	if err != nil {
 		return err
 	}
@@ -68,7 +72,11 @@ func (b *bothAuthServiceHandler) HandleCookie(rw http.ResponseWriter, req *http.
 		return errors.WrapWithPermissionDenied(err)
 	}
 	cookieToken := bearertoken.Token(authCookie.Value)
-	if err := b.impl.Cookie(req.Context(), cookieToken); err != nil {
+	authCtx, err := validateRequestAuth(req, cookieToken, EndpointInfo{Service: "BothAuthService", Name: "cookie", HTTPMethod: "GET", HTTPPath: "/cookie", AuthCookie: "P_TOKEN"})
+	if err != nil {
+		return err
+	}
+	if err := b.impl.Cookie(authCtx, cookieToken); err != nil {
 		return err
 	}
 	rw.WriteHeader(http.StatusNoContent)
@@ -88,11 +96,24 @@ func (b *bothAuthServiceHandler) HandleWithArg(rw http.ResponseWriter, req *http
 	if err != nil {
 		return errors.WrapWithPermissionDenied(err)
 	}
+	authCtx, err := validateRequestAuth(req, bearertoken.Token(authHeader), EndpointInfo{Service: "BothAuthService", Name: "withArg", HTTPMethod: "POST", HTTPPath: "/withArg"})
+	if err != nil {
+		return err
+	}
 	var argArg string
-	if err := codecs.JSON.Decode(req.Body, &argArg); err != nil {
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	argArg, err = decodeJSONString(value)
+	if err != nil {
 		return errors.WrapWithInvalidArgument(err)
 	}
-	if err := b.impl.WithArg(req.Context(), bearertoken.Token(authHeader), argArg); err != nil {
+	if err := b.impl.WithArg(authCtx, bearertoken.Token(authHeader), argArg); err != nil {
 		return err
 	}
 	rw.WriteHeader(http.StatusNoContent)
@@ -126,7 +147,11 @@ func (c *cookieAuthServiceHandler) HandleCookie(rw http.ResponseWriter, req *htt
 		return errors.WrapWithPermissionDenied(err)
 	}
 	cookieToken := bearertoken.Token(authCookie.Value)
-	if err := c.impl.Cookie(req.Context(), cookieToken); err != nil {
+	authCtx, err := validateRequestAuth(req, cookieToken, EndpointInfo{Service: "CookieAuthService", Name: "cookie", HTTPMethod: "GET", HTTPPath: "/cookie", AuthCookie: "P_TOKEN"})
+	if err != nil {
+		return err
+	}
+	if err := c.impl.Cookie(authCtx, cookieToken); err != nil {
 		return err
 	}
 	rw.WriteHeader(http.StatusNoContent)
@@ -167,7 +192,11 @@ func (h *headerAuthServiceHandler) HandleDefault(rw http.ResponseWriter, req *ht
 	if err != nil {
 		return errors.WrapWithPermissionDenied(err)
 	}
-	respArg, err := h.impl.Default(req.Context(), bearertoken.Token(authHeader))
+	authCtx, err := validateRequestAuth(req, bearertoken.Token(authHeader), EndpointInfo{Service: "HeaderAuthService", Name: "default", HTTPMethod: "GET", HTTPPath: "/default"})
+	if err != nil {
+		return err
+	}
+	respArg, err := h.impl.Default(authCtx, bearertoken.Token(authHeader))
 	if err != nil {
 		return err
 	}
@@ -180,7 +209,11 @@ func (h *headerAuthServiceHandler) HandleBinary(rw http.ResponseWriter, req *htt
 	if err != nil {
 		return errors.WrapWithPermissionDenied(err)
 	}
-	respArg, err := h.impl.Binary(req.Context(), bearertoken.Token(authHeader))
+	authCtx, err := validateRequestAuth(req, bearertoken.Token(authHeader), EndpointInfo{Service: "HeaderAuthService", Name: "binary", HTTPMethod: "GET", HTTPPath: "/binary"})
+	if err != nil {
+		return err
+	}
+	respArg, err := h.impl.Binary(authCtx, bearertoken.Token(authHeader))
 	if err != nil {
 		return err
 	}
@@ -193,7 +226,11 @@ func (h *headerAuthServiceHandler) HandleBinaryOptional(rw http.ResponseWriter,
 	if err != nil {
 		return errors.WrapWithPermissionDenied(err)
 	}
-	respArg, err := h.impl.BinaryOptional(req.Context(), bearertoken.Token(authHeader))
+	authCtx, err := validateRequestAuth(req, bearertoken.Token(authHeader), EndpointInfo{Service: "HeaderAuthService", Name: "binaryOptional", HTTPMethod: "GET", HTTPPath: "/binaryOptional"})
+	if err != nil {
+		return err
+	}
+	respArg, err := h.impl.BinaryOptional(authCtx, bearertoken.Token(authHeader))
 	if err != nil {
 		return err
 	}
@@ -235,7 +272,11 @@ func (s *someHeaderAuthServiceHandler) HandleDefault(rw http.ResponseWriter, req
 	if err != nil {
 		return errors.WrapWithPermissionDenied(err)
 	}
-	respArg, err := s.impl.Default(req.Context(), bearertoken.Token(authHeader))
+	authCtx, err := validateRequestAuth(req, bearertoken.Token(authHeader), EndpointInfo{Service: "SomeHeaderAuthService", Name: "default", HTTPMethod: "GET", HTTPPath: "/default"})
+	if err != nil {
+		return err
+	}
+	respArg, err := s.impl.Default(authCtx, bearertoken.Token(authHeader))
 	if err != nil {
 		return err
 	}
@@ -250,3 +291,59 @@ func (s *someHeaderAuthServiceHandler) HandleNone(rw http.ResponseWriter, req *h
 	rw.WriteHeader(http.StatusNoContent)
 	return nil
 }
+
+// EndpointInfo describes the Conjure endpoint that handles a request.
+type EndpointInfo struct {
+	// Service is the name of the Conjure service of the endpoint.
+	Service string
+	// Name is the name of the endpoint.
+	Name string
+	// HTTPMethod is the HTTP method of the endpoint.
+	HTTPMethod string
+	// HTTPPath is the path template of the endpoint.
+	HTTPPath string
+	// AuthCookie is the name of the cookie that contains the token of the request. It is empty if the token is
+	// provided in the Authorization header or the endpoint does not require authentication.
+	AuthCookie string
+	// Tags are the tags of the endpoint.
+	Tags []string
+	// Markers are the names of the markers of the endpoint, such as "com.palantir.logsafe.Safe".
+	Markers []string
+}
+
+// AuthValidator validates the token of a request to an authenticated endpoint before the endpoint implementation is
+// called. The returned context is passed to the implementation, which allows validators to store information about
+// the authenticated caller. Requests are rejected if the validator returns an error: Conjure errors are returned
+// as-is, other errors are returned as PermissionDenied errors.
+type AuthValidator func(ctx context.Context, token bearertoken.Token, endpoint EndpointInfo) (context.Context, error)
+
+// WithAuthValidator returns a route parameter that configures the handlers registered by the RegisterRoutes
+// functions of this package to validate the tokens of requests using validator.
+func WithAuthValidator(validator AuthValidator) wrouter.RouteParam {
+	return wrouter.RouteMiddleware(func(rw http.ResponseWriter, req *http.Request, reqVals wrouter.RequestVals, next wrouter.RouteRequestHandler) {
+		ctx := context.WithValue(req.Context(), authValidatorContextKey{}, validator)
+		next(rw, req.WithContext(ctx), reqVals)
+	})
+}
+
+type authValidatorContextKey struct{}
+
+// validateRequestAuth calls the AuthValidator configured using WithAuthValidator, if any, and returns the context
+// with which the endpoint implementation is called.
+func validateRequestAuth(req *http.Request, token bearertoken.Token, endpoint EndpointInfo) (context.Context, error) {
+	validator, ok := req.Context().Value(authValidatorContextKey{}).(AuthValidator)
+	if !ok || validator == nil {
+		return req.Context(), nil
+	}
+	ctx, err := validator(req.Context(), token, endpoint)
+	if err != nil {
+		if errors.GetConjureError(err) != nil {
+			return nil, err
+		}
+		return nil, errors.WrapWithPermissionDenied(err)
+	}
+	if ctx == nil {
+		return req.Context(), nil
+	}
+	return ctx, nil
+}
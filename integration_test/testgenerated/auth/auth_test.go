@@ -16,6 +16,7 @@ package auth_test
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"strings"
@@ -197,6 +198,68 @@ func TestTokenProviderClient(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestAuthValidator(t *testing.T) {
+	ctx := testutil.TestContext()
+	var endpoints []api.EndpointInfo
+	validator := api.AuthValidator(func(ctx context.Context, token bearertoken.Token, endpoint api.EndpointInfo) (context.Context, error) {
+		endpoints = append(endpoints, endpoint)
+		switch token {
+		case "rejected":
+			return nil, fmt.Errorf("token rejected")
+		case "conflict":
+			return nil, errors.NewConflict()
+		}
+		return context.WithValue(ctx, principalKey{}, "principal"), nil
+	})
+	httpClient, cleanup := testutil.StartTestServer(t, func(ctx context.Context, info witchcraft.InitInfo) (cleanup func(), rErr error) {
+		if err := api.RegisterRoutesBothAuthService(info.Router, principalImpl{}, api.WithAuthValidator(validator)); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	})
+	defer cleanup()
+	client := api.NewBothAuthServiceClient(httpClient)
+
+	t.Run("header", func(t *testing.T) {
+		endpoints = nil
+		resp, err := client.Default(ctx, testJWT)
+		require.NoError(t, err)
+		assert.Equal(t, "principal", resp)
+		assert.Equal(t, []api.EndpointInfo{{Service: "BothAuthService", Name: "default", HTTPMethod: "GET", HTTPPath: "/default"}}, endpoints)
+	})
+	t.Run("cookie", func(t *testing.T) {
+		endpoints = nil
+		require.NoError(t, client.Cookie(ctx, testJWT))
+		assert.Equal(t, []api.EndpointInfo{{Service: "BothAuthService", Name: "cookie", HTTPMethod: "GET", HTTPPath: "/cookie", AuthCookie: "P_TOKEN"}}, endpoints)
+	})
+	t.Run("no auth", func(t *testing.T) {
+		endpoints = nil
+		require.NoError(t, client.None(ctx))
+		assert.Empty(t, endpoints)
+	})
+	t.Run("rejected", func(t *testing.T) {
+		_, err := client.Default(ctx, "rejected")
+		require.Error(t, err)
+		assert.Equal(t, errors.PermissionDenied, errors.GetConjureError(err).Code())
+	})
+	t.Run("conjure error", func(t *testing.T) {
+		err := client.WithArg(ctx, "conflict", "arg")
+		require.Error(t, err)
+		assert.Equal(t, errors.Conflict, errors.GetConjureError(err).Code())
+	})
+}
+
+type principalKey struct{}
+
+type principalImpl struct {
+	bothAuthImpl
+}
+
+func (principalImpl) Default(ctx context.Context, authHeader bearertoken.Token) (string, error) {
+	principal, _ := ctx.Value(principalKey{}).(string)
+	return principal, nil
+}
+
 type bothAuthImpl struct{}
 
 func (bothAuthImpl) Default(ctx context.Context, authHeader bearertoken.Token) (string, error) {
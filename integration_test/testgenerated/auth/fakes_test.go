@@ -0,0 +1,69 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/conjure-go/v6/integration_test/internal/testutil"
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/auth/api"
+	"github.com/palantir/pkg/bearertoken"
+	"github.com/palantir/witchcraft-go-server/v2/witchcraft"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeBothAuthService(t *testing.T) {
+	ctx := testutil.TestContext()
+	fake := &api.FakeBothAuthService{
+		DefaultFunc: func(ctx context.Context, authHeader bearertoken.Token) (string, error) {
+			return "fake response", nil
+		},
+	}
+	httpClient, cleanup := testutil.StartTestServer(t, func(ctx context.Context, info witchcraft.InitInfo) (cleanup func(), rErr error) {
+		if err := api.RegisterRoutesBothAuthService(info.Router, fake); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	})
+	defer cleanup()
+	client := api.NewBothAuthServiceClient(httpClient)
+
+	resp, err := client.Default(ctx, testJWT)
+	require.NoError(t, err)
+	assert.Equal(t, "fake response", resp)
+	assert.Equal(t, []api.FakeBothAuthServiceDefaultCall{{AuthHeader: testJWT}}, fake.DefaultCalls())
+
+	// endpoints without a func return an internal error by default
+	err = client.WithArg(ctx, testJWT, "foo")
+	require.Error(t, err)
+	assert.Equal(t, errors.DefaultInternal.Name(), errors.GetConjureError(err).Name())
+	// the client retries internal errors, so the fake may observe more than one call
+	withArgCalls := fake.WithArgCalls()
+	require.NotEmpty(t, withArgCalls)
+	assert.Equal(t, api.FakeBothAuthServiceWithArgCall{AuthHeader: testJWT, Arg: "foo"}, withArgCalls[0])
+	assert.Empty(t, fake.NoneCalls())
+}
+
+func TestFakeBothAuthServiceDefaultErr(t *testing.T) {
+	fake := &api.FakeBothAuthService{
+		DefaultErr: errors.NewNotFound(),
+	}
+	err := fake.None(context.Background())
+	assert.Equal(t, errors.DefaultNotFound.Name(), errors.GetConjureError(err).Name())
+	assert.Len(t, fake.NoneCalls(), 1)
+}
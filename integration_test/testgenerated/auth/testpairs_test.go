@@ -0,0 +1,72 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/auth/api"
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/auth/api/apitest"
+	"github.com/palantir/pkg/bearertoken"
+	"github.com/palantir/witchcraft-go-server/v2/wrouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBothAuthServiceTestPair(t *testing.T) {
+	ctx := context.Background()
+	fake := &api.FakeBothAuthService{
+		DefaultFunc: func(ctx context.Context, authHeader bearertoken.Token) (string, error) {
+			return string(authHeader), nil
+		},
+	}
+	client := apitest.NewBothAuthServiceTestPair(t, fake, apitest.TestPairOptions{
+		ClientParams: []httpclient.ClientParam{httpclient.WithMaxRetries(0)},
+	})
+
+	resp, err := client.Default(ctx, testJWT)
+	require.NoError(t, err)
+	assert.Equal(t, testJWT, resp)
+
+	err = client.Cookie(ctx, testJWT)
+	require.Error(t, err)
+	assert.Equal(t, []api.FakeBothAuthServiceCookieCall{{CookieToken: testJWT}}, fake.CookieCalls())
+}
+
+func TestBothAuthServiceTestPairRouteParams(t *testing.T) {
+	ctx := context.Background()
+	fake := &api.FakeBothAuthService{
+		DefaultFunc: func(ctx context.Context, authHeader bearertoken.Token) (string, error) {
+			return string(authHeader), nil
+		},
+	}
+	var paths []string
+	client := apitest.NewBothAuthServiceTestPair(t, fake, apitest.TestPairOptions{
+		RouteParams: []wrouter.RouteParam{
+			wrouter.RouteMiddleware(func(rw http.ResponseWriter, r *http.Request, reqVals wrouter.RequestVals, next wrouter.RouteRequestHandler) {
+				paths = append(paths, reqVals.Spec.PathTemplate)
+				next(rw, r, reqVals)
+			}),
+		},
+		ClientParams: []httpclient.ClientParam{httpclient.WithMaxRetries(0)},
+	})
+
+	_, err := client.Default(ctx, testJWT)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/default"}, paths)
+}
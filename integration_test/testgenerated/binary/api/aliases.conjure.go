@@ -3,13 +3,29 @@
 package api
 
 import (
+	"bytes"
+	"encoding/base64"
+
 	"github.com/palantir/pkg/binary"
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type BinaryAlias []byte
 
+func (a BinaryAlias) AppendJSON(out []byte) ([]byte, error) {
+	return appendJSONBinary(out, a), nil
+}
+
+func (a BinaryAlias) JSONSize() (int, error) {
+	return base64.StdEncoding.EncodedLen(len(a)) + 2, nil
+}
+
+func (a BinaryAlias) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
 func (a BinaryAlias) String() string {
 	return binary.New(a).String()
 }
@@ -43,6 +59,40 @@ func (a *BinaryAlias) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+func (a *BinaryAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *BinaryAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []byte
+	v, err = decodeJSONBinary(value)
+	if err != nil {
+		return err
+	}
+	*a = BinaryAlias(v)
+	return nil
+}
+
+// Equal returns true if the BinaryAlias is equal to other according to the Conjure semantics of its values.
+func (a BinaryAlias) Equal(other BinaryAlias) bool {
+	return bytes.Equal(a, other)
+}
+
+// Clone returns a deep copy of the BinaryAlias.
+func (a BinaryAlias) Clone() BinaryAlias {
+	var out BinaryAlias
+	if a != nil {
+		out = make(BinaryAlias, len(a))
+		copy(out, a)
+	}
+	return out
+}
+
 type BinaryAliasAlias struct {
 	Value *BinaryAlias
 }
@@ -54,11 +104,37 @@ func (a BinaryAliasAlias) MarshalText() ([]byte, error) {
 	return binary.New(*a.Value).MarshalText()
 }
 
-func (a BinaryAliasAlias) MarshalJSON() ([]byte, error) {
+func (a BinaryAliasAlias) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	if a.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out, err = a.Value.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func (a BinaryAliasAlias) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
 	if a.Value == nil {
-		return []byte("null"), nil
+		size += 4
+	} else {
+		n, err = a.Value.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
 	}
-	return safejson.Marshal(a.Value)
+	return size, nil
+}
+
+func (a BinaryAliasAlias) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
 }
 
 func (a *BinaryAliasAlias) UnmarshalText(data []byte) error {
@@ -70,6 +146,29 @@ func (a *BinaryAliasAlias) UnmarshalText(data []byte) error {
 	return nil
 }
 
+func (a *BinaryAliasAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *BinaryAliasAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *BinaryAlias
+	if value.Type != gjson.Null {
+		var v1 BinaryAlias
+		err = v1.decodeJSONStrict(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = BinaryAliasAlias{Value: v}
+	return nil
+}
+
 func (a BinaryAliasAlias) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
@@ -86,6 +185,29 @@ func (a *BinaryAliasAlias) UnmarshalYAML(unmarshal func(interface{}) error) erro
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+// Equal returns true if the BinaryAliasAlias is equal to other according to the Conjure semantics of its values.
+func (a BinaryAliasAlias) Equal(other BinaryAliasAlias) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if !(*a.Value).Equal(*other.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the BinaryAliasAlias.
+func (a BinaryAliasAlias) Clone() BinaryAliasAlias {
+	out := a
+	if a.Value != nil {
+		v := (*a.Value).Clone()
+		out.Value = &v
+	}
+	return out
+}
+
 type BinaryAliasOptional struct {
 	Value *[]byte
 }
@@ -97,11 +219,35 @@ func (a BinaryAliasOptional) MarshalText() ([]byte, error) {
 	return binary.New(*a.Value).MarshalText()
 }
 
-func (a BinaryAliasOptional) MarshalJSON() ([]byte, error) {
+func (a BinaryAliasOptional) AppendJSON(out []byte) ([]byte, error) {
 	if a.Value == nil {
-		return []byte("null"), nil
+		out = append(out, "null"...)
+	} else {
+		if *a.Value == nil {
+			out = append(out, "null"...)
+		} else {
+			out = appendJSONBinary(out, *a.Value)
+		}
 	}
-	return safejson.Marshal(a.Value)
+	return out, nil
+}
+
+func (a BinaryAliasOptional) JSONSize() (int, error) {
+	var size int
+	if a.Value == nil {
+		size += 4
+	} else {
+		if *a.Value == nil {
+			size += 4
+		} else {
+			size += base64.StdEncoding.EncodedLen(len(*a.Value)) + 2
+		}
+	}
+	return size, nil
+}
+
+func (a BinaryAliasOptional) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
 }
 
 func (a *BinaryAliasOptional) UnmarshalText(data []byte) error {
@@ -113,6 +259,29 @@ func (a *BinaryAliasOptional) UnmarshalText(data []byte) error {
 	return nil
 }
 
+func (a *BinaryAliasOptional) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *BinaryAliasOptional) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *[]byte
+	if value.Type != gjson.Null {
+		var v1 []byte
+		v1, err = decodeJSONBinary(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = BinaryAliasOptional{Value: v}
+	return nil
+}
+
 func (a BinaryAliasOptional) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
@@ -128,3 +297,30 @@ func (a *BinaryAliasOptional) UnmarshalYAML(unmarshal func(interface{}) error) e
 	}
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
+
+// Equal returns true if the BinaryAliasOptional is equal to other according to the Conjure semantics of its values.
+func (a BinaryAliasOptional) Equal(other BinaryAliasOptional) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if !bytes.Equal(*a.Value, *other.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the BinaryAliasOptional.
+func (a BinaryAliasOptional) Clone() BinaryAliasOptional {
+	out := a
+	if a.Value != nil {
+		var v []byte
+		if *a.Value != nil {
+			v = make([]byte, len(*a.Value))
+			copy(v, *a.Value)
+		}
+		out.Value = &v
+	}
+	return out
+}
@@ -0,0 +1,236 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	wparams "github.com/palantir/witchcraft-go-params"
+)
+
+// FakeTestService is an in-memory implementation of TestService for use in tests.
+// Each endpoint records its arguments and then invokes the corresponding <Endpoint>Func field.
+// If the field is nil, the endpoint returns DefaultErr (or a Conjure Internal error if DefaultErr is nil).
+// The zero value is ready to use and all methods are safe for concurrent use.
+type FakeTestService struct {
+	// BinaryAliasFunc is invoked by BinaryAlias if non-nil.
+	BinaryAliasFunc func(ctx context.Context, bodyArg io.ReadCloser) (io.ReadCloser, error)
+	// BinaryAliasOptionalFunc is invoked by BinaryAliasOptional if non-nil.
+	BinaryAliasOptionalFunc func(ctx context.Context) (*io.ReadCloser, error)
+	// BinaryAliasAliasFunc is invoked by BinaryAliasAlias if non-nil.
+	BinaryAliasAliasFunc func(ctx context.Context, bodyArg *io.ReadCloser) (*io.ReadCloser, error)
+	// BinaryFunc is invoked by Binary if non-nil.
+	BinaryFunc func(ctx context.Context, bodyArg io.ReadCloser) (io.ReadCloser, error)
+	// BinaryOptionalFunc is invoked by BinaryOptional if non-nil.
+	BinaryOptionalFunc func(ctx context.Context) (*io.ReadCloser, error)
+	// BinaryOptionalAliasFunc is invoked by BinaryOptionalAlias if non-nil.
+	BinaryOptionalAliasFunc func(ctx context.Context, bodyArg *io.ReadCloser) (*io.ReadCloser, error)
+	// BinaryListFunc is invoked by BinaryList if non-nil.
+	BinaryListFunc func(ctx context.Context, bodyArg [][]byte) ([][]byte, error)
+	// BytesFunc is invoked by Bytes if non-nil.
+	BytesFunc func(ctx context.Context, bodyArg CustomObject) (CustomObject, error)
+	// DefaultErr is returned by endpoints whose func field is nil.
+	DefaultErr error
+
+	mu                       sync.Mutex
+	binaryAliasCalls         []FakeTestServiceBinaryAliasCall
+	binaryAliasOptionalCalls []FakeTestServiceBinaryAliasOptionalCall
+	binaryAliasAliasCalls    []FakeTestServiceBinaryAliasAliasCall
+	binaryCalls              []FakeTestServiceBinaryCall
+	binaryOptionalCalls      []FakeTestServiceBinaryOptionalCall
+	binaryOptionalAliasCalls []FakeTestServiceBinaryOptionalAliasCall
+	binaryListCalls          []FakeTestServiceBinaryListCall
+	bytesCalls               []FakeTestServiceBytesCall
+}
+
+var _ TestService = (*FakeTestService)(nil)
+
+// FakeTestServiceBinaryAliasCall records the arguments of a call to FakeTestService.BinaryAlias.
+type FakeTestServiceBinaryAliasCall struct {
+	Body io.ReadCloser
+}
+
+func (f *FakeTestService) BinaryAlias(ctx context.Context, bodyArg io.ReadCloser) (io.ReadCloser, error) {
+	f.mu.Lock()
+	f.binaryAliasCalls = append(f.binaryAliasCalls, FakeTestServiceBinaryAliasCall{Body: bodyArg})
+	f.mu.Unlock()
+	if f.BinaryAliasFunc != nil {
+		return f.BinaryAliasFunc(ctx, bodyArg)
+	}
+	var defaultReturnVal io.ReadCloser
+	return defaultReturnVal, f.defaultErr("binaryAlias")
+}
+
+// BinaryAliasCalls returns the arguments of every call made to BinaryAlias, in call order.
+func (f *FakeTestService) BinaryAliasCalls() []FakeTestServiceBinaryAliasCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceBinaryAliasCall(nil), f.binaryAliasCalls...)
+}
+
+// FakeTestServiceBinaryAliasOptionalCall records the arguments of a call to FakeTestService.BinaryAliasOptional.
+type FakeTestServiceBinaryAliasOptionalCall struct{}
+
+func (f *FakeTestService) BinaryAliasOptional(ctx context.Context) (*io.ReadCloser, error) {
+	f.mu.Lock()
+	f.binaryAliasOptionalCalls = append(f.binaryAliasOptionalCalls, FakeTestServiceBinaryAliasOptionalCall{})
+	f.mu.Unlock()
+	if f.BinaryAliasOptionalFunc != nil {
+		return f.BinaryAliasOptionalFunc(ctx)
+	}
+	var defaultReturnVal *io.ReadCloser
+	return defaultReturnVal, f.defaultErr("binaryAliasOptional")
+}
+
+// BinaryAliasOptionalCalls returns the arguments of every call made to BinaryAliasOptional, in call order.
+func (f *FakeTestService) BinaryAliasOptionalCalls() []FakeTestServiceBinaryAliasOptionalCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceBinaryAliasOptionalCall(nil), f.binaryAliasOptionalCalls...)
+}
+
+// FakeTestServiceBinaryAliasAliasCall records the arguments of a call to FakeTestService.BinaryAliasAlias.
+type FakeTestServiceBinaryAliasAliasCall struct {
+	Body *io.ReadCloser
+}
+
+func (f *FakeTestService) BinaryAliasAlias(ctx context.Context, bodyArg *io.ReadCloser) (*io.ReadCloser, error) {
+	f.mu.Lock()
+	f.binaryAliasAliasCalls = append(f.binaryAliasAliasCalls, FakeTestServiceBinaryAliasAliasCall{Body: bodyArg})
+	f.mu.Unlock()
+	if f.BinaryAliasAliasFunc != nil {
+		return f.BinaryAliasAliasFunc(ctx, bodyArg)
+	}
+	var defaultReturnVal *io.ReadCloser
+	return defaultReturnVal, f.defaultErr("binaryAliasAlias")
+}
+
+// BinaryAliasAliasCalls returns the arguments of every call made to BinaryAliasAlias, in call order.
+func (f *FakeTestService) BinaryAliasAliasCalls() []FakeTestServiceBinaryAliasAliasCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceBinaryAliasAliasCall(nil), f.binaryAliasAliasCalls...)
+}
+
+// FakeTestServiceBinaryCall records the arguments of a call to FakeTestService.Binary.
+type FakeTestServiceBinaryCall struct {
+	Body io.ReadCloser
+}
+
+func (f *FakeTestService) Binary(ctx context.Context, bodyArg io.ReadCloser) (io.ReadCloser, error) {
+	f.mu.Lock()
+	f.binaryCalls = append(f.binaryCalls, FakeTestServiceBinaryCall{Body: bodyArg})
+	f.mu.Unlock()
+	if f.BinaryFunc != nil {
+		return f.BinaryFunc(ctx, bodyArg)
+	}
+	var defaultReturnVal io.ReadCloser
+	return defaultReturnVal, f.defaultErr("binary")
+}
+
+// BinaryCalls returns the arguments of every call made to Binary, in call order.
+func (f *FakeTestService) BinaryCalls() []FakeTestServiceBinaryCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceBinaryCall(nil), f.binaryCalls...)
+}
+
+// FakeTestServiceBinaryOptionalCall records the arguments of a call to FakeTestService.BinaryOptional.
+type FakeTestServiceBinaryOptionalCall struct{}
+
+func (f *FakeTestService) BinaryOptional(ctx context.Context) (*io.ReadCloser, error) {
+	f.mu.Lock()
+	f.binaryOptionalCalls = append(f.binaryOptionalCalls, FakeTestServiceBinaryOptionalCall{})
+	f.mu.Unlock()
+	if f.BinaryOptionalFunc != nil {
+		return f.BinaryOptionalFunc(ctx)
+	}
+	var defaultReturnVal *io.ReadCloser
+	return defaultReturnVal, f.defaultErr("binaryOptional")
+}
+
+// BinaryOptionalCalls returns the arguments of every call made to BinaryOptional, in call order.
+func (f *FakeTestService) BinaryOptionalCalls() []FakeTestServiceBinaryOptionalCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceBinaryOptionalCall(nil), f.binaryOptionalCalls...)
+}
+
+// FakeTestServiceBinaryOptionalAliasCall records the arguments of a call to FakeTestService.BinaryOptionalAlias.
+type FakeTestServiceBinaryOptionalAliasCall struct {
+	Body *io.ReadCloser
+}
+
+func (f *FakeTestService) BinaryOptionalAlias(ctx context.Context, bodyArg *io.ReadCloser) (*io.ReadCloser, error) {
+	f.mu.Lock()
+	f.binaryOptionalAliasCalls = append(f.binaryOptionalAliasCalls, FakeTestServiceBinaryOptionalAliasCall{Body: bodyArg})
+	f.mu.Unlock()
+	if f.BinaryOptionalAliasFunc != nil {
+		return f.BinaryOptionalAliasFunc(ctx, bodyArg)
+	}
+	var defaultReturnVal *io.ReadCloser
+	return defaultReturnVal, f.defaultErr("binaryOptionalAlias")
+}
+
+// BinaryOptionalAliasCalls returns the arguments of every call made to BinaryOptionalAlias, in call order.
+func (f *FakeTestService) BinaryOptionalAliasCalls() []FakeTestServiceBinaryOptionalAliasCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceBinaryOptionalAliasCall(nil), f.binaryOptionalAliasCalls...)
+}
+
+// FakeTestServiceBinaryListCall records the arguments of a call to FakeTestService.BinaryList.
+type FakeTestServiceBinaryListCall struct {
+	Body [][]byte
+}
+
+func (f *FakeTestService) BinaryList(ctx context.Context, bodyArg [][]byte) ([][]byte, error) {
+	f.mu.Lock()
+	f.binaryListCalls = append(f.binaryListCalls, FakeTestServiceBinaryListCall{Body: bodyArg})
+	f.mu.Unlock()
+	if f.BinaryListFunc != nil {
+		return f.BinaryListFunc(ctx, bodyArg)
+	}
+	var defaultReturnVal [][]byte
+	return defaultReturnVal, f.defaultErr("binaryList")
+}
+
+// BinaryListCalls returns the arguments of every call made to BinaryList, in call order.
+func (f *FakeTestService) BinaryListCalls() []FakeTestServiceBinaryListCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceBinaryListCall(nil), f.binaryListCalls...)
+}
+
+// FakeTestServiceBytesCall records the arguments of a call to FakeTestService.Bytes.
+type FakeTestServiceBytesCall struct {
+	Body CustomObject
+}
+
+func (f *FakeTestService) Bytes(ctx context.Context, bodyArg CustomObject) (CustomObject, error) {
+	f.mu.Lock()
+	f.bytesCalls = append(f.bytesCalls, FakeTestServiceBytesCall{Body: bodyArg})
+	f.mu.Unlock()
+	if f.BytesFunc != nil {
+		return f.BytesFunc(ctx, bodyArg)
+	}
+	var defaultReturnVal CustomObject
+	return defaultReturnVal, f.defaultErr("bytes")
+}
+
+// BytesCalls returns the arguments of every call made to Bytes, in call order.
+func (f *FakeTestService) BytesCalls() []FakeTestServiceBytesCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceBytesCall(nil), f.bytesCalls...)
+}
+
+func (f *FakeTestService) defaultErr(endpoint string) error {
+	if f.DefaultErr != nil {
+		return f.DefaultErr
+	}
+	return errors.NewInternal(wparams.NewSafeParamStorer(map[string]interface{}{"fakeEndpoint": endpoint}))
+}
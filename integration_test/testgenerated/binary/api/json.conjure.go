@@ -0,0 +1,104 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tidwall/gjson"
+)
+
+// appendJSONBinary appends b encoded as a base64 JSON string to out.
+func appendJSONBinary(out []byte, b []byte) []byte {
+	out = append(out, '"')
+	start := len(out)
+	out = append(out, make([]byte, base64.StdEncoding.EncodedLen(len(b)))...)
+	base64.StdEncoding.Encode(out[start:], b)
+	return append(out, '"')
+}
+
+// parseJSONStrict parses data, which must contain a single valid JSON value.
+func parseJSONStrict(data []byte) (gjson.Result, error) {
+	if !gjson.ValidBytes(data) {
+		return gjson.Result{}, fmt.Errorf("invalid JSON")
+	}
+	return gjson.ParseBytes(data), nil
+}
+
+// jsonTypeError returns the error for a value that is not of the expected kind.
+func jsonTypeError(value gjson.Result, want string) error {
+	var got string
+	switch value.Type {
+	case gjson.Null:
+		got = "null"
+	case gjson.False, gjson.True:
+		got = "boolean"
+	case gjson.Number:
+		got = "number"
+	case gjson.String:
+		got = "string"
+	default:
+		if value.IsArray() {
+			got = "array"
+		} else {
+			got = "object"
+		}
+	}
+	return fmt.Errorf("expected %s but found %s", want, got)
+}
+
+// decodeJSONBinary decodes a JSON string that is base64 encoded binary data.
+func decodeJSONBinary(value gjson.Result) ([]byte, error) {
+	if value.Type != gjson.String {
+		return nil, jsonTypeError(value, "binary")
+	}
+	return base64.StdEncoding.DecodeString(value.Str)
+}
+
+// unmarshalJSONString decodes a JSON string or null into v like encoding/json. It returns false for other values
+// and for strings that gjson may unescape differently, which are strings that are not valid UTF-8 or that
+// contain escaped surrogates.
+func unmarshalJSONString(value gjson.Result, v *string) bool {
+	switch value.Type {
+	case gjson.Null:
+		return true
+	case gjson.String:
+		if !utf8.ValidString(value.Raw) || strings.Contains(value.Raw, "\\ud") || strings.Contains(value.Raw, "\\uD") {
+			return false
+		}
+		*v = value.Str
+		return true
+	}
+	return false
+}
+
+// unmarshalJSONBinary decodes a JSON string that is base64 encoded binary data or null into v like encoding/json.
+func unmarshalJSONBinary(value gjson.Result, v *[]byte) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	var s string
+	if !unmarshalJSONString(value, &s) {
+		return false
+	}
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return false
+	}
+	*v = b
+	return true
+}
+
+// matchesJSONField returns true if encoding/json decodes the object key into one of the fields with the
+// provided names, which it matches case-insensitively.
+func matchesJSONField(key string, names ...string) bool {
+	for _, name := range names {
+		if strings.EqualFold(key, name) {
+			return true
+		}
+	}
+	return false
+}
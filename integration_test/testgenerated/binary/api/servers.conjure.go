@@ -13,6 +13,7 @@ import (
 	werror "github.com/palantir/witchcraft-go-error"
 	"github.com/palantir/witchcraft-go-server/v2/witchcraft/wresource"
 	"github.com/palantir/witchcraft-go-server/v2/wrouter"
+	"github.com/tidwall/gjson"
 )
 
 type TestService interface {
@@ -146,7 +147,28 @@ func (t *testServiceHandler) HandleBinaryOptionalAlias(rw http.ResponseWriter, r
 
 func (t *testServiceHandler) HandleBinaryList(rw http.ResponseWriter, req *http.Request) error {
 	var bodyArg [][]byte
-	if err := codecs.JSON.Decode(req.Body, &bodyArg); err != nil {
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	if !value.IsArray() {
+		return errors.WrapWithInvalidArgument(jsonTypeError(value, "array"))
+	}
+	bodyArg = make([][]byte, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v []byte
+		v, err = decodeJSONBinary(elem)
+		if err != nil {
+			return false
+		}
+		bodyArg = append(bodyArg, v)
+		return true
+	})
+	if err != nil {
 		return errors.WrapWithInvalidArgument(err)
 	}
 	respArg, err := t.impl.BinaryList(req.Context(), bodyArg)
@@ -159,7 +181,11 @@ func (t *testServiceHandler) HandleBinaryList(rw http.ResponseWriter, req *http.
 
 func (t *testServiceHandler) HandleBytes(rw http.ResponseWriter, req *http.Request) error {
 	var bodyArg CustomObject
-	if err := codecs.JSON.Decode(req.Body, &bodyArg); err != nil {
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	if err := bodyArg.UnmarshalJSONStrict(data); err != nil {
 		return errors.WrapWithInvalidArgument(err)
 	}
 	respArg, err := t.impl.Bytes(req.Context(), bodyArg)
@@ -3,8 +3,13 @@
 package api
 
 import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type CustomObject struct {
@@ -12,6 +17,158 @@ type CustomObject struct {
 	BinaryAlias *BinaryAlias `json:"binaryAlias"`
 }
 
+func (o CustomObject) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"data\":"...)
+	if o.Data == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONBinary(out, o.Data)
+	}
+	out = append(out, ",\"binaryAlias\":"...)
+	if o.BinaryAlias == nil {
+		out = append(out, "null"...)
+	} else {
+		out, err = o.BinaryAlias.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o CustomObject) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 24
+	if o.Data == nil {
+		size += 4
+	} else {
+		size += base64.StdEncoding.EncodedLen(len(o.Data)) + 2
+	}
+	if o.BinaryAlias == nil {
+		size += 4
+	} else {
+		n, err = o.BinaryAlias.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (o *CustomObject) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenData, seenBinaryAlias bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "data":
+			if seenData {
+				ok = false
+				return false
+			}
+			seenData = true
+			if !unmarshalJSONBinary(field, &o.Data) {
+				ok = false
+				return false
+			}
+		case "binaryAlias":
+			if seenBinaryAlias {
+				ok = false
+				return false
+			}
+			seenBinaryAlias = true
+			if field.Type != gjson.Null {
+				var v BinaryAlias
+				if field.Type != gjson.Null {
+					var s string
+					if !unmarshalJSONString(field, &s) || v.UnmarshalText([]byte(s)) != nil {
+						ok = false
+						return false
+					}
+				}
+				o.BinaryAlias = &v
+			}
+		default:
+			if matchesJSONField(key.Str, "data", "binaryAlias") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *CustomObject) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *CustomObject) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = CustomObject{}
+	var seenData, seenBinaryAlias bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "data":
+			if seenData {
+				err = fmt.Errorf("duplicate field \"data\"")
+				return false
+			}
+			seenData = true
+			o.Data, err = decodeJSONBinary(field)
+			if err != nil {
+				err = fmt.Errorf("field \"data\": %w", err)
+				return false
+			}
+		case "binaryAlias":
+			if seenBinaryAlias {
+				err = fmt.Errorf("duplicate field \"binaryAlias\"")
+				return false
+			}
+			seenBinaryAlias = true
+			if field.Type != gjson.Null {
+				var v BinaryAlias
+				err = v.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"binaryAlias\": %w", err)
+					return false
+				}
+				o.BinaryAlias = &v
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenData {
+		return fmt.Errorf("field \"data\" is required")
+	}
+	return nil
+}
+
 func (o CustomObject) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -27,3 +184,33 @@ func (o *CustomObject) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	}
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
+
+// Equal returns true if the CustomObject is equal to other according to the Conjure semantics of its values.
+func (o CustomObject) Equal(other CustomObject) bool {
+	if !bytes.Equal(o.Data, other.Data) {
+		return false
+	}
+	if (o.BinaryAlias == nil) != (other.BinaryAlias == nil) {
+		return false
+	}
+	if o.BinaryAlias != nil {
+		if !(*o.BinaryAlias).Equal(*other.BinaryAlias) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the CustomObject.
+func (o CustomObject) Clone() CustomObject {
+	out := o
+	if o.Data != nil {
+		out.Data = make([]byte, len(o.Data))
+		copy(out.Data, o.Data)
+	}
+	if o.BinaryAlias != nil {
+		v := (*o.BinaryAlias).Clone()
+		out.BinaryAlias = &v
+	}
+	return out
+}
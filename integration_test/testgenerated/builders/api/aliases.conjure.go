@@ -0,0 +1,98 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
+)
+
+type Color Kind
+
+func (a Color) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out, err = Kind(a).AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (a Color) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	n, err = Kind(a).JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (a Color) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a Color) String() string {
+	return Kind(a).String()
+}
+
+func (a Color) MarshalText() ([]byte, error) {
+	return Kind(a).MarshalText()
+}
+
+func (a *Color) UnmarshalText(data []byte) error {
+	var rawColor Kind
+	if err := rawColor.UnmarshalText(data); err != nil {
+		return err
+	}
+	*a = Color(rawColor)
+	return nil
+}
+
+func (a Color) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *Color) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+func (a *Color) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *Color) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v Kind
+	err = v.decodeJSONStrict(value)
+	if err != nil {
+		return err
+	}
+	*a = Color(v)
+	return nil
+}
+
+// Equal returns true if the Color is equal to other according to the Conjure semantics of its values.
+func (a Color) Equal(other Color) bool {
+	return Kind(a).Equal(Kind(other))
+}
+
+// Clone returns a deep copy of the Color.
+func (a Color) Clone() Color {
+	return a
+}
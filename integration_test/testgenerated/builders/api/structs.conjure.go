@@ -0,0 +1,1509 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/palantir/pkg/rid"
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
+)
+
+type Dimensions struct {
+	Width  int  `json:"width"`
+	Height int  `json:"height"`
+	Unit   Kind `json:"unit"`
+}
+
+// NewDimensions returns a Dimensions with the provided required fields and empty collections.
+func NewDimensions(widthArg int, heightArg int, unitArg Kind) Dimensions {
+	return Dimensions{Width: widthArg, Height: heightArg, Unit: unitArg}
+}
+
+// ValidateRequired returns an error if a required field of the Dimensions is missing.
+func (o *Dimensions) ValidateRequired() error {
+	var missing []string
+	if o.Unit == (Kind{}) {
+		missing = append(missing, "unit")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required fields: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func (o Dimensions) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"width\":"...)
+	out = strconv.AppendInt(out, int64(o.Width), 10)
+	out = append(out, ",\"height\":"...)
+	out = strconv.AppendInt(out, int64(o.Height), 10)
+	out = append(out, ",\"unit\":"...)
+	out, err = o.Unit.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Dimensions) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 28
+	size += jsonIntSize(int64(o.Width))
+	size += jsonIntSize(int64(o.Height))
+	n, err = o.Unit.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (o *Dimensions) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenWidth, seenHeight, seenUnit bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "width":
+			if seenWidth {
+				ok = false
+				return false
+			}
+			seenWidth = true
+			if !unmarshalJSONInt(field, &o.Width) {
+				ok = false
+				return false
+			}
+		case "height":
+			if seenHeight {
+				ok = false
+				return false
+			}
+			seenHeight = true
+			if !unmarshalJSONInt(field, &o.Height) {
+				ok = false
+				return false
+			}
+		case "unit":
+			if seenUnit {
+				ok = false
+				return false
+			}
+			seenUnit = true
+			if field.Type != gjson.Null {
+				var s string
+				if !unmarshalJSONString(field, &s) || o.Unit.UnmarshalText([]byte(s)) != nil {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "width", "height", "unit") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *Dimensions) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Dimensions) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Dimensions{}
+	var seenWidth, seenHeight, seenUnit bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "width":
+			if seenWidth {
+				err = fmt.Errorf("duplicate field \"width\"")
+				return false
+			}
+			seenWidth = true
+			o.Width, err = decodeJSONInt(field)
+			if err != nil {
+				err = fmt.Errorf("field \"width\": %w", err)
+				return false
+			}
+		case "height":
+			if seenHeight {
+				err = fmt.Errorf("duplicate field \"height\"")
+				return false
+			}
+			seenHeight = true
+			o.Height, err = decodeJSONInt(field)
+			if err != nil {
+				err = fmt.Errorf("field \"height\": %w", err)
+				return false
+			}
+		case "unit":
+			if seenUnit {
+				err = fmt.Errorf("duplicate field \"unit\"")
+				return false
+			}
+			seenUnit = true
+			err = o.Unit.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"unit\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenWidth {
+		return fmt.Errorf("field \"width\" is required")
+	}
+	if !seenHeight {
+		return fmt.Errorf("field \"height\" is required")
+	}
+	if !seenUnit {
+		return fmt.Errorf("field \"unit\" is required")
+	}
+	return nil
+}
+
+func (o Dimensions) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *Dimensions) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the Dimensions is equal to other according to the Conjure semantics of its values.
+func (o Dimensions) Equal(other Dimensions) bool {
+	if o.Width != other.Width {
+		return false
+	}
+	if o.Height != other.Height {
+		return false
+	}
+	if !o.Unit.Equal(other.Unit) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Dimensions.
+func (o Dimensions) Clone() Dimensions {
+	out := o
+	return out
+}
+
+type Empty struct{}
+
+// NewEmpty returns a Empty with the provided required fields and empty collections.
+func NewEmpty() Empty {
+	return Empty{}
+}
+
+// ValidateRequired returns an error if a required field of the Empty is missing.
+func (o *Empty) ValidateRequired() error {
+	return nil
+}
+
+func (o Empty) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{}"...)
+	return out, nil
+}
+
+func (o Empty) JSONSize() (int, error) {
+	size := 2
+	return size, nil
+}
+
+func (o *Empty) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	return value.IsObject()
+}
+
+func (o *Empty) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Empty) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Empty{}
+	var err error
+	value.ForEach(func(key, _ gjson.Result) bool {
+		err = fmt.Errorf("unknown field %q", key.Str)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o Empty) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *Empty) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the Empty is equal to other according to the Conjure semantics of its values.
+func (o Empty) Equal(other Empty) bool {
+	return true
+}
+
+// Clone returns a deep copy of the Empty.
+func (o Empty) Clone() Empty {
+	out := o
+	return out
+}
+
+type FieldNames struct {
+	KebabCase     string  `json:"kebab-case"`
+	SnakeCase     int     `json:"snake_case"`
+	Type          string  `json:"type"`
+	OptionalKebab *string `json:"optional-kebab"`
+}
+
+// NewFieldNames returns a FieldNames with the provided required fields and empty collections.
+func NewFieldNames(kebabCaseArg string, snakeCaseArg int, typeArg string) FieldNames {
+	return FieldNames{KebabCase: kebabCaseArg, SnakeCase: snakeCaseArg, Type: typeArg}
+}
+
+// WithOptionalKebab returns a copy of the FieldNames with the "optional-kebab" field set to the provided value.
+func (o FieldNames) WithOptionalKebab(optionalKebabArg string) FieldNames {
+	o.OptionalKebab = &optionalKebabArg
+	return o
+}
+
+// ValidateRequired returns an error if a required field of the FieldNames is missing.
+func (o *FieldNames) ValidateRequired() error {
+	return nil
+}
+
+func (o FieldNames) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"kebab-case\":"...)
+	out = appendJSONString(out, o.KebabCase)
+	out = append(out, ",\"snake_case\":"...)
+	out = strconv.AppendInt(out, int64(o.SnakeCase), 10)
+	out = append(out, ",\"type\":"...)
+	out = appendJSONString(out, o.Type)
+	out = append(out, ",\"optional-kebab\":"...)
+	if o.OptionalKebab == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, *o.OptionalKebab)
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o FieldNames) JSONSize() (int, error) {
+	size := 55
+	size += jsonStringSize(o.KebabCase)
+	size += jsonIntSize(int64(o.SnakeCase))
+	size += jsonStringSize(o.Type)
+	if o.OptionalKebab == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(*o.OptionalKebab)
+	}
+	return size, nil
+}
+
+func (o *FieldNames) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenKebabCase, seenSnakeCase, seenType, seenOptionalKebab bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "kebab-case":
+			if seenKebabCase {
+				ok = false
+				return false
+			}
+			seenKebabCase = true
+			if !unmarshalJSONString(field, &o.KebabCase) {
+				ok = false
+				return false
+			}
+		case "snake_case":
+			if seenSnakeCase {
+				ok = false
+				return false
+			}
+			seenSnakeCase = true
+			if !unmarshalJSONInt(field, &o.SnakeCase) {
+				ok = false
+				return false
+			}
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !unmarshalJSONString(field, &o.Type) {
+				ok = false
+				return false
+			}
+		case "optional-kebab":
+			if seenOptionalKebab {
+				ok = false
+				return false
+			}
+			seenOptionalKebab = true
+			if field.Type != gjson.Null {
+				var v string
+				if !unmarshalJSONString(field, &v) {
+					ok = false
+					return false
+				}
+				o.OptionalKebab = &v
+			}
+		default:
+			if matchesJSONField(key.Str, "kebab-case", "snake_case", "type", "optional-kebab") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *FieldNames) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *FieldNames) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = FieldNames{}
+	var seenKebabCase, seenSnakeCase, seenType, seenOptionalKebab bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "kebab-case":
+			if seenKebabCase {
+				err = fmt.Errorf("duplicate field \"kebab-case\"")
+				return false
+			}
+			seenKebabCase = true
+			o.KebabCase, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"kebab-case\": %w", err)
+				return false
+			}
+		case "snake_case":
+			if seenSnakeCase {
+				err = fmt.Errorf("duplicate field \"snake_case\"")
+				return false
+			}
+			seenSnakeCase = true
+			o.SnakeCase, err = decodeJSONInt(field)
+			if err != nil {
+				err = fmt.Errorf("field \"snake_case\": %w", err)
+				return false
+			}
+		case "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			o.Type, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"type\": %w", err)
+				return false
+			}
+		case "optional-kebab":
+			if seenOptionalKebab {
+				err = fmt.Errorf("duplicate field \"optional-kebab\"")
+				return false
+			}
+			seenOptionalKebab = true
+			if field.Type != gjson.Null {
+				var v string
+				v, err = decodeJSONString(field)
+				if err != nil {
+					err = fmt.Errorf("field \"optional-kebab\": %w", err)
+					return false
+				}
+				o.OptionalKebab = &v
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenKebabCase {
+		return fmt.Errorf("field \"kebab-case\" is required")
+	}
+	if !seenSnakeCase {
+		return fmt.Errorf("field \"snake_case\" is required")
+	}
+	if !seenType {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	return nil
+}
+
+func (o FieldNames) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *FieldNames) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the FieldNames is equal to other according to the Conjure semantics of its values.
+func (o FieldNames) Equal(other FieldNames) bool {
+	if o.KebabCase != other.KebabCase {
+		return false
+	}
+	if o.SnakeCase != other.SnakeCase {
+		return false
+	}
+	if o.Type != other.Type {
+		return false
+	}
+	if (o.OptionalKebab == nil) != (other.OptionalKebab == nil) {
+		return false
+	}
+	if o.OptionalKebab != nil {
+		if *o.OptionalKebab != *other.OptionalKebab {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the FieldNames.
+func (o FieldNames) Clone() FieldNames {
+	out := o
+	if o.OptionalKebab != nil {
+		v := *o.OptionalKebab
+		out.OptionalKebab = &v
+	}
+	return out
+}
+
+type Widget struct {
+	Name       string                 `json:"name"`
+	Kind       Kind                   `json:"kind"`
+	Shape      Shape                  `json:"shape"`
+	Color      Color                  `json:"color"`
+	Owner      rid.ResourceIdentifier `json:"owner"`
+	Payload    []byte                 `json:"payload"`
+	Metadata   interface{}            `json:"metadata"`
+	Dimensions Dimensions             `json:"dimensions"`
+	Size       *int                   `json:"size"`
+	Tags       []string               `json:"tags"`
+	Labels     []string               `json:"labels"`
+	Attributes map[string]string      `json:"attributes"`
+	// Deprecated: use name instead
+	LegacyName *string `json:"legacyName"`
+}
+
+// NewWidget returns a Widget with the provided required fields and empty collections.
+func NewWidget(nameArg string, kindArg Kind, shapeArg Shape, colorArg Color, ownerArg rid.ResourceIdentifier, payloadArg []byte, metadataArg interface{}, dimensionsArg Dimensions) Widget {
+	return Widget{Name: nameArg, Kind: kindArg, Shape: shapeArg, Color: colorArg, Owner: ownerArg, Payload: payloadArg, Metadata: metadataArg, Dimensions: dimensionsArg, Tags: make([]string, 0), Labels: make([]string, 0), Attributes: make(map[string]string, 0)}
+}
+
+// WithSize returns a copy of the Widget with the "size" field set to the provided value.
+func (o Widget) WithSize(sizeArg int) Widget {
+	o.Size = &sizeArg
+	return o
+}
+
+// WithTags returns a copy of the Widget with the "tags" field set to the provided value.
+func (o Widget) WithTags(tagsArg []string) Widget {
+	o.Tags = tagsArg
+	return o
+}
+
+// WithLabels returns a copy of the Widget with the "labels" field set to the provided value.
+func (o Widget) WithLabels(labelsArg []string) Widget {
+	o.Labels = labelsArg
+	return o
+}
+
+// WithAttributes returns a copy of the Widget with the "attributes" field set to the provided value.
+func (o Widget) WithAttributes(attributesArg map[string]string) Widget {
+	o.Attributes = attributesArg
+	return o
+}
+
+// WithLegacyName returns a copy of the Widget with the "legacyName" field set to the provided value.
+//
+// Deprecated: use name instead
+func (o Widget) WithLegacyName(legacyNameArg string) Widget {
+	o.LegacyName = &legacyNameArg
+	return o
+}
+
+// ValidateRequired returns an error if a required field of the Widget is missing.
+func (o *Widget) ValidateRequired() error {
+	var missing []string
+	if o.Kind == (Kind{}) {
+		missing = append(missing, "kind")
+	}
+	if o.Shape == (Shape{}) {
+		missing = append(missing, "shape")
+	}
+	if o.Color == (Color{}) {
+		missing = append(missing, "color")
+	}
+	if o.Owner == (rid.ResourceIdentifier{}) {
+		missing = append(missing, "owner")
+	}
+	if o.Payload == nil {
+		missing = append(missing, "payload")
+	}
+	if o.Metadata == nil {
+		missing = append(missing, "metadata")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required fields: %s", strings.Join(missing, ", "))
+	}
+	if validator, ok := interface{}(&o.Dimensions).(interface {
+		ValidateRequired() error
+	}); ok {
+		if err := validator.ValidateRequired(); err != nil {
+			return fmt.Errorf("field \"dimensions\": %w", err)
+		}
+	}
+	return nil
+}
+
+func (o Widget) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"name\":"...)
+	out = appendJSONString(out, o.Name)
+	out = append(out, ",\"kind\":"...)
+	out, err = o.Kind.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"shape\":"...)
+	out, err = o.Shape.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"color\":"...)
+	out, err = o.Color.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"owner\":"...)
+	out, err = appendJSONText(out, o.Owner)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"payload\":"...)
+	if o.Payload == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONBinary(out, o.Payload)
+	}
+	out = append(out, ",\"metadata\":"...)
+	out, err = appendJSONMarshal(out, o.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"dimensions\":"...)
+	out, err = o.Dimensions.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"size\":"...)
+	if o.Size == nil {
+		out = append(out, "null"...)
+	} else {
+		out = strconv.AppendInt(out, int64(*o.Size), 10)
+	}
+	out = append(out, ",\"tags\":"...)
+	out = append(out, '[')
+	for i, v := range o.Tags {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
+	}
+	out = append(out, ']')
+	out = append(out, ",\"labels\":"...)
+	out = append(out, '[')
+	for i, v := range o.Labels {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
+	}
+	out = append(out, ']')
+	out = append(out, ",\"attributes\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value string
+		}
+		entries := make([]mapEntry, 0, len(o.Attributes))
+		for k, v := range o.Attributes {
+			entries = append(entries, mapEntry{key: k, value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = appendJSONString(out, entry.value)
+		}
+	}
+	out = append(out, '}')
+	out = append(out, ",\"legacyName\":"...)
+	if o.LegacyName == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, *o.LegacyName)
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Widget) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 135
+	size += jsonStringSize(o.Name)
+	n, err = o.Kind.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.Shape.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.Color.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = jsonTextSize(o.Owner)
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	if o.Payload == nil {
+		size += 4
+	} else {
+		size += base64.StdEncoding.EncodedLen(len(o.Payload)) + 2
+	}
+	n, err = jsonMarshalSize(o.Metadata)
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.Dimensions.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	if o.Size == nil {
+		size += 4
+	} else {
+		size += jsonIntSize(int64(*o.Size))
+	}
+	size += 2
+	if len(o.Tags) > 1 {
+		size += len(o.Tags) - 1
+	}
+	for _, v := range o.Tags {
+		size += jsonStringSize(v)
+	}
+	size += 2
+	if len(o.Labels) > 1 {
+		size += len(o.Labels) - 1
+	}
+	for _, v := range o.Labels {
+		size += jsonStringSize(v)
+	}
+	size += 2
+	if len(o.Attributes) > 1 {
+		size += len(o.Attributes) - 1
+	}
+	for k, v := range o.Attributes {
+		size += jsonStringSize(k) + 1
+		size += jsonStringSize(v)
+	}
+	if o.LegacyName == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(*o.LegacyName)
+	}
+	return size, nil
+}
+
+func (o Widget) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
+}
+
+func (o *Widget) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Widget
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
+	type WidgetAlias Widget
+	var rawWidget WidgetAlias
+	if err := safejson.Unmarshal(data, &rawWidget); err != nil {
+		return err
+	}
+	if rawWidget.Tags == nil {
+		rawWidget.Tags = make([]string, 0)
+	}
+	if rawWidget.Labels == nil {
+		rawWidget.Labels = make([]string, 0)
+	}
+	if rawWidget.Attributes == nil {
+		rawWidget.Attributes = make(map[string]string, 0)
+	}
+	*o = Widget(rawWidget)
+	return nil
+}
+
+func (o *Widget) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Tags == nil {
+			o.Tags = make([]string, 0)
+		}
+		if o.Labels == nil {
+			o.Labels = make([]string, 0)
+		}
+		if o.Attributes == nil {
+			o.Attributes = make(map[string]string, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenName, seenKind, seenShape, seenColor, seenOwner, seenPayload, seenMetadata, seenDimensions, seenSize, seenTags, seenLabels, seenAttributes, seenLegacyName bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "name":
+			if seenName {
+				ok = false
+				return false
+			}
+			seenName = true
+			if !unmarshalJSONString(field, &o.Name) {
+				ok = false
+				return false
+			}
+		case "kind":
+			if seenKind {
+				ok = false
+				return false
+			}
+			seenKind = true
+			if field.Type != gjson.Null {
+				var s string
+				if !unmarshalJSONString(field, &s) || o.Kind.UnmarshalText([]byte(s)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "shape":
+			if seenShape {
+				ok = false
+				return false
+			}
+			seenShape = true
+			if !o.Shape.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "color":
+			if seenColor {
+				ok = false
+				return false
+			}
+			seenColor = true
+			if field.Type != gjson.Null {
+				var s1 string
+				if !unmarshalJSONString(field, &s1) || o.Color.UnmarshalText([]byte(s1)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "owner":
+			if seenOwner {
+				ok = false
+				return false
+			}
+			seenOwner = true
+			if field.Type != gjson.Null {
+				var s2 string
+				if !unmarshalJSONString(field, &s2) || o.Owner.UnmarshalText([]byte(s2)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "payload":
+			if seenPayload {
+				ok = false
+				return false
+			}
+			seenPayload = true
+			if !unmarshalJSONBinary(field, &o.Payload) {
+				ok = false
+				return false
+			}
+		case "metadata":
+			if seenMetadata {
+				ok = false
+				return false
+			}
+			seenMetadata = true
+			if !unmarshalJSONAny(field, &o.Metadata) {
+				ok = false
+				return false
+			}
+		case "dimensions":
+			if seenDimensions {
+				ok = false
+				return false
+			}
+			seenDimensions = true
+			if !o.Dimensions.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "size":
+			if seenSize {
+				ok = false
+				return false
+			}
+			seenSize = true
+			if field.Type != gjson.Null {
+				var v int
+				if !unmarshalJSONInt(field, &v) {
+					ok = false
+					return false
+				}
+				o.Size = &v
+			}
+		case "tags":
+			if seenTags {
+				ok = false
+				return false
+			}
+			seenTags = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Tags = make([]string, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v1 string
+					if !unmarshalJSONString(elem, &v1) {
+						ok1 = false
+						return false
+					}
+					o.Tags = append(o.Tags, v1)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "labels":
+			if seenLabels {
+				ok = false
+				return false
+			}
+			seenLabels = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Labels = make([]string, 0)
+				ok2 := true
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v2 string
+					if !unmarshalJSONString(elem1, &v2) {
+						ok2 = false
+						return false
+					}
+					o.Labels = append(o.Labels, v2)
+					return true
+				})
+				if !ok2 {
+					ok = false
+					return false
+				}
+			}
+		case "attributes":
+			if seenAttributes {
+				ok = false
+				return false
+			}
+			seenAttributes = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.Attributes = make(map[string]string)
+				ok3 := true
+				field.ForEach(func(key1, elem2 gjson.Result) bool {
+					var k string
+					if !unmarshalJSONString(key1, &k) {
+						ok3 = false
+						return false
+					}
+					var v3 string
+					if !unmarshalJSONString(elem2, &v3) {
+						ok3 = false
+						return false
+					}
+					o.Attributes[k] = v3
+					return true
+				})
+				if !ok3 {
+					ok = false
+					return false
+				}
+			}
+		case "legacyName":
+			if seenLegacyName {
+				ok = false
+				return false
+			}
+			seenLegacyName = true
+			if field.Type != gjson.Null {
+				var v4 string
+				if !unmarshalJSONString(field, &v4) {
+					ok = false
+					return false
+				}
+				o.LegacyName = &v4
+			}
+		default:
+			if matchesJSONField(key.Str, "name", "kind", "shape", "color", "owner", "payload", "metadata", "dimensions", "size", "tags", "labels", "attributes", "legacyName") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Tags == nil {
+		o.Tags = make([]string, 0)
+	}
+	if o.Labels == nil {
+		o.Labels = make([]string, 0)
+	}
+	if o.Attributes == nil {
+		o.Attributes = make(map[string]string, 0)
+	}
+	return true
+}
+
+func (o *Widget) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Widget) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Widget{}
+	var seenName, seenKind, seenShape, seenColor, seenOwner, seenPayload, seenMetadata, seenDimensions, seenSize, seenTags, seenLabels, seenAttributes, seenLegacyName bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "name":
+			if seenName {
+				err = fmt.Errorf("duplicate field \"name\"")
+				return false
+			}
+			seenName = true
+			o.Name, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"name\": %w", err)
+				return false
+			}
+		case "kind":
+			if seenKind {
+				err = fmt.Errorf("duplicate field \"kind\"")
+				return false
+			}
+			seenKind = true
+			err = o.Kind.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"kind\": %w", err)
+				return false
+			}
+		case "shape":
+			if seenShape {
+				err = fmt.Errorf("duplicate field \"shape\"")
+				return false
+			}
+			seenShape = true
+			err = o.Shape.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"shape\": %w", err)
+				return false
+			}
+		case "color":
+			if seenColor {
+				err = fmt.Errorf("duplicate field \"color\"")
+				return false
+			}
+			seenColor = true
+			err = o.Color.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"color\": %w", err)
+				return false
+			}
+		case "owner":
+			if seenOwner {
+				err = fmt.Errorf("duplicate field \"owner\"")
+				return false
+			}
+			seenOwner = true
+			o.Owner, err = decodeJSONRID(field)
+			if err != nil {
+				err = fmt.Errorf("field \"owner\": %w", err)
+				return false
+			}
+		case "payload":
+			if seenPayload {
+				err = fmt.Errorf("duplicate field \"payload\"")
+				return false
+			}
+			seenPayload = true
+			o.Payload, err = decodeJSONBinary(field)
+			if err != nil {
+				err = fmt.Errorf("field \"payload\": %w", err)
+				return false
+			}
+		case "metadata":
+			if seenMetadata {
+				err = fmt.Errorf("duplicate field \"metadata\"")
+				return false
+			}
+			seenMetadata = true
+			o.Metadata, err = decodeJSONAny(field)
+			if err != nil {
+				err = fmt.Errorf("field \"metadata\": %w", err)
+				return false
+			}
+		case "dimensions":
+			if seenDimensions {
+				err = fmt.Errorf("duplicate field \"dimensions\"")
+				return false
+			}
+			seenDimensions = true
+			err = o.Dimensions.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"dimensions\": %w", err)
+				return false
+			}
+		case "size":
+			if seenSize {
+				err = fmt.Errorf("duplicate field \"size\"")
+				return false
+			}
+			seenSize = true
+			if field.Type != gjson.Null {
+				var v int
+				v, err = decodeJSONInt(field)
+				if err != nil {
+					err = fmt.Errorf("field \"size\": %w", err)
+					return false
+				}
+				o.Size = &v
+			}
+		case "tags":
+			if seenTags {
+				err = fmt.Errorf("duplicate field \"tags\"")
+				return false
+			}
+			seenTags = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"tags\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Tags = make([]string, 0)
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v1 string
+					v1, err = decodeJSONString(elem)
+					if err != nil {
+						return false
+					}
+					o.Tags = append(o.Tags, v1)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"tags\": %w", err)
+					return false
+				}
+			}
+		case "labels":
+			if seenLabels {
+				err = fmt.Errorf("duplicate field \"labels\"")
+				return false
+			}
+			seenLabels = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"labels\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Labels = make([]string, 0)
+				seen := make(map[string]struct{})
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v2 string
+					v2, err = decodeJSONString(elem1)
+					if err != nil {
+						return false
+					}
+					if _, ok := seen[v2]; ok {
+						err = fmt.Errorf("duplicate set element %s", elem1.Raw)
+						return false
+					}
+					seen[v2] = struct{}{}
+					o.Labels = append(o.Labels, v2)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"labels\": %w", err)
+					return false
+				}
+			}
+		case "attributes":
+			if seenAttributes {
+				err = fmt.Errorf("duplicate field \"attributes\"")
+				return false
+			}
+			seenAttributes = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"attributes\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.Attributes = make(map[string]string)
+				field.ForEach(func(key1, elem2 gjson.Result) bool {
+					var k string
+					k = key1.Str
+					if _, ok := o.Attributes[k]; ok {
+						err = fmt.Errorf("duplicate map key %s", key1.Raw)
+						return false
+					}
+					var v3 string
+					v3, err = decodeJSONString(elem2)
+					if err != nil {
+						return false
+					}
+					o.Attributes[k] = v3
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"attributes\": %w", err)
+					return false
+				}
+			}
+		case "legacyName":
+			if seenLegacyName {
+				err = fmt.Errorf("duplicate field \"legacyName\"")
+				return false
+			}
+			seenLegacyName = true
+			if field.Type != gjson.Null {
+				var v4 string
+				v4, err = decodeJSONString(field)
+				if err != nil {
+					err = fmt.Errorf("field \"legacyName\": %w", err)
+					return false
+				}
+				o.LegacyName = &v4
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenName {
+		return fmt.Errorf("field \"name\" is required")
+	}
+	if !seenKind {
+		return fmt.Errorf("field \"kind\" is required")
+	}
+	if !seenShape {
+		return fmt.Errorf("field \"shape\" is required")
+	}
+	if !seenColor {
+		return fmt.Errorf("field \"color\" is required")
+	}
+	if !seenOwner {
+		return fmt.Errorf("field \"owner\" is required")
+	}
+	if !seenPayload {
+		return fmt.Errorf("field \"payload\" is required")
+	}
+	if !seenMetadata {
+		return fmt.Errorf("field \"metadata\" is required")
+	}
+	if !seenDimensions {
+		return fmt.Errorf("field \"dimensions\" is required")
+	}
+	if o.Tags == nil {
+		o.Tags = make([]string, 0)
+	}
+	if o.Labels == nil {
+		o.Labels = make([]string, 0)
+	}
+	if o.Attributes == nil {
+		o.Attributes = make(map[string]string, 0)
+	}
+	return nil
+}
+
+func (o Widget) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *Widget) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the Widget is equal to other according to the Conjure semantics of its values.
+func (o Widget) Equal(other Widget) bool {
+	if o.Name != other.Name {
+		return false
+	}
+	if !o.Kind.Equal(other.Kind) {
+		return false
+	}
+	if !o.Shape.Equal(other.Shape) {
+		return false
+	}
+	if !o.Color.Equal(other.Color) {
+		return false
+	}
+	if o.Owner != other.Owner {
+		return false
+	}
+	if !bytes.Equal(o.Payload, other.Payload) {
+		return false
+	}
+	if !reflect.DeepEqual(o.Metadata, other.Metadata) {
+		return false
+	}
+	if !o.Dimensions.Equal(other.Dimensions) {
+		return false
+	}
+	if (o.Size == nil) != (other.Size == nil) {
+		return false
+	}
+	if o.Size != nil {
+		if *o.Size != *other.Size {
+			return false
+		}
+	}
+	if len(o.Tags) != len(other.Tags) {
+		return false
+	}
+	for i := range o.Tags {
+		if o.Tags[i] != other.Tags[i] {
+			return false
+		}
+	}
+	if !func() bool {
+		if len(o.Labels) != len(other.Labels) {
+			return false
+		}
+		counts := make(map[string]int, len(o.Labels))
+		for _, v := range o.Labels {
+			counts[v]++
+		}
+		for _, v := range other.Labels {
+			if counts[v] == 0 {
+				return false
+			}
+			counts[v]--
+		}
+		return true
+	}() {
+		return false
+	}
+	if len(o.Attributes) != len(other.Attributes) {
+		return false
+	}
+	for k, v := range o.Attributes {
+		otherV, ok := other.Attributes[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	if (o.LegacyName == nil) != (other.LegacyName == nil) {
+		return false
+	}
+	if o.LegacyName != nil {
+		if *o.LegacyName != *other.LegacyName {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Widget.
+func (o Widget) Clone() Widget {
+	out := o
+	if o.Payload != nil {
+		out.Payload = make([]byte, len(o.Payload))
+		copy(out.Payload, o.Payload)
+	}
+	if o.Size != nil {
+		v := *o.Size
+		out.Size = &v
+	}
+	if o.Tags != nil {
+		out.Tags = make([]string, len(o.Tags))
+		copy(out.Tags, o.Tags)
+	}
+	if o.Labels != nil {
+		out.Labels = make([]string, len(o.Labels))
+		copy(out.Labels, o.Labels)
+	}
+	if o.Attributes != nil {
+		out.Attributes = make(map[string]string, len(o.Attributes))
+		for k, v := range o.Attributes {
+			out.Attributes[k] = v
+		}
+	}
+	if o.LegacyName != nil {
+		v := *o.LegacyName
+		out.LegacyName = &v
+	}
+	return out
+}
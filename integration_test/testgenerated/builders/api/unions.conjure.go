@@ -0,0 +1,387 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
+)
+
+type Shape struct {
+	typ    string
+	circle *int
+	square *int
+}
+
+type shapeDeserializer struct {
+	Type   string `json:"type"`
+	Circle *int   `json:"circle"`
+	Square *int   `json:"square"`
+}
+
+func (u *shapeDeserializer) toStruct() Shape {
+	return Shape{typ: u.Type, circle: u.Circle, square: u.Square}
+}
+
+func (u Shape) AppendJSON(out []byte) ([]byte, error) {
+	switch u.typ {
+	default:
+		return nil, fmt.Errorf("unknown type %q", u.typ)
+	case "circle":
+		if u.circle == nil {
+			return nil, fmt.Errorf("field \"circle\" is required")
+		}
+		out = append(out, "{\"type\":\"circle\",\"circle\":"...)
+		out = strconv.AppendInt(out, int64(*u.circle), 10)
+	case "square":
+		if u.square == nil {
+			return nil, fmt.Errorf("field \"square\" is required")
+		}
+		out = append(out, "{\"type\":\"square\",\"square\":"...)
+		out = strconv.AppendInt(out, int64(*u.square), 10)
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (u Shape) JSONSize() (int, error) {
+	var size int
+	switch u.typ {
+	default:
+		return 0, fmt.Errorf("unknown type %q", u.typ)
+	case "circle":
+		if u.circle == nil {
+			return 0, fmt.Errorf("field \"circle\" is required")
+		}
+		size = 27
+		size += jsonIntSize(int64(*u.circle))
+	case "square":
+		if u.square == nil {
+			return 0, fmt.Errorf("field \"square\" is required")
+		}
+		size = 27
+		size += jsonIntSize(int64(*u.square))
+	}
+	return size, nil
+}
+
+func (u Shape) MarshalJSON() ([]byte, error) {
+	return u.AppendJSON(nil)
+}
+
+func (u *Shape) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Shape
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*u = v
+			return nil
+		}
+	}
+	var deser shapeDeserializer
+	if err := safejson.Unmarshal(data, &deser); err != nil {
+		return err
+	}
+	*u = deser.toStruct()
+	switch u.typ {
+	case "circle":
+		if u.circle == nil {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+	case "square":
+		if u.square == nil {
+			return fmt.Errorf("field \"square\" is required")
+		}
+	}
+	return nil
+}
+
+func (u *Shape) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenType, seenCircle, seenSquare bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !unmarshalJSONString(field, &u.typ) {
+				ok = false
+				return false
+			}
+		case "circle":
+			if seenCircle {
+				ok = false
+				return false
+			}
+			seenCircle = true
+			if field.Type != gjson.Null {
+				var v int
+				if !unmarshalJSONInt(field, &v) {
+					ok = false
+					return false
+				}
+				u.circle = &v
+			}
+		case "square":
+			if seenSquare {
+				ok = false
+				return false
+			}
+			seenSquare = true
+			if field.Type != gjson.Null {
+				var v1 int
+				if !unmarshalJSONInt(field, &v1) {
+					ok = false
+					return false
+				}
+				u.square = &v1
+			}
+		default:
+			if matchesJSONField(key.Str, "type", "circle", "square") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	switch u.typ {
+	case "circle":
+		if u.circle == nil {
+			return false
+		}
+	case "square":
+		if u.square == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *Shape) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return u.decodeJSONStrict(value)
+}
+
+func (u *Shape) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	var typ string
+	var variantName string
+	var variant gjson.Result
+	var seenType, seenVariant bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch {
+		case key.Str == "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			typ, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"type\": %w", err)
+				return false
+			}
+			return true
+		case seenVariant && key.Str == variantName:
+			err = fmt.Errorf("duplicate field %q", key.Str)
+			return false
+		case seenVariant:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		variantName, variant, seenVariant = key.Str, field, true
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenType {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	if seenVariant && variantName != typ {
+		return fmt.Errorf("unknown field %q", variantName)
+	}
+	*u = Shape{typ: typ}
+	switch typ {
+	case "circle":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+		var v int
+		v, err = decodeJSONInt(variant)
+		if err != nil {
+			return fmt.Errorf("field \"circle\": %w", err)
+		}
+		u.circle = &v
+	case "square":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"square\" is required")
+		}
+		var v1 int
+		v1, err = decodeJSONInt(variant)
+		if err != nil {
+			return fmt.Errorf("field \"square\": %w", err)
+		}
+		u.square = &v1
+	}
+	return nil
+}
+
+func (u Shape) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (u *Shape) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&u)
+}
+
+func (u *Shape) AcceptFuncs(circleFunc func(int) error, squareFunc func(int) error, unknownFunc func(string) error) error {
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return fmt.Errorf("invalid value in union type")
+		}
+		return unknownFunc(u.typ)
+	case "circle":
+		if u.circle == nil {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+		return circleFunc(*u.circle)
+	case "square":
+		if u.square == nil {
+			return fmt.Errorf("field \"square\" is required")
+		}
+		return squareFunc(*u.square)
+	}
+}
+
+func (u *Shape) CircleNoopSuccess(int) error {
+	return nil
+}
+
+func (u *Shape) SquareNoopSuccess(int) error {
+	return nil
+}
+
+func (u *Shape) ErrorOnUnknown(typeName string) error {
+	return fmt.Errorf("invalid value in union type. Type name: %s", typeName)
+}
+
+func (u *Shape) Accept(v ShapeVisitor) error {
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return fmt.Errorf("invalid value in union type")
+		}
+		return v.VisitUnknown(u.typ)
+	case "circle":
+		if u.circle == nil {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+		return v.VisitCircle(*u.circle)
+	case "square":
+		if u.square == nil {
+			return fmt.Errorf("field \"square\" is required")
+		}
+		return v.VisitSquare(*u.square)
+	}
+}
+
+type ShapeVisitor interface {
+	VisitCircle(v int) error
+	VisitSquare(v int) error
+	VisitUnknown(typeName string) error
+}
+
+func (u *Shape) AcceptWithContext(ctx context.Context, v ShapeVisitorWithContext) error {
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return fmt.Errorf("invalid value in union type")
+		}
+		return v.VisitUnknownWithContext(ctx, u.typ)
+	case "circle":
+		if u.circle == nil {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+		return v.VisitCircleWithContext(ctx, *u.circle)
+	case "square":
+		if u.square == nil {
+			return fmt.Errorf("field \"square\" is required")
+		}
+		return v.VisitSquareWithContext(ctx, *u.square)
+	}
+}
+
+type ShapeVisitorWithContext interface {
+	VisitCircleWithContext(ctx context.Context, v int) error
+	VisitSquareWithContext(ctx context.Context, v int) error
+	VisitUnknownWithContext(ctx context.Context, typeName string) error
+}
+
+func NewShapeFromCircle(v int) Shape {
+	return Shape{typ: "circle", circle: &v}
+}
+
+func NewShapeFromSquare(v int) Shape {
+	return Shape{typ: "square", square: &v}
+}
+
+// Equal returns true if the Shape is equal to other according to the Conjure semantics of its values.
+func (u Shape) Equal(other Shape) bool {
+	if u.typ != other.typ {
+		return false
+	}
+	if (u.circle == nil) != (other.circle == nil) {
+		return false
+	}
+	if u.circle != nil {
+		if *u.circle != *other.circle {
+			return false
+		}
+	}
+	if (u.square == nil) != (other.square == nil) {
+		return false
+	}
+	if u.square != nil {
+		if *u.square != *other.square {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Shape.
+func (u Shape) Clone() Shape {
+	return u
+}
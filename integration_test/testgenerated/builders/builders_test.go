@@ -0,0 +1,101 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builders_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/builders/api"
+	"github.com/palantir/pkg/rid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewObject(t *testing.T) {
+	owner := rid.MustNew("service", "instance", "type", "locator")
+	widget := api.NewWidget(
+		"foo",
+		api.New_Kind(api.Kind_SMALL),
+		api.NewShapeFromCircle(1),
+		api.Color(api.New_Kind(api.Kind_LARGE)),
+		owner,
+		[]byte("payload"),
+		"metadata",
+		api.NewDimensions(1, 2, api.New_Kind(api.Kind_SMALL)),
+	)
+	require.NoError(t, widget.ValidateRequired())
+	assert.NotNil(t, widget.Tags)
+	assert.NotNil(t, widget.Labels)
+	assert.NotNil(t, widget.Attributes)
+
+	out, err := json.Marshal(widget)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"name": "foo",
+		"kind": "SMALL",
+		"shape": {"type": "circle", "circle": 1},
+		"color": "LARGE",
+		"owner": "ri.service.instance.type.locator",
+		"payload": "cGF5bG9hZA==",
+		"metadata": "metadata",
+		"dimensions": {"width": 1, "height": 2, "unit": "SMALL"},
+		"size": null,
+		"tags": [],
+		"labels": [],
+		"attributes": {},
+		"legacyName": null
+	}`, string(out))
+
+	updated := widget.WithSize(3).WithTags([]string{"a"}).WithLegacyName("bar")
+	assert.Equal(t, 3, *updated.Size)
+	assert.Equal(t, []string{"a"}, updated.Tags)
+	assert.Equal(t, "bar", *updated.LegacyName)
+	// setters return a copy
+	assert.Nil(t, widget.Size)
+	assert.Empty(t, widget.Tags)
+
+	assert.Equal(t, api.Empty{}, api.NewEmpty())
+}
+
+func TestFieldNames(t *testing.T) {
+	fieldNames := api.NewFieldNames("kebab", 1, "type").WithOptionalKebab("optional")
+	out, err := json.Marshal(fieldNames)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"kebab-case": "kebab", "snake_case": 1, "type": "type", "optional-kebab": "optional"}`, string(out))
+}
+
+func TestValidateRequired(t *testing.T) {
+	var widget api.Widget
+	assert.EqualError(t, widget.ValidateRequired(), "missing required fields: kind, shape, color, owner, payload, metadata")
+
+	widget = api.NewWidget(
+		"",
+		api.New_Kind(api.Kind_SMALL),
+		api.NewShapeFromSquare(0),
+		api.Color(api.New_Kind(api.Kind_SMALL)),
+		rid.MustNew("service", "instance", "type", "locator"),
+		[]byte{},
+		0,
+		api.Dimensions{},
+	)
+	assert.EqualError(t, widget.ValidateRequired(), `field "dimensions": missing required fields: unit`)
+
+	widget.Dimensions = api.NewDimensions(0, 0, api.New_Kind(api.Kind_LARGE))
+	assert.NoError(t, widget.ValidateRequired())
+
+	empty := api.NewEmpty()
+	assert.NoError(t, empty.ValidateRequired())
+}
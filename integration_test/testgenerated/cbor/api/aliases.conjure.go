@@ -0,0 +1,363 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
+)
+
+type Children []Child
+
+func (a Children) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, '[')
+	for i, v := range []Child(a) {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	return out, nil
+}
+
+func (a Children) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	size += 2
+	if len([]Child(a)) > 1 {
+		size += len([]Child(a)) - 1
+	}
+	for _, v := range []Child(a) {
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (a Children) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *Children) UnmarshalJSON(data []byte) error {
+	var rawChildren []Child
+	if err := safejson.Unmarshal(data, &rawChildren); err != nil {
+		return err
+	}
+	*a = Children(rawChildren)
+	return nil
+}
+
+func (a Children) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *Children) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+func (a *Children) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *Children) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []Child
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]Child, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 Child
+		err = v1.decodeJSONStrict(elem)
+		if err != nil {
+			return false
+		}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = Children(v)
+	return nil
+}
+
+// Equal returns true if the Children is equal to other according to the Conjure semantics of its values.
+func (a Children) Equal(other Children) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(other[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Children.
+func (a Children) Clone() Children {
+	var out Children
+	if a != nil {
+		out = make(Children, len(a))
+		for i := range a {
+			out[i] = a[i].Clone()
+		}
+	}
+	return out
+}
+
+func (a Children) AppendCBOR(out []byte) ([]byte, error) {
+	var err error
+	out = appendCBORHead(out, 4, uint64(len([]Child(a))))
+	for _, v := range []Child(a) {
+		out, err = v.AppendCBOR(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func (a *Children) decodeCBOR(r *cborReader) error {
+	var value []Child
+	value = make([]Child, 0)
+	if err := r.decodeArray(func() error {
+		var v Child
+		if err := v.decodeCBOR(r); err != nil {
+			return err
+		}
+		value = append(value, v)
+		return nil
+	}); err != nil {
+		return err
+	}
+	*a = Children(value)
+	return nil
+}
+
+func (a Children) MarshalCBOR() ([]byte, error) {
+	return a.AppendCBOR(nil)
+}
+
+func (a *Children) UnmarshalCBOR(data []byte) error {
+	r := cborReader{data: data}
+	if err := a.decodeCBOR(&r); err != nil {
+		return err
+	}
+	return r.end()
+}
+
+type Name string
+
+func (a *Name) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *Name) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v string
+	v, err = decodeJSONString(value)
+	if err != nil {
+		return err
+	}
+	*a = Name(v)
+	return nil
+}
+
+// Equal returns true if the Name is equal to other according to the Conjure semantics of its values.
+func (a Name) Equal(other Name) bool {
+	return string(a) == string(other)
+}
+
+// Clone returns a deep copy of the Name.
+func (a Name) Clone() Name {
+	return a
+}
+
+func (a Name) AppendCBOR(out []byte) ([]byte, error) {
+	out = appendCBORString(out, string(a))
+	return out, nil
+}
+
+func (a *Name) decodeCBOR(r *cborReader) error {
+	var value string
+	if err := r.decodeString(&value); err != nil {
+		return err
+	}
+	*a = Name(value)
+	return nil
+}
+
+func (a Name) MarshalCBOR() ([]byte, error) {
+	return a.AppendCBOR(nil)
+}
+
+func (a *Name) UnmarshalCBOR(data []byte) error {
+	r := cborReader{data: data}
+	if err := a.decodeCBOR(&r); err != nil {
+		return err
+	}
+	return r.end()
+}
+
+type OptionalName struct {
+	Value *string
+}
+
+func (a OptionalName) MarshalText() ([]byte, error) {
+	if a.Value == nil {
+		return nil, nil
+	}
+	return []byte(*a.Value), nil
+}
+
+func (a OptionalName) AppendJSON(out []byte) ([]byte, error) {
+	if a.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, *a.Value)
+	}
+	return out, nil
+}
+
+func (a OptionalName) JSONSize() (int, error) {
+	var size int
+	if a.Value == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(*a.Value)
+	}
+	return size, nil
+}
+
+func (a OptionalName) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *OptionalName) UnmarshalText(data []byte) error {
+	rawOptionalName := string(data)
+	a.Value = &rawOptionalName
+	return nil
+}
+
+func (a *OptionalName) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *OptionalName) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *string
+	if value.Type != gjson.Null {
+		var v1 string
+		v1, err = decodeJSONString(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = OptionalName{Value: v}
+	return nil
+}
+
+func (a OptionalName) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *OptionalName) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+// Equal returns true if the OptionalName is equal to other according to the Conjure semantics of its values.
+func (a OptionalName) Equal(other OptionalName) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if *a.Value != *other.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalName.
+func (a OptionalName) Clone() OptionalName {
+	out := a
+	if a.Value != nil {
+		v := *a.Value
+		out.Value = &v
+	}
+	return out
+}
+
+func (a OptionalName) AppendCBOR(out []byte) ([]byte, error) {
+	if a.Value == nil {
+		out = append(out, "\xf6"...)
+	} else {
+		out = appendCBORString(out, *a.Value)
+	}
+	return out, nil
+}
+
+func (a *OptionalName) decodeCBOR(r *cborReader) error {
+	*a = OptionalName{}
+	if !r.decodeNull() {
+		var opt string
+		if err := r.decodeString(&opt); err != nil {
+			return err
+		}
+		a.Value = &opt
+	}
+	return nil
+}
+
+func (a OptionalName) MarshalCBOR() ([]byte, error) {
+	return a.AppendCBOR(nil)
+}
+
+func (a *OptionalName) UnmarshalCBOR(data []byte) error {
+	r := cborReader{data: data}
+	if err := a.decodeCBOR(&r); err != nil {
+		return err
+	}
+	return r.end()
+}
@@ -0,0 +1,40 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package apitest
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/cbor/api"
+	"github.com/palantir/witchcraft-go-server/v2/wrouter"
+	"github.com/palantir/witchcraft-go-server/v2/wrouter/whttprouter"
+)
+
+// TestPairOptions configures the server and client of a test pair. The zero value registers the routes
+// without route params and creates the client with only the base URL of the server.
+type TestPairOptions struct {
+	// RouteParams are passed to the route registration function of the service.
+	RouteParams []wrouter.RouteParam
+	// ClientParams are applied after the base URL of the server.
+	ClientParams []httpclient.ClientParam
+}
+
+// NewCBORServiceTestPair starts an httptest.Server serving impl using the routes registered by RegisterRoutesCBORService
+// and returns a CBORServiceClient that sends requests to it, configured by opts. The server is closed when
+// the test completes.
+func NewCBORServiceTestPair(t testing.TB, impl api.CBORService, opts TestPairOptions) api.CBORServiceClient {
+	t.Helper()
+	router := wrouter.New(whttprouter.New())
+	if err := api.RegisterRoutesCBORService(router, impl, opts.RouteParams...); err != nil {
+		t.Fatalf("failed to register CBORService routes: %v", err)
+	}
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	client, err := httpclient.NewClient(append([]httpclient.ClientParam{httpclient.WithBaseURLs([]string{server.URL})}, opts.ClientParams...)...)
+	if err != nil {
+		t.Fatalf("failed to create CBORService client: %v", err)
+	}
+	return api.NewCBORServiceClient(client)
+}
@@ -0,0 +1,655 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/palantir/pkg/bearertoken"
+	"github.com/palantir/pkg/datetime"
+	"github.com/palantir/pkg/rid"
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safelong"
+	"github.com/palantir/pkg/uuid"
+)
+
+// appendCBORHead appends the head of a data item with the provided major type and argument to out.
+func appendCBORHead(out []byte, major byte, n uint64) []byte {
+	switch {
+	case n < 24:
+		return append(out, major<<5|byte(n))
+	case n <= 0xff:
+		return append(out, major<<5|24, byte(n))
+	case n <= 0xffff:
+		return append(out, major<<5|25, byte(n>>8), byte(n))
+	case n <= 0xffffffff:
+		return append(out, major<<5|26, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	}
+	return append(out, major<<5|27, byte(n>>56), byte(n>>48), byte(n>>40), byte(n>>32), byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+}
+
+// appendCBORString appends s encoded as a text string to out.
+func appendCBORString(out []byte, s string) []byte {
+	out = appendCBORHead(out, 3, uint64(len(s)))
+	return append(out, s...)
+}
+
+// appendCBORBytes appends b encoded as a byte string to out.
+func appendCBORBytes(out []byte, b []byte) []byte {
+	out = appendCBORHead(out, 2, uint64(len(b)))
+	return append(out, b...)
+}
+
+// appendCBORInt appends v encoded as an integer to out.
+func appendCBORInt(out []byte, v int64) []byte {
+	if v < 0 {
+		return appendCBORHead(out, 1, uint64(-1-v))
+	}
+	return appendCBORHead(out, 0, uint64(v))
+}
+
+// appendCBORFloat64 appends v encoded as a double-precision float to out.
+func appendCBORFloat64(out []byte, v float64) []byte {
+	bits := math.Float64bits(v)
+	return append(out, 0xfb, byte(bits>>56), byte(bits>>48), byte(bits>>40), byte(bits>>32), byte(bits>>24), byte(bits>>16), byte(bits>>8), byte(bits))
+}
+
+// appendCBORBool appends v encoded as a simple value to out.
+func appendCBORBool(out []byte, v bool) []byte {
+	if v {
+		return append(out, 0xf5)
+	}
+	return append(out, 0xf4)
+}
+
+// appendCBORAny appends v to out. v is usually the value of an any field, which is encoded like it is
+// decoded from JSON; values of other types are converted to such values using their JSON encoding.
+func appendCBORAny(out []byte, v interface{}) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return append(out, 0xf6), nil
+	case bool:
+		return appendCBORBool(out, v), nil
+	case string:
+		return appendCBORString(out, v), nil
+	case []byte:
+		return appendCBORBytes(out, v), nil
+	case int:
+		return appendCBORInt(out, int64(v)), nil
+	case int64:
+		return appendCBORInt(out, v), nil
+	case float64:
+		return appendCBORFloat64(out, v), nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return appendCBORInt(out, i), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return appendCBORFloat64(out, f), nil
+	case []interface{}:
+		out = appendCBORHead(out, 4, uint64(len(v)))
+		var err error
+		for _, elem := range v {
+			if out, err = appendCBORAny(out, elem); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		out = appendCBORHead(out, 5, uint64(len(v)))
+		var err error
+		for _, k := range keys {
+			out = appendCBORString(out, k)
+			if out, err = appendCBORAny(out, v[k]); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	}
+	data, err := safejson.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var decoded interface{}
+	if err := safejson.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+	return appendCBORAny(out, decoded)
+}
+
+// cborTypeError returns the error for a data item of the provided major type that is not of the wanted type.
+func cborTypeError(major byte, want string) error {
+	return fmt.Errorf("expected %s but found CBOR major type %d", want, major)
+}
+
+// cborInteger returns the value of an integer with the provided major type and argument.
+func cborInteger(major byte, arg uint64, want string) (int64, error) {
+	switch {
+	case major > 1:
+		return 0, cborTypeError(major, want)
+	case arg > math.MaxInt64:
+		return 0, fmt.Errorf("invalid %s: integer out of range", want)
+	case major == 1:
+		return -1 - int64(arg), nil
+	}
+	return int64(arg), nil
+}
+
+// cborFloat returns the value of a half-, single- or double-precision float with the provided additional
+// information and argument.
+func cborFloat(info byte, arg uint64) float64 {
+	switch info {
+	case 25:
+		exp, mant := int(arg>>10&0x1f), float64(arg&0x3ff)
+		var v float64
+		switch exp {
+		case 0:
+			v = math.Ldexp(mant, -24)
+		case 0x1f:
+			v = math.Inf(1)
+			if mant != 0 {
+				v = math.NaN()
+			}
+		default:
+			v = math.Ldexp(mant+1024, exp-25)
+		}
+		if arg&0x8000 != 0 {
+			return -v
+		}
+		return v
+	case 26:
+		return float64(math.Float32frombits(uint32(arg)))
+	}
+	return math.Float64frombits(arg)
+}
+
+// cborReader decodes the data items of data, which is read from pos.
+type cborReader struct {
+	data []byte
+	pos  int
+}
+
+// head reads the head of the next data item and returns its major type, additional information and argument.
+// Tags are skipped.
+func (r *cborReader) head() (major byte, info byte, arg uint64, err error) {
+	for {
+		if r.pos >= len(r.data) {
+			return 0, 0, 0, io.ErrUnexpectedEOF
+		}
+		b := r.data[r.pos]
+		r.pos++
+		major, info, arg = b>>5, b&0x1f, 0
+		switch {
+		case info < 24:
+			arg = uint64(info)
+		case info <= 27:
+			n := 1 << (info - 24)
+			if len(r.data)-r.pos < n {
+				return 0, 0, 0, io.ErrUnexpectedEOF
+			}
+			for _, c := range r.data[r.pos : r.pos+n] {
+				arg = arg<<8 | uint64(c)
+			}
+			r.pos += n
+		// the indefinite length of strings, arrays and maps or the break code
+		case info == 31 && major >= 2 && major != 6:
+		default:
+			return 0, 0, 0, fmt.Errorf("invalid CBOR additional information %d", info)
+		}
+		if major != 6 {
+			return major, info, arg, nil
+		}
+	}
+}
+
+// atBreak returns true and consumes the next byte if it is the break code that ends an indefinite-length item.
+func (r *cborReader) atBreak() bool {
+	if r.pos < len(r.data) && r.data[r.pos] == 0xff {
+		r.pos++
+		return true
+	}
+	return false
+}
+
+// decodeNull returns true and consumes the next data item if it is null or undefined.
+func (r *cborReader) decodeNull() bool {
+	if r.pos < len(r.data) && (r.data[r.pos] == 0xf6 || r.data[r.pos] == 0xf7) {
+		r.pos++
+		return true
+	}
+	return false
+}
+
+// end returns an error if there is data after the decoded data item.
+func (r *cborReader) end() error {
+	if r.pos != len(r.data) {
+		return fmt.Errorf("unexpected data after CBOR data item")
+	}
+	return nil
+}
+
+// stringBody reads the content of a byte or text string with the provided major type, additional information
+// and argument. The chunks of indefinite-length strings are concatenated.
+func (r *cborReader) stringBody(major byte, info byte, arg uint64) ([]byte, error) {
+	if info != 31 {
+		if uint64(len(r.data)-r.pos) < arg {
+			return nil, io.ErrUnexpectedEOF
+		}
+		s := r.data[r.pos : r.pos+int(arg)]
+		r.pos += int(arg)
+		return s, nil
+	}
+	var s []byte
+	for !r.atBreak() {
+		chunkMajor, chunkInfo, chunkArg, err := r.head()
+		if err != nil {
+			return nil, err
+		}
+		if chunkMajor != major || chunkInfo == 31 {
+			return nil, fmt.Errorf("invalid chunk of indefinite-length CBOR string")
+		}
+		chunk, err := r.stringBody(major, chunkInfo, chunkArg)
+		if err != nil {
+			return nil, err
+		}
+		s = append(s, chunk...)
+	}
+	return s, nil
+}
+
+// readString reads a byte or text string with the provided major type.
+func (r *cborReader) readString(want byte, name string) ([]byte, error) {
+	major, info, arg, err := r.head()
+	if err != nil {
+		return nil, err
+	}
+	if major != want {
+		return nil, cborTypeError(major, name)
+	}
+	return r.stringBody(major, info, arg)
+}
+
+// readInteger reads an integer.
+func (r *cborReader) readInteger(want string) (int64, error) {
+	major, _, arg, err := r.head()
+	if err != nil {
+		return 0, err
+	}
+	return cborInteger(major, arg, want)
+}
+
+// skip skips the next data item.
+func (r *cborReader) skip() error {
+	major, info, arg, err := r.head()
+	if err != nil {
+		return err
+	}
+	switch major {
+	case 2, 3:
+		_, err = r.stringBody(major, info, arg)
+		return err
+	case 4, 5:
+		if major == 5 && info != 31 {
+			// the keys and values of the entries
+			arg *= 2
+		}
+		for i := uint64(0); info == 31 && !r.atBreak() || info != 31 && i < arg; i++ {
+			if err := r.skip(); err != nil {
+				return err
+			}
+		}
+	case 7:
+		if info == 31 {
+			return fmt.Errorf("unexpected CBOR break code")
+		}
+	}
+	return nil
+}
+
+// decodeArray calls decodeElem for each element of the next data item, which must be an array. null is decoded as
+// an empty array.
+func (r *cborReader) decodeArray(decodeElem func() error) error {
+	if r.decodeNull() {
+		return nil
+	}
+	major, info, arg, err := r.head()
+	if err != nil {
+		return err
+	}
+	if major != 4 {
+		return cborTypeError(major, "array")
+	}
+	for i := uint64(0); info == 31 && !r.atBreak() || info != 31 && i < arg; i++ {
+		if err := decodeElem(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeMap calls decodeEntry for each entry of the next data item, which must be a map. decodeEntry must decode
+// the key and the value of the entry. null is decoded as an empty map.
+func (r *cborReader) decodeMap(decodeEntry func() error) error {
+	if r.decodeNull() {
+		return nil
+	}
+	major, info, arg, err := r.head()
+	if err != nil {
+		return err
+	}
+	if major != 5 {
+		return cborTypeError(major, "map")
+	}
+	for i := uint64(0); info == 31 && !r.atBreak() || info != 31 && i < arg; i++ {
+		if err := decodeEntry(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeString decodes a text string.
+func (r *cborReader) decodeString(v *string) error {
+	s, err := r.readString(3, "string")
+	if err != nil {
+		return err
+	}
+	*v = string(s)
+	return nil
+}
+
+// decodeBearerToken decodes a text string that is a bearer token.
+func (r *cborReader) decodeBearerToken(v *bearertoken.Token) error {
+	s, err := r.readString(3, "bearertoken")
+	if err != nil {
+		return err
+	}
+	*v = bearertoken.Token(s)
+	return nil
+}
+
+// decodeInt decodes an integer that is a Conjure integer, which must fit in 32 bits.
+func (r *cborReader) decodeInt(v *int) error {
+	i, err := r.readInteger("integer")
+	if err != nil {
+		return err
+	}
+	if i < math.MinInt32 || i > math.MaxInt32 {
+		return fmt.Errorf("invalid integer %d", i)
+	}
+	*v = int(i)
+	return nil
+}
+
+// decodeSafeLong decodes an integer that is a safelong.
+func (r *cborReader) decodeSafeLong(v *safelong.SafeLong) error {
+	i, err := r.readInteger("safelong")
+	if err != nil {
+		return err
+	}
+	parsed, err := safelong.NewSafeLong(i)
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// decodeFloat64 decodes a float or an integer.
+func (r *cborReader) decodeFloat64(v *float64) error {
+	major, info, arg, err := r.head()
+	if err != nil {
+		return err
+	}
+	switch {
+	case major <= 1:
+		i, err := cborInteger(major, arg, "double")
+		if err != nil {
+			return err
+		}
+		*v = float64(i)
+	case major == 7 && info >= 25 && info <= 27:
+		*v = cborFloat(info, arg)
+	default:
+		return cborTypeError(major, "double")
+	}
+	return nil
+}
+
+// decodeBool decodes a boolean.
+func (r *cborReader) decodeBool(v *bool) error {
+	major, info, _, err := r.head()
+	if err != nil {
+		return err
+	}
+	if major != 7 || info != 20 && info != 21 {
+		return cborTypeError(major, "boolean")
+	}
+	*v = info == 21
+	return nil
+}
+
+// decodeUUID decodes a text string that is a uuid.
+func (r *cborReader) decodeUUID(v *uuid.UUID) error {
+	s, err := r.readString(3, "uuid")
+	if err != nil {
+		return err
+	}
+	parsed, err := uuid.ParseUUID(string(s))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// decodeDateTime decodes a text string that is a datetime.
+func (r *cborReader) decodeDateTime(v *datetime.DateTime) error {
+	s, err := r.readString(3, "datetime")
+	if err != nil {
+		return err
+	}
+	parsed, err := datetime.ParseDateTime(string(s))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// decodeRID decodes a text string that is a rid.
+func (r *cborReader) decodeRID(v *rid.ResourceIdentifier) error {
+	s, err := r.readString(3, "rid")
+	if err != nil {
+		return err
+	}
+	parsed, err := rid.ParseRID(string(s))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// decodeBinary decodes a byte string. The decoded value does not share memory with the data of the reader.
+func (r *cborReader) decodeBinary(v *[]byte) error {
+	b, err := r.readString(2, "binary")
+	if err != nil {
+		return err
+	}
+	*v = append([]byte{}, b...)
+	return nil
+}
+
+// readAny decodes the next data item into a value like the ones that safejson.Unmarshal decodes JSON into:
+// arrays are decoded into []interface{}, maps into map[string]interface{} and numbers into json.Number, except
+// for NaN and infinite floats, which are decoded into float64.
+func (r *cborReader) readAny() (interface{}, error) {
+	if r.decodeNull() {
+		return nil, nil
+	}
+	major, info, arg, err := r.head()
+	if err != nil {
+		return nil, err
+	}
+	switch major {
+	case 0, 1:
+		i, err := cborInteger(major, arg, "integer")
+		if err != nil {
+			return nil, err
+		}
+		return json.Number(strconv.FormatInt(i, 10)), nil
+	case 2, 3:
+		s, err := r.stringBody(major, info, arg)
+		if err != nil {
+			return nil, err
+		}
+		if major == 2 {
+			return append([]byte{}, s...), nil
+		}
+		return string(s), nil
+	case 4:
+		list := make([]interface{}, 0)
+		for i := uint64(0); info == 31 && !r.atBreak() || info != 31 && i < arg; i++ {
+			elem, err := r.readAny()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, elem)
+		}
+		return list, nil
+	case 5:
+		m := make(map[string]interface{})
+		for i := uint64(0); info == 31 && !r.atBreak() || info != 31 && i < arg; i++ {
+			key, err := r.readAny()
+			if err != nil {
+				return nil, err
+			}
+			k, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported CBOR map key %v", key)
+			}
+			value, err := r.readAny()
+			if err != nil {
+				return nil, err
+			}
+			m[k] = value
+		}
+		return m, nil
+	case 7:
+		switch info {
+		case 20, 21:
+			return info == 21, nil
+		case 25, 26, 27:
+			f := cborFloat(info, arg)
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				return f, nil
+			}
+			return json.Number(strconv.FormatFloat(f, 'g', -1, 64)), nil
+		}
+	}
+	return nil, cborTypeError(major, "value")
+}
+
+// decodeAny decodes any data item using readAny.
+func (r *cborReader) decodeAny(v *interface{}) error {
+	value, err := r.readAny()
+	if err != nil {
+		return err
+	}
+	*v = value
+	return nil
+}
+
+// cborContentType is the media type of CBOR request and response bodies.
+const cborContentType = "application/cbor"
+
+// cborCodec encodes and decodes values of types with MarshalCBOR and UnmarshalCBOR methods.
+type cborCodec struct{}
+
+func (cborCodec) Accept() string {
+	return cborContentType
+}
+
+func (c cborCodec) Decode(r io.Reader, v interface{}) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return c.Unmarshal(data, v)
+}
+
+func (cborCodec) Unmarshal(data []byte, v interface{}) error {
+	u, ok := v.(interface {
+		UnmarshalCBOR([]byte) error
+	})
+	if !ok {
+		return fmt.Errorf("%T does not implement UnmarshalCBOR", v)
+	}
+	return u.UnmarshalCBOR(data)
+}
+
+func (cborCodec) ContentType() string {
+	return cborContentType
+}
+
+func (c cborCodec) Encode(w io.Writer, v interface{}) error {
+	data, err := c.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+func (cborCodec) Marshal(v interface{}) ([]byte, error) {
+	m, ok := v.(interface {
+		MarshalCBOR() ([]byte, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("%T does not implement MarshalCBOR", v)
+	}
+	return m.MarshalCBOR()
+}
+
+// isCBORRequest returns true if the body of req is encoded as CBOR.
+func isCBORRequest(req *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	return err == nil && mediaType == cborContentType
+}
+
+// acceptsCBOR returns true if req prefers CBOR responses to JSON responses, which is the case if its Accept
+// header lists application/cbor before application/json. Media ranges with a quality of 0 are ignored.
+func acceptsCBOR(req *http.Request) bool {
+	for _, accept := range req.Header.Values("Accept") {
+		for _, mediaRange := range strings.Split(accept, ",") {
+			mediaType, params, err := mime.ParseMediaType(mediaRange)
+			if err != nil || params["q"] == "0" {
+				continue
+			}
+			switch mediaType {
+			case cborContentType:
+				return true
+			case "application/json":
+				return false
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,361 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/codecs"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	wlogzap "github.com/palantir/witchcraft-go-logging/wlog-zap"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/trclog/trc1log"
+	"github.com/palantir/witchcraft-go-tracing/wtracing"
+	"github.com/palantir/witchcraft-go-tracing/wzipkin"
+	"github.com/spf13/cobra"
+	pflag "github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+type CLIConfig struct {
+	Client httpclient.ClientConfig `yaml:",inline"`
+}
+
+// Commands for CBORService
+
+type CLICBORServiceClientProvider interface {
+	Get(ctx context.Context, flags *pflag.FlagSet) (CBORServiceClient, error)
+}
+
+type defaultCLICBORServiceClientProvider struct{}
+
+func NewDefaultCLICBORServiceClientProvider() CLICBORServiceClientProvider {
+	return defaultCLICBORServiceClientProvider{}
+}
+
+func (d defaultCLICBORServiceClientProvider) Get(ctx context.Context, flags *pflag.FlagSet) (CBORServiceClient, error) {
+	conf, err := loadCLIConfig(ctx, flags)
+	if err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "failed to load CLI configuration file")
+	}
+	client, err := httpclient.NewClient(httpclient.WithConfig(conf.Client))
+	if err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "failed to create client with provided config")
+	}
+	return NewCBORServiceClient(client), nil
+}
+
+type CBORServiceCLICommand struct {
+	clientProvider CLICBORServiceClientProvider
+}
+
+func NewCBORServiceCLICommand() *cobra.Command {
+	return NewCBORServiceCLICommandWithClientProvider(NewDefaultCLICBORServiceClientProvider())
+}
+
+func NewCBORServiceCLICommandWithClientProvider(clientProvider CLICBORServiceClientProvider) *cobra.Command {
+	rootCmd := &cobra.Command{
+		Short: "Runs commands on the CBORService",
+		Use:   "cBORService",
+	}
+	rootCmd.PersistentFlags().String("conf", "var/conf/configuration.yml", "The configuration file is optional. The default path is ./var/conf/configuration.yml.")
+	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "Enables verbose mode for debugging client connections.")
+
+	cliCommand := CBORServiceCLICommand{clientProvider: clientProvider}
+
+	cBORService_EchoRecord_Cmd := &cobra.Command{
+		RunE:  cliCommand.cBORService_EchoRecord_CmdRun,
+		Short: "Calls the echoRecord endpoint.",
+		Use:   "echoRecord",
+	}
+	rootCmd.AddCommand(cBORService_EchoRecord_Cmd)
+	cBORService_EchoRecord_Cmd.Flags().String("record", "", "Required. ")
+
+	cBORService_EchoShape_Cmd := &cobra.Command{
+		RunE:  cliCommand.cBORService_EchoShape_CmdRun,
+		Short: "Calls the echoShape endpoint.",
+		Use:   "echoShape",
+	}
+	rootCmd.AddCommand(cBORService_EchoShape_Cmd)
+	cBORService_EchoShape_Cmd.Flags().String("shape", "", "Required. ")
+
+	cBORService_EchoOptionalName_Cmd := &cobra.Command{
+		RunE:  cliCommand.cBORService_EchoOptionalName_CmdRun,
+		Short: "Calls the echoOptionalName endpoint.",
+		Use:   "echoOptionalName",
+	}
+	rootCmd.AddCommand(cBORService_EchoOptionalName_Cmd)
+	cBORService_EchoOptionalName_Cmd.Flags().String("name", "", "Optional. ")
+
+	cBORService_EchoChildren_Cmd := &cobra.Command{
+		RunE:  cliCommand.cBORService_EchoChildren_CmdRun,
+		Short: "Calls the echoChildren endpoint.",
+		Use:   "echoChildren",
+	}
+	rootCmd.AddCommand(cBORService_EchoChildren_Cmd)
+	cBORService_EchoChildren_Cmd.Flags().String("children", "", "Required. ")
+
+	cBORService_EchoList_Cmd := &cobra.Command{
+		RunE:  cliCommand.cBORService_EchoList_CmdRun,
+		Short: "Calls the echoList endpoint.",
+		Use:   "echoList",
+	}
+	rootCmd.AddCommand(cBORService_EchoList_Cmd)
+	cBORService_EchoList_Cmd.Flags().String("values", "", "Required. ")
+
+	return rootCmd
+}
+
+func (c CBORServiceCLICommand) cBORService_EchoRecord_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	recordRaw, err := flags.GetString("record")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument record")
+	}
+	if recordRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "record is a required argument")
+	}
+	var recordArg Record
+	var recordArgReader io.ReadCloser
+	switch {
+	case recordRaw == "@-":
+		recordArgReader = io.NopCloser(cmd.InOrStdin())
+	case strings.HasPrefix(recordRaw, "@"):
+		recordArgReader, err = os.Open(strings.TrimSpace(recordRaw[1:]))
+		if err != nil {
+			return werror.WrapWithContextParams(ctx, err, "failed to open file for argument record")
+		}
+	default:
+		recordArgReader = io.NopCloser(bytes.NewReader([]byte(recordRaw)))
+	}
+	defer recordArgReader.Close()
+	if err := codecs.JSON.Decode(recordArgReader, &recordArg); err != nil {
+		return werror.WrapWithContextParams(ctx, err, "invalid value for record argument")
+	}
+
+	result, err := client.EchoRecord(ctx, recordArg)
+	if err != nil {
+		return err
+	}
+	resultBytes, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		fmt.Printf("Failed to marshal to json with err: %v\n\nPrinting as string:\n%v\n", err, result)
+		return nil
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%v\n", string(resultBytes))
+	return nil
+}
+
+func (c CBORServiceCLICommand) cBORService_EchoShape_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	shapeRaw, err := flags.GetString("shape")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument shape")
+	}
+	if shapeRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "shape is a required argument")
+	}
+	var shapeArg Shape
+	var shapeArgReader io.ReadCloser
+	switch {
+	case shapeRaw == "@-":
+		shapeArgReader = io.NopCloser(cmd.InOrStdin())
+	case strings.HasPrefix(shapeRaw, "@"):
+		shapeArgReader, err = os.Open(strings.TrimSpace(shapeRaw[1:]))
+		if err != nil {
+			return werror.WrapWithContextParams(ctx, err, "failed to open file for argument shape")
+		}
+	default:
+		shapeArgReader = io.NopCloser(bytes.NewReader([]byte(shapeRaw)))
+	}
+	defer shapeArgReader.Close()
+	if err := codecs.JSON.Decode(shapeArgReader, &shapeArg); err != nil {
+		return werror.WrapWithContextParams(ctx, err, "invalid value for shape argument")
+	}
+
+	result, err := client.EchoShape(ctx, shapeArg)
+	if err != nil {
+		return err
+	}
+	resultBytes, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		fmt.Printf("Failed to marshal to json with err: %v\n\nPrinting as string:\n%v\n", err, result)
+		return nil
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%v\n", string(resultBytes))
+	return nil
+}
+
+func (c CBORServiceCLICommand) cBORService_EchoOptionalName_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	nameRaw, err := flags.GetString("name")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument name")
+	}
+	var nameArgValue *string
+	if nameArgValueStr1 := nameRaw; nameArgValueStr1 != "" {
+		nameArgValueInternal1 := nameArgValueStr1
+		nameArgValue = &nameArgValueInternal1
+	}
+	nameArg := OptionalName{Value: nameArgValue}
+
+	result, err := client.EchoOptionalName(ctx, nameArg)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%v\n", result)
+	return nil
+}
+
+func (c CBORServiceCLICommand) cBORService_EchoChildren_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	childrenRaw, err := flags.GetString("children")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument children")
+	}
+	if childrenRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "children is a required argument")
+	}
+	var childrenArg Children
+	var childrenArgReader io.ReadCloser
+	switch {
+	case childrenRaw == "@-":
+		childrenArgReader = io.NopCloser(cmd.InOrStdin())
+	case strings.HasPrefix(childrenRaw, "@"):
+		childrenArgReader, err = os.Open(strings.TrimSpace(childrenRaw[1:]))
+		if err != nil {
+			return werror.WrapWithContextParams(ctx, err, "failed to open file for argument children")
+		}
+	default:
+		childrenArgReader = io.NopCloser(bytes.NewReader([]byte(childrenRaw)))
+	}
+	defer childrenArgReader.Close()
+	if err := codecs.JSON.Decode(childrenArgReader, &childrenArg); err != nil {
+		return werror.WrapWithContextParams(ctx, err, "invalid value for children argument")
+	}
+
+	result, err := client.EchoChildren(ctx, childrenArg)
+	if err != nil {
+		return err
+	}
+	resultBytes, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		fmt.Printf("Failed to marshal to json with err: %v\n\nPrinting as string:\n%v\n", err, result)
+		return nil
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%v\n", string(resultBytes))
+	return nil
+}
+
+func (c CBORServiceCLICommand) cBORService_EchoList_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	valuesRaw, err := flags.GetString("values")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument values")
+	}
+	if valuesRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "values is a required argument")
+	}
+	var valuesArg []string
+	var valuesArgReader io.ReadCloser
+	switch {
+	case valuesRaw == "@-":
+		valuesArgReader = io.NopCloser(cmd.InOrStdin())
+	case strings.HasPrefix(valuesRaw, "@"):
+		valuesArgReader, err = os.Open(strings.TrimSpace(valuesRaw[1:]))
+		if err != nil {
+			return werror.WrapWithContextParams(ctx, err, "failed to open file for argument values")
+		}
+	default:
+		valuesArgReader = io.NopCloser(bytes.NewReader([]byte(valuesRaw)))
+	}
+	defer valuesArgReader.Close()
+	if err := codecs.JSON.Decode(valuesArgReader, &valuesArg); err != nil {
+		return werror.WrapWithContextParams(ctx, err, "invalid value for values argument")
+	}
+
+	result, err := client.EchoList(ctx, valuesArg)
+	if err != nil {
+		return err
+	}
+	resultBytes, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		fmt.Printf("Failed to marshal to json with err: %v\n\nPrinting as string:\n%v\n", err, result)
+		return nil
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%v\n", string(resultBytes))
+	return nil
+}
+
+func loadCLIConfig(ctx context.Context, flags *pflag.FlagSet) (CLIConfig, error) {
+	var emptyConfig CLIConfig
+	configPath, err := flags.GetString("conf")
+	if err != nil || configPath == "" {
+		return emptyConfig, werror.WrapWithContextParams(ctx, err, "config file location must be specified")
+	}
+	confBytes, err := os.ReadFile(configPath)
+	if err != nil {
+		return emptyConfig, err
+	}
+	var conf CLIConfig
+	err = yaml.Unmarshal(confBytes, &conf)
+	if err != nil {
+		return emptyConfig, err
+	}
+	return conf, nil
+}
+
+func getCLIContext(flags *pflag.FlagSet) context.Context {
+	ctx := context.Background()
+	logProvider := wlog.NewNoopLoggerProvider()
+	logWriter := io.Discard
+	verbose, err := flags.GetBool("verbose")
+	if verbose && err == nil {
+		logProvider = wlogzap.LoggerProvider()
+		logWriter = os.Stdout
+	}
+	wlog.SetDefaultLoggerProvider(logProvider)
+	ctx = svc1log.WithLogger(ctx, svc1log.New(logWriter, wlog.DebugLevel))
+	traceLogger := trc1log.New(logWriter)
+	ctx = trc1log.WithLogger(ctx, traceLogger)
+	ctx = evt2log.WithLogger(ctx, evt2log.New(logWriter))
+	tracer, err := wzipkin.NewTracer(traceLogger)
+	if err != nil {
+		return ctx
+	}
+	return wtracing.ContextWithTracer(ctx, tracer)
+}
@@ -0,0 +1,161 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	wparams "github.com/palantir/witchcraft-go-params"
+)
+
+// FakeCBORService is an in-memory implementation of CBORService for use in tests.
+// Each endpoint records its arguments and then invokes the corresponding <Endpoint>Func field.
+// If the field is nil, the endpoint returns DefaultErr (or a Conjure Internal error if DefaultErr is nil).
+// The zero value is ready to use and all methods are safe for concurrent use.
+type FakeCBORService struct {
+	// EchoRecordFunc is invoked by EchoRecord if non-nil.
+	EchoRecordFunc func(ctx context.Context, recordArg Record) (Record, error)
+	// EchoShapeFunc is invoked by EchoShape if non-nil.
+	EchoShapeFunc func(ctx context.Context, shapeArg Shape) (Shape, error)
+	// EchoOptionalNameFunc is invoked by EchoOptionalName if non-nil.
+	EchoOptionalNameFunc func(ctx context.Context, nameArg OptionalName) (OptionalName, error)
+	// EchoChildrenFunc is invoked by EchoChildren if non-nil.
+	EchoChildrenFunc func(ctx context.Context, childrenArg Children) (Children, error)
+	// EchoListFunc is invoked by EchoList if non-nil.
+	EchoListFunc func(ctx context.Context, valuesArg []string) ([]string, error)
+	// DefaultErr is returned by endpoints whose func field is nil.
+	DefaultErr error
+
+	mu                    sync.Mutex
+	echoRecordCalls       []FakeCBORServiceEchoRecordCall
+	echoShapeCalls        []FakeCBORServiceEchoShapeCall
+	echoOptionalNameCalls []FakeCBORServiceEchoOptionalNameCall
+	echoChildrenCalls     []FakeCBORServiceEchoChildrenCall
+	echoListCalls         []FakeCBORServiceEchoListCall
+}
+
+var _ CBORService = (*FakeCBORService)(nil)
+
+// FakeCBORServiceEchoRecordCall records the arguments of a call to FakeCBORService.EchoRecord.
+type FakeCBORServiceEchoRecordCall struct {
+	Record Record
+}
+
+func (f *FakeCBORService) EchoRecord(ctx context.Context, recordArg Record) (Record, error) {
+	f.mu.Lock()
+	f.echoRecordCalls = append(f.echoRecordCalls, FakeCBORServiceEchoRecordCall{Record: recordArg})
+	f.mu.Unlock()
+	if f.EchoRecordFunc != nil {
+		return f.EchoRecordFunc(ctx, recordArg)
+	}
+	var defaultReturnVal Record
+	return defaultReturnVal, f.defaultErr("echoRecord")
+}
+
+// EchoRecordCalls returns the arguments of every call made to EchoRecord, in call order.
+func (f *FakeCBORService) EchoRecordCalls() []FakeCBORServiceEchoRecordCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCBORServiceEchoRecordCall(nil), f.echoRecordCalls...)
+}
+
+// FakeCBORServiceEchoShapeCall records the arguments of a call to FakeCBORService.EchoShape.
+type FakeCBORServiceEchoShapeCall struct {
+	Shape Shape
+}
+
+func (f *FakeCBORService) EchoShape(ctx context.Context, shapeArg Shape) (Shape, error) {
+	f.mu.Lock()
+	f.echoShapeCalls = append(f.echoShapeCalls, FakeCBORServiceEchoShapeCall{Shape: shapeArg})
+	f.mu.Unlock()
+	if f.EchoShapeFunc != nil {
+		return f.EchoShapeFunc(ctx, shapeArg)
+	}
+	var defaultReturnVal Shape
+	return defaultReturnVal, f.defaultErr("echoShape")
+}
+
+// EchoShapeCalls returns the arguments of every call made to EchoShape, in call order.
+func (f *FakeCBORService) EchoShapeCalls() []FakeCBORServiceEchoShapeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCBORServiceEchoShapeCall(nil), f.echoShapeCalls...)
+}
+
+// FakeCBORServiceEchoOptionalNameCall records the arguments of a call to FakeCBORService.EchoOptionalName.
+type FakeCBORServiceEchoOptionalNameCall struct {
+	Name OptionalName
+}
+
+func (f *FakeCBORService) EchoOptionalName(ctx context.Context, nameArg OptionalName) (OptionalName, error) {
+	f.mu.Lock()
+	f.echoOptionalNameCalls = append(f.echoOptionalNameCalls, FakeCBORServiceEchoOptionalNameCall{Name: nameArg})
+	f.mu.Unlock()
+	if f.EchoOptionalNameFunc != nil {
+		return f.EchoOptionalNameFunc(ctx, nameArg)
+	}
+	var defaultReturnVal OptionalName
+	return defaultReturnVal, f.defaultErr("echoOptionalName")
+}
+
+// EchoOptionalNameCalls returns the arguments of every call made to EchoOptionalName, in call order.
+func (f *FakeCBORService) EchoOptionalNameCalls() []FakeCBORServiceEchoOptionalNameCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCBORServiceEchoOptionalNameCall(nil), f.echoOptionalNameCalls...)
+}
+
+// FakeCBORServiceEchoChildrenCall records the arguments of a call to FakeCBORService.EchoChildren.
+type FakeCBORServiceEchoChildrenCall struct {
+	Children Children
+}
+
+func (f *FakeCBORService) EchoChildren(ctx context.Context, childrenArg Children) (Children, error) {
+	f.mu.Lock()
+	f.echoChildrenCalls = append(f.echoChildrenCalls, FakeCBORServiceEchoChildrenCall{Children: childrenArg})
+	f.mu.Unlock()
+	if f.EchoChildrenFunc != nil {
+		return f.EchoChildrenFunc(ctx, childrenArg)
+	}
+	var defaultReturnVal Children
+	return defaultReturnVal, f.defaultErr("echoChildren")
+}
+
+// EchoChildrenCalls returns the arguments of every call made to EchoChildren, in call order.
+func (f *FakeCBORService) EchoChildrenCalls() []FakeCBORServiceEchoChildrenCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCBORServiceEchoChildrenCall(nil), f.echoChildrenCalls...)
+}
+
+// FakeCBORServiceEchoListCall records the arguments of a call to FakeCBORService.EchoList.
+type FakeCBORServiceEchoListCall struct {
+	Values []string
+}
+
+func (f *FakeCBORService) EchoList(ctx context.Context, valuesArg []string) ([]string, error) {
+	f.mu.Lock()
+	f.echoListCalls = append(f.echoListCalls, FakeCBORServiceEchoListCall{Values: valuesArg})
+	f.mu.Unlock()
+	if f.EchoListFunc != nil {
+		return f.EchoListFunc(ctx, valuesArg)
+	}
+	var defaultReturnVal []string
+	return defaultReturnVal, f.defaultErr("echoList")
+}
+
+// EchoListCalls returns the arguments of every call made to EchoList, in call order.
+func (f *FakeCBORService) EchoListCalls() []FakeCBORServiceEchoListCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCBORServiceEchoListCall(nil), f.echoListCalls...)
+}
+
+func (f *FakeCBORService) defaultErr(endpoint string) error {
+	if f.DefaultErr != nil {
+		return f.DefaultErr
+	}
+	return errors.NewInternal(wparams.NewSafeParamStorer(map[string]interface{}{"fakeEndpoint": endpoint}))
+}
@@ -0,0 +1,202 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/codecs"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-server/httpserver"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-server/v2/witchcraft/wresource"
+	"github.com/palantir/witchcraft-go-server/v2/wrouter"
+	"github.com/tidwall/gjson"
+)
+
+type CBORService interface {
+	EchoRecord(ctx context.Context, recordArg Record) (Record, error)
+	EchoShape(ctx context.Context, shapeArg Shape) (Shape, error)
+	EchoOptionalName(ctx context.Context, nameArg OptionalName) (OptionalName, error)
+	EchoChildren(ctx context.Context, childrenArg Children) (Children, error)
+	EchoList(ctx context.Context, valuesArg []string) ([]string, error)
+}
+
+// RegisterRoutesCBORService registers handlers for the CBORService endpoints with a witchcraft wrouter.
+// This should typically be called in a witchcraft server's InitFunc.
+// impl provides an implementation of each endpoint, which can assume the request parameters have been parsed
+// in accordance with the Conjure specification.
+func RegisterRoutesCBORService(router wrouter.Router, impl CBORService, routerParams ...wrouter.RouteParam) error {
+	handler := cBORServiceHandler{impl: impl}
+	resource := wresource.New("cborservice", router)
+	if err := resource.Post("EchoRecord", "/cbor/record", httpserver.NewJSONHandler(handler.HandleEchoRecord, httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add echoRecord route")
+	}
+	if err := resource.Post("EchoShape", "/cbor/shape", httpserver.NewJSONHandler(handler.HandleEchoShape, httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add echoShape route")
+	}
+	if err := resource.Post("EchoOptionalName", "/cbor/optional-name", httpserver.NewJSONHandler(handler.HandleEchoOptionalName, httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add echoOptionalName route")
+	}
+	if err := resource.Post("EchoChildren", "/cbor/children", httpserver.NewJSONHandler(handler.HandleEchoChildren, httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add echoChildren route")
+	}
+	if err := resource.Post("EchoList", "/cbor/list", httpserver.NewJSONHandler(handler.HandleEchoList, httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add echoList route")
+	}
+	return nil
+}
+
+type cBORServiceHandler struct {
+	impl CBORService
+}
+
+func (c *cBORServiceHandler) HandleEchoRecord(rw http.ResponseWriter, req *http.Request) error {
+	var recordArg Record
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	if isCBORRequest(req) {
+		if err := recordArg.UnmarshalCBOR(data); err != nil {
+			return errors.WrapWithInvalidArgument(err)
+		}
+	} else {
+		if err := recordArg.UnmarshalJSONStrict(data); err != nil {
+			return errors.WrapWithInvalidArgument(err)
+		}
+	}
+	respArg, err := c.impl.EchoRecord(req.Context(), recordArg)
+	if err != nil {
+		return err
+	}
+	if acceptsCBOR(req) {
+		rw.Header().Add("Content-Type", cborContentType)
+		return cborCodec{}.Encode(rw, respArg)
+	}
+	rw.Header().Add("Content-Type", codecs.JSON.ContentType())
+	return codecs.JSON.Encode(rw, respArg)
+}
+
+func (c *cBORServiceHandler) HandleEchoShape(rw http.ResponseWriter, req *http.Request) error {
+	var shapeArg Shape
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	if isCBORRequest(req) {
+		if err := shapeArg.UnmarshalCBOR(data); err != nil {
+			return errors.WrapWithInvalidArgument(err)
+		}
+	} else {
+		if err := shapeArg.UnmarshalJSONStrict(data); err != nil {
+			return errors.WrapWithInvalidArgument(err)
+		}
+	}
+	respArg, err := c.impl.EchoShape(req.Context(), shapeArg)
+	if err != nil {
+		return err
+	}
+	if acceptsCBOR(req) {
+		rw.Header().Add("Content-Type", cborContentType)
+		return cborCodec{}.Encode(rw, respArg)
+	}
+	rw.Header().Add("Content-Type", codecs.JSON.ContentType())
+	return codecs.JSON.Encode(rw, respArg)
+}
+
+func (c *cBORServiceHandler) HandleEchoOptionalName(rw http.ResponseWriter, req *http.Request) error {
+	var nameArg OptionalName
+	if req.Body != nil && req.Body != http.NoBody {
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			return errors.WrapWithInvalidArgument(err)
+		}
+		if isCBORRequest(req) {
+			if err := nameArg.UnmarshalCBOR(data); err != nil {
+				return errors.WrapWithInvalidArgument(err)
+			}
+		} else {
+			if err := nameArg.UnmarshalJSONStrict(data); err != nil {
+				return errors.WrapWithInvalidArgument(err)
+			}
+		}
+	}
+	respArg, err := c.impl.EchoOptionalName(req.Context(), nameArg)
+	if err != nil {
+		return err
+	}
+	if respArg.Value == nil {
+		rw.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+	if acceptsCBOR(req) {
+		rw.Header().Add("Content-Type", cborContentType)
+		return cborCodec{}.Encode(rw, respArg)
+	}
+	rw.Header().Add("Content-Type", codecs.JSON.ContentType())
+	return codecs.JSON.Encode(rw, respArg)
+}
+
+func (c *cBORServiceHandler) HandleEchoChildren(rw http.ResponseWriter, req *http.Request) error {
+	var childrenArg Children
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	if isCBORRequest(req) {
+		if err := childrenArg.UnmarshalCBOR(data); err != nil {
+			return errors.WrapWithInvalidArgument(err)
+		}
+	} else {
+		if err := childrenArg.UnmarshalJSONStrict(data); err != nil {
+			return errors.WrapWithInvalidArgument(err)
+		}
+	}
+	respArg, err := c.impl.EchoChildren(req.Context(), childrenArg)
+	if err != nil {
+		return err
+	}
+	if acceptsCBOR(req) {
+		rw.Header().Add("Content-Type", cborContentType)
+		return cborCodec{}.Encode(rw, respArg)
+	}
+	rw.Header().Add("Content-Type", codecs.JSON.ContentType())
+	return codecs.JSON.Encode(rw, respArg)
+}
+
+func (c *cBORServiceHandler) HandleEchoList(rw http.ResponseWriter, req *http.Request) error {
+	var valuesArg []string
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	if !value.IsArray() {
+		return errors.WrapWithInvalidArgument(jsonTypeError(value, "array"))
+	}
+	valuesArg = make([]string, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v string
+		v, err = decodeJSONString(elem)
+		if err != nil {
+			return false
+		}
+		valuesArg = append(valuesArg, v)
+		return true
+	})
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	respArg, err := c.impl.EchoList(req.Context(), valuesArg)
+	if err != nil {
+		return err
+	}
+	rw.Header().Add("Content-Type", codecs.JSON.ContentType())
+	return codecs.JSON.Encode(rw, respArg)
+}
@@ -0,0 +1,154 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	werror "github.com/palantir/witchcraft-go-error"
+)
+
+type CBORServiceClient interface {
+	EchoRecord(ctx context.Context, recordArg Record) (Record, error)
+	EchoShape(ctx context.Context, shapeArg Shape) (Shape, error)
+	EchoOptionalName(ctx context.Context, nameArg OptionalName) (OptionalName, error)
+	EchoChildren(ctx context.Context, childrenArg Children) (Children, error)
+	EchoList(ctx context.Context, valuesArg []string) ([]string, error)
+}
+
+type cBORServiceClient struct {
+	client httpclient.Client
+	cbor   bool
+}
+
+func NewCBORServiceClient(client httpclient.Client) CBORServiceClient {
+	return &cBORServiceClient{client: client}
+}
+
+// NewCBORServiceCBORClient returns a client that encodes the request and response bodies of Conjure types as CBOR. The
+// server must support CBOR.
+func NewCBORServiceCBORClient(client httpclient.Client) CBORServiceClient {
+	return &cBORServiceClient{client: client, cbor: true}
+}
+
+func (c *cBORServiceClient) EchoRecord(ctx context.Context, recordArg Record) (Record, error) {
+	var defaultReturnVal Record
+	var returnVal *Record
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("EchoRecord"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("POST"))
+	requestParams = append(requestParams, httpclient.WithPathf("/cbor/record"))
+	if c.cbor {
+		requestParams = append(requestParams, httpclient.WithRequestBody(recordArg, cborCodec{}))
+	} else {
+		requestParams = append(requestParams, httpclient.WithJSONRequest(recordArg))
+	}
+	if c.cbor {
+		returnVal = new(Record)
+		requestParams = append(requestParams, httpclient.WithResponseBody(returnVal, cborCodec{}))
+	} else {
+		requestParams = append(requestParams, httpclient.WithJSONResponse(&returnVal))
+	}
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return defaultReturnVal, werror.WrapWithContextParams(ctx, err, "echoRecord failed")
+	}
+	if returnVal == nil {
+		return defaultReturnVal, werror.ErrorWithContextParams(ctx, "echoRecord response cannot be nil")
+	}
+	return *returnVal, nil
+}
+
+func (c *cBORServiceClient) EchoShape(ctx context.Context, shapeArg Shape) (Shape, error) {
+	var defaultReturnVal Shape
+	var returnVal *Shape
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("EchoShape"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("POST"))
+	requestParams = append(requestParams, httpclient.WithPathf("/cbor/shape"))
+	if c.cbor {
+		requestParams = append(requestParams, httpclient.WithRequestBody(shapeArg, cborCodec{}))
+	} else {
+		requestParams = append(requestParams, httpclient.WithJSONRequest(shapeArg))
+	}
+	if c.cbor {
+		returnVal = new(Shape)
+		requestParams = append(requestParams, httpclient.WithResponseBody(returnVal, cborCodec{}))
+	} else {
+		requestParams = append(requestParams, httpclient.WithJSONResponse(&returnVal))
+	}
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return defaultReturnVal, werror.WrapWithContextParams(ctx, err, "echoShape failed")
+	}
+	if returnVal == nil {
+		return defaultReturnVal, werror.ErrorWithContextParams(ctx, "echoShape response cannot be nil")
+	}
+	return *returnVal, nil
+}
+
+func (c *cBORServiceClient) EchoOptionalName(ctx context.Context, nameArg OptionalName) (OptionalName, error) {
+	var defaultReturnVal OptionalName
+	var returnVal OptionalName
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("EchoOptionalName"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("POST"))
+	requestParams = append(requestParams, httpclient.WithPathf("/cbor/optional-name"))
+	if nameArg.Value != nil {
+		if c.cbor {
+			requestParams = append(requestParams, httpclient.WithRequestBody(nameArg, cborCodec{}))
+		} else {
+			requestParams = append(requestParams, httpclient.WithJSONRequest(nameArg))
+		}
+	}
+	if c.cbor {
+		requestParams = append(requestParams, httpclient.WithResponseBody(&returnVal, cborCodec{}))
+	} else {
+		requestParams = append(requestParams, httpclient.WithJSONResponse(&returnVal))
+	}
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return defaultReturnVal, werror.WrapWithContextParams(ctx, err, "echoOptionalName failed")
+	}
+	return returnVal, nil
+}
+
+func (c *cBORServiceClient) EchoChildren(ctx context.Context, childrenArg Children) (Children, error) {
+	var returnVal Children
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("EchoChildren"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("POST"))
+	requestParams = append(requestParams, httpclient.WithPathf("/cbor/children"))
+	if c.cbor {
+		requestParams = append(requestParams, httpclient.WithRequestBody(childrenArg, cborCodec{}))
+	} else {
+		requestParams = append(requestParams, httpclient.WithJSONRequest(childrenArg))
+	}
+	if c.cbor {
+		requestParams = append(requestParams, httpclient.WithResponseBody(&returnVal, cborCodec{}))
+	} else {
+		requestParams = append(requestParams, httpclient.WithJSONResponse(&returnVal))
+	}
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "echoChildren failed")
+	}
+	if returnVal == nil {
+		return nil, werror.ErrorWithContextParams(ctx, "echoChildren response cannot be nil")
+	}
+	return returnVal, nil
+}
+
+func (c *cBORServiceClient) EchoList(ctx context.Context, valuesArg []string) ([]string, error) {
+	var returnVal []string
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("EchoList"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("POST"))
+	requestParams = append(requestParams, httpclient.WithPathf("/cbor/list"))
+	requestParams = append(requestParams, httpclient.WithJSONRequest(valuesArg))
+	requestParams = append(requestParams, httpclient.WithJSONResponse(&returnVal))
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "echoList failed")
+	}
+	if returnVal == nil {
+		return nil, werror.ErrorWithContextParams(ctx, "echoList response cannot be nil")
+	}
+	return returnVal, nil
+}
@@ -0,0 +1,2212 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/palantir/pkg/bearertoken"
+	"github.com/palantir/pkg/binary"
+	"github.com/palantir/pkg/boolean"
+	"github.com/palantir/pkg/datetime"
+	"github.com/palantir/pkg/rid"
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safelong"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/palantir/pkg/uuid"
+	"github.com/tidwall/gjson"
+)
+
+type Child struct {
+	Value int                      `json:"value"`
+	Blobs map[binary.Binary][]byte `json:"blobs"`
+}
+
+func (o Child) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"value\":"...)
+	out = strconv.AppendInt(out, int64(o.Value), 10)
+	out = append(out, ",\"blobs\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value []byte
+		}
+		entries := make([]mapEntry, 0, len(o.Blobs))
+		for k, v := range o.Blobs {
+			entries = append(entries, mapEntry{key: string(k), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			if entry.value == nil {
+				out = append(out, "null"...)
+			} else {
+				out = appendJSONBinary(out, entry.value)
+			}
+		}
+	}
+	out = append(out, '}')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Child) JSONSize() (int, error) {
+	size := 19
+	size += jsonIntSize(int64(o.Value))
+	size += 2
+	if len(o.Blobs) > 1 {
+		size += len(o.Blobs) - 1
+	}
+	for k, v := range o.Blobs {
+		size += jsonStringSize(string(k)) + 1
+		if v == nil {
+			size += 4
+		} else {
+			size += base64.StdEncoding.EncodedLen(len(v)) + 2
+		}
+	}
+	return size, nil
+}
+
+func (o Child) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
+}
+
+func (o *Child) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Child
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
+	type ChildAlias Child
+	var rawChild ChildAlias
+	if err := safejson.Unmarshal(data, &rawChild); err != nil {
+		return err
+	}
+	if rawChild.Blobs == nil {
+		rawChild.Blobs = make(map[binary.Binary][]byte, 0)
+	}
+	*o = Child(rawChild)
+	return nil
+}
+
+func (o *Child) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Blobs == nil {
+			o.Blobs = make(map[binary.Binary][]byte, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue, seenBlobs bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if !unmarshalJSONInt(field, &o.Value) {
+				ok = false
+				return false
+			}
+		case "blobs":
+			if seenBlobs {
+				ok = false
+				return false
+			}
+			seenBlobs = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.Blobs = make(map[binary.Binary][]byte)
+				ok1 := true
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k binary.Binary
+					var s string
+					if !unmarshalJSONString(key1, &s) || k.UnmarshalText([]byte(s)) != nil {
+						ok1 = false
+						return false
+					}
+					var v []byte
+					if !unmarshalJSONBinary(elem, &v) {
+						ok1 = false
+						return false
+					}
+					o.Blobs[k] = v
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "value", "blobs") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Blobs == nil {
+		o.Blobs = make(map[binary.Binary][]byte, 0)
+	}
+	return true
+}
+
+func (o *Child) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Child) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Child{}
+	var seenValue, seenBlobs bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			o.Value, err = decodeJSONInt(field)
+			if err != nil {
+				err = fmt.Errorf("field \"value\": %w", err)
+				return false
+			}
+		case "blobs":
+			if seenBlobs {
+				err = fmt.Errorf("duplicate field \"blobs\"")
+				return false
+			}
+			seenBlobs = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"blobs\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.Blobs = make(map[binary.Binary][]byte)
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k binary.Binary
+					k, err = decodeJSONBinaryKey(key1)
+					if err != nil {
+						return false
+					}
+					if _, ok := o.Blobs[k]; ok {
+						err = fmt.Errorf("duplicate map key %s", key1.Raw)
+						return false
+					}
+					var v []byte
+					v, err = decodeJSONBinary(elem)
+					if err != nil {
+						return false
+					}
+					o.Blobs[k] = v
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"blobs\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenValue {
+		return fmt.Errorf("field \"value\" is required")
+	}
+	if o.Blobs == nil {
+		o.Blobs = make(map[binary.Binary][]byte, 0)
+	}
+	return nil
+}
+
+func (o Child) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *Child) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the Child is equal to other according to the Conjure semantics of its values.
+func (o Child) Equal(other Child) bool {
+	if o.Value != other.Value {
+		return false
+	}
+	if len(o.Blobs) != len(other.Blobs) {
+		return false
+	}
+	for k, v := range o.Blobs {
+		otherV, ok := other.Blobs[k]
+		if !ok {
+			return false
+		}
+		if !bytes.Equal(v, otherV) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Child.
+func (o Child) Clone() Child {
+	out := o
+	if o.Blobs != nil {
+		out.Blobs = make(map[binary.Binary][]byte, len(o.Blobs))
+		for k, v := range o.Blobs {
+			var elem []byte
+			if v != nil {
+				elem = make([]byte, len(v))
+				copy(elem, v)
+			}
+			out.Blobs[k] = elem
+		}
+	}
+	return out
+}
+
+func (o Child) AppendCBOR(out []byte) ([]byte, error) {
+	out = append(out, "\xa2evalue"...)
+	out = appendCBORInt(out, int64(o.Value))
+	out = append(out, "eblobs"...)
+	out = appendCBORHead(out, 5, uint64(len(o.Blobs)))
+	for k, v := range o.Blobs {
+		out = appendCBORString(out, string(k))
+		out = appendCBORBytes(out, v)
+	}
+	return out, nil
+}
+
+func (o *Child) decodeCBOR(r *cborReader) error {
+	*o = Child{}
+	if err := r.decodeMap(func() error {
+		var key string
+		if err := r.decodeString(&key); err != nil {
+			return err
+		}
+		switch key {
+		case "value":
+			if err := r.decodeInt(&o.Value); err != nil {
+				return err
+			}
+		case "blobs":
+			o.Blobs = make(map[binary.Binary][]byte)
+			if err := r.decodeMap(func() error {
+				var k binary.Binary
+				if err := r.decodeString((*string)(&k)); err != nil {
+					return err
+				}
+				var v []byte
+				if err := r.decodeBinary(&v); err != nil {
+					return err
+				}
+				o.Blobs[k] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		default:
+			return r.skip()
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if o.Blobs == nil {
+		o.Blobs = make(map[binary.Binary][]byte, 0)
+	}
+	return nil
+}
+
+func (o Child) MarshalCBOR() ([]byte, error) {
+	return o.AppendCBOR(nil)
+}
+
+func (o *Child) UnmarshalCBOR(data []byte) error {
+	r := cborReader{data: data}
+	if err := o.decodeCBOR(&r); err != nil {
+		return err
+	}
+	return r.end()
+}
+
+type Empty struct{}
+
+func (o Empty) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{}"...)
+	return out, nil
+}
+
+func (o Empty) JSONSize() (int, error) {
+	size := 2
+	return size, nil
+}
+
+func (o *Empty) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	return value.IsObject()
+}
+
+func (o *Empty) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Empty) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Empty{}
+	var err error
+	value.ForEach(func(key, _ gjson.Result) bool {
+		err = fmt.Errorf("unknown field %q", key.Str)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o Empty) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *Empty) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the Empty is equal to other according to the Conjure semantics of its values.
+func (o Empty) Equal(other Empty) bool {
+	return true
+}
+
+// Clone returns a deep copy of the Empty.
+func (o Empty) Clone() Empty {
+	out := o
+	return out
+}
+
+func (o Empty) AppendCBOR(out []byte) ([]byte, error) {
+	out = append(out, "\xa0"...)
+	return out, nil
+}
+
+func (o *Empty) decodeCBOR(r *cborReader) error {
+	*o = Empty{}
+	return r.decodeMap(func() error {
+		if err := r.skip(); err != nil {
+			return err
+		}
+		return r.skip()
+	})
+}
+
+func (o Empty) MarshalCBOR() ([]byte, error) {
+	return o.AppendCBOR(nil)
+}
+
+func (o *Empty) UnmarshalCBOR(data []byte) error {
+	r := cborReader{data: data}
+	if err := o.decodeCBOR(&r); err != nil {
+		return err
+	}
+	return r.end()
+}
+
+type Record struct {
+	Name          string                     `json:"name"`
+	Count         int                        `json:"count"`
+	Total         safelong.SafeLong          `json:"total"`
+	Ratio         float64                    `json:"ratio"`
+	Enabled       bool                       `json:"enabled"`
+	Id            uuid.UUID                  `json:"id"`
+	Created       datetime.DateTime          `json:"created"`
+	Resource      rid.ResourceIdentifier     `json:"resource"`
+	Token         bearertoken.Token          `json:"token"`
+	Payload       []byte                     `json:"payload"`
+	Extra         interface{}                `json:"extra"`
+	Nickname      *string                    `json:"nickname"`
+	Tags          []string                   `json:"tags"`
+	Labels        []string                   `json:"labels"`
+	Scores        map[string]float64         `json:"scores"`
+	ColorCounts   map[Color]int              `json:"colorCounts"`
+	Flags         map[boolean.Boolean]string `json:"flags"`
+	Children      []Child                    `json:"children"`
+	Shape         *Shape                     `json:"shape"`
+	Color         Color                      `json:"color"`
+	Alias         Name                       `json:"alias"`
+	OptionalAlias OptionalName               `json:"optionalAlias"`
+}
+
+func (o Record) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"name\":"...)
+	out = appendJSONString(out, o.Name)
+	out = append(out, ",\"count\":"...)
+	out = strconv.AppendInt(out, int64(o.Count), 10)
+	out = append(out, ",\"total\":"...)
+	if _, err := safelong.NewSafeLong(int64(o.Total)); err != nil {
+		return nil, err
+	}
+	out = strconv.AppendInt(out, int64(o.Total), 10)
+	out = append(out, ",\"ratio\":"...)
+	out = appendJSONFloat64(out, o.Ratio)
+	out = append(out, ",\"enabled\":"...)
+	out = strconv.AppendBool(out, o.Enabled)
+	out = append(out, ",\"id\":"...)
+	out = appendJSONUUID(out, o.Id)
+	out = append(out, ",\"created\":"...)
+	out = appendJSONDateTime(out, o.Created)
+	out = append(out, ",\"resource\":"...)
+	out, err = appendJSONText(out, o.Resource)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"token\":"...)
+	out = appendJSONString(out, string(o.Token))
+	out = append(out, ",\"payload\":"...)
+	if o.Payload == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONBinary(out, o.Payload)
+	}
+	out = append(out, ",\"extra\":"...)
+	out, err = appendJSONMarshal(out, o.Extra)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"nickname\":"...)
+	if o.Nickname == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, *o.Nickname)
+	}
+	out = append(out, ",\"tags\":"...)
+	out = append(out, '[')
+	for i, v := range o.Tags {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
+	}
+	out = append(out, ']')
+	out = append(out, ",\"labels\":"...)
+	out = append(out, '[')
+	for i, v := range o.Labels {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
+	}
+	out = append(out, ']')
+	out = append(out, ",\"scores\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value float64
+		}
+		entries := make([]mapEntry, 0, len(o.Scores))
+		for k, v := range o.Scores {
+			entries = append(entries, mapEntry{key: k, value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = appendJSONFloat64(out, entry.value)
+		}
+	}
+	out = append(out, '}')
+	out = append(out, ",\"colorCounts\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value int
+		}
+		entries := make([]mapEntry, 0, len(o.ColorCounts))
+		for k, v := range o.ColorCounts {
+			entries = append(entries, mapEntry{key: k.String(), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = strconv.AppendInt(out, int64(entry.value), 10)
+		}
+	}
+	out = append(out, '}')
+	out = append(out, ",\"flags\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value string
+		}
+		entries := make([]mapEntry, 0, len(o.Flags))
+		for k, v := range o.Flags {
+			entries = append(entries, mapEntry{key: strconv.FormatBool(bool(k)), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = appendJSONString(out, entry.value)
+		}
+	}
+	out = append(out, '}')
+	out = append(out, ",\"children\":"...)
+	out = append(out, '[')
+	for i, v := range o.Children {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	out = append(out, ",\"shape\":"...)
+	if o.Shape == nil {
+		out = append(out, "null"...)
+	} else {
+		out, err = o.Shape.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ",\"color\":"...)
+	out, err = o.Color.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"alias\":"...)
+	out = appendJSONString(out, string(o.Alias))
+	out = append(out, ",\"optionalAlias\":"...)
+	out, err = o.OptionalAlias.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Record) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 225
+	size += jsonStringSize(o.Name)
+	size += jsonIntSize(int64(o.Count))
+	if _, err := safelong.NewSafeLong(int64(o.Total)); err != nil {
+		return 0, err
+	}
+	size += jsonIntSize(int64(o.Total))
+	size += jsonFloat64Size(o.Ratio)
+	if o.Enabled {
+		size += 4
+	} else {
+		size += 5
+	}
+	size += 38
+	size += jsonDateTimeSize(o.Created)
+	n, err = jsonTextSize(o.Resource)
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	size += jsonStringSize(string(o.Token))
+	if o.Payload == nil {
+		size += 4
+	} else {
+		size += base64.StdEncoding.EncodedLen(len(o.Payload)) + 2
+	}
+	n, err = jsonMarshalSize(o.Extra)
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	if o.Nickname == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(*o.Nickname)
+	}
+	size += 2
+	if len(o.Tags) > 1 {
+		size += len(o.Tags) - 1
+	}
+	for _, v := range o.Tags {
+		size += jsonStringSize(v)
+	}
+	size += 2
+	if len(o.Labels) > 1 {
+		size += len(o.Labels) - 1
+	}
+	for _, v := range o.Labels {
+		size += jsonStringSize(v)
+	}
+	size += 2
+	if len(o.Scores) > 1 {
+		size += len(o.Scores) - 1
+	}
+	for k, v := range o.Scores {
+		size += jsonStringSize(k) + 1
+		size += jsonFloat64Size(v)
+	}
+	size += 2
+	if len(o.ColorCounts) > 1 {
+		size += len(o.ColorCounts) - 1
+	}
+	for k, v := range o.ColorCounts {
+		size += jsonStringSize(k.String()) + 1
+		size += jsonIntSize(int64(v))
+	}
+	size += 2
+	if len(o.Flags) > 1 {
+		size += len(o.Flags) - 1
+	}
+	for k, v := range o.Flags {
+		size += jsonStringSize(strconv.FormatBool(bool(k))) + 1
+		size += jsonStringSize(v)
+	}
+	size += 2
+	if len(o.Children) > 1 {
+		size += len(o.Children) - 1
+	}
+	for _, v := range o.Children {
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	if o.Shape == nil {
+		size += 4
+	} else {
+		n, err = o.Shape.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	n, err = o.Color.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	size += jsonStringSize(string(o.Alias))
+	n, err = o.OptionalAlias.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (o Record) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
+}
+
+func (o *Record) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Record
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
+	type RecordAlias Record
+	var rawRecord RecordAlias
+	if err := safejson.Unmarshal(data, &rawRecord); err != nil {
+		return err
+	}
+	if rawRecord.Tags == nil {
+		rawRecord.Tags = make([]string, 0)
+	}
+	if rawRecord.Labels == nil {
+		rawRecord.Labels = make([]string, 0)
+	}
+	if rawRecord.Scores == nil {
+		rawRecord.Scores = make(map[string]float64, 0)
+	}
+	if rawRecord.ColorCounts == nil {
+		rawRecord.ColorCounts = make(map[Color]int, 0)
+	}
+	if rawRecord.Flags == nil {
+		rawRecord.Flags = make(map[boolean.Boolean]string, 0)
+	}
+	if rawRecord.Children == nil {
+		rawRecord.Children = make([]Child, 0)
+	}
+	*o = Record(rawRecord)
+	return nil
+}
+
+func (o *Record) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Tags == nil {
+			o.Tags = make([]string, 0)
+		}
+		if o.Labels == nil {
+			o.Labels = make([]string, 0)
+		}
+		if o.Scores == nil {
+			o.Scores = make(map[string]float64, 0)
+		}
+		if o.ColorCounts == nil {
+			o.ColorCounts = make(map[Color]int, 0)
+		}
+		if o.Flags == nil {
+			o.Flags = make(map[boolean.Boolean]string, 0)
+		}
+		if o.Children == nil {
+			o.Children = make([]Child, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenName, seenCount, seenTotal, seenRatio, seenEnabled, seenId, seenCreated, seenResource, seenToken, seenPayload, seenExtra, seenNickname, seenTags, seenLabels, seenScores, seenColorCounts, seenFlags, seenChildren, seenShape, seenColor, seenAlias, seenOptionalAlias bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "name":
+			if seenName {
+				ok = false
+				return false
+			}
+			seenName = true
+			if !unmarshalJSONString(field, &o.Name) {
+				ok = false
+				return false
+			}
+		case "count":
+			if seenCount {
+				ok = false
+				return false
+			}
+			seenCount = true
+			if !unmarshalJSONInt(field, &o.Count) {
+				ok = false
+				return false
+			}
+		case "total":
+			if seenTotal {
+				ok = false
+				return false
+			}
+			seenTotal = true
+			if !unmarshalJSONSafeLong(field, &o.Total) {
+				ok = false
+				return false
+			}
+		case "ratio":
+			if seenRatio {
+				ok = false
+				return false
+			}
+			seenRatio = true
+			if !unmarshalJSONFloat64(field, &o.Ratio) {
+				ok = false
+				return false
+			}
+		case "enabled":
+			if seenEnabled {
+				ok = false
+				return false
+			}
+			seenEnabled = true
+			if !unmarshalJSONBool(field, &o.Enabled) {
+				ok = false
+				return false
+			}
+		case "id":
+			if seenId {
+				ok = false
+				return false
+			}
+			seenId = true
+			if field.Type != gjson.Null {
+				var s string
+				if !unmarshalJSONString(field, &s) || o.Id.UnmarshalText([]byte(s)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "created":
+			if seenCreated {
+				ok = false
+				return false
+			}
+			seenCreated = true
+			if field.Type != gjson.Null {
+				var s1 string
+				if !unmarshalJSONString(field, &s1) || o.Created.UnmarshalText([]byte(s1)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "resource":
+			if seenResource {
+				ok = false
+				return false
+			}
+			seenResource = true
+			if field.Type != gjson.Null {
+				var s2 string
+				if !unmarshalJSONString(field, &s2) || o.Resource.UnmarshalText([]byte(s2)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "token":
+			if seenToken {
+				ok = false
+				return false
+			}
+			seenToken = true
+			if field.Type != gjson.Null {
+				var s3 string
+				if !unmarshalJSONString(field, &s3) || o.Token.UnmarshalText([]byte(s3)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "payload":
+			if seenPayload {
+				ok = false
+				return false
+			}
+			seenPayload = true
+			if !unmarshalJSONBinary(field, &o.Payload) {
+				ok = false
+				return false
+			}
+		case "extra":
+			if seenExtra {
+				ok = false
+				return false
+			}
+			seenExtra = true
+			if !unmarshalJSONAny(field, &o.Extra) {
+				ok = false
+				return false
+			}
+		case "nickname":
+			if seenNickname {
+				ok = false
+				return false
+			}
+			seenNickname = true
+			if field.Type != gjson.Null {
+				var v string
+				if !unmarshalJSONString(field, &v) {
+					ok = false
+					return false
+				}
+				o.Nickname = &v
+			}
+		case "tags":
+			if seenTags {
+				ok = false
+				return false
+			}
+			seenTags = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Tags = make([]string, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v1 string
+					if !unmarshalJSONString(elem, &v1) {
+						ok1 = false
+						return false
+					}
+					o.Tags = append(o.Tags, v1)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "labels":
+			if seenLabels {
+				ok = false
+				return false
+			}
+			seenLabels = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Labels = make([]string, 0)
+				ok2 := true
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v2 string
+					if !unmarshalJSONString(elem1, &v2) {
+						ok2 = false
+						return false
+					}
+					o.Labels = append(o.Labels, v2)
+					return true
+				})
+				if !ok2 {
+					ok = false
+					return false
+				}
+			}
+		case "scores":
+			if seenScores {
+				ok = false
+				return false
+			}
+			seenScores = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.Scores = make(map[string]float64)
+				ok3 := true
+				field.ForEach(func(key1, elem2 gjson.Result) bool {
+					var k string
+					if !unmarshalJSONString(key1, &k) {
+						ok3 = false
+						return false
+					}
+					var v3 float64
+					if !unmarshalJSONFloat64(elem2, &v3) {
+						ok3 = false
+						return false
+					}
+					o.Scores[k] = v3
+					return true
+				})
+				if !ok3 {
+					ok = false
+					return false
+				}
+			}
+		case "colorCounts":
+			if seenColorCounts {
+				ok = false
+				return false
+			}
+			seenColorCounts = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.ColorCounts = make(map[Color]int)
+				ok4 := true
+				field.ForEach(func(key2, elem3 gjson.Result) bool {
+					var k1 Color
+					var s4 string
+					if !unmarshalJSONString(key2, &s4) || k1.UnmarshalText([]byte(s4)) != nil {
+						ok4 = false
+						return false
+					}
+					var v4 int
+					if !unmarshalJSONInt(elem3, &v4) {
+						ok4 = false
+						return false
+					}
+					o.ColorCounts[k1] = v4
+					return true
+				})
+				if !ok4 {
+					ok = false
+					return false
+				}
+			}
+		case "flags":
+			if seenFlags {
+				ok = false
+				return false
+			}
+			seenFlags = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.Flags = make(map[boolean.Boolean]string)
+				ok5 := true
+				field.ForEach(func(key3, elem4 gjson.Result) bool {
+					var k2 boolean.Boolean
+					var s5 string
+					if !unmarshalJSONString(key3, &s5) || k2.UnmarshalText([]byte(s5)) != nil {
+						ok5 = false
+						return false
+					}
+					var v5 string
+					if !unmarshalJSONString(elem4, &v5) {
+						ok5 = false
+						return false
+					}
+					o.Flags[k2] = v5
+					return true
+				})
+				if !ok5 {
+					ok = false
+					return false
+				}
+			}
+		case "children":
+			if seenChildren {
+				ok = false
+				return false
+			}
+			seenChildren = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Children = make([]Child, 0)
+				ok6 := true
+				field.ForEach(func(_, elem5 gjson.Result) bool {
+					var v6 Child
+					if !v6.unmarshalJSONValue(elem5) {
+						ok6 = false
+						return false
+					}
+					o.Children = append(o.Children, v6)
+					return true
+				})
+				if !ok6 {
+					ok = false
+					return false
+				}
+			}
+		case "shape":
+			if seenShape {
+				ok = false
+				return false
+			}
+			seenShape = true
+			if field.Type != gjson.Null {
+				var v7 Shape
+				if !v7.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				o.Shape = &v7
+			}
+		case "color":
+			if seenColor {
+				ok = false
+				return false
+			}
+			seenColor = true
+			if field.Type != gjson.Null {
+				var s6 string
+				if !unmarshalJSONString(field, &s6) || o.Color.UnmarshalText([]byte(s6)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "alias":
+			if seenAlias {
+				ok = false
+				return false
+			}
+			seenAlias = true
+			var v8 string
+			if !unmarshalJSONString(field, &v8) {
+				ok = false
+				return false
+			}
+			o.Alias = Name(v8)
+		case "optionalAlias":
+			if seenOptionalAlias {
+				ok = false
+				return false
+			}
+			seenOptionalAlias = true
+			if field.Type != gjson.Null {
+				var s7 string
+				if !unmarshalJSONString(field, &s7) || o.OptionalAlias.UnmarshalText([]byte(s7)) != nil {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "name", "count", "total", "ratio", "enabled", "id", "created", "resource", "token", "payload", "extra", "nickname", "tags", "labels", "scores", "colorCounts", "flags", "children", "shape", "color", "alias", "optionalAlias") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Tags == nil {
+		o.Tags = make([]string, 0)
+	}
+	if o.Labels == nil {
+		o.Labels = make([]string, 0)
+	}
+	if o.Scores == nil {
+		o.Scores = make(map[string]float64, 0)
+	}
+	if o.ColorCounts == nil {
+		o.ColorCounts = make(map[Color]int, 0)
+	}
+	if o.Flags == nil {
+		o.Flags = make(map[boolean.Boolean]string, 0)
+	}
+	if o.Children == nil {
+		o.Children = make([]Child, 0)
+	}
+	return true
+}
+
+func (o *Record) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Record) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Record{}
+	var seenName, seenCount, seenTotal, seenRatio, seenEnabled, seenId, seenCreated, seenResource, seenToken, seenPayload, seenExtra, seenNickname, seenTags, seenLabels, seenScores, seenColorCounts, seenFlags, seenChildren, seenShape, seenColor, seenAlias, seenOptionalAlias bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "name":
+			if seenName {
+				err = fmt.Errorf("duplicate field \"name\"")
+				return false
+			}
+			seenName = true
+			o.Name, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"name\": %w", err)
+				return false
+			}
+		case "count":
+			if seenCount {
+				err = fmt.Errorf("duplicate field \"count\"")
+				return false
+			}
+			seenCount = true
+			o.Count, err = decodeJSONInt(field)
+			if err != nil {
+				err = fmt.Errorf("field \"count\": %w", err)
+				return false
+			}
+		case "total":
+			if seenTotal {
+				err = fmt.Errorf("duplicate field \"total\"")
+				return false
+			}
+			seenTotal = true
+			o.Total, err = decodeJSONSafeLong(field)
+			if err != nil {
+				err = fmt.Errorf("field \"total\": %w", err)
+				return false
+			}
+		case "ratio":
+			if seenRatio {
+				err = fmt.Errorf("duplicate field \"ratio\"")
+				return false
+			}
+			seenRatio = true
+			o.Ratio, err = decodeJSONFloat64(field)
+			if err != nil {
+				err = fmt.Errorf("field \"ratio\": %w", err)
+				return false
+			}
+		case "enabled":
+			if seenEnabled {
+				err = fmt.Errorf("duplicate field \"enabled\"")
+				return false
+			}
+			seenEnabled = true
+			o.Enabled, err = decodeJSONBool(field)
+			if err != nil {
+				err = fmt.Errorf("field \"enabled\": %w", err)
+				return false
+			}
+		case "id":
+			if seenId {
+				err = fmt.Errorf("duplicate field \"id\"")
+				return false
+			}
+			seenId = true
+			o.Id, err = decodeJSONUUID(field)
+			if err != nil {
+				err = fmt.Errorf("field \"id\": %w", err)
+				return false
+			}
+		case "created":
+			if seenCreated {
+				err = fmt.Errorf("duplicate field \"created\"")
+				return false
+			}
+			seenCreated = true
+			o.Created, err = decodeJSONDateTime(field)
+			if err != nil {
+				err = fmt.Errorf("field \"created\": %w", err)
+				return false
+			}
+		case "resource":
+			if seenResource {
+				err = fmt.Errorf("duplicate field \"resource\"")
+				return false
+			}
+			seenResource = true
+			o.Resource, err = decodeJSONRID(field)
+			if err != nil {
+				err = fmt.Errorf("field \"resource\": %w", err)
+				return false
+			}
+		case "token":
+			if seenToken {
+				err = fmt.Errorf("duplicate field \"token\"")
+				return false
+			}
+			seenToken = true
+			o.Token, err = decodeJSONBearerToken(field)
+			if err != nil {
+				err = fmt.Errorf("field \"token\": %w", err)
+				return false
+			}
+		case "payload":
+			if seenPayload {
+				err = fmt.Errorf("duplicate field \"payload\"")
+				return false
+			}
+			seenPayload = true
+			o.Payload, err = decodeJSONBinary(field)
+			if err != nil {
+				err = fmt.Errorf("field \"payload\": %w", err)
+				return false
+			}
+		case "extra":
+			if seenExtra {
+				err = fmt.Errorf("duplicate field \"extra\"")
+				return false
+			}
+			seenExtra = true
+			o.Extra, err = decodeJSONAny(field)
+			if err != nil {
+				err = fmt.Errorf("field \"extra\": %w", err)
+				return false
+			}
+		case "nickname":
+			if seenNickname {
+				err = fmt.Errorf("duplicate field \"nickname\"")
+				return false
+			}
+			seenNickname = true
+			if field.Type != gjson.Null {
+				var v string
+				v, err = decodeJSONString(field)
+				if err != nil {
+					err = fmt.Errorf("field \"nickname\": %w", err)
+					return false
+				}
+				o.Nickname = &v
+			}
+		case "tags":
+			if seenTags {
+				err = fmt.Errorf("duplicate field \"tags\"")
+				return false
+			}
+			seenTags = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"tags\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Tags = make([]string, 0)
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v1 string
+					v1, err = decodeJSONString(elem)
+					if err != nil {
+						return false
+					}
+					o.Tags = append(o.Tags, v1)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"tags\": %w", err)
+					return false
+				}
+			}
+		case "labels":
+			if seenLabels {
+				err = fmt.Errorf("duplicate field \"labels\"")
+				return false
+			}
+			seenLabels = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"labels\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Labels = make([]string, 0)
+				seen := make(map[string]struct{})
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v2 string
+					v2, err = decodeJSONString(elem1)
+					if err != nil {
+						return false
+					}
+					if _, ok := seen[v2]; ok {
+						err = fmt.Errorf("duplicate set element %s", elem1.Raw)
+						return false
+					}
+					seen[v2] = struct{}{}
+					o.Labels = append(o.Labels, v2)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"labels\": %w", err)
+					return false
+				}
+			}
+		case "scores":
+			if seenScores {
+				err = fmt.Errorf("duplicate field \"scores\"")
+				return false
+			}
+			seenScores = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"scores\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.Scores = make(map[string]float64)
+				field.ForEach(func(key1, elem2 gjson.Result) bool {
+					var k string
+					k = key1.Str
+					if _, ok := o.Scores[k]; ok {
+						err = fmt.Errorf("duplicate map key %s", key1.Raw)
+						return false
+					}
+					var v3 float64
+					v3, err = decodeJSONFloat64(elem2)
+					if err != nil {
+						return false
+					}
+					o.Scores[k] = v3
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"scores\": %w", err)
+					return false
+				}
+			}
+		case "colorCounts":
+			if seenColorCounts {
+				err = fmt.Errorf("duplicate field \"colorCounts\"")
+				return false
+			}
+			seenColorCounts = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"colorCounts\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.ColorCounts = make(map[Color]int)
+				field.ForEach(func(key2, elem3 gjson.Result) bool {
+					var k1 Color
+					err = k1.decodeJSONStrict(key2)
+					if err != nil {
+						return false
+					}
+					if _, ok := o.ColorCounts[k1]; ok {
+						err = fmt.Errorf("duplicate map key %s", key2.Raw)
+						return false
+					}
+					var v4 int
+					v4, err = decodeJSONInt(elem3)
+					if err != nil {
+						return false
+					}
+					o.ColorCounts[k1] = v4
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"colorCounts\": %w", err)
+					return false
+				}
+			}
+		case "flags":
+			if seenFlags {
+				err = fmt.Errorf("duplicate field \"flags\"")
+				return false
+			}
+			seenFlags = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"flags\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.Flags = make(map[boolean.Boolean]string)
+				field.ForEach(func(key3, elem4 gjson.Result) bool {
+					var k2 boolean.Boolean
+					k2, err = decodeJSONBooleanKey(key3)
+					if err != nil {
+						return false
+					}
+					if _, ok := o.Flags[k2]; ok {
+						err = fmt.Errorf("duplicate map key %s", key3.Raw)
+						return false
+					}
+					var v5 string
+					v5, err = decodeJSONString(elem4)
+					if err != nil {
+						return false
+					}
+					o.Flags[k2] = v5
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"flags\": %w", err)
+					return false
+				}
+			}
+		case "children":
+			if seenChildren {
+				err = fmt.Errorf("duplicate field \"children\"")
+				return false
+			}
+			seenChildren = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"children\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Children = make([]Child, 0)
+				field.ForEach(func(_, elem5 gjson.Result) bool {
+					var v6 Child
+					err = v6.decodeJSONStrict(elem5)
+					if err != nil {
+						return false
+					}
+					o.Children = append(o.Children, v6)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"children\": %w", err)
+					return false
+				}
+			}
+		case "shape":
+			if seenShape {
+				err = fmt.Errorf("duplicate field \"shape\"")
+				return false
+			}
+			seenShape = true
+			if field.Type != gjson.Null {
+				var v7 Shape
+				err = v7.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"shape\": %w", err)
+					return false
+				}
+				o.Shape = &v7
+			}
+		case "color":
+			if seenColor {
+				err = fmt.Errorf("duplicate field \"color\"")
+				return false
+			}
+			seenColor = true
+			err = o.Color.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"color\": %w", err)
+				return false
+			}
+		case "alias":
+			if seenAlias {
+				err = fmt.Errorf("duplicate field \"alias\"")
+				return false
+			}
+			seenAlias = true
+			err = o.Alias.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"alias\": %w", err)
+				return false
+			}
+		case "optionalAlias":
+			if seenOptionalAlias {
+				err = fmt.Errorf("duplicate field \"optionalAlias\"")
+				return false
+			}
+			seenOptionalAlias = true
+			err = o.OptionalAlias.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"optionalAlias\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenName {
+		return fmt.Errorf("field \"name\" is required")
+	}
+	if !seenCount {
+		return fmt.Errorf("field \"count\" is required")
+	}
+	if !seenTotal {
+		return fmt.Errorf("field \"total\" is required")
+	}
+	if !seenRatio {
+		return fmt.Errorf("field \"ratio\" is required")
+	}
+	if !seenEnabled {
+		return fmt.Errorf("field \"enabled\" is required")
+	}
+	if !seenId {
+		return fmt.Errorf("field \"id\" is required")
+	}
+	if !seenCreated {
+		return fmt.Errorf("field \"created\" is required")
+	}
+	if !seenResource {
+		return fmt.Errorf("field \"resource\" is required")
+	}
+	if !seenToken {
+		return fmt.Errorf("field \"token\" is required")
+	}
+	if !seenPayload {
+		return fmt.Errorf("field \"payload\" is required")
+	}
+	if !seenExtra {
+		return fmt.Errorf("field \"extra\" is required")
+	}
+	if o.Tags == nil {
+		o.Tags = make([]string, 0)
+	}
+	if o.Labels == nil {
+		o.Labels = make([]string, 0)
+	}
+	if o.Scores == nil {
+		o.Scores = make(map[string]float64, 0)
+	}
+	if o.ColorCounts == nil {
+		o.ColorCounts = make(map[Color]int, 0)
+	}
+	if o.Flags == nil {
+		o.Flags = make(map[boolean.Boolean]string, 0)
+	}
+	if o.Children == nil {
+		o.Children = make([]Child, 0)
+	}
+	if !seenColor {
+		return fmt.Errorf("field \"color\" is required")
+	}
+	if !seenAlias {
+		return fmt.Errorf("field \"alias\" is required")
+	}
+	return nil
+}
+
+func (o Record) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *Record) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the Record is equal to other according to the Conjure semantics of its values.
+func (o Record) Equal(other Record) bool {
+	if o.Name != other.Name {
+		return false
+	}
+	if o.Count != other.Count {
+		return false
+	}
+	if o.Total != other.Total {
+		return false
+	}
+	if !(o.Ratio == other.Ratio || math.IsNaN(o.Ratio) && math.IsNaN(other.Ratio)) {
+		return false
+	}
+	if o.Enabled != other.Enabled {
+		return false
+	}
+	if o.Id != other.Id {
+		return false
+	}
+	if !time.Time(o.Created).Equal(time.Time(other.Created)) {
+		return false
+	}
+	if o.Resource != other.Resource {
+		return false
+	}
+	if o.Token != other.Token {
+		return false
+	}
+	if !bytes.Equal(o.Payload, other.Payload) {
+		return false
+	}
+	if !reflect.DeepEqual(o.Extra, other.Extra) {
+		return false
+	}
+	if (o.Nickname == nil) != (other.Nickname == nil) {
+		return false
+	}
+	if o.Nickname != nil {
+		if *o.Nickname != *other.Nickname {
+			return false
+		}
+	}
+	if len(o.Tags) != len(other.Tags) {
+		return false
+	}
+	for i := range o.Tags {
+		if o.Tags[i] != other.Tags[i] {
+			return false
+		}
+	}
+	if !func() bool {
+		if len(o.Labels) != len(other.Labels) {
+			return false
+		}
+		counts := make(map[string]int, len(o.Labels))
+		for _, v := range o.Labels {
+			counts[v]++
+		}
+		for _, v := range other.Labels {
+			if counts[v] == 0 {
+				return false
+			}
+			counts[v]--
+		}
+		return true
+	}() {
+		return false
+	}
+	if len(o.Scores) != len(other.Scores) {
+		return false
+	}
+	for k, v := range o.Scores {
+		otherV, ok := other.Scores[k]
+		if !ok {
+			return false
+		}
+		if !(v == otherV || math.IsNaN(v) && math.IsNaN(otherV)) {
+			return false
+		}
+	}
+	if len(o.ColorCounts) != len(other.ColorCounts) {
+		return false
+	}
+	for k, v := range o.ColorCounts {
+		otherV, ok := other.ColorCounts[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	if len(o.Flags) != len(other.Flags) {
+		return false
+	}
+	for k, v := range o.Flags {
+		otherV, ok := other.Flags[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	if len(o.Children) != len(other.Children) {
+		return false
+	}
+	for i := range o.Children {
+		if !o.Children[i].Equal(other.Children[i]) {
+			return false
+		}
+	}
+	if (o.Shape == nil) != (other.Shape == nil) {
+		return false
+	}
+	if o.Shape != nil {
+		if !(*o.Shape).Equal(*other.Shape) {
+			return false
+		}
+	}
+	if !o.Color.Equal(other.Color) {
+		return false
+	}
+	if !o.Alias.Equal(other.Alias) {
+		return false
+	}
+	if !o.OptionalAlias.Equal(other.OptionalAlias) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Record.
+func (o Record) Clone() Record {
+	out := o
+	if o.Payload != nil {
+		out.Payload = make([]byte, len(o.Payload))
+		copy(out.Payload, o.Payload)
+	}
+	if o.Nickname != nil {
+		v := *o.Nickname
+		out.Nickname = &v
+	}
+	if o.Tags != nil {
+		out.Tags = make([]string, len(o.Tags))
+		copy(out.Tags, o.Tags)
+	}
+	if o.Labels != nil {
+		out.Labels = make([]string, len(o.Labels))
+		copy(out.Labels, o.Labels)
+	}
+	if o.Scores != nil {
+		out.Scores = make(map[string]float64, len(o.Scores))
+		for k, v := range o.Scores {
+			out.Scores[k] = v
+		}
+	}
+	if o.ColorCounts != nil {
+		out.ColorCounts = make(map[Color]int, len(o.ColorCounts))
+		for k, v := range o.ColorCounts {
+			out.ColorCounts[k] = v
+		}
+	}
+	if o.Flags != nil {
+		out.Flags = make(map[boolean.Boolean]string, len(o.Flags))
+		for k, v := range o.Flags {
+			out.Flags[k] = v
+		}
+	}
+	if o.Children != nil {
+		out.Children = make([]Child, len(o.Children))
+		for i := range o.Children {
+			out.Children[i] = o.Children[i].Clone()
+		}
+	}
+	if o.Shape != nil {
+		v := (*o.Shape).Clone()
+		out.Shape = &v
+	}
+	out.OptionalAlias = o.OptionalAlias.Clone()
+	return out
+}
+
+func (o Record) AppendCBOR(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "\xb6dname"...)
+	out = appendCBORString(out, o.Name)
+	out = append(out, "ecount"...)
+	out = appendCBORInt(out, int64(o.Count))
+	out = append(out, "etotal"...)
+	if _, err := safelong.NewSafeLong(int64(o.Total)); err != nil {
+		return nil, err
+	}
+	out = appendCBORInt(out, int64(o.Total))
+	out = append(out, "eratio"...)
+	out = appendCBORFloat64(out, o.Ratio)
+	out = append(out, "genabled"...)
+	out = appendCBORBool(out, o.Enabled)
+	out = append(out, "bid"...)
+	out = appendCBORString(out, o.Id.String())
+	out = append(out, "gcreated"...)
+	out = appendCBORString(out, o.Created.String())
+	out = append(out, "hresource"...)
+	out = appendCBORString(out, o.Resource.String())
+	out = append(out, "etoken"...)
+	out = appendCBORString(out, string(o.Token))
+	out = append(out, "gpayload"...)
+	out = appendCBORBytes(out, o.Payload)
+	out = append(out, "eextra"...)
+	out, err = appendCBORAny(out, o.Extra)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, "hnickname"...)
+	if o.Nickname == nil {
+		out = append(out, "\xf6"...)
+	} else {
+		out = appendCBORString(out, *o.Nickname)
+	}
+	out = append(out, "dtags"...)
+	out = appendCBORHead(out, 4, uint64(len(o.Tags)))
+	for _, v := range o.Tags {
+		out = appendCBORString(out, v)
+	}
+	out = append(out, "flabels"...)
+	out = appendCBORHead(out, 4, uint64(len(o.Labels)))
+	for _, v := range o.Labels {
+		out = appendCBORString(out, v)
+	}
+	out = append(out, "fscores"...)
+	out = appendCBORHead(out, 5, uint64(len(o.Scores)))
+	for k, v := range o.Scores {
+		out = appendCBORString(out, k)
+		out = appendCBORFloat64(out, v)
+	}
+	out = append(out, "kcolorCounts"...)
+	out = appendCBORHead(out, 5, uint64(len(o.ColorCounts)))
+	for k, v := range o.ColorCounts {
+		out, err = k.AppendCBOR(out)
+		if err != nil {
+			return nil, err
+		}
+		out = appendCBORInt(out, int64(v))
+	}
+	out = append(out, "eflags"...)
+	out = appendCBORHead(out, 5, uint64(len(o.Flags)))
+	for k, v := range o.Flags {
+		out = appendCBORBool(out, bool(k))
+		out = appendCBORString(out, v)
+	}
+	out = append(out, "hchildren"...)
+	out = appendCBORHead(out, 4, uint64(len(o.Children)))
+	for _, v := range o.Children {
+		out, err = v.AppendCBOR(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, "eshape"...)
+	if o.Shape == nil {
+		out = append(out, "\xf6"...)
+	} else {
+		out, err = o.Shape.AppendCBOR(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, "ecolor"...)
+	out, err = o.Color.AppendCBOR(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, "ealias"...)
+	out, err = o.Alias.AppendCBOR(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, "moptionalAlias"...)
+	out, err = o.OptionalAlias.AppendCBOR(out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (o *Record) decodeCBOR(r *cborReader) error {
+	*o = Record{}
+	if err := r.decodeMap(func() error {
+		var key string
+		if err := r.decodeString(&key); err != nil {
+			return err
+		}
+		switch key {
+		case "name":
+			if err := r.decodeString(&o.Name); err != nil {
+				return err
+			}
+		case "count":
+			if err := r.decodeInt(&o.Count); err != nil {
+				return err
+			}
+		case "total":
+			if err := r.decodeSafeLong(&o.Total); err != nil {
+				return err
+			}
+		case "ratio":
+			if err := r.decodeFloat64(&o.Ratio); err != nil {
+				return err
+			}
+		case "enabled":
+			if err := r.decodeBool(&o.Enabled); err != nil {
+				return err
+			}
+		case "id":
+			if err := r.decodeUUID(&o.Id); err != nil {
+				return err
+			}
+		case "created":
+			if err := r.decodeDateTime(&o.Created); err != nil {
+				return err
+			}
+		case "resource":
+			if err := r.decodeRID(&o.Resource); err != nil {
+				return err
+			}
+		case "token":
+			if err := r.decodeBearerToken(&o.Token); err != nil {
+				return err
+			}
+		case "payload":
+			if err := r.decodeBinary(&o.Payload); err != nil {
+				return err
+			}
+		case "extra":
+			if err := r.decodeAny(&o.Extra); err != nil {
+				return err
+			}
+		case "nickname":
+			if !r.decodeNull() {
+				var opt string
+				if err := r.decodeString(&opt); err != nil {
+					return err
+				}
+				o.Nickname = &opt
+			}
+		case "tags":
+			o.Tags = make([]string, 0)
+			if err := r.decodeArray(func() error {
+				var v string
+				if err := r.decodeString(&v); err != nil {
+					return err
+				}
+				o.Tags = append(o.Tags, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "labels":
+			o.Labels = make([]string, 0)
+			if err := r.decodeArray(func() error {
+				var v string
+				if err := r.decodeString(&v); err != nil {
+					return err
+				}
+				o.Labels = append(o.Labels, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "scores":
+			o.Scores = make(map[string]float64)
+			if err := r.decodeMap(func() error {
+				var k string
+				if err := r.decodeString(&k); err != nil {
+					return err
+				}
+				var v float64
+				if err := r.decodeFloat64(&v); err != nil {
+					return err
+				}
+				o.Scores[k] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "colorCounts":
+			o.ColorCounts = make(map[Color]int)
+			if err := r.decodeMap(func() error {
+				var k Color
+				if err := k.decodeCBOR(r); err != nil {
+					return err
+				}
+				var v int
+				if err := r.decodeInt(&v); err != nil {
+					return err
+				}
+				o.ColorCounts[k] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "flags":
+			o.Flags = make(map[boolean.Boolean]string)
+			if err := r.decodeMap(func() error {
+				var k boolean.Boolean
+				if err := r.decodeBool((*bool)(&k)); err != nil {
+					return err
+				}
+				var v string
+				if err := r.decodeString(&v); err != nil {
+					return err
+				}
+				o.Flags[k] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "children":
+			o.Children = make([]Child, 0)
+			if err := r.decodeArray(func() error {
+				var v Child
+				if err := v.decodeCBOR(r); err != nil {
+					return err
+				}
+				o.Children = append(o.Children, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "shape":
+			if !r.decodeNull() {
+				var opt Shape
+				if err := opt.decodeCBOR(r); err != nil {
+					return err
+				}
+				o.Shape = &opt
+			}
+		case "color":
+			if err := o.Color.decodeCBOR(r); err != nil {
+				return err
+			}
+		case "alias":
+			if err := o.Alias.decodeCBOR(r); err != nil {
+				return err
+			}
+		case "optionalAlias":
+			if err := o.OptionalAlias.decodeCBOR(r); err != nil {
+				return err
+			}
+		default:
+			return r.skip()
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if o.Tags == nil {
+		o.Tags = make([]string, 0)
+	}
+	if o.Labels == nil {
+		o.Labels = make([]string, 0)
+	}
+	if o.Scores == nil {
+		o.Scores = make(map[string]float64, 0)
+	}
+	if o.ColorCounts == nil {
+		o.ColorCounts = make(map[Color]int, 0)
+	}
+	if o.Flags == nil {
+		o.Flags = make(map[boolean.Boolean]string, 0)
+	}
+	if o.Children == nil {
+		o.Children = make([]Child, 0)
+	}
+	return nil
+}
+
+func (o Record) MarshalCBOR() ([]byte, error) {
+	return o.AppendCBOR(nil)
+}
+
+func (o *Record) UnmarshalCBOR(data []byte) error {
+	r := cborReader{data: data}
+	if err := o.decodeCBOR(&r); err != nil {
+		return err
+	}
+	return r.end()
+}
@@ -0,0 +1,695 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
+)
+
+type Shape struct {
+	typ    string
+	circle *float64
+	square *Child
+	name   *Name
+	maybe  **string
+}
+
+type shapeDeserializer struct {
+	Type   string   `json:"type"`
+	Circle *float64 `json:"circle"`
+	Square *Child   `json:"square"`
+	Name   *Name    `json:"name"`
+	Maybe  **string `json:"maybe"`
+}
+
+func (u *shapeDeserializer) toStruct() Shape {
+	return Shape{typ: u.Type, circle: u.Circle, square: u.Square, name: u.Name, maybe: u.Maybe}
+}
+
+func (u Shape) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	switch u.typ {
+	default:
+		return nil, fmt.Errorf("unknown type %q", u.typ)
+	case "circle":
+		if u.circle == nil {
+			return nil, fmt.Errorf("field \"circle\" is required")
+		}
+		out = append(out, "{\"type\":\"circle\",\"circle\":"...)
+		out = appendJSONFloat64(out, *u.circle)
+	case "square":
+		if u.square == nil {
+			return nil, fmt.Errorf("field \"square\" is required")
+		}
+		out = append(out, "{\"type\":\"square\",\"square\":"...)
+		out, err = u.square.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	case "name":
+		if u.name == nil {
+			return nil, fmt.Errorf("field \"name\" is required")
+		}
+		out = append(out, "{\"type\":\"name\",\"name\":"...)
+		out = appendJSONString(out, string(*u.name))
+	case "maybe":
+		var maybe *string
+		if u.maybe != nil {
+			maybe = *u.maybe
+		}
+		out = append(out, "{\"type\":\"maybe\",\"maybe\":"...)
+		if maybe == nil {
+			out = append(out, "null"...)
+		} else {
+			out = appendJSONString(out, *maybe)
+		}
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (u Shape) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	switch u.typ {
+	default:
+		return 0, fmt.Errorf("unknown type %q", u.typ)
+	case "circle":
+		if u.circle == nil {
+			return 0, fmt.Errorf("field \"circle\" is required")
+		}
+		size = 27
+		size += jsonFloat64Size(*u.circle)
+	case "square":
+		if u.square == nil {
+			return 0, fmt.Errorf("field \"square\" is required")
+		}
+		size = 27
+		n, err = u.square.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "name":
+		if u.name == nil {
+			return 0, fmt.Errorf("field \"name\" is required")
+		}
+		size = 23
+		size += jsonStringSize(string(*u.name))
+	case "maybe":
+		var maybe *string
+		if u.maybe != nil {
+			maybe = *u.maybe
+		}
+		size = 25
+		if maybe == nil {
+			size += 4
+		} else {
+			size += jsonStringSize(*maybe)
+		}
+	}
+	return size, nil
+}
+
+func (u Shape) MarshalJSON() ([]byte, error) {
+	return u.AppendJSON(nil)
+}
+
+func (u *Shape) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Shape
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*u = v
+			return nil
+		}
+	}
+	var deser shapeDeserializer
+	if err := safejson.Unmarshal(data, &deser); err != nil {
+		return err
+	}
+	*u = deser.toStruct()
+	switch u.typ {
+	case "circle":
+		if u.circle == nil {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+	case "square":
+		if u.square == nil {
+			return fmt.Errorf("field \"square\" is required")
+		}
+	case "name":
+		if u.name == nil {
+			return fmt.Errorf("field \"name\" is required")
+		}
+	case "maybe":
+	}
+	return nil
+}
+
+func (u *Shape) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenType, seenCircle, seenSquare, seenName, seenMaybe bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !unmarshalJSONString(field, &u.typ) {
+				ok = false
+				return false
+			}
+		case "circle":
+			if seenCircle {
+				ok = false
+				return false
+			}
+			seenCircle = true
+			if field.Type != gjson.Null {
+				var v float64
+				if !unmarshalJSONFloat64(field, &v) {
+					ok = false
+					return false
+				}
+				u.circle = &v
+			}
+		case "square":
+			if seenSquare {
+				ok = false
+				return false
+			}
+			seenSquare = true
+			if field.Type != gjson.Null {
+				var v1 Child
+				if !v1.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.square = &v1
+			}
+		case "name":
+			if seenName {
+				ok = false
+				return false
+			}
+			seenName = true
+			if field.Type != gjson.Null {
+				var v2 Name
+				var v3 string
+				if !unmarshalJSONString(field, &v3) {
+					ok = false
+					return false
+				}
+				v2 = Name(v3)
+				u.name = &v2
+			}
+		case "maybe":
+			if seenMaybe {
+				ok = false
+				return false
+			}
+			seenMaybe = true
+			if field.Type != gjson.Null {
+				var v4 *string
+				if field.Type != gjson.Null {
+					var v5 string
+					if !unmarshalJSONString(field, &v5) {
+						ok = false
+						return false
+					}
+					v4 = &v5
+				}
+				u.maybe = &v4
+			}
+		default:
+			if matchesJSONField(key.Str, "type", "circle", "square", "name", "maybe") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	switch u.typ {
+	case "circle":
+		if u.circle == nil {
+			return false
+		}
+	case "square":
+		if u.square == nil {
+			return false
+		}
+	case "name":
+		if u.name == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *Shape) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return u.decodeJSONStrict(value)
+}
+
+func (u *Shape) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	var typ string
+	var variantName string
+	var variant gjson.Result
+	var seenType, seenVariant bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch {
+		case key.Str == "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			typ, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"type\": %w", err)
+				return false
+			}
+			return true
+		case seenVariant && key.Str == variantName:
+			err = fmt.Errorf("duplicate field %q", key.Str)
+			return false
+		case seenVariant:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		variantName, variant, seenVariant = key.Str, field, true
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenType {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	if seenVariant && variantName != typ {
+		return fmt.Errorf("unknown field %q", variantName)
+	}
+	*u = Shape{typ: typ}
+	switch typ {
+	case "circle":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+		var v float64
+		v, err = decodeJSONFloat64(variant)
+		if err != nil {
+			return fmt.Errorf("field \"circle\": %w", err)
+		}
+		u.circle = &v
+	case "square":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"square\" is required")
+		}
+		var v1 Child
+		err = v1.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"square\": %w", err)
+		}
+		u.square = &v1
+	case "name":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"name\" is required")
+		}
+		var v2 Name
+		err = v2.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"name\": %w", err)
+		}
+		u.name = &v2
+	case "maybe":
+		var v3 *string
+		if variant.Type != gjson.Null {
+			var v4 string
+			v4, err = decodeJSONString(variant)
+			if err != nil {
+				return fmt.Errorf("field \"maybe\": %w", err)
+			}
+			v3 = &v4
+		}
+		u.maybe = &v3
+	}
+	return nil
+}
+
+func (u Shape) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (u *Shape) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&u)
+}
+
+func (u *Shape) AcceptFuncs(circleFunc func(float64) error, squareFunc func(Child) error, nameFunc func(Name) error, maybeFunc func(*string) error, unknownFunc func(string) error) error {
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return fmt.Errorf("invalid value in union type")
+		}
+		return unknownFunc(u.typ)
+	case "circle":
+		if u.circle == nil {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+		return circleFunc(*u.circle)
+	case "square":
+		if u.square == nil {
+			return fmt.Errorf("field \"square\" is required")
+		}
+		return squareFunc(*u.square)
+	case "name":
+		if u.name == nil {
+			return fmt.Errorf("field \"name\" is required")
+		}
+		return nameFunc(*u.name)
+	case "maybe":
+		var maybe *string
+		if u.maybe != nil {
+			maybe = *u.maybe
+		}
+		return maybeFunc(maybe)
+	}
+}
+
+func (u *Shape) CircleNoopSuccess(float64) error {
+	return nil
+}
+
+func (u *Shape) SquareNoopSuccess(Child) error {
+	return nil
+}
+
+func (u *Shape) NameNoopSuccess(Name) error {
+	return nil
+}
+
+func (u *Shape) MaybeNoopSuccess(*string) error {
+	return nil
+}
+
+func (u *Shape) ErrorOnUnknown(typeName string) error {
+	return fmt.Errorf("invalid value in union type. Type name: %s", typeName)
+}
+
+func (u *Shape) Accept(v ShapeVisitor) error {
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return fmt.Errorf("invalid value in union type")
+		}
+		return v.VisitUnknown(u.typ)
+	case "circle":
+		if u.circle == nil {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+		return v.VisitCircle(*u.circle)
+	case "square":
+		if u.square == nil {
+			return fmt.Errorf("field \"square\" is required")
+		}
+		return v.VisitSquare(*u.square)
+	case "name":
+		if u.name == nil {
+			return fmt.Errorf("field \"name\" is required")
+		}
+		return v.VisitName(*u.name)
+	case "maybe":
+		var maybe *string
+		if u.maybe != nil {
+			maybe = *u.maybe
+		}
+		return v.VisitMaybe(maybe)
+	}
+}
+
+type ShapeVisitor interface {
+	VisitCircle(v float64) error
+	VisitSquare(v Child) error
+	VisitName(v Name) error
+	VisitMaybe(v *string) error
+	VisitUnknown(typeName string) error
+}
+
+func (u *Shape) AcceptWithContext(ctx context.Context, v ShapeVisitorWithContext) error {
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return fmt.Errorf("invalid value in union type")
+		}
+		return v.VisitUnknownWithContext(ctx, u.typ)
+	case "circle":
+		if u.circle == nil {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+		return v.VisitCircleWithContext(ctx, *u.circle)
+	case "square":
+		if u.square == nil {
+			return fmt.Errorf("field \"square\" is required")
+		}
+		return v.VisitSquareWithContext(ctx, *u.square)
+	case "name":
+		if u.name == nil {
+			return fmt.Errorf("field \"name\" is required")
+		}
+		return v.VisitNameWithContext(ctx, *u.name)
+	case "maybe":
+		var maybe *string
+		if u.maybe != nil {
+			maybe = *u.maybe
+		}
+		return v.VisitMaybeWithContext(ctx, maybe)
+	}
+}
+
+type ShapeVisitorWithContext interface {
+	VisitCircleWithContext(ctx context.Context, v float64) error
+	VisitSquareWithContext(ctx context.Context, v Child) error
+	VisitNameWithContext(ctx context.Context, v Name) error
+	VisitMaybeWithContext(ctx context.Context, v *string) error
+	VisitUnknownWithContext(ctx context.Context, typeName string) error
+}
+
+func NewShapeFromCircle(v float64) Shape {
+	return Shape{typ: "circle", circle: &v}
+}
+
+func NewShapeFromSquare(v Child) Shape {
+	return Shape{typ: "square", square: &v}
+}
+
+func NewShapeFromName(v Name) Shape {
+	return Shape{typ: "name", name: &v}
+}
+
+func NewShapeFromMaybe(v *string) Shape {
+	return Shape{typ: "maybe", maybe: &v}
+}
+
+// Equal returns true if the Shape is equal to other according to the Conjure semantics of its values.
+func (u Shape) Equal(other Shape) bool {
+	if u.typ != other.typ {
+		return false
+	}
+	if (u.circle == nil) != (other.circle == nil) {
+		return false
+	}
+	if u.circle != nil {
+		if !(*u.circle == *other.circle || math.IsNaN(*u.circle) && math.IsNaN(*other.circle)) {
+			return false
+		}
+	}
+	if (u.square == nil) != (other.square == nil) {
+		return false
+	}
+	if u.square != nil {
+		if !(*u.square).Equal(*other.square) {
+			return false
+		}
+	}
+	if (u.name == nil) != (other.name == nil) {
+		return false
+	}
+	if u.name != nil {
+		if !(*u.name).Equal(*other.name) {
+			return false
+		}
+	}
+	if (u.maybe == nil) != (other.maybe == nil) {
+		return false
+	}
+	if u.maybe != nil {
+		if (*u.maybe == nil) != (*other.maybe == nil) {
+			return false
+		}
+		if *u.maybe != nil {
+			if **u.maybe != **other.maybe {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Shape.
+func (u Shape) Clone() Shape {
+	out := u
+	if u.square != nil {
+		v := (*u.square).Clone()
+		out.square = &v
+	}
+	if u.maybe != nil {
+		var v *string
+		if *u.maybe != nil {
+			v1 := **u.maybe
+			v = &v1
+		}
+		out.maybe = &v
+	}
+	return out
+}
+
+func (u Shape) AppendCBOR(out []byte) ([]byte, error) {
+	var err error
+	switch u.typ {
+	default:
+		return nil, fmt.Errorf("unknown type %q", u.typ)
+	case "circle":
+		if u.circle == nil {
+			return nil, fmt.Errorf("field \"circle\" is required")
+		}
+		out = append(out, "\xa2dtypefcirclefcircle"...)
+		out = appendCBORFloat64(out, *u.circle)
+	case "square":
+		if u.square == nil {
+			return nil, fmt.Errorf("field \"square\" is required")
+		}
+		out = append(out, "\xa2dtypefsquarefsquare"...)
+		out, err = u.square.AppendCBOR(out)
+		if err != nil {
+			return nil, err
+		}
+	case "name":
+		if u.name == nil {
+			return nil, fmt.Errorf("field \"name\" is required")
+		}
+		out = append(out, "\xa2dtypednamedname"...)
+		out, err = u.name.AppendCBOR(out)
+		if err != nil {
+			return nil, err
+		}
+	case "maybe":
+		var maybe *string
+		if u.maybe != nil {
+			maybe = *u.maybe
+		}
+		out = append(out, "\xa2dtypeemaybeemaybe"...)
+		if maybe == nil {
+			out = append(out, "\xf6"...)
+		} else {
+			out = appendCBORString(out, *maybe)
+		}
+	}
+	return out, nil
+}
+
+func (u *Shape) decodeCBOR(r *cborReader) error {
+	*u = Shape{}
+	if err := r.decodeMap(func() error {
+		var key string
+		if err := r.decodeString(&key); err != nil {
+			return err
+		}
+		switch key {
+		case "type":
+			if err := r.decodeString(&u.typ); err != nil {
+				return err
+			}
+		case "circle":
+			var value float64
+			if err := r.decodeFloat64(&value); err != nil {
+				return err
+			}
+			u.circle = &value
+		case "square":
+			var value Child
+			if err := value.decodeCBOR(r); err != nil {
+				return err
+			}
+			u.square = &value
+		case "name":
+			var value Name
+			if err := value.decodeCBOR(r); err != nil {
+				return err
+			}
+			u.name = &value
+		case "maybe":
+			var value *string
+			if !r.decodeNull() {
+				var opt string
+				if err := r.decodeString(&opt); err != nil {
+					return err
+				}
+				value = &opt
+			}
+			u.maybe = &value
+		default:
+			return r.skip()
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if u.typ == "" {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	return nil
+}
+
+func (u Shape) MarshalCBOR() ([]byte, error) {
+	return u.AppendCBOR(nil)
+}
+
+func (u *Shape) UnmarshalCBOR(data []byte) error {
+	r := cborReader{data: data}
+	if err := u.decodeCBOR(&r); err != nil {
+		return err
+	}
+	return r.end()
+}
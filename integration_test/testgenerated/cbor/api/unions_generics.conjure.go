@@ -0,0 +1,52 @@
+// This file was generated by Conjure and should not be manually edited.
+
+//go:build go1.18
+
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+type ShapeWithT[T any] Shape
+
+func (u *ShapeWithT[T]) Accept(ctx context.Context, v ShapeVisitorWithT[T]) (T, error) {
+	var result T
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return result, fmt.Errorf("invalid value in union type")
+		}
+		return v.VisitUnknown(ctx, u.typ)
+	case "circle":
+		if u.circle == nil {
+			return result, fmt.Errorf("field \"circle\" is required")
+		}
+		return v.VisitCircle(ctx, *u.circle)
+	case "square":
+		if u.square == nil {
+			return result, fmt.Errorf("field \"square\" is required")
+		}
+		return v.VisitSquare(ctx, *u.square)
+	case "name":
+		if u.name == nil {
+			return result, fmt.Errorf("field \"name\" is required")
+		}
+		return v.VisitName(ctx, *u.name)
+	case "maybe":
+		var maybe *string
+		if u.maybe != nil {
+			maybe = *u.maybe
+		}
+		return v.VisitMaybe(ctx, maybe)
+	}
+}
+
+type ShapeVisitorWithT[T any] interface {
+	VisitCircle(ctx context.Context, v float64) (T, error)
+	VisitSquare(ctx context.Context, v Child) (T, error)
+	VisitName(ctx context.Context, v Name) (T, error)
+	VisitMaybe(ctx context.Context, v *string) (T, error)
+	VisitUnknown(ctx context.Context, typ string) (T, error)
+}
@@ -0,0 +1,314 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cbor_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/cbor/api"
+	"github.com/palantir/pkg/binary"
+	"github.com/palantir/pkg/boolean"
+	"github.com/palantir/pkg/datetime"
+	"github.com/palantir/pkg/rid"
+	"github.com/palantir/pkg/uuid"
+	"github.com/palantir/witchcraft-go-server/v2/wrouter"
+	"github.com/palantir/witchcraft-go-server/v2/wrouter/whttprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRecord() api.Record {
+	nickname := "nick"
+	optionalName := "optional"
+	shape := api.NewShapeFromSquare(api.Child{Value: -3, Blobs: map[binary.Binary][]byte{}})
+	return api.Record{
+		Name:          "name",
+		Count:         -100000,
+		Total:         1 << 40,
+		Ratio:         0.25,
+		Enabled:       true,
+		Id:            uuid.UUID{1, 2, 3},
+		Created:       datetime.DateTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)),
+		Resource:      rid.MustNew("service", "instance", "type", "locator"),
+		Token:         "token",
+		Payload:       []byte{0, 1, 2},
+		Extra:         map[string]interface{}{"a": []interface{}{json.Number("1"), "b", true, nil}},
+		Nickname:      &nickname,
+		Tags:          []string{"x", "y"},
+		Labels:        []string{"l"},
+		Scores:        map[string]float64{"s": 1.5},
+		ColorCounts:   map[api.Color]int{api.New_Color(api.Color_RED): 2},
+		Flags:         map[boolean.Boolean]string{true: "yes"},
+		Children:      []api.Child{{Value: 1, Blobs: map[binary.Binary][]byte{}}},
+		Shape:         &shape,
+		Color:         api.New_Color(api.Color_GREEN),
+		Alias:         "alias",
+		OptionalAlias: api.OptionalName{Value: &optionalName},
+	}
+}
+
+func TestRecordRoundTrip(t *testing.T) {
+	record := testRecord()
+	data, err := record.MarshalCBOR()
+	require.NoError(t, err)
+
+	var decoded api.Record
+	require.NoError(t, decoded.UnmarshalCBOR(data))
+	assert.True(t, record.Equal(decoded), "%+v", decoded)
+
+	// values decoded from CBOR and from JSON are the same
+	jsonData, err := json.Marshal(record)
+	require.NoError(t, err)
+	var fromJSON api.Record
+	require.NoError(t, json.Unmarshal(jsonData, &fromJSON))
+	assert.Equal(t, fromJSON, decoded)
+}
+
+func TestNilFieldsRoundTrip(t *testing.T) {
+	record := testRecord()
+	record.Nickname, record.Shape, record.Tags, record.Scores = nil, nil, nil, nil
+	data, err := record.MarshalCBOR()
+	require.NoError(t, err)
+
+	var decoded api.Record
+	require.NoError(t, decoded.UnmarshalCBOR(data))
+	assert.Nil(t, decoded.Nickname)
+	assert.Nil(t, decoded.Shape)
+	assert.NotNil(t, decoded.Tags)
+	assert.NotNil(t, decoded.Scores)
+}
+
+func TestChildEncoding(t *testing.T) {
+	data, err := api.Child{Value: 1}.MarshalCBOR()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("\xa2evalue\x01eblobs\xa0"), data)
+
+	data, err = api.Child{Value: -25, Blobs: map[binary.Binary][]byte{binary.New([]byte("k")): {0xff}}}.MarshalCBOR()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("\xa2evalue\x38\x18eblobs\xa1daw==\x41\xff"), data)
+
+	data, err = api.Empty{}.MarshalCBOR()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("\xa0"), data)
+}
+
+func TestDecode(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		data     string
+		expected api.Child
+	}{
+		{
+			name:     "indefinite-length map and string",
+			data:     "\xbf\x7f\x63val\x62ue\xff\x19\x01\x00\xff",
+			expected: api.Child{Value: 256, Blobs: map[binary.Binary][]byte{}},
+		},
+		{
+			name:     "unknown fields are skipped",
+			data:     "\xa3eother\x82\x01\xa1aa\x9f\xf5\xffevalue\x07eblobs\xf6",
+			expected: api.Child{Value: 7, Blobs: map[binary.Binary][]byte{}},
+		},
+		{
+			name:     "tags are ignored",
+			data:     "\xa1evalue\xc1\x1a\x00\x01\x00\x00",
+			expected: api.Child{Value: 65536, Blobs: map[binary.Binary][]byte{}},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var child api.Child
+			require.NoError(t, child.UnmarshalCBOR([]byte(test.data)))
+			assert.Equal(t, test.expected, child)
+		})
+	}
+
+	var shape api.Shape
+	require.NoError(t, shape.UnmarshalCBOR([]byte("\xa2dtypefcirclefcircle\xf9\x3e\x00")))
+	assert.Equal(t, api.NewShapeFromCircle(1.5), shape)
+
+	var empty api.Empty
+	require.NoError(t, empty.UnmarshalCBOR([]byte("\xa1aa\x01")))
+}
+
+func TestDecodeErrors(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		data string
+		err  string
+	}{
+		{name: "truncated", data: "\xa2evalue", err: "unexpected EOF"},
+		{name: "trailing data", data: "\xa0\x00", err: "unexpected data after CBOR data item"},
+		{name: "wrong type", data: "\xa1evalue\x61a", err: "expected integer but found CBOR major type 3"},
+		{name: "integer out of range", data: "\xa1evalue\x1b\x00\x00\x00\x01\x00\x00\x00\x00", err: "invalid integer 4294967296"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var child api.Child
+			err := child.UnmarshalCBOR([]byte(test.data))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+
+	var shape api.Shape
+	err := shape.UnmarshalCBOR([]byte("\xa1fcircle\xf9\x3e\x00"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `field "type" is required`)
+
+	_, err = api.Shape{}.MarshalCBOR()
+	require.Error(t, err)
+}
+
+func TestUnionAndAliasRoundTrip(t *testing.T) {
+	name := "maybe"
+	for _, shape := range []api.Shape{
+		api.NewShapeFromCircle(2),
+		api.NewShapeFromSquare(api.Child{Value: 1, Blobs: map[binary.Binary][]byte{}}),
+		api.NewShapeFromName("name"),
+		api.NewShapeFromMaybe(&name),
+		api.NewShapeFromMaybe(nil),
+	} {
+		data, err := shape.MarshalCBOR()
+		require.NoError(t, err)
+		var decoded api.Shape
+		require.NoError(t, decoded.UnmarshalCBOR(data))
+		assert.True(t, shape.Equal(decoded), "%+v", decoded)
+	}
+
+	for _, alias := range []api.OptionalName{{}, {Value: &name}} {
+		data, err := alias.MarshalCBOR()
+		require.NoError(t, err)
+		var decoded api.OptionalName
+		require.NoError(t, decoded.UnmarshalCBOR(data))
+		assert.Equal(t, alias, decoded)
+	}
+}
+
+func newCBORServer(t *testing.T) *httptest.Server {
+	fake := &api.FakeCBORService{
+		EchoRecordFunc: func(ctx context.Context, record api.Record) (api.Record, error) {
+			return record, nil
+		},
+		EchoShapeFunc: func(ctx context.Context, shape api.Shape) (api.Shape, error) {
+			return shape, nil
+		},
+		EchoOptionalNameFunc: func(ctx context.Context, name api.OptionalName) (api.OptionalName, error) {
+			return name, nil
+		},
+		EchoChildrenFunc: func(ctx context.Context, children api.Children) (api.Children, error) {
+			return children, nil
+		},
+		EchoListFunc: func(ctx context.Context, values []string) ([]string, error) {
+			return values, nil
+		},
+	}
+	router := wrouter.New(whttprouter.New())
+	require.NoError(t, api.RegisterRoutesCBORService(router, fake))
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClients(t *testing.T) {
+	ctx := context.Background()
+	server := newCBORServer(t)
+	httpClient, err := httpclient.NewClient(httpclient.WithBaseURLs([]string{server.URL}), httpclient.WithMaxRetries(0))
+	require.NoError(t, err)
+
+	for name, client := range map[string]api.CBORServiceClient{
+		"JSON": api.NewCBORServiceClient(httpClient),
+		"CBOR": api.NewCBORServiceCBORClient(httpClient),
+	} {
+		t.Run(name, func(t *testing.T) {
+			record := testRecord()
+			echoed, err := client.EchoRecord(ctx, record)
+			require.NoError(t, err)
+			assert.True(t, record.Equal(echoed), "%+v", echoed)
+
+			shape, err := client.EchoShape(ctx, api.NewShapeFromName("name"))
+			require.NoError(t, err)
+			assert.Equal(t, api.NewShapeFromName("name"), shape)
+
+			optionalName := "name"
+			echoedName, err := client.EchoOptionalName(ctx, api.OptionalName{Value: &optionalName})
+			require.NoError(t, err)
+			assert.Equal(t, api.OptionalName{Value: &optionalName}, echoedName)
+
+			children, err := client.EchoChildren(ctx, api.Children{{Value: 1, Blobs: map[binary.Binary][]byte{}}})
+			require.NoError(t, err)
+			assert.Equal(t, api.Children{{Value: 1, Blobs: map[binary.Binary][]byte{}}}, children)
+
+			values, err := client.EchoList(ctx, []string{"a"})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"a"}, values)
+		})
+	}
+}
+
+func TestServerNegotiation(t *testing.T) {
+	server := newCBORServer(t)
+	child := api.Children{{Value: 1}}
+	cborBody, err := child.MarshalCBOR()
+	require.NoError(t, err)
+	jsonBody, err := json.Marshal(child)
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		name                string
+		body                []byte
+		contentType         string
+		accept              string
+		expectedContentType string
+	}{
+		{name: "JSON", body: jsonBody, contentType: "application/json", accept: "application/json", expectedContentType: "application/json"},
+		{name: "no accept", body: cborBody, contentType: "application/cbor", expectedContentType: "application/json"},
+		{name: "CBOR", body: cborBody, contentType: "application/cbor", accept: "application/cbor", expectedContentType: "application/cbor"},
+		{name: "JSON preferred", body: jsonBody, contentType: "application/json", accept: "application/json, application/cbor", expectedContentType: "application/json"},
+		{name: "CBOR preferred", body: jsonBody, contentType: "application/json", accept: "application/cbor;q=0.9, application/json;q=0.8", expectedContentType: "application/cbor"},
+		{name: "CBOR rejected", body: jsonBody, contentType: "application/json", accept: "application/cbor;q=0, */*", expectedContentType: "application/json"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, server.URL+"/cbor/children", bytes.NewReader(test.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", test.contentType)
+			if test.accept != "" {
+				req.Header.Set("Accept", test.accept)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer func() {
+				_ = resp.Body.Close()
+			}()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, test.expectedContentType, resp.Header.Get("Content-Type"))
+			respBody, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			var decoded api.Children
+			if test.expectedContentType == "application/cbor" {
+				require.NoError(t, decoded.UnmarshalCBOR(respBody))
+			} else {
+				require.NoError(t, json.Unmarshal(respBody, &decoded))
+			}
+			assert.Equal(t, api.Children{{Value: 1, Blobs: map[binary.Binary][]byte{}}}, decoded)
+		})
+	}
+}
@@ -4,6 +4,8 @@ package api
 
 import (
 	"strings"
+
+	"github.com/tidwall/gjson"
 )
 
 type CustomEnum struct {
@@ -62,3 +64,33 @@ func (e *CustomEnum) UnmarshalText(data []byte) error {
 	}
 	return nil
 }
+
+func (e CustomEnum) AppendJSON(out []byte) ([]byte, error) {
+	return appendJSONString(out, string(e.val)), nil
+}
+
+func (e CustomEnum) JSONSize() (int, error) {
+	return jsonStringSize(string(e.val)), nil
+}
+
+func (e *CustomEnum) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return e.decodeJSONStrict(value)
+}
+
+func (e *CustomEnum) decodeJSONStrict(value gjson.Result) error {
+	return decodeJSONEnum(value, e)
+}
+
+// Equal returns true if the CustomEnum is equal to other according to the Conjure semantics of its values.
+func (e CustomEnum) Equal(other CustomEnum) bool {
+	return e.val == other.val
+}
+
+// Clone returns a deep copy of the CustomEnum.
+func (e CustomEnum) Clone() CustomEnum {
+	return e
+}
@@ -0,0 +1,560 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/pkg/bearertoken"
+	"github.com/palantir/pkg/datetime"
+	"github.com/palantir/pkg/rid"
+	"github.com/palantir/pkg/safelong"
+	"github.com/palantir/pkg/uuid"
+	wparams "github.com/palantir/witchcraft-go-params"
+)
+
+// FakeTestService is an in-memory implementation of TestService for use in tests.
+// Each endpoint records its arguments and then invokes the corresponding <Endpoint>Func field.
+// If the field is nil, the endpoint returns DefaultErr (or a Conjure Internal error if DefaultErr is nil).
+// The zero value is ready to use and all methods are safe for concurrent use.
+type FakeTestService struct {
+	// EchoFunc is invoked by Echo if non-nil.
+	EchoFunc func(ctx context.Context, cookieToken bearertoken.Token) error
+	// EchoStringsFunc is invoked by EchoStrings if non-nil.
+	EchoStringsFunc func(ctx context.Context, bodyArg []string) ([]string, error)
+	// EchoCustomObjectFunc is invoked by EchoCustomObject if non-nil.
+	EchoCustomObjectFunc func(ctx context.Context, bodyArg *CustomObject) (*CustomObject, error)
+	// EchoOptionalAliasFunc is invoked by EchoOptionalAlias if non-nil.
+	EchoOptionalAliasFunc func(ctx context.Context, bodyArg OptionalIntegerAlias) (OptionalIntegerAlias, error)
+	// EchoOptionalListAliasFunc is invoked by EchoOptionalListAlias if non-nil.
+	EchoOptionalListAliasFunc func(ctx context.Context, bodyArg OptionalListAlias) (OptionalListAlias, error)
+	// GetPathParamFunc is invoked by GetPathParam if non-nil.
+	GetPathParamFunc func(ctx context.Context, authHeader bearertoken.Token, myPathParamArg string) error
+	// GetListBooleanFunc is invoked by GetListBoolean if non-nil.
+	GetListBooleanFunc func(ctx context.Context, myQueryParam1Arg []bool) ([]bool, error)
+	// PutMapStringStringFunc is invoked by PutMapStringString if non-nil.
+	PutMapStringStringFunc func(ctx context.Context, myParamArg map[string]string) (map[string]string, error)
+	// PutMapStringAnyFunc is invoked by PutMapStringAny if non-nil.
+	PutMapStringAnyFunc func(ctx context.Context, myParamArg map[string]interface{}) (map[string]interface{}, error)
+	// GetDateTimeFunc is invoked by GetDateTime if non-nil.
+	GetDateTimeFunc func(ctx context.Context, myParamArg datetime.DateTime) (datetime.DateTime, error)
+	// GetDoubleFunc is invoked by GetDouble if non-nil.
+	GetDoubleFunc func(ctx context.Context, myParamArg float64) (float64, error)
+	// GetRidFunc is invoked by GetRid if non-nil.
+	GetRidFunc func(ctx context.Context, myParamArg rid.ResourceIdentifier) (rid.ResourceIdentifier, error)
+	// GetSafeLongFunc is invoked by GetSafeLong if non-nil.
+	GetSafeLongFunc func(ctx context.Context, myParamArg safelong.SafeLong) (safelong.SafeLong, error)
+	// GetUuidFunc is invoked by GetUuid if non-nil.
+	GetUuidFunc func(ctx context.Context, myParamArg uuid.UUID) (uuid.UUID, error)
+	// GetEnumFunc is invoked by GetEnum if non-nil.
+	GetEnumFunc func(ctx context.Context, myParamArg CustomEnum) (CustomEnum, error)
+	// PutBinaryFunc is invoked by PutBinary if non-nil.
+	PutBinaryFunc func(ctx context.Context, myParamArg io.ReadCloser) (io.ReadCloser, error)
+	// GetOptionalBinaryFunc is invoked by GetOptionalBinary if non-nil.
+	GetOptionalBinaryFunc func(ctx context.Context) (*io.ReadCloser, error)
+	// PutCustomUnionFunc is invoked by PutCustomUnion if non-nil.
+	PutCustomUnionFunc func(ctx context.Context, myParamArg CustomUnion) (CustomUnion, error)
+	// GetReservedFunc is invoked by GetReserved if non-nil.
+	GetReservedFunc func(ctx context.Context, confArg string, bearertokenArg string) error
+	// ChanFunc is invoked by Chan if non-nil.
+	ChanFunc func(ctx context.Context, varArg string, importArg map[string]string, typeArg string, returnArg safelong.SafeLong, httpArg string, jsonArg string, reqArg string, rwArg string) error
+	// DefaultErr is returned by endpoints whose func field is nil.
+	DefaultErr error
+
+	mu                         sync.Mutex
+	echoCalls                  []FakeTestServiceEchoCall
+	echoStringsCalls           []FakeTestServiceEchoStringsCall
+	echoCustomObjectCalls      []FakeTestServiceEchoCustomObjectCall
+	echoOptionalAliasCalls     []FakeTestServiceEchoOptionalAliasCall
+	echoOptionalListAliasCalls []FakeTestServiceEchoOptionalListAliasCall
+	getPathParamCalls          []FakeTestServiceGetPathParamCall
+	getListBooleanCalls        []FakeTestServiceGetListBooleanCall
+	putMapStringStringCalls    []FakeTestServicePutMapStringStringCall
+	putMapStringAnyCalls       []FakeTestServicePutMapStringAnyCall
+	getDateTimeCalls           []FakeTestServiceGetDateTimeCall
+	getDoubleCalls             []FakeTestServiceGetDoubleCall
+	getRidCalls                []FakeTestServiceGetRidCall
+	getSafeLongCalls           []FakeTestServiceGetSafeLongCall
+	getUuidCalls               []FakeTestServiceGetUuidCall
+	getEnumCalls               []FakeTestServiceGetEnumCall
+	putBinaryCalls             []FakeTestServicePutBinaryCall
+	getOptionalBinaryCalls     []FakeTestServiceGetOptionalBinaryCall
+	putCustomUnionCalls        []FakeTestServicePutCustomUnionCall
+	getReservedCalls           []FakeTestServiceGetReservedCall
+	chanCalls                  []FakeTestServiceChanCall
+}
+
+var _ TestService = (*FakeTestService)(nil)
+
+// FakeTestServiceEchoCall records the arguments of a call to FakeTestService.Echo.
+type FakeTestServiceEchoCall struct {
+	CookieToken bearertoken.Token
+}
+
+func (f *FakeTestService) Echo(ctx context.Context, cookieToken bearertoken.Token) error {
+	f.mu.Lock()
+	f.echoCalls = append(f.echoCalls, FakeTestServiceEchoCall{CookieToken: cookieToken})
+	f.mu.Unlock()
+	if f.EchoFunc != nil {
+		return f.EchoFunc(ctx, cookieToken)
+	}
+	return f.defaultErr("echo")
+}
+
+// EchoCalls returns the arguments of every call made to Echo, in call order.
+func (f *FakeTestService) EchoCalls() []FakeTestServiceEchoCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceEchoCall(nil), f.echoCalls...)
+}
+
+// FakeTestServiceEchoStringsCall records the arguments of a call to FakeTestService.EchoStrings.
+type FakeTestServiceEchoStringsCall struct {
+	Body []string
+}
+
+func (f *FakeTestService) EchoStrings(ctx context.Context, bodyArg []string) ([]string, error) {
+	f.mu.Lock()
+	f.echoStringsCalls = append(f.echoStringsCalls, FakeTestServiceEchoStringsCall{Body: bodyArg})
+	f.mu.Unlock()
+	if f.EchoStringsFunc != nil {
+		return f.EchoStringsFunc(ctx, bodyArg)
+	}
+	var defaultReturnVal []string
+	return defaultReturnVal, f.defaultErr("echoStrings")
+}
+
+// EchoStringsCalls returns the arguments of every call made to EchoStrings, in call order.
+func (f *FakeTestService) EchoStringsCalls() []FakeTestServiceEchoStringsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceEchoStringsCall(nil), f.echoStringsCalls...)
+}
+
+// FakeTestServiceEchoCustomObjectCall records the arguments of a call to FakeTestService.EchoCustomObject.
+type FakeTestServiceEchoCustomObjectCall struct {
+	Body *CustomObject
+}
+
+func (f *FakeTestService) EchoCustomObject(ctx context.Context, bodyArg *CustomObject) (*CustomObject, error) {
+	f.mu.Lock()
+	f.echoCustomObjectCalls = append(f.echoCustomObjectCalls, FakeTestServiceEchoCustomObjectCall{Body: bodyArg})
+	f.mu.Unlock()
+	if f.EchoCustomObjectFunc != nil {
+		return f.EchoCustomObjectFunc(ctx, bodyArg)
+	}
+	var defaultReturnVal *CustomObject
+	return defaultReturnVal, f.defaultErr("echoCustomObject")
+}
+
+// EchoCustomObjectCalls returns the arguments of every call made to EchoCustomObject, in call order.
+func (f *FakeTestService) EchoCustomObjectCalls() []FakeTestServiceEchoCustomObjectCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceEchoCustomObjectCall(nil), f.echoCustomObjectCalls...)
+}
+
+// FakeTestServiceEchoOptionalAliasCall records the arguments of a call to FakeTestService.EchoOptionalAlias.
+type FakeTestServiceEchoOptionalAliasCall struct {
+	Body OptionalIntegerAlias
+}
+
+func (f *FakeTestService) EchoOptionalAlias(ctx context.Context, bodyArg OptionalIntegerAlias) (OptionalIntegerAlias, error) {
+	f.mu.Lock()
+	f.echoOptionalAliasCalls = append(f.echoOptionalAliasCalls, FakeTestServiceEchoOptionalAliasCall{Body: bodyArg})
+	f.mu.Unlock()
+	if f.EchoOptionalAliasFunc != nil {
+		return f.EchoOptionalAliasFunc(ctx, bodyArg)
+	}
+	var defaultReturnVal OptionalIntegerAlias
+	return defaultReturnVal, f.defaultErr("echoOptionalAlias")
+}
+
+// EchoOptionalAliasCalls returns the arguments of every call made to EchoOptionalAlias, in call order.
+func (f *FakeTestService) EchoOptionalAliasCalls() []FakeTestServiceEchoOptionalAliasCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceEchoOptionalAliasCall(nil), f.echoOptionalAliasCalls...)
+}
+
+// FakeTestServiceEchoOptionalListAliasCall records the arguments of a call to FakeTestService.EchoOptionalListAlias.
+type FakeTestServiceEchoOptionalListAliasCall struct {
+	Body OptionalListAlias
+}
+
+func (f *FakeTestService) EchoOptionalListAlias(ctx context.Context, bodyArg OptionalListAlias) (OptionalListAlias, error) {
+	f.mu.Lock()
+	f.echoOptionalListAliasCalls = append(f.echoOptionalListAliasCalls, FakeTestServiceEchoOptionalListAliasCall{Body: bodyArg})
+	f.mu.Unlock()
+	if f.EchoOptionalListAliasFunc != nil {
+		return f.EchoOptionalListAliasFunc(ctx, bodyArg)
+	}
+	var defaultReturnVal OptionalListAlias
+	return defaultReturnVal, f.defaultErr("echoOptionalListAlias")
+}
+
+// EchoOptionalListAliasCalls returns the arguments of every call made to EchoOptionalListAlias, in call order.
+func (f *FakeTestService) EchoOptionalListAliasCalls() []FakeTestServiceEchoOptionalListAliasCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceEchoOptionalListAliasCall(nil), f.echoOptionalListAliasCalls...)
+}
+
+// FakeTestServiceGetPathParamCall records the arguments of a call to FakeTestService.GetPathParam.
+type FakeTestServiceGetPathParamCall struct {
+	AuthHeader  bearertoken.Token
+	MyPathParam string
+}
+
+func (f *FakeTestService) GetPathParam(ctx context.Context, authHeader bearertoken.Token, myPathParamArg string) error {
+	f.mu.Lock()
+	f.getPathParamCalls = append(f.getPathParamCalls, FakeTestServiceGetPathParamCall{AuthHeader: authHeader, MyPathParam: myPathParamArg})
+	f.mu.Unlock()
+	if f.GetPathParamFunc != nil {
+		return f.GetPathParamFunc(ctx, authHeader, myPathParamArg)
+	}
+	return f.defaultErr("getPathParam")
+}
+
+// GetPathParamCalls returns the arguments of every call made to GetPathParam, in call order.
+func (f *FakeTestService) GetPathParamCalls() []FakeTestServiceGetPathParamCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceGetPathParamCall(nil), f.getPathParamCalls...)
+}
+
+// FakeTestServiceGetListBooleanCall records the arguments of a call to FakeTestService.GetListBoolean.
+type FakeTestServiceGetListBooleanCall struct {
+	MyQueryParam1 []bool
+}
+
+func (f *FakeTestService) GetListBoolean(ctx context.Context, myQueryParam1Arg []bool) ([]bool, error) {
+	f.mu.Lock()
+	f.getListBooleanCalls = append(f.getListBooleanCalls, FakeTestServiceGetListBooleanCall{MyQueryParam1: myQueryParam1Arg})
+	f.mu.Unlock()
+	if f.GetListBooleanFunc != nil {
+		return f.GetListBooleanFunc(ctx, myQueryParam1Arg)
+	}
+	var defaultReturnVal []bool
+	return defaultReturnVal, f.defaultErr("getListBoolean")
+}
+
+// GetListBooleanCalls returns the arguments of every call made to GetListBoolean, in call order.
+func (f *FakeTestService) GetListBooleanCalls() []FakeTestServiceGetListBooleanCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceGetListBooleanCall(nil), f.getListBooleanCalls...)
+}
+
+// FakeTestServicePutMapStringStringCall records the arguments of a call to FakeTestService.PutMapStringString.
+type FakeTestServicePutMapStringStringCall struct {
+	MyParam map[string]string
+}
+
+func (f *FakeTestService) PutMapStringString(ctx context.Context, myParamArg map[string]string) (map[string]string, error) {
+	f.mu.Lock()
+	f.putMapStringStringCalls = append(f.putMapStringStringCalls, FakeTestServicePutMapStringStringCall{MyParam: myParamArg})
+	f.mu.Unlock()
+	if f.PutMapStringStringFunc != nil {
+		return f.PutMapStringStringFunc(ctx, myParamArg)
+	}
+	var defaultReturnVal map[string]string
+	return defaultReturnVal, f.defaultErr("putMapStringString")
+}
+
+// PutMapStringStringCalls returns the arguments of every call made to PutMapStringString, in call order.
+func (f *FakeTestService) PutMapStringStringCalls() []FakeTestServicePutMapStringStringCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServicePutMapStringStringCall(nil), f.putMapStringStringCalls...)
+}
+
+// FakeTestServicePutMapStringAnyCall records the arguments of a call to FakeTestService.PutMapStringAny.
+type FakeTestServicePutMapStringAnyCall struct {
+	MyParam map[string]interface{}
+}
+
+func (f *FakeTestService) PutMapStringAny(ctx context.Context, myParamArg map[string]interface{}) (map[string]interface{}, error) {
+	f.mu.Lock()
+	f.putMapStringAnyCalls = append(f.putMapStringAnyCalls, FakeTestServicePutMapStringAnyCall{MyParam: myParamArg})
+	f.mu.Unlock()
+	if f.PutMapStringAnyFunc != nil {
+		return f.PutMapStringAnyFunc(ctx, myParamArg)
+	}
+	var defaultReturnVal map[string]interface{}
+	return defaultReturnVal, f.defaultErr("putMapStringAny")
+}
+
+// PutMapStringAnyCalls returns the arguments of every call made to PutMapStringAny, in call order.
+func (f *FakeTestService) PutMapStringAnyCalls() []FakeTestServicePutMapStringAnyCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServicePutMapStringAnyCall(nil), f.putMapStringAnyCalls...)
+}
+
+// FakeTestServiceGetDateTimeCall records the arguments of a call to FakeTestService.GetDateTime.
+type FakeTestServiceGetDateTimeCall struct {
+	MyParam datetime.DateTime
+}
+
+func (f *FakeTestService) GetDateTime(ctx context.Context, myParamArg datetime.DateTime) (datetime.DateTime, error) {
+	f.mu.Lock()
+	f.getDateTimeCalls = append(f.getDateTimeCalls, FakeTestServiceGetDateTimeCall{MyParam: myParamArg})
+	f.mu.Unlock()
+	if f.GetDateTimeFunc != nil {
+		return f.GetDateTimeFunc(ctx, myParamArg)
+	}
+	var defaultReturnVal datetime.DateTime
+	return defaultReturnVal, f.defaultErr("getDateTime")
+}
+
+// GetDateTimeCalls returns the arguments of every call made to GetDateTime, in call order.
+func (f *FakeTestService) GetDateTimeCalls() []FakeTestServiceGetDateTimeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceGetDateTimeCall(nil), f.getDateTimeCalls...)
+}
+
+// FakeTestServiceGetDoubleCall records the arguments of a call to FakeTestService.GetDouble.
+type FakeTestServiceGetDoubleCall struct {
+	MyParam float64
+}
+
+func (f *FakeTestService) GetDouble(ctx context.Context, myParamArg float64) (float64, error) {
+	f.mu.Lock()
+	f.getDoubleCalls = append(f.getDoubleCalls, FakeTestServiceGetDoubleCall{MyParam: myParamArg})
+	f.mu.Unlock()
+	if f.GetDoubleFunc != nil {
+		return f.GetDoubleFunc(ctx, myParamArg)
+	}
+	var defaultReturnVal float64
+	return defaultReturnVal, f.defaultErr("getDouble")
+}
+
+// GetDoubleCalls returns the arguments of every call made to GetDouble, in call order.
+func (f *FakeTestService) GetDoubleCalls() []FakeTestServiceGetDoubleCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceGetDoubleCall(nil), f.getDoubleCalls...)
+}
+
+// FakeTestServiceGetRidCall records the arguments of a call to FakeTestService.GetRid.
+type FakeTestServiceGetRidCall struct {
+	MyParam rid.ResourceIdentifier
+}
+
+func (f *FakeTestService) GetRid(ctx context.Context, myParamArg rid.ResourceIdentifier) (rid.ResourceIdentifier, error) {
+	f.mu.Lock()
+	f.getRidCalls = append(f.getRidCalls, FakeTestServiceGetRidCall{MyParam: myParamArg})
+	f.mu.Unlock()
+	if f.GetRidFunc != nil {
+		return f.GetRidFunc(ctx, myParamArg)
+	}
+	var defaultReturnVal rid.ResourceIdentifier
+	return defaultReturnVal, f.defaultErr("getRid")
+}
+
+// GetRidCalls returns the arguments of every call made to GetRid, in call order.
+func (f *FakeTestService) GetRidCalls() []FakeTestServiceGetRidCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceGetRidCall(nil), f.getRidCalls...)
+}
+
+// FakeTestServiceGetSafeLongCall records the arguments of a call to FakeTestService.GetSafeLong.
+type FakeTestServiceGetSafeLongCall struct {
+	MyParam safelong.SafeLong
+}
+
+func (f *FakeTestService) GetSafeLong(ctx context.Context, myParamArg safelong.SafeLong) (safelong.SafeLong, error) {
+	f.mu.Lock()
+	f.getSafeLongCalls = append(f.getSafeLongCalls, FakeTestServiceGetSafeLongCall{MyParam: myParamArg})
+	f.mu.Unlock()
+	if f.GetSafeLongFunc != nil {
+		return f.GetSafeLongFunc(ctx, myParamArg)
+	}
+	var defaultReturnVal safelong.SafeLong
+	return defaultReturnVal, f.defaultErr("getSafeLong")
+}
+
+// GetSafeLongCalls returns the arguments of every call made to GetSafeLong, in call order.
+func (f *FakeTestService) GetSafeLongCalls() []FakeTestServiceGetSafeLongCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceGetSafeLongCall(nil), f.getSafeLongCalls...)
+}
+
+// FakeTestServiceGetUuidCall records the arguments of a call to FakeTestService.GetUuid.
+type FakeTestServiceGetUuidCall struct {
+	MyParam uuid.UUID
+}
+
+func (f *FakeTestService) GetUuid(ctx context.Context, myParamArg uuid.UUID) (uuid.UUID, error) {
+	f.mu.Lock()
+	f.getUuidCalls = append(f.getUuidCalls, FakeTestServiceGetUuidCall{MyParam: myParamArg})
+	f.mu.Unlock()
+	if f.GetUuidFunc != nil {
+		return f.GetUuidFunc(ctx, myParamArg)
+	}
+	var defaultReturnVal uuid.UUID
+	return defaultReturnVal, f.defaultErr("getUuid")
+}
+
+// GetUuidCalls returns the arguments of every call made to GetUuid, in call order.
+func (f *FakeTestService) GetUuidCalls() []FakeTestServiceGetUuidCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceGetUuidCall(nil), f.getUuidCalls...)
+}
+
+// FakeTestServiceGetEnumCall records the arguments of a call to FakeTestService.GetEnum.
+type FakeTestServiceGetEnumCall struct {
+	MyParam CustomEnum
+}
+
+func (f *FakeTestService) GetEnum(ctx context.Context, myParamArg CustomEnum) (CustomEnum, error) {
+	f.mu.Lock()
+	f.getEnumCalls = append(f.getEnumCalls, FakeTestServiceGetEnumCall{MyParam: myParamArg})
+	f.mu.Unlock()
+	if f.GetEnumFunc != nil {
+		return f.GetEnumFunc(ctx, myParamArg)
+	}
+	var defaultReturnVal CustomEnum
+	return defaultReturnVal, f.defaultErr("getEnum")
+}
+
+// GetEnumCalls returns the arguments of every call made to GetEnum, in call order.
+func (f *FakeTestService) GetEnumCalls() []FakeTestServiceGetEnumCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceGetEnumCall(nil), f.getEnumCalls...)
+}
+
+// FakeTestServicePutBinaryCall records the arguments of a call to FakeTestService.PutBinary.
+type FakeTestServicePutBinaryCall struct {
+	MyParam io.ReadCloser
+}
+
+func (f *FakeTestService) PutBinary(ctx context.Context, myParamArg io.ReadCloser) (io.ReadCloser, error) {
+	f.mu.Lock()
+	f.putBinaryCalls = append(f.putBinaryCalls, FakeTestServicePutBinaryCall{MyParam: myParamArg})
+	f.mu.Unlock()
+	if f.PutBinaryFunc != nil {
+		return f.PutBinaryFunc(ctx, myParamArg)
+	}
+	var defaultReturnVal io.ReadCloser
+	return defaultReturnVal, f.defaultErr("putBinary")
+}
+
+// PutBinaryCalls returns the arguments of every call made to PutBinary, in call order.
+func (f *FakeTestService) PutBinaryCalls() []FakeTestServicePutBinaryCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServicePutBinaryCall(nil), f.putBinaryCalls...)
+}
+
+// FakeTestServiceGetOptionalBinaryCall records the arguments of a call to FakeTestService.GetOptionalBinary.
+type FakeTestServiceGetOptionalBinaryCall struct{}
+
+func (f *FakeTestService) GetOptionalBinary(ctx context.Context) (*io.ReadCloser, error) {
+	f.mu.Lock()
+	f.getOptionalBinaryCalls = append(f.getOptionalBinaryCalls, FakeTestServiceGetOptionalBinaryCall{})
+	f.mu.Unlock()
+	if f.GetOptionalBinaryFunc != nil {
+		return f.GetOptionalBinaryFunc(ctx)
+	}
+	var defaultReturnVal *io.ReadCloser
+	return defaultReturnVal, f.defaultErr("getOptionalBinary")
+}
+
+// GetOptionalBinaryCalls returns the arguments of every call made to GetOptionalBinary, in call order.
+func (f *FakeTestService) GetOptionalBinaryCalls() []FakeTestServiceGetOptionalBinaryCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceGetOptionalBinaryCall(nil), f.getOptionalBinaryCalls...)
+}
+
+// FakeTestServicePutCustomUnionCall records the arguments of a call to FakeTestService.PutCustomUnion.
+type FakeTestServicePutCustomUnionCall struct {
+	MyParam CustomUnion
+}
+
+func (f *FakeTestService) PutCustomUnion(ctx context.Context, myParamArg CustomUnion) (CustomUnion, error) {
+	f.mu.Lock()
+	f.putCustomUnionCalls = append(f.putCustomUnionCalls, FakeTestServicePutCustomUnionCall{MyParam: myParamArg})
+	f.mu.Unlock()
+	if f.PutCustomUnionFunc != nil {
+		return f.PutCustomUnionFunc(ctx, myParamArg)
+	}
+	var defaultReturnVal CustomUnion
+	return defaultReturnVal, f.defaultErr("putCustomUnion")
+}
+
+// PutCustomUnionCalls returns the arguments of every call made to PutCustomUnion, in call order.
+func (f *FakeTestService) PutCustomUnionCalls() []FakeTestServicePutCustomUnionCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServicePutCustomUnionCall(nil), f.putCustomUnionCalls...)
+}
+
+// FakeTestServiceGetReservedCall records the arguments of a call to FakeTestService.GetReserved.
+type FakeTestServiceGetReservedCall struct {
+	Conf        string
+	Bearertoken string
+}
+
+func (f *FakeTestService) GetReserved(ctx context.Context, confArg string, bearertokenArg string) error {
+	f.mu.Lock()
+	f.getReservedCalls = append(f.getReservedCalls, FakeTestServiceGetReservedCall{Conf: confArg, Bearertoken: bearertokenArg})
+	f.mu.Unlock()
+	if f.GetReservedFunc != nil {
+		return f.GetReservedFunc(ctx, confArg, bearertokenArg)
+	}
+	return f.defaultErr("getReserved")
+}
+
+// GetReservedCalls returns the arguments of every call made to GetReserved, in call order.
+func (f *FakeTestService) GetReservedCalls() []FakeTestServiceGetReservedCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceGetReservedCall(nil), f.getReservedCalls...)
+}
+
+// FakeTestServiceChanCall records the arguments of a call to FakeTestService.Chan.
+type FakeTestServiceChanCall struct {
+	Var    string
+	Import map[string]string
+	Type   string
+	Return safelong.SafeLong
+	Http   string
+	Json   string
+	Req    string
+	Rw     string
+}
+
+func (f *FakeTestService) Chan(ctx context.Context, varArg string, importArg map[string]string, typeArg string, returnArg safelong.SafeLong, httpArg string, jsonArg string, reqArg string, rwArg string) error {
+	f.mu.Lock()
+	f.chanCalls = append(f.chanCalls, FakeTestServiceChanCall{Var: varArg, Import: importArg, Type: typeArg, Return: returnArg, Http: httpArg, Json: jsonArg, Req: reqArg, Rw: rwArg})
+	f.mu.Unlock()
+	if f.ChanFunc != nil {
+		return f.ChanFunc(ctx, varArg, importArg, typeArg, returnArg, httpArg, jsonArg, reqArg, rwArg)
+	}
+	return f.defaultErr("chan")
+}
+
+// ChanCalls returns the arguments of every call made to Chan, in call order.
+func (f *FakeTestService) ChanCalls() []FakeTestServiceChanCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceChanCall(nil), f.chanCalls...)
+}
+
+func (f *FakeTestService) defaultErr(endpoint string) error {
+	if f.DefaultErr != nil {
+		return f.DefaultErr
+	}
+	return errors.NewInternal(wparams.NewSafeParamStorer(map[string]interface{}{"fakeEndpoint": endpoint}))
+}
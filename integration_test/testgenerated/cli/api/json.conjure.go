@@ -0,0 +1,266 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"encoding"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/palantir/pkg/safejson"
+	"github.com/tidwall/gjson"
+)
+
+// appendJSONString appends s encoded as a JSON string to out.
+func appendJSONString(out []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+	out = append(out, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if b := s[i]; b < utf8.RuneSelf {
+			if b >= ' ' && b != '"' && b != '\\' {
+				i++
+				continue
+			}
+			out = append(out, s[start:i]...)
+			switch b {
+			case '\\', '"':
+				out = append(out, '\\', b)
+			case '\b':
+				out = append(out, '\\', 'b')
+			case '\f':
+				out = append(out, '\\', 'f')
+			case '\n':
+				out = append(out, '\\', 'n')
+			case '\r':
+				out = append(out, '\\', 'r')
+			case '\t':
+				out = append(out, '\\', 't')
+			default:
+				out = append(out, '\\', 'u', '0', '0', hex[b>>4], hex[b&15])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			out = append(out, s[start:i]...)
+			out = append(out, "\\ufffd"...)
+			start = i + size
+		case r == '\u2028' || r == '\u2029':
+			out = append(out, s[start:i]...)
+			out = append(out, '\\', 'u', '2', '0', '2', hex[r&15])
+			start = i + size
+		}
+		i += size
+	}
+	out = append(out, s[start:]...)
+	return append(out, '"')
+}
+
+// jsonStringSize returns the length of s encoded as a JSON string.
+func jsonStringSize(s string) int {
+	size := len(s) + 2
+	for i := 0; i < len(s); {
+		if b := s[i]; b < utf8.RuneSelf {
+			switch {
+			case b == '\\' || b == '"' || b == '\b' || b == '\f' || b == '\n' || b == '\r' || b == '\t':
+				size++
+			case b < ' ':
+				size += 5
+			}
+			i++
+			continue
+		}
+		r, n := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == utf8.RuneError && n == 1:
+			// replaced with \ufffd
+			size += 5
+		case r == '\u2028' || r == '\u2029':
+			// escaped as \u2028 or \u2029
+			size += 3
+		}
+		i += n
+	}
+	return size
+}
+
+// jsonIntSize returns the length of the decimal representation of v.
+func jsonIntSize(v int64) int {
+	var buf [20]byte
+	return len(strconv.AppendInt(buf[:0], v, 10))
+}
+
+// appendJSONBinary appends b encoded as a base64 JSON string to out.
+func appendJSONBinary(out []byte, b []byte) []byte {
+	out = append(out, '"')
+	start := len(out)
+	out = append(out, make([]byte, base64.StdEncoding.EncodedLen(len(b)))...)
+	base64.StdEncoding.Encode(out[start:], b)
+	return append(out, '"')
+}
+
+// parseJSONStrict parses data, which must contain a single valid JSON value.
+func parseJSONStrict(data []byte) (gjson.Result, error) {
+	if !gjson.ValidBytes(data) {
+		return gjson.Result{}, fmt.Errorf("invalid JSON")
+	}
+	return gjson.ParseBytes(data), nil
+}
+
+// jsonTypeError returns the error for a value that is not of the expected kind.
+func jsonTypeError(value gjson.Result, want string) error {
+	var got string
+	switch value.Type {
+	case gjson.Null:
+		got = "null"
+	case gjson.False, gjson.True:
+		got = "boolean"
+	case gjson.Number:
+		got = "number"
+	case gjson.String:
+		got = "string"
+	default:
+		if value.IsArray() {
+			got = "array"
+		} else {
+			got = "object"
+		}
+	}
+	return fmt.Errorf("expected %s but found %s", want, got)
+}
+
+// decodeJSONString decodes a JSON string.
+func decodeJSONString(value gjson.Result) (string, error) {
+	if value.Type != gjson.String {
+		return "", jsonTypeError(value, "string")
+	}
+	return value.Str, nil
+}
+
+// decodeJSONInt decodes a JSON number that is a 32-bit integer.
+func decodeJSONInt(value gjson.Result) (int, error) {
+	if value.Type != gjson.Number {
+		return 0, jsonTypeError(value, "integer")
+	}
+	v, err := strconv.ParseInt(value.Raw, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid integer %s", value.Raw)
+	}
+	return int(v), nil
+}
+
+// decodeJSONBinary decodes a JSON string that is base64 encoded binary data.
+func decodeJSONBinary(value gjson.Result) ([]byte, error) {
+	if value.Type != gjson.String {
+		return nil, jsonTypeError(value, "binary")
+	}
+	return base64.StdEncoding.DecodeString(value.Str)
+}
+
+// decodeJSONAny decodes a JSON value that is not null using safejson.Unmarshal.
+func decodeJSONAny(value gjson.Result) (interface{}, error) {
+	if value.Type == gjson.Null {
+		return nil, jsonTypeError(value, "value")
+	}
+	var v interface{}
+	if err := safejson.Unmarshal([]byte(value.Raw), &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// decodeJSONEnum decodes a JSON string that matches ^[A-Z][A-Z0-9]*(_[A-Z0-9]+)*$ into v.
+func decodeJSONEnum(value gjson.Result, v encoding.TextUnmarshaler) error {
+	if value.Type != gjson.String {
+		return jsonTypeError(value, "enum")
+	}
+	s := value.Str
+	if s == "" {
+		return fmt.Errorf("invalid enum value %q", s)
+	}
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c >= 'A' && c <= 'Z':
+		case c >= '0' && c <= '9':
+			if i == 0 {
+				return fmt.Errorf("invalid enum value %q", s)
+			}
+		case c == '_':
+			if i == 0 || i == len(s)-1 || s[i-1] == '_' {
+				return fmt.Errorf("invalid enum value %q", s)
+			}
+		default:
+			return fmt.Errorf("invalid enum value %q", s)
+		}
+	}
+	return v.UnmarshalText([]byte(s))
+}
+
+// unmarshalJSONString decodes a JSON string or null into v like encoding/json. It returns false for other values
+// and for strings that gjson may unescape differently, which are strings that are not valid UTF-8 or that
+// contain escaped surrogates.
+func unmarshalJSONString(value gjson.Result, v *string) bool {
+	switch value.Type {
+	case gjson.Null:
+		return true
+	case gjson.String:
+		if !utf8.ValidString(value.Raw) || strings.Contains(value.Raw, "\\ud") || strings.Contains(value.Raw, "\\uD") {
+			return false
+		}
+		*v = value.Str
+		return true
+	}
+	return false
+}
+
+// unmarshalJSONInt decodes a JSON number or null into v like encoding/json.
+func unmarshalJSONInt(value gjson.Result, v *int) bool {
+	switch value.Type {
+	case gjson.Null:
+		return true
+	case gjson.Number:
+		n := value.Raw
+		parsed, err := strconv.Atoi(n)
+		if err != nil {
+			return false
+		}
+		*v = parsed
+		return true
+	}
+	return false
+}
+
+// unmarshalJSONBinary decodes a JSON string that is base64 encoded binary data or null into v like encoding/json.
+func unmarshalJSONBinary(value gjson.Result, v *[]byte) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	var s string
+	if !unmarshalJSONString(value, &s) {
+		return false
+	}
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return false
+	}
+	*v = b
+	return true
+}
+
+// matchesJSONField returns true if encoding/json decodes the object key into one of the fields with the
+// provided names, which it matches case-insensitively.
+func matchesJSONField(key string, names ...string) bool {
+	for _, name := range names {
+		if strings.EqualFold(key, name) {
+			return true
+		}
+	}
+	return false
+}
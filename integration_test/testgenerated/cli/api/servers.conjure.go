@@ -4,6 +4,7 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"strconv"
@@ -19,6 +20,7 @@ import (
 	werror "github.com/palantir/witchcraft-go-error"
 	"github.com/palantir/witchcraft-go-server/v2/witchcraft/wresource"
 	"github.com/palantir/witchcraft-go-server/v2/wrouter"
+	"github.com/tidwall/gjson"
 )
 
 type TestService interface {
@@ -136,7 +138,28 @@ func (t *testServiceHandler) HandleEcho(rw http.ResponseWriter, req *http.Reques
 
 func (t *testServiceHandler) HandleEchoStrings(rw http.ResponseWriter, req *http.Request) error {
 	var bodyArg []string
-	if err := codecs.JSON.Decode(req.Body, &bodyArg); err != nil {
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	if !value.IsArray() {
+		return errors.WrapWithInvalidArgument(jsonTypeError(value, "array"))
+	}
+	bodyArg = make([]string, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v string
+		v, err = decodeJSONString(elem)
+		if err != nil {
+			return false
+		}
+		bodyArg = append(bodyArg, v)
+		return true
+	})
+	if err != nil {
 		return errors.WrapWithInvalidArgument(err)
 	}
 	respArg, err := t.impl.EchoStrings(req.Context(), bodyArg)
@@ -150,9 +173,22 @@ func (t *testServiceHandler) HandleEchoStrings(rw http.ResponseWriter, req *http
 func (t *testServiceHandler) HandleEchoCustomObject(rw http.ResponseWriter, req *http.Request) error {
 	var bodyArg *CustomObject
 	if req.Body != nil && req.Body != http.NoBody {
-		if err := codecs.JSON.Decode(req.Body, &bodyArg); err != nil {
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
 			return errors.WrapWithInvalidArgument(err)
 		}
+		value, err := parseJSONStrict(data)
+		if err != nil {
+			return errors.WrapWithInvalidArgument(err)
+		}
+		if value.Type != gjson.Null {
+			var v CustomObject
+			err = v.decodeJSONStrict(value)
+			if err != nil {
+				return errors.WrapWithInvalidArgument(err)
+			}
+			bodyArg = &v
+		}
 	}
 	respArg, err := t.impl.EchoCustomObject(req.Context(), bodyArg)
 	if err != nil {
@@ -169,7 +205,11 @@ func (t *testServiceHandler) HandleEchoCustomObject(rw http.ResponseWriter, req
 func (t *testServiceHandler) HandleEchoOptionalAlias(rw http.ResponseWriter, req *http.Request) error {
 	var bodyArg OptionalIntegerAlias
 	if req.Body != nil && req.Body != http.NoBody {
-		if err := codecs.JSON.Decode(req.Body, &bodyArg); err != nil {
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			return errors.WrapWithInvalidArgument(err)
+		}
+		if err := bodyArg.UnmarshalJSONStrict(data); err != nil {
 			return errors.WrapWithInvalidArgument(err)
 		}
 	}
@@ -188,7 +228,11 @@ func (t *testServiceHandler) HandleEchoOptionalAlias(rw http.ResponseWriter, req
 func (t *testServiceHandler) HandleEchoOptionalListAlias(rw http.ResponseWriter, req *http.Request) error {
 	var bodyArg OptionalListAlias
 	if req.Body != nil && req.Body != http.NoBody {
-		if err := codecs.JSON.Decode(req.Body, &bodyArg); err != nil {
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			return errors.WrapWithInvalidArgument(err)
+		}
+		if err := bodyArg.UnmarshalJSONStrict(data); err != nil {
 			return errors.WrapWithInvalidArgument(err)
 		}
 	}
@@ -243,7 +287,34 @@ func (t *testServiceHandler) HandleGetListBoolean(rw http.ResponseWriter, req *h
 
 func (t *testServiceHandler) HandlePutMapStringString(rw http.ResponseWriter, req *http.Request) error {
 	var myParamArg map[string]string
-	if err := codecs.JSON.Decode(req.Body, &myParamArg); err != nil {
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	if !value.IsObject() {
+		return errors.WrapWithInvalidArgument(jsonTypeError(value, "object"))
+	}
+	myParamArg = make(map[string]string)
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k string
+		k = key.Str
+		if _, ok := myParamArg[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v string
+		v, err = decodeJSONString(elem)
+		if err != nil {
+			return false
+		}
+		myParamArg[k] = v
+		return true
+	})
+	if err != nil {
 		return errors.WrapWithInvalidArgument(err)
 	}
 	respArg, err := t.impl.PutMapStringString(req.Context(), myParamArg)
@@ -256,7 +327,34 @@ func (t *testServiceHandler) HandlePutMapStringString(rw http.ResponseWriter, re
 
 func (t *testServiceHandler) HandlePutMapStringAny(rw http.ResponseWriter, req *http.Request) error {
 	var myParamArg map[string]interface{}
-	if err := codecs.JSON.Decode(req.Body, &myParamArg); err != nil {
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	if !value.IsObject() {
+		return errors.WrapWithInvalidArgument(jsonTypeError(value, "object"))
+	}
+	myParamArg = make(map[string]interface{})
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k string
+		k = key.Str
+		if _, ok := myParamArg[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v interface{}
+		v, err = decodeJSONAny(elem)
+		if err != nil {
+			return false
+		}
+		myParamArg[k] = v
+		return true
+	})
+	if err != nil {
 		return errors.WrapWithInvalidArgument(err)
 	}
 	respArg, err := t.impl.PutMapStringAny(req.Context(), myParamArg)
@@ -370,7 +468,11 @@ func (t *testServiceHandler) HandleGetOptionalBinary(rw http.ResponseWriter, req
 
 func (t *testServiceHandler) HandlePutCustomUnion(rw http.ResponseWriter, req *http.Request) error {
 	var myParamArg CustomUnion
-	if err := codecs.JSON.Decode(req.Body, &myParamArg); err != nil {
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	if err := myParamArg.UnmarshalJSONStrict(data); err != nil {
 		return errors.WrapWithInvalidArgument(err)
 	}
 	respArg, err := t.impl.PutCustomUnion(req.Context(), myParamArg)
@@ -410,7 +512,34 @@ func (t *testServiceHandler) HandleChan(rw http.ResponseWriter, req *http.Reques
 		return werror.WrapWithContextParams(req.Context(), errors.WrapWithInvalidArgument(err), "failed to parse \"return\" as safelong")
 	}
 	var importArg map[string]string
-	if err := codecs.JSON.Decode(req.Body, &importArg); err != nil {
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	if !value.IsObject() {
+		return errors.WrapWithInvalidArgument(jsonTypeError(value, "object"))
+	}
+	importArg = make(map[string]string)
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k string
+		k = key.Str
+		if _, ok := importArg[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v string
+		v, err = decodeJSONString(elem)
+		if err != nil {
+			return false
+		}
+		importArg[k] = v
+		return true
+	})
+	if err != nil {
 		return errors.WrapWithInvalidArgument(err)
 	}
 	if err := t.impl.Chan(req.Context(), varArg, importArg, typeArg, returnArg, httpArg, jsonArg, reqArg, rwArg); err != nil {
@@ -5,9 +5,11 @@ package api
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type CustomUnion struct {
@@ -26,7 +28,7 @@ func (u *customUnionDeserializer) toStruct() CustomUnion {
 	return CustomUnion{typ: u.Type, asString: u.AsString, asInteger: u.AsInteger}
 }
 
-func (u *CustomUnion) toSerializer() (interface{}, error) {
+func (u CustomUnion) AppendJSON(out []byte) ([]byte, error) {
 	switch u.typ {
 	default:
 		return nil, fmt.Errorf("unknown type %q", u.typ)
@@ -34,30 +36,52 @@ func (u *CustomUnion) toSerializer() (interface{}, error) {
 		if u.asString == nil {
 			return nil, fmt.Errorf("field \"asString\" is required")
 		}
-		return struct {
-			Type     string `json:"type"`
-			AsString string `json:"asString"`
-		}{Type: "asString", AsString: *u.asString}, nil
+		out = append(out, "{\"type\":\"asString\",\"asString\":"...)
+		out = appendJSONString(out, *u.asString)
 	case "asInteger":
 		if u.asInteger == nil {
 			return nil, fmt.Errorf("field \"asInteger\" is required")
 		}
-		return struct {
-			Type      string `json:"type"`
-			AsInteger int    `json:"asInteger"`
-		}{Type: "asInteger", AsInteger: *u.asInteger}, nil
+		out = append(out, "{\"type\":\"asInteger\",\"asInteger\":"...)
+		out = strconv.AppendInt(out, int64(*u.asInteger), 10)
 	}
+	out = append(out, '}')
+	return out, nil
 }
 
-func (u CustomUnion) MarshalJSON() ([]byte, error) {
-	ser, err := u.toSerializer()
-	if err != nil {
-		return nil, err
+func (u CustomUnion) JSONSize() (int, error) {
+	var size int
+	switch u.typ {
+	default:
+		return 0, fmt.Errorf("unknown type %q", u.typ)
+	case "asString":
+		if u.asString == nil {
+			return 0, fmt.Errorf("field \"asString\" is required")
+		}
+		size = 31
+		size += jsonStringSize(*u.asString)
+	case "asInteger":
+		if u.asInteger == nil {
+			return 0, fmt.Errorf("field \"asInteger\" is required")
+		}
+		size = 33
+		size += jsonIntSize(int64(*u.asInteger))
 	}
-	return safejson.Marshal(ser)
+	return size, nil
+}
+
+func (u CustomUnion) MarshalJSON() ([]byte, error) {
+	return u.AppendJSON(nil)
 }
 
 func (u *CustomUnion) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v CustomUnion
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*u = v
+			return nil
+		}
+	}
 	var deser customUnionDeserializer
 	if err := safejson.Unmarshal(data, &deser); err != nil {
 		return err
@@ -76,6 +100,155 @@ func (u *CustomUnion) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (u *CustomUnion) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenType, seenAsString, seenAsInteger bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !unmarshalJSONString(field, &u.typ) {
+				ok = false
+				return false
+			}
+		case "asString":
+			if seenAsString {
+				ok = false
+				return false
+			}
+			seenAsString = true
+			if field.Type != gjson.Null {
+				var v string
+				if !unmarshalJSONString(field, &v) {
+					ok = false
+					return false
+				}
+				u.asString = &v
+			}
+		case "asInteger":
+			if seenAsInteger {
+				ok = false
+				return false
+			}
+			seenAsInteger = true
+			if field.Type != gjson.Null {
+				var v1 int
+				if !unmarshalJSONInt(field, &v1) {
+					ok = false
+					return false
+				}
+				u.asInteger = &v1
+			}
+		default:
+			if matchesJSONField(key.Str, "type", "asString", "asInteger") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	switch u.typ {
+	case "asString":
+		if u.asString == nil {
+			return false
+		}
+	case "asInteger":
+		if u.asInteger == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *CustomUnion) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return u.decodeJSONStrict(value)
+}
+
+func (u *CustomUnion) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	var typ string
+	var variantName string
+	var variant gjson.Result
+	var seenType, seenVariant bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch {
+		case key.Str == "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			typ, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"type\": %w", err)
+				return false
+			}
+			return true
+		case seenVariant && key.Str == variantName:
+			err = fmt.Errorf("duplicate field %q", key.Str)
+			return false
+		case seenVariant:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		variantName, variant, seenVariant = key.Str, field, true
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenType {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	if seenVariant && variantName != typ {
+		return fmt.Errorf("unknown field %q", variantName)
+	}
+	*u = CustomUnion{typ: typ}
+	switch typ {
+	case "asString":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"asString\" is required")
+		}
+		var v string
+		v, err = decodeJSONString(variant)
+		if err != nil {
+			return fmt.Errorf("field \"asString\": %w", err)
+		}
+		u.asString = &v
+	case "asInteger":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"asInteger\" is required")
+		}
+		var v1 int
+		v1, err = decodeJSONInt(variant)
+		if err != nil {
+			return fmt.Errorf("field \"asInteger\": %w", err)
+		}
+		u.asInteger = &v1
+	}
+	return nil
+}
+
 func (u CustomUnion) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(u)
 	if err != nil {
@@ -183,3 +356,32 @@ func NewCustomUnionFromAsString(v string) CustomUnion {
 func NewCustomUnionFromAsInteger(v int) CustomUnion {
 	return CustomUnion{typ: "asInteger", asInteger: &v}
 }
+
+// Equal returns true if the CustomUnion is equal to other according to the Conjure semantics of its values.
+func (u CustomUnion) Equal(other CustomUnion) bool {
+	if u.typ != other.typ {
+		return false
+	}
+	if (u.asString == nil) != (other.asString == nil) {
+		return false
+	}
+	if u.asString != nil {
+		if *u.asString != *other.asString {
+			return false
+		}
+	}
+	if (u.asInteger == nil) != (other.asInteger == nil) {
+		return false
+	}
+	if u.asInteger != nil {
+		if *u.asInteger != *other.asInteger {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the CustomUnion.
+func (u CustomUnion) Clone() CustomUnion {
+	return u
+}
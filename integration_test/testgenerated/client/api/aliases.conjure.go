@@ -6,10 +6,36 @@ import (
 	"github.com/palantir/pkg/rid"
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type RidAlias rid.ResourceIdentifier
 
+func (a RidAlias) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out, err = appendJSONText(out, rid.ResourceIdentifier(a))
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (a RidAlias) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	n, err = jsonTextSize(rid.ResourceIdentifier(a))
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (a RidAlias) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
 func (a RidAlias) String() string {
 	return rid.ResourceIdentifier(a).String()
 }
@@ -43,4 +69,62 @@ func (a *RidAlias) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+func (a *RidAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *RidAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v rid.ResourceIdentifier
+	v, err = decodeJSONRID(value)
+	if err != nil {
+		return err
+	}
+	*a = RidAlias(v)
+	return nil
+}
+
+// Equal returns true if the RidAlias is equal to other according to the Conjure semantics of its values.
+func (a RidAlias) Equal(other RidAlias) bool {
+	return rid.ResourceIdentifier(a) == rid.ResourceIdentifier(other)
+}
+
+// Clone returns a deep copy of the RidAlias.
+func (a RidAlias) Clone() RidAlias {
+	return a
+}
+
 type StringAlias string
+
+func (a *StringAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *StringAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v string
+	v, err = decodeJSONString(value)
+	if err != nil {
+		return err
+	}
+	*a = StringAlias(v)
+	return nil
+}
+
+// Equal returns true if the StringAlias is equal to other according to the Conjure semantics of its values.
+func (a StringAlias) Equal(other StringAlias) bool {
+	return string(a) == string(other)
+}
+
+// Clone returns a deep copy of the StringAlias.
+func (a StringAlias) Clone() StringAlias {
+	return a
+}
@@ -0,0 +1,253 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/pkg/rid"
+	wparams "github.com/palantir/witchcraft-go-params"
+)
+
+// FakeTestService is an in-memory implementation of TestService for use in tests.
+// Each endpoint records its arguments and then invokes the corresponding <Endpoint>Func field.
+// If the field is nil, the endpoint returns DefaultErr (or a Conjure Internal error if DefaultErr is nil).
+// The zero value is ready to use and all methods are safe for concurrent use.
+type FakeTestService struct {
+	// EchoFunc is invoked by Echo if non-nil.
+	EchoFunc func(ctx context.Context) error
+	// PathParamFunc is invoked by PathParam if non-nil.
+	PathParamFunc func(ctx context.Context, paramArg string) error
+	// PathParamAliasFunc is invoked by PathParamAlias if non-nil.
+	PathParamAliasFunc func(ctx context.Context, paramArg StringAlias) error
+	// PathParamRidFunc is invoked by PathParamRid if non-nil.
+	PathParamRidFunc func(ctx context.Context, paramArg rid.ResourceIdentifier) error
+	// PathParamRidAliasFunc is invoked by PathParamRidAlias if non-nil.
+	PathParamRidAliasFunc func(ctx context.Context, paramArg RidAlias) error
+	// BytesFunc is invoked by Bytes if non-nil.
+	BytesFunc func(ctx context.Context) (CustomObject, error)
+	// BinaryFunc is invoked by Binary if non-nil.
+	BinaryFunc func(ctx context.Context) (io.ReadCloser, error)
+	// MaybeBinaryFunc is invoked by MaybeBinary if non-nil.
+	MaybeBinaryFunc func(ctx context.Context) (*io.ReadCloser, error)
+	// QueryFunc is invoked by Query if non-nil.
+	QueryFunc func(ctx context.Context, queryArg *StringAlias) error
+	// DefaultErr is returned by endpoints whose func field is nil.
+	DefaultErr error
+
+	mu                     sync.Mutex
+	echoCalls              []FakeTestServiceEchoCall
+	pathParamCalls         []FakeTestServicePathParamCall
+	pathParamAliasCalls    []FakeTestServicePathParamAliasCall
+	pathParamRidCalls      []FakeTestServicePathParamRidCall
+	pathParamRidAliasCalls []FakeTestServicePathParamRidAliasCall
+	bytesCalls             []FakeTestServiceBytesCall
+	binaryCalls            []FakeTestServiceBinaryCall
+	maybeBinaryCalls       []FakeTestServiceMaybeBinaryCall
+	queryCalls             []FakeTestServiceQueryCall
+}
+
+var _ TestService = (*FakeTestService)(nil)
+
+// FakeTestServiceEchoCall records the arguments of a call to FakeTestService.Echo.
+type FakeTestServiceEchoCall struct{}
+
+func (f *FakeTestService) Echo(ctx context.Context) error {
+	f.mu.Lock()
+	f.echoCalls = append(f.echoCalls, FakeTestServiceEchoCall{})
+	f.mu.Unlock()
+	if f.EchoFunc != nil {
+		return f.EchoFunc(ctx)
+	}
+	return f.defaultErr("echo")
+}
+
+// EchoCalls returns the arguments of every call made to Echo, in call order.
+func (f *FakeTestService) EchoCalls() []FakeTestServiceEchoCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceEchoCall(nil), f.echoCalls...)
+}
+
+// FakeTestServicePathParamCall records the arguments of a call to FakeTestService.PathParam.
+type FakeTestServicePathParamCall struct {
+	Param string
+}
+
+func (f *FakeTestService) PathParam(ctx context.Context, paramArg string) error {
+	f.mu.Lock()
+	f.pathParamCalls = append(f.pathParamCalls, FakeTestServicePathParamCall{Param: paramArg})
+	f.mu.Unlock()
+	if f.PathParamFunc != nil {
+		return f.PathParamFunc(ctx, paramArg)
+	}
+	return f.defaultErr("pathParam")
+}
+
+// PathParamCalls returns the arguments of every call made to PathParam, in call order.
+func (f *FakeTestService) PathParamCalls() []FakeTestServicePathParamCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServicePathParamCall(nil), f.pathParamCalls...)
+}
+
+// FakeTestServicePathParamAliasCall records the arguments of a call to FakeTestService.PathParamAlias.
+type FakeTestServicePathParamAliasCall struct {
+	Param StringAlias
+}
+
+func (f *FakeTestService) PathParamAlias(ctx context.Context, paramArg StringAlias) error {
+	f.mu.Lock()
+	f.pathParamAliasCalls = append(f.pathParamAliasCalls, FakeTestServicePathParamAliasCall{Param: paramArg})
+	f.mu.Unlock()
+	if f.PathParamAliasFunc != nil {
+		return f.PathParamAliasFunc(ctx, paramArg)
+	}
+	return f.defaultErr("pathParamAlias")
+}
+
+// PathParamAliasCalls returns the arguments of every call made to PathParamAlias, in call order.
+func (f *FakeTestService) PathParamAliasCalls() []FakeTestServicePathParamAliasCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServicePathParamAliasCall(nil), f.pathParamAliasCalls...)
+}
+
+// FakeTestServicePathParamRidCall records the arguments of a call to FakeTestService.PathParamRid.
+type FakeTestServicePathParamRidCall struct {
+	Param rid.ResourceIdentifier
+}
+
+func (f *FakeTestService) PathParamRid(ctx context.Context, paramArg rid.ResourceIdentifier) error {
+	f.mu.Lock()
+	f.pathParamRidCalls = append(f.pathParamRidCalls, FakeTestServicePathParamRidCall{Param: paramArg})
+	f.mu.Unlock()
+	if f.PathParamRidFunc != nil {
+		return f.PathParamRidFunc(ctx, paramArg)
+	}
+	return f.defaultErr("pathParamRid")
+}
+
+// PathParamRidCalls returns the arguments of every call made to PathParamRid, in call order.
+func (f *FakeTestService) PathParamRidCalls() []FakeTestServicePathParamRidCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServicePathParamRidCall(nil), f.pathParamRidCalls...)
+}
+
+// FakeTestServicePathParamRidAliasCall records the arguments of a call to FakeTestService.PathParamRidAlias.
+type FakeTestServicePathParamRidAliasCall struct {
+	Param RidAlias
+}
+
+func (f *FakeTestService) PathParamRidAlias(ctx context.Context, paramArg RidAlias) error {
+	f.mu.Lock()
+	f.pathParamRidAliasCalls = append(f.pathParamRidAliasCalls, FakeTestServicePathParamRidAliasCall{Param: paramArg})
+	f.mu.Unlock()
+	if f.PathParamRidAliasFunc != nil {
+		return f.PathParamRidAliasFunc(ctx, paramArg)
+	}
+	return f.defaultErr("pathParamRidAlias")
+}
+
+// PathParamRidAliasCalls returns the arguments of every call made to PathParamRidAlias, in call order.
+func (f *FakeTestService) PathParamRidAliasCalls() []FakeTestServicePathParamRidAliasCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServicePathParamRidAliasCall(nil), f.pathParamRidAliasCalls...)
+}
+
+// FakeTestServiceBytesCall records the arguments of a call to FakeTestService.Bytes.
+type FakeTestServiceBytesCall struct{}
+
+func (f *FakeTestService) Bytes(ctx context.Context) (CustomObject, error) {
+	f.mu.Lock()
+	f.bytesCalls = append(f.bytesCalls, FakeTestServiceBytesCall{})
+	f.mu.Unlock()
+	if f.BytesFunc != nil {
+		return f.BytesFunc(ctx)
+	}
+	var defaultReturnVal CustomObject
+	return defaultReturnVal, f.defaultErr("bytes")
+}
+
+// BytesCalls returns the arguments of every call made to Bytes, in call order.
+func (f *FakeTestService) BytesCalls() []FakeTestServiceBytesCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceBytesCall(nil), f.bytesCalls...)
+}
+
+// FakeTestServiceBinaryCall records the arguments of a call to FakeTestService.Binary.
+type FakeTestServiceBinaryCall struct{}
+
+func (f *FakeTestService) Binary(ctx context.Context) (io.ReadCloser, error) {
+	f.mu.Lock()
+	f.binaryCalls = append(f.binaryCalls, FakeTestServiceBinaryCall{})
+	f.mu.Unlock()
+	if f.BinaryFunc != nil {
+		return f.BinaryFunc(ctx)
+	}
+	var defaultReturnVal io.ReadCloser
+	return defaultReturnVal, f.defaultErr("binary")
+}
+
+// BinaryCalls returns the arguments of every call made to Binary, in call order.
+func (f *FakeTestService) BinaryCalls() []FakeTestServiceBinaryCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceBinaryCall(nil), f.binaryCalls...)
+}
+
+// FakeTestServiceMaybeBinaryCall records the arguments of a call to FakeTestService.MaybeBinary.
+type FakeTestServiceMaybeBinaryCall struct{}
+
+func (f *FakeTestService) MaybeBinary(ctx context.Context) (*io.ReadCloser, error) {
+	f.mu.Lock()
+	f.maybeBinaryCalls = append(f.maybeBinaryCalls, FakeTestServiceMaybeBinaryCall{})
+	f.mu.Unlock()
+	if f.MaybeBinaryFunc != nil {
+		return f.MaybeBinaryFunc(ctx)
+	}
+	var defaultReturnVal *io.ReadCloser
+	return defaultReturnVal, f.defaultErr("maybeBinary")
+}
+
+// MaybeBinaryCalls returns the arguments of every call made to MaybeBinary, in call order.
+func (f *FakeTestService) MaybeBinaryCalls() []FakeTestServiceMaybeBinaryCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceMaybeBinaryCall(nil), f.maybeBinaryCalls...)
+}
+
+// FakeTestServiceQueryCall records the arguments of a call to FakeTestService.Query.
+type FakeTestServiceQueryCall struct {
+	Query *StringAlias
+}
+
+func (f *FakeTestService) Query(ctx context.Context, queryArg *StringAlias) error {
+	f.mu.Lock()
+	f.queryCalls = append(f.queryCalls, FakeTestServiceQueryCall{Query: queryArg})
+	f.mu.Unlock()
+	if f.QueryFunc != nil {
+		return f.QueryFunc(ctx, queryArg)
+	}
+	return f.defaultErr("query")
+}
+
+// QueryCalls returns the arguments of every call made to Query, in call order.
+func (f *FakeTestService) QueryCalls() []FakeTestServiceQueryCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceQueryCall(nil), f.queryCalls...)
+}
+
+func (f *FakeTestService) defaultErr(endpoint string) error {
+	if f.DefaultErr != nil {
+		return f.DefaultErr
+	}
+	return errors.NewInternal(wparams.NewSafeParamStorer(map[string]interface{}{"fakeEndpoint": endpoint}))
+}
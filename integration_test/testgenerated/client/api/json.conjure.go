@@ -0,0 +1,217 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"encoding"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/palantir/pkg/rid"
+	"github.com/tidwall/gjson"
+)
+
+// appendJSONString appends s encoded as a JSON string to out.
+func appendJSONString(out []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+	out = append(out, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if b := s[i]; b < utf8.RuneSelf {
+			if b >= ' ' && b != '"' && b != '\\' {
+				i++
+				continue
+			}
+			out = append(out, s[start:i]...)
+			switch b {
+			case '\\', '"':
+				out = append(out, '\\', b)
+			case '\b':
+				out = append(out, '\\', 'b')
+			case '\f':
+				out = append(out, '\\', 'f')
+			case '\n':
+				out = append(out, '\\', 'n')
+			case '\r':
+				out = append(out, '\\', 'r')
+			case '\t':
+				out = append(out, '\\', 't')
+			default:
+				out = append(out, '\\', 'u', '0', '0', hex[b>>4], hex[b&15])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			out = append(out, s[start:i]...)
+			out = append(out, "\\ufffd"...)
+			start = i + size
+		case r == '\u2028' || r == '\u2029':
+			out = append(out, s[start:i]...)
+			out = append(out, '\\', 'u', '2', '0', '2', hex[r&15])
+			start = i + size
+		}
+		i += size
+	}
+	out = append(out, s[start:]...)
+	return append(out, '"')
+}
+
+// jsonStringSize returns the length of s encoded as a JSON string.
+func jsonStringSize(s string) int {
+	size := len(s) + 2
+	for i := 0; i < len(s); {
+		if b := s[i]; b < utf8.RuneSelf {
+			switch {
+			case b == '\\' || b == '"' || b == '\b' || b == '\f' || b == '\n' || b == '\r' || b == '\t':
+				size++
+			case b < ' ':
+				size += 5
+			}
+			i++
+			continue
+		}
+		r, n := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == utf8.RuneError && n == 1:
+			// replaced with \ufffd
+			size += 5
+		case r == '\u2028' || r == '\u2029':
+			// escaped as \u2028 or \u2029
+			size += 3
+		}
+		i += n
+	}
+	return size
+}
+
+// appendJSONBinary appends b encoded as a base64 JSON string to out.
+func appendJSONBinary(out []byte, b []byte) []byte {
+	out = append(out, '"')
+	start := len(out)
+	out = append(out, make([]byte, base64.StdEncoding.EncodedLen(len(b)))...)
+	base64.StdEncoding.Encode(out[start:], b)
+	return append(out, '"')
+}
+
+// appendJSONText appends the text form of v as a JSON string to out.
+func appendJSONText(out []byte, v encoding.TextMarshaler) ([]byte, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return appendJSONString(out, string(text)), nil
+}
+
+// jsonTextSize returns the length of the output of appendJSONText.
+func jsonTextSize(v encoding.TextMarshaler) (int, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return 0, err
+	}
+	return jsonStringSize(string(text)), nil
+}
+
+// parseJSONStrict parses data, which must contain a single valid JSON value.
+func parseJSONStrict(data []byte) (gjson.Result, error) {
+	if !gjson.ValidBytes(data) {
+		return gjson.Result{}, fmt.Errorf("invalid JSON")
+	}
+	return gjson.ParseBytes(data), nil
+}
+
+// jsonTypeError returns the error for a value that is not of the expected kind.
+func jsonTypeError(value gjson.Result, want string) error {
+	var got string
+	switch value.Type {
+	case gjson.Null:
+		got = "null"
+	case gjson.False, gjson.True:
+		got = "boolean"
+	case gjson.Number:
+		got = "number"
+	case gjson.String:
+		got = "string"
+	default:
+		if value.IsArray() {
+			got = "array"
+		} else {
+			got = "object"
+		}
+	}
+	return fmt.Errorf("expected %s but found %s", want, got)
+}
+
+// decodeJSONString decodes a JSON string.
+func decodeJSONString(value gjson.Result) (string, error) {
+	if value.Type != gjson.String {
+		return "", jsonTypeError(value, "string")
+	}
+	return value.Str, nil
+}
+
+// decodeJSONRID decodes a JSON string that is a resource identifier.
+func decodeJSONRID(value gjson.Result) (rid.ResourceIdentifier, error) {
+	if value.Type != gjson.String {
+		return rid.ResourceIdentifier{}, jsonTypeError(value, "rid")
+	}
+	return rid.ParseRID(value.Str)
+}
+
+// decodeJSONBinary decodes a JSON string that is base64 encoded binary data.
+func decodeJSONBinary(value gjson.Result) ([]byte, error) {
+	if value.Type != gjson.String {
+		return nil, jsonTypeError(value, "binary")
+	}
+	return base64.StdEncoding.DecodeString(value.Str)
+}
+
+// unmarshalJSONString decodes a JSON string or null into v like encoding/json. It returns false for other values
+// and for strings that gjson may unescape differently, which are strings that are not valid UTF-8 or that
+// contain escaped surrogates.
+func unmarshalJSONString(value gjson.Result, v *string) bool {
+	switch value.Type {
+	case gjson.Null:
+		return true
+	case gjson.String:
+		if !utf8.ValidString(value.Raw) || strings.Contains(value.Raw, "\\ud") || strings.Contains(value.Raw, "\\uD") {
+			return false
+		}
+		*v = value.Str
+		return true
+	}
+	return false
+}
+
+// unmarshalJSONBinary decodes a JSON string that is base64 encoded binary data or null into v like encoding/json.
+func unmarshalJSONBinary(value gjson.Result, v *[]byte) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	var s string
+	if !unmarshalJSONString(value, &s) {
+		return false
+	}
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return false
+	}
+	*v = b
+	return true
+}
+
+// matchesJSONField returns true if encoding/json decodes the object key into one of the fields with the
+// provided names, which it matches case-insensitively.
+func matchesJSONField(key string, names ...string) bool {
+	for _, name := range names {
+		if strings.EqualFold(key, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -3,14 +3,118 @@
 package api
 
 import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type CustomObject struct {
 	Data []byte `json:"data"`
 }
 
+func (o CustomObject) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"data\":"...)
+	if o.Data == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONBinary(out, o.Data)
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o CustomObject) JSONSize() (int, error) {
+	size := 9
+	if o.Data == nil {
+		size += 4
+	} else {
+		size += base64.StdEncoding.EncodedLen(len(o.Data)) + 2
+	}
+	return size, nil
+}
+
+func (o *CustomObject) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenData bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "data":
+			if seenData {
+				ok = false
+				return false
+			}
+			seenData = true
+			if !unmarshalJSONBinary(field, &o.Data) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "data") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *CustomObject) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *CustomObject) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = CustomObject{}
+	var seenData bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "data":
+			if seenData {
+				err = fmt.Errorf("duplicate field \"data\"")
+				return false
+			}
+			seenData = true
+			o.Data, err = decodeJSONBinary(field)
+			if err != nil {
+				err = fmt.Errorf("field \"data\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenData {
+		return fmt.Errorf("field \"data\" is required")
+	}
+	return nil
+}
+
 func (o CustomObject) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -26,3 +130,21 @@ func (o *CustomObject) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	}
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
+
+// Equal returns true if the CustomObject is equal to other according to the Conjure semantics of its values.
+func (o CustomObject) Equal(other CustomObject) bool {
+	if !bytes.Equal(o.Data, other.Data) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the CustomObject.
+func (o CustomObject) Clone() CustomObject {
+	out := o
+	if o.Data != nil {
+		out.Data = make([]byte, len(o.Data))
+		copy(out.Data, o.Data)
+	}
+	return out
+}
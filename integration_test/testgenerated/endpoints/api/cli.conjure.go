@@ -0,0 +1,267 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/codecs"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/pkg/bearertoken"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	wlogzap "github.com/palantir/witchcraft-go-logging/wlog-zap"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/trclog/trc1log"
+	"github.com/palantir/witchcraft-go-tracing/wtracing"
+	"github.com/palantir/witchcraft-go-tracing/wzipkin"
+	"github.com/spf13/cobra"
+	pflag "github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+type CLIConfig struct {
+	Client httpclient.ClientConfig `yaml:",inline"`
+}
+
+// Commands for ItemService
+
+type CLIItemServiceClientProvider interface {
+	Get(ctx context.Context, flags *pflag.FlagSet) (ItemServiceClient, error)
+}
+
+type defaultCLIItemServiceClientProvider struct{}
+
+func NewDefaultCLIItemServiceClientProvider() CLIItemServiceClientProvider {
+	return defaultCLIItemServiceClientProvider{}
+}
+
+func (d defaultCLIItemServiceClientProvider) Get(ctx context.Context, flags *pflag.FlagSet) (ItemServiceClient, error) {
+	conf, err := loadCLIConfig(ctx, flags)
+	if err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "failed to load CLI configuration file")
+	}
+	client, err := httpclient.NewClient(httpclient.WithConfig(conf.Client))
+	if err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "failed to create client with provided config")
+	}
+	return NewItemServiceClient(client), nil
+}
+
+type ItemServiceCLICommand struct {
+	clientProvider CLIItemServiceClientProvider
+}
+
+func NewItemServiceCLICommand() *cobra.Command {
+	return NewItemServiceCLICommandWithClientProvider(NewDefaultCLIItemServiceClientProvider())
+}
+
+func NewItemServiceCLICommandWithClientProvider(clientProvider CLIItemServiceClientProvider) *cobra.Command {
+	rootCmd := &cobra.Command{
+		Short: "Runs commands on the ItemService",
+		Use:   "itemService",
+	}
+	rootCmd.PersistentFlags().String("conf", "var/conf/configuration.yml", "The configuration file is optional. The default path is ./var/conf/configuration.yml.")
+	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "Enables verbose mode for debugging client connections.")
+
+	cliCommand := ItemServiceCLICommand{clientProvider: clientProvider}
+
+	itemService_GetItem_Cmd := &cobra.Command{
+		RunE:  cliCommand.itemService_GetItem_CmdRun,
+		Short: "Calls the getItem endpoint.",
+		Use:   "getItem",
+	}
+	rootCmd.AddCommand(itemService_GetItem_Cmd)
+	itemService_GetItem_Cmd.Flags().String("itemId", "", "Required. ")
+	itemService_GetItem_Cmd.Flags().String("version", "", "Optional. ")
+	itemService_GetItem_Cmd.Flags().String("requestId", "", "Required. ")
+	itemService_GetItem_Cmd.Flags().String("bearer_token", "", "bearer_token is a required field.")
+
+	itemService_PutItem_Cmd := &cobra.Command{
+		RunE:  cliCommand.itemService_PutItem_CmdRun,
+		Short: "Calls the putItem endpoint.",
+		Use:   "putItem",
+	}
+	rootCmd.AddCommand(itemService_PutItem_Cmd)
+	itemService_PutItem_Cmd.Flags().String("itemId", "", "Required. ")
+	itemService_PutItem_Cmd.Flags().String("item", "", "Required. ")
+	itemService_PutItem_Cmd.Flags().String("bearer_token", "", "bearer_token is a required field.")
+
+	itemService_Ping_Cmd := &cobra.Command{
+		RunE:  cliCommand.itemService_Ping_CmdRun,
+		Short: "Calls the ping endpoint.",
+		Use:   "ping",
+	}
+	rootCmd.AddCommand(itemService_Ping_Cmd)
+
+	return rootCmd
+}
+
+func (c ItemServiceCLICommand) itemService_GetItem_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	bearer_tokenRaw, err := flags.GetString("bearer_token")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument __authVar")
+	}
+	if bearer_tokenRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "bearer_token is a required argument")
+	}
+	__authVarArg := bearertoken.Token(bearer_tokenRaw)
+	itemIdRaw, err := flags.GetString("itemId")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument itemId")
+	}
+	if itemIdRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "itemId is a required argument")
+	}
+	itemIdArg := itemIdRaw
+
+	versionRaw, err := flags.GetString("version")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument version")
+	}
+	var versionArg *int
+	if versionArgStr := versionRaw; versionArgStr != "" {
+		versionArgInternal, err := strconv.Atoi(versionArgStr)
+		if err != nil {
+			return werror.WrapWithContextParams(ctx, errors.WrapWithInvalidArgument(err), "failed to parse \"version\" as integer")
+		}
+		versionArg = &versionArgInternal
+	}
+
+	requestIdRaw, err := flags.GetString("requestId")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument requestId")
+	}
+	if requestIdRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "requestId is a required argument")
+	}
+	requestIdArg := requestIdRaw
+
+	result, err := client.GetItem(ctx, __authVarArg, itemIdArg, versionArg, requestIdArg)
+	if err != nil {
+		return err
+	}
+	resultBytes, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		fmt.Printf("Failed to marshal to json with err: %v\n\nPrinting as string:\n%v\n", err, result)
+		return nil
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%v\n", string(resultBytes))
+	return nil
+}
+
+func (c ItemServiceCLICommand) itemService_PutItem_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	bearer_tokenRaw, err := flags.GetString("bearer_token")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument __authVar")
+	}
+	if bearer_tokenRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "bearer_token is a required argument")
+	}
+	__authVarArg := bearertoken.Token(bearer_tokenRaw)
+	itemIdRaw, err := flags.GetString("itemId")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument itemId")
+	}
+	if itemIdRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "itemId is a required argument")
+	}
+	itemIdArg := itemIdRaw
+
+	itemRaw, err := flags.GetString("item")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument item")
+	}
+	if itemRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "item is a required argument")
+	}
+	var itemArg Item
+	var itemArgReader io.ReadCloser
+	switch {
+	case itemRaw == "@-":
+		itemArgReader = io.NopCloser(cmd.InOrStdin())
+	case strings.HasPrefix(itemRaw, "@"):
+		itemArgReader, err = os.Open(strings.TrimSpace(itemRaw[1:]))
+		if err != nil {
+			return werror.WrapWithContextParams(ctx, err, "failed to open file for argument item")
+		}
+	default:
+		itemArgReader = io.NopCloser(bytes.NewReader([]byte(itemRaw)))
+	}
+	defer itemArgReader.Close()
+	if err := codecs.JSON.Decode(itemArgReader, &itemArg); err != nil {
+		return werror.WrapWithContextParams(ctx, err, "invalid value for item argument")
+	}
+
+	return client.PutItem(ctx, __authVarArg, itemIdArg, itemArg)
+}
+
+func (c ItemServiceCLICommand) itemService_Ping_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	return client.Ping(ctx)
+}
+
+func loadCLIConfig(ctx context.Context, flags *pflag.FlagSet) (CLIConfig, error) {
+	var emptyConfig CLIConfig
+	configPath, err := flags.GetString("conf")
+	if err != nil || configPath == "" {
+		return emptyConfig, werror.WrapWithContextParams(ctx, err, "config file location must be specified")
+	}
+	confBytes, err := os.ReadFile(configPath)
+	if err != nil {
+		return emptyConfig, err
+	}
+	var conf CLIConfig
+	err = yaml.Unmarshal(confBytes, &conf)
+	if err != nil {
+		return emptyConfig, err
+	}
+	return conf, nil
+}
+
+func getCLIContext(flags *pflag.FlagSet) context.Context {
+	ctx := context.Background()
+	logProvider := wlog.NewNoopLoggerProvider()
+	logWriter := io.Discard
+	verbose, err := flags.GetBool("verbose")
+	if verbose && err == nil {
+		logProvider = wlogzap.LoggerProvider()
+		logWriter = os.Stdout
+	}
+	wlog.SetDefaultLoggerProvider(logProvider)
+	ctx = svc1log.WithLogger(ctx, svc1log.New(logWriter, wlog.DebugLevel))
+	traceLogger := trc1log.New(logWriter)
+	ctx = trc1log.WithLogger(ctx, traceLogger)
+	ctx = evt2log.WithLogger(ctx, evt2log.New(logWriter))
+	tracer, err := wzipkin.NewTracer(traceLogger)
+	if err != nil {
+		return ctx
+	}
+	return wtracing.ContextWithTracer(ctx, tracer)
+}
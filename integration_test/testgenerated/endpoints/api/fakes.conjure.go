@@ -0,0 +1,111 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/pkg/bearertoken"
+	wparams "github.com/palantir/witchcraft-go-params"
+)
+
+// FakeItemService is an in-memory implementation of ItemService for use in tests.
+// Each endpoint records its arguments and then invokes the corresponding <Endpoint>Func field.
+// If the field is nil, the endpoint returns DefaultErr (or a Conjure Internal error if DefaultErr is nil).
+// The zero value is ready to use and all methods are safe for concurrent use.
+type FakeItemService struct {
+	// GetItemFunc is invoked by GetItem if non-nil.
+	GetItemFunc func(ctx context.Context, authHeader bearertoken.Token, itemIdArg string, versionArg *int, requestIdArg string) (Item, error)
+	// PutItemFunc is invoked by PutItem if non-nil.
+	PutItemFunc func(ctx context.Context, authHeader bearertoken.Token, itemIdArg string, itemArg Item) error
+	// PingFunc is invoked by Ping if non-nil.
+	PingFunc func(ctx context.Context) error
+	// DefaultErr is returned by endpoints whose func field is nil.
+	DefaultErr error
+
+	mu           sync.Mutex
+	getItemCalls []FakeItemServiceGetItemCall
+	putItemCalls []FakeItemServicePutItemCall
+	pingCalls    []FakeItemServicePingCall
+}
+
+var _ ItemService = (*FakeItemService)(nil)
+
+// FakeItemServiceGetItemCall records the arguments of a call to FakeItemService.GetItem.
+type FakeItemServiceGetItemCall struct {
+	AuthHeader bearertoken.Token
+	ItemId     string
+	Version    *int
+	RequestId  string
+}
+
+func (f *FakeItemService) GetItem(ctx context.Context, authHeader bearertoken.Token, itemIdArg string, versionArg *int, requestIdArg string) (Item, error) {
+	f.mu.Lock()
+	f.getItemCalls = append(f.getItemCalls, FakeItemServiceGetItemCall{AuthHeader: authHeader, ItemId: itemIdArg, Version: versionArg, RequestId: requestIdArg})
+	f.mu.Unlock()
+	if f.GetItemFunc != nil {
+		return f.GetItemFunc(ctx, authHeader, itemIdArg, versionArg, requestIdArg)
+	}
+	var defaultReturnVal Item
+	return defaultReturnVal, f.defaultErr("getItem")
+}
+
+// GetItemCalls returns the arguments of every call made to GetItem, in call order.
+func (f *FakeItemService) GetItemCalls() []FakeItemServiceGetItemCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeItemServiceGetItemCall(nil), f.getItemCalls...)
+}
+
+// FakeItemServicePutItemCall records the arguments of a call to FakeItemService.PutItem.
+type FakeItemServicePutItemCall struct {
+	AuthHeader bearertoken.Token
+	ItemId     string
+	Item       Item
+}
+
+func (f *FakeItemService) PutItem(ctx context.Context, authHeader bearertoken.Token, itemIdArg string, itemArg Item) error {
+	f.mu.Lock()
+	f.putItemCalls = append(f.putItemCalls, FakeItemServicePutItemCall{AuthHeader: authHeader, ItemId: itemIdArg, Item: itemArg})
+	f.mu.Unlock()
+	if f.PutItemFunc != nil {
+		return f.PutItemFunc(ctx, authHeader, itemIdArg, itemArg)
+	}
+	return f.defaultErr("putItem")
+}
+
+// PutItemCalls returns the arguments of every call made to PutItem, in call order.
+func (f *FakeItemService) PutItemCalls() []FakeItemServicePutItemCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeItemServicePutItemCall(nil), f.putItemCalls...)
+}
+
+// FakeItemServicePingCall records the arguments of a call to FakeItemService.Ping.
+type FakeItemServicePingCall struct{}
+
+func (f *FakeItemService) Ping(ctx context.Context) error {
+	f.mu.Lock()
+	f.pingCalls = append(f.pingCalls, FakeItemServicePingCall{})
+	f.mu.Unlock()
+	if f.PingFunc != nil {
+		return f.PingFunc(ctx)
+	}
+	return f.defaultErr("ping")
+}
+
+// PingCalls returns the arguments of every call made to Ping, in call order.
+func (f *FakeItemService) PingCalls() []FakeItemServicePingCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeItemServicePingCall(nil), f.pingCalls...)
+}
+
+func (f *FakeItemService) defaultErr(endpoint string) error {
+	if f.DefaultErr != nil {
+		return f.DefaultErr
+	}
+	return errors.NewInternal(wparams.NewSafeParamStorer(map[string]interface{}{"fakeEndpoint": endpoint}))
+}
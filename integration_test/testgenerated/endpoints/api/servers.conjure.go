@@ -0,0 +1,114 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/codecs"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-server/httpserver"
+	"github.com/palantir/pkg/bearertoken"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-server/v2/witchcraft/wresource"
+	"github.com/palantir/witchcraft-go-server/v2/wrouter"
+)
+
+type ItemService interface {
+	GetItem(ctx context.Context, authHeader bearertoken.Token, itemIdArg string, versionArg *int, requestIdArg string) (Item, error)
+	// Deprecated: Use createItem instead.
+	PutItem(ctx context.Context, authHeader bearertoken.Token, itemIdArg string, itemArg Item) error
+	Ping(ctx context.Context) error
+}
+
+// RegisterRoutesItemService registers handlers for the ItemService endpoints with a witchcraft wrouter.
+// This should typically be called in a witchcraft server's InitFunc.
+// impl provides an implementation of each endpoint, which can assume the request parameters have been parsed
+// in accordance with the Conjure specification.
+func RegisterRoutesItemService(router wrouter.Router, impl ItemService, routerParams ...wrouter.RouteParam) error {
+	handler := itemServiceHandler{impl: impl}
+	resource := wresource.New("itemservice", router)
+	if err := resource.Get("GetItem", "/items/{itemId}", httpserver.NewJSONHandler(handler.HandleGetItem, httpserver.StatusCodeMapper, httpserver.ErrHandler), append(routerParams, wrouter.SafePathParams("itemId"), wrouter.ForbiddenQueryParams("v"))...); err != nil {
+		return werror.Wrap(err, "failed to add getItem route")
+	}
+	if err := resource.Put("PutItem", "/items/{itemId}", httpserver.NewJSONHandler(handler.HandlePutItem, httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add putItem route")
+	}
+	if err := resource.Get("Ping", "/items/ping", httpserver.NewJSONHandler(handler.HandlePing, httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add ping route")
+	}
+	return nil
+}
+
+type itemServiceHandler struct {
+	impl ItemService
+}
+
+func (i *itemServiceHandler) HandleGetItem(rw http.ResponseWriter, req *http.Request) error {
+	authHeader, err := httpserver.ParseBearerTokenHeader(req)
+	if err != nil {
+		return errors.WrapWithPermissionDenied(err)
+	}
+	pathParams := wrouter.PathParams(req)
+	if pathParams == nil {
+		return werror.Wrap(errors.NewInternal(), "path params not found on request: ensure this endpoint is registered with wrouter")
+	}
+	itemIdArg, ok := pathParams["itemId"]
+	if !ok {
+		return werror.WrapWithContextParams(req.Context(), errors.NewInvalidArgument(), "path parameter \"itemId\" not present")
+	}
+	var versionArg *int
+	if versionArgStr := req.URL.Query().Get("v"); versionArgStr != "" {
+		versionArgInternal, err := strconv.Atoi(versionArgStr)
+		if err != nil {
+			return werror.WrapWithContextParams(req.Context(), errors.WrapWithInvalidArgument(err), "failed to parse \"version\" as integer")
+		}
+		versionArg = &versionArgInternal
+	}
+	requestIdArg := req.Header.Get("X-Request-Id")
+	respArg, err := i.impl.GetItem(req.Context(), bearertoken.Token(authHeader), itemIdArg, versionArg, requestIdArg)
+	if err != nil {
+		return err
+	}
+	rw.Header().Add("Content-Type", codecs.JSON.ContentType())
+	return codecs.JSON.Encode(rw, respArg)
+}
+
+func (i *itemServiceHandler) HandlePutItem(rw http.ResponseWriter, req *http.Request) error {
+	authHeader, err := httpserver.ParseBearerTokenHeader(req)
+	if err != nil {
+		return errors.WrapWithPermissionDenied(err)
+	}
+	pathParams := wrouter.PathParams(req)
+	if pathParams == nil {
+		return werror.Wrap(errors.NewInternal(), "path params not found on request: ensure this endpoint is registered with wrouter")
+	}
+	itemIdArg, ok := pathParams["itemId"]
+	if !ok {
+		return werror.WrapWithContextParams(req.Context(), errors.NewInvalidArgument(), "path parameter \"itemId\" not present")
+	}
+	var itemArg Item
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	if err := itemArg.UnmarshalJSONStrict(data); err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	if err := i.impl.PutItem(req.Context(), bearertoken.Token(authHeader), itemIdArg, itemArg); err != nil {
+		return err
+	}
+	rw.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (i *itemServiceHandler) HandlePing(rw http.ResponseWriter, req *http.Request) error {
+	if err := i.impl.Ping(req.Context()); err != nil {
+		return err
+	}
+	rw.WriteHeader(http.StatusNoContent)
+	return nil
+}
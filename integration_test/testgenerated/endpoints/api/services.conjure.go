@@ -0,0 +1,240 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	"github.com/palantir/pkg/bearertoken"
+	werror "github.com/palantir/witchcraft-go-error"
+)
+
+type ItemServiceClient interface {
+	GetItem(ctx context.Context, authHeader bearertoken.Token, itemIdArg string, versionArg *int, requestIdArg string) (Item, error)
+	// Deprecated: Use createItem instead.
+	PutItem(ctx context.Context, authHeader bearertoken.Token, itemIdArg string, itemArg Item) error
+	Ping(ctx context.Context) error
+}
+
+type itemServiceClient struct {
+	client httpclient.Client
+}
+
+func NewItemServiceClient(client httpclient.Client) ItemServiceClient {
+	return &itemServiceClient{client: client}
+}
+
+func (c *itemServiceClient) GetItem(ctx context.Context, authHeader bearertoken.Token, itemIdArg string, versionArg *int, requestIdArg string) (Item, error) {
+	var defaultReturnVal Item
+	var returnVal *Item
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("GetItem"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("GET"))
+	requestParams = append(requestParams, httpclient.WithHeader("Authorization", fmt.Sprint("Bearer ", authHeader)))
+	requestParams = append(requestParams, httpclient.WithPathf("/items/%s", url.PathEscape(fmt.Sprint(itemIdArg))))
+	requestParams = append(requestParams, httpclient.WithHeader("X-Request-Id", fmt.Sprint(requestIdArg)))
+	queryParams := make(url.Values)
+	if versionArg != nil {
+		queryParams.Set("v", fmt.Sprint(*versionArg))
+	}
+	requestParams = append(requestParams, httpclient.WithQueryValues(queryParams))
+	requestParams = append(requestParams, httpclient.WithJSONResponse(&returnVal))
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return defaultReturnVal, werror.WrapWithContextParams(ctx, err, "getItem failed")
+	}
+	if returnVal == nil {
+		return defaultReturnVal, werror.ErrorWithContextParams(ctx, "getItem response cannot be nil")
+	}
+	return *returnVal, nil
+}
+
+func (c *itemServiceClient) PutItem(ctx context.Context, authHeader bearertoken.Token, itemIdArg string, itemArg Item) error {
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("PutItem"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("PUT"))
+	requestParams = append(requestParams, httpclient.WithHeader("Authorization", fmt.Sprint("Bearer ", authHeader)))
+	requestParams = append(requestParams, httpclient.WithPathf("/items/%s", url.PathEscape(fmt.Sprint(itemIdArg))))
+	requestParams = append(requestParams, httpclient.WithJSONRequest(itemArg))
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return werror.WrapWithContextParams(ctx, err, "putItem failed")
+	}
+	return nil
+}
+
+func (c *itemServiceClient) Ping(ctx context.Context) error {
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("Ping"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("GET"))
+	requestParams = append(requestParams, httpclient.WithPathf("/items/ping"))
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return werror.WrapWithContextParams(ctx, err, "ping failed")
+	}
+	return nil
+}
+
+type ItemServiceClientWithAuth interface {
+	GetItem(ctx context.Context, itemIdArg string, versionArg *int, requestIdArg string) (Item, error)
+	// Deprecated: Use createItem instead.
+	PutItem(ctx context.Context, itemIdArg string, itemArg Item) error
+	Ping(ctx context.Context) error
+}
+
+func NewItemServiceClientWithAuth(client ItemServiceClient, authHeader bearertoken.Token) ItemServiceClientWithAuth {
+	return &itemServiceClientWithAuth{client: client, authHeader: authHeader}
+}
+
+type itemServiceClientWithAuth struct {
+	client     ItemServiceClient
+	authHeader bearertoken.Token
+}
+
+func (c *itemServiceClientWithAuth) GetItem(ctx context.Context, itemIdArg string, versionArg *int, requestIdArg string) (Item, error) {
+	return c.client.GetItem(ctx, c.authHeader, itemIdArg, versionArg, requestIdArg)
+}
+
+func (c *itemServiceClientWithAuth) PutItem(ctx context.Context, itemIdArg string, itemArg Item) error {
+	return c.client.PutItem(ctx, c.authHeader, itemIdArg, itemArg)
+}
+
+func (c *itemServiceClientWithAuth) Ping(ctx context.Context) error {
+	return c.client.Ping(ctx)
+}
+
+func NewItemServiceClientWithTokenProvider(client ItemServiceClient, tokenProvider httpclient.TokenProvider) ItemServiceClientWithAuth {
+	return &itemServiceClientWithTokenProvider{client: client, tokenProvider: tokenProvider}
+}
+
+type itemServiceClientWithTokenProvider struct {
+	client        ItemServiceClient
+	tokenProvider httpclient.TokenProvider
+}
+
+func (c *itemServiceClientWithTokenProvider) GetItem(ctx context.Context, itemIdArg string, versionArg *int, requestIdArg string) (Item, error) {
+	var defaultReturnVal Item
+	token, err := c.tokenProvider(ctx)
+	if err != nil {
+		return defaultReturnVal, err
+	}
+	return c.client.GetItem(ctx, bearertoken.Token(token), itemIdArg, versionArg, requestIdArg)
+}
+
+func (c *itemServiceClientWithTokenProvider) PutItem(ctx context.Context, itemIdArg string, itemArg Item) error {
+	token, err := c.tokenProvider(ctx)
+	if err != nil {
+		return err
+	}
+	return c.client.PutItem(ctx, bearertoken.Token(token), itemIdArg, itemArg)
+}
+
+func (c *itemServiceClientWithTokenProvider) Ping(ctx context.Context) error {
+	return c.client.Ping(ctx)
+}
+
+// ItemServiceEndpoints describes the endpoints of ItemService in declaration order.
+var ItemServiceEndpoints = []EndpointMetadata{
+	{
+		Service:    "ItemService",
+		Name:       "getItem",
+		HTTPMethod: "GET",
+		HTTPPath:   "/items/{itemId}",
+		Params: []EndpointParamMetadata{
+			{
+				Name:    "itemId",
+				Kind:    EndpointParamKindPath,
+				ParamID: "itemId",
+				Safety:  "SAFE",
+				Markers: []string{"com.palantir.logsafe.Safe"},
+			},
+			{
+				Name:    "version",
+				Kind:    EndpointParamKindQuery,
+				ParamID: "v",
+				Safety:  "DO_NOT_LOG",
+			},
+			{
+				Name:    "requestId",
+				Kind:    EndpointParamKindHeader,
+				ParamID: "X-Request-Id",
+				Tags:    []string{"tracing"},
+			},
+		},
+		Tags:    []string{"read"},
+		Markers: []string{"com.palantir.logsafe.Safe"},
+	},
+	{
+		Service:    "ItemService",
+		Name:       "putItem",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/items/{itemId}",
+		Params: []EndpointParamMetadata{
+			{
+				Name:    "itemId",
+				Kind:    EndpointParamKindPath,
+				ParamID: "itemId",
+			},
+			{
+				Name:    "item",
+				Kind:    EndpointParamKindBody,
+				ParamID: "item",
+			},
+		},
+		Tags:       []string{"error:Items:ItemNotFound"},
+		Deprecated: "Use createItem instead.",
+	},
+	{
+		Service:    "ItemService",
+		Name:       "ping",
+		HTTPMethod: "GET",
+		HTTPPath:   "/items/ping",
+	},
+}
+
+// EndpointMetadata describes a Conjure endpoint.
+type EndpointMetadata struct {
+	// Service is the name of the Conjure service of the endpoint.
+	Service string
+	// Name is the name of the endpoint.
+	Name string
+	// HTTPMethod is the HTTP method of the endpoint.
+	HTTPMethod string
+	// HTTPPath is the path template of the endpoint.
+	HTTPPath string
+	// Params are the parameters of the endpoint in declaration order.
+	Params []EndpointParamMetadata
+	// Tags are the tags of the endpoint.
+	Tags []string
+	// Markers are the names of the markers of the endpoint, such as "com.palantir.logsafe.Safe".
+	Markers []string
+	// Deprecated is the deprecation documentation of the endpoint, or empty if it is not deprecated.
+	Deprecated string
+}
+
+// EndpointParamMetadata describes a parameter of a Conjure endpoint.
+type EndpointParamMetadata struct {
+	// Name is the name of the argument of the parameter.
+	Name string
+	// Kind is the location of the parameter in requests.
+	Kind EndpointParamKind
+	// ParamID is the name of the path parameter, query parameter or header. It is the name of the argument for
+	// body parameters.
+	ParamID string
+	// Safety is the log safety of the parameter: "SAFE", "UNSAFE" or "DO_NOT_LOG", or empty if it is unknown.
+	Safety string
+	// Tags are the tags of the parameter.
+	Tags []string
+	// Markers are the names of the markers of the parameter.
+	Markers []string
+}
+
+// EndpointParamKind is the location of an endpoint parameter in requests.
+type EndpointParamKind string
+
+const (
+	EndpointParamKindPath   EndpointParamKind = "path"
+	EndpointParamKindQuery  EndpointParamKind = "query"
+	EndpointParamKindHeader EndpointParamKind = "header"
+	EndpointParamKindBody   EndpointParamKind = "body"
+)
@@ -0,0 +1,58 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package endpoints_test
+
+import (
+	"testing"
+
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/endpoints/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointMetadata(t *testing.T) {
+	require.Len(t, api.ItemServiceEndpoints, 3)
+	assert.Equal(t, api.EndpointMetadata{
+		Service:    "ItemService",
+		Name:       "getItem",
+		HTTPMethod: "GET",
+		HTTPPath:   "/items/{itemId}",
+		Params: []api.EndpointParamMetadata{
+			{Name: "itemId", Kind: api.EndpointParamKindPath, ParamID: "itemId", Safety: "SAFE", Markers: []string{"com.palantir.logsafe.Safe"}},
+			{Name: "version", Kind: api.EndpointParamKindQuery, ParamID: "v", Safety: "DO_NOT_LOG"},
+			{Name: "requestId", Kind: api.EndpointParamKindHeader, ParamID: "X-Request-Id", Tags: []string{"tracing"}},
+		},
+		Tags:    []string{"read"},
+		Markers: []string{"com.palantir.logsafe.Safe"},
+	}, api.ItemServiceEndpoints[0])
+	assert.Equal(t, api.EndpointMetadata{
+		Service:    "ItemService",
+		Name:       "putItem",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/items/{itemId}",
+		Params: []api.EndpointParamMetadata{
+			{Name: "itemId", Kind: api.EndpointParamKindPath, ParamID: "itemId"},
+			{Name: "item", Kind: api.EndpointParamKindBody, ParamID: "item"},
+		},
+		Tags:       []string{"error:Items:ItemNotFound"},
+		Deprecated: "Use createItem instead.",
+	}, api.ItemServiceEndpoints[1])
+	assert.Equal(t, api.EndpointMetadata{
+		Service:    "ItemService",
+		Name:       "ping",
+		HTTPMethod: "GET",
+		HTTPPath:   "/items/ping",
+	}, api.ItemServiceEndpoints[2])
+}
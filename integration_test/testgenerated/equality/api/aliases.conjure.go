@@ -0,0 +1,535 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/palantir/pkg/binary"
+	"github.com/palantir/pkg/datetime"
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
+)
+
+type AnyAlias interface{}
+type BinaryAlias []byte
+
+func (a BinaryAlias) AppendJSON(out []byte) ([]byte, error) {
+	return appendJSONBinary(out, a), nil
+}
+
+func (a BinaryAlias) JSONSize() (int, error) {
+	return base64.StdEncoding.EncodedLen(len(a)) + 2, nil
+}
+
+func (a BinaryAlias) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a BinaryAlias) String() string {
+	return binary.New(a).String()
+}
+
+func (a BinaryAlias) MarshalText() ([]byte, error) {
+	return binary.New(a).MarshalText()
+}
+
+func (a *BinaryAlias) UnmarshalText(data []byte) error {
+	rawBinaryAlias, err := binary.Binary(data).Bytes()
+	if err != nil {
+		return err
+	}
+	*a = BinaryAlias(rawBinaryAlias)
+	return nil
+}
+
+func (a BinaryAlias) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *BinaryAlias) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+func (a *BinaryAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *BinaryAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []byte
+	v, err = decodeJSONBinary(value)
+	if err != nil {
+		return err
+	}
+	*a = BinaryAlias(v)
+	return nil
+}
+
+// Equal returns true if the BinaryAlias is equal to other according to the Conjure semantics of its values.
+func (a BinaryAlias) Equal(other BinaryAlias) bool {
+	return bytes.Equal(a, other)
+}
+
+// Clone returns a deep copy of the BinaryAlias.
+func (a BinaryAlias) Clone() BinaryAlias {
+	var out BinaryAlias
+	if a != nil {
+		out = make(BinaryAlias, len(a))
+		copy(out, a)
+	}
+	return out
+}
+
+// Hash returns a hash of the BinaryAlias. Values that are Equal have the same hash.
+func (a BinaryAlias) Hash() uint64 {
+	return hashBytes(a)
+}
+
+type BooleanAlias bool
+
+func (a *BooleanAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *BooleanAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v bool
+	v, err = decodeJSONBool(value)
+	if err != nil {
+		return err
+	}
+	*a = BooleanAlias(v)
+	return nil
+}
+
+// Equal returns true if the BooleanAlias is equal to other according to the Conjure semantics of its values.
+func (a BooleanAlias) Equal(other BooleanAlias) bool {
+	return bool(a) == bool(other)
+}
+
+// Clone returns a deep copy of the BooleanAlias.
+func (a BooleanAlias) Clone() BooleanAlias {
+	return a
+}
+
+// Hash returns a hash of the BooleanAlias. Values that are Equal have the same hash.
+func (a BooleanAlias) Hash() uint64 {
+	return hashBool(bool(a))
+}
+
+type DateTimeAlias datetime.DateTime
+
+func (a DateTimeAlias) AppendJSON(out []byte) ([]byte, error) {
+	out = appendJSONDateTime(out, datetime.DateTime(a))
+	return out, nil
+}
+
+func (a DateTimeAlias) JSONSize() (int, error) {
+	var size int
+	size += jsonDateTimeSize(datetime.DateTime(a))
+	return size, nil
+}
+
+func (a DateTimeAlias) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a DateTimeAlias) String() string {
+	return datetime.DateTime(a).String()
+}
+
+func (a DateTimeAlias) MarshalText() ([]byte, error) {
+	return datetime.DateTime(a).MarshalText()
+}
+
+func (a *DateTimeAlias) UnmarshalText(data []byte) error {
+	var rawDateTimeAlias datetime.DateTime
+	if err := rawDateTimeAlias.UnmarshalText(data); err != nil {
+		return err
+	}
+	*a = DateTimeAlias(rawDateTimeAlias)
+	return nil
+}
+
+func (a DateTimeAlias) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *DateTimeAlias) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+func (a *DateTimeAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *DateTimeAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v datetime.DateTime
+	v, err = decodeJSONDateTime(value)
+	if err != nil {
+		return err
+	}
+	*a = DateTimeAlias(v)
+	return nil
+}
+
+// Equal returns true if the DateTimeAlias is equal to other according to the Conjure semantics of its values.
+func (a DateTimeAlias) Equal(other DateTimeAlias) bool {
+	return time.Time(a).Equal(time.Time(other))
+}
+
+// Clone returns a deep copy of the DateTimeAlias.
+func (a DateTimeAlias) Clone() DateTimeAlias {
+	return a
+}
+
+// Hash returns a hash of the DateTimeAlias. Values that are Equal have the same hash.
+func (a DateTimeAlias) Hash() uint64 {
+	return uint64(time.Time(a).UnixNano())
+}
+
+type ItemAlias Item
+
+func (a ItemAlias) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out, err = Item(a).AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (a ItemAlias) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	n, err = Item(a).JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (a ItemAlias) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *ItemAlias) UnmarshalJSON(data []byte) error {
+	var rawItemAlias Item
+	if err := safejson.Unmarshal(data, &rawItemAlias); err != nil {
+		return err
+	}
+	*a = ItemAlias(rawItemAlias)
+	return nil
+}
+
+func (a ItemAlias) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *ItemAlias) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+func (a *ItemAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *ItemAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v Item
+	err = v.decodeJSONStrict(value)
+	if err != nil {
+		return err
+	}
+	*a = ItemAlias(v)
+	return nil
+}
+
+// Equal returns true if the ItemAlias is equal to other according to the Conjure semantics of its values.
+func (a ItemAlias) Equal(other ItemAlias) bool {
+	return Item(a).Equal(Item(other))
+}
+
+// Clone returns a deep copy of the ItemAlias.
+func (a ItemAlias) Clone() ItemAlias {
+	return ItemAlias(Item(a).Clone())
+}
+
+// Hash returns a hash of the ItemAlias. Values that are Equal have the same hash.
+func (a ItemAlias) Hash() uint64 {
+	return Item(a).Hash()
+}
+
+type ListAlias []string
+
+func (a *ListAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *ListAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []string
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]string, 0)
+	seen := make(map[string]struct{})
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 string
+		v1, err = decodeJSONString(elem)
+		if err != nil {
+			return false
+		}
+		if _, ok := seen[v1]; ok {
+			err = fmt.Errorf("duplicate set element %s", elem.Raw)
+			return false
+		}
+		seen[v1] = struct{}{}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = ListAlias(v)
+	return nil
+}
+
+// Equal returns true if the ListAlias is equal to other according to the Conjure semantics of its values.
+func (a ListAlias) Equal(other ListAlias) bool {
+	if !func() bool {
+		if len(a) != len(other) {
+			return false
+		}
+		counts := make(map[string]int, len(a))
+		for _, v := range a {
+			counts[v]++
+		}
+		for _, v := range other {
+			if counts[v] == 0 {
+				return false
+			}
+			counts[v]--
+		}
+		return true
+	}() {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the ListAlias.
+func (a ListAlias) Clone() ListAlias {
+	var out ListAlias
+	if a != nil {
+		out = make(ListAlias, len(a))
+		copy(out, a)
+	}
+	return out
+}
+
+// Hash returns a hash of the ListAlias. Values that are Equal have the same hash.
+func (a ListAlias) Hash() uint64 {
+	var h uint64
+	for _, v := range a {
+		var elemHash uint64
+		elemHash = hashMix(elemHash, hashString(v))
+		h += elemHash
+	}
+	return h
+}
+
+type OptionalAlias struct {
+	Value *[]string
+}
+
+func (a OptionalAlias) AppendJSON(out []byte) ([]byte, error) {
+	if a.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out = append(out, '[')
+		for i, v := range *a.Value {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, v)
+		}
+		out = append(out, ']')
+	}
+	return out, nil
+}
+
+func (a OptionalAlias) JSONSize() (int, error) {
+	var size int
+	if a.Value == nil {
+		size += 4
+	} else {
+		size += 2
+		if len(*a.Value) > 1 {
+			size += len(*a.Value) - 1
+		}
+		for _, v := range *a.Value {
+			size += jsonStringSize(v)
+		}
+	}
+	return size, nil
+}
+
+func (a OptionalAlias) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *OptionalAlias) UnmarshalJSON(data []byte) error {
+	if a.Value == nil {
+		a.Value = new([]string)
+	}
+	return safejson.Unmarshal(data, a.Value)
+}
+
+func (a *OptionalAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *OptionalAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *[]string
+	if value.Type != gjson.Null {
+		var v1 []string
+		if !value.IsArray() {
+			return jsonTypeError(value, "array")
+		}
+		v1 = make([]string, 0)
+		value.ForEach(func(_, elem gjson.Result) bool {
+			var v2 string
+			v2, err = decodeJSONString(elem)
+			if err != nil {
+				return false
+			}
+			v1 = append(v1, v2)
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = OptionalAlias{Value: v}
+	return nil
+}
+
+func (a OptionalAlias) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *OptionalAlias) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+// Equal returns true if the OptionalAlias is equal to other according to the Conjure semantics of its values.
+func (a OptionalAlias) Equal(other OptionalAlias) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		v, otherV := *a.Value, *other.Value
+		if len(v) != len(otherV) {
+			return false
+		}
+		for i1 := range v {
+			if v[i1] != otherV[i1] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalAlias.
+func (a OptionalAlias) Clone() OptionalAlias {
+	out := a
+	if a.Value != nil {
+		elems := *a.Value
+		var v []string
+		if elems != nil {
+			v = make([]string, len(elems))
+			copy(v, elems)
+		}
+		out.Value = &v
+	}
+	return out
+}
+
+// Hash returns a hash of the OptionalAlias. Values that are Equal have the same hash.
+func (a OptionalAlias) Hash() uint64 {
+	var h uint64
+	if a.Value != nil {
+		for _, v1 := range *a.Value {
+			h = hashMix(h, hashString(v1))
+		}
+	}
+	return h
+}
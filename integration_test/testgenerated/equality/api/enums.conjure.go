@@ -0,0 +1,101 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+type Kind struct {
+	val Kind_Value
+}
+
+type Kind_Value string
+
+const (
+	Kind_SMALL   Kind_Value = "SMALL"
+	Kind_LARGE   Kind_Value = "LARGE"
+	Kind_UNKNOWN Kind_Value = "UNKNOWN"
+)
+
+// Kind_Values returns all known variants of Kind.
+func Kind_Values() []Kind_Value {
+	return []Kind_Value{Kind_SMALL, Kind_LARGE}
+}
+
+func New_Kind(value Kind_Value) Kind {
+	return Kind{val: value}
+}
+
+// IsUnknown returns false for all known variants of Kind and true otherwise.
+func (e Kind) IsUnknown() bool {
+	switch e.val {
+	case Kind_SMALL, Kind_LARGE:
+		return false
+	}
+	return true
+}
+
+func (e Kind) Value() Kind_Value {
+	if e.IsUnknown() {
+		return Kind_UNKNOWN
+	}
+	return e.val
+}
+
+func (e Kind) String() string {
+	return string(e.val)
+}
+
+func (e Kind) MarshalText() ([]byte, error) {
+	return []byte(e.val), nil
+}
+
+func (e *Kind) UnmarshalText(data []byte) error {
+	switch v := strings.ToUpper(string(data)); v {
+	default:
+		*e = New_Kind(Kind_Value(v))
+	case "SMALL":
+		*e = New_Kind(Kind_SMALL)
+	case "LARGE":
+		*e = New_Kind(Kind_LARGE)
+	}
+	return nil
+}
+
+func (e Kind) AppendJSON(out []byte) ([]byte, error) {
+	return appendJSONString(out, string(e.val)), nil
+}
+
+func (e Kind) JSONSize() (int, error) {
+	return jsonStringSize(string(e.val)), nil
+}
+
+func (e *Kind) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return e.decodeJSONStrict(value)
+}
+
+func (e *Kind) decodeJSONStrict(value gjson.Result) error {
+	return decodeJSONEnum(value, e)
+}
+
+// Equal returns true if the Kind is equal to other according to the Conjure semantics of its values.
+func (e Kind) Equal(other Kind) bool {
+	return e.val == other.val
+}
+
+// Clone returns a deep copy of the Kind.
+func (e Kind) Clone() Kind {
+	return e
+}
+
+// Hash returns a hash of the Kind. Values that are Equal have the same hash.
+func (e Kind) Hash() uint64 {
+	return hashString(string(e.val))
+}
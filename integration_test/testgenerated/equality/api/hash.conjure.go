@@ -0,0 +1,51 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"math"
+)
+
+// hashMix returns the hash h combined with the hash v.
+func hashMix(h, v uint64) uint64 {
+	return (h ^ v) * 1099511628211
+}
+
+// hashString returns the FNV-1a hash of s.
+func hashString(s string) uint64 {
+	h := uint64(0xcbf29ce484222325)
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= 1099511628211
+	}
+	return h
+}
+
+// hashBytes returns the FNV-1a hash of b.
+func hashBytes(b []byte) uint64 {
+	h := uint64(0xcbf29ce484222325)
+	for i := 0; i < len(b); i++ {
+		h ^= uint64(b[i])
+		h *= 1099511628211
+	}
+	return h
+}
+
+// hashFloat64 returns the hash of f. Zero and negative zero have the same hash, as do all NaNs.
+func hashFloat64(f float64) uint64 {
+	if f == 0 {
+		return 0
+	}
+	if math.IsNaN(f) {
+		return math.Float64bits(math.NaN())
+	}
+	return math.Float64bits(f)
+}
+
+// hashBool returns the hash of b.
+func hashBool(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,3432 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/palantir/pkg/bearertoken"
+	"github.com/palantir/pkg/binary"
+	"github.com/palantir/pkg/boolean"
+	"github.com/palantir/pkg/datetime"
+	"github.com/palantir/pkg/rid"
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safelong"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/palantir/pkg/uuid"
+	"github.com/tidwall/gjson"
+)
+
+type Item struct {
+	Value  string   `json:"value"`
+	Values []string `json:"values"`
+}
+
+func (o Item) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"value\":"...)
+	out = appendJSONString(out, o.Value)
+	out = append(out, ",\"values\":"...)
+	out = append(out, '[')
+	for i, v := range o.Values {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
+	}
+	out = append(out, ']')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Item) JSONSize() (int, error) {
+	size := 20
+	size += jsonStringSize(o.Value)
+	size += 2
+	if len(o.Values) > 1 {
+		size += len(o.Values) - 1
+	}
+	for _, v := range o.Values {
+		size += jsonStringSize(v)
+	}
+	return size, nil
+}
+
+func (o Item) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
+}
+
+func (o *Item) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Item
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
+	type ItemAlias Item
+	var rawItem ItemAlias
+	if err := safejson.Unmarshal(data, &rawItem); err != nil {
+		return err
+	}
+	if rawItem.Values == nil {
+		rawItem.Values = make([]string, 0)
+	}
+	*o = Item(rawItem)
+	return nil
+}
+
+func (o *Item) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Values == nil {
+			o.Values = make([]string, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue, seenValues bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if !unmarshalJSONString(field, &o.Value) {
+				ok = false
+				return false
+			}
+		case "values":
+			if seenValues {
+				ok = false
+				return false
+			}
+			seenValues = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Values = make([]string, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v string
+					if !unmarshalJSONString(elem, &v) {
+						ok1 = false
+						return false
+					}
+					o.Values = append(o.Values, v)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "value", "values") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Values == nil {
+		o.Values = make([]string, 0)
+	}
+	return true
+}
+
+func (o *Item) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Item) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Item{}
+	var seenValue, seenValues bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			o.Value, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"value\": %w", err)
+				return false
+			}
+		case "values":
+			if seenValues {
+				err = fmt.Errorf("duplicate field \"values\"")
+				return false
+			}
+			seenValues = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"values\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Values = make([]string, 0)
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v string
+					v, err = decodeJSONString(elem)
+					if err != nil {
+						return false
+					}
+					o.Values = append(o.Values, v)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"values\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenValue {
+		return fmt.Errorf("field \"value\" is required")
+	}
+	if o.Values == nil {
+		o.Values = make([]string, 0)
+	}
+	return nil
+}
+
+func (o Item) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *Item) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the Item is equal to other according to the Conjure semantics of its values.
+func (o Item) Equal(other Item) bool {
+	if o.Value != other.Value {
+		return false
+	}
+	if len(o.Values) != len(other.Values) {
+		return false
+	}
+	for i := range o.Values {
+		if o.Values[i] != other.Values[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Item.
+func (o Item) Clone() Item {
+	out := o
+	if o.Values != nil {
+		out.Values = make([]string, len(o.Values))
+		copy(out.Values, o.Values)
+	}
+	return out
+}
+
+// Hash returns a hash of the Item. Values that are Equal have the same hash.
+func (o Item) Hash() uint64 {
+	var h uint64
+	h = hashMix(h, hashString(o.Value))
+	for _, v := range o.Values {
+		h = hashMix(h, hashString(v))
+	}
+	return h
+}
+
+type Keys struct {
+	BinaryKeys       map[binary.Binary]string `json:"binaryKeys"`
+	BinaryAliasKeys  map[binary.Binary]string `json:"binaryAliasKeys"`
+	BooleanKeys      map[boolean.Boolean]int  `json:"booleanKeys"`
+	BooleanAliasKeys map[boolean.Boolean]int  `json:"booleanAliasKeys"`
+	Anys             []interface{}            `json:"anys"`
+	AnyAliases       []AnyAlias               `json:"anyAliases"`
+	Externals        []interface{}            `json:"externals"`
+	Methods          *Methods                 `json:"methods"`
+}
+
+func (o Keys) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"binaryKeys\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value string
+		}
+		entries := make([]mapEntry, 0, len(o.BinaryKeys))
+		for k, v := range o.BinaryKeys {
+			entries = append(entries, mapEntry{key: string(k), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = appendJSONString(out, entry.value)
+		}
+	}
+	out = append(out, '}')
+	out = append(out, ",\"binaryAliasKeys\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value string
+		}
+		entries := make([]mapEntry, 0, len(o.BinaryAliasKeys))
+		for k, v := range o.BinaryAliasKeys {
+			entries = append(entries, mapEntry{key: string([]byte(k)), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = appendJSONString(out, entry.value)
+		}
+	}
+	out = append(out, '}')
+	out = append(out, ",\"booleanKeys\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value int
+		}
+		entries := make([]mapEntry, 0, len(o.BooleanKeys))
+		for k, v := range o.BooleanKeys {
+			entries = append(entries, mapEntry{key: strconv.FormatBool(bool(k)), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = strconv.AppendInt(out, int64(entry.value), 10)
+		}
+	}
+	out = append(out, '}')
+	out = append(out, ",\"booleanAliasKeys\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value int
+		}
+		entries := make([]mapEntry, 0, len(o.BooleanAliasKeys))
+		for k, v := range o.BooleanAliasKeys {
+			entries = append(entries, mapEntry{key: strconv.FormatBool(bool(bool(k))), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = strconv.AppendInt(out, int64(entry.value), 10)
+		}
+	}
+	out = append(out, '}')
+	out = append(out, ",\"anys\":"...)
+	out = append(out, '[')
+	for i, v := range o.Anys {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = appendJSONMarshal(out, v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	out = append(out, ",\"anyAliases\":"...)
+	out = append(out, '[')
+	for i, v := range o.AnyAliases {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = appendJSONMarshal(out, interface{}(v))
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	out = append(out, ",\"externals\":"...)
+	out = append(out, '[')
+	for i, v := range o.Externals {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = appendJSONMarshal(out, v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	out = append(out, ",\"methods\":"...)
+	if o.Methods == nil {
+		out = append(out, "null"...)
+	} else {
+		out, err = o.Methods.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Keys) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 115
+	size += 2
+	if len(o.BinaryKeys) > 1 {
+		size += len(o.BinaryKeys) - 1
+	}
+	for k, v := range o.BinaryKeys {
+		size += jsonStringSize(string(k)) + 1
+		size += jsonStringSize(v)
+	}
+	size += 2
+	if len(o.BinaryAliasKeys) > 1 {
+		size += len(o.BinaryAliasKeys) - 1
+	}
+	for k, v := range o.BinaryAliasKeys {
+		size += jsonStringSize(string([]byte(k))) + 1
+		size += jsonStringSize(v)
+	}
+	size += 2
+	if len(o.BooleanKeys) > 1 {
+		size += len(o.BooleanKeys) - 1
+	}
+	for k, v := range o.BooleanKeys {
+		size += jsonStringSize(strconv.FormatBool(bool(k))) + 1
+		size += jsonIntSize(int64(v))
+	}
+	size += 2
+	if len(o.BooleanAliasKeys) > 1 {
+		size += len(o.BooleanAliasKeys) - 1
+	}
+	for k, v := range o.BooleanAliasKeys {
+		size += jsonStringSize(strconv.FormatBool(bool(bool(k)))) + 1
+		size += jsonIntSize(int64(v))
+	}
+	size += 2
+	if len(o.Anys) > 1 {
+		size += len(o.Anys) - 1
+	}
+	for _, v := range o.Anys {
+		n, err = jsonMarshalSize(v)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 2
+	if len(o.AnyAliases) > 1 {
+		size += len(o.AnyAliases) - 1
+	}
+	for _, v := range o.AnyAliases {
+		n, err = jsonMarshalSize(interface{}(v))
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 2
+	if len(o.Externals) > 1 {
+		size += len(o.Externals) - 1
+	}
+	for _, v := range o.Externals {
+		n, err = jsonMarshalSize(v)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	if o.Methods == nil {
+		size += 4
+	} else {
+		n, err = o.Methods.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (o Keys) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
+}
+
+func (o *Keys) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Keys
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
+	type KeysAlias Keys
+	var rawKeys KeysAlias
+	if err := safejson.Unmarshal(data, &rawKeys); err != nil {
+		return err
+	}
+	if rawKeys.BinaryKeys == nil {
+		rawKeys.BinaryKeys = make(map[binary.Binary]string, 0)
+	}
+	if rawKeys.BinaryAliasKeys == nil {
+		rawKeys.BinaryAliasKeys = make(map[binary.Binary]string, 0)
+	}
+	if rawKeys.BooleanKeys == nil {
+		rawKeys.BooleanKeys = make(map[boolean.Boolean]int, 0)
+	}
+	if rawKeys.BooleanAliasKeys == nil {
+		rawKeys.BooleanAliasKeys = make(map[boolean.Boolean]int, 0)
+	}
+	if rawKeys.Anys == nil {
+		rawKeys.Anys = make([]interface{}, 0)
+	}
+	if rawKeys.AnyAliases == nil {
+		rawKeys.AnyAliases = make([]AnyAlias, 0)
+	}
+	if rawKeys.Externals == nil {
+		rawKeys.Externals = make([]interface{}, 0)
+	}
+	*o = Keys(rawKeys)
+	return nil
+}
+
+func (o *Keys) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.BinaryKeys == nil {
+			o.BinaryKeys = make(map[binary.Binary]string, 0)
+		}
+		if o.BinaryAliasKeys == nil {
+			o.BinaryAliasKeys = make(map[binary.Binary]string, 0)
+		}
+		if o.BooleanKeys == nil {
+			o.BooleanKeys = make(map[boolean.Boolean]int, 0)
+		}
+		if o.BooleanAliasKeys == nil {
+			o.BooleanAliasKeys = make(map[boolean.Boolean]int, 0)
+		}
+		if o.Anys == nil {
+			o.Anys = make([]interface{}, 0)
+		}
+		if o.AnyAliases == nil {
+			o.AnyAliases = make([]AnyAlias, 0)
+		}
+		if o.Externals == nil {
+			o.Externals = make([]interface{}, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenBinaryKeys, seenBinaryAliasKeys, seenBooleanKeys, seenBooleanAliasKeys, seenAnys, seenAnyAliases, seenExternals, seenMethods bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "binaryKeys":
+			if seenBinaryKeys {
+				ok = false
+				return false
+			}
+			seenBinaryKeys = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.BinaryKeys = make(map[binary.Binary]string)
+				ok1 := true
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k binary.Binary
+					var s string
+					if !unmarshalJSONString(key1, &s) || k.UnmarshalText([]byte(s)) != nil {
+						ok1 = false
+						return false
+					}
+					var v string
+					if !unmarshalJSONString(elem, &v) {
+						ok1 = false
+						return false
+					}
+					o.BinaryKeys[k] = v
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "binaryAliasKeys":
+			if seenBinaryAliasKeys {
+				ok = false
+				return false
+			}
+			seenBinaryAliasKeys = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.BinaryAliasKeys = make(map[binary.Binary]string)
+				ok2 := true
+				field.ForEach(func(key2, elem1 gjson.Result) bool {
+					var k1 binary.Binary
+					var s1 string
+					if !unmarshalJSONString(key2, &s1) || k1.UnmarshalText([]byte(s1)) != nil {
+						ok2 = false
+						return false
+					}
+					var v1 string
+					if !unmarshalJSONString(elem1, &v1) {
+						ok2 = false
+						return false
+					}
+					o.BinaryAliasKeys[k1] = v1
+					return true
+				})
+				if !ok2 {
+					ok = false
+					return false
+				}
+			}
+		case "booleanKeys":
+			if seenBooleanKeys {
+				ok = false
+				return false
+			}
+			seenBooleanKeys = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.BooleanKeys = make(map[boolean.Boolean]int)
+				ok3 := true
+				field.ForEach(func(key3, elem2 gjson.Result) bool {
+					var k2 boolean.Boolean
+					var s2 string
+					if !unmarshalJSONString(key3, &s2) || k2.UnmarshalText([]byte(s2)) != nil {
+						ok3 = false
+						return false
+					}
+					var v2 int
+					if !unmarshalJSONInt(elem2, &v2) {
+						ok3 = false
+						return false
+					}
+					o.BooleanKeys[k2] = v2
+					return true
+				})
+				if !ok3 {
+					ok = false
+					return false
+				}
+			}
+		case "booleanAliasKeys":
+			if seenBooleanAliasKeys {
+				ok = false
+				return false
+			}
+			seenBooleanAliasKeys = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.BooleanAliasKeys = make(map[boolean.Boolean]int)
+				ok4 := true
+				field.ForEach(func(key4, elem3 gjson.Result) bool {
+					var k3 boolean.Boolean
+					var s3 string
+					if !unmarshalJSONString(key4, &s3) || k3.UnmarshalText([]byte(s3)) != nil {
+						ok4 = false
+						return false
+					}
+					var v3 int
+					if !unmarshalJSONInt(elem3, &v3) {
+						ok4 = false
+						return false
+					}
+					o.BooleanAliasKeys[k3] = v3
+					return true
+				})
+				if !ok4 {
+					ok = false
+					return false
+				}
+			}
+		case "anys":
+			if seenAnys {
+				ok = false
+				return false
+			}
+			seenAnys = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Anys = make([]interface{}, 0)
+				ok5 := true
+				field.ForEach(func(_, elem4 gjson.Result) bool {
+					var v4 interface{}
+					if !unmarshalJSONAny(elem4, &v4) {
+						ok5 = false
+						return false
+					}
+					o.Anys = append(o.Anys, v4)
+					return true
+				})
+				if !ok5 {
+					ok = false
+					return false
+				}
+			}
+		case "anyAliases":
+			if seenAnyAliases {
+				ok = false
+				return false
+			}
+			seenAnyAliases = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.AnyAliases = make([]AnyAlias, 0)
+				ok6 := true
+				field.ForEach(func(_, elem5 gjson.Result) bool {
+					var v5 AnyAlias
+					if safejson.Unmarshal([]byte(elem5.Raw), &v5) != nil {
+						ok6 = false
+						return false
+					}
+					o.AnyAliases = append(o.AnyAliases, v5)
+					return true
+				})
+				if !ok6 {
+					ok = false
+					return false
+				}
+			}
+		case "externals":
+			if seenExternals {
+				ok = false
+				return false
+			}
+			seenExternals = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Externals = make([]interface{}, 0)
+				ok7 := true
+				field.ForEach(func(_, elem6 gjson.Result) bool {
+					var v6 interface{}
+					if safejson.Unmarshal([]byte(elem6.Raw), &v6) != nil {
+						ok7 = false
+						return false
+					}
+					o.Externals = append(o.Externals, v6)
+					return true
+				})
+				if !ok7 {
+					ok = false
+					return false
+				}
+			}
+		case "methods":
+			if seenMethods {
+				ok = false
+				return false
+			}
+			seenMethods = true
+			if field.Type != gjson.Null {
+				var v7 Methods
+				if !v7.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				o.Methods = &v7
+			}
+		default:
+			if matchesJSONField(key.Str, "binaryKeys", "binaryAliasKeys", "booleanKeys", "booleanAliasKeys", "anys", "anyAliases", "externals", "methods") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.BinaryKeys == nil {
+		o.BinaryKeys = make(map[binary.Binary]string, 0)
+	}
+	if o.BinaryAliasKeys == nil {
+		o.BinaryAliasKeys = make(map[binary.Binary]string, 0)
+	}
+	if o.BooleanKeys == nil {
+		o.BooleanKeys = make(map[boolean.Boolean]int, 0)
+	}
+	if o.BooleanAliasKeys == nil {
+		o.BooleanAliasKeys = make(map[boolean.Boolean]int, 0)
+	}
+	if o.Anys == nil {
+		o.Anys = make([]interface{}, 0)
+	}
+	if o.AnyAliases == nil {
+		o.AnyAliases = make([]AnyAlias, 0)
+	}
+	if o.Externals == nil {
+		o.Externals = make([]interface{}, 0)
+	}
+	return true
+}
+
+func (o *Keys) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Keys) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Keys{}
+	var seenBinaryKeys, seenBinaryAliasKeys, seenBooleanKeys, seenBooleanAliasKeys, seenAnys, seenAnyAliases, seenExternals, seenMethods bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "binaryKeys":
+			if seenBinaryKeys {
+				err = fmt.Errorf("duplicate field \"binaryKeys\"")
+				return false
+			}
+			seenBinaryKeys = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"binaryKeys\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.BinaryKeys = make(map[binary.Binary]string)
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k binary.Binary
+					k, err = decodeJSONBinaryKey(key1)
+					if err != nil {
+						return false
+					}
+					if _, ok := o.BinaryKeys[k]; ok {
+						err = fmt.Errorf("duplicate map key %s", key1.Raw)
+						return false
+					}
+					var v string
+					v, err = decodeJSONString(elem)
+					if err != nil {
+						return false
+					}
+					o.BinaryKeys[k] = v
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"binaryKeys\": %w", err)
+					return false
+				}
+			}
+		case "binaryAliasKeys":
+			if seenBinaryAliasKeys {
+				err = fmt.Errorf("duplicate field \"binaryAliasKeys\"")
+				return false
+			}
+			seenBinaryAliasKeys = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"binaryAliasKeys\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.BinaryAliasKeys = make(map[binary.Binary]string)
+				field.ForEach(func(key2, elem1 gjson.Result) bool {
+					var k1 binary.Binary
+					k1, err = decodeJSONBinaryKey(key2)
+					if err != nil {
+						return false
+					}
+					if _, ok := o.BinaryAliasKeys[k1]; ok {
+						err = fmt.Errorf("duplicate map key %s", key2.Raw)
+						return false
+					}
+					var v1 string
+					v1, err = decodeJSONString(elem1)
+					if err != nil {
+						return false
+					}
+					o.BinaryAliasKeys[k1] = v1
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"binaryAliasKeys\": %w", err)
+					return false
+				}
+			}
+		case "booleanKeys":
+			if seenBooleanKeys {
+				err = fmt.Errorf("duplicate field \"booleanKeys\"")
+				return false
+			}
+			seenBooleanKeys = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"booleanKeys\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.BooleanKeys = make(map[boolean.Boolean]int)
+				field.ForEach(func(key3, elem2 gjson.Result) bool {
+					var k2 boolean.Boolean
+					k2, err = decodeJSONBooleanKey(key3)
+					if err != nil {
+						return false
+					}
+					if _, ok := o.BooleanKeys[k2]; ok {
+						err = fmt.Errorf("duplicate map key %s", key3.Raw)
+						return false
+					}
+					var v2 int
+					v2, err = decodeJSONInt(elem2)
+					if err != nil {
+						return false
+					}
+					o.BooleanKeys[k2] = v2
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"booleanKeys\": %w", err)
+					return false
+				}
+			}
+		case "booleanAliasKeys":
+			if seenBooleanAliasKeys {
+				err = fmt.Errorf("duplicate field \"booleanAliasKeys\"")
+				return false
+			}
+			seenBooleanAliasKeys = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"booleanAliasKeys\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.BooleanAliasKeys = make(map[boolean.Boolean]int)
+				field.ForEach(func(key4, elem3 gjson.Result) bool {
+					var k3 boolean.Boolean
+					k3, err = decodeJSONBooleanKey(key4)
+					if err != nil {
+						return false
+					}
+					if _, ok := o.BooleanAliasKeys[k3]; ok {
+						err = fmt.Errorf("duplicate map key %s", key4.Raw)
+						return false
+					}
+					var v3 int
+					v3, err = decodeJSONInt(elem3)
+					if err != nil {
+						return false
+					}
+					o.BooleanAliasKeys[k3] = v3
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"booleanAliasKeys\": %w", err)
+					return false
+				}
+			}
+		case "anys":
+			if seenAnys {
+				err = fmt.Errorf("duplicate field \"anys\"")
+				return false
+			}
+			seenAnys = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"anys\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Anys = make([]interface{}, 0)
+				field.ForEach(func(_, elem4 gjson.Result) bool {
+					var v4 interface{}
+					v4, err = decodeJSONAny(elem4)
+					if err != nil {
+						return false
+					}
+					o.Anys = append(o.Anys, v4)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"anys\": %w", err)
+					return false
+				}
+			}
+		case "anyAliases":
+			if seenAnyAliases {
+				err = fmt.Errorf("duplicate field \"anyAliases\"")
+				return false
+			}
+			seenAnyAliases = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"anyAliases\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.AnyAliases = make([]AnyAlias, 0)
+				field.ForEach(func(_, elem5 gjson.Result) bool {
+					var v5 AnyAlias
+					err = safejson.Unmarshal([]byte(elem5.Raw), &v5)
+					if err != nil {
+						return false
+					}
+					o.AnyAliases = append(o.AnyAliases, v5)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"anyAliases\": %w", err)
+					return false
+				}
+			}
+		case "externals":
+			if seenExternals {
+				err = fmt.Errorf("duplicate field \"externals\"")
+				return false
+			}
+			seenExternals = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"externals\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Externals = make([]interface{}, 0)
+				field.ForEach(func(_, elem6 gjson.Result) bool {
+					var v6 interface{}
+					err = safejson.Unmarshal([]byte(elem6.Raw), &v6)
+					if err != nil {
+						return false
+					}
+					o.Externals = append(o.Externals, v6)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"externals\": %w", err)
+					return false
+				}
+			}
+		case "methods":
+			if seenMethods {
+				err = fmt.Errorf("duplicate field \"methods\"")
+				return false
+			}
+			seenMethods = true
+			if field.Type != gjson.Null {
+				var v7 Methods
+				err = v7.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"methods\": %w", err)
+					return false
+				}
+				o.Methods = &v7
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if o.BinaryKeys == nil {
+		o.BinaryKeys = make(map[binary.Binary]string, 0)
+	}
+	if o.BinaryAliasKeys == nil {
+		o.BinaryAliasKeys = make(map[binary.Binary]string, 0)
+	}
+	if o.BooleanKeys == nil {
+		o.BooleanKeys = make(map[boolean.Boolean]int, 0)
+	}
+	if o.BooleanAliasKeys == nil {
+		o.BooleanAliasKeys = make(map[boolean.Boolean]int, 0)
+	}
+	if o.Anys == nil {
+		o.Anys = make([]interface{}, 0)
+	}
+	if o.AnyAliases == nil {
+		o.AnyAliases = make([]AnyAlias, 0)
+	}
+	if o.Externals == nil {
+		o.Externals = make([]interface{}, 0)
+	}
+	return nil
+}
+
+func (o Keys) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *Keys) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the Keys is equal to other according to the Conjure semantics of its values.
+func (o Keys) Equal(other Keys) bool {
+	if len(o.BinaryKeys) != len(other.BinaryKeys) {
+		return false
+	}
+	for k, v := range o.BinaryKeys {
+		otherV, ok := other.BinaryKeys[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	if len(o.BinaryAliasKeys) != len(other.BinaryAliasKeys) {
+		return false
+	}
+	for k, v := range o.BinaryAliasKeys {
+		otherV, ok := other.BinaryAliasKeys[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	if len(o.BooleanKeys) != len(other.BooleanKeys) {
+		return false
+	}
+	for k, v := range o.BooleanKeys {
+		otherV, ok := other.BooleanKeys[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	if len(o.BooleanAliasKeys) != len(other.BooleanAliasKeys) {
+		return false
+	}
+	for k, v := range o.BooleanAliasKeys {
+		otherV, ok := other.BooleanAliasKeys[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	if len(o.Anys) != len(other.Anys) {
+		return false
+	}
+	for i := range o.Anys {
+		if !reflect.DeepEqual(o.Anys[i], other.Anys[i]) {
+			return false
+		}
+	}
+	if len(o.AnyAliases) != len(other.AnyAliases) {
+		return false
+	}
+	for i := range o.AnyAliases {
+		if !reflect.DeepEqual(o.AnyAliases[i], other.AnyAliases[i]) {
+			return false
+		}
+	}
+	if len(o.Externals) != len(other.Externals) {
+		return false
+	}
+	for i := range o.Externals {
+		if !reflect.DeepEqual(o.Externals[i], other.Externals[i]) {
+			return false
+		}
+	}
+	if (o.Methods == nil) != (other.Methods == nil) {
+		return false
+	}
+	if o.Methods != nil {
+		if !reflect.DeepEqual((*o.Methods), *other.Methods) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Keys.
+func (o Keys) Clone() Keys {
+	out := o
+	if o.BinaryKeys != nil {
+		out.BinaryKeys = make(map[binary.Binary]string, len(o.BinaryKeys))
+		for k, v := range o.BinaryKeys {
+			out.BinaryKeys[k] = v
+		}
+	}
+	if o.BinaryAliasKeys != nil {
+		out.BinaryAliasKeys = make(map[binary.Binary]string, len(o.BinaryAliasKeys))
+		for k, v := range o.BinaryAliasKeys {
+			out.BinaryAliasKeys[k] = v
+		}
+	}
+	if o.BooleanKeys != nil {
+		out.BooleanKeys = make(map[boolean.Boolean]int, len(o.BooleanKeys))
+		for k, v := range o.BooleanKeys {
+			out.BooleanKeys[k] = v
+		}
+	}
+	if o.BooleanAliasKeys != nil {
+		out.BooleanAliasKeys = make(map[boolean.Boolean]int, len(o.BooleanAliasKeys))
+		for k, v := range o.BooleanAliasKeys {
+			out.BooleanAliasKeys[k] = v
+		}
+	}
+	if o.Anys != nil {
+		out.Anys = make([]interface{}, len(o.Anys))
+		copy(out.Anys, o.Anys)
+	}
+	if o.AnyAliases != nil {
+		out.AnyAliases = make([]AnyAlias, len(o.AnyAliases))
+		copy(out.AnyAliases, o.AnyAliases)
+	}
+	if o.Externals != nil {
+		out.Externals = make([]interface{}, len(o.Externals))
+		copy(out.Externals, o.Externals)
+	}
+	if o.Methods != nil {
+		v := *o.Methods
+		out.Methods = &v
+	}
+	return out
+}
+
+// Hash returns a hash of the Keys. Values that are Equal have the same hash.
+func (o Keys) Hash() uint64 {
+	var h uint64
+	for k, v := range o.BinaryKeys {
+		var elemHash uint64
+		elemHash = hashMix(elemHash, hashString(string(k)))
+		elemHash = hashMix(elemHash, hashString(v))
+		h += elemHash
+	}
+	for k, v := range o.BinaryAliasKeys {
+		var elemHash uint64
+		elemHash = hashMix(elemHash, hashString(string(k)))
+		elemHash = hashMix(elemHash, hashString(v))
+		h += elemHash
+	}
+	for k, v := range o.BooleanKeys {
+		var elemHash uint64
+		elemHash = hashMix(elemHash, hashBool(bool(k)))
+		elemHash = hashMix(elemHash, uint64(v))
+		h += elemHash
+	}
+	for k, v := range o.BooleanAliasKeys {
+		var elemHash uint64
+		elemHash = hashMix(elemHash, hashBool(bool(k)))
+		elemHash = hashMix(elemHash, uint64(v))
+		h += elemHash
+	}
+	for _, v := range o.Anys {
+		h = hashMix(h, hashString(fmt.Sprint(v)))
+	}
+	for _, v := range o.AnyAliases {
+		h = hashMix(h, hashString(fmt.Sprint(v)))
+	}
+	for _, v := range o.Externals {
+		h = hashMix(h, hashString(fmt.Sprint(v)))
+	}
+	if o.Methods != nil {
+		h = hashMix(h, 0)
+	}
+	return h
+}
+
+type Methods struct {
+	Equal string `json:"equal"`
+	Clone string `json:"clone"`
+	Hash  string `json:"hash"`
+}
+
+func (o Methods) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"equal\":"...)
+	out = appendJSONString(out, o.Equal)
+	out = append(out, ",\"clone\":"...)
+	out = appendJSONString(out, o.Clone)
+	out = append(out, ",\"hash\":"...)
+	out = appendJSONString(out, o.Hash)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Methods) JSONSize() (int, error) {
+	size := 27
+	size += jsonStringSize(o.Equal)
+	size += jsonStringSize(o.Clone)
+	size += jsonStringSize(o.Hash)
+	return size, nil
+}
+
+func (o *Methods) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenEqual, seenClone, seenHash bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "equal":
+			if seenEqual {
+				ok = false
+				return false
+			}
+			seenEqual = true
+			if !unmarshalJSONString(field, &o.Equal) {
+				ok = false
+				return false
+			}
+		case "clone":
+			if seenClone {
+				ok = false
+				return false
+			}
+			seenClone = true
+			if !unmarshalJSONString(field, &o.Clone) {
+				ok = false
+				return false
+			}
+		case "hash":
+			if seenHash {
+				ok = false
+				return false
+			}
+			seenHash = true
+			if !unmarshalJSONString(field, &o.Hash) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "equal", "clone", "hash") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *Methods) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Methods) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Methods{}
+	var seenEqual, seenClone, seenHash bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "equal":
+			if seenEqual {
+				err = fmt.Errorf("duplicate field \"equal\"")
+				return false
+			}
+			seenEqual = true
+			o.Equal, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"equal\": %w", err)
+				return false
+			}
+		case "clone":
+			if seenClone {
+				err = fmt.Errorf("duplicate field \"clone\"")
+				return false
+			}
+			seenClone = true
+			o.Clone, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"clone\": %w", err)
+				return false
+			}
+		case "hash":
+			if seenHash {
+				err = fmt.Errorf("duplicate field \"hash\"")
+				return false
+			}
+			seenHash = true
+			o.Hash, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"hash\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenEqual {
+		return fmt.Errorf("field \"equal\" is required")
+	}
+	if !seenClone {
+		return fmt.Errorf("field \"clone\" is required")
+	}
+	if !seenHash {
+		return fmt.Errorf("field \"hash\" is required")
+	}
+	return nil
+}
+
+func (o Methods) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *Methods) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+type Record struct {
+	Name          string                 `json:"name"`
+	Count         int                    `json:"count"`
+	Big           safelong.SafeLong      `json:"big"`
+	Ratio         float64                `json:"ratio"`
+	Flag          bool                   `json:"flag"`
+	Id            uuid.UUID              `json:"id"`
+	Owner         rid.ResourceIdentifier `json:"owner"`
+	Created       datetime.DateTime      `json:"created"`
+	Data          []byte                 `json:"data"`
+	Extra         interface{}            `json:"extra"`
+	Token         bearertoken.Token      `json:"token"`
+	Kind          Kind                   `json:"kind"`
+	Shape         Shape                  `json:"shape"`
+	Label         *string                `json:"label"`
+	Parent        *Record                `json:"parent"`
+	Tags          []string               `json:"tags"`
+	Names         []string               `json:"names"`
+	Times         []datetime.DateTime    `json:"times"`
+	Ratios        []float64              `json:"ratios"`
+	Nested        [][]Kind               `json:"nested"`
+	Children      map[string]Record      `json:"children"`
+	Counts        map[Kind][]int         `json:"counts"`
+	Alias         ItemAlias              `json:"alias"`
+	OptionalAlias OptionalAlias          `json:"optionalAlias"`
+	ListAlias     ListAlias              `json:"listAlias"`
+	BinaryAlias   BinaryAlias            `json:"binaryAlias"`
+	TimeAlias     DateTimeAlias          `json:"timeAlias"`
+}
+
+func (o Record) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"name\":"...)
+	out = appendJSONString(out, o.Name)
+	out = append(out, ",\"count\":"...)
+	out = strconv.AppendInt(out, int64(o.Count), 10)
+	out = append(out, ",\"big\":"...)
+	if _, err := safelong.NewSafeLong(int64(o.Big)); err != nil {
+		return nil, err
+	}
+	out = strconv.AppendInt(out, int64(o.Big), 10)
+	out = append(out, ",\"ratio\":"...)
+	out = appendJSONFloat64(out, o.Ratio)
+	out = append(out, ",\"flag\":"...)
+	out = strconv.AppendBool(out, o.Flag)
+	out = append(out, ",\"id\":"...)
+	out = appendJSONUUID(out, o.Id)
+	out = append(out, ",\"owner\":"...)
+	out, err = appendJSONText(out, o.Owner)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"created\":"...)
+	out = appendJSONDateTime(out, o.Created)
+	out = append(out, ",\"data\":"...)
+	if o.Data == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONBinary(out, o.Data)
+	}
+	out = append(out, ",\"extra\":"...)
+	out, err = appendJSONMarshal(out, o.Extra)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"token\":"...)
+	out = appendJSONString(out, string(o.Token))
+	out = append(out, ",\"kind\":"...)
+	out, err = o.Kind.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"shape\":"...)
+	out, err = o.Shape.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"label\":"...)
+	if o.Label == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, *o.Label)
+	}
+	out = append(out, ",\"parent\":"...)
+	if o.Parent == nil {
+		out = append(out, "null"...)
+	} else {
+		out, err = o.Parent.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ",\"tags\":"...)
+	out = append(out, '[')
+	for i, v := range o.Tags {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
+	}
+	out = append(out, ']')
+	out = append(out, ",\"names\":"...)
+	out = append(out, '[')
+	for i, v := range o.Names {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
+	}
+	out = append(out, ']')
+	out = append(out, ",\"times\":"...)
+	out = append(out, '[')
+	for i, v := range o.Times {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONDateTime(out, v)
+	}
+	out = append(out, ']')
+	out = append(out, ",\"ratios\":"...)
+	out = append(out, '[')
+	for i, v := range o.Ratios {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONFloat64(out, v)
+	}
+	out = append(out, ']')
+	out = append(out, ",\"nested\":"...)
+	out = append(out, '[')
+	for i, v := range o.Nested {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = append(out, '[')
+		for i1, v1 := range v {
+			if i1 > 0 {
+				out = append(out, ',')
+			}
+			out, err = v1.AppendJSON(out)
+			if err != nil {
+				return nil, err
+			}
+		}
+		out = append(out, ']')
+	}
+	out = append(out, ']')
+	out = append(out, ",\"children\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value Record
+		}
+		entries := make([]mapEntry, 0, len(o.Children))
+		for k, v := range o.Children {
+			entries = append(entries, mapEntry{key: k, value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out, err = entry.value.AppendJSON(out)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	out = append(out, '}')
+	out = append(out, ",\"counts\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value []int
+		}
+		entries := make([]mapEntry, 0, len(o.Counts))
+		for k, v := range o.Counts {
+			entries = append(entries, mapEntry{key: k.String(), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = append(out, '[')
+			for i1, v1 := range entry.value {
+				if i1 > 0 {
+					out = append(out, ',')
+				}
+				out = strconv.AppendInt(out, int64(v1), 10)
+			}
+			out = append(out, ']')
+		}
+	}
+	out = append(out, '}')
+	out = append(out, ",\"alias\":"...)
+	out, err = o.Alias.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"optionalAlias\":"...)
+	out, err = o.OptionalAlias.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"listAlias\":"...)
+	out = append(out, '[')
+	for i, v := range []string(o.ListAlias) {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
+	}
+	out = append(out, ']')
+	out = append(out, ",\"binaryAlias\":"...)
+	out, err = o.BinaryAlias.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"timeAlias\":"...)
+	out, err = o.TimeAlias.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Record) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 265
+	size += jsonStringSize(o.Name)
+	size += jsonIntSize(int64(o.Count))
+	if _, err := safelong.NewSafeLong(int64(o.Big)); err != nil {
+		return 0, err
+	}
+	size += jsonIntSize(int64(o.Big))
+	size += jsonFloat64Size(o.Ratio)
+	if o.Flag {
+		size += 4
+	} else {
+		size += 5
+	}
+	size += 38
+	n, err = jsonTextSize(o.Owner)
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	size += jsonDateTimeSize(o.Created)
+	if o.Data == nil {
+		size += 4
+	} else {
+		size += base64.StdEncoding.EncodedLen(len(o.Data)) + 2
+	}
+	n, err = jsonMarshalSize(o.Extra)
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	size += jsonStringSize(string(o.Token))
+	n, err = o.Kind.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.Shape.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	if o.Label == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(*o.Label)
+	}
+	if o.Parent == nil {
+		size += 4
+	} else {
+		n, err = o.Parent.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 2
+	if len(o.Tags) > 1 {
+		size += len(o.Tags) - 1
+	}
+	for _, v := range o.Tags {
+		size += jsonStringSize(v)
+	}
+	size += 2
+	if len(o.Names) > 1 {
+		size += len(o.Names) - 1
+	}
+	for _, v := range o.Names {
+		size += jsonStringSize(v)
+	}
+	size += 2
+	if len(o.Times) > 1 {
+		size += len(o.Times) - 1
+	}
+	for _, v := range o.Times {
+		size += jsonDateTimeSize(v)
+	}
+	size += 2
+	if len(o.Ratios) > 1 {
+		size += len(o.Ratios) - 1
+	}
+	for _, v := range o.Ratios {
+		size += jsonFloat64Size(v)
+	}
+	size += 2
+	if len(o.Nested) > 1 {
+		size += len(o.Nested) - 1
+	}
+	for _, v := range o.Nested {
+		size += 2
+		if len(v) > 1 {
+			size += len(v) - 1
+		}
+		for _, v1 := range v {
+			n, err = v1.JSONSize()
+			if err != nil {
+				return 0, err
+			}
+			size += n
+		}
+	}
+	size += 2
+	if len(o.Children) > 1 {
+		size += len(o.Children) - 1
+	}
+	for k, v := range o.Children {
+		size += jsonStringSize(k) + 1
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 2
+	if len(o.Counts) > 1 {
+		size += len(o.Counts) - 1
+	}
+	for k, v := range o.Counts {
+		size += jsonStringSize(k.String()) + 1
+		size += 2
+		if len(v) > 1 {
+			size += len(v) - 1
+		}
+		for _, v1 := range v {
+			size += jsonIntSize(int64(v1))
+		}
+	}
+	n, err = o.Alias.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.OptionalAlias.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	size += 2
+	if len([]string(o.ListAlias)) > 1 {
+		size += len([]string(o.ListAlias)) - 1
+	}
+	for _, v := range []string(o.ListAlias) {
+		size += jsonStringSize(v)
+	}
+	n, err = o.BinaryAlias.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.TimeAlias.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (o Record) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
+}
+
+func (o *Record) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Record
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
+	type RecordAlias Record
+	var rawRecord RecordAlias
+	if err := safejson.Unmarshal(data, &rawRecord); err != nil {
+		return err
+	}
+	if rawRecord.Tags == nil {
+		rawRecord.Tags = make([]string, 0)
+	}
+	if rawRecord.Names == nil {
+		rawRecord.Names = make([]string, 0)
+	}
+	if rawRecord.Times == nil {
+		rawRecord.Times = make([]datetime.DateTime, 0)
+	}
+	if rawRecord.Ratios == nil {
+		rawRecord.Ratios = make([]float64, 0)
+	}
+	if rawRecord.Nested == nil {
+		rawRecord.Nested = make([][]Kind, 0)
+	}
+	if rawRecord.Children == nil {
+		rawRecord.Children = make(map[string]Record, 0)
+	}
+	if rawRecord.Counts == nil {
+		rawRecord.Counts = make(map[Kind][]int, 0)
+	}
+	if rawRecord.ListAlias == nil {
+		rawRecord.ListAlias = ListAlias(make([]string, 0))
+	}
+	*o = Record(rawRecord)
+	return nil
+}
+
+func (o *Record) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Tags == nil {
+			o.Tags = make([]string, 0)
+		}
+		if o.Names == nil {
+			o.Names = make([]string, 0)
+		}
+		if o.Times == nil {
+			o.Times = make([]datetime.DateTime, 0)
+		}
+		if o.Ratios == nil {
+			o.Ratios = make([]float64, 0)
+		}
+		if o.Nested == nil {
+			o.Nested = make([][]Kind, 0)
+		}
+		if o.Children == nil {
+			o.Children = make(map[string]Record, 0)
+		}
+		if o.Counts == nil {
+			o.Counts = make(map[Kind][]int, 0)
+		}
+		if o.ListAlias == nil {
+			o.ListAlias = ListAlias(make([]string, 0))
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenName, seenCount, seenBig, seenRatio, seenFlag, seenId, seenOwner, seenCreated, seenData, seenExtra, seenToken, seenKind, seenShape, seenLabel, seenParent, seenTags, seenNames, seenTimes, seenRatios, seenNested, seenChildren, seenCounts, seenAlias, seenOptionalAlias, seenListAlias, seenBinaryAlias, seenTimeAlias bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "name":
+			if seenName {
+				ok = false
+				return false
+			}
+			seenName = true
+			if !unmarshalJSONString(field, &o.Name) {
+				ok = false
+				return false
+			}
+		case "count":
+			if seenCount {
+				ok = false
+				return false
+			}
+			seenCount = true
+			if !unmarshalJSONInt(field, &o.Count) {
+				ok = false
+				return false
+			}
+		case "big":
+			if seenBig {
+				ok = false
+				return false
+			}
+			seenBig = true
+			if !unmarshalJSONSafeLong(field, &o.Big) {
+				ok = false
+				return false
+			}
+		case "ratio":
+			if seenRatio {
+				ok = false
+				return false
+			}
+			seenRatio = true
+			if !unmarshalJSONFloat64(field, &o.Ratio) {
+				ok = false
+				return false
+			}
+		case "flag":
+			if seenFlag {
+				ok = false
+				return false
+			}
+			seenFlag = true
+			if !unmarshalJSONBool(field, &o.Flag) {
+				ok = false
+				return false
+			}
+		case "id":
+			if seenId {
+				ok = false
+				return false
+			}
+			seenId = true
+			if field.Type != gjson.Null {
+				var s string
+				if !unmarshalJSONString(field, &s) || o.Id.UnmarshalText([]byte(s)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "owner":
+			if seenOwner {
+				ok = false
+				return false
+			}
+			seenOwner = true
+			if field.Type != gjson.Null {
+				var s1 string
+				if !unmarshalJSONString(field, &s1) || o.Owner.UnmarshalText([]byte(s1)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "created":
+			if seenCreated {
+				ok = false
+				return false
+			}
+			seenCreated = true
+			if field.Type != gjson.Null {
+				var s2 string
+				if !unmarshalJSONString(field, &s2) || o.Created.UnmarshalText([]byte(s2)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "data":
+			if seenData {
+				ok = false
+				return false
+			}
+			seenData = true
+			if !unmarshalJSONBinary(field, &o.Data) {
+				ok = false
+				return false
+			}
+		case "extra":
+			if seenExtra {
+				ok = false
+				return false
+			}
+			seenExtra = true
+			if !unmarshalJSONAny(field, &o.Extra) {
+				ok = false
+				return false
+			}
+		case "token":
+			if seenToken {
+				ok = false
+				return false
+			}
+			seenToken = true
+			if field.Type != gjson.Null {
+				var s3 string
+				if !unmarshalJSONString(field, &s3) || o.Token.UnmarshalText([]byte(s3)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "kind":
+			if seenKind {
+				ok = false
+				return false
+			}
+			seenKind = true
+			if field.Type != gjson.Null {
+				var s4 string
+				if !unmarshalJSONString(field, &s4) || o.Kind.UnmarshalText([]byte(s4)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "shape":
+			if seenShape {
+				ok = false
+				return false
+			}
+			seenShape = true
+			if !o.Shape.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "label":
+			if seenLabel {
+				ok = false
+				return false
+			}
+			seenLabel = true
+			if field.Type != gjson.Null {
+				var v string
+				if !unmarshalJSONString(field, &v) {
+					ok = false
+					return false
+				}
+				o.Label = &v
+			}
+		case "parent":
+			if seenParent {
+				ok = false
+				return false
+			}
+			seenParent = true
+			if field.Type != gjson.Null {
+				var v1 Record
+				if !v1.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				o.Parent = &v1
+			}
+		case "tags":
+			if seenTags {
+				ok = false
+				return false
+			}
+			seenTags = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Tags = make([]string, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v2 string
+					if !unmarshalJSONString(elem, &v2) {
+						ok1 = false
+						return false
+					}
+					o.Tags = append(o.Tags, v2)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "names":
+			if seenNames {
+				ok = false
+				return false
+			}
+			seenNames = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Names = make([]string, 0)
+				ok2 := true
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v3 string
+					if !unmarshalJSONString(elem1, &v3) {
+						ok2 = false
+						return false
+					}
+					o.Names = append(o.Names, v3)
+					return true
+				})
+				if !ok2 {
+					ok = false
+					return false
+				}
+			}
+		case "times":
+			if seenTimes {
+				ok = false
+				return false
+			}
+			seenTimes = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Times = make([]datetime.DateTime, 0)
+				ok3 := true
+				field.ForEach(func(_, elem2 gjson.Result) bool {
+					var v4 datetime.DateTime
+					if elem2.Type != gjson.Null {
+						var s5 string
+						if !unmarshalJSONString(elem2, &s5) || v4.UnmarshalText([]byte(s5)) != nil {
+							ok3 = false
+							return false
+						}
+					}
+					o.Times = append(o.Times, v4)
+					return true
+				})
+				if !ok3 {
+					ok = false
+					return false
+				}
+			}
+		case "ratios":
+			if seenRatios {
+				ok = false
+				return false
+			}
+			seenRatios = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Ratios = make([]float64, 0)
+				ok4 := true
+				field.ForEach(func(_, elem3 gjson.Result) bool {
+					var v5 float64
+					if !unmarshalJSONFloat64(elem3, &v5) {
+						ok4 = false
+						return false
+					}
+					o.Ratios = append(o.Ratios, v5)
+					return true
+				})
+				if !ok4 {
+					ok = false
+					return false
+				}
+			}
+		case "nested":
+			if seenNested {
+				ok = false
+				return false
+			}
+			seenNested = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Nested = make([][]Kind, 0)
+				ok5 := true
+				field.ForEach(func(_, elem4 gjson.Result) bool {
+					var v6 []Kind
+					if elem4.Type != gjson.Null {
+						if !elem4.IsArray() {
+							ok5 = false
+							return false
+						}
+						v6 = make([]Kind, 0)
+						ok6 := true
+						elem4.ForEach(func(_, elem5 gjson.Result) bool {
+							var v7 Kind
+							if elem5.Type != gjson.Null {
+								var s6 string
+								if !unmarshalJSONString(elem5, &s6) || v7.UnmarshalText([]byte(s6)) != nil {
+									ok6 = false
+									return false
+								}
+							}
+							v6 = append(v6, v7)
+							return true
+						})
+						if !ok6 {
+							ok5 = false
+							return false
+						}
+					}
+					o.Nested = append(o.Nested, v6)
+					return true
+				})
+				if !ok5 {
+					ok = false
+					return false
+				}
+			}
+		case "children":
+			if seenChildren {
+				ok = false
+				return false
+			}
+			seenChildren = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.Children = make(map[string]Record)
+				ok7 := true
+				field.ForEach(func(key1, elem6 gjson.Result) bool {
+					var k string
+					if !unmarshalJSONString(key1, &k) {
+						ok7 = false
+						return false
+					}
+					var v8 Record
+					if !v8.unmarshalJSONValue(elem6) {
+						ok7 = false
+						return false
+					}
+					o.Children[k] = v8
+					return true
+				})
+				if !ok7 {
+					ok = false
+					return false
+				}
+			}
+		case "counts":
+			if seenCounts {
+				ok = false
+				return false
+			}
+			seenCounts = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.Counts = make(map[Kind][]int)
+				ok8 := true
+				field.ForEach(func(key2, elem7 gjson.Result) bool {
+					var k1 Kind
+					var s7 string
+					if !unmarshalJSONString(key2, &s7) || k1.UnmarshalText([]byte(s7)) != nil {
+						ok8 = false
+						return false
+					}
+					var v9 []int
+					if elem7.Type != gjson.Null {
+						if !elem7.IsArray() {
+							ok8 = false
+							return false
+						}
+						v9 = make([]int, 0)
+						ok9 := true
+						elem7.ForEach(func(_, elem8 gjson.Result) bool {
+							var v10 int
+							if !unmarshalJSONInt(elem8, &v10) {
+								ok9 = false
+								return false
+							}
+							v9 = append(v9, v10)
+							return true
+						})
+						if !ok9 {
+							ok8 = false
+							return false
+						}
+					}
+					o.Counts[k1] = v9
+					return true
+				})
+				if !ok8 {
+					ok = false
+					return false
+				}
+			}
+		case "alias":
+			if seenAlias {
+				ok = false
+				return false
+			}
+			seenAlias = true
+			var v11 Item
+			if !v11.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+			o.Alias = ItemAlias(v11)
+		case "optionalAlias":
+			if seenOptionalAlias {
+				ok = false
+				return false
+			}
+			seenOptionalAlias = true
+			var v12 []string
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				v12 = make([]string, 0)
+				ok10 := true
+				field.ForEach(func(_, elem9 gjson.Result) bool {
+					var v13 string
+					if !unmarshalJSONString(elem9, &v13) {
+						ok10 = false
+						return false
+					}
+					v12 = append(v12, v13)
+					return true
+				})
+				if !ok10 {
+					ok = false
+					return false
+				}
+			}
+			o.OptionalAlias.Value = &v12
+		case "listAlias":
+			if seenListAlias {
+				ok = false
+				return false
+			}
+			seenListAlias = true
+			var v14 []string
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				v14 = make([]string, 0)
+				ok11 := true
+				field.ForEach(func(_, elem10 gjson.Result) bool {
+					var v15 string
+					if !unmarshalJSONString(elem10, &v15) {
+						ok11 = false
+						return false
+					}
+					v14 = append(v14, v15)
+					return true
+				})
+				if !ok11 {
+					ok = false
+					return false
+				}
+			}
+			o.ListAlias = ListAlias(v14)
+		case "binaryAlias":
+			if seenBinaryAlias {
+				ok = false
+				return false
+			}
+			seenBinaryAlias = true
+			if field.Type != gjson.Null {
+				var s8 string
+				if !unmarshalJSONString(field, &s8) || o.BinaryAlias.UnmarshalText([]byte(s8)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "timeAlias":
+			if seenTimeAlias {
+				ok = false
+				return false
+			}
+			seenTimeAlias = true
+			if field.Type != gjson.Null {
+				var s9 string
+				if !unmarshalJSONString(field, &s9) || o.TimeAlias.UnmarshalText([]byte(s9)) != nil {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "name", "count", "big", "ratio", "flag", "id", "owner", "created", "data", "extra", "token", "kind", "shape", "label", "parent", "tags", "names", "times", "ratios", "nested", "children", "counts", "alias", "optionalAlias", "listAlias", "binaryAlias", "timeAlias") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Tags == nil {
+		o.Tags = make([]string, 0)
+	}
+	if o.Names == nil {
+		o.Names = make([]string, 0)
+	}
+	if o.Times == nil {
+		o.Times = make([]datetime.DateTime, 0)
+	}
+	if o.Ratios == nil {
+		o.Ratios = make([]float64, 0)
+	}
+	if o.Nested == nil {
+		o.Nested = make([][]Kind, 0)
+	}
+	if o.Children == nil {
+		o.Children = make(map[string]Record, 0)
+	}
+	if o.Counts == nil {
+		o.Counts = make(map[Kind][]int, 0)
+	}
+	if o.ListAlias == nil {
+		o.ListAlias = ListAlias(make([]string, 0))
+	}
+	return true
+}
+
+func (o *Record) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Record) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Record{}
+	var seenName, seenCount, seenBig, seenRatio, seenFlag, seenId, seenOwner, seenCreated, seenData, seenExtra, seenToken, seenKind, seenShape, seenLabel, seenParent, seenTags, seenNames, seenTimes, seenRatios, seenNested, seenChildren, seenCounts, seenAlias, seenOptionalAlias, seenListAlias, seenBinaryAlias, seenTimeAlias bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "name":
+			if seenName {
+				err = fmt.Errorf("duplicate field \"name\"")
+				return false
+			}
+			seenName = true
+			o.Name, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"name\": %w", err)
+				return false
+			}
+		case "count":
+			if seenCount {
+				err = fmt.Errorf("duplicate field \"count\"")
+				return false
+			}
+			seenCount = true
+			o.Count, err = decodeJSONInt(field)
+			if err != nil {
+				err = fmt.Errorf("field \"count\": %w", err)
+				return false
+			}
+		case "big":
+			if seenBig {
+				err = fmt.Errorf("duplicate field \"big\"")
+				return false
+			}
+			seenBig = true
+			o.Big, err = decodeJSONSafeLong(field)
+			if err != nil {
+				err = fmt.Errorf("field \"big\": %w", err)
+				return false
+			}
+		case "ratio":
+			if seenRatio {
+				err = fmt.Errorf("duplicate field \"ratio\"")
+				return false
+			}
+			seenRatio = true
+			o.Ratio, err = decodeJSONFloat64(field)
+			if err != nil {
+				err = fmt.Errorf("field \"ratio\": %w", err)
+				return false
+			}
+		case "flag":
+			if seenFlag {
+				err = fmt.Errorf("duplicate field \"flag\"")
+				return false
+			}
+			seenFlag = true
+			o.Flag, err = decodeJSONBool(field)
+			if err != nil {
+				err = fmt.Errorf("field \"flag\": %w", err)
+				return false
+			}
+		case "id":
+			if seenId {
+				err = fmt.Errorf("duplicate field \"id\"")
+				return false
+			}
+			seenId = true
+			o.Id, err = decodeJSONUUID(field)
+			if err != nil {
+				err = fmt.Errorf("field \"id\": %w", err)
+				return false
+			}
+		case "owner":
+			if seenOwner {
+				err = fmt.Errorf("duplicate field \"owner\"")
+				return false
+			}
+			seenOwner = true
+			o.Owner, err = decodeJSONRID(field)
+			if err != nil {
+				err = fmt.Errorf("field \"owner\": %w", err)
+				return false
+			}
+		case "created":
+			if seenCreated {
+				err = fmt.Errorf("duplicate field \"created\"")
+				return false
+			}
+			seenCreated = true
+			o.Created, err = decodeJSONDateTime(field)
+			if err != nil {
+				err = fmt.Errorf("field \"created\": %w", err)
+				return false
+			}
+		case "data":
+			if seenData {
+				err = fmt.Errorf("duplicate field \"data\"")
+				return false
+			}
+			seenData = true
+			o.Data, err = decodeJSONBinary(field)
+			if err != nil {
+				err = fmt.Errorf("field \"data\": %w", err)
+				return false
+			}
+		case "extra":
+			if seenExtra {
+				err = fmt.Errorf("duplicate field \"extra\"")
+				return false
+			}
+			seenExtra = true
+			o.Extra, err = decodeJSONAny(field)
+			if err != nil {
+				err = fmt.Errorf("field \"extra\": %w", err)
+				return false
+			}
+		case "token":
+			if seenToken {
+				err = fmt.Errorf("duplicate field \"token\"")
+				return false
+			}
+			seenToken = true
+			o.Token, err = decodeJSONBearerToken(field)
+			if err != nil {
+				err = fmt.Errorf("field \"token\": %w", err)
+				return false
+			}
+		case "kind":
+			if seenKind {
+				err = fmt.Errorf("duplicate field \"kind\"")
+				return false
+			}
+			seenKind = true
+			err = o.Kind.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"kind\": %w", err)
+				return false
+			}
+		case "shape":
+			if seenShape {
+				err = fmt.Errorf("duplicate field \"shape\"")
+				return false
+			}
+			seenShape = true
+			err = o.Shape.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"shape\": %w", err)
+				return false
+			}
+		case "label":
+			if seenLabel {
+				err = fmt.Errorf("duplicate field \"label\"")
+				return false
+			}
+			seenLabel = true
+			if field.Type != gjson.Null {
+				var v string
+				v, err = decodeJSONString(field)
+				if err != nil {
+					err = fmt.Errorf("field \"label\": %w", err)
+					return false
+				}
+				o.Label = &v
+			}
+		case "parent":
+			if seenParent {
+				err = fmt.Errorf("duplicate field \"parent\"")
+				return false
+			}
+			seenParent = true
+			if field.Type != gjson.Null {
+				var v1 Record
+				err = v1.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"parent\": %w", err)
+					return false
+				}
+				o.Parent = &v1
+			}
+		case "tags":
+			if seenTags {
+				err = fmt.Errorf("duplicate field \"tags\"")
+				return false
+			}
+			seenTags = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"tags\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Tags = make([]string, 0)
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v2 string
+					v2, err = decodeJSONString(elem)
+					if err != nil {
+						return false
+					}
+					o.Tags = append(o.Tags, v2)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"tags\": %w", err)
+					return false
+				}
+			}
+		case "names":
+			if seenNames {
+				err = fmt.Errorf("duplicate field \"names\"")
+				return false
+			}
+			seenNames = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"names\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Names = make([]string, 0)
+				seen := make(map[string]struct{})
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v3 string
+					v3, err = decodeJSONString(elem1)
+					if err != nil {
+						return false
+					}
+					if _, ok := seen[v3]; ok {
+						err = fmt.Errorf("duplicate set element %s", elem1.Raw)
+						return false
+					}
+					seen[v3] = struct{}{}
+					o.Names = append(o.Names, v3)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"names\": %w", err)
+					return false
+				}
+			}
+		case "times":
+			if seenTimes {
+				err = fmt.Errorf("duplicate field \"times\"")
+				return false
+			}
+			seenTimes = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"times\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Times = make([]datetime.DateTime, 0)
+				field.ForEach(func(_, elem2 gjson.Result) bool {
+					var v4 datetime.DateTime
+					v4, err = decodeJSONDateTime(elem2)
+					if err != nil {
+						return false
+					}
+					o.Times = append(o.Times, v4)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"times\": %w", err)
+					return false
+				}
+			}
+		case "ratios":
+			if seenRatios {
+				err = fmt.Errorf("duplicate field \"ratios\"")
+				return false
+			}
+			seenRatios = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"ratios\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Ratios = make([]float64, 0)
+				seen1 := make(map[float64]struct{})
+				field.ForEach(func(_, elem3 gjson.Result) bool {
+					var v5 float64
+					v5, err = decodeJSONFloat64(elem3)
+					if err != nil {
+						return false
+					}
+					if _, ok := seen1[v5]; ok {
+						err = fmt.Errorf("duplicate set element %s", elem3.Raw)
+						return false
+					}
+					seen1[v5] = struct{}{}
+					o.Ratios = append(o.Ratios, v5)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"ratios\": %w", err)
+					return false
+				}
+			}
+		case "nested":
+			if seenNested {
+				err = fmt.Errorf("duplicate field \"nested\"")
+				return false
+			}
+			seenNested = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"nested\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Nested = make([][]Kind, 0)
+				field.ForEach(func(_, elem4 gjson.Result) bool {
+					var v6 []Kind
+					if !elem4.IsArray() {
+						err = jsonTypeError(elem4, "array")
+						return false
+					}
+					v6 = make([]Kind, 0)
+					seen2 := make(map[Kind]struct{})
+					elem4.ForEach(func(_, elem5 gjson.Result) bool {
+						var v7 Kind
+						err = v7.decodeJSONStrict(elem5)
+						if err != nil {
+							return false
+						}
+						if _, ok := seen2[v7]; ok {
+							err = fmt.Errorf("duplicate set element %s", elem5.Raw)
+							return false
+						}
+						seen2[v7] = struct{}{}
+						v6 = append(v6, v7)
+						return true
+					})
+					if err != nil {
+						return false
+					}
+					o.Nested = append(o.Nested, v6)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"nested\": %w", err)
+					return false
+				}
+			}
+		case "children":
+			if seenChildren {
+				err = fmt.Errorf("duplicate field \"children\"")
+				return false
+			}
+			seenChildren = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"children\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.Children = make(map[string]Record)
+				field.ForEach(func(key1, elem6 gjson.Result) bool {
+					var k string
+					k = key1.Str
+					if _, ok := o.Children[k]; ok {
+						err = fmt.Errorf("duplicate map key %s", key1.Raw)
+						return false
+					}
+					var v8 Record
+					err = v8.decodeJSONStrict(elem6)
+					if err != nil {
+						return false
+					}
+					o.Children[k] = v8
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"children\": %w", err)
+					return false
+				}
+			}
+		case "counts":
+			if seenCounts {
+				err = fmt.Errorf("duplicate field \"counts\"")
+				return false
+			}
+			seenCounts = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"counts\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.Counts = make(map[Kind][]int)
+				field.ForEach(func(key2, elem7 gjson.Result) bool {
+					var k1 Kind
+					err = k1.decodeJSONStrict(key2)
+					if err != nil {
+						return false
+					}
+					if _, ok := o.Counts[k1]; ok {
+						err = fmt.Errorf("duplicate map key %s", key2.Raw)
+						return false
+					}
+					var v9 []int
+					if !elem7.IsArray() {
+						err = jsonTypeError(elem7, "array")
+						return false
+					}
+					v9 = make([]int, 0)
+					elem7.ForEach(func(_, elem8 gjson.Result) bool {
+						var v10 int
+						v10, err = decodeJSONInt(elem8)
+						if err != nil {
+							return false
+						}
+						v9 = append(v9, v10)
+						return true
+					})
+					if err != nil {
+						return false
+					}
+					o.Counts[k1] = v9
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"counts\": %w", err)
+					return false
+				}
+			}
+		case "alias":
+			if seenAlias {
+				err = fmt.Errorf("duplicate field \"alias\"")
+				return false
+			}
+			seenAlias = true
+			err = o.Alias.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"alias\": %w", err)
+				return false
+			}
+		case "optionalAlias":
+			if seenOptionalAlias {
+				err = fmt.Errorf("duplicate field \"optionalAlias\"")
+				return false
+			}
+			seenOptionalAlias = true
+			err = o.OptionalAlias.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"optionalAlias\": %w", err)
+				return false
+			}
+		case "listAlias":
+			if seenListAlias {
+				err = fmt.Errorf("duplicate field \"listAlias\"")
+				return false
+			}
+			seenListAlias = true
+			if field.Type != gjson.Null {
+				err = o.ListAlias.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"listAlias\": %w", err)
+					return false
+				}
+			}
+		case "binaryAlias":
+			if seenBinaryAlias {
+				err = fmt.Errorf("duplicate field \"binaryAlias\"")
+				return false
+			}
+			seenBinaryAlias = true
+			err = o.BinaryAlias.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"binaryAlias\": %w", err)
+				return false
+			}
+		case "timeAlias":
+			if seenTimeAlias {
+				err = fmt.Errorf("duplicate field \"timeAlias\"")
+				return false
+			}
+			seenTimeAlias = true
+			err = o.TimeAlias.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"timeAlias\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenName {
+		return fmt.Errorf("field \"name\" is required")
+	}
+	if !seenCount {
+		return fmt.Errorf("field \"count\" is required")
+	}
+	if !seenBig {
+		return fmt.Errorf("field \"big\" is required")
+	}
+	if !seenRatio {
+		return fmt.Errorf("field \"ratio\" is required")
+	}
+	if !seenFlag {
+		return fmt.Errorf("field \"flag\" is required")
+	}
+	if !seenId {
+		return fmt.Errorf("field \"id\" is required")
+	}
+	if !seenOwner {
+		return fmt.Errorf("field \"owner\" is required")
+	}
+	if !seenCreated {
+		return fmt.Errorf("field \"created\" is required")
+	}
+	if !seenData {
+		return fmt.Errorf("field \"data\" is required")
+	}
+	if !seenExtra {
+		return fmt.Errorf("field \"extra\" is required")
+	}
+	if !seenToken {
+		return fmt.Errorf("field \"token\" is required")
+	}
+	if !seenKind {
+		return fmt.Errorf("field \"kind\" is required")
+	}
+	if !seenShape {
+		return fmt.Errorf("field \"shape\" is required")
+	}
+	if o.Tags == nil {
+		o.Tags = make([]string, 0)
+	}
+	if o.Names == nil {
+		o.Names = make([]string, 0)
+	}
+	if o.Times == nil {
+		o.Times = make([]datetime.DateTime, 0)
+	}
+	if o.Ratios == nil {
+		o.Ratios = make([]float64, 0)
+	}
+	if o.Nested == nil {
+		o.Nested = make([][]Kind, 0)
+	}
+	if o.Children == nil {
+		o.Children = make(map[string]Record, 0)
+	}
+	if o.Counts == nil {
+		o.Counts = make(map[Kind][]int, 0)
+	}
+	if !seenAlias {
+		return fmt.Errorf("field \"alias\" is required")
+	}
+	if o.ListAlias == nil {
+		o.ListAlias = ListAlias(make([]string, 0))
+	}
+	if !seenBinaryAlias {
+		return fmt.Errorf("field \"binaryAlias\" is required")
+	}
+	if !seenTimeAlias {
+		return fmt.Errorf("field \"timeAlias\" is required")
+	}
+	return nil
+}
+
+func (o Record) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *Record) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the Record is equal to other according to the Conjure semantics of its values.
+func (o Record) Equal(other Record) bool {
+	if o.Name != other.Name {
+		return false
+	}
+	if o.Count != other.Count {
+		return false
+	}
+	if o.Big != other.Big {
+		return false
+	}
+	if !(o.Ratio == other.Ratio || math.IsNaN(o.Ratio) && math.IsNaN(other.Ratio)) {
+		return false
+	}
+	if o.Flag != other.Flag {
+		return false
+	}
+	if o.Id != other.Id {
+		return false
+	}
+	if o.Owner != other.Owner {
+		return false
+	}
+	if !time.Time(o.Created).Equal(time.Time(other.Created)) {
+		return false
+	}
+	if !bytes.Equal(o.Data, other.Data) {
+		return false
+	}
+	if !reflect.DeepEqual(o.Extra, other.Extra) {
+		return false
+	}
+	if o.Token != other.Token {
+		return false
+	}
+	if !o.Kind.Equal(other.Kind) {
+		return false
+	}
+	if !o.Shape.Equal(other.Shape) {
+		return false
+	}
+	if (o.Label == nil) != (other.Label == nil) {
+		return false
+	}
+	if o.Label != nil {
+		if *o.Label != *other.Label {
+			return false
+		}
+	}
+	if (o.Parent == nil) != (other.Parent == nil) {
+		return false
+	}
+	if o.Parent != nil {
+		if !(*o.Parent).Equal(*other.Parent) {
+			return false
+		}
+	}
+	if len(o.Tags) != len(other.Tags) {
+		return false
+	}
+	for i := range o.Tags {
+		if o.Tags[i] != other.Tags[i] {
+			return false
+		}
+	}
+	if !func() bool {
+		if len(o.Names) != len(other.Names) {
+			return false
+		}
+		counts := make(map[string]int, len(o.Names))
+		for _, v := range o.Names {
+			counts[v]++
+		}
+		for _, v := range other.Names {
+			if counts[v] == 0 {
+				return false
+			}
+			counts[v]--
+		}
+		return true
+	}() {
+		return false
+	}
+	if !func() bool {
+		if len(o.Times) != len(other.Times) {
+			return false
+		}
+		matched := make([]bool, len(other.Times))
+		for _, v := range o.Times {
+			found := false
+			for j, otherV := range other.Times {
+				if !matched[j] && time.Time(v).Equal(time.Time(otherV)) {
+					matched[j] = true
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}() {
+		return false
+	}
+	if !func() bool {
+		if len(o.Ratios) != len(other.Ratios) {
+			return false
+		}
+		matched := make([]bool, len(other.Ratios))
+		for _, v := range o.Ratios {
+			found := false
+			for j, otherV := range other.Ratios {
+				if !matched[j] && (v == otherV || math.IsNaN(v) && math.IsNaN(otherV)) {
+					matched[j] = true
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}() {
+		return false
+	}
+	if len(o.Nested) != len(other.Nested) {
+		return false
+	}
+	for i := range o.Nested {
+		if !func() bool {
+			if len(o.Nested[i]) != len(other.Nested[i]) {
+				return false
+			}
+			counts1 := make(map[Kind]int, len(o.Nested[i]))
+			for _, v1 := range o.Nested[i] {
+				counts1[v1]++
+			}
+			for _, v1 := range other.Nested[i] {
+				if counts1[v1] == 0 {
+					return false
+				}
+				counts1[v1]--
+			}
+			return true
+		}() {
+			return false
+		}
+	}
+	if len(o.Children) != len(other.Children) {
+		return false
+	}
+	for k, v := range o.Children {
+		otherV, ok := other.Children[k]
+		if !ok {
+			return false
+		}
+		if !v.Equal(otherV) {
+			return false
+		}
+	}
+	if len(o.Counts) != len(other.Counts) {
+		return false
+	}
+	for k, v := range o.Counts {
+		otherV, ok := other.Counts[k]
+		if !ok {
+			return false
+		}
+		if len(v) != len(otherV) {
+			return false
+		}
+		for i1 := range v {
+			if v[i1] != otherV[i1] {
+				return false
+			}
+		}
+	}
+	if !o.Alias.Equal(other.Alias) {
+		return false
+	}
+	if !o.OptionalAlias.Equal(other.OptionalAlias) {
+		return false
+	}
+	if !o.ListAlias.Equal(other.ListAlias) {
+		return false
+	}
+	if !o.BinaryAlias.Equal(other.BinaryAlias) {
+		return false
+	}
+	if !o.TimeAlias.Equal(other.TimeAlias) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Record.
+func (o Record) Clone() Record {
+	out := o
+	if o.Data != nil {
+		out.Data = make([]byte, len(o.Data))
+		copy(out.Data, o.Data)
+	}
+	out.Shape = o.Shape.Clone()
+	if o.Label != nil {
+		v := *o.Label
+		out.Label = &v
+	}
+	if o.Parent != nil {
+		v := (*o.Parent).Clone()
+		out.Parent = &v
+	}
+	if o.Tags != nil {
+		out.Tags = make([]string, len(o.Tags))
+		copy(out.Tags, o.Tags)
+	}
+	if o.Names != nil {
+		out.Names = make([]string, len(o.Names))
+		copy(out.Names, o.Names)
+	}
+	if o.Times != nil {
+		out.Times = make([]datetime.DateTime, len(o.Times))
+		copy(out.Times, o.Times)
+	}
+	if o.Ratios != nil {
+		out.Ratios = make([]float64, len(o.Ratios))
+		copy(out.Ratios, o.Ratios)
+	}
+	if o.Nested != nil {
+		out.Nested = make([][]Kind, len(o.Nested))
+		for i := range o.Nested {
+			if o.Nested[i] != nil {
+				out.Nested[i] = make([]Kind, len(o.Nested[i]))
+				copy(out.Nested[i], o.Nested[i])
+			}
+		}
+	}
+	if o.Children != nil {
+		out.Children = make(map[string]Record, len(o.Children))
+		for k, v := range o.Children {
+			out.Children[k] = v.Clone()
+		}
+	}
+	if o.Counts != nil {
+		out.Counts = make(map[Kind][]int, len(o.Counts))
+		for k, v := range o.Counts {
+			var elem []int
+			if v != nil {
+				elem = make([]int, len(v))
+				copy(elem, v)
+			}
+			out.Counts[k] = elem
+		}
+	}
+	out.Alias = o.Alias.Clone()
+	out.OptionalAlias = o.OptionalAlias.Clone()
+	out.ListAlias = o.ListAlias.Clone()
+	out.BinaryAlias = o.BinaryAlias.Clone()
+	return out
+}
+
+// Hash returns a hash of the Record. Values that are Equal have the same hash.
+func (o Record) Hash() uint64 {
+	var h uint64
+	h = hashMix(h, hashString(o.Name))
+	h = hashMix(h, uint64(o.Count))
+	h = hashMix(h, uint64(o.Big))
+	h = hashMix(h, hashFloat64(o.Ratio))
+	h = hashMix(h, hashBool(o.Flag))
+	h = hashMix(h, hashBytes(o.Id[:]))
+	h = hashMix(h, hashString(o.Owner.String()))
+	h = hashMix(h, uint64(time.Time(o.Created).UnixNano()))
+	h = hashMix(h, hashBytes(o.Data))
+	h = hashMix(h, hashString(fmt.Sprint(o.Extra)))
+	h = hashMix(h, hashString(string(o.Token)))
+	h = hashMix(h, o.Kind.Hash())
+	h = hashMix(h, o.Shape.Hash())
+	if o.Label != nil {
+		h = hashMix(h, hashString(*o.Label))
+	}
+	if o.Parent != nil {
+		h = hashMix(h, (*o.Parent).Hash())
+	}
+	for _, v := range o.Tags {
+		h = hashMix(h, hashString(v))
+	}
+	for _, v := range o.Names {
+		var elemHash uint64
+		elemHash = hashMix(elemHash, hashString(v))
+		h += elemHash
+	}
+	for _, v := range o.Times {
+		var elemHash uint64
+		elemHash = hashMix(elemHash, uint64(time.Time(v).UnixNano()))
+		h += elemHash
+	}
+	for _, v := range o.Ratios {
+		var elemHash uint64
+		elemHash = hashMix(elemHash, hashFloat64(v))
+		h += elemHash
+	}
+	for _, v := range o.Nested {
+		for _, v1 := range v {
+			var elemHash1 uint64
+			elemHash1 = hashMix(elemHash1, v1.Hash())
+			h += elemHash1
+		}
+	}
+	for k, v := range o.Children {
+		var elemHash uint64
+		elemHash = hashMix(elemHash, hashString(k))
+		elemHash = hashMix(elemHash, v.Hash())
+		h += elemHash
+	}
+	for k, v := range o.Counts {
+		var elemHash uint64
+		elemHash = hashMix(elemHash, k.Hash())
+		for _, v1 := range v {
+			elemHash = hashMix(elemHash, uint64(v1))
+		}
+		h += elemHash
+	}
+	h = hashMix(h, o.Alias.Hash())
+	h = hashMix(h, o.OptionalAlias.Hash())
+	h = hashMix(h, o.ListAlias.Hash())
+	h = hashMix(h, o.BinaryAlias.Hash())
+	h = hashMix(h, o.TimeAlias.Hash())
+	return h
+}
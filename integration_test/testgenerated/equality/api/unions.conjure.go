@@ -0,0 +1,664 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
+)
+
+type Shape struct {
+	typ           string
+	circle        *float64
+	points        *[]int
+	record        *Record
+	optionalLabel **string
+}
+
+type shapeDeserializer struct {
+	Type          string   `json:"type"`
+	Circle        *float64 `json:"circle"`
+	Points        *[]int   `json:"points"`
+	Record        *Record  `json:"record"`
+	OptionalLabel **string `json:"optionalLabel"`
+}
+
+func (u *shapeDeserializer) toStruct() Shape {
+	return Shape{typ: u.Type, circle: u.Circle, points: u.Points, record: u.Record, optionalLabel: u.OptionalLabel}
+}
+
+func (u Shape) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	switch u.typ {
+	default:
+		return nil, fmt.Errorf("unknown type %q", u.typ)
+	case "circle":
+		if u.circle == nil {
+			return nil, fmt.Errorf("field \"circle\" is required")
+		}
+		out = append(out, "{\"type\":\"circle\",\"circle\":"...)
+		out = appendJSONFloat64(out, *u.circle)
+	case "points":
+		if u.points == nil {
+			return nil, fmt.Errorf("field \"points\" is required")
+		}
+		out = append(out, "{\"type\":\"points\",\"points\":"...)
+		out = append(out, '[')
+		for i, v := range *u.points {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = strconv.AppendInt(out, int64(v), 10)
+		}
+		out = append(out, ']')
+	case "record":
+		if u.record == nil {
+			return nil, fmt.Errorf("field \"record\" is required")
+		}
+		out = append(out, "{\"type\":\"record\",\"record\":"...)
+		out, err = u.record.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	case "optionalLabel":
+		var optionalLabel *string
+		if u.optionalLabel != nil {
+			optionalLabel = *u.optionalLabel
+		}
+		out = append(out, "{\"type\":\"optionalLabel\",\"optionalLabel\":"...)
+		if optionalLabel == nil {
+			out = append(out, "null"...)
+		} else {
+			out = appendJSONString(out, *optionalLabel)
+		}
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (u Shape) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	switch u.typ {
+	default:
+		return 0, fmt.Errorf("unknown type %q", u.typ)
+	case "circle":
+		if u.circle == nil {
+			return 0, fmt.Errorf("field \"circle\" is required")
+		}
+		size = 27
+		size += jsonFloat64Size(*u.circle)
+	case "points":
+		if u.points == nil {
+			return 0, fmt.Errorf("field \"points\" is required")
+		}
+		size = 27
+		size += 2
+		if len(*u.points) > 1 {
+			size += len(*u.points) - 1
+		}
+		for _, v := range *u.points {
+			size += jsonIntSize(int64(v))
+		}
+	case "record":
+		if u.record == nil {
+			return 0, fmt.Errorf("field \"record\" is required")
+		}
+		size = 27
+		n, err = u.record.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "optionalLabel":
+		var optionalLabel *string
+		if u.optionalLabel != nil {
+			optionalLabel = *u.optionalLabel
+		}
+		size = 41
+		if optionalLabel == nil {
+			size += 4
+		} else {
+			size += jsonStringSize(*optionalLabel)
+		}
+	}
+	return size, nil
+}
+
+func (u Shape) MarshalJSON() ([]byte, error) {
+	return u.AppendJSON(nil)
+}
+
+func (u *Shape) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Shape
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*u = v
+			return nil
+		}
+	}
+	var deser shapeDeserializer
+	if err := safejson.Unmarshal(data, &deser); err != nil {
+		return err
+	}
+	*u = deser.toStruct()
+	switch u.typ {
+	case "circle":
+		if u.circle == nil {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+	case "points":
+		if u.points == nil {
+			return fmt.Errorf("field \"points\" is required")
+		}
+	case "record":
+		if u.record == nil {
+			return fmt.Errorf("field \"record\" is required")
+		}
+	case "optionalLabel":
+	}
+	return nil
+}
+
+func (u *Shape) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenType, seenCircle, seenPoints, seenRecord, seenOptionalLabel bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !unmarshalJSONString(field, &u.typ) {
+				ok = false
+				return false
+			}
+		case "circle":
+			if seenCircle {
+				ok = false
+				return false
+			}
+			seenCircle = true
+			if field.Type != gjson.Null {
+				var v float64
+				if !unmarshalJSONFloat64(field, &v) {
+					ok = false
+					return false
+				}
+				u.circle = &v
+			}
+		case "points":
+			if seenPoints {
+				ok = false
+				return false
+			}
+			seenPoints = true
+			if field.Type != gjson.Null {
+				var v1 []int
+				if field.Type != gjson.Null {
+					if !field.IsArray() {
+						ok = false
+						return false
+					}
+					v1 = make([]int, 0)
+					ok1 := true
+					field.ForEach(func(_, elem gjson.Result) bool {
+						var v2 int
+						if !unmarshalJSONInt(elem, &v2) {
+							ok1 = false
+							return false
+						}
+						v1 = append(v1, v2)
+						return true
+					})
+					if !ok1 {
+						ok = false
+						return false
+					}
+				}
+				u.points = &v1
+			}
+		case "record":
+			if seenRecord {
+				ok = false
+				return false
+			}
+			seenRecord = true
+			if field.Type != gjson.Null {
+				var v3 Record
+				if !v3.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.record = &v3
+			}
+		case "optionalLabel":
+			if seenOptionalLabel {
+				ok = false
+				return false
+			}
+			seenOptionalLabel = true
+			if field.Type != gjson.Null {
+				var v4 *string
+				if field.Type != gjson.Null {
+					var v5 string
+					if !unmarshalJSONString(field, &v5) {
+						ok = false
+						return false
+					}
+					v4 = &v5
+				}
+				u.optionalLabel = &v4
+			}
+		default:
+			if matchesJSONField(key.Str, "type", "circle", "points", "record", "optionalLabel") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	switch u.typ {
+	case "circle":
+		if u.circle == nil {
+			return false
+		}
+	case "points":
+		if u.points == nil {
+			return false
+		}
+	case "record":
+		if u.record == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *Shape) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return u.decodeJSONStrict(value)
+}
+
+func (u *Shape) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	var typ string
+	var variantName string
+	var variant gjson.Result
+	var seenType, seenVariant bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch {
+		case key.Str == "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			typ, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"type\": %w", err)
+				return false
+			}
+			return true
+		case seenVariant && key.Str == variantName:
+			err = fmt.Errorf("duplicate field %q", key.Str)
+			return false
+		case seenVariant:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		variantName, variant, seenVariant = key.Str, field, true
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenType {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	if seenVariant && variantName != typ {
+		return fmt.Errorf("unknown field %q", variantName)
+	}
+	*u = Shape{typ: typ}
+	switch typ {
+	case "circle":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+		var v float64
+		v, err = decodeJSONFloat64(variant)
+		if err != nil {
+			return fmt.Errorf("field \"circle\": %w", err)
+		}
+		u.circle = &v
+	case "points":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"points\" is required")
+		}
+		var v1 []int
+		if !variant.IsArray() {
+			return fmt.Errorf("field \"points\": %w", jsonTypeError(variant, "array"))
+		}
+		v1 = make([]int, 0)
+		variant.ForEach(func(_, elem gjson.Result) bool {
+			var v2 int
+			v2, err = decodeJSONInt(elem)
+			if err != nil {
+				return false
+			}
+			v1 = append(v1, v2)
+			return true
+		})
+		if err != nil {
+			return fmt.Errorf("field \"points\": %w", err)
+		}
+		u.points = &v1
+	case "record":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"record\" is required")
+		}
+		var v3 Record
+		err = v3.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"record\": %w", err)
+		}
+		u.record = &v3
+	case "optionalLabel":
+		var v4 *string
+		if variant.Type != gjson.Null {
+			var v5 string
+			v5, err = decodeJSONString(variant)
+			if err != nil {
+				return fmt.Errorf("field \"optionalLabel\": %w", err)
+			}
+			v4 = &v5
+		}
+		u.optionalLabel = &v4
+	}
+	return nil
+}
+
+func (u Shape) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (u *Shape) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&u)
+}
+
+func (u *Shape) AcceptFuncs(circleFunc func(float64) error, pointsFunc func([]int) error, recordFunc func(Record) error, optionalLabelFunc func(*string) error, unknownFunc func(string) error) error {
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return fmt.Errorf("invalid value in union type")
+		}
+		return unknownFunc(u.typ)
+	case "circle":
+		if u.circle == nil {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+		return circleFunc(*u.circle)
+	case "points":
+		if u.points == nil {
+			return fmt.Errorf("field \"points\" is required")
+		}
+		return pointsFunc(*u.points)
+	case "record":
+		if u.record == nil {
+			return fmt.Errorf("field \"record\" is required")
+		}
+		return recordFunc(*u.record)
+	case "optionalLabel":
+		var optionalLabel *string
+		if u.optionalLabel != nil {
+			optionalLabel = *u.optionalLabel
+		}
+		return optionalLabelFunc(optionalLabel)
+	}
+}
+
+func (u *Shape) CircleNoopSuccess(float64) error {
+	return nil
+}
+
+func (u *Shape) PointsNoopSuccess([]int) error {
+	return nil
+}
+
+func (u *Shape) RecordNoopSuccess(Record) error {
+	return nil
+}
+
+func (u *Shape) OptionalLabelNoopSuccess(*string) error {
+	return nil
+}
+
+func (u *Shape) ErrorOnUnknown(typeName string) error {
+	return fmt.Errorf("invalid value in union type. Type name: %s", typeName)
+}
+
+func (u *Shape) Accept(v ShapeVisitor) error {
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return fmt.Errorf("invalid value in union type")
+		}
+		return v.VisitUnknown(u.typ)
+	case "circle":
+		if u.circle == nil {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+		return v.VisitCircle(*u.circle)
+	case "points":
+		if u.points == nil {
+			return fmt.Errorf("field \"points\" is required")
+		}
+		return v.VisitPoints(*u.points)
+	case "record":
+		if u.record == nil {
+			return fmt.Errorf("field \"record\" is required")
+		}
+		return v.VisitRecord(*u.record)
+	case "optionalLabel":
+		var optionalLabel *string
+		if u.optionalLabel != nil {
+			optionalLabel = *u.optionalLabel
+		}
+		return v.VisitOptionalLabel(optionalLabel)
+	}
+}
+
+type ShapeVisitor interface {
+	VisitCircle(v float64) error
+	VisitPoints(v []int) error
+	VisitRecord(v Record) error
+	VisitOptionalLabel(v *string) error
+	VisitUnknown(typeName string) error
+}
+
+func (u *Shape) AcceptWithContext(ctx context.Context, v ShapeVisitorWithContext) error {
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return fmt.Errorf("invalid value in union type")
+		}
+		return v.VisitUnknownWithContext(ctx, u.typ)
+	case "circle":
+		if u.circle == nil {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+		return v.VisitCircleWithContext(ctx, *u.circle)
+	case "points":
+		if u.points == nil {
+			return fmt.Errorf("field \"points\" is required")
+		}
+		return v.VisitPointsWithContext(ctx, *u.points)
+	case "record":
+		if u.record == nil {
+			return fmt.Errorf("field \"record\" is required")
+		}
+		return v.VisitRecordWithContext(ctx, *u.record)
+	case "optionalLabel":
+		var optionalLabel *string
+		if u.optionalLabel != nil {
+			optionalLabel = *u.optionalLabel
+		}
+		return v.VisitOptionalLabelWithContext(ctx, optionalLabel)
+	}
+}
+
+type ShapeVisitorWithContext interface {
+	VisitCircleWithContext(ctx context.Context, v float64) error
+	VisitPointsWithContext(ctx context.Context, v []int) error
+	VisitRecordWithContext(ctx context.Context, v Record) error
+	VisitOptionalLabelWithContext(ctx context.Context, v *string) error
+	VisitUnknownWithContext(ctx context.Context, typeName string) error
+}
+
+func NewShapeFromCircle(v float64) Shape {
+	return Shape{typ: "circle", circle: &v}
+}
+
+func NewShapeFromPoints(v []int) Shape {
+	return Shape{typ: "points", points: &v}
+}
+
+func NewShapeFromRecord(v Record) Shape {
+	return Shape{typ: "record", record: &v}
+}
+
+func NewShapeFromOptionalLabel(v *string) Shape {
+	return Shape{typ: "optionalLabel", optionalLabel: &v}
+}
+
+// Equal returns true if the Shape is equal to other according to the Conjure semantics of its values.
+func (u Shape) Equal(other Shape) bool {
+	if u.typ != other.typ {
+		return false
+	}
+	if (u.circle == nil) != (other.circle == nil) {
+		return false
+	}
+	if u.circle != nil {
+		if !(*u.circle == *other.circle || math.IsNaN(*u.circle) && math.IsNaN(*other.circle)) {
+			return false
+		}
+	}
+	if (u.points == nil) != (other.points == nil) {
+		return false
+	}
+	if u.points != nil {
+		v, otherV := *u.points, *other.points
+		if len(v) != len(otherV) {
+			return false
+		}
+		for i1 := range v {
+			if v[i1] != otherV[i1] {
+				return false
+			}
+		}
+	}
+	if (u.record == nil) != (other.record == nil) {
+		return false
+	}
+	if u.record != nil {
+		if !(*u.record).Equal(*other.record) {
+			return false
+		}
+	}
+	if (u.optionalLabel == nil) != (other.optionalLabel == nil) {
+		return false
+	}
+	if u.optionalLabel != nil {
+		if (*u.optionalLabel == nil) != (*other.optionalLabel == nil) {
+			return false
+		}
+		if *u.optionalLabel != nil {
+			if **u.optionalLabel != **other.optionalLabel {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Shape.
+func (u Shape) Clone() Shape {
+	out := u
+	if u.points != nil {
+		elems := *u.points
+		var v []int
+		if elems != nil {
+			v = make([]int, len(elems))
+			copy(v, elems)
+		}
+		out.points = &v
+	}
+	if u.record != nil {
+		v := (*u.record).Clone()
+		out.record = &v
+	}
+	if u.optionalLabel != nil {
+		var v *string
+		if *u.optionalLabel != nil {
+			v1 := **u.optionalLabel
+			v = &v1
+		}
+		out.optionalLabel = &v
+	}
+	return out
+}
+
+// Hash returns a hash of the Shape. Values that are Equal have the same hash.
+func (u Shape) Hash() uint64 {
+	h := hashString(u.typ)
+	if u.circle != nil {
+		h = hashMix(h, hashFloat64(*u.circle))
+	}
+	if u.points != nil {
+		for _, v1 := range *u.points {
+			h = hashMix(h, uint64(v1))
+		}
+	}
+	if u.record != nil {
+		h = hashMix(h, (*u.record).Hash())
+	}
+	if u.optionalLabel != nil {
+		if *u.optionalLabel != nil {
+			h = hashMix(h, hashString(**u.optionalLabel))
+		}
+	}
+	return h
+}
@@ -0,0 +1,52 @@
+// This file was generated by Conjure and should not be manually edited.
+
+//go:build go1.18
+
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+type ShapeWithT[T any] Shape
+
+func (u *ShapeWithT[T]) Accept(ctx context.Context, v ShapeVisitorWithT[T]) (T, error) {
+	var result T
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return result, fmt.Errorf("invalid value in union type")
+		}
+		return v.VisitUnknown(ctx, u.typ)
+	case "circle":
+		if u.circle == nil {
+			return result, fmt.Errorf("field \"circle\" is required")
+		}
+		return v.VisitCircle(ctx, *u.circle)
+	case "points":
+		if u.points == nil {
+			return result, fmt.Errorf("field \"points\" is required")
+		}
+		return v.VisitPoints(ctx, *u.points)
+	case "record":
+		if u.record == nil {
+			return result, fmt.Errorf("field \"record\" is required")
+		}
+		return v.VisitRecord(ctx, *u.record)
+	case "optionalLabel":
+		var optionalLabel *string
+		if u.optionalLabel != nil {
+			optionalLabel = *u.optionalLabel
+		}
+		return v.VisitOptionalLabel(ctx, optionalLabel)
+	}
+}
+
+type ShapeVisitorWithT[T any] interface {
+	VisitCircle(ctx context.Context, v float64) (T, error)
+	VisitPoints(ctx context.Context, v []int) (T, error)
+	VisitRecord(ctx context.Context, v Record) (T, error)
+	VisitOptionalLabel(ctx context.Context, v *string) (T, error)
+	VisitUnknown(ctx context.Context, typ string) (T, error)
+}
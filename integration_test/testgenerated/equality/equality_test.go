@@ -0,0 +1,194 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package equality_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/equality/api"
+	"github.com/palantir/pkg/binary"
+	"github.com/palantir/pkg/boolean"
+	"github.com/palantir/pkg/datetime"
+	"github.com/palantir/pkg/rid"
+	"github.com/palantir/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRecord() api.Record {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	label := "label"
+	return api.Record{
+		Name:    "name",
+		Count:   1,
+		Big:     2,
+		Ratio:   math.NaN(),
+		Flag:    true,
+		Id:      uuid.UUID{1, 2, 3},
+		Owner:   rid.MustNew("service", "instance", "type", "locator"),
+		Created: datetime.DateTime(created),
+		Data:    []byte("data"),
+		Extra:   map[string]interface{}{"key": []interface{}{"value"}},
+		Token:   "token",
+		Kind:    api.New_Kind(api.Kind_SMALL),
+		Shape:   api.NewShapeFromPoints([]int{1, 2}),
+		Label:   &label,
+		Parent:  &api.Record{Name: "parent", Shape: api.NewShapeFromCircle(1)},
+		Tags:    []string{"a", "b"},
+		Names:   []string{"x", "y", "z"},
+		Times:   []datetime.DateTime{datetime.DateTime(created), datetime.DateTime(created.Add(time.Hour))},
+		Ratios:  []float64{math.NaN(), 1},
+		Nested:  [][]api.Kind{{api.New_Kind(api.Kind_SMALL), api.New_Kind(api.Kind_LARGE)}},
+		Children: map[string]api.Record{
+			"child": {Name: "child", Tags: []string{"c"}, Shape: api.NewShapeFromCircle(2)},
+		},
+		Counts:        map[api.Kind][]int{api.New_Kind(api.Kind_LARGE): {1, 2}},
+		Alias:         api.ItemAlias{Value: "item", Values: []string{"v"}},
+		OptionalAlias: api.OptionalAlias{Value: &[]string{"o"}},
+		ListAlias:     api.ListAlias{"l1", "l2"},
+		BinaryAlias:   api.BinaryAlias("binary"),
+		TimeAlias:     api.DateTimeAlias(created),
+	}
+}
+
+func TestEqual(t *testing.T) {
+	record := newRecord()
+	assert.True(t, record.Equal(record))
+	assert.True(t, record.Equal(newRecord()))
+
+	for _, tc := range []struct {
+		name   string
+		modify func(*api.Record)
+		equal  bool
+	}{
+		{name: "sets in another order", modify: func(r *api.Record) {
+			r.Names = []string{"z", "x", "y"}
+			r.Times = []datetime.DateTime{r.Times[1], r.Times[0]}
+			r.Ratios = []float64{1, math.NaN()}
+			r.Nested = [][]api.Kind{{api.New_Kind(api.Kind_LARGE), api.New_Kind(api.Kind_SMALL)}}
+			r.ListAlias = api.ListAlias{"l2", "l1"}
+		}, equal: true},
+		{name: "datetime in another location", modify: func(r *api.Record) {
+			r.Created = datetime.DateTime(time.Time(r.Created).In(time.FixedZone("offset", 3600)))
+			r.TimeAlias = api.DateTimeAlias(time.Time(r.TimeAlias).In(time.FixedZone("offset", -3600)))
+		}, equal: true},
+		{name: "empty collections instead of nil", modify: func(r *api.Record) {
+			r.Parent.Tags = []string{}
+			r.Parent.Children = map[string]api.Record{}
+			r.Parent.Data = []byte{}
+		}, equal: true},
+		{name: "list in another order", modify: func(r *api.Record) { r.Tags = []string{"b", "a"} }},
+		{name: "different set element", modify: func(r *api.Record) { r.Names = []string{"x", "y", "y"} }},
+		{name: "different datetime", modify: func(r *api.Record) { r.Created = datetime.DateTime(time.Time(r.Created).Add(time.Second)) }},
+		{name: "number instead of NaN", modify: func(r *api.Record) { r.Ratio = 1 }},
+		{name: "nil optional", modify: func(r *api.Record) { r.Label = nil }},
+		{name: "different optional", modify: func(r *api.Record) { r.Parent.Name = "other" }},
+		{name: "different union variant", modify: func(r *api.Record) { r.Shape = api.NewShapeFromCircle(1) }},
+		{name: "different union value", modify: func(r *api.Record) { r.Shape = api.NewShapeFromPoints([]int{2, 1}) }},
+		{name: "different map value", modify: func(r *api.Record) { r.Counts[api.New_Kind(api.Kind_LARGE)] = []int{1} }},
+		{name: "different map key", modify: func(r *api.Record) {
+			r.Children = map[string]api.Record{"other": r.Children["child"]}
+		}},
+		{name: "different any", modify: func(r *api.Record) { r.Extra = map[string]interface{}{"key": "value"} }},
+		{name: "different optional alias", modify: func(r *api.Record) { r.OptionalAlias = api.OptionalAlias{} }},
+		{name: "different binary alias", modify: func(r *api.Record) { r.BinaryAlias = nil }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			other := newRecord()
+			tc.modify(&other)
+			assert.Equal(t, tc.equal, record.Equal(other))
+			assert.Equal(t, tc.equal, other.Equal(record))
+			if tc.equal {
+				assert.Equal(t, record.Hash(), other.Hash())
+			}
+		})
+	}
+}
+
+func TestClone(t *testing.T) {
+	record := newRecord()
+	clone := record.Clone()
+	assert.True(t, record.Equal(clone))
+	assert.Equal(t, record.Hash(), clone.Hash())
+
+	clone.Data[0] = 'x'
+	*clone.Label = "other"
+	clone.Parent.Name = "other"
+	clone.Tags[0] = "other"
+	clone.Nested[0][0] = api.New_Kind(api.Kind_LARGE)
+	clone.Counts[api.New_Kind(api.Kind_LARGE)][0] = 3
+	clone.Children["child"].Tags[0] = "other"
+	clone.Alias.Values[0] = "other"
+	(*clone.OptionalAlias.Value)[0] = "other"
+	clone.ListAlias[0] = "other"
+	clone.BinaryAlias[0] = 'x'
+	assert.True(t, record.Equal(newRecord()))
+	assert.False(t, record.Equal(clone))
+
+	shape := api.NewShapeFromPoints([]int{1})
+	shapeClone := shape.Clone()
+	assert.True(t, shape.Equal(shapeClone))
+	assert.NoError(t, shapeClone.AcceptFuncs(
+		func(float64) error { return nil },
+		func(points []int) error {
+			points[0] = 2
+			return nil
+		},
+		func(api.Record) error { return nil },
+		func(*string) error { return nil },
+		func(string) error { return nil },
+	))
+	assert.True(t, shape.Equal(api.NewShapeFromPoints([]int{1})))
+
+	var empty api.Record
+	assert.True(t, empty.Equal(empty.Clone()))
+	assert.Nil(t, empty.Clone().Tags)
+}
+
+func TestHash(t *testing.T) {
+	zero := api.DateTimeAlias(time.Time{})
+	assert.Equal(t, api.ListAlias{"a", "b"}.Hash(), api.ListAlias{"b", "a"}.Hash())
+	assert.NotEqual(t, api.ListAlias{"a", "b"}.Hash(), api.ListAlias{"a", "c"}.Hash())
+	assert.Equal(t, zero.Hash(), api.DateTimeAlias(time.Time{}.In(time.FixedZone("offset", 60))).Hash())
+	assert.NotEqual(t, newRecord().Hash(), api.Record{}.Hash())
+	assert.Equal(t, api.Record{Ratio: 0}.Hash(), api.Record{Ratio: math.Copysign(0, -1)}.Hash())
+	assert.True(t, api.Record{Ratio: 0}.Equal(api.Record{Ratio: math.Copysign(0, -1)}))
+	assert.NotEqual(t, api.NewShapeFromCircle(1).Hash(), api.NewShapeFromCircle(2).Hash())
+	assert.Equal(t, api.New_Kind(api.Kind_SMALL).Hash(), api.New_Kind(api.Kind_SMALL).Hash())
+}
+
+func TestHashKeysAndAnys(t *testing.T) {
+	keys := api.Keys{
+		BinaryKeys:       map[binary.Binary]string{binary.New([]byte("a")): "a"},
+		BinaryAliasKeys:  map[binary.Binary]string{binary.New([]byte("b")): "b"},
+		BooleanKeys:      map[boolean.Boolean]int{true: 1},
+		BooleanAliasKeys: map[boolean.Boolean]int{false: 0},
+		Anys:             []interface{}{"a", 1},
+		AnyAliases:       []api.AnyAlias{"b"},
+		Externals:        []interface{}{int64(1)},
+		Methods:          &api.Methods{Equal: "equal", Clone: "clone", Hash: "hash"},
+	}
+	clone := keys.Clone()
+	assert.True(t, keys.Equal(clone))
+	assert.Equal(t, keys.Hash(), clone.Hash())
+
+	changed := keys.Clone()
+	changed.BooleanKeys = map[boolean.Boolean]int{false: 1}
+	assert.NotEqual(t, keys.Hash(), changed.Hash())
+	changed = keys.Clone()
+	changed.Externals = []interface{}{int64(2)}
+	assert.NotEqual(t, keys.Hash(), changed.Hash())
+}
@@ -0,0 +1,202 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	wlogzap "github.com/palantir/witchcraft-go-logging/wlog-zap"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/trclog/trc1log"
+	"github.com/palantir/witchcraft-go-tracing/wtracing"
+	"github.com/palantir/witchcraft-go-tracing/wzipkin"
+	"github.com/spf13/cobra"
+	pflag "github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+type CLIConfig struct {
+	Client httpclient.ClientConfig `yaml:",inline"`
+}
+
+// Commands for WidgetService
+
+type CLIWidgetServiceClientProvider interface {
+	Get(ctx context.Context, flags *pflag.FlagSet) (WidgetServiceClient, error)
+}
+
+type defaultCLIWidgetServiceClientProvider struct{}
+
+func NewDefaultCLIWidgetServiceClientProvider() CLIWidgetServiceClientProvider {
+	return defaultCLIWidgetServiceClientProvider{}
+}
+
+func (d defaultCLIWidgetServiceClientProvider) Get(ctx context.Context, flags *pflag.FlagSet) (WidgetServiceClient, error) {
+	conf, err := loadCLIConfig(ctx, flags)
+	if err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "failed to load CLI configuration file")
+	}
+	client, err := httpclient.NewClient(httpclient.WithConfig(conf.Client))
+	if err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "failed to create client with provided config")
+	}
+	return NewWidgetServiceClient(client), nil
+}
+
+type WidgetServiceCLICommand struct {
+	clientProvider CLIWidgetServiceClientProvider
+}
+
+func NewWidgetServiceCLICommand() *cobra.Command {
+	return NewWidgetServiceCLICommandWithClientProvider(NewDefaultCLIWidgetServiceClientProvider())
+}
+
+func NewWidgetServiceCLICommandWithClientProvider(clientProvider CLIWidgetServiceClientProvider) *cobra.Command {
+	rootCmd := &cobra.Command{
+		Short: "Runs commands on the WidgetService",
+		Use:   "widgetService",
+	}
+	rootCmd.PersistentFlags().String("conf", "var/conf/configuration.yml", "The configuration file is optional. The default path is ./var/conf/configuration.yml.")
+	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "Enables verbose mode for debugging client connections.")
+
+	cliCommand := WidgetServiceCLICommand{clientProvider: clientProvider}
+
+	widgetService_GetWidget_Cmd := &cobra.Command{
+		RunE:  cliCommand.widgetService_GetWidget_CmdRun,
+		Short: "Calls the getWidget endpoint.",
+		Use:   "getWidget",
+	}
+	rootCmd.AddCommand(widgetService_GetWidget_Cmd)
+	widgetService_GetWidget_Cmd.Flags().String("widgetId", "", "Required. ")
+
+	widgetService_UpdateWidget_Cmd := &cobra.Command{
+		RunE:  cliCommand.widgetService_UpdateWidget_CmdRun,
+		Short: "Calls the updateWidget endpoint.",
+		Use:   "updateWidget",
+	}
+	rootCmd.AddCommand(widgetService_UpdateWidget_Cmd)
+	widgetService_UpdateWidget_Cmd.Flags().String("widgetId", "", "Required. ")
+	widgetService_UpdateWidget_Cmd.Flags().String("value", "", "Required. ")
+
+	widgetService_Ping_Cmd := &cobra.Command{
+		RunE:  cliCommand.widgetService_Ping_CmdRun,
+		Short: "Calls the ping endpoint.",
+		Use:   "ping",
+	}
+	rootCmd.AddCommand(widgetService_Ping_Cmd)
+
+	return rootCmd
+}
+
+func (c WidgetServiceCLICommand) widgetService_GetWidget_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	widgetIdRaw, err := flags.GetString("widgetId")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument widgetId")
+	}
+	if widgetIdRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "widgetId is a required argument")
+	}
+	widgetIdArg := widgetIdRaw
+
+	result, err := client.GetWidget(ctx, widgetIdArg)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%v\n", result)
+	return nil
+}
+
+func (c WidgetServiceCLICommand) widgetService_UpdateWidget_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	widgetIdRaw, err := flags.GetString("widgetId")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument widgetId")
+	}
+	if widgetIdRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "widgetId is a required argument")
+	}
+	widgetIdArg := widgetIdRaw
+
+	valueRaw, err := flags.GetString("value")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument value")
+	}
+	if valueRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "value is a required argument")
+	}
+	valueArg := valueRaw
+
+	return client.UpdateWidget(ctx, widgetIdArg, valueArg)
+}
+
+func (c WidgetServiceCLICommand) widgetService_Ping_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	result, err := client.Ping(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%v\n", result)
+	return nil
+}
+
+func loadCLIConfig(ctx context.Context, flags *pflag.FlagSet) (CLIConfig, error) {
+	var emptyConfig CLIConfig
+	configPath, err := flags.GetString("conf")
+	if err != nil || configPath == "" {
+		return emptyConfig, werror.WrapWithContextParams(ctx, err, "config file location must be specified")
+	}
+	confBytes, err := os.ReadFile(configPath)
+	if err != nil {
+		return emptyConfig, err
+	}
+	var conf CLIConfig
+	err = yaml.Unmarshal(confBytes, &conf)
+	if err != nil {
+		return emptyConfig, err
+	}
+	return conf, nil
+}
+
+func getCLIContext(flags *pflag.FlagSet) context.Context {
+	ctx := context.Background()
+	logProvider := wlog.NewNoopLoggerProvider()
+	logWriter := io.Discard
+	verbose, err := flags.GetBool("verbose")
+	if verbose && err == nil {
+		logProvider = wlogzap.LoggerProvider()
+		logWriter = os.Stdout
+	}
+	wlog.SetDefaultLoggerProvider(logProvider)
+	ctx = svc1log.WithLogger(ctx, svc1log.New(logWriter, wlog.DebugLevel))
+	traceLogger := trc1log.New(logWriter)
+	ctx = trc1log.WithLogger(ctx, traceLogger)
+	ctx = evt2log.WithLogger(ctx, evt2log.New(logWriter))
+	tracer, err := wzipkin.NewTracer(traceLogger)
+	if err != nil {
+		return ctx
+	}
+	return wtracing.ContextWithTracer(ctx, tracer)
+}
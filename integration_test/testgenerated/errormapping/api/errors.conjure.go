@@ -0,0 +1,407 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"reflect"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/palantir/pkg/uuid"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/tidwall/gjson"
+)
+
+type widgetLocked struct{}
+
+func (o widgetLocked) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{}"...)
+	return out, nil
+}
+
+func (o widgetLocked) JSONSize() (int, error) {
+	size := 2
+	return size, nil
+}
+
+func (o *widgetLocked) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	return value.IsObject()
+}
+
+func (o widgetLocked) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *widgetLocked) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// NewWidgetLocked returns new instance of WidgetLocked error.
+func NewWidgetLocked() *WidgetLocked {
+	return &WidgetLocked{errorInstanceID: uuid.NewUUID(), stack: werror.NewStackTrace(), widgetLocked: widgetLocked{}}
+}
+
+// WrapWithWidgetLocked returns new instance of WidgetLocked error wrapping an existing error.
+func WrapWithWidgetLocked(err error) *WidgetLocked {
+	return &WidgetLocked{errorInstanceID: uuid.NewUUID(), stack: werror.NewStackTrace(), cause: err, widgetLocked: widgetLocked{}}
+}
+
+// MapToWidgetLocked returns a function that maps errors that match sentinel, as reported by errors.Is, to a new
+// WidgetLocked error with the provided parameters that wraps them. The function returns nil for all other errors.
+// It can be passed to the WithErrorMappers route parameter of generated servers.
+func MapToWidgetLocked(sentinel error) func(error) error {
+	return func(err error) error {
+		if !stderrors.Is(err, sentinel) {
+			return nil
+		}
+		return WrapWithWidgetLocked(err)
+	}
+}
+
+// WidgetLocked is an error type.
+type WidgetLocked struct {
+	errorInstanceID uuid.UUID
+	widgetLocked
+	cause error
+	stack werror.StackTrace
+}
+
+// IsWidgetLocked returns true if err is an instance of WidgetLocked.
+func IsWidgetLocked(err error) bool {
+	if err == nil {
+		return false
+	}
+	_, ok := errors.GetConjureError(err).(*WidgetLocked)
+	return ok
+}
+
+func (e *WidgetLocked) Error() string {
+	return fmt.Sprintf("CONFLICT Widgets:WidgetLocked (%s)", e.errorInstanceID)
+}
+
+// Cause returns the underlying cause of the error, or nil if none.
+// Note that cause is not serialized and sent over the wire.
+func (e *WidgetLocked) Cause() error {
+	return e.cause
+}
+
+// StackTrace returns the StackTrace for the error, or nil if none.
+// Note that stack traces are not serialized and sent over the wire.
+func (e *WidgetLocked) StackTrace() werror.StackTrace {
+	return e.stack
+}
+
+// Message returns the message body for the error.
+func (e *WidgetLocked) Message() string {
+	return "CONFLICT Widgets:WidgetLocked"
+}
+
+// Format implements fmt.Formatter, a requirement of werror.Werror.
+func (e *WidgetLocked) Format(state fmt.State, verb rune) {
+	werror.Format(e, e.safeParams(), state, verb)
+}
+
+// Code returns an enum describing error category.
+func (e *WidgetLocked) Code() errors.ErrorCode {
+	return errors.Conflict
+}
+
+// Name returns an error name identifying error type.
+func (e *WidgetLocked) Name() string {
+	return "Widgets:WidgetLocked"
+}
+
+// InstanceID returns unique identifier of this particular error instance.
+func (e *WidgetLocked) InstanceID() uuid.UUID {
+	return e.errorInstanceID
+}
+
+// Parameters returns a set of named parameters detailing this particular error instance.
+func (e *WidgetLocked) Parameters() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+// safeParams returns a set of named safe parameters detailing this particular error instance.
+func (e *WidgetLocked) safeParams() map[string]interface{} {
+	return map[string]interface{}{"errorInstanceId": e.errorInstanceID, "errorName": e.Name()}
+}
+
+// SafeParams returns a set of named safe parameters detailing this particular error instance and
+// any underlying causes.
+func (e *WidgetLocked) SafeParams() map[string]interface{} {
+	safeParams, _ := werror.ParamsFromError(e.cause)
+	for k, v := range e.safeParams() {
+		if _, exists := safeParams[k]; !exists {
+			safeParams[k] = v
+		}
+	}
+	return safeParams
+}
+
+// unsafeParams returns a set of named unsafe parameters detailing this particular error instance.
+func (e *WidgetLocked) unsafeParams() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+// UnsafeParams returns a set of named unsafe parameters detailing this particular error instance and
+// any underlying causes.
+func (e *WidgetLocked) UnsafeParams() map[string]interface{} {
+	_, unsafeParams := werror.ParamsFromError(e.cause)
+	for k, v := range e.unsafeParams() {
+		if _, exists := unsafeParams[k]; !exists {
+			unsafeParams[k] = v
+		}
+	}
+	return unsafeParams
+}
+
+func (e WidgetLocked) MarshalJSON() ([]byte, error) {
+	parameters, err := safejson.Marshal(e.widgetLocked)
+	if err != nil {
+		return nil, err
+	}
+	return safejson.Marshal(errors.SerializableError{ErrorCode: errors.Conflict, ErrorName: "Widgets:WidgetLocked", ErrorInstanceID: e.errorInstanceID, Parameters: json.RawMessage(parameters)})
+}
+
+func (e *WidgetLocked) UnmarshalJSON(data []byte) error {
+	var serializableError errors.SerializableError
+	if err := safejson.Unmarshal(data, &serializableError); err != nil {
+		return err
+	}
+	var parameters widgetLocked
+	if err := safejson.Unmarshal([]byte(serializableError.Parameters), &parameters); err != nil {
+		return err
+	}
+	e.errorInstanceID = serializableError.ErrorInstanceID
+	e.widgetLocked = parameters
+	return nil
+}
+
+type widgetNotFound struct {
+	WidgetId string `json:"widgetId"`
+}
+
+func (o widgetNotFound) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"widgetId\":"...)
+	out = appendJSONString(out, o.WidgetId)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o widgetNotFound) JSONSize() (int, error) {
+	size := 13
+	size += jsonStringSize(o.WidgetId)
+	return size, nil
+}
+
+func (o *widgetNotFound) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenWidgetId bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "widgetId":
+			if seenWidgetId {
+				ok = false
+				return false
+			}
+			seenWidgetId = true
+			if !unmarshalJSONString(field, &o.WidgetId) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "widgetId") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o widgetNotFound) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *widgetNotFound) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// NewWidgetNotFound returns new instance of WidgetNotFound error.
+func NewWidgetNotFound(widgetIdArg string) *WidgetNotFound {
+	return &WidgetNotFound{errorInstanceID: uuid.NewUUID(), stack: werror.NewStackTrace(), widgetNotFound: widgetNotFound{WidgetId: widgetIdArg}}
+}
+
+// WrapWithWidgetNotFound returns new instance of WidgetNotFound error wrapping an existing error.
+func WrapWithWidgetNotFound(err error, widgetIdArg string) *WidgetNotFound {
+	return &WidgetNotFound{errorInstanceID: uuid.NewUUID(), stack: werror.NewStackTrace(), cause: err, widgetNotFound: widgetNotFound{WidgetId: widgetIdArg}}
+}
+
+// MapToWidgetNotFound returns a function that maps errors that match sentinel, as reported by errors.Is, to a new
+// WidgetNotFound error with the provided parameters that wraps them. The function returns nil for all other errors.
+// It can be passed to the WithErrorMappers route parameter of generated servers.
+func MapToWidgetNotFound(sentinel error, widgetIdArg string) func(error) error {
+	return func(err error) error {
+		if !stderrors.Is(err, sentinel) {
+			return nil
+		}
+		return WrapWithWidgetNotFound(err, widgetIdArg)
+	}
+}
+
+// WidgetNotFound is an error type.
+type WidgetNotFound struct {
+	errorInstanceID uuid.UUID
+	widgetNotFound
+	cause error
+	stack werror.StackTrace
+}
+
+// IsWidgetNotFound returns true if err is an instance of WidgetNotFound.
+func IsWidgetNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	_, ok := errors.GetConjureError(err).(*WidgetNotFound)
+	return ok
+}
+
+func (e *WidgetNotFound) Error() string {
+	return fmt.Sprintf("NOT_FOUND Widgets:WidgetNotFound (%s)", e.errorInstanceID)
+}
+
+// Cause returns the underlying cause of the error, or nil if none.
+// Note that cause is not serialized and sent over the wire.
+func (e *WidgetNotFound) Cause() error {
+	return e.cause
+}
+
+// StackTrace returns the StackTrace for the error, or nil if none.
+// Note that stack traces are not serialized and sent over the wire.
+func (e *WidgetNotFound) StackTrace() werror.StackTrace {
+	return e.stack
+}
+
+// Message returns the message body for the error.
+func (e *WidgetNotFound) Message() string {
+	return "NOT_FOUND Widgets:WidgetNotFound"
+}
+
+// Format implements fmt.Formatter, a requirement of werror.Werror.
+func (e *WidgetNotFound) Format(state fmt.State, verb rune) {
+	werror.Format(e, e.safeParams(), state, verb)
+}
+
+// Code returns an enum describing error category.
+func (e *WidgetNotFound) Code() errors.ErrorCode {
+	return errors.NotFound
+}
+
+// Name returns an error name identifying error type.
+func (e *WidgetNotFound) Name() string {
+	return "Widgets:WidgetNotFound"
+}
+
+// InstanceID returns unique identifier of this particular error instance.
+func (e *WidgetNotFound) InstanceID() uuid.UUID {
+	return e.errorInstanceID
+}
+
+// Parameters returns a set of named parameters detailing this particular error instance.
+func (e *WidgetNotFound) Parameters() map[string]interface{} {
+	return map[string]interface{}{"widgetId": e.WidgetId}
+}
+
+// safeParams returns a set of named safe parameters detailing this particular error instance.
+func (e *WidgetNotFound) safeParams() map[string]interface{} {
+	return map[string]interface{}{"widgetId": e.WidgetId, "errorInstanceId": e.errorInstanceID, "errorName": e.Name()}
+}
+
+// SafeParams returns a set of named safe parameters detailing this particular error instance and
+// any underlying causes.
+func (e *WidgetNotFound) SafeParams() map[string]interface{} {
+	safeParams, _ := werror.ParamsFromError(e.cause)
+	for k, v := range e.safeParams() {
+		if _, exists := safeParams[k]; !exists {
+			safeParams[k] = v
+		}
+	}
+	return safeParams
+}
+
+// unsafeParams returns a set of named unsafe parameters detailing this particular error instance.
+func (e *WidgetNotFound) unsafeParams() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+// UnsafeParams returns a set of named unsafe parameters detailing this particular error instance and
+// any underlying causes.
+func (e *WidgetNotFound) UnsafeParams() map[string]interface{} {
+	_, unsafeParams := werror.ParamsFromError(e.cause)
+	for k, v := range e.unsafeParams() {
+		if _, exists := unsafeParams[k]; !exists {
+			unsafeParams[k] = v
+		}
+	}
+	return unsafeParams
+}
+
+func (e WidgetNotFound) MarshalJSON() ([]byte, error) {
+	parameters, err := safejson.Marshal(e.widgetNotFound)
+	if err != nil {
+		return nil, err
+	}
+	return safejson.Marshal(errors.SerializableError{ErrorCode: errors.NotFound, ErrorName: "Widgets:WidgetNotFound", ErrorInstanceID: e.errorInstanceID, Parameters: json.RawMessage(parameters)})
+}
+
+func (e *WidgetNotFound) UnmarshalJSON(data []byte) error {
+	var serializableError errors.SerializableError
+	if err := safejson.Unmarshal(data, &serializableError); err != nil {
+		return err
+	}
+	var parameters widgetNotFound
+	if err := safejson.Unmarshal([]byte(serializableError.Parameters), &parameters); err != nil {
+		return err
+	}
+	e.errorInstanceID = serializableError.ErrorInstanceID
+	e.widgetNotFound = parameters
+	return nil
+}
+
+func init() {
+	errors.RegisterErrorType("Widgets:WidgetLocked", reflect.TypeOf(WidgetLocked{}))
+	errors.RegisterErrorType("Widgets:WidgetNotFound", reflect.TypeOf(WidgetNotFound{}))
+}
@@ -0,0 +1,107 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	wparams "github.com/palantir/witchcraft-go-params"
+)
+
+// FakeWidgetService is an in-memory implementation of WidgetService for use in tests.
+// Each endpoint records its arguments and then invokes the corresponding <Endpoint>Func field.
+// If the field is nil, the endpoint returns DefaultErr (or a Conjure Internal error if DefaultErr is nil).
+// The zero value is ready to use and all methods are safe for concurrent use.
+type FakeWidgetService struct {
+	// GetWidgetFunc is invoked by GetWidget if non-nil.
+	GetWidgetFunc func(ctx context.Context, widgetIdArg string) (string, error)
+	// UpdateWidgetFunc is invoked by UpdateWidget if non-nil.
+	UpdateWidgetFunc func(ctx context.Context, widgetIdArg string, valueArg string) error
+	// PingFunc is invoked by Ping if non-nil.
+	PingFunc func(ctx context.Context) (string, error)
+	// DefaultErr is returned by endpoints whose func field is nil.
+	DefaultErr error
+
+	mu                sync.Mutex
+	getWidgetCalls    []FakeWidgetServiceGetWidgetCall
+	updateWidgetCalls []FakeWidgetServiceUpdateWidgetCall
+	pingCalls         []FakeWidgetServicePingCall
+}
+
+var _ WidgetService = (*FakeWidgetService)(nil)
+
+// FakeWidgetServiceGetWidgetCall records the arguments of a call to FakeWidgetService.GetWidget.
+type FakeWidgetServiceGetWidgetCall struct {
+	WidgetId string
+}
+
+func (f *FakeWidgetService) GetWidget(ctx context.Context, widgetIdArg string) (string, error) {
+	f.mu.Lock()
+	f.getWidgetCalls = append(f.getWidgetCalls, FakeWidgetServiceGetWidgetCall{WidgetId: widgetIdArg})
+	f.mu.Unlock()
+	if f.GetWidgetFunc != nil {
+		return f.GetWidgetFunc(ctx, widgetIdArg)
+	}
+	var defaultReturnVal string
+	return defaultReturnVal, f.defaultErr("getWidget")
+}
+
+// GetWidgetCalls returns the arguments of every call made to GetWidget, in call order.
+func (f *FakeWidgetService) GetWidgetCalls() []FakeWidgetServiceGetWidgetCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeWidgetServiceGetWidgetCall(nil), f.getWidgetCalls...)
+}
+
+// FakeWidgetServiceUpdateWidgetCall records the arguments of a call to FakeWidgetService.UpdateWidget.
+type FakeWidgetServiceUpdateWidgetCall struct {
+	WidgetId string
+	Value    string
+}
+
+func (f *FakeWidgetService) UpdateWidget(ctx context.Context, widgetIdArg string, valueArg string) error {
+	f.mu.Lock()
+	f.updateWidgetCalls = append(f.updateWidgetCalls, FakeWidgetServiceUpdateWidgetCall{WidgetId: widgetIdArg, Value: valueArg})
+	f.mu.Unlock()
+	if f.UpdateWidgetFunc != nil {
+		return f.UpdateWidgetFunc(ctx, widgetIdArg, valueArg)
+	}
+	return f.defaultErr("updateWidget")
+}
+
+// UpdateWidgetCalls returns the arguments of every call made to UpdateWidget, in call order.
+func (f *FakeWidgetService) UpdateWidgetCalls() []FakeWidgetServiceUpdateWidgetCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeWidgetServiceUpdateWidgetCall(nil), f.updateWidgetCalls...)
+}
+
+// FakeWidgetServicePingCall records the arguments of a call to FakeWidgetService.Ping.
+type FakeWidgetServicePingCall struct{}
+
+func (f *FakeWidgetService) Ping(ctx context.Context) (string, error) {
+	f.mu.Lock()
+	f.pingCalls = append(f.pingCalls, FakeWidgetServicePingCall{})
+	f.mu.Unlock()
+	if f.PingFunc != nil {
+		return f.PingFunc(ctx)
+	}
+	var defaultReturnVal string
+	return defaultReturnVal, f.defaultErr("ping")
+}
+
+// PingCalls returns the arguments of every call made to Ping, in call order.
+func (f *FakeWidgetService) PingCalls() []FakeWidgetServicePingCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeWidgetServicePingCall(nil), f.pingCalls...)
+}
+
+func (f *FakeWidgetService) defaultErr(endpoint string) error {
+	if f.DefaultErr != nil {
+		return f.DefaultErr
+	}
+	return errors.NewInternal(wparams.NewSafeParamStorer(map[string]interface{}{"fakeEndpoint": endpoint}))
+}
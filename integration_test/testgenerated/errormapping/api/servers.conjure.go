@@ -0,0 +1,164 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/codecs"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-server/httpserver"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-server/v2/witchcraft/wresource"
+	"github.com/palantir/witchcraft-go-server/v2/wrouter"
+)
+
+type WidgetService interface {
+	GetWidget(ctx context.Context, widgetIdArg string) (string, error)
+	UpdateWidget(ctx context.Context, widgetIdArg string, valueArg string) error
+	Ping(ctx context.Context) (string, error)
+}
+
+// RegisterRoutesWidgetService registers handlers for the WidgetService endpoints with a witchcraft wrouter.
+// This should typically be called in a witchcraft server's InitFunc.
+// impl provides an implementation of each endpoint, which can assume the request parameters have been parsed
+// in accordance with the Conjure specification.
+func RegisterRoutesWidgetService(router wrouter.Router, impl WidgetService, routerParams ...wrouter.RouteParam) error {
+	handler := widgetServiceHandler{impl: impl}
+	resource := wresource.New("widgetservice", router)
+	if err := resource.Get("GetWidget", "/widgets/{widgetId}", httpserver.NewJSONHandler(mapEndpointErrors(handler.HandleGetWidget), httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add getWidget route")
+	}
+	if err := resource.Put("UpdateWidget", "/widgets/{widgetId}", httpserver.NewJSONHandler(mapEndpointErrors(handler.HandleUpdateWidget), httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add updateWidget route")
+	}
+	if err := resource.Get("Ping", "/ping", httpserver.NewJSONHandler(mapEndpointErrors(handler.HandlePing), httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add ping route")
+	}
+	return nil
+}
+
+type widgetServiceHandler struct {
+	impl WidgetService
+}
+
+func (w *widgetServiceHandler) HandleGetWidget(rw http.ResponseWriter, req *http.Request) error {
+	pathParams := wrouter.PathParams(req)
+	if pathParams == nil {
+		return werror.Wrap(errors.NewInternal(), "path params not found on request: ensure this endpoint is registered with wrouter")
+	}
+	widgetIdArg, ok := pathParams["widgetId"]
+	if !ok {
+		return werror.WrapWithContextParams(req.Context(), errors.NewInvalidArgument(), "path parameter \"widgetId\" not present")
+	}
+	respArg, err := w.impl.GetWidget(req.Context(), widgetIdArg)
+	if err != nil {
+		return err
+	}
+	rw.Header().Add("Content-Type", codecs.JSON.ContentType())
+	return codecs.JSON.Encode(rw, respArg)
+}
+
+func (w *widgetServiceHandler) HandleUpdateWidget(rw http.ResponseWriter, req *http.Request) error {
+	pathParams := wrouter.PathParams(req)
+	if pathParams == nil {
+		return werror.Wrap(errors.NewInternal(), "path params not found on request: ensure this endpoint is registered with wrouter")
+	}
+	widgetIdArg, ok := pathParams["widgetId"]
+	if !ok {
+		return werror.WrapWithContextParams(req.Context(), errors.NewInvalidArgument(), "path parameter \"widgetId\" not present")
+	}
+	var valueArg string
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	valueArg, err = decodeJSONString(value)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	if err := w.impl.UpdateWidget(req.Context(), widgetIdArg, valueArg); err != nil {
+		return err
+	}
+	rw.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (w *widgetServiceHandler) HandlePing(rw http.ResponseWriter, req *http.Request) error {
+	respArg, err := w.impl.Ping(req.Context())
+	if err != nil {
+		return err
+	}
+	rw.Header().Add("Content-Type", codecs.JSON.ContentType())
+	return codecs.JSON.Encode(rw, respArg)
+}
+
+// WidgetServiceErrors maps the names of the endpoints of WidgetService to the names of the Conjure errors they declare
+// using "error:<namespace>:<name>" tags.
+var WidgetServiceErrors = map[string][]string{
+	"getWidget":    {"Widgets:WidgetNotFound"},
+	"updateWidget": {"Widgets:WidgetNotFound", "Widgets:WidgetLocked"},
+}
+
+// VerifyWidgetServiceError returns an error if err is a Conjure error that the endpoint of WidgetService with the provided
+// name does not declare in WidgetServiceErrors. It returns nil if err is nil, is not a Conjure error or has a default
+// Conjure error name, such as "Default:NotFound".
+func VerifyWidgetServiceError(endpointName string, err error) error {
+	conjureErr := errors.GetConjureError(err)
+	if conjureErr == nil || strings.HasPrefix(conjureErr.Name(), "Default:") {
+		return nil
+	}
+	for _, errorName := range WidgetServiceErrors[endpointName] {
+		if conjureErr.Name() == errorName {
+			return nil
+		}
+	}
+	return werror.Error("endpoint returned an undeclared Conjure error",
+		werror.SafeParam("service", "WidgetService"),
+		werror.SafeParam("endpoint", endpointName),
+		werror.SafeParam("errorName", conjureErr.Name()),
+	)
+}
+
+// ErrorMapper maps an error returned by an endpoint implementation to the error that is returned to the
+// client, such as a Conjure error declared by the endpoint. It returns nil if it does not map err.
+type ErrorMapper func(err error) error
+
+// WithErrorMappers returns a route parameter that configures the handlers registered by the RegisterRoutes
+// functions of this package to map the errors returned by endpoint implementations using mappers. The first
+// mapper that maps an error determines the returned error. Errors that no mapper maps are returned as-is.
+func WithErrorMappers(mappers ...ErrorMapper) wrouter.RouteParam {
+	return wrouter.RouteMiddleware(func(rw http.ResponseWriter, req *http.Request, reqVals wrouter.RequestVals, next wrouter.RouteRequestHandler) {
+		configured, _ := req.Context().Value(errorMappersContextKey{}).([]ErrorMapper)
+		configured = append(configured[:len(configured):len(configured)], mappers...)
+		ctx := context.WithValue(req.Context(), errorMappersContextKey{}, configured)
+		next(rw, req.WithContext(ctx), reqVals)
+	})
+}
+
+type errorMappersContextKey struct{}
+
+// mapEndpointErrors returns a function that calls handle and maps the errors it returns using the mappers
+// configured using WithErrorMappers, if any.
+func mapEndpointErrors(handle func(http.ResponseWriter, *http.Request) error) func(http.ResponseWriter, *http.Request) error {
+	return func(rw http.ResponseWriter, req *http.Request) error {
+		err := handle(rw, req)
+		if err == nil {
+			return nil
+		}
+		mappers, _ := req.Context().Value(errorMappersContextKey{}).([]ErrorMapper)
+		for _, mapper := range mappers {
+			if mappedErr := mapper(err); mappedErr != nil {
+				return mappedErr
+			}
+		}
+		return err
+	}
+}
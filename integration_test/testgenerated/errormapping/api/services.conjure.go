@@ -0,0 +1,121 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	werror "github.com/palantir/witchcraft-go-error"
+)
+
+type WidgetServiceClient interface {
+	GetWidget(ctx context.Context, widgetIdArg string) (string, error)
+	UpdateWidget(ctx context.Context, widgetIdArg string, valueArg string) error
+	Ping(ctx context.Context) (string, error)
+}
+
+type widgetServiceClient struct {
+	client httpclient.Client
+}
+
+func NewWidgetServiceClient(client httpclient.Client) WidgetServiceClient {
+	return &widgetServiceClient{client: client}
+}
+
+func (c *widgetServiceClient) GetWidget(ctx context.Context, widgetIdArg string) (string, error) {
+	var defaultReturnVal string
+	var returnVal *string
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("GetWidget"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("GET"))
+	requestParams = append(requestParams, httpclient.WithPathf("/widgets/%s", url.PathEscape(fmt.Sprint(widgetIdArg))))
+	requestParams = append(requestParams, httpclient.WithJSONResponse(&returnVal))
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return defaultReturnVal, werror.WrapWithContextParams(ctx, err, "getWidget failed")
+	}
+	if returnVal == nil {
+		return defaultReturnVal, werror.ErrorWithContextParams(ctx, "getWidget response cannot be nil")
+	}
+	return *returnVal, nil
+}
+
+func (c *widgetServiceClient) UpdateWidget(ctx context.Context, widgetIdArg string, valueArg string) error {
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("UpdateWidget"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("PUT"))
+	requestParams = append(requestParams, httpclient.WithPathf("/widgets/%s", url.PathEscape(fmt.Sprint(widgetIdArg))))
+	requestParams = append(requestParams, httpclient.WithJSONRequest(valueArg))
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return werror.WrapWithContextParams(ctx, err, "updateWidget failed")
+	}
+	return nil
+}
+
+func (c *widgetServiceClient) Ping(ctx context.Context) (string, error) {
+	var defaultReturnVal string
+	var returnVal *string
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("Ping"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("GET"))
+	requestParams = append(requestParams, httpclient.WithPathf("/ping"))
+	requestParams = append(requestParams, httpclient.WithJSONResponse(&returnVal))
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return defaultReturnVal, werror.WrapWithContextParams(ctx, err, "ping failed")
+	}
+	if returnVal == nil {
+		return defaultReturnVal, werror.ErrorWithContextParams(ctx, "ping response cannot be nil")
+	}
+	return *returnVal, nil
+}
+
+// IsWidgetServiceGetWidgetError reports whether err is or wraps one of the Conjure errors that the getWidget endpoint of
+// WidgetService declares: WidgetNotFound.
+func IsWidgetServiceGetWidgetError(err error) bool {
+	switch errors.GetConjureError(err).(type) {
+	case *WidgetNotFound:
+		return true
+	default:
+		return false
+	}
+}
+
+// MatchWidgetServiceGetWidgetError calls the function for the Conjure error that err is or wraps if it is one of the errors
+// that the getWidget endpoint of WidgetService declares, and otherFunc with err otherwise, including if err is nil.
+// It returns the result of the function it calls.
+func MatchWidgetServiceGetWidgetError(err error, widgetNotFoundFunc func(*WidgetNotFound) error, otherFunc func(error) error) error {
+	switch conjureErr := errors.GetConjureError(err).(type) {
+	case *WidgetNotFound:
+		return widgetNotFoundFunc(conjureErr)
+	default:
+		return otherFunc(err)
+	}
+}
+
+// IsWidgetServiceUpdateWidgetError reports whether err is or wraps one of the Conjure errors that the updateWidget endpoint of
+// WidgetService declares: WidgetNotFound, WidgetLocked.
+func IsWidgetServiceUpdateWidgetError(err error) bool {
+	switch errors.GetConjureError(err).(type) {
+	case *WidgetNotFound, *WidgetLocked:
+		return true
+	default:
+		return false
+	}
+}
+
+// MatchWidgetServiceUpdateWidgetError calls the function for the Conjure error that err is or wraps if it is one of the errors
+// that the updateWidget endpoint of WidgetService declares, and otherFunc with err otherwise, including if err is nil.
+// It returns the result of the function it calls.
+func MatchWidgetServiceUpdateWidgetError(err error, widgetNotFoundFunc func(*WidgetNotFound) error, widgetLockedFunc func(*WidgetLocked) error, otherFunc func(error) error) error {
+	switch conjureErr := errors.GetConjureError(err).(type) {
+	case *WidgetNotFound:
+		return widgetNotFoundFunc(conjureErr)
+	case *WidgetLocked:
+		return widgetLockedFunc(conjureErr)
+	default:
+		return otherFunc(err)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errormapping_test
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"testing"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/conjure-go/v6/integration_test/internal/testutil"
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/errormapping/api"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-server/v2/witchcraft"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errWidgetNotFound = stderrors.New("widget not found")
+	errWidgetLocked   = stderrors.New("widget locked")
+)
+
+func TestErrorMappers(t *testing.T) {
+	ctx := testutil.TestContext()
+	httpClient, cleanup := testutil.StartTestServer(t, func(ctx context.Context, info witchcraft.InitInfo) (cleanup func(), rErr error) {
+		if err := api.RegisterRoutesWidgetService(info.Router, widgetImpl{}, api.WithErrorMappers(
+			api.MapToWidgetNotFound(errWidgetNotFound, "unknown"),
+			api.MapToWidgetLocked(errWidgetLocked),
+		)); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	})
+	defer cleanup()
+	client := api.NewWidgetServiceClient(httpClient)
+
+	t.Run("mapped sentinel", func(t *testing.T) {
+		_, err := client.GetWidget(ctx, "missing")
+		require.Error(t, err)
+		assert.True(t, api.IsWidgetNotFound(err), "%v", err)
+		assert.Equal(t, "unknown", errors.GetConjureError(err).SafeParams()["widgetId"])
+		assert.True(t, api.IsWidgetServiceGetWidgetError(err))
+	})
+	t.Run("second mapper", func(t *testing.T) {
+		err := client.UpdateWidget(ctx, "locked", "value")
+		require.Error(t, err)
+		assert.True(t, api.IsWidgetLocked(err), "%v", err)
+		assert.True(t, api.IsWidgetServiceUpdateWidgetError(err))
+		assert.False(t, api.IsWidgetServiceGetWidgetError(err))
+	})
+	t.Run("no error", func(t *testing.T) {
+		resp, err := client.GetWidget(ctx, "present")
+		require.NoError(t, err)
+		assert.Equal(t, "present", resp)
+		require.NoError(t, client.UpdateWidget(ctx, "present", "value"))
+	})
+}
+
+func TestMapToError(t *testing.T) {
+	mapper := api.MapToWidgetNotFound(errWidgetNotFound, "id")
+	assert.Nil(t, mapper(errWidgetLocked))
+	cause := fmt.Errorf("lookup: %w", errWidgetNotFound)
+	mapped := mapper(cause)
+	require.True(t, api.IsWidgetNotFound(mapped))
+	assert.Equal(t, cause, mapped.(*api.WidgetNotFound).Cause())
+}
+
+func TestServiceErrors(t *testing.T) {
+	assert.Equal(t, map[string][]string{
+		"getWidget":    {"Widgets:WidgetNotFound"},
+		"updateWidget": {"Widgets:WidgetNotFound", "Widgets:WidgetLocked"},
+	}, api.WidgetServiceErrors)
+
+	for _, tc := range []struct {
+		name     string
+		endpoint string
+		err      error
+		wantErr  bool
+	}{
+		{name: "nil", endpoint: "ping", err: nil},
+		{name: "not a Conjure error", endpoint: "ping", err: errWidgetLocked},
+		{name: "default error", endpoint: "ping", err: errors.NewNotFound()},
+		{name: "declared", endpoint: "updateWidget", err: api.NewWidgetLocked()},
+		{name: "declared and wrapped", endpoint: "getWidget", err: werror.Wrap(api.NewWidgetNotFound("id"), "wrapped")},
+		{name: "undeclared", endpoint: "getWidget", err: api.NewWidgetLocked(), wantErr: true},
+		{name: "undeclared and wrapped", endpoint: "getWidget", err: werror.Wrap(api.NewWidgetLocked(), "wrapped"), wantErr: true},
+		{name: "no declared errors", endpoint: "ping", err: api.NewWidgetNotFound("id"), wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := api.VerifyWidgetServiceError(tc.endpoint, tc.err)
+			if tc.wantErr {
+				assert.EqualError(t, err, "endpoint returned an undeclared Conjure error")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMatchEndpointError(t *testing.T) {
+	match := func(err error) string {
+		var handled string
+		require.NoError(t, api.MatchWidgetServiceUpdateWidgetError(err,
+			func(err *api.WidgetNotFound) error {
+				handled = "not found " + err.SafeParams()["widgetId"].(string)
+				return nil
+			},
+			func(*api.WidgetLocked) error {
+				handled = "locked"
+				return nil
+			},
+			func(err error) error {
+				handled = fmt.Sprintf("other %v", err)
+				return nil
+			},
+		))
+		return handled
+	}
+	assert.Equal(t, "not found id", match(api.NewWidgetNotFound("id")))
+	assert.Equal(t, "locked", match(werror.Wrap(api.NewWidgetLocked(), "wrapped")))
+	assert.Equal(t, "other widget locked", match(errWidgetLocked))
+	assert.Equal(t, "other <nil>", match(nil))
+
+	assert.False(t, api.IsWidgetServiceUpdateWidgetError(nil))
+	assert.False(t, api.IsWidgetServiceUpdateWidgetError(errors.NewNotFound()))
+}
+
+type widgetImpl struct{}
+
+func (widgetImpl) GetWidget(ctx context.Context, widgetIDArg string) (string, error) {
+	if widgetIDArg == "missing" {
+		return "", fmt.Errorf("lookup %s: %w", widgetIDArg, errWidgetNotFound)
+	}
+	return widgetIDArg, nil
+}
+
+func (widgetImpl) UpdateWidget(ctx context.Context, widgetIDArg string, valueArg string) error {
+	if widgetIDArg == "locked" {
+		return errWidgetLocked
+	}
+	return nil
+}
+
+func (widgetImpl) Ping(ctx context.Context) (string, error) {
+	return "pong", nil
+}
@@ -6,12 +6,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strconv"
 
 	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
 	"github.com/palantir/pkg/uuid"
 	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/tidwall/gjson"
 )
 
 type myInternal struct {
@@ -26,15 +28,77 @@ type myInternal struct {
 	MyInternal string  `json:"myInternal"`
 }
 
-func (o myInternal) MarshalJSON() ([]byte, error) {
-	if o.SafeArgB == nil {
-		o.SafeArgB = make([]int, 0)
+func (o myInternal) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"safeArgA\":"...)
+	out, err = o.SafeArgA.AppendJSON(out)
+	if err != nil {
+		return nil, err
 	}
-	type myInternalAlias myInternal
-	return safejson.Marshal(myInternalAlias(o))
+	out = append(out, ",\"safeArgB\":"...)
+	out = append(out, '[')
+	for i, v := range o.SafeArgB {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = strconv.AppendInt(out, int64(v), 10)
+	}
+	out = append(out, ']')
+	out = append(out, ",\"type\":"...)
+	out = appendJSONString(out, o.Type)
+	out = append(out, ",\"unsafeArgA\":"...)
+	out = appendJSONString(out, o.UnsafeArgA)
+	out = append(out, ",\"unsafeArgB\":"...)
+	if o.UnsafeArgB == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, *o.UnsafeArgB)
+	}
+	out = append(out, ",\"myInternal\":"...)
+	out = appendJSONString(out, o.MyInternal)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o myInternal) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 75
+	n, err = o.SafeArgA.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	size += 2
+	if len(o.SafeArgB) > 1 {
+		size += len(o.SafeArgB) - 1
+	}
+	for _, v := range o.SafeArgB {
+		size += jsonIntSize(int64(v))
+	}
+	size += jsonStringSize(o.Type)
+	size += jsonStringSize(o.UnsafeArgA)
+	if o.UnsafeArgB == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(*o.UnsafeArgB)
+	}
+	size += jsonStringSize(o.MyInternal)
+	return size, nil
+}
+
+func (o myInternal) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *myInternal) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v myInternal
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type myInternalAlias myInternal
 	var rawmyInternal myInternalAlias
 	if err := safejson.Unmarshal(data, &rawmyInternal); err != nil {
@@ -47,6 +111,118 @@ func (o *myInternal) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *myInternal) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.SafeArgB == nil {
+			o.SafeArgB = make([]int, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenSafeArgA, seenSafeArgB, seenType, seenUnsafeArgA, seenUnsafeArgB, seenMyInternal bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "safeArgA":
+			if seenSafeArgA {
+				ok = false
+				return false
+			}
+			seenSafeArgA = true
+			if !o.SafeArgA.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "safeArgB":
+			if seenSafeArgB {
+				ok = false
+				return false
+			}
+			seenSafeArgB = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.SafeArgB = make([]int, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v int
+					if !unmarshalJSONInt(elem, &v) {
+						ok1 = false
+						return false
+					}
+					o.SafeArgB = append(o.SafeArgB, v)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !unmarshalJSONString(field, &o.Type) {
+				ok = false
+				return false
+			}
+		case "unsafeArgA":
+			if seenUnsafeArgA {
+				ok = false
+				return false
+			}
+			seenUnsafeArgA = true
+			if !unmarshalJSONString(field, &o.UnsafeArgA) {
+				ok = false
+				return false
+			}
+		case "unsafeArgB":
+			if seenUnsafeArgB {
+				ok = false
+				return false
+			}
+			seenUnsafeArgB = true
+			if field.Type != gjson.Null {
+				var v1 string
+				if !unmarshalJSONString(field, &v1) {
+					ok = false
+					return false
+				}
+				o.UnsafeArgB = &v1
+			}
+		case "myInternal":
+			if seenMyInternal {
+				ok = false
+				return false
+			}
+			seenMyInternal = true
+			if !unmarshalJSONString(field, &o.MyInternal) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "safeArgA", "safeArgB", "type", "unsafeArgA", "unsafeArgB", "myInternal") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.SafeArgB == nil {
+		o.SafeArgB = make([]int, 0)
+	}
+	return true
+}
+
 func (o myInternal) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -204,15 +380,74 @@ type myNotFound struct {
 	UnsafeArgB *string `json:"unsafeArgB"`
 }
 
-func (o myNotFound) MarshalJSON() ([]byte, error) {
-	if o.SafeArgB == nil {
-		o.SafeArgB = make([]int, 0)
+func (o myNotFound) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"safeArgA\":"...)
+	out, err = o.SafeArgA.AppendJSON(out)
+	if err != nil {
+		return nil, err
 	}
-	type myNotFoundAlias myNotFound
-	return safejson.Marshal(myNotFoundAlias(o))
+	out = append(out, ",\"safeArgB\":"...)
+	out = append(out, '[')
+	for i, v := range o.SafeArgB {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = strconv.AppendInt(out, int64(v), 10)
+	}
+	out = append(out, ']')
+	out = append(out, ",\"type\":"...)
+	out = appendJSONString(out, o.Type)
+	out = append(out, ",\"unsafeArgA\":"...)
+	out = appendJSONString(out, o.UnsafeArgA)
+	out = append(out, ",\"unsafeArgB\":"...)
+	if o.UnsafeArgB == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, *o.UnsafeArgB)
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o myNotFound) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 61
+	n, err = o.SafeArgA.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	size += 2
+	if len(o.SafeArgB) > 1 {
+		size += len(o.SafeArgB) - 1
+	}
+	for _, v := range o.SafeArgB {
+		size += jsonIntSize(int64(v))
+	}
+	size += jsonStringSize(o.Type)
+	size += jsonStringSize(o.UnsafeArgA)
+	if o.UnsafeArgB == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(*o.UnsafeArgB)
+	}
+	return size, nil
+}
+
+func (o myNotFound) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *myNotFound) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v myNotFound
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type myNotFoundAlias myNotFound
 	var rawmyNotFound myNotFoundAlias
 	if err := safejson.Unmarshal(data, &rawmyNotFound); err != nil {
@@ -225,6 +460,108 @@ func (o *myNotFound) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *myNotFound) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.SafeArgB == nil {
+			o.SafeArgB = make([]int, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenSafeArgA, seenSafeArgB, seenType, seenUnsafeArgA, seenUnsafeArgB bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "safeArgA":
+			if seenSafeArgA {
+				ok = false
+				return false
+			}
+			seenSafeArgA = true
+			if !o.SafeArgA.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "safeArgB":
+			if seenSafeArgB {
+				ok = false
+				return false
+			}
+			seenSafeArgB = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.SafeArgB = make([]int, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v int
+					if !unmarshalJSONInt(elem, &v) {
+						ok1 = false
+						return false
+					}
+					o.SafeArgB = append(o.SafeArgB, v)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !unmarshalJSONString(field, &o.Type) {
+				ok = false
+				return false
+			}
+		case "unsafeArgA":
+			if seenUnsafeArgA {
+				ok = false
+				return false
+			}
+			seenUnsafeArgA = true
+			if !unmarshalJSONString(field, &o.UnsafeArgA) {
+				ok = false
+				return false
+			}
+		case "unsafeArgB":
+			if seenUnsafeArgB {
+				ok = false
+				return false
+			}
+			seenUnsafeArgB = true
+			if field.Type != gjson.Null {
+				var v1 string
+				if !unmarshalJSONString(field, &v1) {
+					ok = false
+					return false
+				}
+				o.UnsafeArgB = &v1
+			}
+		default:
+			if matchesJSONField(key.Str, "safeArgA", "safeArgB", "type", "unsafeArgA", "unsafeArgB") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.SafeArgB == nil {
+		o.SafeArgB = make([]int, 0)
+	}
+	return true
+}
+
 func (o myNotFound) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
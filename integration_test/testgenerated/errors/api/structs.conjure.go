@@ -3,14 +3,108 @@
 package api
 
 import (
+	"fmt"
+
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type Basic struct {
 	Data string `json:"data"`
 }
 
+func (o Basic) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"data\":"...)
+	out = appendJSONString(out, o.Data)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Basic) JSONSize() (int, error) {
+	size := 9
+	size += jsonStringSize(o.Data)
+	return size, nil
+}
+
+func (o *Basic) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenData bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "data":
+			if seenData {
+				ok = false
+				return false
+			}
+			seenData = true
+			if !unmarshalJSONString(field, &o.Data) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "data") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *Basic) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Basic) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Basic{}
+	var seenData bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "data":
+			if seenData {
+				err = fmt.Errorf("duplicate field \"data\"")
+				return false
+			}
+			seenData = true
+			o.Data, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"data\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenData {
+		return fmt.Errorf("field \"data\" is required")
+	}
+	return nil
+}
+
 func (o Basic) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -26,3 +120,17 @@ func (o *Basic) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	}
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
+
+// Equal returns true if the Basic is equal to other according to the Conjure semantics of its values.
+func (o Basic) Equal(other Basic) bool {
+	if o.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Basic.
+func (o Basic) Clone() Basic {
+	out := o
+	return out
+}
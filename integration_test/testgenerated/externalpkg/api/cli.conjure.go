@@ -0,0 +1,168 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/codecs"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	wlogzap "github.com/palantir/witchcraft-go-logging/wlog-zap"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/trclog/trc1log"
+	"github.com/palantir/witchcraft-go-tracing/wtracing"
+	"github.com/palantir/witchcraft-go-tracing/wzipkin"
+	"github.com/spf13/cobra"
+	pflag "github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+type CLIConfig struct {
+	Client httpclient.ClientConfig `yaml:",inline"`
+}
+
+// Commands for SharedService
+
+type CLISharedServiceClientProvider interface {
+	Get(ctx context.Context, flags *pflag.FlagSet) (SharedServiceClient, error)
+}
+
+type defaultCLISharedServiceClientProvider struct{}
+
+func NewDefaultCLISharedServiceClientProvider() CLISharedServiceClientProvider {
+	return defaultCLISharedServiceClientProvider{}
+}
+
+func (d defaultCLISharedServiceClientProvider) Get(ctx context.Context, flags *pflag.FlagSet) (SharedServiceClient, error) {
+	conf, err := loadCLIConfig(ctx, flags)
+	if err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "failed to load CLI configuration file")
+	}
+	client, err := httpclient.NewClient(httpclient.WithConfig(conf.Client))
+	if err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "failed to create client with provided config")
+	}
+	return NewSharedServiceClient(client), nil
+}
+
+type SharedServiceCLICommand struct {
+	clientProvider CLISharedServiceClientProvider
+}
+
+func NewSharedServiceCLICommand() *cobra.Command {
+	return NewSharedServiceCLICommandWithClientProvider(NewDefaultCLISharedServiceClientProvider())
+}
+
+func NewSharedServiceCLICommandWithClientProvider(clientProvider CLISharedServiceClientProvider) *cobra.Command {
+	rootCmd := &cobra.Command{
+		Short: "Runs commands on the SharedService",
+		Use:   "sharedService",
+	}
+	rootCmd.PersistentFlags().String("conf", "var/conf/configuration.yml", "The configuration file is optional. The default path is ./var/conf/configuration.yml.")
+	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "Enables verbose mode for debugging client connections.")
+
+	cliCommand := SharedServiceCLICommand{clientProvider: clientProvider}
+
+	sharedService_Echo_Cmd := &cobra.Command{
+		RunE:  cliCommand.sharedService_Echo_CmdRun,
+		Short: "Calls the echo endpoint.",
+		Use:   "echo",
+	}
+	rootCmd.AddCommand(sharedService_Echo_Cmd)
+	sharedService_Echo_Cmd.Flags().String("input", "", "Required. ")
+
+	return rootCmd
+}
+
+func (c SharedServiceCLICommand) sharedService_Echo_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	inputRaw, err := flags.GetString("input")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument input")
+	}
+	if inputRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "input is a required argument")
+	}
+	var inputArg WrapsShared
+	var inputArgReader io.ReadCloser
+	switch {
+	case inputRaw == "@-":
+		inputArgReader = io.NopCloser(cmd.InOrStdin())
+	case strings.HasPrefix(inputRaw, "@"):
+		inputArgReader, err = os.Open(strings.TrimSpace(inputRaw[1:]))
+		if err != nil {
+			return werror.WrapWithContextParams(ctx, err, "failed to open file for argument input")
+		}
+	default:
+		inputArgReader = io.NopCloser(bytes.NewReader([]byte(inputRaw)))
+	}
+	defer inputArgReader.Close()
+	if err := codecs.JSON.Decode(inputArgReader, &inputArg); err != nil {
+		return werror.WrapWithContextParams(ctx, err, "invalid value for input argument")
+	}
+
+	result, err := client.Echo(ctx, inputArg)
+	if err != nil {
+		return err
+	}
+	resultBytes, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		fmt.Printf("Failed to marshal to json with err: %v\n\nPrinting as string:\n%v\n", err, result)
+		return nil
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%v\n", string(resultBytes))
+	return nil
+}
+
+func loadCLIConfig(ctx context.Context, flags *pflag.FlagSet) (CLIConfig, error) {
+	var emptyConfig CLIConfig
+	configPath, err := flags.GetString("conf")
+	if err != nil || configPath == "" {
+		return emptyConfig, werror.WrapWithContextParams(ctx, err, "config file location must be specified")
+	}
+	confBytes, err := os.ReadFile(configPath)
+	if err != nil {
+		return emptyConfig, err
+	}
+	var conf CLIConfig
+	err = yaml.Unmarshal(confBytes, &conf)
+	if err != nil {
+		return emptyConfig, err
+	}
+	return conf, nil
+}
+
+func getCLIContext(flags *pflag.FlagSet) context.Context {
+	ctx := context.Background()
+	logProvider := wlog.NewNoopLoggerProvider()
+	logWriter := io.Discard
+	verbose, err := flags.GetBool("verbose")
+	if verbose && err == nil {
+		logProvider = wlogzap.LoggerProvider()
+		logWriter = os.Stdout
+	}
+	wlog.SetDefaultLoggerProvider(logProvider)
+	ctx = svc1log.WithLogger(ctx, svc1log.New(logWriter, wlog.DebugLevel))
+	traceLogger := trc1log.New(logWriter)
+	ctx = trc1log.WithLogger(ctx, traceLogger)
+	ctx = evt2log.WithLogger(ctx, evt2log.New(logWriter))
+	tracer, err := wzipkin.NewTracer(traceLogger)
+	if err != nil {
+		return ctx
+	}
+	return wtracing.ContextWithTracer(ctx, tracer)
+}
@@ -0,0 +1,58 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/objects/api"
+	wparams "github.com/palantir/witchcraft-go-params"
+)
+
+// FakeSharedService is an in-memory implementation of SharedService for use in tests.
+// Each endpoint records its arguments and then invokes the corresponding <Endpoint>Func field.
+// If the field is nil, the endpoint returns DefaultErr (or a Conjure Internal error if DefaultErr is nil).
+// The zero value is ready to use and all methods are safe for concurrent use.
+type FakeSharedService struct {
+	// EchoFunc is invoked by Echo if non-nil.
+	EchoFunc func(ctx context.Context, inputArg WrapsShared) (api.Basic, error)
+	// DefaultErr is returned by endpoints whose func field is nil.
+	DefaultErr error
+
+	mu        sync.Mutex
+	echoCalls []FakeSharedServiceEchoCall
+}
+
+var _ SharedService = (*FakeSharedService)(nil)
+
+// FakeSharedServiceEchoCall records the arguments of a call to FakeSharedService.Echo.
+type FakeSharedServiceEchoCall struct {
+	Input WrapsShared
+}
+
+func (f *FakeSharedService) Echo(ctx context.Context, inputArg WrapsShared) (api.Basic, error) {
+	f.mu.Lock()
+	f.echoCalls = append(f.echoCalls, FakeSharedServiceEchoCall{Input: inputArg})
+	f.mu.Unlock()
+	if f.EchoFunc != nil {
+		return f.EchoFunc(ctx, inputArg)
+	}
+	var defaultReturnVal api.Basic
+	return defaultReturnVal, f.defaultErr("echo")
+}
+
+// EchoCalls returns the arguments of every call made to Echo, in call order.
+func (f *FakeSharedService) EchoCalls() []FakeSharedServiceEchoCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeSharedServiceEchoCall(nil), f.echoCalls...)
+}
+
+func (f *FakeSharedService) defaultErr(endpoint string) error {
+	if f.DefaultErr != nil {
+		return f.DefaultErr
+	}
+	return errors.NewInternal(wparams.NewSafeParamStorer(map[string]interface{}{"fakeEndpoint": endpoint}))
+}
@@ -0,0 +1,55 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/codecs"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-server/httpserver"
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/objects/api"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-server/v2/witchcraft/wresource"
+	"github.com/palantir/witchcraft-go-server/v2/wrouter"
+)
+
+type SharedService interface {
+	Echo(ctx context.Context, inputArg WrapsShared) (api.Basic, error)
+}
+
+// RegisterRoutesSharedService registers handlers for the SharedService endpoints with a witchcraft wrouter.
+// This should typically be called in a witchcraft server's InitFunc.
+// impl provides an implementation of each endpoint, which can assume the request parameters have been parsed
+// in accordance with the Conjure specification.
+func RegisterRoutesSharedService(router wrouter.Router, impl SharedService, routerParams ...wrouter.RouteParam) error {
+	handler := sharedServiceHandler{impl: impl}
+	resource := wresource.New("sharedservice", router)
+	if err := resource.Post("Echo", "/shared/echo", httpserver.NewJSONHandler(handler.HandleEcho, httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add echo route")
+	}
+	return nil
+}
+
+type sharedServiceHandler struct {
+	impl SharedService
+}
+
+func (s *sharedServiceHandler) HandleEcho(rw http.ResponseWriter, req *http.Request) error {
+	var inputArg WrapsShared
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	if err := inputArg.UnmarshalJSONStrict(data); err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	respArg, err := s.impl.Echo(req.Context(), inputArg)
+	if err != nil {
+		return err
+	}
+	rw.Header().Add("Content-Type", codecs.JSON.ContentType())
+	return codecs.JSON.Encode(rw, respArg)
+}
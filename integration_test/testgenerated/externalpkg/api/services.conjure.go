@@ -0,0 +1,41 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/objects/api"
+	werror "github.com/palantir/witchcraft-go-error"
+)
+
+type SharedServiceClient interface {
+	Echo(ctx context.Context, inputArg WrapsShared) (api.Basic, error)
+}
+
+type sharedServiceClient struct {
+	client httpclient.Client
+}
+
+func NewSharedServiceClient(client httpclient.Client) SharedServiceClient {
+	return &sharedServiceClient{client: client}
+}
+
+func (c *sharedServiceClient) Echo(ctx context.Context, inputArg WrapsShared) (api.Basic, error) {
+	var defaultReturnVal api.Basic
+	var returnVal *api.Basic
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("Echo"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("POST"))
+	requestParams = append(requestParams, httpclient.WithPathf("/shared/echo"))
+	requestParams = append(requestParams, httpclient.WithJSONRequest(inputArg))
+	requestParams = append(requestParams, httpclient.WithJSONResponse(&returnVal))
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return defaultReturnVal, werror.WrapWithContextParams(ctx, err, "echo failed")
+	}
+	if returnVal == nil {
+		return defaultReturnVal, werror.ErrorWithContextParams(ctx, "echo response cannot be nil")
+	}
+	return *returnVal, nil
+}
@@ -0,0 +1,330 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/objects/api"
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
+)
+
+type WrapsShared struct {
+	Basic         api.Basic   `json:"basic"`
+	OptionalBasic *api.Basic  `json:"optionalBasic"`
+	Basics        []api.Basic `json:"basics"`
+}
+
+func (o WrapsShared) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"basic\":"...)
+	out, err = appendJSONMarshal(out, o.Basic)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"optionalBasic\":"...)
+	if o.OptionalBasic == nil {
+		out = append(out, "null"...)
+	} else {
+		out, err = appendJSONMarshal(out, *o.OptionalBasic)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ",\"basics\":"...)
+	out = append(out, '[')
+	for i, v := range o.Basics {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = appendJSONMarshal(out, v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o WrapsShared) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 37
+	n, err = jsonMarshalSize(o.Basic)
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	if o.OptionalBasic == nil {
+		size += 4
+	} else {
+		n, err = jsonMarshalSize(*o.OptionalBasic)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 2
+	if len(o.Basics) > 1 {
+		size += len(o.Basics) - 1
+	}
+	for _, v := range o.Basics {
+		n, err = jsonMarshalSize(v)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (o WrapsShared) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
+}
+
+func (o *WrapsShared) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v WrapsShared
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
+	type WrapsSharedAlias WrapsShared
+	var rawWrapsShared WrapsSharedAlias
+	if err := safejson.Unmarshal(data, &rawWrapsShared); err != nil {
+		return err
+	}
+	if rawWrapsShared.Basics == nil {
+		rawWrapsShared.Basics = make([]api.Basic, 0)
+	}
+	*o = WrapsShared(rawWrapsShared)
+	return nil
+}
+
+func (o *WrapsShared) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Basics == nil {
+			o.Basics = make([]api.Basic, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenBasic, seenOptionalBasic, seenBasics bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "basic":
+			if seenBasic {
+				ok = false
+				return false
+			}
+			seenBasic = true
+			if safejson.Unmarshal([]byte(field.Raw), &o.Basic) != nil {
+				ok = false
+				return false
+			}
+		case "optionalBasic":
+			if seenOptionalBasic {
+				ok = false
+				return false
+			}
+			seenOptionalBasic = true
+			if field.Type != gjson.Null {
+				var v api.Basic
+				if safejson.Unmarshal([]byte(field.Raw), &v) != nil {
+					ok = false
+					return false
+				}
+				o.OptionalBasic = &v
+			}
+		case "basics":
+			if seenBasics {
+				ok = false
+				return false
+			}
+			seenBasics = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Basics = make([]api.Basic, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v1 api.Basic
+					if safejson.Unmarshal([]byte(elem.Raw), &v1) != nil {
+						ok1 = false
+						return false
+					}
+					o.Basics = append(o.Basics, v1)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "basic", "optionalBasic", "basics") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Basics == nil {
+		o.Basics = make([]api.Basic, 0)
+	}
+	return true
+}
+
+func (o *WrapsShared) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *WrapsShared) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = WrapsShared{}
+	var seenBasic, seenOptionalBasic, seenBasics bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "basic":
+			if seenBasic {
+				err = fmt.Errorf("duplicate field \"basic\"")
+				return false
+			}
+			seenBasic = true
+			err = safejson.Unmarshal([]byte(field.Raw), &o.Basic)
+			if err != nil {
+				err = fmt.Errorf("field \"basic\": %w", err)
+				return false
+			}
+		case "optionalBasic":
+			if seenOptionalBasic {
+				err = fmt.Errorf("duplicate field \"optionalBasic\"")
+				return false
+			}
+			seenOptionalBasic = true
+			if field.Type != gjson.Null {
+				var v api.Basic
+				err = safejson.Unmarshal([]byte(field.Raw), &v)
+				if err != nil {
+					err = fmt.Errorf("field \"optionalBasic\": %w", err)
+					return false
+				}
+				o.OptionalBasic = &v
+			}
+		case "basics":
+			if seenBasics {
+				err = fmt.Errorf("duplicate field \"basics\"")
+				return false
+			}
+			seenBasics = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"basics\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Basics = make([]api.Basic, 0)
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v1 api.Basic
+					err = safejson.Unmarshal([]byte(elem.Raw), &v1)
+					if err != nil {
+						return false
+					}
+					o.Basics = append(o.Basics, v1)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"basics\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenBasic {
+		return fmt.Errorf("field \"basic\" is required")
+	}
+	if o.Basics == nil {
+		o.Basics = make([]api.Basic, 0)
+	}
+	return nil
+}
+
+func (o WrapsShared) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *WrapsShared) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the WrapsShared is equal to other according to the Conjure semantics of its values.
+func (o WrapsShared) Equal(other WrapsShared) bool {
+	if !reflect.DeepEqual(o.Basic, other.Basic) {
+		return false
+	}
+	if (o.OptionalBasic == nil) != (other.OptionalBasic == nil) {
+		return false
+	}
+	if o.OptionalBasic != nil {
+		if !reflect.DeepEqual((*o.OptionalBasic), *other.OptionalBasic) {
+			return false
+		}
+	}
+	if len(o.Basics) != len(other.Basics) {
+		return false
+	}
+	for i := range o.Basics {
+		if !reflect.DeepEqual(o.Basics[i], other.Basics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the WrapsShared.
+func (o WrapsShared) Clone() WrapsShared {
+	out := o
+	if o.OptionalBasic != nil {
+		v := *o.OptionalBasic
+		out.OptionalBasic = &v
+	}
+	if o.Basics != nil {
+		out.Basics = make([]api.Basic, len(o.Basics))
+		copy(out.Basics, o.Basics)
+	}
+	return out
+}
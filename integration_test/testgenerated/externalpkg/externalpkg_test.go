@@ -0,0 +1,46 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externalpkg_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/externalpkg/api"
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/externalpkg/api/apitest"
+	objectsapi "github.com/palantir/conjure-go/v6/integration_test/testgenerated/objects/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalPackageTypesAreReused(t *testing.T) {
+	// the external package is not generated
+	_, err := os.Stat("com")
+	assert.True(t, os.IsNotExist(err))
+
+	fake := &api.FakeSharedService{
+		EchoFunc: func(ctx context.Context, inputArg api.WrapsShared) (objectsapi.Basic, error) {
+			return inputArg.Basics[0], nil
+		},
+	}
+	client := apitest.NewSharedServiceTestPair(t, fake, apitest.TestPairOptions{})
+	resp, err := client.Echo(context.Background(), api.WrapsShared{
+		Basic:  objectsapi.Basic{Data: "basic"},
+		Basics: []objectsapi.Basic{{Data: "first"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, objectsapi.Basic{Data: "first"}, resp)
+}
@@ -38,16 +38,97 @@ func main() {
 }
 
 var definitions = map[string]string{
-	"auth/auth-service.yml":        "auth",
-	"binary/binary-service.yml":    "binary",
-	"cli/cli-service.yml":          "cli",
-	"client/client-service.yml":    "client",
-	"errors/errors.yml":            "errors",
-	"imports/imports.yml":          "imports",
-	"objects/objects.yml":          "objects",
-	"post/post-service.yml":        "post",
-	"queryparam/query-service.yml": "queryparam",
-	"server/server-service.yml":    "server",
+	"auth/auth-service.yml":         "auth",
+	"binary/binary-service.yml":     "binary",
+	"builders/builders.yml":         "builders",
+	"cbor/cbor.yml":                 "cbor",
+	"cli/cli-service.yml":           "cli",
+	"client/client-service.yml":     "client",
+	"equality/equality.yml":         "equality",
+	"endpoints/endpoints.yml":       "endpoints",
+	"errormapping/errormapping.yml": "errormapping",
+	"errors/errors.yml":             "errors",
+	"externalpkg/externalpkg.yml":   "externalpkg",
+	"imports/imports.yml":           "imports",
+	"interceptors/interceptors.yml": "interceptors",
+	"jsonencoding/jsonencoding.yml": "jsonencoding",
+	"objects/objects.yml":           "objects",
+	"post/post-service.yml":         "post",
+	"queryparam/query-service.yml":  "queryparam",
+	"server/server-service.yml":     "server",
+	"sets/sets.yml":                 "sets",
+	"streaming/streaming.yml":       "streaming",
+	"validation/validation.yml":     "validation",
+}
+
+// externalPackages configures the Conjure packages of a definition that are already generated in another package.
+var externalPackages = map[string]map[string]string{
+	"externalpkg/externalpkg.yml": {
+		"com.palantir.shared": "github.com/palantir/conjure-go/v6/integration_test/testgenerated/objects/api",
+	},
+}
+
+// openAPIDefinitions are the definitions for which OpenAPI documents are generated.
+var openAPIDefinitions = map[string]bool{
+	"server/server-service.yml": true,
+}
+
+// validationDefinitions are the definitions for which server handlers validate their parameters.
+var validationDefinitions = map[string]bool{
+	"validation/validation.yml": true,
+}
+
+// builderDefinitions are the definitions for which objects have constructors, setters and ValidateRequired methods.
+var builderDefinitions = map[string]bool{
+	"builders/builders.yml":     true,
+	"validation/validation.yml": true,
+}
+
+// hashDefinitions are the definitions for which Conjure types have Hash methods.
+var hashDefinitions = map[string]bool{
+	"equality/equality.yml": true,
+	"sets/sets.yml":         true,
+}
+
+// setTypeDefinitions are the definitions for which sets are represented by named set types.
+var setTypeDefinitions = map[string]bool{
+	"sets/sets.yml": true,
+}
+
+// cborDefinitions are the definitions for which Conjure types have CBOR methods and services negotiate CBOR.
+var cborDefinitions = map[string]bool{
+	"cbor/cbor.yml": true,
+}
+
+// authValidatorDefinitions are the definitions for which server handlers validate tokens using an AuthValidator.
+var authValidatorDefinitions = map[string]bool{
+	"auth/auth-service.yml": true,
+}
+
+// interceptorDefinitions are the definitions for which server handlers call EndpointInterceptors.
+var interceptorDefinitions = map[string]bool{
+	"interceptors/interceptors.yml": true,
+}
+
+// endpointMetadataDefinitions are the definitions for which services have <Service>Endpoints variables.
+var endpointMetadataDefinitions = map[string]bool{
+	"endpoints/endpoints.yml": true,
+}
+
+// errorMappingDefinitions are the definitions for which endpoints resolve the errors they declare, errors have
+// MapTo<Error> functions and server handlers map errors using ErrorMappers.
+var errorMappingDefinitions = map[string]bool{
+	"errormapping/errormapping.yml": true,
+}
+
+// clientIteratorDefinitions are the definitions for which clients have <Endpoint>Iter methods.
+var clientIteratorDefinitions = map[string]bool{
+	"streaming/streaming.yml": true,
+}
+
+// unionHelperDefinitions are the definitions for which unions have Match<Union> functions, As<Variant> accessors and Type methods.
+var unionHelperDefinitions = map[string]bool{
+	"objects/objects.yml": true,
 }
 
 func run(in, out string) error {
@@ -60,9 +141,24 @@ func run(in, out string) error {
 		return err
 	}
 	return conjure.Generate(conjureDef, conjure.OutputConfiguration{
-		OutputDir:            out,
-		GenerateServer:       true,
-		GenerateFuncsVisitor: true,
-		GenerateCLI:          true,
+		OutputDir:                    out,
+		GenerateServer:               true,
+		GenerateFuncsVisitor:         true,
+		GenerateCLI:                  true,
+		GenerateFakes:                true,
+		GenerateTestPairs:            true,
+		GenerateOpenAPI:              openAPIDefinitions[in],
+		GenerateValidation:           validationDefinitions[in],
+		GenerateBuilders:             builderDefinitions[in],
+		GenerateHash:                 hashDefinitions[in],
+		GenerateSetTypes:             setTypeDefinitions[in],
+		GenerateCBOR:                 cborDefinitions[in],
+		GenerateAuthValidator:        authValidatorDefinitions[in],
+		GenerateEndpointInterceptors: interceptorDefinitions[in],
+		GenerateEndpointMetadata:     endpointMetadataDefinitions[in],
+		GenerateErrorMapping:         errorMappingDefinitions[in],
+		GenerateClientIterators:      clientIteratorDefinitions[in],
+		GenerateUnionHelpers:         unionHelperDefinitions[in],
+		ExternalPackages:             externalPackages[in],
 	})
 }
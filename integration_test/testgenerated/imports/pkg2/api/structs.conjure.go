@@ -3,15 +3,119 @@
 package api
 
 import (
+	"fmt"
+
 	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/imports/pkg1/api"
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type Struct2 struct {
 	Data api.Struct1 `json:"data"`
 }
 
+func (o Struct2) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"data\":"...)
+	out, err = o.Data.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Struct2) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 9
+	n, err = o.Data.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (o *Struct2) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenData bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "data":
+			if seenData {
+				ok = false
+				return false
+			}
+			seenData = true
+			if safejson.Unmarshal([]byte(field.Raw), &o.Data) != nil {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "data") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *Struct2) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Struct2) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Struct2{}
+	var seenData bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "data":
+			if seenData {
+				err = fmt.Errorf("duplicate field \"data\"")
+				return false
+			}
+			seenData = true
+			err = o.Data.UnmarshalJSONStrict([]byte(field.Raw))
+			if err != nil {
+				err = fmt.Errorf("field \"data\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenData {
+		return fmt.Errorf("field \"data\" is required")
+	}
+	return nil
+}
+
 func (o Struct2) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -27,3 +131,17 @@ func (o *Struct2) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	}
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
+
+// Equal returns true if the Struct2 is equal to other according to the Conjure semantics of its values.
+func (o Struct2) Equal(other Struct2) bool {
+	if !o.Data.Equal(other.Data) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Struct2.
+func (o Struct2) Clone() Struct2 {
+	out := o
+	return out
+}
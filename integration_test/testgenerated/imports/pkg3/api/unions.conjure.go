@@ -12,6 +12,7 @@ import (
 	v21 "github.com/palantir/conjure-go/v6/integration_test/testgenerated/imports/pkg5/v2"
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type Union struct {
@@ -34,7 +35,8 @@ func (u *unionDeserializer) toStruct() Union {
 	return Union{typ: u.Type, one: u.One, two: u.Two, three: u.Three, four: u.Four}
 }
 
-func (u *Union) toSerializer() (interface{}, error) {
+func (u Union) AppendJSON(out []byte) ([]byte, error) {
+	var err error
 	switch u.typ {
 	default:
 		return nil, fmt.Errorf("unknown type %q", u.typ)
@@ -42,46 +44,106 @@ func (u *Union) toSerializer() (interface{}, error) {
 		if u.one == nil {
 			return nil, fmt.Errorf("field \"one\" is required")
 		}
-		return struct {
-			Type string      `json:"type"`
-			One  api.Struct1 `json:"one"`
-		}{Type: "one", One: *u.one}, nil
+		out = append(out, "{\"type\":\"one\",\"one\":"...)
+		out, err = u.one.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "two":
 		if u.two == nil {
 			return nil, fmt.Errorf("field \"two\" is required")
 		}
-		return struct {
-			Type string       `json:"type"`
-			Two  api1.Struct2 `json:"two"`
-		}{Type: "two", Two: *u.two}, nil
+		out = append(out, "{\"type\":\"two\",\"two\":"...)
+		out, err = u.two.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "three":
 		if u.three == nil {
 			return nil, fmt.Errorf("field \"three\" is required")
 		}
-		return struct {
-			Type  string                            `json:"type"`
-			Three v2.ObjectInPackageEndingInVersion `json:"three"`
-		}{Type: "three", Three: *u.three}, nil
+		out = append(out, "{\"type\":\"three\",\"three\":"...)
+		out, err = u.three.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	case "four":
 		if u.four == nil {
 			return nil, fmt.Errorf("field \"four\" is required")
 		}
-		return struct {
-			Type string                              `json:"type"`
-			Four v21.DifferentPackageEndingInVersion `json:"four"`
-		}{Type: "four", Four: *u.four}, nil
+		out = append(out, "{\"type\":\"four\",\"four\":"...)
+		out, err = u.four.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
 	}
+	out = append(out, '}')
+	return out, nil
 }
 
-func (u Union) MarshalJSON() ([]byte, error) {
-	ser, err := u.toSerializer()
-	if err != nil {
-		return nil, err
+func (u Union) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	switch u.typ {
+	default:
+		return 0, fmt.Errorf("unknown type %q", u.typ)
+	case "one":
+		if u.one == nil {
+			return 0, fmt.Errorf("field \"one\" is required")
+		}
+		size = 21
+		n, err = u.one.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "two":
+		if u.two == nil {
+			return 0, fmt.Errorf("field \"two\" is required")
+		}
+		size = 21
+		n, err = u.two.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "three":
+		if u.three == nil {
+			return 0, fmt.Errorf("field \"three\" is required")
+		}
+		size = 25
+		n, err = u.three.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "four":
+		if u.four == nil {
+			return 0, fmt.Errorf("field \"four\" is required")
+		}
+		size = 23
+		n, err = u.four.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
 	}
-	return safejson.Marshal(ser)
+	return size, nil
+}
+
+func (u Union) MarshalJSON() ([]byte, error) {
+	return u.AppendJSON(nil)
 }
 
 func (u *Union) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Union
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*u = v
+			return nil
+		}
+	}
 	var deser unionDeserializer
 	if err := safejson.Unmarshal(data, &deser); err != nil {
 		return err
@@ -108,6 +170,211 @@ func (u *Union) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (u *Union) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenType, seenOne, seenTwo, seenThree, seenFour bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !unmarshalJSONString(field, &u.typ) {
+				ok = false
+				return false
+			}
+		case "one":
+			if seenOne {
+				ok = false
+				return false
+			}
+			seenOne = true
+			if field.Type != gjson.Null {
+				var v api.Struct1
+				if safejson.Unmarshal([]byte(field.Raw), &v) != nil {
+					ok = false
+					return false
+				}
+				u.one = &v
+			}
+		case "two":
+			if seenTwo {
+				ok = false
+				return false
+			}
+			seenTwo = true
+			if field.Type != gjson.Null {
+				var v1 api1.Struct2
+				if safejson.Unmarshal([]byte(field.Raw), &v1) != nil {
+					ok = false
+					return false
+				}
+				u.two = &v1
+			}
+		case "three":
+			if seenThree {
+				ok = false
+				return false
+			}
+			seenThree = true
+			if field.Type != gjson.Null {
+				var v2 v2.ObjectInPackageEndingInVersion
+				if safejson.Unmarshal([]byte(field.Raw), &v2) != nil {
+					ok = false
+					return false
+				}
+				u.three = &v2
+			}
+		case "four":
+			if seenFour {
+				ok = false
+				return false
+			}
+			seenFour = true
+			if field.Type != gjson.Null {
+				var v3 v21.DifferentPackageEndingInVersion
+				if safejson.Unmarshal([]byte(field.Raw), &v3) != nil {
+					ok = false
+					return false
+				}
+				u.four = &v3
+			}
+		default:
+			if matchesJSONField(key.Str, "type", "one", "two", "three", "four") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	switch u.typ {
+	case "one":
+		if u.one == nil {
+			return false
+		}
+	case "two":
+		if u.two == nil {
+			return false
+		}
+	case "three":
+		if u.three == nil {
+			return false
+		}
+	case "four":
+		if u.four == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *Union) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return u.decodeJSONStrict(value)
+}
+
+func (u *Union) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	var typ string
+	var variantName string
+	var variant gjson.Result
+	var seenType, seenVariant bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch {
+		case key.Str == "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			typ, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"type\": %w", err)
+				return false
+			}
+			return true
+		case seenVariant && key.Str == variantName:
+			err = fmt.Errorf("duplicate field %q", key.Str)
+			return false
+		case seenVariant:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		variantName, variant, seenVariant = key.Str, field, true
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenType {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	if seenVariant && variantName != typ {
+		return fmt.Errorf("unknown field %q", variantName)
+	}
+	*u = Union{typ: typ}
+	switch typ {
+	case "one":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"one\" is required")
+		}
+		var v api.Struct1
+		err = v.UnmarshalJSONStrict([]byte(variant.Raw))
+		if err != nil {
+			return fmt.Errorf("field \"one\": %w", err)
+		}
+		u.one = &v
+	case "two":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"two\" is required")
+		}
+		var v1 api1.Struct2
+		err = v1.UnmarshalJSONStrict([]byte(variant.Raw))
+		if err != nil {
+			return fmt.Errorf("field \"two\": %w", err)
+		}
+		u.two = &v1
+	case "three":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"three\" is required")
+		}
+		var v2 v2.ObjectInPackageEndingInVersion
+		err = v2.UnmarshalJSONStrict([]byte(variant.Raw))
+		if err != nil {
+			return fmt.Errorf("field \"three\": %w", err)
+		}
+		u.three = &v2
+	case "four":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"four\" is required")
+		}
+		var v3 v21.DifferentPackageEndingInVersion
+		err = v3.UnmarshalJSONStrict([]byte(variant.Raw))
+		if err != nil {
+			return fmt.Errorf("field \"four\": %w", err)
+		}
+		u.four = &v3
+	}
+	return nil
+}
+
 func (u Union) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(u)
 	if err != nil {
@@ -265,3 +532,48 @@ func NewUnionFromThree(v v2.ObjectInPackageEndingInVersion) Union {
 func NewUnionFromFour(v v21.DifferentPackageEndingInVersion) Union {
 	return Union{typ: "four", four: &v}
 }
+
+// Equal returns true if the Union is equal to other according to the Conjure semantics of its values.
+func (u Union) Equal(other Union) bool {
+	if u.typ != other.typ {
+		return false
+	}
+	if (u.one == nil) != (other.one == nil) {
+		return false
+	}
+	if u.one != nil {
+		if !(*u.one).Equal(*other.one) {
+			return false
+		}
+	}
+	if (u.two == nil) != (other.two == nil) {
+		return false
+	}
+	if u.two != nil {
+		if !(*u.two).Equal(*other.two) {
+			return false
+		}
+	}
+	if (u.three == nil) != (other.three == nil) {
+		return false
+	}
+	if u.three != nil {
+		if !(*u.three).Equal(*other.three) {
+			return false
+		}
+	}
+	if (u.four == nil) != (other.four == nil) {
+		return false
+	}
+	if u.four != nil {
+		if !(*u.four).Equal(*other.four) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Union.
+func (u Union) Clone() Union {
+	return u
+}
@@ -3,14 +3,108 @@
 package v2
 
 import (
+	"fmt"
+
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type DifferentPackageEndingInVersion struct {
 	Name string `json:"name"`
 }
 
+func (o DifferentPackageEndingInVersion) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"name\":"...)
+	out = appendJSONString(out, o.Name)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o DifferentPackageEndingInVersion) JSONSize() (int, error) {
+	size := 9
+	size += jsonStringSize(o.Name)
+	return size, nil
+}
+
+func (o *DifferentPackageEndingInVersion) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenName bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "name":
+			if seenName {
+				ok = false
+				return false
+			}
+			seenName = true
+			if !unmarshalJSONString(field, &o.Name) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "name") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *DifferentPackageEndingInVersion) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *DifferentPackageEndingInVersion) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = DifferentPackageEndingInVersion{}
+	var seenName bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "name":
+			if seenName {
+				err = fmt.Errorf("duplicate field \"name\"")
+				return false
+			}
+			seenName = true
+			o.Name, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"name\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenName {
+		return fmt.Errorf("field \"name\" is required")
+	}
+	return nil
+}
+
 func (o DifferentPackageEndingInVersion) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -26,3 +120,17 @@ func (o *DifferentPackageEndingInVersion) UnmarshalYAML(unmarshal func(interface
 	}
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
+
+// Equal returns true if the DifferentPackageEndingInVersion is equal to other according to the Conjure semantics of its values.
+func (o DifferentPackageEndingInVersion) Equal(other DifferentPackageEndingInVersion) bool {
+	if o.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the DifferentPackageEndingInVersion.
+func (o DifferentPackageEndingInVersion) Clone() DifferentPackageEndingInVersion {
+	out := o
+	return out
+}
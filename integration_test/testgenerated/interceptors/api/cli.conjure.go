@@ -0,0 +1,201 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	"github.com/palantir/pkg/bearertoken"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	wlogzap "github.com/palantir/witchcraft-go-logging/wlog-zap"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/trclog/trc1log"
+	"github.com/palantir/witchcraft-go-tracing/wtracing"
+	"github.com/palantir/witchcraft-go-tracing/wzipkin"
+	"github.com/spf13/cobra"
+	pflag "github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+type CLIConfig struct {
+	Client httpclient.ClientConfig `yaml:",inline"`
+}
+
+// Commands for InterceptedService
+
+type CLIInterceptedServiceClientProvider interface {
+	Get(ctx context.Context, flags *pflag.FlagSet) (InterceptedServiceClient, error)
+}
+
+type defaultCLIInterceptedServiceClientProvider struct{}
+
+func NewDefaultCLIInterceptedServiceClientProvider() CLIInterceptedServiceClientProvider {
+	return defaultCLIInterceptedServiceClientProvider{}
+}
+
+func (d defaultCLIInterceptedServiceClientProvider) Get(ctx context.Context, flags *pflag.FlagSet) (InterceptedServiceClient, error) {
+	conf, err := loadCLIConfig(ctx, flags)
+	if err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "failed to load CLI configuration file")
+	}
+	client, err := httpclient.NewClient(httpclient.WithConfig(conf.Client))
+	if err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "failed to create client with provided config")
+	}
+	return NewInterceptedServiceClient(client), nil
+}
+
+type InterceptedServiceCLICommand struct {
+	clientProvider CLIInterceptedServiceClientProvider
+}
+
+func NewInterceptedServiceCLICommand() *cobra.Command {
+	return NewInterceptedServiceCLICommandWithClientProvider(NewDefaultCLIInterceptedServiceClientProvider())
+}
+
+func NewInterceptedServiceCLICommandWithClientProvider(clientProvider CLIInterceptedServiceClientProvider) *cobra.Command {
+	rootCmd := &cobra.Command{
+		Short: "Runs commands on the InterceptedService",
+		Use:   "interceptedService",
+	}
+	rootCmd.PersistentFlags().String("conf", "var/conf/configuration.yml", "The configuration file is optional. The default path is ./var/conf/configuration.yml.")
+	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "Enables verbose mode for debugging client connections.")
+
+	cliCommand := InterceptedServiceCLICommand{clientProvider: clientProvider}
+
+	interceptedService_Echo_Cmd := &cobra.Command{
+		RunE:  cliCommand.interceptedService_Echo_CmdRun,
+		Short: "Calls the echo endpoint.",
+		Use:   "echo",
+	}
+	rootCmd.AddCommand(interceptedService_Echo_Cmd)
+	interceptedService_Echo_Cmd.Flags().String("value", "", "Required. ")
+	interceptedService_Echo_Cmd.Flags().String("bearer_token", "", "bearer_token is a required field.")
+
+	interceptedService_Disabled_Cmd := &cobra.Command{
+		RunE:  cliCommand.interceptedService_Disabled_CmdRun,
+		Short: "Calls the disabled endpoint.",
+		Use:   "disabled",
+	}
+	rootCmd.AddCommand(interceptedService_Disabled_Cmd)
+
+	interceptedService_Cookie_Cmd := &cobra.Command{
+		RunE:  cliCommand.interceptedService_Cookie_CmdRun,
+		Short: "Calls the cookie endpoint.",
+		Use:   "cookie",
+	}
+	rootCmd.AddCommand(interceptedService_Cookie_Cmd)
+	interceptedService_Cookie_Cmd.Flags().String("bearer_token", "", "bearer_token is a required field.")
+
+	return rootCmd
+}
+
+func (c InterceptedServiceCLICommand) interceptedService_Echo_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	bearer_tokenRaw, err := flags.GetString("bearer_token")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument __authVar")
+	}
+	if bearer_tokenRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "bearer_token is a required argument")
+	}
+	__authVarArg := bearertoken.Token(bearer_tokenRaw)
+	valueRaw, err := flags.GetString("value")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument value")
+	}
+	if valueRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "value is a required argument")
+	}
+	valueArg := valueRaw
+
+	result, err := client.Echo(ctx, __authVarArg, valueArg)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%v\n", result)
+	return nil
+}
+
+func (c InterceptedServiceCLICommand) interceptedService_Disabled_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	return client.Disabled(ctx)
+}
+
+func (c InterceptedServiceCLICommand) interceptedService_Cookie_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	bearer_tokenRaw, err := flags.GetString("bearer_token")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument __authVar")
+	}
+	if bearer_tokenRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "bearer_token is a required argument")
+	}
+	__authVarArg := bearertoken.Token(bearer_tokenRaw)
+	result, err := client.Cookie(ctx, __authVarArg)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%v\n", result)
+	return nil
+}
+
+func loadCLIConfig(ctx context.Context, flags *pflag.FlagSet) (CLIConfig, error) {
+	var emptyConfig CLIConfig
+	configPath, err := flags.GetString("conf")
+	if err != nil || configPath == "" {
+		return emptyConfig, werror.WrapWithContextParams(ctx, err, "config file location must be specified")
+	}
+	confBytes, err := os.ReadFile(configPath)
+	if err != nil {
+		return emptyConfig, err
+	}
+	var conf CLIConfig
+	err = yaml.Unmarshal(confBytes, &conf)
+	if err != nil {
+		return emptyConfig, err
+	}
+	return conf, nil
+}
+
+func getCLIContext(flags *pflag.FlagSet) context.Context {
+	ctx := context.Background()
+	logProvider := wlog.NewNoopLoggerProvider()
+	logWriter := io.Discard
+	verbose, err := flags.GetBool("verbose")
+	if verbose && err == nil {
+		logProvider = wlogzap.LoggerProvider()
+		logWriter = os.Stdout
+	}
+	wlog.SetDefaultLoggerProvider(logProvider)
+	ctx = svc1log.WithLogger(ctx, svc1log.New(logWriter, wlog.DebugLevel))
+	traceLogger := trc1log.New(logWriter)
+	ctx = trc1log.WithLogger(ctx, traceLogger)
+	ctx = evt2log.WithLogger(ctx, evt2log.New(logWriter))
+	tracer, err := wzipkin.NewTracer(traceLogger)
+	if err != nil {
+		return ctx
+	}
+	return wtracing.ContextWithTracer(ctx, tracer)
+}
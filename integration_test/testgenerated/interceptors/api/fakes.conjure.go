@@ -0,0 +1,108 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/pkg/bearertoken"
+	wparams "github.com/palantir/witchcraft-go-params"
+)
+
+// FakeInterceptedService is an in-memory implementation of InterceptedService for use in tests.
+// Each endpoint records its arguments and then invokes the corresponding <Endpoint>Func field.
+// If the field is nil, the endpoint returns DefaultErr (or a Conjure Internal error if DefaultErr is nil).
+// The zero value is ready to use and all methods are safe for concurrent use.
+type FakeInterceptedService struct {
+	// EchoFunc is invoked by Echo if non-nil.
+	EchoFunc func(ctx context.Context, authHeader bearertoken.Token, valueArg string) (string, error)
+	// DisabledFunc is invoked by Disabled if non-nil.
+	DisabledFunc func(ctx context.Context) error
+	// CookieFunc is invoked by Cookie if non-nil.
+	CookieFunc func(ctx context.Context, cookieToken bearertoken.Token) (string, error)
+	// DefaultErr is returned by endpoints whose func field is nil.
+	DefaultErr error
+
+	mu            sync.Mutex
+	echoCalls     []FakeInterceptedServiceEchoCall
+	disabledCalls []FakeInterceptedServiceDisabledCall
+	cookieCalls   []FakeInterceptedServiceCookieCall
+}
+
+var _ InterceptedService = (*FakeInterceptedService)(nil)
+
+// FakeInterceptedServiceEchoCall records the arguments of a call to FakeInterceptedService.Echo.
+type FakeInterceptedServiceEchoCall struct {
+	AuthHeader bearertoken.Token
+	Value      string
+}
+
+func (f *FakeInterceptedService) Echo(ctx context.Context, authHeader bearertoken.Token, valueArg string) (string, error) {
+	f.mu.Lock()
+	f.echoCalls = append(f.echoCalls, FakeInterceptedServiceEchoCall{AuthHeader: authHeader, Value: valueArg})
+	f.mu.Unlock()
+	if f.EchoFunc != nil {
+		return f.EchoFunc(ctx, authHeader, valueArg)
+	}
+	var defaultReturnVal string
+	return defaultReturnVal, f.defaultErr("echo")
+}
+
+// EchoCalls returns the arguments of every call made to Echo, in call order.
+func (f *FakeInterceptedService) EchoCalls() []FakeInterceptedServiceEchoCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeInterceptedServiceEchoCall(nil), f.echoCalls...)
+}
+
+// FakeInterceptedServiceDisabledCall records the arguments of a call to FakeInterceptedService.Disabled.
+type FakeInterceptedServiceDisabledCall struct{}
+
+func (f *FakeInterceptedService) Disabled(ctx context.Context) error {
+	f.mu.Lock()
+	f.disabledCalls = append(f.disabledCalls, FakeInterceptedServiceDisabledCall{})
+	f.mu.Unlock()
+	if f.DisabledFunc != nil {
+		return f.DisabledFunc(ctx)
+	}
+	return f.defaultErr("disabled")
+}
+
+// DisabledCalls returns the arguments of every call made to Disabled, in call order.
+func (f *FakeInterceptedService) DisabledCalls() []FakeInterceptedServiceDisabledCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeInterceptedServiceDisabledCall(nil), f.disabledCalls...)
+}
+
+// FakeInterceptedServiceCookieCall records the arguments of a call to FakeInterceptedService.Cookie.
+type FakeInterceptedServiceCookieCall struct {
+	CookieToken bearertoken.Token
+}
+
+func (f *FakeInterceptedService) Cookie(ctx context.Context, cookieToken bearertoken.Token) (string, error) {
+	f.mu.Lock()
+	f.cookieCalls = append(f.cookieCalls, FakeInterceptedServiceCookieCall{CookieToken: cookieToken})
+	f.mu.Unlock()
+	if f.CookieFunc != nil {
+		return f.CookieFunc(ctx, cookieToken)
+	}
+	var defaultReturnVal string
+	return defaultReturnVal, f.defaultErr("cookie")
+}
+
+// CookieCalls returns the arguments of every call made to Cookie, in call order.
+func (f *FakeInterceptedService) CookieCalls() []FakeInterceptedServiceCookieCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeInterceptedServiceCookieCall(nil), f.cookieCalls...)
+}
+
+func (f *FakeInterceptedService) defaultErr(endpoint string) error {
+	if f.DefaultErr != nil {
+		return f.DefaultErr
+	}
+	return errors.NewInternal(wparams.NewSafeParamStorer(map[string]interface{}{"fakeEndpoint": endpoint}))
+}
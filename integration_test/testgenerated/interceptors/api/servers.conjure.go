@@ -0,0 +1,145 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/codecs"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-server/httpserver"
+	"github.com/palantir/pkg/bearertoken"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-server/v2/witchcraft/wresource"
+	"github.com/palantir/witchcraft-go-server/v2/wrouter"
+)
+
+type InterceptedService interface {
+	Echo(ctx context.Context, authHeader bearertoken.Token, valueArg string) (string, error)
+	Disabled(ctx context.Context) error
+	Cookie(ctx context.Context, cookieToken bearertoken.Token) (string, error)
+}
+
+// RegisterRoutesInterceptedService registers handlers for the InterceptedService endpoints with a witchcraft wrouter.
+// This should typically be called in a witchcraft server's InitFunc.
+// impl provides an implementation of each endpoint, which can assume the request parameters have been parsed
+// in accordance with the Conjure specification.
+func RegisterRoutesInterceptedService(router wrouter.Router, impl InterceptedService, routerParams ...wrouter.RouteParam) error {
+	handler := interceptedServiceHandler{impl: impl}
+	resource := wresource.New("interceptedservice", router)
+	if err := resource.Post("Echo", "/intercepted/echo/{value}", httpserver.NewJSONHandler(interceptEndpoint(EndpointInfo{Service: "InterceptedService", Name: "echo", HTTPMethod: "POST", HTTPPath: "/intercepted/echo/{value}", Tags: []string{"audited"}, Markers: []string{"com.palantir.logsafe.Safe"}}, handler.HandleEcho), httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add echo route")
+	}
+	if err := resource.Get("Disabled", "/intercepted/disabled", httpserver.NewJSONHandler(interceptEndpoint(EndpointInfo{Service: "InterceptedService", Name: "disabled", HTTPMethod: "GET", HTTPPath: "/intercepted/disabled", Tags: []string{"disabled"}}, handler.HandleDisabled), httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add disabled route")
+	}
+	if err := resource.Get("Cookie", "/intercepted/cookie", httpserver.NewJSONHandler(interceptEndpoint(EndpointInfo{Service: "InterceptedService", Name: "cookie", HTTPMethod: "GET", HTTPPath: "/intercepted/cookie", AuthCookie: "P_TOKEN"}, handler.HandleCookie), httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add cookie route")
+	}
+	return nil
+}
+
+type interceptedServiceHandler struct {
+	impl InterceptedService
+}
+
+func (i *interceptedServiceHandler) HandleEcho(rw http.ResponseWriter, req *http.Request) error {
+	authHeader, err := httpserver.ParseBearerTokenHeader(req)
+	if err != nil {
+		return errors.WrapWithPermissionDenied(err)
+	}
+	pathParams := wrouter.PathParams(req)
+	if pathParams == nil {
+		return werror.Wrap(errors.NewInternal(), "path params not found on request: ensure this endpoint is registered with wrouter")
+	}
+	valueArg, ok := pathParams["value"]
+	if !ok {
+		return werror.WrapWithContextParams(req.Context(), errors.NewInvalidArgument(), "path parameter \"value\" not present")
+	}
+	respArg, err := i.impl.Echo(req.Context(), bearertoken.Token(authHeader), valueArg)
+	if err != nil {
+		return err
+	}
+	rw.Header().Add("Content-Type", codecs.JSON.ContentType())
+	return codecs.JSON.Encode(rw, respArg)
+}
+
+func (i *interceptedServiceHandler) HandleDisabled(rw http.ResponseWriter, req *http.Request) error {
+	if err := i.impl.Disabled(req.Context()); err != nil {
+		return err
+	}
+	rw.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (i *interceptedServiceHandler) HandleCookie(rw http.ResponseWriter, req *http.Request) error {
+	authCookie, err := req.Cookie("P_TOKEN")
+	if err != nil {
+		return errors.WrapWithPermissionDenied(err)
+	}
+	cookieToken := bearertoken.Token(authCookie.Value)
+	respArg, err := i.impl.Cookie(req.Context(), cookieToken)
+	if err != nil {
+		return err
+	}
+	rw.Header().Add("Content-Type", codecs.JSON.ContentType())
+	return codecs.JSON.Encode(rw, respArg)
+}
+
+// EndpointInfo describes the Conjure endpoint that handles a request.
+type EndpointInfo struct {
+	// Service is the name of the Conjure service of the endpoint.
+	Service string
+	// Name is the name of the endpoint.
+	Name string
+	// HTTPMethod is the HTTP method of the endpoint.
+	HTTPMethod string
+	// HTTPPath is the path template of the endpoint.
+	HTTPPath string
+	// AuthCookie is the name of the cookie that contains the token of the request. It is empty if the token is
+	// provided in the Authorization header or the endpoint does not require authentication.
+	AuthCookie string
+	// Tags are the tags of the endpoint.
+	Tags []string
+	// Markers are the names of the markers of the endpoint, such as "com.palantir.logsafe.Safe".
+	Markers []string
+}
+
+// EndpointInterceptor intercepts the requests to the routes it is configured for using WithEndpointInterceptor.
+// It is called before the request is decoded and must either call next, with ctx or a context derived from it,
+// to handle the request or return an error to reject it. Errors are returned to the client like errors of
+// endpoint implementations.
+type EndpointInterceptor func(ctx context.Context, endpoint EndpointInfo, next func(ctx context.Context) error) error
+
+// WithEndpointInterceptor returns a route parameter that configures the handlers registered by the RegisterRoutes
+// functions of this package to call interceptor. If multiple interceptors are configured, they are called in the
+// order in which they are provided.
+func WithEndpointInterceptor(interceptor EndpointInterceptor) wrouter.RouteParam {
+	return wrouter.RouteMiddleware(func(rw http.ResponseWriter, req *http.Request, reqVals wrouter.RequestVals, next wrouter.RouteRequestHandler) {
+		interceptors, _ := req.Context().Value(endpointInterceptorsContextKey{}).([]EndpointInterceptor)
+		interceptors = append(interceptors[:len(interceptors):len(interceptors)], interceptor)
+		ctx := context.WithValue(req.Context(), endpointInterceptorsContextKey{}, interceptors)
+		next(rw, req.WithContext(ctx), reqVals)
+	})
+}
+
+type endpointInterceptorsContextKey struct{}
+
+// interceptEndpoint returns a function that calls the interceptors configured using WithEndpointInterceptor,
+// if any, before it calls handle.
+func interceptEndpoint(endpoint EndpointInfo, handle func(http.ResponseWriter, *http.Request) error) func(http.ResponseWriter, *http.Request) error {
+	return func(rw http.ResponseWriter, req *http.Request) error {
+		interceptors, _ := req.Context().Value(endpointInterceptorsContextKey{}).([]EndpointInterceptor)
+		next := func(ctx context.Context) error {
+			return handle(rw, req.WithContext(ctx))
+		}
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(ctx context.Context) error {
+				return interceptor(ctx, endpoint, inner)
+			}
+		}
+		return next(req.Context())
+	}
+}
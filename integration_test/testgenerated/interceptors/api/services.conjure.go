@@ -0,0 +1,102 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	"github.com/palantir/pkg/bearertoken"
+	werror "github.com/palantir/witchcraft-go-error"
+)
+
+type InterceptedServiceClient interface {
+	Echo(ctx context.Context, authHeader bearertoken.Token, valueArg string) (string, error)
+	Disabled(ctx context.Context) error
+	Cookie(ctx context.Context, cookieToken bearertoken.Token) (string, error)
+}
+
+type interceptedServiceClient struct {
+	client httpclient.Client
+}
+
+func NewInterceptedServiceClient(client httpclient.Client) InterceptedServiceClient {
+	return &interceptedServiceClient{client: client}
+}
+
+func (c *interceptedServiceClient) Echo(ctx context.Context, authHeader bearertoken.Token, valueArg string) (string, error) {
+	var defaultReturnVal string
+	var returnVal *string
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("Echo"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("POST"))
+	requestParams = append(requestParams, httpclient.WithHeader("Authorization", fmt.Sprint("Bearer ", authHeader)))
+	requestParams = append(requestParams, httpclient.WithPathf("/intercepted/echo/%s", url.PathEscape(fmt.Sprint(valueArg))))
+	requestParams = append(requestParams, httpclient.WithJSONResponse(&returnVal))
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return defaultReturnVal, werror.WrapWithContextParams(ctx, err, "echo failed")
+	}
+	if returnVal == nil {
+		return defaultReturnVal, werror.ErrorWithContextParams(ctx, "echo response cannot be nil")
+	}
+	return *returnVal, nil
+}
+
+func (c *interceptedServiceClient) Disabled(ctx context.Context) error {
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("Disabled"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("GET"))
+	requestParams = append(requestParams, httpclient.WithPathf("/intercepted/disabled"))
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return werror.WrapWithContextParams(ctx, err, "disabled failed")
+	}
+	return nil
+}
+
+func (c *interceptedServiceClient) Cookie(ctx context.Context, cookieToken bearertoken.Token) (string, error) {
+	var defaultReturnVal string
+	var returnVal *string
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("Cookie"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("GET"))
+	requestParams = append(requestParams, httpclient.WithHeader("Cookie", fmt.Sprint("P_TOKEN=", cookieToken)))
+	requestParams = append(requestParams, httpclient.WithPathf("/intercepted/cookie"))
+	requestParams = append(requestParams, httpclient.WithJSONResponse(&returnVal))
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return defaultReturnVal, werror.WrapWithContextParams(ctx, err, "cookie failed")
+	}
+	if returnVal == nil {
+		return defaultReturnVal, werror.ErrorWithContextParams(ctx, "cookie response cannot be nil")
+	}
+	return *returnVal, nil
+}
+
+type InterceptedServiceClientWithAuth interface {
+	Echo(ctx context.Context, valueArg string) (string, error)
+	Disabled(ctx context.Context) error
+	Cookie(ctx context.Context) (string, error)
+}
+
+func NewInterceptedServiceClientWithAuth(client InterceptedServiceClient, authHeader bearertoken.Token, cookieToken bearertoken.Token) InterceptedServiceClientWithAuth {
+	return &interceptedServiceClientWithAuth{client: client, authHeader: authHeader, cookieToken: cookieToken}
+}
+
+type interceptedServiceClientWithAuth struct {
+	client      InterceptedServiceClient
+	authHeader  bearertoken.Token
+	cookieToken bearertoken.Token
+}
+
+func (c *interceptedServiceClientWithAuth) Echo(ctx context.Context, valueArg string) (string, error) {
+	return c.client.Echo(ctx, c.authHeader, valueArg)
+}
+
+func (c *interceptedServiceClientWithAuth) Disabled(ctx context.Context) error {
+	return c.client.Disabled(ctx)
+}
+
+func (c *interceptedServiceClientWithAuth) Cookie(ctx context.Context) (string, error) {
+	return c.client.Cookie(ctx, c.cookieToken)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/conjure-go/v6/integration_test/internal/testutil"
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/interceptors/api"
+	"github.com/palantir/pkg/bearertoken"
+	"github.com/palantir/witchcraft-go-server/v2/witchcraft"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type callerKey struct{}
+
+func TestEndpointInterceptors(t *testing.T) {
+	ctx := testutil.TestContext()
+	var calls []string
+	var endpoints []api.EndpointInfo
+	first := api.EndpointInterceptor(func(ctx context.Context, endpoint api.EndpointInfo, next func(context.Context) error) error {
+		calls = append(calls, "first")
+		endpoints = append(endpoints, endpoint)
+		for _, tag := range endpoint.Tags {
+			if tag == "disabled" {
+				return errors.NewPermissionDenied()
+			}
+		}
+		return next(context.WithValue(ctx, callerKey{}, "caller"))
+	})
+	second := api.EndpointInterceptor(func(ctx context.Context, endpoint api.EndpointInfo, next func(context.Context) error) error {
+		calls = append(calls, "second")
+		return next(ctx)
+	})
+	httpClient, cleanup := testutil.StartTestServer(t, func(ctx context.Context, info witchcraft.InitInfo) (cleanup func(), rErr error) {
+		if err := api.RegisterRoutesInterceptedService(info.Router, interceptedImpl{}, api.WithEndpointInterceptor(first), api.WithEndpointInterceptor(second)); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	})
+	defer cleanup()
+	client := api.NewInterceptedServiceClient(httpClient)
+
+	t.Run("intercepted", func(t *testing.T) {
+		calls, endpoints = nil, nil
+		resp, err := client.Echo(ctx, "token", "value")
+		require.NoError(t, err)
+		assert.Equal(t, "value from caller", resp)
+		assert.Equal(t, []string{"first", "second"}, calls)
+		assert.Equal(t, []api.EndpointInfo{{
+			Service:    "InterceptedService",
+			Name:       "echo",
+			HTTPMethod: "POST",
+			HTTPPath:   "/intercepted/echo/{value}",
+			Tags:       []string{"audited"},
+			Markers:    []string{"com.palantir.logsafe.Safe"},
+		}}, endpoints)
+	})
+	t.Run("cookie", func(t *testing.T) {
+		calls, endpoints = nil, nil
+		resp, err := client.Cookie(ctx, "token")
+		require.NoError(t, err)
+		assert.Equal(t, "caller", resp)
+		assert.Equal(t, []api.EndpointInfo{{
+			Service:    "InterceptedService",
+			Name:       "cookie",
+			HTTPMethod: "GET",
+			HTTPPath:   "/intercepted/cookie",
+			AuthCookie: "P_TOKEN",
+		}}, endpoints)
+	})
+	t.Run("rejected", func(t *testing.T) {
+		calls, endpoints = nil, nil
+		err := client.Disabled(ctx)
+		require.Error(t, err)
+		assert.Equal(t, errors.PermissionDenied, errors.GetConjureError(err).Code())
+		assert.Equal(t, []string{"first"}, calls)
+	})
+}
+
+type interceptedImpl struct{}
+
+func (interceptedImpl) Echo(ctx context.Context, authHeader bearertoken.Token, valueArg string) (string, error) {
+	return valueArg + " from " + caller(ctx), nil
+}
+
+func (interceptedImpl) Disabled(ctx context.Context) error {
+	return errors.NewInternal()
+}
+
+func (interceptedImpl) Cookie(ctx context.Context, cookieToken bearertoken.Token) (string, error) {
+	return caller(ctx), nil
+}
+
+func caller(ctx context.Context) string {
+	caller, _ := ctx.Value(callerKey{}).(string)
+	return caller
+}
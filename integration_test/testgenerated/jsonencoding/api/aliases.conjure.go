@@ -0,0 +1,418 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"bytes"
+	"encoding/base64"
+	"time"
+
+	"github.com/palantir/pkg/binary"
+	"github.com/palantir/pkg/datetime"
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/palantir/pkg/uuid"
+	"github.com/tidwall/gjson"
+)
+
+type Id uuid.UUID
+
+func (a Id) AppendJSON(out []byte) ([]byte, error) {
+	out = appendJSONUUID(out, uuid.UUID(a))
+	return out, nil
+}
+
+func (a Id) JSONSize() (int, error) {
+	var size int
+	size += 38
+	return size, nil
+}
+
+func (a Id) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a Id) String() string {
+	return uuid.UUID(a).String()
+}
+
+func (a Id) MarshalText() ([]byte, error) {
+	return uuid.UUID(a).MarshalText()
+}
+
+func (a *Id) UnmarshalText(data []byte) error {
+	var rawId uuid.UUID
+	if err := rawId.UnmarshalText(data); err != nil {
+		return err
+	}
+	*a = Id(rawId)
+	return nil
+}
+
+func (a Id) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *Id) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+func (a *Id) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *Id) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v uuid.UUID
+	v, err = decodeJSONUUID(value)
+	if err != nil {
+		return err
+	}
+	*a = Id(v)
+	return nil
+}
+
+// Equal returns true if the Id is equal to other according to the Conjure semantics of its values.
+func (a Id) Equal(other Id) bool {
+	return a == other
+}
+
+// Clone returns a deep copy of the Id.
+func (a Id) Clone() Id {
+	return a
+}
+
+type Name string
+
+func (a *Name) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *Name) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v string
+	v, err = decodeJSONString(value)
+	if err != nil {
+		return err
+	}
+	*a = Name(v)
+	return nil
+}
+
+// Equal returns true if the Name is equal to other according to the Conjure semantics of its values.
+func (a Name) Equal(other Name) bool {
+	return string(a) == string(other)
+}
+
+// Clone returns a deep copy of the Name.
+func (a Name) Clone() Name {
+	return a
+}
+
+type Note struct {
+	Value *string
+}
+
+func (a Note) MarshalText() ([]byte, error) {
+	if a.Value == nil {
+		return nil, nil
+	}
+	return []byte(*a.Value), nil
+}
+
+func (a Note) AppendJSON(out []byte) ([]byte, error) {
+	if a.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, *a.Value)
+	}
+	return out, nil
+}
+
+func (a Note) JSONSize() (int, error) {
+	var size int
+	if a.Value == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(*a.Value)
+	}
+	return size, nil
+}
+
+func (a Note) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *Note) UnmarshalText(data []byte) error {
+	rawNote := string(data)
+	a.Value = &rawNote
+	return nil
+}
+
+func (a *Note) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *Note) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *string
+	if value.Type != gjson.Null {
+		var v1 string
+		v1, err = decodeJSONString(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = Note{Value: v}
+	return nil
+}
+
+func (a Note) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *Note) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+// Equal returns true if the Note is equal to other according to the Conjure semantics of its values.
+func (a Note) Equal(other Note) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if *a.Value != *other.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Note.
+func (a Note) Clone() Note {
+	out := a
+	if a.Value != nil {
+		v := *a.Value
+		out.Value = &v
+	}
+	return out
+}
+
+type Payload []byte
+
+func (a Payload) AppendJSON(out []byte) ([]byte, error) {
+	return appendJSONBinary(out, a), nil
+}
+
+func (a Payload) JSONSize() (int, error) {
+	return base64.StdEncoding.EncodedLen(len(a)) + 2, nil
+}
+
+func (a Payload) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a Payload) String() string {
+	return binary.New(a).String()
+}
+
+func (a Payload) MarshalText() ([]byte, error) {
+	return binary.New(a).MarshalText()
+}
+
+func (a *Payload) UnmarshalText(data []byte) error {
+	rawPayload, err := binary.Binary(data).Bytes()
+	if err != nil {
+		return err
+	}
+	*a = Payload(rawPayload)
+	return nil
+}
+
+func (a Payload) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *Payload) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+func (a *Payload) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *Payload) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []byte
+	v, err = decodeJSONBinary(value)
+	if err != nil {
+		return err
+	}
+	*a = Payload(v)
+	return nil
+}
+
+// Equal returns true if the Payload is equal to other according to the Conjure semantics of its values.
+func (a Payload) Equal(other Payload) bool {
+	return bytes.Equal(a, other)
+}
+
+// Clone returns a deep copy of the Payload.
+func (a Payload) Clone() Payload {
+	var out Payload
+	if a != nil {
+		out = make(Payload, len(a))
+		copy(out, a)
+	}
+	return out
+}
+
+type Timestamps []datetime.DateTime
+
+func (a Timestamps) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, '[')
+	for i, v := range []datetime.DateTime(a) {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONDateTime(out, v)
+	}
+	out = append(out, ']')
+	return out, nil
+}
+
+func (a Timestamps) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len([]datetime.DateTime(a)) > 1 {
+		size += len([]datetime.DateTime(a)) - 1
+	}
+	for _, v := range []datetime.DateTime(a) {
+		size += jsonDateTimeSize(v)
+	}
+	return size, nil
+}
+
+func (a Timestamps) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *Timestamps) UnmarshalJSON(data []byte) error {
+	var rawTimestamps []datetime.DateTime
+	if err := safejson.Unmarshal(data, &rawTimestamps); err != nil {
+		return err
+	}
+	*a = Timestamps(rawTimestamps)
+	return nil
+}
+
+func (a Timestamps) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *Timestamps) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+func (a *Timestamps) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *Timestamps) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []datetime.DateTime
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]datetime.DateTime, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 datetime.DateTime
+		v1, err = decodeJSONDateTime(elem)
+		if err != nil {
+			return false
+		}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = Timestamps(v)
+	return nil
+}
+
+// Equal returns true if the Timestamps is equal to other according to the Conjure semantics of its values.
+func (a Timestamps) Equal(other Timestamps) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for i := range a {
+		if !time.Time(a[i]).Equal(time.Time(other[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Timestamps.
+func (a Timestamps) Clone() Timestamps {
+	var out Timestamps
+	if a != nil {
+		out = make(Timestamps, len(a))
+		copy(out, a)
+	}
+	return out
+}
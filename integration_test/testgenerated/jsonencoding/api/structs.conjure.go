@@ -0,0 +1,2862 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/palantir/pkg/bearertoken"
+	"github.com/palantir/pkg/datetime"
+	"github.com/palantir/pkg/rid"
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safelong"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/palantir/pkg/uuid"
+	"github.com/tidwall/gjson"
+)
+
+type Circle struct {
+	Radius float64 `json:"radius"`
+}
+
+func (o Circle) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"radius\":"...)
+	out = appendJSONFloat64(out, o.Radius)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Circle) JSONSize() (int, error) {
+	size := 11
+	size += jsonFloat64Size(o.Radius)
+	return size, nil
+}
+
+func (o *Circle) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenRadius bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "radius":
+			if seenRadius {
+				ok = false
+				return false
+			}
+			seenRadius = true
+			if !unmarshalJSONFloat64(field, &o.Radius) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "radius") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *Circle) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Circle) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Circle{}
+	var seenRadius bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "radius":
+			if seenRadius {
+				err = fmt.Errorf("duplicate field \"radius\"")
+				return false
+			}
+			seenRadius = true
+			o.Radius, err = decodeJSONFloat64(field)
+			if err != nil {
+				err = fmt.Errorf("field \"radius\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenRadius {
+		return fmt.Errorf("field \"radius\" is required")
+	}
+	return nil
+}
+
+func (o Circle) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *Circle) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the Circle is equal to other according to the Conjure semantics of its values.
+func (o Circle) Equal(other Circle) bool {
+	if !(o.Radius == other.Radius || math.IsNaN(o.Radius) && math.IsNaN(other.Radius)) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Circle.
+func (o Circle) Clone() Circle {
+	out := o
+	return out
+}
+
+type Everything struct {
+	Primitives     Primitives                      `json:"primitives"`
+	OptionalString *string                         `json:"optionalString"`
+	OptionalCircle *Circle                         `json:"optionalCircle"`
+	Strings        []string                        `json:"strings"`
+	Uuids          []uuid.UUID                     `json:"uuids"`
+	UuidMap        map[uuid.UUID]string            `json:"uuidMap"`
+	ColorMap       map[Color]int                   `json:"colorMap"`
+	IntMap         map[int][]float64               `json:"intMap"`
+	RidMap         map[rid.ResourceIdentifier]bool `json:"ridMap"`
+	DatetimeMap    map[datetime.DateTime]uuid.UUID `json:"datetimeMap"`
+	NestedMap      map[string]map[string]*Circle   `json:"nestedMap"`
+	AliasMap       map[Name]Id                     `json:"aliasMap"`
+	Shapes         []Shape                         `json:"shapes"`
+	Color          Color                           `json:"color"`
+	Name           Name                            `json:"name"`
+	Id             Id                              `json:"id"`
+	Note           Note                            `json:"note"`
+	Timestamps     Timestamps                      `json:"timestamps"`
+	Payload        Payload                         `json:"payload"`
+}
+
+func (o Everything) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"primitives\":"...)
+	out, err = o.Primitives.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"optionalString\":"...)
+	if o.OptionalString == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, *o.OptionalString)
+	}
+	out = append(out, ",\"optionalCircle\":"...)
+	if o.OptionalCircle == nil {
+		out = append(out, "null"...)
+	} else {
+		out, err = o.OptionalCircle.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ",\"strings\":"...)
+	out = append(out, '[')
+	for i, v := range o.Strings {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
+	}
+	out = append(out, ']')
+	out = append(out, ",\"uuids\":"...)
+	out = append(out, '[')
+	for i, v := range o.Uuids {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONUUID(out, v)
+	}
+	out = append(out, ']')
+	out = append(out, ",\"uuidMap\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value string
+		}
+		entries := make([]mapEntry, 0, len(o.UuidMap))
+		for k, v := range o.UuidMap {
+			entries = append(entries, mapEntry{key: k.String(), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = appendJSONString(out, entry.value)
+		}
+	}
+	out = append(out, '}')
+	out = append(out, ",\"colorMap\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value int
+		}
+		entries := make([]mapEntry, 0, len(o.ColorMap))
+		for k, v := range o.ColorMap {
+			entries = append(entries, mapEntry{key: k.String(), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = strconv.AppendInt(out, int64(entry.value), 10)
+		}
+	}
+	out = append(out, '}')
+	out = append(out, ",\"intMap\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value []float64
+		}
+		entries := make([]mapEntry, 0, len(o.IntMap))
+		for k, v := range o.IntMap {
+			entries = append(entries, mapEntry{key: strconv.Itoa(int(k)), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = append(out, '[')
+			for i1, v1 := range entry.value {
+				if i1 > 0 {
+					out = append(out, ',')
+				}
+				out = appendJSONFloat64(out, v1)
+			}
+			out = append(out, ']')
+		}
+	}
+	out = append(out, '}')
+	out = append(out, ",\"ridMap\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value bool
+		}
+		entries := make([]mapEntry, 0, len(o.RidMap))
+		for k, v := range o.RidMap {
+			keyText, err := k.MarshalText()
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, mapEntry{key: string(keyText), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = strconv.AppendBool(out, entry.value)
+		}
+	}
+	out = append(out, '}')
+	out = append(out, ",\"datetimeMap\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value uuid.UUID
+		}
+		entries := make([]mapEntry, 0, len(o.DatetimeMap))
+		for k, v := range o.DatetimeMap {
+			entries = append(entries, mapEntry{key: k.String(), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = appendJSONUUID(out, entry.value)
+		}
+	}
+	out = append(out, '}')
+	out = append(out, ",\"nestedMap\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value map[string]*Circle
+		}
+		entries := make([]mapEntry, 0, len(o.NestedMap))
+		for k, v := range o.NestedMap {
+			entries = append(entries, mapEntry{key: k, value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = append(out, '{')
+			{
+				type mapEntry1 struct {
+					key   string
+					value *Circle
+				}
+				entries1 := make([]mapEntry1, 0, len(entry.value))
+				for k1, v1 := range entry.value {
+					entries1 = append(entries1, mapEntry1{key: k1, value: v1})
+				}
+				sort.Slice(entries1, func(i, j int) bool {
+					return entries1[i].key < entries1[j].key
+				})
+				for i1, entry1 := range entries1 {
+					if i1 > 0 {
+						out = append(out, ',')
+					}
+					out = appendJSONString(out, entry1.key)
+					out = append(out, ':')
+					if entry1.value == nil {
+						out = append(out, "null"...)
+					} else {
+						out, err = entry1.value.AppendJSON(out)
+						if err != nil {
+							return nil, err
+						}
+					}
+				}
+			}
+			out = append(out, '}')
+		}
+	}
+	out = append(out, '}')
+	out = append(out, ",\"aliasMap\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value Id
+		}
+		entries := make([]mapEntry, 0, len(o.AliasMap))
+		for k, v := range o.AliasMap {
+			entries = append(entries, mapEntry{key: string(k), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out, err = entry.value.AppendJSON(out)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	out = append(out, '}')
+	out = append(out, ",\"shapes\":"...)
+	out = append(out, '[')
+	for i, v := range o.Shapes {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	out = append(out, ",\"color\":"...)
+	out, err = o.Color.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"name\":"...)
+	out = appendJSONString(out, string(o.Name))
+	out = append(out, ",\"id\":"...)
+	out, err = o.Id.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"note\":"...)
+	out, err = o.Note.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"timestamps\":"...)
+	out, err = o.Timestamps.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"payload\":"...)
+	out, err = o.Payload.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Everything) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 220
+	n, err = o.Primitives.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	if o.OptionalString == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(*o.OptionalString)
+	}
+	if o.OptionalCircle == nil {
+		size += 4
+	} else {
+		n, err = o.OptionalCircle.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 2
+	if len(o.Strings) > 1 {
+		size += len(o.Strings) - 1
+	}
+	for _, v := range o.Strings {
+		size += jsonStringSize(v)
+	}
+	size += 2
+	if len(o.Uuids) > 0 {
+		size += len(o.Uuids)*39 - 1
+	}
+	size += 2
+	if len(o.UuidMap) > 1 {
+		size += len(o.UuidMap) - 1
+	}
+	size += len(o.UuidMap) * 39
+	for _, v := range o.UuidMap {
+		size += jsonStringSize(v)
+	}
+	size += 2
+	if len(o.ColorMap) > 1 {
+		size += len(o.ColorMap) - 1
+	}
+	for k, v := range o.ColorMap {
+		size += jsonStringSize(k.String()) + 1
+		size += jsonIntSize(int64(v))
+	}
+	size += 2
+	if len(o.IntMap) > 1 {
+		size += len(o.IntMap) - 1
+	}
+	for k, v := range o.IntMap {
+		size += jsonStringSize(strconv.Itoa(int(k))) + 1
+		size += 2
+		if len(v) > 1 {
+			size += len(v) - 1
+		}
+		for _, v1 := range v {
+			size += jsonFloat64Size(v1)
+		}
+	}
+	size += 2
+	if len(o.RidMap) > 1 {
+		size += len(o.RidMap) - 1
+	}
+	for k, v := range o.RidMap {
+		keyText, err := k.MarshalText()
+		if err != nil {
+			return 0, err
+		}
+		size += jsonStringSize(string(keyText)) + 1
+		if v {
+			size += 4
+		} else {
+			size += 5
+		}
+	}
+	size += 2
+	if len(o.DatetimeMap) > 1 {
+		size += len(o.DatetimeMap) - 1
+	}
+	size += len(o.DatetimeMap) * 38
+	for k := range o.DatetimeMap {
+		size += jsonStringSize(k.String()) + 1
+	}
+	size += 2
+	if len(o.NestedMap) > 1 {
+		size += len(o.NestedMap) - 1
+	}
+	for k, v := range o.NestedMap {
+		size += jsonStringSize(k) + 1
+		size += 2
+		if len(v) > 1 {
+			size += len(v) - 1
+		}
+		for k1, v1 := range v {
+			size += jsonStringSize(k1) + 1
+			if v1 == nil {
+				size += 4
+			} else {
+				n, err = v1.JSONSize()
+				if err != nil {
+					return 0, err
+				}
+				size += n
+			}
+		}
+	}
+	size += 2
+	if len(o.AliasMap) > 1 {
+		size += len(o.AliasMap) - 1
+	}
+	for k, v := range o.AliasMap {
+		size += jsonStringSize(string(k)) + 1
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 2
+	if len(o.Shapes) > 1 {
+		size += len(o.Shapes) - 1
+	}
+	for _, v := range o.Shapes {
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	n, err = o.Color.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	size += jsonStringSize(string(o.Name))
+	n, err = o.Id.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.Note.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.Timestamps.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.Payload.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (o Everything) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
+}
+
+func (o *Everything) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Everything
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
+	type EverythingAlias Everything
+	var rawEverything EverythingAlias
+	if err := safejson.Unmarshal(data, &rawEverything); err != nil {
+		return err
+	}
+	if rawEverything.Strings == nil {
+		rawEverything.Strings = make([]string, 0)
+	}
+	if rawEverything.Uuids == nil {
+		rawEverything.Uuids = make([]uuid.UUID, 0)
+	}
+	if rawEverything.UuidMap == nil {
+		rawEverything.UuidMap = make(map[uuid.UUID]string, 0)
+	}
+	if rawEverything.ColorMap == nil {
+		rawEverything.ColorMap = make(map[Color]int, 0)
+	}
+	if rawEverything.IntMap == nil {
+		rawEverything.IntMap = make(map[int][]float64, 0)
+	}
+	if rawEverything.RidMap == nil {
+		rawEverything.RidMap = make(map[rid.ResourceIdentifier]bool, 0)
+	}
+	if rawEverything.DatetimeMap == nil {
+		rawEverything.DatetimeMap = make(map[datetime.DateTime]uuid.UUID, 0)
+	}
+	if rawEverything.NestedMap == nil {
+		rawEverything.NestedMap = make(map[string]map[string]*Circle, 0)
+	}
+	if rawEverything.AliasMap == nil {
+		rawEverything.AliasMap = make(map[Name]Id, 0)
+	}
+	if rawEverything.Shapes == nil {
+		rawEverything.Shapes = make([]Shape, 0)
+	}
+	if rawEverything.Timestamps == nil {
+		rawEverything.Timestamps = make([]datetime.DateTime, 0)
+	}
+	*o = Everything(rawEverything)
+	return nil
+}
+
+func (o *Everything) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Strings == nil {
+			o.Strings = make([]string, 0)
+		}
+		if o.Uuids == nil {
+			o.Uuids = make([]uuid.UUID, 0)
+		}
+		if o.UuidMap == nil {
+			o.UuidMap = make(map[uuid.UUID]string, 0)
+		}
+		if o.ColorMap == nil {
+			o.ColorMap = make(map[Color]int, 0)
+		}
+		if o.IntMap == nil {
+			o.IntMap = make(map[int][]float64, 0)
+		}
+		if o.RidMap == nil {
+			o.RidMap = make(map[rid.ResourceIdentifier]bool, 0)
+		}
+		if o.DatetimeMap == nil {
+			o.DatetimeMap = make(map[datetime.DateTime]uuid.UUID, 0)
+		}
+		if o.NestedMap == nil {
+			o.NestedMap = make(map[string]map[string]*Circle, 0)
+		}
+		if o.AliasMap == nil {
+			o.AliasMap = make(map[Name]Id, 0)
+		}
+		if o.Shapes == nil {
+			o.Shapes = make([]Shape, 0)
+		}
+		if o.Timestamps == nil {
+			o.Timestamps = make([]datetime.DateTime, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenPrimitives, seenOptionalString, seenOptionalCircle, seenStrings, seenUuids, seenUuidMap, seenColorMap, seenIntMap, seenRidMap, seenDatetimeMap, seenNestedMap, seenAliasMap, seenShapes, seenColor, seenName, seenId, seenNote, seenTimestamps, seenPayload bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "primitives":
+			if seenPrimitives {
+				ok = false
+				return false
+			}
+			seenPrimitives = true
+			if !o.Primitives.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "optionalString":
+			if seenOptionalString {
+				ok = false
+				return false
+			}
+			seenOptionalString = true
+			if field.Type != gjson.Null {
+				var v string
+				if !unmarshalJSONString(field, &v) {
+					ok = false
+					return false
+				}
+				o.OptionalString = &v
+			}
+		case "optionalCircle":
+			if seenOptionalCircle {
+				ok = false
+				return false
+			}
+			seenOptionalCircle = true
+			if field.Type != gjson.Null {
+				var v1 Circle
+				if !v1.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				o.OptionalCircle = &v1
+			}
+		case "strings":
+			if seenStrings {
+				ok = false
+				return false
+			}
+			seenStrings = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Strings = make([]string, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v2 string
+					if !unmarshalJSONString(elem, &v2) {
+						ok1 = false
+						return false
+					}
+					o.Strings = append(o.Strings, v2)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "uuids":
+			if seenUuids {
+				ok = false
+				return false
+			}
+			seenUuids = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Uuids = make([]uuid.UUID, 0)
+				ok2 := true
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v3 uuid.UUID
+					if elem1.Type != gjson.Null {
+						var s string
+						if !unmarshalJSONString(elem1, &s) || v3.UnmarshalText([]byte(s)) != nil {
+							ok2 = false
+							return false
+						}
+					}
+					o.Uuids = append(o.Uuids, v3)
+					return true
+				})
+				if !ok2 {
+					ok = false
+					return false
+				}
+			}
+		case "uuidMap":
+			if seenUuidMap {
+				ok = false
+				return false
+			}
+			seenUuidMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.UuidMap = make(map[uuid.UUID]string)
+				ok3 := true
+				field.ForEach(func(key1, elem2 gjson.Result) bool {
+					var k uuid.UUID
+					var s1 string
+					if !unmarshalJSONString(key1, &s1) || k.UnmarshalText([]byte(s1)) != nil {
+						ok3 = false
+						return false
+					}
+					var v4 string
+					if !unmarshalJSONString(elem2, &v4) {
+						ok3 = false
+						return false
+					}
+					o.UuidMap[k] = v4
+					return true
+				})
+				if !ok3 {
+					ok = false
+					return false
+				}
+			}
+		case "colorMap":
+			if seenColorMap {
+				ok = false
+				return false
+			}
+			seenColorMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.ColorMap = make(map[Color]int)
+				ok4 := true
+				field.ForEach(func(key2, elem3 gjson.Result) bool {
+					var k1 Color
+					var s2 string
+					if !unmarshalJSONString(key2, &s2) || k1.UnmarshalText([]byte(s2)) != nil {
+						ok4 = false
+						return false
+					}
+					var v5 int
+					if !unmarshalJSONInt(elem3, &v5) {
+						ok4 = false
+						return false
+					}
+					o.ColorMap[k1] = v5
+					return true
+				})
+				if !ok4 {
+					ok = false
+					return false
+				}
+			}
+		case "intMap":
+			if seenIntMap {
+				ok = false
+				return false
+			}
+			seenIntMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.IntMap = make(map[int][]float64)
+				ok5 := true
+				field.ForEach(func(key3, elem4 gjson.Result) bool {
+					var k2 int
+					if !unmarshalJSONIntKey(key3, &k2) {
+						ok5 = false
+						return false
+					}
+					var v6 []float64
+					if elem4.Type != gjson.Null {
+						if !elem4.IsArray() {
+							ok5 = false
+							return false
+						}
+						v6 = make([]float64, 0)
+						ok6 := true
+						elem4.ForEach(func(_, elem5 gjson.Result) bool {
+							var v7 float64
+							if !unmarshalJSONFloat64(elem5, &v7) {
+								ok6 = false
+								return false
+							}
+							v6 = append(v6, v7)
+							return true
+						})
+						if !ok6 {
+							ok5 = false
+							return false
+						}
+					}
+					o.IntMap[k2] = v6
+					return true
+				})
+				if !ok5 {
+					ok = false
+					return false
+				}
+			}
+		case "ridMap":
+			if seenRidMap {
+				ok = false
+				return false
+			}
+			seenRidMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.RidMap = make(map[rid.ResourceIdentifier]bool)
+				ok7 := true
+				field.ForEach(func(key4, elem6 gjson.Result) bool {
+					var k3 rid.ResourceIdentifier
+					var s3 string
+					if !unmarshalJSONString(key4, &s3) || k3.UnmarshalText([]byte(s3)) != nil {
+						ok7 = false
+						return false
+					}
+					var v8 bool
+					if !unmarshalJSONBool(elem6, &v8) {
+						ok7 = false
+						return false
+					}
+					o.RidMap[k3] = v8
+					return true
+				})
+				if !ok7 {
+					ok = false
+					return false
+				}
+			}
+		case "datetimeMap":
+			if seenDatetimeMap {
+				ok = false
+				return false
+			}
+			seenDatetimeMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.DatetimeMap = make(map[datetime.DateTime]uuid.UUID)
+				ok8 := true
+				field.ForEach(func(key5, elem7 gjson.Result) bool {
+					var k4 datetime.DateTime
+					var s4 string
+					if !unmarshalJSONString(key5, &s4) || k4.UnmarshalText([]byte(s4)) != nil {
+						ok8 = false
+						return false
+					}
+					var v9 uuid.UUID
+					if elem7.Type != gjson.Null {
+						var s5 string
+						if !unmarshalJSONString(elem7, &s5) || v9.UnmarshalText([]byte(s5)) != nil {
+							ok8 = false
+							return false
+						}
+					}
+					o.DatetimeMap[k4] = v9
+					return true
+				})
+				if !ok8 {
+					ok = false
+					return false
+				}
+			}
+		case "nestedMap":
+			if seenNestedMap {
+				ok = false
+				return false
+			}
+			seenNestedMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.NestedMap = make(map[string]map[string]*Circle)
+				ok9 := true
+				field.ForEach(func(key6, elem8 gjson.Result) bool {
+					var k5 string
+					if !unmarshalJSONString(key6, &k5) {
+						ok9 = false
+						return false
+					}
+					var v10 map[string]*Circle
+					if elem8.Type != gjson.Null {
+						if !elem8.IsObject() {
+							ok9 = false
+							return false
+						}
+						v10 = make(map[string]*Circle)
+						ok10 := true
+						elem8.ForEach(func(key7, elem9 gjson.Result) bool {
+							var k6 string
+							if !unmarshalJSONString(key7, &k6) {
+								ok10 = false
+								return false
+							}
+							var v11 *Circle
+							if elem9.Type != gjson.Null {
+								var v12 Circle
+								if !v12.unmarshalJSONValue(elem9) {
+									ok10 = false
+									return false
+								}
+								v11 = &v12
+							}
+							v10[k6] = v11
+							return true
+						})
+						if !ok10 {
+							ok9 = false
+							return false
+						}
+					}
+					o.NestedMap[k5] = v10
+					return true
+				})
+				if !ok9 {
+					ok = false
+					return false
+				}
+			}
+		case "aliasMap":
+			if seenAliasMap {
+				ok = false
+				return false
+			}
+			seenAliasMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.AliasMap = make(map[Name]Id)
+				ok11 := true
+				field.ForEach(func(key8, elem10 gjson.Result) bool {
+					var k7 Name
+					var k8 string
+					if !unmarshalJSONString(key8, &k8) {
+						ok11 = false
+						return false
+					}
+					k7 = Name(k8)
+					var v13 Id
+					if elem10.Type != gjson.Null {
+						var s6 string
+						if !unmarshalJSONString(elem10, &s6) || v13.UnmarshalText([]byte(s6)) != nil {
+							ok11 = false
+							return false
+						}
+					}
+					o.AliasMap[k7] = v13
+					return true
+				})
+				if !ok11 {
+					ok = false
+					return false
+				}
+			}
+		case "shapes":
+			if seenShapes {
+				ok = false
+				return false
+			}
+			seenShapes = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Shapes = make([]Shape, 0)
+				ok12 := true
+				field.ForEach(func(_, elem11 gjson.Result) bool {
+					var v14 Shape
+					if !v14.unmarshalJSONValue(elem11) {
+						ok12 = false
+						return false
+					}
+					o.Shapes = append(o.Shapes, v14)
+					return true
+				})
+				if !ok12 {
+					ok = false
+					return false
+				}
+			}
+		case "color":
+			if seenColor {
+				ok = false
+				return false
+			}
+			seenColor = true
+			if field.Type != gjson.Null {
+				var s7 string
+				if !unmarshalJSONString(field, &s7) || o.Color.UnmarshalText([]byte(s7)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "name":
+			if seenName {
+				ok = false
+				return false
+			}
+			seenName = true
+			var v15 string
+			if !unmarshalJSONString(field, &v15) {
+				ok = false
+				return false
+			}
+			o.Name = Name(v15)
+		case "id":
+			if seenId {
+				ok = false
+				return false
+			}
+			seenId = true
+			if field.Type != gjson.Null {
+				var s8 string
+				if !unmarshalJSONString(field, &s8) || o.Id.UnmarshalText([]byte(s8)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "note":
+			if seenNote {
+				ok = false
+				return false
+			}
+			seenNote = true
+			if field.Type != gjson.Null {
+				var s9 string
+				if !unmarshalJSONString(field, &s9) || o.Note.UnmarshalText([]byte(s9)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "timestamps":
+			if seenTimestamps {
+				ok = false
+				return false
+			}
+			seenTimestamps = true
+			var v16 []datetime.DateTime
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				v16 = make([]datetime.DateTime, 0)
+				ok13 := true
+				field.ForEach(func(_, elem12 gjson.Result) bool {
+					var v17 datetime.DateTime
+					if elem12.Type != gjson.Null {
+						var s10 string
+						if !unmarshalJSONString(elem12, &s10) || v17.UnmarshalText([]byte(s10)) != nil {
+							ok13 = false
+							return false
+						}
+					}
+					v16 = append(v16, v17)
+					return true
+				})
+				if !ok13 {
+					ok = false
+					return false
+				}
+			}
+			o.Timestamps = Timestamps(v16)
+		case "payload":
+			if seenPayload {
+				ok = false
+				return false
+			}
+			seenPayload = true
+			if field.Type != gjson.Null {
+				var s11 string
+				if !unmarshalJSONString(field, &s11) || o.Payload.UnmarshalText([]byte(s11)) != nil {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "primitives", "optionalString", "optionalCircle", "strings", "uuids", "uuidMap", "colorMap", "intMap", "ridMap", "datetimeMap", "nestedMap", "aliasMap", "shapes", "color", "name", "id", "note", "timestamps", "payload") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Strings == nil {
+		o.Strings = make([]string, 0)
+	}
+	if o.Uuids == nil {
+		o.Uuids = make([]uuid.UUID, 0)
+	}
+	if o.UuidMap == nil {
+		o.UuidMap = make(map[uuid.UUID]string, 0)
+	}
+	if o.ColorMap == nil {
+		o.ColorMap = make(map[Color]int, 0)
+	}
+	if o.IntMap == nil {
+		o.IntMap = make(map[int][]float64, 0)
+	}
+	if o.RidMap == nil {
+		o.RidMap = make(map[rid.ResourceIdentifier]bool, 0)
+	}
+	if o.DatetimeMap == nil {
+		o.DatetimeMap = make(map[datetime.DateTime]uuid.UUID, 0)
+	}
+	if o.NestedMap == nil {
+		o.NestedMap = make(map[string]map[string]*Circle, 0)
+	}
+	if o.AliasMap == nil {
+		o.AliasMap = make(map[Name]Id, 0)
+	}
+	if o.Shapes == nil {
+		o.Shapes = make([]Shape, 0)
+	}
+	if o.Timestamps == nil {
+		o.Timestamps = make([]datetime.DateTime, 0)
+	}
+	return true
+}
+
+func (o *Everything) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Everything) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Everything{}
+	var seenPrimitives, seenOptionalString, seenOptionalCircle, seenStrings, seenUuids, seenUuidMap, seenColorMap, seenIntMap, seenRidMap, seenDatetimeMap, seenNestedMap, seenAliasMap, seenShapes, seenColor, seenName, seenId, seenNote, seenTimestamps, seenPayload bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "primitives":
+			if seenPrimitives {
+				err = fmt.Errorf("duplicate field \"primitives\"")
+				return false
+			}
+			seenPrimitives = true
+			err = o.Primitives.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"primitives\": %w", err)
+				return false
+			}
+		case "optionalString":
+			if seenOptionalString {
+				err = fmt.Errorf("duplicate field \"optionalString\"")
+				return false
+			}
+			seenOptionalString = true
+			if field.Type != gjson.Null {
+				var v string
+				v, err = decodeJSONString(field)
+				if err != nil {
+					err = fmt.Errorf("field \"optionalString\": %w", err)
+					return false
+				}
+				o.OptionalString = &v
+			}
+		case "optionalCircle":
+			if seenOptionalCircle {
+				err = fmt.Errorf("duplicate field \"optionalCircle\"")
+				return false
+			}
+			seenOptionalCircle = true
+			if field.Type != gjson.Null {
+				var v1 Circle
+				err = v1.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"optionalCircle\": %w", err)
+					return false
+				}
+				o.OptionalCircle = &v1
+			}
+		case "strings":
+			if seenStrings {
+				err = fmt.Errorf("duplicate field \"strings\"")
+				return false
+			}
+			seenStrings = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"strings\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Strings = make([]string, 0)
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v2 string
+					v2, err = decodeJSONString(elem)
+					if err != nil {
+						return false
+					}
+					o.Strings = append(o.Strings, v2)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"strings\": %w", err)
+					return false
+				}
+			}
+		case "uuids":
+			if seenUuids {
+				err = fmt.Errorf("duplicate field \"uuids\"")
+				return false
+			}
+			seenUuids = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"uuids\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Uuids = make([]uuid.UUID, 0)
+				seen := make(map[uuid.UUID]struct{})
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v3 uuid.UUID
+					v3, err = decodeJSONUUID(elem1)
+					if err != nil {
+						return false
+					}
+					if _, ok := seen[v3]; ok {
+						err = fmt.Errorf("duplicate set element %s", elem1.Raw)
+						return false
+					}
+					seen[v3] = struct{}{}
+					o.Uuids = append(o.Uuids, v3)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"uuids\": %w", err)
+					return false
+				}
+			}
+		case "uuidMap":
+			if seenUuidMap {
+				err = fmt.Errorf("duplicate field \"uuidMap\"")
+				return false
+			}
+			seenUuidMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"uuidMap\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.UuidMap = make(map[uuid.UUID]string)
+				field.ForEach(func(key1, elem2 gjson.Result) bool {
+					var k uuid.UUID
+					k, err = decodeJSONUUID(key1)
+					if err != nil {
+						return false
+					}
+					if _, ok := o.UuidMap[k]; ok {
+						err = fmt.Errorf("duplicate map key %s", key1.Raw)
+						return false
+					}
+					var v4 string
+					v4, err = decodeJSONString(elem2)
+					if err != nil {
+						return false
+					}
+					o.UuidMap[k] = v4
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"uuidMap\": %w", err)
+					return false
+				}
+			}
+		case "colorMap":
+			if seenColorMap {
+				err = fmt.Errorf("duplicate field \"colorMap\"")
+				return false
+			}
+			seenColorMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"colorMap\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.ColorMap = make(map[Color]int)
+				field.ForEach(func(key2, elem3 gjson.Result) bool {
+					var k1 Color
+					err = k1.decodeJSONStrict(key2)
+					if err != nil {
+						return false
+					}
+					if _, ok := o.ColorMap[k1]; ok {
+						err = fmt.Errorf("duplicate map key %s", key2.Raw)
+						return false
+					}
+					var v5 int
+					v5, err = decodeJSONInt(elem3)
+					if err != nil {
+						return false
+					}
+					o.ColorMap[k1] = v5
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"colorMap\": %w", err)
+					return false
+				}
+			}
+		case "intMap":
+			if seenIntMap {
+				err = fmt.Errorf("duplicate field \"intMap\"")
+				return false
+			}
+			seenIntMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"intMap\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.IntMap = make(map[int][]float64)
+				field.ForEach(func(key3, elem4 gjson.Result) bool {
+					var k2 int
+					k2, err = decodeJSONInt(jsonNumberKey(key3))
+					if err != nil {
+						return false
+					}
+					if _, ok := o.IntMap[k2]; ok {
+						err = fmt.Errorf("duplicate map key %s", key3.Raw)
+						return false
+					}
+					var v6 []float64
+					if !elem4.IsArray() {
+						err = jsonTypeError(elem4, "array")
+						return false
+					}
+					v6 = make([]float64, 0)
+					elem4.ForEach(func(_, elem5 gjson.Result) bool {
+						var v7 float64
+						v7, err = decodeJSONFloat64(elem5)
+						if err != nil {
+							return false
+						}
+						v6 = append(v6, v7)
+						return true
+					})
+					if err != nil {
+						return false
+					}
+					o.IntMap[k2] = v6
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"intMap\": %w", err)
+					return false
+				}
+			}
+		case "ridMap":
+			if seenRidMap {
+				err = fmt.Errorf("duplicate field \"ridMap\"")
+				return false
+			}
+			seenRidMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"ridMap\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.RidMap = make(map[rid.ResourceIdentifier]bool)
+				field.ForEach(func(key4, elem6 gjson.Result) bool {
+					var k3 rid.ResourceIdentifier
+					k3, err = decodeJSONRID(key4)
+					if err != nil {
+						return false
+					}
+					if _, ok := o.RidMap[k3]; ok {
+						err = fmt.Errorf("duplicate map key %s", key4.Raw)
+						return false
+					}
+					var v8 bool
+					v8, err = decodeJSONBool(elem6)
+					if err != nil {
+						return false
+					}
+					o.RidMap[k3] = v8
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"ridMap\": %w", err)
+					return false
+				}
+			}
+		case "datetimeMap":
+			if seenDatetimeMap {
+				err = fmt.Errorf("duplicate field \"datetimeMap\"")
+				return false
+			}
+			seenDatetimeMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"datetimeMap\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.DatetimeMap = make(map[datetime.DateTime]uuid.UUID)
+				field.ForEach(func(key5, elem7 gjson.Result) bool {
+					var k4 datetime.DateTime
+					k4, err = decodeJSONDateTime(key5)
+					if err != nil {
+						return false
+					}
+					if _, ok := o.DatetimeMap[k4]; ok {
+						err = fmt.Errorf("duplicate map key %s", key5.Raw)
+						return false
+					}
+					var v9 uuid.UUID
+					v9, err = decodeJSONUUID(elem7)
+					if err != nil {
+						return false
+					}
+					o.DatetimeMap[k4] = v9
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"datetimeMap\": %w", err)
+					return false
+				}
+			}
+		case "nestedMap":
+			if seenNestedMap {
+				err = fmt.Errorf("duplicate field \"nestedMap\"")
+				return false
+			}
+			seenNestedMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"nestedMap\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.NestedMap = make(map[string]map[string]*Circle)
+				field.ForEach(func(key6, elem8 gjson.Result) bool {
+					var k5 string
+					k5 = key6.Str
+					if _, ok := o.NestedMap[k5]; ok {
+						err = fmt.Errorf("duplicate map key %s", key6.Raw)
+						return false
+					}
+					var v10 map[string]*Circle
+					if !elem8.IsObject() {
+						err = jsonTypeError(elem8, "object")
+						return false
+					}
+					v10 = make(map[string]*Circle)
+					elem8.ForEach(func(key7, elem9 gjson.Result) bool {
+						var k6 string
+						k6 = key7.Str
+						if _, ok := v10[k6]; ok {
+							err = fmt.Errorf("duplicate map key %s", key7.Raw)
+							return false
+						}
+						var v11 *Circle
+						if elem9.Type != gjson.Null {
+							var v12 Circle
+							err = v12.decodeJSONStrict(elem9)
+							if err != nil {
+								return false
+							}
+							v11 = &v12
+						}
+						v10[k6] = v11
+						return true
+					})
+					if err != nil {
+						return false
+					}
+					o.NestedMap[k5] = v10
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"nestedMap\": %w", err)
+					return false
+				}
+			}
+		case "aliasMap":
+			if seenAliasMap {
+				err = fmt.Errorf("duplicate field \"aliasMap\"")
+				return false
+			}
+			seenAliasMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"aliasMap\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.AliasMap = make(map[Name]Id)
+				field.ForEach(func(key8, elem10 gjson.Result) bool {
+					var k7 Name
+					var k8 string
+					k8 = key8.Str
+					k7 = Name(k8)
+					if _, ok := o.AliasMap[k7]; ok {
+						err = fmt.Errorf("duplicate map key %s", key8.Raw)
+						return false
+					}
+					var v13 Id
+					err = v13.decodeJSONStrict(elem10)
+					if err != nil {
+						return false
+					}
+					o.AliasMap[k7] = v13
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"aliasMap\": %w", err)
+					return false
+				}
+			}
+		case "shapes":
+			if seenShapes {
+				err = fmt.Errorf("duplicate field \"shapes\"")
+				return false
+			}
+			seenShapes = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"shapes\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Shapes = make([]Shape, 0)
+				field.ForEach(func(_, elem11 gjson.Result) bool {
+					var v14 Shape
+					err = v14.decodeJSONStrict(elem11)
+					if err != nil {
+						return false
+					}
+					o.Shapes = append(o.Shapes, v14)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"shapes\": %w", err)
+					return false
+				}
+			}
+		case "color":
+			if seenColor {
+				err = fmt.Errorf("duplicate field \"color\"")
+				return false
+			}
+			seenColor = true
+			err = o.Color.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"color\": %w", err)
+				return false
+			}
+		case "name":
+			if seenName {
+				err = fmt.Errorf("duplicate field \"name\"")
+				return false
+			}
+			seenName = true
+			err = o.Name.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"name\": %w", err)
+				return false
+			}
+		case "id":
+			if seenId {
+				err = fmt.Errorf("duplicate field \"id\"")
+				return false
+			}
+			seenId = true
+			err = o.Id.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"id\": %w", err)
+				return false
+			}
+		case "note":
+			if seenNote {
+				err = fmt.Errorf("duplicate field \"note\"")
+				return false
+			}
+			seenNote = true
+			err = o.Note.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"note\": %w", err)
+				return false
+			}
+		case "timestamps":
+			if seenTimestamps {
+				err = fmt.Errorf("duplicate field \"timestamps\"")
+				return false
+			}
+			seenTimestamps = true
+			if field.Type != gjson.Null {
+				err = o.Timestamps.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"timestamps\": %w", err)
+					return false
+				}
+			}
+		case "payload":
+			if seenPayload {
+				err = fmt.Errorf("duplicate field \"payload\"")
+				return false
+			}
+			seenPayload = true
+			err = o.Payload.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"payload\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenPrimitives {
+		return fmt.Errorf("field \"primitives\" is required")
+	}
+	if o.Strings == nil {
+		o.Strings = make([]string, 0)
+	}
+	if o.Uuids == nil {
+		o.Uuids = make([]uuid.UUID, 0)
+	}
+	if o.UuidMap == nil {
+		o.UuidMap = make(map[uuid.UUID]string, 0)
+	}
+	if o.ColorMap == nil {
+		o.ColorMap = make(map[Color]int, 0)
+	}
+	if o.IntMap == nil {
+		o.IntMap = make(map[int][]float64, 0)
+	}
+	if o.RidMap == nil {
+		o.RidMap = make(map[rid.ResourceIdentifier]bool, 0)
+	}
+	if o.DatetimeMap == nil {
+		o.DatetimeMap = make(map[datetime.DateTime]uuid.UUID, 0)
+	}
+	if o.NestedMap == nil {
+		o.NestedMap = make(map[string]map[string]*Circle, 0)
+	}
+	if o.AliasMap == nil {
+		o.AliasMap = make(map[Name]Id, 0)
+	}
+	if o.Shapes == nil {
+		o.Shapes = make([]Shape, 0)
+	}
+	if !seenColor {
+		return fmt.Errorf("field \"color\" is required")
+	}
+	if !seenName {
+		return fmt.Errorf("field \"name\" is required")
+	}
+	if !seenId {
+		return fmt.Errorf("field \"id\" is required")
+	}
+	if o.Timestamps == nil {
+		o.Timestamps = make([]datetime.DateTime, 0)
+	}
+	if !seenPayload {
+		return fmt.Errorf("field \"payload\" is required")
+	}
+	return nil
+}
+
+func (o Everything) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *Everything) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the Everything is equal to other according to the Conjure semantics of its values.
+func (o Everything) Equal(other Everything) bool {
+	if !o.Primitives.Equal(other.Primitives) {
+		return false
+	}
+	if (o.OptionalString == nil) != (other.OptionalString == nil) {
+		return false
+	}
+	if o.OptionalString != nil {
+		if *o.OptionalString != *other.OptionalString {
+			return false
+		}
+	}
+	if (o.OptionalCircle == nil) != (other.OptionalCircle == nil) {
+		return false
+	}
+	if o.OptionalCircle != nil {
+		if !(*o.OptionalCircle).Equal(*other.OptionalCircle) {
+			return false
+		}
+	}
+	if len(o.Strings) != len(other.Strings) {
+		return false
+	}
+	for i := range o.Strings {
+		if o.Strings[i] != other.Strings[i] {
+			return false
+		}
+	}
+	if !func() bool {
+		if len(o.Uuids) != len(other.Uuids) {
+			return false
+		}
+		counts := make(map[uuid.UUID]int, len(o.Uuids))
+		for _, v := range o.Uuids {
+			counts[v]++
+		}
+		for _, v := range other.Uuids {
+			if counts[v] == 0 {
+				return false
+			}
+			counts[v]--
+		}
+		return true
+	}() {
+		return false
+	}
+	if len(o.UuidMap) != len(other.UuidMap) {
+		return false
+	}
+	for k, v := range o.UuidMap {
+		otherV, ok := other.UuidMap[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	if len(o.ColorMap) != len(other.ColorMap) {
+		return false
+	}
+	for k, v := range o.ColorMap {
+		otherV, ok := other.ColorMap[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	if len(o.IntMap) != len(other.IntMap) {
+		return false
+	}
+	for k, v := range o.IntMap {
+		otherV, ok := other.IntMap[k]
+		if !ok {
+			return false
+		}
+		if len(v) != len(otherV) {
+			return false
+		}
+		for i1 := range v {
+			if !(v[i1] == otherV[i1] || math.IsNaN(v[i1]) && math.IsNaN(otherV[i1])) {
+				return false
+			}
+		}
+	}
+	if len(o.RidMap) != len(other.RidMap) {
+		return false
+	}
+	for k, v := range o.RidMap {
+		otherV, ok := other.RidMap[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	if len(o.DatetimeMap) != len(other.DatetimeMap) {
+		return false
+	}
+	for k, v := range o.DatetimeMap {
+		otherV, ok := other.DatetimeMap[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	if len(o.NestedMap) != len(other.NestedMap) {
+		return false
+	}
+	for k, v := range o.NestedMap {
+		otherV, ok := other.NestedMap[k]
+		if !ok {
+			return false
+		}
+		if len(v) != len(otherV) {
+			return false
+		}
+		for k1, v1 := range v {
+			otherV1, ok1 := otherV[k1]
+			if !ok1 {
+				return false
+			}
+			if (v1 == nil) != (otherV1 == nil) {
+				return false
+			}
+			if v1 != nil {
+				if !(*v1).Equal(*otherV1) {
+					return false
+				}
+			}
+		}
+	}
+	if len(o.AliasMap) != len(other.AliasMap) {
+		return false
+	}
+	for k, v := range o.AliasMap {
+		otherV, ok := other.AliasMap[k]
+		if !ok {
+			return false
+		}
+		if !v.Equal(otherV) {
+			return false
+		}
+	}
+	if len(o.Shapes) != len(other.Shapes) {
+		return false
+	}
+	for i := range o.Shapes {
+		if !o.Shapes[i].Equal(other.Shapes[i]) {
+			return false
+		}
+	}
+	if !o.Color.Equal(other.Color) {
+		return false
+	}
+	if !o.Name.Equal(other.Name) {
+		return false
+	}
+	if !o.Id.Equal(other.Id) {
+		return false
+	}
+	if !o.Note.Equal(other.Note) {
+		return false
+	}
+	if !o.Timestamps.Equal(other.Timestamps) {
+		return false
+	}
+	if !o.Payload.Equal(other.Payload) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Everything.
+func (o Everything) Clone() Everything {
+	out := o
+	out.Primitives = o.Primitives.Clone()
+	if o.OptionalString != nil {
+		v := *o.OptionalString
+		out.OptionalString = &v
+	}
+	if o.OptionalCircle != nil {
+		v := *o.OptionalCircle
+		out.OptionalCircle = &v
+	}
+	if o.Strings != nil {
+		out.Strings = make([]string, len(o.Strings))
+		copy(out.Strings, o.Strings)
+	}
+	if o.Uuids != nil {
+		out.Uuids = make([]uuid.UUID, len(o.Uuids))
+		copy(out.Uuids, o.Uuids)
+	}
+	if o.UuidMap != nil {
+		out.UuidMap = make(map[uuid.UUID]string, len(o.UuidMap))
+		for k, v := range o.UuidMap {
+			out.UuidMap[k] = v
+		}
+	}
+	if o.ColorMap != nil {
+		out.ColorMap = make(map[Color]int, len(o.ColorMap))
+		for k, v := range o.ColorMap {
+			out.ColorMap[k] = v
+		}
+	}
+	if o.IntMap != nil {
+		out.IntMap = make(map[int][]float64, len(o.IntMap))
+		for k, v := range o.IntMap {
+			var elem []float64
+			if v != nil {
+				elem = make([]float64, len(v))
+				copy(elem, v)
+			}
+			out.IntMap[k] = elem
+		}
+	}
+	if o.RidMap != nil {
+		out.RidMap = make(map[rid.ResourceIdentifier]bool, len(o.RidMap))
+		for k, v := range o.RidMap {
+			out.RidMap[k] = v
+		}
+	}
+	if o.DatetimeMap != nil {
+		out.DatetimeMap = make(map[datetime.DateTime]uuid.UUID, len(o.DatetimeMap))
+		for k, v := range o.DatetimeMap {
+			out.DatetimeMap[k] = v
+		}
+	}
+	if o.NestedMap != nil {
+		out.NestedMap = make(map[string]map[string]*Circle, len(o.NestedMap))
+		for k, v := range o.NestedMap {
+			var elem map[string]*Circle
+			if v != nil {
+				elem = make(map[string]*Circle, len(v))
+				for k1, v1 := range v {
+					var elem1 *Circle
+					if v1 != nil {
+						v2 := *v1
+						elem1 = &v2
+					}
+					elem[k1] = elem1
+				}
+			}
+			out.NestedMap[k] = elem
+		}
+	}
+	if o.AliasMap != nil {
+		out.AliasMap = make(map[Name]Id, len(o.AliasMap))
+		for k, v := range o.AliasMap {
+			out.AliasMap[k] = v
+		}
+	}
+	if o.Shapes != nil {
+		out.Shapes = make([]Shape, len(o.Shapes))
+		for i := range o.Shapes {
+			out.Shapes[i] = o.Shapes[i].Clone()
+		}
+	}
+	out.Note = o.Note.Clone()
+	out.Timestamps = o.Timestamps.Clone()
+	out.Payload = o.Payload.Clone()
+	return out
+}
+
+type Page struct {
+	Items         []Primitives `json:"items"`
+	NextPageToken *string      `json:"nextPageToken"`
+}
+
+func (o Page) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"items\":"...)
+	out = append(out, '[')
+	for i, v := range o.Items {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	out = append(out, ",\"nextPageToken\":"...)
+	if o.NextPageToken == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, *o.NextPageToken)
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Page) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 27
+	size += 2
+	if len(o.Items) > 1 {
+		size += len(o.Items) - 1
+	}
+	for _, v := range o.Items {
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	if o.NextPageToken == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(*o.NextPageToken)
+	}
+	return size, nil
+}
+
+func (o Page) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
+}
+
+func (o *Page) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Page
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
+	type PageAlias Page
+	var rawPage PageAlias
+	if err := safejson.Unmarshal(data, &rawPage); err != nil {
+		return err
+	}
+	if rawPage.Items == nil {
+		rawPage.Items = make([]Primitives, 0)
+	}
+	*o = Page(rawPage)
+	return nil
+}
+
+func (o *Page) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Items == nil {
+			o.Items = make([]Primitives, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenItems, seenNextPageToken bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "items":
+			if seenItems {
+				ok = false
+				return false
+			}
+			seenItems = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Items = make([]Primitives, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v Primitives
+					if !v.unmarshalJSONValue(elem) {
+						ok1 = false
+						return false
+					}
+					o.Items = append(o.Items, v)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "nextPageToken":
+			if seenNextPageToken {
+				ok = false
+				return false
+			}
+			seenNextPageToken = true
+			if field.Type != gjson.Null {
+				var v1 string
+				if !unmarshalJSONString(field, &v1) {
+					ok = false
+					return false
+				}
+				o.NextPageToken = &v1
+			}
+		default:
+			if matchesJSONField(key.Str, "items", "nextPageToken") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Items == nil {
+		o.Items = make([]Primitives, 0)
+	}
+	return true
+}
+
+func (o *Page) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Page) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Page{}
+	var seenItems, seenNextPageToken bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "items":
+			if seenItems {
+				err = fmt.Errorf("duplicate field \"items\"")
+				return false
+			}
+			seenItems = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"items\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Items = make([]Primitives, 0)
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v Primitives
+					err = v.decodeJSONStrict(elem)
+					if err != nil {
+						return false
+					}
+					o.Items = append(o.Items, v)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"items\": %w", err)
+					return false
+				}
+			}
+		case "nextPageToken":
+			if seenNextPageToken {
+				err = fmt.Errorf("duplicate field \"nextPageToken\"")
+				return false
+			}
+			seenNextPageToken = true
+			if field.Type != gjson.Null {
+				var v1 string
+				v1, err = decodeJSONString(field)
+				if err != nil {
+					err = fmt.Errorf("field \"nextPageToken\": %w", err)
+					return false
+				}
+				o.NextPageToken = &v1
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if o.Items == nil {
+		o.Items = make([]Primitives, 0)
+	}
+	return nil
+}
+
+func (o Page) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *Page) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the Page is equal to other according to the Conjure semantics of its values.
+func (o Page) Equal(other Page) bool {
+	if len(o.Items) != len(other.Items) {
+		return false
+	}
+	for i := range o.Items {
+		if !o.Items[i].Equal(other.Items[i]) {
+			return false
+		}
+	}
+	if (o.NextPageToken == nil) != (other.NextPageToken == nil) {
+		return false
+	}
+	if o.NextPageToken != nil {
+		if *o.NextPageToken != *other.NextPageToken {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Page.
+func (o Page) Clone() Page {
+	out := o
+	if o.Items != nil {
+		out.Items = make([]Primitives, len(o.Items))
+		for i := range o.Items {
+			out.Items[i] = o.Items[i].Clone()
+		}
+	}
+	if o.NextPageToken != nil {
+		v := *o.NextPageToken
+		out.NextPageToken = &v
+	}
+	return out
+}
+
+type Primitives struct {
+	Str      string                 `json:"str"`
+	Int      int                    `json:"int"`
+	Long     safelong.SafeLong      `json:"long"`
+	Double   float64                `json:"double"`
+	Bool     bool                   `json:"bool"`
+	Uuid     uuid.UUID              `json:"uuid"`
+	Rid      rid.ResourceIdentifier `json:"rid"`
+	Datetime datetime.DateTime      `json:"datetime"`
+	Binary   []byte                 `json:"binary"`
+	Bearer   bearertoken.Token      `json:"bearer"`
+	Any      interface{}            `json:"any"`
+}
+
+func (o Primitives) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"str\":"...)
+	out = appendJSONString(out, o.Str)
+	out = append(out, ",\"int\":"...)
+	out = strconv.AppendInt(out, int64(o.Int), 10)
+	out = append(out, ",\"long\":"...)
+	if _, err := safelong.NewSafeLong(int64(o.Long)); err != nil {
+		return nil, err
+	}
+	out = strconv.AppendInt(out, int64(o.Long), 10)
+	out = append(out, ",\"double\":"...)
+	out = appendJSONFloat64(out, o.Double)
+	out = append(out, ",\"bool\":"...)
+	out = strconv.AppendBool(out, o.Bool)
+	out = append(out, ",\"uuid\":"...)
+	out = appendJSONUUID(out, o.Uuid)
+	out = append(out, ",\"rid\":"...)
+	out, err = appendJSONText(out, o.Rid)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"datetime\":"...)
+	out = appendJSONDateTime(out, o.Datetime)
+	out = append(out, ",\"binary\":"...)
+	if o.Binary == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONBinary(out, o.Binary)
+	}
+	out = append(out, ",\"bearer\":"...)
+	out = appendJSONString(out, string(o.Bearer))
+	out = append(out, ",\"any\":"...)
+	out, err = appendJSONMarshal(out, o.Any)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Primitives) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 95
+	size += jsonStringSize(o.Str)
+	size += jsonIntSize(int64(o.Int))
+	if _, err := safelong.NewSafeLong(int64(o.Long)); err != nil {
+		return 0, err
+	}
+	size += jsonIntSize(int64(o.Long))
+	size += jsonFloat64Size(o.Double)
+	if o.Bool {
+		size += 4
+	} else {
+		size += 5
+	}
+	size += 38
+	n, err = jsonTextSize(o.Rid)
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	size += jsonDateTimeSize(o.Datetime)
+	if o.Binary == nil {
+		size += 4
+	} else {
+		size += base64.StdEncoding.EncodedLen(len(o.Binary)) + 2
+	}
+	size += jsonStringSize(string(o.Bearer))
+	n, err = jsonMarshalSize(o.Any)
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (o *Primitives) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenStr, seenInt, seenLong, seenDouble, seenBool, seenUuid, seenRid, seenDatetime, seenBinary, seenBearer, seenAny bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "str":
+			if seenStr {
+				ok = false
+				return false
+			}
+			seenStr = true
+			if !unmarshalJSONString(field, &o.Str) {
+				ok = false
+				return false
+			}
+		case "int":
+			if seenInt {
+				ok = false
+				return false
+			}
+			seenInt = true
+			if !unmarshalJSONInt(field, &o.Int) {
+				ok = false
+				return false
+			}
+		case "long":
+			if seenLong {
+				ok = false
+				return false
+			}
+			seenLong = true
+			if !unmarshalJSONSafeLong(field, &o.Long) {
+				ok = false
+				return false
+			}
+		case "double":
+			if seenDouble {
+				ok = false
+				return false
+			}
+			seenDouble = true
+			if !unmarshalJSONFloat64(field, &o.Double) {
+				ok = false
+				return false
+			}
+		case "bool":
+			if seenBool {
+				ok = false
+				return false
+			}
+			seenBool = true
+			if !unmarshalJSONBool(field, &o.Bool) {
+				ok = false
+				return false
+			}
+		case "uuid":
+			if seenUuid {
+				ok = false
+				return false
+			}
+			seenUuid = true
+			if field.Type != gjson.Null {
+				var s string
+				if !unmarshalJSONString(field, &s) || o.Uuid.UnmarshalText([]byte(s)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "rid":
+			if seenRid {
+				ok = false
+				return false
+			}
+			seenRid = true
+			if field.Type != gjson.Null {
+				var s1 string
+				if !unmarshalJSONString(field, &s1) || o.Rid.UnmarshalText([]byte(s1)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "datetime":
+			if seenDatetime {
+				ok = false
+				return false
+			}
+			seenDatetime = true
+			if field.Type != gjson.Null {
+				var s2 string
+				if !unmarshalJSONString(field, &s2) || o.Datetime.UnmarshalText([]byte(s2)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "binary":
+			if seenBinary {
+				ok = false
+				return false
+			}
+			seenBinary = true
+			if !unmarshalJSONBinary(field, &o.Binary) {
+				ok = false
+				return false
+			}
+		case "bearer":
+			if seenBearer {
+				ok = false
+				return false
+			}
+			seenBearer = true
+			if field.Type != gjson.Null {
+				var s3 string
+				if !unmarshalJSONString(field, &s3) || o.Bearer.UnmarshalText([]byte(s3)) != nil {
+					ok = false
+					return false
+				}
+			}
+		case "any":
+			if seenAny {
+				ok = false
+				return false
+			}
+			seenAny = true
+			if !unmarshalJSONAny(field, &o.Any) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "str", "int", "long", "double", "bool", "uuid", "rid", "datetime", "binary", "bearer", "any") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *Primitives) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Primitives) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Primitives{}
+	var seenStr, seenInt, seenLong, seenDouble, seenBool, seenUuid, seenRid, seenDatetime, seenBinary, seenBearer, seenAny bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "str":
+			if seenStr {
+				err = fmt.Errorf("duplicate field \"str\"")
+				return false
+			}
+			seenStr = true
+			o.Str, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"str\": %w", err)
+				return false
+			}
+		case "int":
+			if seenInt {
+				err = fmt.Errorf("duplicate field \"int\"")
+				return false
+			}
+			seenInt = true
+			o.Int, err = decodeJSONInt(field)
+			if err != nil {
+				err = fmt.Errorf("field \"int\": %w", err)
+				return false
+			}
+		case "long":
+			if seenLong {
+				err = fmt.Errorf("duplicate field \"long\"")
+				return false
+			}
+			seenLong = true
+			o.Long, err = decodeJSONSafeLong(field)
+			if err != nil {
+				err = fmt.Errorf("field \"long\": %w", err)
+				return false
+			}
+		case "double":
+			if seenDouble {
+				err = fmt.Errorf("duplicate field \"double\"")
+				return false
+			}
+			seenDouble = true
+			o.Double, err = decodeJSONFloat64(field)
+			if err != nil {
+				err = fmt.Errorf("field \"double\": %w", err)
+				return false
+			}
+		case "bool":
+			if seenBool {
+				err = fmt.Errorf("duplicate field \"bool\"")
+				return false
+			}
+			seenBool = true
+			o.Bool, err = decodeJSONBool(field)
+			if err != nil {
+				err = fmt.Errorf("field \"bool\": %w", err)
+				return false
+			}
+		case "uuid":
+			if seenUuid {
+				err = fmt.Errorf("duplicate field \"uuid\"")
+				return false
+			}
+			seenUuid = true
+			o.Uuid, err = decodeJSONUUID(field)
+			if err != nil {
+				err = fmt.Errorf("field \"uuid\": %w", err)
+				return false
+			}
+		case "rid":
+			if seenRid {
+				err = fmt.Errorf("duplicate field \"rid\"")
+				return false
+			}
+			seenRid = true
+			o.Rid, err = decodeJSONRID(field)
+			if err != nil {
+				err = fmt.Errorf("field \"rid\": %w", err)
+				return false
+			}
+		case "datetime":
+			if seenDatetime {
+				err = fmt.Errorf("duplicate field \"datetime\"")
+				return false
+			}
+			seenDatetime = true
+			o.Datetime, err = decodeJSONDateTime(field)
+			if err != nil {
+				err = fmt.Errorf("field \"datetime\": %w", err)
+				return false
+			}
+		case "binary":
+			if seenBinary {
+				err = fmt.Errorf("duplicate field \"binary\"")
+				return false
+			}
+			seenBinary = true
+			o.Binary, err = decodeJSONBinary(field)
+			if err != nil {
+				err = fmt.Errorf("field \"binary\": %w", err)
+				return false
+			}
+		case "bearer":
+			if seenBearer {
+				err = fmt.Errorf("duplicate field \"bearer\"")
+				return false
+			}
+			seenBearer = true
+			o.Bearer, err = decodeJSONBearerToken(field)
+			if err != nil {
+				err = fmt.Errorf("field \"bearer\": %w", err)
+				return false
+			}
+		case "any":
+			if seenAny {
+				err = fmt.Errorf("duplicate field \"any\"")
+				return false
+			}
+			seenAny = true
+			o.Any, err = decodeJSONAny(field)
+			if err != nil {
+				err = fmt.Errorf("field \"any\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenStr {
+		return fmt.Errorf("field \"str\" is required")
+	}
+	if !seenInt {
+		return fmt.Errorf("field \"int\" is required")
+	}
+	if !seenLong {
+		return fmt.Errorf("field \"long\" is required")
+	}
+	if !seenDouble {
+		return fmt.Errorf("field \"double\" is required")
+	}
+	if !seenBool {
+		return fmt.Errorf("field \"bool\" is required")
+	}
+	if !seenUuid {
+		return fmt.Errorf("field \"uuid\" is required")
+	}
+	if !seenRid {
+		return fmt.Errorf("field \"rid\" is required")
+	}
+	if !seenDatetime {
+		return fmt.Errorf("field \"datetime\" is required")
+	}
+	if !seenBinary {
+		return fmt.Errorf("field \"binary\" is required")
+	}
+	if !seenBearer {
+		return fmt.Errorf("field \"bearer\" is required")
+	}
+	if !seenAny {
+		return fmt.Errorf("field \"any\" is required")
+	}
+	return nil
+}
+
+func (o Primitives) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *Primitives) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the Primitives is equal to other according to the Conjure semantics of its values.
+func (o Primitives) Equal(other Primitives) bool {
+	if o.Str != other.Str {
+		return false
+	}
+	if o.Int != other.Int {
+		return false
+	}
+	if o.Long != other.Long {
+		return false
+	}
+	if !(o.Double == other.Double || math.IsNaN(o.Double) && math.IsNaN(other.Double)) {
+		return false
+	}
+	if o.Bool != other.Bool {
+		return false
+	}
+	if o.Uuid != other.Uuid {
+		return false
+	}
+	if o.Rid != other.Rid {
+		return false
+	}
+	if !time.Time(o.Datetime).Equal(time.Time(other.Datetime)) {
+		return false
+	}
+	if !bytes.Equal(o.Binary, other.Binary) {
+		return false
+	}
+	if o.Bearer != other.Bearer {
+		return false
+	}
+	if !reflect.DeepEqual(o.Any, other.Any) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Primitives.
+func (o Primitives) Clone() Primitives {
+	out := o
+	if o.Binary != nil {
+		out.Binary = make([]byte, len(o.Binary))
+		copy(out.Binary, o.Binary)
+	}
+	return out
+}
@@ -0,0 +1,637 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
+)
+
+type Shape struct {
+	typ    string
+	circle *Circle
+	label  *string
+	count  **int
+	tags   *[]string
+}
+
+type shapeDeserializer struct {
+	Type   string    `json:"type"`
+	Circle *Circle   `json:"circle"`
+	Label  *string   `json:"label"`
+	Count  **int     `json:"count"`
+	Tags   *[]string `json:"tags"`
+}
+
+func (u *shapeDeserializer) toStruct() Shape {
+	return Shape{typ: u.Type, circle: u.Circle, label: u.Label, count: u.Count, tags: u.Tags}
+}
+
+func (u Shape) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	switch u.typ {
+	default:
+		return nil, fmt.Errorf("unknown type %q", u.typ)
+	case "circle":
+		if u.circle == nil {
+			return nil, fmt.Errorf("field \"circle\" is required")
+		}
+		out = append(out, "{\"type\":\"circle\",\"circle\":"...)
+		out, err = u.circle.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	case "label":
+		if u.label == nil {
+			return nil, fmt.Errorf("field \"label\" is required")
+		}
+		out = append(out, "{\"type\":\"label\",\"label\":"...)
+		out = appendJSONString(out, *u.label)
+	case "count":
+		var count *int
+		if u.count != nil {
+			count = *u.count
+		}
+		out = append(out, "{\"type\":\"count\",\"count\":"...)
+		if count == nil {
+			out = append(out, "null"...)
+		} else {
+			out = strconv.AppendInt(out, int64(*count), 10)
+		}
+	case "tags":
+		if u.tags == nil {
+			return nil, fmt.Errorf("field \"tags\" is required")
+		}
+		out = append(out, "{\"type\":\"tags\",\"tags\":"...)
+		out = append(out, '[')
+		for i, v := range *u.tags {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, v)
+		}
+		out = append(out, ']')
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (u Shape) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	switch u.typ {
+	default:
+		return 0, fmt.Errorf("unknown type %q", u.typ)
+	case "circle":
+		if u.circle == nil {
+			return 0, fmt.Errorf("field \"circle\" is required")
+		}
+		size = 27
+		n, err = u.circle.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "label":
+		if u.label == nil {
+			return 0, fmt.Errorf("field \"label\" is required")
+		}
+		size = 25
+		size += jsonStringSize(*u.label)
+	case "count":
+		var count *int
+		if u.count != nil {
+			count = *u.count
+		}
+		size = 25
+		if count == nil {
+			size += 4
+		} else {
+			size += jsonIntSize(int64(*count))
+		}
+	case "tags":
+		if u.tags == nil {
+			return 0, fmt.Errorf("field \"tags\" is required")
+		}
+		size = 23
+		size += 2
+		if len(*u.tags) > 1 {
+			size += len(*u.tags) - 1
+		}
+		for _, v := range *u.tags {
+			size += jsonStringSize(v)
+		}
+	}
+	return size, nil
+}
+
+func (u Shape) MarshalJSON() ([]byte, error) {
+	return u.AppendJSON(nil)
+}
+
+func (u *Shape) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Shape
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*u = v
+			return nil
+		}
+	}
+	var deser shapeDeserializer
+	if err := safejson.Unmarshal(data, &deser); err != nil {
+		return err
+	}
+	*u = deser.toStruct()
+	switch u.typ {
+	case "circle":
+		if u.circle == nil {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+	case "label":
+		if u.label == nil {
+			return fmt.Errorf("field \"label\" is required")
+		}
+	case "count":
+	case "tags":
+		if u.tags == nil {
+			return fmt.Errorf("field \"tags\" is required")
+		}
+	}
+	return nil
+}
+
+func (u *Shape) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenType, seenCircle, seenLabel, seenCount, seenTags bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !unmarshalJSONString(field, &u.typ) {
+				ok = false
+				return false
+			}
+		case "circle":
+			if seenCircle {
+				ok = false
+				return false
+			}
+			seenCircle = true
+			if field.Type != gjson.Null {
+				var v Circle
+				if !v.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.circle = &v
+			}
+		case "label":
+			if seenLabel {
+				ok = false
+				return false
+			}
+			seenLabel = true
+			if field.Type != gjson.Null {
+				var v1 string
+				if !unmarshalJSONString(field, &v1) {
+					ok = false
+					return false
+				}
+				u.label = &v1
+			}
+		case "count":
+			if seenCount {
+				ok = false
+				return false
+			}
+			seenCount = true
+			if field.Type != gjson.Null {
+				var v2 *int
+				if field.Type != gjson.Null {
+					var v3 int
+					if !unmarshalJSONInt(field, &v3) {
+						ok = false
+						return false
+					}
+					v2 = &v3
+				}
+				u.count = &v2
+			}
+		case "tags":
+			if seenTags {
+				ok = false
+				return false
+			}
+			seenTags = true
+			if field.Type != gjson.Null {
+				var v4 []string
+				if field.Type != gjson.Null {
+					if !field.IsArray() {
+						ok = false
+						return false
+					}
+					v4 = make([]string, 0)
+					ok1 := true
+					field.ForEach(func(_, elem gjson.Result) bool {
+						var v5 string
+						if !unmarshalJSONString(elem, &v5) {
+							ok1 = false
+							return false
+						}
+						v4 = append(v4, v5)
+						return true
+					})
+					if !ok1 {
+						ok = false
+						return false
+					}
+				}
+				u.tags = &v4
+			}
+		default:
+			if matchesJSONField(key.Str, "type", "circle", "label", "count", "tags") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	switch u.typ {
+	case "circle":
+		if u.circle == nil {
+			return false
+		}
+	case "label":
+		if u.label == nil {
+			return false
+		}
+	case "tags":
+		if u.tags == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *Shape) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return u.decodeJSONStrict(value)
+}
+
+func (u *Shape) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	var typ string
+	var variantName string
+	var variant gjson.Result
+	var seenType, seenVariant bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch {
+		case key.Str == "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			typ, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"type\": %w", err)
+				return false
+			}
+			return true
+		case seenVariant && key.Str == variantName:
+			err = fmt.Errorf("duplicate field %q", key.Str)
+			return false
+		case seenVariant:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		variantName, variant, seenVariant = key.Str, field, true
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenType {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	if seenVariant && variantName != typ {
+		return fmt.Errorf("unknown field %q", variantName)
+	}
+	*u = Shape{typ: typ}
+	switch typ {
+	case "circle":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+		var v Circle
+		err = v.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"circle\": %w", err)
+		}
+		u.circle = &v
+	case "label":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"label\" is required")
+		}
+		var v1 string
+		v1, err = decodeJSONString(variant)
+		if err != nil {
+			return fmt.Errorf("field \"label\": %w", err)
+		}
+		u.label = &v1
+	case "count":
+		var v2 *int
+		if variant.Type != gjson.Null {
+			var v3 int
+			v3, err = decodeJSONInt(variant)
+			if err != nil {
+				return fmt.Errorf("field \"count\": %w", err)
+			}
+			v2 = &v3
+		}
+		u.count = &v2
+	case "tags":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"tags\" is required")
+		}
+		var v4 []string
+		if !variant.IsArray() {
+			return fmt.Errorf("field \"tags\": %w", jsonTypeError(variant, "array"))
+		}
+		v4 = make([]string, 0)
+		variant.ForEach(func(_, elem gjson.Result) bool {
+			var v5 string
+			v5, err = decodeJSONString(elem)
+			if err != nil {
+				return false
+			}
+			v4 = append(v4, v5)
+			return true
+		})
+		if err != nil {
+			return fmt.Errorf("field \"tags\": %w", err)
+		}
+		u.tags = &v4
+	}
+	return nil
+}
+
+func (u Shape) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (u *Shape) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&u)
+}
+
+func (u *Shape) AcceptFuncs(circleFunc func(Circle) error, labelFunc func(string) error, countFunc func(*int) error, tagsFunc func([]string) error, unknownFunc func(string) error) error {
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return fmt.Errorf("invalid value in union type")
+		}
+		return unknownFunc(u.typ)
+	case "circle":
+		if u.circle == nil {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+		return circleFunc(*u.circle)
+	case "label":
+		if u.label == nil {
+			return fmt.Errorf("field \"label\" is required")
+		}
+		return labelFunc(*u.label)
+	case "count":
+		var count *int
+		if u.count != nil {
+			count = *u.count
+		}
+		return countFunc(count)
+	case "tags":
+		if u.tags == nil {
+			return fmt.Errorf("field \"tags\" is required")
+		}
+		return tagsFunc(*u.tags)
+	}
+}
+
+func (u *Shape) CircleNoopSuccess(Circle) error {
+	return nil
+}
+
+func (u *Shape) LabelNoopSuccess(string) error {
+	return nil
+}
+
+func (u *Shape) CountNoopSuccess(*int) error {
+	return nil
+}
+
+func (u *Shape) TagsNoopSuccess([]string) error {
+	return nil
+}
+
+func (u *Shape) ErrorOnUnknown(typeName string) error {
+	return fmt.Errorf("invalid value in union type. Type name: %s", typeName)
+}
+
+func (u *Shape) Accept(v ShapeVisitor) error {
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return fmt.Errorf("invalid value in union type")
+		}
+		return v.VisitUnknown(u.typ)
+	case "circle":
+		if u.circle == nil {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+		return v.VisitCircle(*u.circle)
+	case "label":
+		if u.label == nil {
+			return fmt.Errorf("field \"label\" is required")
+		}
+		return v.VisitLabel(*u.label)
+	case "count":
+		var count *int
+		if u.count != nil {
+			count = *u.count
+		}
+		return v.VisitCount(count)
+	case "tags":
+		if u.tags == nil {
+			return fmt.Errorf("field \"tags\" is required")
+		}
+		return v.VisitTags(*u.tags)
+	}
+}
+
+type ShapeVisitor interface {
+	VisitCircle(v Circle) error
+	VisitLabel(v string) error
+	VisitCount(v *int) error
+	VisitTags(v []string) error
+	VisitUnknown(typeName string) error
+}
+
+func (u *Shape) AcceptWithContext(ctx context.Context, v ShapeVisitorWithContext) error {
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return fmt.Errorf("invalid value in union type")
+		}
+		return v.VisitUnknownWithContext(ctx, u.typ)
+	case "circle":
+		if u.circle == nil {
+			return fmt.Errorf("field \"circle\" is required")
+		}
+		return v.VisitCircleWithContext(ctx, *u.circle)
+	case "label":
+		if u.label == nil {
+			return fmt.Errorf("field \"label\" is required")
+		}
+		return v.VisitLabelWithContext(ctx, *u.label)
+	case "count":
+		var count *int
+		if u.count != nil {
+			count = *u.count
+		}
+		return v.VisitCountWithContext(ctx, count)
+	case "tags":
+		if u.tags == nil {
+			return fmt.Errorf("field \"tags\" is required")
+		}
+		return v.VisitTagsWithContext(ctx, *u.tags)
+	}
+}
+
+type ShapeVisitorWithContext interface {
+	VisitCircleWithContext(ctx context.Context, v Circle) error
+	VisitLabelWithContext(ctx context.Context, v string) error
+	VisitCountWithContext(ctx context.Context, v *int) error
+	VisitTagsWithContext(ctx context.Context, v []string) error
+	VisitUnknownWithContext(ctx context.Context, typeName string) error
+}
+
+func NewShapeFromCircle(v Circle) Shape {
+	return Shape{typ: "circle", circle: &v}
+}
+
+func NewShapeFromLabel(v string) Shape {
+	return Shape{typ: "label", label: &v}
+}
+
+func NewShapeFromCount(v *int) Shape {
+	return Shape{typ: "count", count: &v}
+}
+
+func NewShapeFromTags(v []string) Shape {
+	return Shape{typ: "tags", tags: &v}
+}
+
+// Equal returns true if the Shape is equal to other according to the Conjure semantics of its values.
+func (u Shape) Equal(other Shape) bool {
+	if u.typ != other.typ {
+		return false
+	}
+	if (u.circle == nil) != (other.circle == nil) {
+		return false
+	}
+	if u.circle != nil {
+		if !(*u.circle).Equal(*other.circle) {
+			return false
+		}
+	}
+	if (u.label == nil) != (other.label == nil) {
+		return false
+	}
+	if u.label != nil {
+		if *u.label != *other.label {
+			return false
+		}
+	}
+	if (u.count == nil) != (other.count == nil) {
+		return false
+	}
+	if u.count != nil {
+		if (*u.count == nil) != (*other.count == nil) {
+			return false
+		}
+		if *u.count != nil {
+			if **u.count != **other.count {
+				return false
+			}
+		}
+	}
+	if (u.tags == nil) != (other.tags == nil) {
+		return false
+	}
+	if u.tags != nil {
+		v, otherV := *u.tags, *other.tags
+		if len(v) != len(otherV) {
+			return false
+		}
+		for i1 := range v {
+			if v[i1] != otherV[i1] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Shape.
+func (u Shape) Clone() Shape {
+	out := u
+	if u.count != nil {
+		var v *int
+		if *u.count != nil {
+			v1 := **u.count
+			v = &v1
+		}
+		out.count = &v
+	}
+	if u.tags != nil {
+		elems := *u.tags
+		var v []string
+		if elems != nil {
+			v = make([]string, len(elems))
+			copy(v, elems)
+		}
+		out.tags = &v
+	}
+	return out
+}
@@ -0,0 +1,52 @@
+// This file was generated by Conjure and should not be manually edited.
+
+//go:build go1.18
+
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+type ShapeWithT[T any] Shape
+
+func (u *ShapeWithT[T]) Accept(ctx context.Context, v ShapeVisitorWithT[T]) (T, error) {
+	var result T
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return result, fmt.Errorf("invalid value in union type")
+		}
+		return v.VisitUnknown(ctx, u.typ)
+	case "circle":
+		if u.circle == nil {
+			return result, fmt.Errorf("field \"circle\" is required")
+		}
+		return v.VisitCircle(ctx, *u.circle)
+	case "label":
+		if u.label == nil {
+			return result, fmt.Errorf("field \"label\" is required")
+		}
+		return v.VisitLabel(ctx, *u.label)
+	case "count":
+		var count *int
+		if u.count != nil {
+			count = *u.count
+		}
+		return v.VisitCount(ctx, count)
+	case "tags":
+		if u.tags == nil {
+			return result, fmt.Errorf("field \"tags\" is required")
+		}
+		return v.VisitTags(ctx, *u.tags)
+	}
+}
+
+type ShapeVisitorWithT[T any] interface {
+	VisitCircle(ctx context.Context, v Circle) (T, error)
+	VisitLabel(ctx context.Context, v string) (T, error)
+	VisitCount(ctx context.Context, v *int) (T, error)
+	VisitTags(ctx context.Context, v []string) (T, error)
+	VisitUnknown(ctx context.Context, typ string) (T, error)
+}
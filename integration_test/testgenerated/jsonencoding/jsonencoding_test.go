@@ -0,0 +1,468 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonencoding
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/jsonencoding/api"
+	"github.com/palantir/pkg/bearertoken"
+	"github.com/palantir/pkg/datetime"
+	"github.com/palantir/pkg/rid"
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safelong"
+	"github.com/palantir/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The reflection types have the same fields as the generated types but none of their methods, so safejson.Marshal
+// and safejson.Unmarshal encode and decode them the way objects without generated JSON methods are encoded and decoded.
+type (
+	reflectionPrimitives api.Primitives
+	reflectionEverything api.Everything
+	reflectionCircle     api.Circle
+	reflectionPage       api.Page
+)
+
+// previousEverything has the MarshalJSON method that was generated for Everything before AppendJSON methods were
+// generated. Primitives had no MarshalJSON method then (and has none now), so its previous encoding is its reflection
+// encoding.
+type previousEverything api.Everything
+
+func (o previousEverything) MarshalJSON() ([]byte, error) {
+	if o.Strings == nil {
+		o.Strings = make([]string, 0)
+	}
+	if o.Uuids == nil {
+		o.Uuids = make([]uuid.UUID, 0)
+	}
+	if o.UuidMap == nil {
+		o.UuidMap = make(map[uuid.UUID]string, 0)
+	}
+	if o.ColorMap == nil {
+		o.ColorMap = make(map[api.Color]int, 0)
+	}
+	if o.IntMap == nil {
+		o.IntMap = make(map[int][]float64, 0)
+	}
+	if o.RidMap == nil {
+		o.RidMap = make(map[rid.ResourceIdentifier]bool, 0)
+	}
+	if o.DatetimeMap == nil {
+		o.DatetimeMap = make(map[datetime.DateTime]uuid.UUID, 0)
+	}
+	if o.NestedMap == nil {
+		o.NestedMap = make(map[string]map[string]*api.Circle, 0)
+	}
+	if o.AliasMap == nil {
+		o.AliasMap = make(map[api.Name]api.Id, 0)
+	}
+	if o.Shapes == nil {
+		o.Shapes = make([]api.Shape, 0)
+	}
+	if o.Timestamps == nil {
+		o.Timestamps = make([]datetime.DateTime, 0)
+	}
+	type EverythingAlias previousEverything
+	return safejson.Marshal(EverythingAlias(o))
+}
+
+var (
+	uuidA = uuid.UUID{0x6b, 0x5a, 0x0d, 0xc8, 0x1f, 0x2e, 0x4f, 0x3a, 0x9a, 0x4c, 0x0e, 0x6c, 0x1e, 0x8f, 0x0b, 0x71}
+	uuidB = uuid.UUID{0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}
+	dateA = datetime.DateTime(time.Date(2026, 1, 2, 3, 4, 5, 600, time.UTC))
+	dateB = datetime.DateTime(time.Date(2025, 12, 31, 23, 59, 59, 0, time.FixedZone("", -5*60*60)))
+)
+
+func primitives() api.Primitives {
+	return api.Primitives{
+		Str:      `<a href="x">&</a> "quoted" \ tab	newline` + "\n\x01\u2028\u2029 é 😀",
+		Int:      -42,
+		Long:     safelong.SafeLong(1<<53 - 1),
+		Double:   123.456,
+		Bool:     true,
+		Uuid:     uuidA,
+		Rid:      rid.MustNew("service", "instance", "type", "locator.1"),
+		Datetime: dateA,
+		Binary:   []byte("binary\x00data"),
+		Bearer:   bearertoken.Token("token"),
+		Any:      map[string]interface{}{"b": []interface{}{1, "two", nil}, "a": 1.5},
+	}
+}
+
+func everything() api.Everything {
+	note := "note"
+	label := "label"
+	count := 3
+	return api.Everything{
+		Primitives:     primitives(),
+		OptionalString: &note,
+		OptionalCircle: &api.Circle{Radius: 1e21},
+		Strings:        []string{"a", "", "c"},
+		Uuids:          []uuid.UUID{uuidA, uuidB},
+		UuidMap:        map[uuid.UUID]string{uuidA: "a", uuidB: "b"},
+		ColorMap:       map[api.Color]int{api.New_Color(api.Color_RED): 1, api.New_Color(api.Color_GREEN): 2},
+		IntMap:         map[int][]float64{10: {0.000001, 1e-7}, 9: {-0.5}, -1: {}},
+		RidMap:         map[rid.ResourceIdentifier]bool{rid.MustNew("a", "b", "c", "d"): true},
+		DatetimeMap:    map[datetime.DateTime]uuid.UUID{dateA: uuidA, dateB: uuidB},
+		NestedMap: map[string]map[string]*api.Circle{
+			"outer": {"present": &api.Circle{Radius: 2}, "absent": nil},
+			"empty": {},
+		},
+		AliasMap: map[api.Name]api.Id{"z": api.Id(uuidA), "y": api.Id(uuidB)},
+		Shapes: []api.Shape{
+			api.NewShapeFromCircle(api.Circle{Radius: 0.5}),
+			api.NewShapeFromLabel(label),
+			api.NewShapeFromCount(&count),
+			api.NewShapeFromCount(nil),
+			api.NewShapeFromTags([]string{"x", "y"}),
+		},
+		Color:      api.New_Color(api.Color_GREEN),
+		Name:       "name",
+		Id:         api.Id(uuidB),
+		Note:       api.Note{Value: &note},
+		Timestamps: api.Timestamps{dateA, dateB},
+		Payload:    api.Payload("payload"),
+	}
+}
+
+func TestAppendJSONMatchesReflection(t *testing.T) {
+	for _, str := range []string{"", "plain", "<>&", "\"\\/", "\b\f\n\r\t\x00\x1f\x7f", "\u2028\u2029", "日本語 😀"} {
+		p := primitives()
+		p.Str = str
+		assertEncoding(t, p, reflectionPrimitives(p))
+	}
+	for _, f := range []float64{0, math.Copysign(0, -1), 1, -1.5, 1e-6, 1e-7, 123456789, 1e20, 1e21, 1.2345e-300, math.MaxFloat64, math.SmallestNonzeroFloat64} {
+		assertEncoding(t, api.Circle{Radius: f}, reflectionCircle(api.Circle{Radius: f}))
+	}
+	assertEncoding(t, primitives(), reflectionPrimitives(primitives()))
+	assertEncoding(t, everything(), reflectionEverything(everything()))
+}
+
+func TestAppendJSON(t *testing.T) {
+	out, err := api.Everything{
+		Primitives: api.Primitives{Str: "s", Rid: rid.MustNew("a", "b", "c", "d"), Binary: []byte("b"), Datetime: dateA},
+		IntMap:     map[int][]float64{10: {1e-7}, 9: nil},
+		Shapes:     []api.Shape{api.NewShapeFromCount(nil), api.NewShapeFromCircle(api.Circle{Radius: 2})},
+		Color:      api.New_Color(api.Color_RED),
+		Note:       api.Note{},
+	}.AppendJSON([]byte("prefix"))
+	require.NoError(t, err)
+	assert.Equal(t, `prefix{"primitives":{"str":"s","int":0,"long":0,"double":0,"bool":false,"uuid":"00000000-0000-0000-0000-000000000000","rid":"ri.a.b.c.d","datetime":"2026-01-02T03:04:05.0000006Z","binary":"Yg==","bearer":"","any":null},`+
+		`"optionalString":null,"optionalCircle":null,"strings":[],"uuids":[],"uuidMap":{},"colorMap":{},"intMap":{"10":[1e-7],"9":[]},"ridMap":{},"datetimeMap":{},"nestedMap":{},"aliasMap":{},`+
+		`"shapes":[{"type":"count","count":null},{"type":"circle","circle":{"radius":2}}],"color":"RED","name":"","id":"00000000-0000-0000-0000-000000000000","note":null,"timestamps":[],"payload":""}`, string(out))
+}
+
+func TestAppendJSONInvalidUTF8(t *testing.T) {
+	out, err := api.NewShapeFromLabel("a\xffb\xfe").MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `{"type":"label","label":"a\ufffdb\ufffd"}`, string(out))
+}
+
+func TestAppendJSONErrors(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		value interface {
+			AppendJSON([]byte) ([]byte, error)
+			JSONSize() (int, error)
+		}
+		err string
+	}{
+		{name: "unsafe long", value: api.Primitives{Long: safelong.SafeLong(1 << 53)}, err: "9007199254740992 is not a valid value for a SafeLong as it is not safely representable in Javascript: must be between -9007199254740991 and 9007199254740991"},
+		{name: "nested", value: api.Everything{Shapes: []api.Shape{api.NewShapeFromCircle(api.Circle{Radius: math.NaN()})}, Primitives: api.Primitives{Long: safelong.SafeLong(-1 << 53)}}, err: "-9007199254740992 is not a valid value for a SafeLong as it is not safely representable in Javascript: must be between -9007199254740991 and 9007199254740991"},
+		{name: "unknown union type", value: api.Shape{}, err: `unknown type ""`},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := test.value.AppendJSON(nil)
+			assert.EqualError(t, err, test.err)
+			_, err = test.value.JSONSize()
+			assert.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestAppendJSONNonFinite(t *testing.T) {
+	for _, test := range []struct {
+		value float64
+		want  string
+	}{
+		{value: math.NaN(), want: `{"radius":"NaN"}`},
+		{value: math.Inf(1), want: `{"radius":"Infinity"}`},
+		{value: math.Inf(-1), want: `{"radius":"-Infinity"}`},
+	} {
+		out, err := api.Circle{Radius: test.value}.AppendJSON(nil)
+		require.NoError(t, err)
+		assert.Equal(t, test.want, string(out))
+		size, err := api.Circle{Radius: test.value}.JSONSize()
+		require.NoError(t, err)
+		assert.Equal(t, len(out), size)
+	}
+}
+
+func TestUnmarshalJSONStrict(t *testing.T) {
+	want, err := everything().MarshalJSON()
+	require.NoError(t, err)
+	var got api.Everything
+	require.NoError(t, got.UnmarshalJSONStrict(want))
+	out, err := got.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, string(want), string(out))
+
+	t.Run("missing collections", func(t *testing.T) {
+		var shape api.Shape
+		require.NoError(t, shape.UnmarshalJSONStrict([]byte(`{"type":"tags","tags":[]}`)))
+		var got api.Everything
+		require.NoError(t, got.UnmarshalJSONStrict([]byte(`{"primitives":`+primitivesJSON+`,"strings":null,"shapes":[],"color":"RED","name":"n","id":"`+uuidA.String()+`","note":null,"timestamps":[],"payload":""}`)))
+		assert.Equal(t, []string{}, got.Strings)
+		assert.Equal(t, map[api.Color]int{}, got.ColorMap)
+		assert.Nil(t, got.OptionalString)
+		assert.Nil(t, got.Note.Value)
+	})
+	t.Run("non-finite doubles", func(t *testing.T) {
+		var got api.Circle
+		require.NoError(t, got.UnmarshalJSONStrict([]byte(`{"radius":"-Infinity"}`)))
+		assert.Equal(t, math.Inf(-1), got.Radius)
+		require.NoError(t, got.UnmarshalJSONStrict([]byte(`{"radius":"NaN"}`)))
+		assert.True(t, math.IsNaN(got.Radius))
+	})
+	t.Run("unknown union type", func(t *testing.T) {
+		var got api.Shape
+		require.NoError(t, got.UnmarshalJSONStrict([]byte(`{"type":"square","square":{}}`)))
+		out, err := got.AppendJSON(nil)
+		assert.Nil(t, out)
+		assert.EqualError(t, err, `unknown type "square"`)
+	})
+}
+
+const primitivesJSON = `{"str":"","int":0,"long":0,"double":0,"bool":false,"uuid":"00000000-0000-0000-0000-000000000000","rid":"ri.a.b.c.d","datetime":"2026-01-02T03:04:05Z","binary":"","bearer":"token","any":{}}`
+
+func TestUnmarshalJSONStrictErrors(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		value interface{ UnmarshalJSONStrict([]byte) error }
+		data  string
+		err   string
+	}{
+		{name: "invalid JSON", value: &api.Circle{}, data: `{"radius":01.5}`, err: `invalid JSON`},
+		{name: "trailing data", value: &api.Circle{}, data: `{"radius":1} {}`, err: `invalid JSON`},
+		{name: "not an object", value: &api.Circle{}, data: `[]`, err: `expected object but found array`},
+		{name: "unknown field", value: &api.Circle{}, data: `{"radius":1,"diameter":2}`, err: `unknown field "diameter"`},
+		{name: "duplicate field", value: &api.Circle{}, data: `{"radius":1,"radius":2}`, err: `duplicate field "radius"`},
+		{name: "missing field", value: &api.Circle{}, data: `{}`, err: `field "radius" is required`},
+		{name: "null field", value: &api.Circle{}, data: `{"radius":null}`, err: `field "radius": expected double but found null`},
+		{name: "invalid double", value: &api.Circle{}, data: `{"radius":"nan"}`, err: `field "radius": invalid double "nan"`},
+		{name: "integer with fraction", value: &api.Primitives{}, data: `{"int":1.5}`, err: `field "int": invalid integer 1.5`},
+		{name: "integer overflow", value: &api.Primitives{}, data: `{"int":2147483648}`, err: `field "int": invalid integer 2147483648`},
+		{name: "integer string", value: &api.Primitives{}, data: `{"int":"1"}`, err: `field "int": expected integer but found string`},
+		{name: "unsafe long", value: &api.Primitives{}, data: `{"long":9007199254740992}`, err: `field "long": 9007199254740992 is not a valid value for a SafeLong as it is not safely representable in Javascript: must be between -9007199254740991 and 9007199254740991`},
+		{name: "datetime precision", value: &api.Primitives{}, data: `{"datetime":"2026-01-02T03:04:05.0000000001Z"}`, err: `field "datetime": invalid datetime "2026-01-02T03:04:05.0000000001Z"`},
+		{name: "datetime zone", value: &api.Primitives{}, data: `{"datetime":"2026-01-02T03:04:05Z[UTC]"}`, err: `field "datetime": invalid datetime "2026-01-02T03:04:05Z[UTC]"`},
+		{name: "bearer token", value: &api.Primitives{}, data: `{"bearer":"secret token"}`, err: `field "bearer": invalid bearer token`},
+		{name: "null any", value: &api.Primitives{}, data: `{"any":null}`, err: `field "any": expected value but found null`},
+		{name: "invalid enum", value: new(api.Color), data: `"red"`, err: `invalid enum value "red"`},
+		{name: "duplicate set element", value: &api.Everything{}, data: `{"primitives":` + primitivesJSON + `,"uuids":["` + uuidA.String() + `","` + uuidA.String() + `"]}`, err: `field "uuids": duplicate set element "` + uuidA.String() + `"`},
+		{name: "duplicate map key", value: &api.Everything{}, data: `{"intMap":{"1":[],"1":[]}}`, err: `field "intMap": duplicate map key "1"`},
+		{name: "nested", value: &api.Everything{}, data: `{"nestedMap":{"a":{"b":{"radius":true}}}}`, err: `field "nestedMap": field "radius": expected double but found boolean`},
+		{name: "union without type", value: &api.Shape{}, data: `{"label":"x"}`, err: `field "type" is required`},
+		{name: "union with mismatched variant", value: &api.Shape{}, data: `{"type":"label","circle":{}}`, err: `unknown field "circle"`},
+		{name: "union with two variants", value: &api.Shape{}, data: `{"type":"label","label":"x","circle":{}}`, err: `unknown field "circle"`},
+		{name: "union with null variant", value: &api.Shape{}, data: `{"type":"label","label":null}`, err: `field "label" is required`},
+		{name: "alias", value: new(api.Id), data: `"x"`, err: `invalid UUID length: 1`},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			assert.EqualError(t, test.value.UnmarshalJSONStrict([]byte(test.data)), test.err)
+		})
+	}
+}
+
+func TestUnmarshalJSONMatchesReflection(t *testing.T) {
+	data, err := everything().MarshalJSON()
+	require.NoError(t, err)
+	for _, test := range []struct {
+		name string
+		data string
+	}{
+		{name: "everything", data: string(data)},
+		{name: "empty", data: `{}`},
+		{name: "null", data: `null`},
+		{name: "null fields", data: `{"primitives":null,"optionalCircle":null,"strings":null,"uuidMap":null,"shapes":[null],"color":null,"id":null,"note":null,"timestamps":null}`},
+		{name: "null elements", data: `{"strings":[null],"uuids":[null],"intMap":{"1":null},"nestedMap":{"a":null,"b":{"c":null}}}`},
+		{name: "unknown fields", data: `{"unknown":{"a":[1,{}]},"name":"n"}`},
+		{name: "case-insensitive fields", data: `{"NAME":"n","optionalcircle":{"RADIUS":1}}`},
+		{name: "duplicate fields", data: `{"strings":["a"],"strings":["b"],"name":"a","name":null}`},
+		{name: "duplicate map keys", data: `{"intMap":{"1":[1],"1":[2]}}`},
+		{name: "escaped strings", data: `{"name":"\u0041\n\"\/","strings":["\ud83d\ude00","\ud800"],"aliasMap":{"\u006b":"` + uuidA.String() + `"}}`},
+		{name: "invalid UTF-8", data: "{\"name\":\"a\xffb\"}"},
+		{name: "integer keys", data: `{"intMap":{"+1":[],"-0":[1]}}`},
+		{name: "any", data: `{"primitives":{"any":{"a":[1,"x",null,true,{"b":-1.5e400}],"a":{},"c":"\ud83d"}}}`},
+		{name: "union variants", data: `{"shapes":[{"type":"label","label":"a","circle":{"radius":1}},{"type":"count","count":null},{"type":"unknown"},{}]}`},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var got api.Everything
+			require.NoError(t, safejson.Unmarshal([]byte(test.data), &got))
+			var want reflectionEverything
+			require.NoError(t, safejson.Unmarshal([]byte(test.data), &want))
+			// the generated UnmarshalJSON initializes nil collections but not nil binary values
+			fields := reflect.ValueOf(&want).Elem()
+			for i := 0; i < fields.NumField(); i++ {
+				if field := fields.Field(i); field.Kind() == reflect.Slice && field.Type().Elem().Kind() != reflect.Uint8 && field.IsNil() {
+					field.Set(reflect.MakeSlice(field.Type(), 0, 0))
+				} else if field.Kind() == reflect.Map && field.IsNil() {
+					field.Set(reflect.MakeMap(field.Type()))
+				}
+			}
+			assert.Equal(t, api.Everything(want), got)
+		})
+	}
+}
+
+func TestUnmarshalJSONErrors(t *testing.T) {
+	// errors are returned by the reflection-based implementation. The messages of encoding/json errors differ between
+	// Go versions, so only their stable parts and types are checked.
+	for _, test := range []struct {
+		name    string
+		value   json.Unmarshaler
+		data    string
+		err     string
+		typeErr bool
+	}{
+		{name: "invalid JSON", value: &api.Everything{}, data: `{"name":"n"`, err: `unexpected EOF`},
+		{name: "not an object", value: &api.Everything{}, data: `[]`, err: `cannot unmarshal array`, typeErr: true},
+		{name: "invalid field", value: &api.Everything{}, data: `{"strings":[1]}`, err: `cannot unmarshal number`, typeErr: true},
+		{name: "invalid nested field", value: &api.Page{}, data: `{"items":[{"int":1.5}]}`, err: `cannot unmarshal number 1.5`, typeErr: true},
+		{name: "unsafe long", value: &api.Page{}, data: `{"items":[{"long":9007199254740992}]}`, err: `9007199254740992 is not a valid value for a SafeLong as it is not safely representable in Javascript: must be between -9007199254740991 and 9007199254740991`},
+		{name: "invalid enum", value: &api.Everything{}, data: `{"color":1}`, err: `cannot unmarshal number`, typeErr: true},
+		{name: "invalid map key", value: &api.Everything{}, data: `{"intMap":{"a":[]}}`, err: `cannot unmarshal number a`, typeErr: true},
+		{name: "union with null variant", value: &api.Shape{}, data: `{"type":"label","label":null}`, err: `field "label" is required`},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.value.UnmarshalJSON([]byte(test.data))
+			assert.ErrorContains(t, err, test.err)
+			if test.typeErr {
+				var typeErr *json.UnmarshalTypeError
+				assert.ErrorAs(t, err, &typeErr)
+			}
+		})
+	}
+}
+
+// assertEncoding asserts that the generated encoding of value matches the reflection-based encoding of the reflection
+// value and that JSONSize returns the exact length of the encoding.
+func assertEncoding(t *testing.T, value interface {
+	AppendJSON([]byte) ([]byte, error)
+	JSONSize() (int, error)
+}, reflectionValue interface{}) {
+	t.Helper()
+	want, err := safejson.Marshal(reflectionValue)
+	require.NoError(t, err)
+	got, err := value.AppendJSON(nil)
+	require.NoError(t, err)
+	assert.Equal(t, string(want), string(got))
+	size, err := value.JSONSize()
+	require.NoError(t, err)
+	assert.Equal(t, len(got), size)
+	marshalled, err := safejson.Marshal(value)
+	require.NoError(t, err)
+	assert.Equal(t, string(want), string(marshalled))
+}
+
+// BenchmarkMarshalJSON compares encoding the generated types using json.Marshal and AppendJSON with encoding them using
+// json.Marshal and the previously generated methods.
+func BenchmarkMarshalJSON(b *testing.B) {
+	for _, bench := range []struct {
+		name     string
+		value    interface{}
+		previous interface{}
+	}{
+		{name: "Primitives", value: primitives(), previous: reflectionPrimitives(primitives())},
+		{name: "Everything", value: everything(), previous: previousEverything(everything())},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			b.Run("Generated", func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					if _, err := json.Marshal(bench.value); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+			b.Run("AppendJSON", func(b *testing.B) {
+				value := bench.value.(interface {
+					AppendJSON([]byte) ([]byte, error)
+				})
+				var buf []byte
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					var err error
+					if buf, err = value.AppendJSON(buf[:0]); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+			b.Run("Previous", func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					if _, err := json.Marshal(bench.previous); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	page := api.Page{}
+	for i := 0; i < 1000; i++ {
+		page.Items = append(page.Items, primitives())
+	}
+	for _, bench := range []struct {
+		name       string
+		value      interface{}
+		generated  func() json.Unmarshaler
+		reflection func() interface{}
+	}{
+		{name: "Everything", value: everything(), generated: func() json.Unmarshaler { return new(api.Everything) }, reflection: func() interface{} { return new(reflectionEverything) }},
+		{name: "Page", value: page, generated: func() json.Unmarshaler { return new(api.Page) }, reflection: func() interface{} { return new(reflectionPage) }},
+	} {
+		data, err := json.Marshal(bench.value)
+		require.NoError(b, err)
+		b.Run(bench.name, func(b *testing.B) {
+			b.Run("Generated", func(b *testing.B) {
+				b.ReportAllocs()
+				b.SetBytes(int64(len(data)))
+				for i := 0; i < b.N; i++ {
+					if err := bench.generated().UnmarshalJSON(data); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+			b.Run("Reflection", func(b *testing.B) {
+				b.ReportAllocs()
+				b.SetBytes(int64(len(data)))
+				for i := 0; i < b.N; i++ {
+					if err := safejson.Unmarshal(data, bench.reflection()); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		})
+	}
+}
@@ -3,16 +3,35 @@
 package api
 
 import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"sort"
+
 	"github.com/palantir/pkg/binary"
 	"github.com/palantir/pkg/rid"
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
 	"github.com/palantir/pkg/uuid"
+	"github.com/tidwall/gjson"
 )
 
 type AnyAlias interface{}
 type BinaryAlias []byte
 
+func (a BinaryAlias) AppendJSON(out []byte) ([]byte, error) {
+	return appendJSONBinary(out, a), nil
+}
+
+func (a BinaryAlias) JSONSize() (int, error) {
+	return base64.StdEncoding.EncodedLen(len(a)) + 2, nil
+}
+
+func (a BinaryAlias) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
 func (a BinaryAlias) String() string {
 	return binary.New(a).String()
 }
@@ -46,15 +65,355 @@ func (a *BinaryAlias) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+func (a *BinaryAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *BinaryAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []byte
+	v, err = decodeJSONBinary(value)
+	if err != nil {
+		return err
+	}
+	*a = BinaryAlias(v)
+	return nil
+}
+
+// Equal returns true if the BinaryAlias is equal to other according to the Conjure semantics of its values.
+func (a BinaryAlias) Equal(other BinaryAlias) bool {
+	return bytes.Equal(a, other)
+}
+
+// Clone returns a deep copy of the BinaryAlias.
+func (a BinaryAlias) Clone() BinaryAlias {
+	var out BinaryAlias
+	if a != nil {
+		out = make(BinaryAlias, len(a))
+		copy(out, a)
+	}
+	return out
+}
+
 type ListLongAlias []interface{}
+
+func (a *ListLongAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *ListLongAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v []interface{}
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	v = make([]interface{}, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v1 interface{}
+		err = safejson.Unmarshal([]byte(elem.Raw), &v1)
+		if err != nil {
+			return false
+		}
+		v = append(v, v1)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = ListLongAlias(v)
+	return nil
+}
+
+// Equal returns true if the ListLongAlias is equal to other according to the Conjure semantics of its values.
+func (a ListLongAlias) Equal(other ListLongAlias) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for i := range a {
+		if !reflect.DeepEqual(a[i], other[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the ListLongAlias.
+func (a ListLongAlias) Clone() ListLongAlias {
+	var out ListLongAlias
+	if a != nil {
+		out = make(ListLongAlias, len(a))
+		copy(out, a)
+	}
+	return out
+}
+
 type LongAlias interface{}
 type MapLongAlias map[string]interface{}
+
+func (a *MapLongAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *MapLongAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v map[string]interface{}
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	v = make(map[string]interface{})
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k string
+		k = key.Str
+		if _, ok := v[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v1 interface{}
+		err = safejson.Unmarshal([]byte(elem.Raw), &v1)
+		if err != nil {
+			return false
+		}
+		v[k] = v1
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = MapLongAlias(v)
+	return nil
+}
+
+// Equal returns true if the MapLongAlias is equal to other according to the Conjure semantics of its values.
+func (a MapLongAlias) Equal(other MapLongAlias) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for k, v := range a {
+		otherV, ok := other[k]
+		if !ok {
+			return false
+		}
+		if !reflect.DeepEqual(v, otherV) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the MapLongAlias.
+func (a MapLongAlias) Clone() MapLongAlias {
+	var out MapLongAlias
+	if a != nil {
+		out = make(MapLongAlias, len(a))
+		for k, v := range a {
+			out[k] = v
+		}
+	}
+	return out
+}
+
 type MapStringAny map[string]interface{}
+
+func (a *MapStringAny) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *MapStringAny) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v map[string]interface{}
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	v = make(map[string]interface{})
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k string
+		k = key.Str
+		if _, ok := v[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v1 interface{}
+		v1, err = decodeJSONAny(elem)
+		if err != nil {
+			return false
+		}
+		v[k] = v1
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = MapStringAny(v)
+	return nil
+}
+
+// Equal returns true if the MapStringAny is equal to other according to the Conjure semantics of its values.
+func (a MapStringAny) Equal(other MapStringAny) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for k, v := range a {
+		otherV, ok := other[k]
+		if !ok {
+			return false
+		}
+		if !reflect.DeepEqual(v, otherV) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the MapStringAny.
+func (a MapStringAny) Clone() MapStringAny {
+	var out MapStringAny
+	if a != nil {
+		out = make(MapStringAny, len(a))
+		for k, v := range a {
+			out[k] = v
+		}
+	}
+	return out
+}
+
 type MapStringAnyAlias map[string]AnyAlias
+
+func (a *MapStringAnyAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *MapStringAnyAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v map[string]AnyAlias
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	v = make(map[string]AnyAlias)
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k string
+		k = key.Str
+		if _, ok := v[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v1 AnyAlias
+		err = safejson.Unmarshal([]byte(elem.Raw), &v1)
+		if err != nil {
+			return false
+		}
+		v[k] = v1
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = MapStringAnyAlias(v)
+	return nil
+}
+
+// Equal returns true if the MapStringAnyAlias is equal to other according to the Conjure semantics of its values.
+func (a MapStringAnyAlias) Equal(other MapStringAnyAlias) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for k, v := range a {
+		otherV, ok := other[k]
+		if !ok {
+			return false
+		}
+		if !reflect.DeepEqual(v, otherV) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the MapStringAnyAlias.
+func (a MapStringAnyAlias) Clone() MapStringAnyAlias {
+	var out MapStringAnyAlias
+	if a != nil {
+		out = make(MapStringAnyAlias, len(a))
+		for k, v := range a {
+			out[k] = v
+		}
+	}
+	return out
+}
+
 type MapUuidLongAlias map[uuid.UUID]interface{}
 
+func (a MapUuidLongAlias) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value interface{}
+		}
+		entries := make([]mapEntry, 0, len(map[uuid.UUID]interface{}(a)))
+		for k, v := range map[uuid.UUID]interface{}(a) {
+			entries = append(entries, mapEntry{key: k.String(), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out, err = appendJSONMarshal(out, entry.value)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (a MapUuidLongAlias) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	size += 2
+	if len(map[uuid.UUID]interface{}(a)) > 1 {
+		size += len(map[uuid.UUID]interface{}(a)) - 1
+	}
+	size += len(map[uuid.UUID]interface{}(a)) * 39
+	for _, v := range map[uuid.UUID]interface{}(a) {
+		n, err = jsonMarshalSize(v)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
 func (a MapUuidLongAlias) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal(map[uuid.UUID]interface{}(a))
+	return a.AppendJSON(nil)
 }
 
 func (a *MapUuidLongAlias) UnmarshalJSON(data []byte) error {
@@ -82,8 +441,137 @@ func (a *MapUuidLongAlias) UnmarshalYAML(unmarshal func(interface{}) error) erro
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+func (a *MapUuidLongAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *MapUuidLongAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v map[uuid.UUID]interface{}
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	v = make(map[uuid.UUID]interface{})
+	value.ForEach(func(key, elem gjson.Result) bool {
+		var k uuid.UUID
+		k, err = decodeJSONUUID(key)
+		if err != nil {
+			return false
+		}
+		if _, ok := v[k]; ok {
+			err = fmt.Errorf("duplicate map key %s", key.Raw)
+			return false
+		}
+		var v1 interface{}
+		err = safejson.Unmarshal([]byte(elem.Raw), &v1)
+		if err != nil {
+			return false
+		}
+		v[k] = v1
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*a = MapUuidLongAlias(v)
+	return nil
+}
+
+// Equal returns true if the MapUuidLongAlias is equal to other according to the Conjure semantics of its values.
+func (a MapUuidLongAlias) Equal(other MapUuidLongAlias) bool {
+	if len(a) != len(other) {
+		return false
+	}
+	for k, v := range a {
+		otherV, ok := other[k]
+		if !ok {
+			return false
+		}
+		if !reflect.DeepEqual(v, otherV) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the MapUuidLongAlias.
+func (a MapUuidLongAlias) Clone() MapUuidLongAlias {
+	var out MapUuidLongAlias
+	if a != nil {
+		out = make(MapUuidLongAlias, len(a))
+		for k, v := range a {
+			out[k] = v
+		}
+	}
+	return out
+}
+
 type NestedAlias1 NestedAlias2
+
+func (a *NestedAlias1) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *NestedAlias1) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v NestedAlias2
+	err = v.decodeJSONStrict(value)
+	if err != nil {
+		return err
+	}
+	*a = NestedAlias1(v)
+	return nil
+}
+
+// Equal returns true if the NestedAlias1 is equal to other according to the Conjure semantics of its values.
+func (a NestedAlias1) Equal(other NestedAlias1) bool {
+	return NestedAlias2(a).Equal(NestedAlias2(other))
+}
+
+// Clone returns a deep copy of the NestedAlias1.
+func (a NestedAlias1) Clone() NestedAlias1 {
+	return NestedAlias1(NestedAlias2(a).Clone())
+}
+
 type NestedAlias2 NestedAlias3
+
+func (a *NestedAlias2) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *NestedAlias2) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v NestedAlias3
+	err = v.decodeJSONStrict(value)
+	if err != nil {
+		return err
+	}
+	*a = NestedAlias2(v)
+	return nil
+}
+
+// Equal returns true if the NestedAlias2 is equal to other according to the Conjure semantics of its values.
+func (a NestedAlias2) Equal(other NestedAlias2) bool {
+	return NestedAlias3(a).Equal(NestedAlias3(other))
+}
+
+// Clone returns a deep copy of the NestedAlias2.
+func (a NestedAlias2) Clone() NestedAlias2 {
+	return NestedAlias2(NestedAlias3(a).Clone())
+}
+
 type NestedAlias3 struct {
 	Value *string
 }
@@ -95,11 +583,27 @@ func (a NestedAlias3) MarshalText() ([]byte, error) {
 	return []byte(*a.Value), nil
 }
 
-func (a NestedAlias3) MarshalJSON() ([]byte, error) {
+func (a NestedAlias3) AppendJSON(out []byte) ([]byte, error) {
+	if a.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, *a.Value)
+	}
+	return out, nil
+}
+
+func (a NestedAlias3) JSONSize() (int, error) {
+	var size int
 	if a.Value == nil {
-		return []byte("null"), nil
+		size += 4
+	} else {
+		size += jsonStringSize(*a.Value)
 	}
-	return safejson.Marshal(a.Value)
+	return size, nil
+}
+
+func (a NestedAlias3) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
 }
 
 func (a *NestedAlias3) UnmarshalText(data []byte) error {
@@ -108,6 +612,29 @@ func (a *NestedAlias3) UnmarshalText(data []byte) error {
 	return nil
 }
 
+func (a *NestedAlias3) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *NestedAlias3) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *string
+	if value.Type != gjson.Null {
+		var v1 string
+		v1, err = decodeJSONString(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = NestedAlias3{Value: v}
+	return nil
+}
+
 func (a NestedAlias3) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
@@ -124,15 +651,64 @@ func (a *NestedAlias3) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+// Equal returns true if the NestedAlias3 is equal to other according to the Conjure semantics of its values.
+func (a NestedAlias3) Equal(other NestedAlias3) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if *a.Value != *other.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the NestedAlias3.
+func (a NestedAlias3) Clone() NestedAlias3 {
+	out := a
+	if a.Value != nil {
+		v := *a.Value
+		out.Value = &v
+	}
+	return out
+}
+
 type OptionalStructAlias struct {
 	Value *Basic
 }
 
-func (a OptionalStructAlias) MarshalJSON() ([]byte, error) {
+func (a OptionalStructAlias) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	if a.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out, err = a.Value.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func (a OptionalStructAlias) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
 	if a.Value == nil {
-		return []byte("null"), nil
+		size += 4
+	} else {
+		n, err = a.Value.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
 	}
-	return safejson.Marshal(a.Value)
+	return size, nil
+}
+
+func (a OptionalStructAlias) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
 }
 
 func (a *OptionalStructAlias) UnmarshalJSON(data []byte) error {
@@ -142,6 +718,29 @@ func (a *OptionalStructAlias) UnmarshalJSON(data []byte) error {
 	return safejson.Unmarshal(data, a.Value)
 }
 
+func (a *OptionalStructAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *OptionalStructAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *Basic
+	if value.Type != gjson.Null {
+		var v1 Basic
+		err = v1.decodeJSONStrict(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = OptionalStructAlias{Value: v}
+	return nil
+}
+
 func (a OptionalStructAlias) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
@@ -158,6 +757,29 @@ func (a *OptionalStructAlias) UnmarshalYAML(unmarshal func(interface{}) error) e
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+// Equal returns true if the OptionalStructAlias is equal to other according to the Conjure semantics of its values.
+func (a OptionalStructAlias) Equal(other OptionalStructAlias) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if !(*a.Value).Equal(*other.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalStructAlias.
+func (a OptionalStructAlias) Clone() OptionalStructAlias {
+	out := a
+	if a.Value != nil {
+		v := *a.Value
+		out.Value = &v
+	}
+	return out
+}
+
 type OptionalUuidAlias struct {
 	Value *uuid.UUID
 }
@@ -169,11 +791,27 @@ func (a OptionalUuidAlias) MarshalText() ([]byte, error) {
 	return a.Value.MarshalText()
 }
 
-func (a OptionalUuidAlias) MarshalJSON() ([]byte, error) {
+func (a OptionalUuidAlias) AppendJSON(out []byte) ([]byte, error) {
 	if a.Value == nil {
-		return []byte("null"), nil
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONUUID(out, *a.Value)
 	}
-	return safejson.Marshal(a.Value)
+	return out, nil
+}
+
+func (a OptionalUuidAlias) JSONSize() (int, error) {
+	var size int
+	if a.Value == nil {
+		size += 4
+	} else {
+		size += 38
+	}
+	return size, nil
+}
+
+func (a OptionalUuidAlias) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
 }
 
 func (a *OptionalUuidAlias) UnmarshalText(data []byte) error {
@@ -183,6 +821,29 @@ func (a *OptionalUuidAlias) UnmarshalText(data []byte) error {
 	return a.Value.UnmarshalText(data)
 }
 
+func (a *OptionalUuidAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *OptionalUuidAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *uuid.UUID
+	if value.Type != gjson.Null {
+		var v1 uuid.UUID
+		v1, err = decodeJSONUUID(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = OptionalUuidAlias{Value: v}
+	return nil
+}
+
 func (a OptionalUuidAlias) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
@@ -199,8 +860,56 @@ func (a *OptionalUuidAlias) UnmarshalYAML(unmarshal func(interface{}) error) err
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+// Equal returns true if the OptionalUuidAlias is equal to other according to the Conjure semantics of its values.
+func (a OptionalUuidAlias) Equal(other OptionalUuidAlias) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if (*a.Value) != (*other.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalUuidAlias.
+func (a OptionalUuidAlias) Clone() OptionalUuidAlias {
+	out := a
+	if a.Value != nil {
+		v := *a.Value
+		out.Value = &v
+	}
+	return out
+}
+
 type RidAlias rid.ResourceIdentifier
 
+func (a RidAlias) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out, err = appendJSONText(out, rid.ResourceIdentifier(a))
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (a RidAlias) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	n, err = jsonTextSize(rid.ResourceIdentifier(a))
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (a RidAlias) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
 func (a RidAlias) String() string {
 	return rid.ResourceIdentifier(a).String()
 }
@@ -234,10 +943,114 @@ func (a *RidAlias) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+func (a *RidAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *RidAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v rid.ResourceIdentifier
+	v, err = decodeJSONRID(value)
+	if err != nil {
+		return err
+	}
+	*a = RidAlias(v)
+	return nil
+}
+
+// Equal returns true if the RidAlias is equal to other according to the Conjure semantics of its values.
+func (a RidAlias) Equal(other RidAlias) bool {
+	return rid.ResourceIdentifier(a) == rid.ResourceIdentifier(other)
+}
+
+// Clone returns a deep copy of the RidAlias.
+func (a RidAlias) Clone() RidAlias {
+	return a
+}
+
 type StringAlias string
+
+func (a *StringAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *StringAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v string
+	v, err = decodeJSONString(value)
+	if err != nil {
+		return err
+	}
+	*a = StringAlias(v)
+	return nil
+}
+
+// Equal returns true if the StringAlias is equal to other according to the Conjure semantics of its values.
+func (a StringAlias) Equal(other StringAlias) bool {
+	return string(a) == string(other)
+}
+
+// Clone returns a deep copy of the StringAlias.
+func (a StringAlias) Clone() StringAlias {
+	return a
+}
+
 type StringAliasAlias StringAlias
+
+func (a *StringAliasAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *StringAliasAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v StringAlias
+	err = v.decodeJSONStrict(value)
+	if err != nil {
+		return err
+	}
+	*a = StringAliasAlias(v)
+	return nil
+}
+
+// Equal returns true if the StringAliasAlias is equal to other according to the Conjure semantics of its values.
+func (a StringAliasAlias) Equal(other StringAliasAlias) bool {
+	return StringAlias(a).Equal(StringAlias(other))
+}
+
+// Clone returns a deep copy of the StringAliasAlias.
+func (a StringAliasAlias) Clone() StringAliasAlias {
+	return a
+}
+
 type UuidAlias uuid.UUID
 
+func (a UuidAlias) AppendJSON(out []byte) ([]byte, error) {
+	out = appendJSONUUID(out, uuid.UUID(a))
+	return out, nil
+}
+
+func (a UuidAlias) JSONSize() (int, error) {
+	var size int
+	size += 38
+	return size, nil
+}
+
+func (a UuidAlias) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
 func (a UuidAlias) String() string {
 	return uuid.UUID(a).String()
 }
@@ -271,10 +1084,60 @@ func (a *UuidAlias) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+func (a *UuidAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *UuidAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v uuid.UUID
+	v, err = decodeJSONUUID(value)
+	if err != nil {
+		return err
+	}
+	*a = UuidAlias(v)
+	return nil
+}
+
+// Equal returns true if the UuidAlias is equal to other according to the Conjure semantics of its values.
+func (a UuidAlias) Equal(other UuidAlias) bool {
+	return a == other
+}
+
+// Clone returns a deep copy of the UuidAlias.
+func (a UuidAlias) Clone() UuidAlias {
+	return a
+}
+
 type UuidAlias2 Compound
 
+func (a UuidAlias2) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out, err = Compound(a).AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (a UuidAlias2) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	n, err = Compound(a).JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
 func (a UuidAlias2) MarshalJSON() ([]byte, error) {
-	return safejson.Marshal(Compound(a))
+	return a.AppendJSON(nil)
 }
 
 func (a *UuidAlias2) UnmarshalJSON(data []byte) error {
@@ -301,3 +1164,32 @@ func (a *UuidAlias2) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	}
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
+
+func (a *UuidAlias2) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *UuidAlias2) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v Compound
+	err = v.decodeJSONStrict(value)
+	if err != nil {
+		return err
+	}
+	*a = UuidAlias2(v)
+	return nil
+}
+
+// Equal returns true if the UuidAlias2 is equal to other according to the Conjure semantics of its values.
+func (a UuidAlias2) Equal(other UuidAlias2) bool {
+	return Compound(a).Equal(Compound(other))
+}
+
+// Clone returns a deep copy of the UuidAlias2.
+func (a UuidAlias2) Clone() UuidAlias2 {
+	return UuidAlias2(Compound(a).Clone())
+}
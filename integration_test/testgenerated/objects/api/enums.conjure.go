@@ -4,6 +4,8 @@ package api
 
 import (
 	"strings"
+
+	"github.com/tidwall/gjson"
 )
 
 type Days struct {
@@ -63,6 +65,36 @@ func (e *Days) UnmarshalText(data []byte) error {
 	return nil
 }
 
+func (e Days) AppendJSON(out []byte) ([]byte, error) {
+	return appendJSONString(out, string(e.val)), nil
+}
+
+func (e Days) JSONSize() (int, error) {
+	return jsonStringSize(string(e.val)), nil
+}
+
+func (e *Days) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return e.decodeJSONStrict(value)
+}
+
+func (e *Days) decodeJSONStrict(value gjson.Result) error {
+	return decodeJSONEnum(value, e)
+}
+
+// Equal returns true if the Days is equal to other according to the Conjure semantics of its values.
+func (e Days) Equal(other Days) bool {
+	return e.val == other.val
+}
+
+// Clone returns a deep copy of the Days.
+func (e Days) Clone() Days {
+	return e
+}
+
 type EmptyValuesEnum struct {
 	val EmptyValuesEnum_Value
 }
@@ -110,6 +142,36 @@ func (e *EmptyValuesEnum) UnmarshalText(data []byte) error {
 	return nil
 }
 
+func (e EmptyValuesEnum) AppendJSON(out []byte) ([]byte, error) {
+	return appendJSONString(out, string(e.val)), nil
+}
+
+func (e EmptyValuesEnum) JSONSize() (int, error) {
+	return jsonStringSize(string(e.val)), nil
+}
+
+func (e *EmptyValuesEnum) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return e.decodeJSONStrict(value)
+}
+
+func (e *EmptyValuesEnum) decodeJSONStrict(value gjson.Result) error {
+	return decodeJSONEnum(value, e)
+}
+
+// Equal returns true if the EmptyValuesEnum is equal to other according to the Conjure semantics of its values.
+func (e EmptyValuesEnum) Equal(other EmptyValuesEnum) bool {
+	return e.val == other.val
+}
+
+// Clone returns a deep copy of the EmptyValuesEnum.
+func (e EmptyValuesEnum) Clone() EmptyValuesEnum {
+	return e
+}
+
 // this is an enum
 type Enum struct {
 	val Enum_Value
@@ -184,3 +246,33 @@ func (e *Enum) UnmarshalText(data []byte) error {
 	}
 	return nil
 }
+
+func (e Enum) AppendJSON(out []byte) ([]byte, error) {
+	return appendJSONString(out, string(e.val)), nil
+}
+
+func (e Enum) JSONSize() (int, error) {
+	return jsonStringSize(string(e.val)), nil
+}
+
+func (e *Enum) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return e.decodeJSONStrict(value)
+}
+
+func (e *Enum) decodeJSONStrict(value gjson.Result) error {
+	return decodeJSONEnum(value, e)
+}
+
+// Equal returns true if the Enum is equal to other according to the Conjure semantics of its values.
+func (e Enum) Equal(other Enum) bool {
+	return e.val == other.val
+}
+
+// Clone returns a deep copy of the Enum.
+func (e Enum) Clone() Enum {
+	return e
+}
@@ -3,17 +3,126 @@
 package api
 
 import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
 	"github.com/palantir/pkg/binary"
 	"github.com/palantir/pkg/boolean"
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
 	"github.com/palantir/pkg/uuid"
+	"github.com/tidwall/gjson"
 )
 
 type AnyValue struct {
 	Value interface{} `json:"value"`
 }
 
+func (o AnyValue) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"value\":"...)
+	out, err = appendJSONMarshal(out, o.Value)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o AnyValue) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 10
+	n, err = jsonMarshalSize(o.Value)
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (o *AnyValue) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenValue bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				ok = false
+				return false
+			}
+			seenValue = true
+			if !unmarshalJSONAny(field, &o.Value) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "value") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *AnyValue) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *AnyValue) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = AnyValue{}
+	var seenValue bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "value":
+			if seenValue {
+				err = fmt.Errorf("duplicate field \"value\"")
+				return false
+			}
+			seenValue = true
+			o.Value, err = decodeJSONAny(field)
+			if err != nil {
+				err = fmt.Errorf("field \"value\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenValue {
+		return fmt.Errorf("field \"value\" is required")
+	}
+	return nil
+}
+
 func (o AnyValue) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -30,6 +139,20 @@ func (o *AnyValue) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the AnyValue is equal to other according to the Conjure semantics of its values.
+func (o AnyValue) Equal(other AnyValue) bool {
+	if !reflect.DeepEqual(o.Value, other.Value) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the AnyValue.
+func (o AnyValue) Clone() AnyValue {
+	out := o
+	return out
+}
+
 type Basic struct {
 	/*
 	   A docs string with
@@ -38,6 +161,97 @@ type Basic struct {
 	Data string `conjure-docs:"A docs string with\nnewline and \"quotes\"." json:"data"`
 }
 
+func (o Basic) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"data\":"...)
+	out = appendJSONString(out, o.Data)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Basic) JSONSize() (int, error) {
+	size := 9
+	size += jsonStringSize(o.Data)
+	return size, nil
+}
+
+func (o *Basic) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenData bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "data":
+			if seenData {
+				ok = false
+				return false
+			}
+			seenData = true
+			if !unmarshalJSONString(field, &o.Data) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "data") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *Basic) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Basic) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Basic{}
+	var seenData bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "data":
+			if seenData {
+				err = fmt.Errorf("duplicate field \"data\"")
+				return false
+			}
+			seenData = true
+			o.Data, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"data\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenData {
+		return fmt.Errorf("field \"data\" is required")
+	}
+	return nil
+}
+
 func (o Basic) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -54,19 +268,86 @@ func (o *Basic) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the Basic is equal to other according to the Conjure semantics of its values.
+func (o Basic) Equal(other Basic) bool {
+	if o.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Basic.
+func (o Basic) Clone() Basic {
+	out := o
+	return out
+}
+
 type BinaryMap struct {
 	Map map[binary.Binary][]byte `json:"map"`
 }
 
-func (o BinaryMap) MarshalJSON() ([]byte, error) {
-	if o.Map == nil {
-		o.Map = make(map[binary.Binary][]byte, 0)
+func (o BinaryMap) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"map\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value []byte
+		}
+		entries := make([]mapEntry, 0, len(o.Map))
+		for k, v := range o.Map {
+			entries = append(entries, mapEntry{key: string(k), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			if entry.value == nil {
+				out = append(out, "null"...)
+			} else {
+				out = appendJSONBinary(out, entry.value)
+			}
+		}
 	}
-	type BinaryMapAlias BinaryMap
-	return safejson.Marshal(BinaryMapAlias(o))
+	out = append(out, '}')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o BinaryMap) JSONSize() (int, error) {
+	size := 8
+	size += 2
+	if len(o.Map) > 1 {
+		size += len(o.Map) - 1
+	}
+	for k, v := range o.Map {
+		size += jsonStringSize(string(k)) + 1
+		if v == nil {
+			size += 4
+		} else {
+			size += base64.StdEncoding.EncodedLen(len(v)) + 2
+		}
+	}
+	return size, nil
+}
+
+func (o BinaryMap) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *BinaryMap) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v BinaryMap
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type BinaryMapAlias BinaryMap
 	var rawBinaryMap BinaryMapAlias
 	if err := safejson.Unmarshal(data, &rawBinaryMap); err != nil {
@@ -79,6 +360,137 @@ func (o *BinaryMap) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *BinaryMap) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Map == nil {
+			o.Map = make(map[binary.Binary][]byte, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenMap bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "map":
+			if seenMap {
+				ok = false
+				return false
+			}
+			seenMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.Map = make(map[binary.Binary][]byte)
+				ok1 := true
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k binary.Binary
+					var s string
+					if !unmarshalJSONString(key1, &s) || k.UnmarshalText([]byte(s)) != nil {
+						ok1 = false
+						return false
+					}
+					var v []byte
+					if !unmarshalJSONBinary(elem, &v) {
+						ok1 = false
+						return false
+					}
+					o.Map[k] = v
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "map") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Map == nil {
+		o.Map = make(map[binary.Binary][]byte, 0)
+	}
+	return true
+}
+
+func (o *BinaryMap) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *BinaryMap) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = BinaryMap{}
+	var seenMap bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "map":
+			if seenMap {
+				err = fmt.Errorf("duplicate field \"map\"")
+				return false
+			}
+			seenMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"map\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.Map = make(map[binary.Binary][]byte)
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k binary.Binary
+					k, err = decodeJSONBinaryKey(key1)
+					if err != nil {
+						return false
+					}
+					if _, ok := o.Map[k]; ok {
+						err = fmt.Errorf("duplicate map key %s", key1.Raw)
+						return false
+					}
+					var v []byte
+					v, err = decodeJSONBinary(elem)
+					if err != nil {
+						return false
+					}
+					o.Map[k] = v
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"map\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if o.Map == nil {
+		o.Map = make(map[binary.Binary][]byte, 0)
+	}
+	return nil
+}
+
 func (o BinaryMap) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -95,19 +507,98 @@ func (o *BinaryMap) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the BinaryMap is equal to other according to the Conjure semantics of its values.
+func (o BinaryMap) Equal(other BinaryMap) bool {
+	if len(o.Map) != len(other.Map) {
+		return false
+	}
+	for k, v := range o.Map {
+		otherV, ok := other.Map[k]
+		if !ok {
+			return false
+		}
+		if !bytes.Equal(v, otherV) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the BinaryMap.
+func (o BinaryMap) Clone() BinaryMap {
+	out := o
+	if o.Map != nil {
+		out.Map = make(map[binary.Binary][]byte, len(o.Map))
+		for k, v := range o.Map {
+			var elem []byte
+			if v != nil {
+				elem = make([]byte, len(v))
+				copy(elem, v)
+			}
+			out.Map[k] = elem
+		}
+	}
+	return out
+}
+
 type BooleanIntegerMap struct {
 	Map map[boolean.Boolean]int `json:"map"`
 }
 
-func (o BooleanIntegerMap) MarshalJSON() ([]byte, error) {
-	if o.Map == nil {
-		o.Map = make(map[boolean.Boolean]int, 0)
+func (o BooleanIntegerMap) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"map\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value int
+		}
+		entries := make([]mapEntry, 0, len(o.Map))
+		for k, v := range o.Map {
+			entries = append(entries, mapEntry{key: strconv.FormatBool(bool(k)), value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = strconv.AppendInt(out, int64(entry.value), 10)
+		}
 	}
-	type BooleanIntegerMapAlias BooleanIntegerMap
-	return safejson.Marshal(BooleanIntegerMapAlias(o))
+	out = append(out, '}')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o BooleanIntegerMap) JSONSize() (int, error) {
+	size := 8
+	size += 2
+	if len(o.Map) > 1 {
+		size += len(o.Map) - 1
+	}
+	for k, v := range o.Map {
+		size += jsonStringSize(strconv.FormatBool(bool(k))) + 1
+		size += jsonIntSize(int64(v))
+	}
+	return size, nil
+}
+
+func (o BooleanIntegerMap) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *BooleanIntegerMap) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v BooleanIntegerMap
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type BooleanIntegerMapAlias BooleanIntegerMap
 	var rawBooleanIntegerMap BooleanIntegerMapAlias
 	if err := safejson.Unmarshal(data, &rawBooleanIntegerMap); err != nil {
@@ -120,6 +611,137 @@ func (o *BooleanIntegerMap) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *BooleanIntegerMap) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Map == nil {
+			o.Map = make(map[boolean.Boolean]int, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenMap bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "map":
+			if seenMap {
+				ok = false
+				return false
+			}
+			seenMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.Map = make(map[boolean.Boolean]int)
+				ok1 := true
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k boolean.Boolean
+					var s string
+					if !unmarshalJSONString(key1, &s) || k.UnmarshalText([]byte(s)) != nil {
+						ok1 = false
+						return false
+					}
+					var v int
+					if !unmarshalJSONInt(elem, &v) {
+						ok1 = false
+						return false
+					}
+					o.Map[k] = v
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "map") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Map == nil {
+		o.Map = make(map[boolean.Boolean]int, 0)
+	}
+	return true
+}
+
+func (o *BooleanIntegerMap) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *BooleanIntegerMap) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = BooleanIntegerMap{}
+	var seenMap bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "map":
+			if seenMap {
+				err = fmt.Errorf("duplicate field \"map\"")
+				return false
+			}
+			seenMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"map\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.Map = make(map[boolean.Boolean]int)
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k boolean.Boolean
+					k, err = decodeJSONBooleanKey(key1)
+					if err != nil {
+						return false
+					}
+					if _, ok := o.Map[k]; ok {
+						err = fmt.Errorf("duplicate map key %s", key1.Raw)
+						return false
+					}
+					var v int
+					v, err = decodeJSONInt(elem)
+					if err != nil {
+						return false
+					}
+					o.Map[k] = v
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"map\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if o.Map == nil {
+		o.Map = make(map[boolean.Boolean]int, 0)
+	}
+	return nil
+}
+
 func (o BooleanIntegerMap) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -136,6 +758,35 @@ func (o *BooleanIntegerMap) UnmarshalYAML(unmarshal func(interface{}) error) err
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the BooleanIntegerMap is equal to other according to the Conjure semantics of its values.
+func (o BooleanIntegerMap) Equal(other BooleanIntegerMap) bool {
+	if len(o.Map) != len(other.Map) {
+		return false
+	}
+	for k, v := range o.Map {
+		otherV, ok := other.Map[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the BooleanIntegerMap.
+func (o BooleanIntegerMap) Clone() BooleanIntegerMap {
+	out := o
+	if o.Map != nil {
+		out.Map = make(map[boolean.Boolean]int, len(o.Map))
+		for k, v := range o.Map {
+			out.Map[k] = v
+		}
+	}
+	return out
+}
+
 type Collections struct {
 	/*
 	   field docs
@@ -147,21 +798,147 @@ type Collections struct {
 	MultiDim [][]map[string]int `json:"multiDim"`
 }
 
-func (o Collections) MarshalJSON() ([]byte, error) {
-	if o.MapVar == nil {
-		o.MapVar = make(map[string][]int, 0)
+func (o Collections) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"mapVar\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value []int
+		}
+		entries := make([]mapEntry, 0, len(o.MapVar))
+		for k, v := range o.MapVar {
+			entries = append(entries, mapEntry{key: k, value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = append(out, '[')
+			for i1, v1 := range entry.value {
+				if i1 > 0 {
+					out = append(out, ',')
+				}
+				out = strconv.AppendInt(out, int64(v1), 10)
+			}
+			out = append(out, ']')
+		}
 	}
-	if o.ListVar == nil {
-		o.ListVar = make([]string, 0)
+	out = append(out, '}')
+	out = append(out, ",\"listVar\":"...)
+	out = append(out, '[')
+	for i, v := range o.ListVar {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
 	}
-	if o.MultiDim == nil {
-		o.MultiDim = make([][]map[string]int, 0)
+	out = append(out, ']')
+	out = append(out, ",\"multiDim\":"...)
+	out = append(out, '[')
+	for i, v := range o.MultiDim {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = append(out, '[')
+		for i1, v1 := range v {
+			if i1 > 0 {
+				out = append(out, ',')
+			}
+			out = append(out, '{')
+			{
+				type mapEntry2 struct {
+					key   string
+					value int
+				}
+				entries2 := make([]mapEntry2, 0, len(v1))
+				for k2, v2 := range v1 {
+					entries2 = append(entries2, mapEntry2{key: k2, value: v2})
+				}
+				sort.Slice(entries2, func(i, j int) bool {
+					return entries2[i].key < entries2[j].key
+				})
+				for i2, entry2 := range entries2 {
+					if i2 > 0 {
+						out = append(out, ',')
+					}
+					out = appendJSONString(out, entry2.key)
+					out = append(out, ':')
+					out = strconv.AppendInt(out, int64(entry2.value), 10)
+				}
+			}
+			out = append(out, '}')
+		}
+		out = append(out, ']')
 	}
-	type CollectionsAlias Collections
-	return safejson.Marshal(CollectionsAlias(o))
+	out = append(out, ']')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Collections) JSONSize() (int, error) {
+	size := 34
+	size += 2
+	if len(o.MapVar) > 1 {
+		size += len(o.MapVar) - 1
+	}
+	for k, v := range o.MapVar {
+		size += jsonStringSize(k) + 1
+		size += 2
+		if len(v) > 1 {
+			size += len(v) - 1
+		}
+		for _, v1 := range v {
+			size += jsonIntSize(int64(v1))
+		}
+	}
+	size += 2
+	if len(o.ListVar) > 1 {
+		size += len(o.ListVar) - 1
+	}
+	for _, v := range o.ListVar {
+		size += jsonStringSize(v)
+	}
+	size += 2
+	if len(o.MultiDim) > 1 {
+		size += len(o.MultiDim) - 1
+	}
+	for _, v := range o.MultiDim {
+		size += 2
+		if len(v) > 1 {
+			size += len(v) - 1
+		}
+		for _, v1 := range v {
+			size += 2
+			if len(v1) > 1 {
+				size += len(v1) - 1
+			}
+			for k2, v2 := range v1 {
+				size += jsonStringSize(k2) + 1
+				size += jsonIntSize(int64(v2))
+			}
+		}
+	}
+	return size, nil
+}
+
+func (o Collections) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *Collections) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Collections
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type CollectionsAlias Collections
 	var rawCollections CollectionsAlias
 	if err := safejson.Unmarshal(data, &rawCollections); err != nil {
@@ -180,6 +957,358 @@ func (o *Collections) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *Collections) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.MapVar == nil {
+			o.MapVar = make(map[string][]int, 0)
+		}
+		if o.ListVar == nil {
+			o.ListVar = make([]string, 0)
+		}
+		if o.MultiDim == nil {
+			o.MultiDim = make([][]map[string]int, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenMapVar, seenListVar, seenMultiDim bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "mapVar":
+			if seenMapVar {
+				ok = false
+				return false
+			}
+			seenMapVar = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.MapVar = make(map[string][]int)
+				ok1 := true
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k string
+					if !unmarshalJSONString(key1, &k) {
+						ok1 = false
+						return false
+					}
+					var v []int
+					if elem.Type != gjson.Null {
+						if !elem.IsArray() {
+							ok1 = false
+							return false
+						}
+						v = make([]int, 0)
+						ok2 := true
+						elem.ForEach(func(_, elem1 gjson.Result) bool {
+							var v1 int
+							if !unmarshalJSONInt(elem1, &v1) {
+								ok2 = false
+								return false
+							}
+							v = append(v, v1)
+							return true
+						})
+						if !ok2 {
+							ok1 = false
+							return false
+						}
+					}
+					o.MapVar[k] = v
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "listVar":
+			if seenListVar {
+				ok = false
+				return false
+			}
+			seenListVar = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.ListVar = make([]string, 0)
+				ok3 := true
+				field.ForEach(func(_, elem2 gjson.Result) bool {
+					var v2 string
+					if !unmarshalJSONString(elem2, &v2) {
+						ok3 = false
+						return false
+					}
+					o.ListVar = append(o.ListVar, v2)
+					return true
+				})
+				if !ok3 {
+					ok = false
+					return false
+				}
+			}
+		case "multiDim":
+			if seenMultiDim {
+				ok = false
+				return false
+			}
+			seenMultiDim = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.MultiDim = make([][]map[string]int, 0)
+				ok4 := true
+				field.ForEach(func(_, elem3 gjson.Result) bool {
+					var v3 []map[string]int
+					if elem3.Type != gjson.Null {
+						if !elem3.IsArray() {
+							ok4 = false
+							return false
+						}
+						v3 = make([]map[string]int, 0)
+						ok5 := true
+						elem3.ForEach(func(_, elem4 gjson.Result) bool {
+							var v4 map[string]int
+							if elem4.Type != gjson.Null {
+								if !elem4.IsObject() {
+									ok5 = false
+									return false
+								}
+								v4 = make(map[string]int)
+								ok6 := true
+								elem4.ForEach(func(key2, elem5 gjson.Result) bool {
+									var k1 string
+									if !unmarshalJSONString(key2, &k1) {
+										ok6 = false
+										return false
+									}
+									var v5 int
+									if !unmarshalJSONInt(elem5, &v5) {
+										ok6 = false
+										return false
+									}
+									v4[k1] = v5
+									return true
+								})
+								if !ok6 {
+									ok5 = false
+									return false
+								}
+							}
+							v3 = append(v3, v4)
+							return true
+						})
+						if !ok5 {
+							ok4 = false
+							return false
+						}
+					}
+					o.MultiDim = append(o.MultiDim, v3)
+					return true
+				})
+				if !ok4 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "mapVar", "listVar", "multiDim") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.MapVar == nil {
+		o.MapVar = make(map[string][]int, 0)
+	}
+	if o.ListVar == nil {
+		o.ListVar = make([]string, 0)
+	}
+	if o.MultiDim == nil {
+		o.MultiDim = make([][]map[string]int, 0)
+	}
+	return true
+}
+
+func (o *Collections) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Collections) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Collections{}
+	var seenMapVar, seenListVar, seenMultiDim bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "mapVar":
+			if seenMapVar {
+				err = fmt.Errorf("duplicate field \"mapVar\"")
+				return false
+			}
+			seenMapVar = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"mapVar\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.MapVar = make(map[string][]int)
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k string
+					k = key1.Str
+					if _, ok := o.MapVar[k]; ok {
+						err = fmt.Errorf("duplicate map key %s", key1.Raw)
+						return false
+					}
+					var v []int
+					if !elem.IsArray() {
+						err = jsonTypeError(elem, "array")
+						return false
+					}
+					v = make([]int, 0)
+					elem.ForEach(func(_, elem1 gjson.Result) bool {
+						var v1 int
+						v1, err = decodeJSONInt(elem1)
+						if err != nil {
+							return false
+						}
+						v = append(v, v1)
+						return true
+					})
+					if err != nil {
+						return false
+					}
+					o.MapVar[k] = v
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"mapVar\": %w", err)
+					return false
+				}
+			}
+		case "listVar":
+			if seenListVar {
+				err = fmt.Errorf("duplicate field \"listVar\"")
+				return false
+			}
+			seenListVar = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"listVar\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.ListVar = make([]string, 0)
+				field.ForEach(func(_, elem2 gjson.Result) bool {
+					var v2 string
+					v2, err = decodeJSONString(elem2)
+					if err != nil {
+						return false
+					}
+					o.ListVar = append(o.ListVar, v2)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"listVar\": %w", err)
+					return false
+				}
+			}
+		case "multiDim":
+			if seenMultiDim {
+				err = fmt.Errorf("duplicate field \"multiDim\"")
+				return false
+			}
+			seenMultiDim = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"multiDim\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.MultiDim = make([][]map[string]int, 0)
+				field.ForEach(func(_, elem3 gjson.Result) bool {
+					var v3 []map[string]int
+					if !elem3.IsArray() {
+						err = jsonTypeError(elem3, "array")
+						return false
+					}
+					v3 = make([]map[string]int, 0)
+					elem3.ForEach(func(_, elem4 gjson.Result) bool {
+						var v4 map[string]int
+						if !elem4.IsObject() {
+							err = jsonTypeError(elem4, "object")
+							return false
+						}
+						v4 = make(map[string]int)
+						elem4.ForEach(func(key2, elem5 gjson.Result) bool {
+							var k1 string
+							k1 = key2.Str
+							if _, ok := v4[k1]; ok {
+								err = fmt.Errorf("duplicate map key %s", key2.Raw)
+								return false
+							}
+							var v5 int
+							v5, err = decodeJSONInt(elem5)
+							if err != nil {
+								return false
+							}
+							v4[k1] = v5
+							return true
+						})
+						if err != nil {
+							return false
+						}
+						v3 = append(v3, v4)
+						return true
+					})
+					if err != nil {
+						return false
+					}
+					o.MultiDim = append(o.MultiDim, v3)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"multiDim\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if o.MapVar == nil {
+		o.MapVar = make(map[string][]int, 0)
+	}
+	if o.ListVar == nil {
+		o.ListVar = make([]string, 0)
+	}
+	if o.MultiDim == nil {
+		o.MultiDim = make([][]map[string]int, 0)
+	}
+	return nil
+}
+
 func (o Collections) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -196,10 +1325,200 @@ func (o *Collections) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the Collections is equal to other according to the Conjure semantics of its values.
+func (o Collections) Equal(other Collections) bool {
+	if len(o.MapVar) != len(other.MapVar) {
+		return false
+	}
+	for k, v := range o.MapVar {
+		otherV, ok := other.MapVar[k]
+		if !ok {
+			return false
+		}
+		if len(v) != len(otherV) {
+			return false
+		}
+		for i1 := range v {
+			if v[i1] != otherV[i1] {
+				return false
+			}
+		}
+	}
+	if len(o.ListVar) != len(other.ListVar) {
+		return false
+	}
+	for i := range o.ListVar {
+		if o.ListVar[i] != other.ListVar[i] {
+			return false
+		}
+	}
+	if len(o.MultiDim) != len(other.MultiDim) {
+		return false
+	}
+	for i := range o.MultiDim {
+		if len(o.MultiDim[i]) != len(other.MultiDim[i]) {
+			return false
+		}
+		for i1 := range o.MultiDim[i] {
+			if len(o.MultiDim[i][i1]) != len(other.MultiDim[i][i1]) {
+				return false
+			}
+			for k2, v2 := range o.MultiDim[i][i1] {
+				otherV2, ok2 := other.MultiDim[i][i1][k2]
+				if !ok2 {
+					return false
+				}
+				if v2 != otherV2 {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Collections.
+func (o Collections) Clone() Collections {
+	out := o
+	if o.MapVar != nil {
+		out.MapVar = make(map[string][]int, len(o.MapVar))
+		for k, v := range o.MapVar {
+			var elem []int
+			if v != nil {
+				elem = make([]int, len(v))
+				copy(elem, v)
+			}
+			out.MapVar[k] = elem
+		}
+	}
+	if o.ListVar != nil {
+		out.ListVar = make([]string, len(o.ListVar))
+		copy(out.ListVar, o.ListVar)
+	}
+	if o.MultiDim != nil {
+		out.MultiDim = make([][]map[string]int, len(o.MultiDim))
+		for i := range o.MultiDim {
+			if o.MultiDim[i] != nil {
+				out.MultiDim[i] = make([]map[string]int, len(o.MultiDim[i]))
+				for i1 := range o.MultiDim[i] {
+					if o.MultiDim[i][i1] != nil {
+						out.MultiDim[i][i1] = make(map[string]int, len(o.MultiDim[i][i1]))
+						for k2, v2 := range o.MultiDim[i][i1] {
+							out.MultiDim[i][i1][k2] = v2
+						}
+					}
+				}
+			}
+		}
+	}
+	return out
+}
+
 type Compound struct {
 	Obj Collections `json:"obj"`
 }
 
+func (o Compound) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"obj\":"...)
+	out, err = o.Obj.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Compound) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 8
+	n, err = o.Obj.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (o *Compound) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenObj bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "obj":
+			if seenObj {
+				ok = false
+				return false
+			}
+			seenObj = true
+			if !o.Obj.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "obj") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *Compound) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Compound) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Compound{}
+	var seenObj bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "obj":
+			if seenObj {
+				err = fmt.Errorf("duplicate field \"obj\"")
+				return false
+			}
+			seenObj = true
+			err = o.Obj.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"obj\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenObj {
+		return fmt.Errorf("field \"obj\" is required")
+	}
+	return nil
+}
+
 func (o Compound) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -216,10 +1535,119 @@ func (o *Compound) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the Compound is equal to other according to the Conjure semantics of its values.
+func (o Compound) Equal(other Compound) bool {
+	if !o.Obj.Equal(other.Obj) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Compound.
+func (o Compound) Clone() Compound {
+	out := o
+	out.Obj = o.Obj.Clone()
+	return out
+}
+
 type ExampleUuid struct {
 	Uid uuid.UUID `json:"uid"`
 }
 
+func (o ExampleUuid) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"uid\":"...)
+	out = appendJSONUUID(out, o.Uid)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o ExampleUuid) JSONSize() (int, error) {
+	size := 8
+	size += 38
+	return size, nil
+}
+
+func (o *ExampleUuid) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenUid bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "uid":
+			if seenUid {
+				ok = false
+				return false
+			}
+			seenUid = true
+			if field.Type != gjson.Null {
+				var s string
+				if !unmarshalJSONString(field, &s) || o.Uid.UnmarshalText([]byte(s)) != nil {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "uid") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *ExampleUuid) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *ExampleUuid) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = ExampleUuid{}
+	var seenUid bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "uid":
+			if seenUid {
+				err = fmt.Errorf("duplicate field \"uid\"")
+				return false
+			}
+			seenUid = true
+			o.Uid, err = decodeJSONUUID(field)
+			if err != nil {
+				err = fmt.Errorf("field \"uid\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenUid {
+		return fmt.Errorf("field \"uid\" is required")
+	}
+	return nil
+}
+
 func (o ExampleUuid) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -236,19 +1664,88 @@ func (o *ExampleUuid) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the ExampleUuid is equal to other according to the Conjure semantics of its values.
+func (o ExampleUuid) Equal(other ExampleUuid) bool {
+	if o.Uid != other.Uid {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the ExampleUuid.
+func (o ExampleUuid) Clone() ExampleUuid {
+	out := o
+	return out
+}
+
 type MapOptional struct {
 	Map map[string]OptionalUuidAlias `json:"map"`
 }
 
-func (o MapOptional) MarshalJSON() ([]byte, error) {
-	if o.Map == nil {
-		o.Map = make(map[string]OptionalUuidAlias, 0)
+func (o MapOptional) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"map\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value OptionalUuidAlias
+		}
+		entries := make([]mapEntry, 0, len(o.Map))
+		for k, v := range o.Map {
+			entries = append(entries, mapEntry{key: k, value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out, err = entry.value.AppendJSON(out)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
-	type MapOptionalAlias MapOptional
-	return safejson.Marshal(MapOptionalAlias(o))
+	out = append(out, '}')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o MapOptional) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 8
+	size += 2
+	if len(o.Map) > 1 {
+		size += len(o.Map) - 1
+	}
+	for k, v := range o.Map {
+		size += jsonStringSize(k) + 1
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (o MapOptional) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *MapOptional) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v MapOptional
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type MapOptionalAlias MapOptional
 	var rawMapOptional MapOptionalAlias
 	if err := safejson.Unmarshal(data, &rawMapOptional); err != nil {
@@ -261,6 +1758,136 @@ func (o *MapOptional) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *MapOptional) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Map == nil {
+			o.Map = make(map[string]OptionalUuidAlias, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenMap bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "map":
+			if seenMap {
+				ok = false
+				return false
+			}
+			seenMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.Map = make(map[string]OptionalUuidAlias)
+				ok1 := true
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k string
+					if !unmarshalJSONString(key1, &k) {
+						ok1 = false
+						return false
+					}
+					var v OptionalUuidAlias
+					if elem.Type != gjson.Null {
+						var s string
+						if !unmarshalJSONString(elem, &s) || v.UnmarshalText([]byte(s)) != nil {
+							ok1 = false
+							return false
+						}
+					}
+					o.Map[k] = v
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "map") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Map == nil {
+		o.Map = make(map[string]OptionalUuidAlias, 0)
+	}
+	return true
+}
+
+func (o *MapOptional) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *MapOptional) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = MapOptional{}
+	var seenMap bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "map":
+			if seenMap {
+				err = fmt.Errorf("duplicate field \"map\"")
+				return false
+			}
+			seenMap = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"map\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.Map = make(map[string]OptionalUuidAlias)
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k string
+					k = key1.Str
+					if _, ok := o.Map[k]; ok {
+						err = fmt.Errorf("duplicate map key %s", key1.Raw)
+						return false
+					}
+					var v OptionalUuidAlias
+					err = v.decodeJSONStrict(elem)
+					if err != nil {
+						return false
+					}
+					o.Map[k] = v
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"map\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if o.Map == nil {
+		o.Map = make(map[string]OptionalUuidAlias, 0)
+	}
+	return nil
+}
+
 func (o MapOptional) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -277,23 +1904,143 @@ func (o *MapOptional) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the MapOptional is equal to other according to the Conjure semantics of its values.
+func (o MapOptional) Equal(other MapOptional) bool {
+	if len(o.Map) != len(other.Map) {
+		return false
+	}
+	for k, v := range o.Map {
+		otherV, ok := other.Map[k]
+		if !ok {
+			return false
+		}
+		if !v.Equal(otherV) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the MapOptional.
+func (o MapOptional) Clone() MapOptional {
+	out := o
+	if o.Map != nil {
+		out.Map = make(map[string]OptionalUuidAlias, len(o.Map))
+		for k, v := range o.Map {
+			out.Map[k] = v.Clone()
+		}
+	}
+	return out
+}
+
 type MapStringAnyObject struct {
 	MapStringAny      MapStringAny      `json:"mapStringAny"`
 	MapStringAnyAlias MapStringAnyAlias `json:"mapStringAnyAlias"`
 }
 
-func (o MapStringAnyObject) MarshalJSON() ([]byte, error) {
-	if o.MapStringAny == nil {
-		o.MapStringAny = make(map[string]interface{}, 0)
+func (o MapStringAnyObject) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"mapStringAny\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value interface{}
+		}
+		entries := make([]mapEntry, 0, len(map[string]interface{}(o.MapStringAny)))
+		for k, v := range map[string]interface{}(o.MapStringAny) {
+			entries = append(entries, mapEntry{key: k, value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out, err = appendJSONMarshal(out, entry.value)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
-	if o.MapStringAnyAlias == nil {
-		o.MapStringAnyAlias = make(map[string]AnyAlias, 0)
+	out = append(out, '}')
+	out = append(out, ",\"mapStringAnyAlias\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value AnyAlias
+		}
+		entries := make([]mapEntry, 0, len(map[string]AnyAlias(o.MapStringAnyAlias)))
+		for k, v := range map[string]AnyAlias(o.MapStringAnyAlias) {
+			entries = append(entries, mapEntry{key: k, value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out, err = appendJSONMarshal(out, interface{}(entry.value))
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
-	type MapStringAnyObjectAlias MapStringAnyObject
-	return safejson.Marshal(MapStringAnyObjectAlias(o))
+	out = append(out, '}')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o MapStringAnyObject) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 38
+	size += 2
+	if len(map[string]interface{}(o.MapStringAny)) > 1 {
+		size += len(map[string]interface{}(o.MapStringAny)) - 1
+	}
+	for k, v := range map[string]interface{}(o.MapStringAny) {
+		size += jsonStringSize(k) + 1
+		n, err = jsonMarshalSize(v)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 2
+	if len(map[string]AnyAlias(o.MapStringAnyAlias)) > 1 {
+		size += len(map[string]AnyAlias(o.MapStringAnyAlias)) - 1
+	}
+	for k, v := range map[string]AnyAlias(o.MapStringAnyAlias) {
+		size += jsonStringSize(k) + 1
+		n, err = jsonMarshalSize(interface{}(v))
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (o MapStringAnyObject) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *MapStringAnyObject) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v MapStringAnyObject
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type MapStringAnyObjectAlias MapStringAnyObject
 	var rawMapStringAnyObject MapStringAnyObjectAlias
 	if err := safejson.Unmarshal(data, &rawMapStringAnyObject); err != nil {
@@ -309,6 +2056,172 @@ func (o *MapStringAnyObject) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *MapStringAnyObject) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.MapStringAny == nil {
+			o.MapStringAny = make(map[string]interface{}, 0)
+		}
+		if o.MapStringAnyAlias == nil {
+			o.MapStringAnyAlias = make(map[string]AnyAlias, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenMapStringAny, seenMapStringAnyAlias bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "mapStringAny":
+			if seenMapStringAny {
+				ok = false
+				return false
+			}
+			seenMapStringAny = true
+			var v map[string]interface{}
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				v = make(map[string]interface{})
+				ok1 := true
+				field.ForEach(func(key1, elem gjson.Result) bool {
+					var k string
+					if !unmarshalJSONString(key1, &k) {
+						ok1 = false
+						return false
+					}
+					var v1 interface{}
+					if !unmarshalJSONAny(elem, &v1) {
+						ok1 = false
+						return false
+					}
+					v[k] = v1
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+			o.MapStringAny = MapStringAny(v)
+		case "mapStringAnyAlias":
+			if seenMapStringAnyAlias {
+				ok = false
+				return false
+			}
+			seenMapStringAnyAlias = true
+			var v2 map[string]AnyAlias
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				v2 = make(map[string]AnyAlias)
+				ok2 := true
+				field.ForEach(func(key2, elem1 gjson.Result) bool {
+					var k1 string
+					if !unmarshalJSONString(key2, &k1) {
+						ok2 = false
+						return false
+					}
+					var v3 AnyAlias
+					if safejson.Unmarshal([]byte(elem1.Raw), &v3) != nil {
+						ok2 = false
+						return false
+					}
+					v2[k1] = v3
+					return true
+				})
+				if !ok2 {
+					ok = false
+					return false
+				}
+			}
+			o.MapStringAnyAlias = MapStringAnyAlias(v2)
+		default:
+			if matchesJSONField(key.Str, "mapStringAny", "mapStringAnyAlias") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.MapStringAny == nil {
+		o.MapStringAny = make(map[string]interface{}, 0)
+	}
+	if o.MapStringAnyAlias == nil {
+		o.MapStringAnyAlias = make(map[string]AnyAlias, 0)
+	}
+	return true
+}
+
+func (o *MapStringAnyObject) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *MapStringAnyObject) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = MapStringAnyObject{}
+	var seenMapStringAny, seenMapStringAnyAlias bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "mapStringAny":
+			if seenMapStringAny {
+				err = fmt.Errorf("duplicate field \"mapStringAny\"")
+				return false
+			}
+			seenMapStringAny = true
+			if field.Type != gjson.Null {
+				err = o.MapStringAny.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"mapStringAny\": %w", err)
+					return false
+				}
+			}
+		case "mapStringAnyAlias":
+			if seenMapStringAnyAlias {
+				err = fmt.Errorf("duplicate field \"mapStringAnyAlias\"")
+				return false
+			}
+			seenMapStringAnyAlias = true
+			if field.Type != gjson.Null {
+				err = o.MapStringAnyAlias.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"mapStringAnyAlias\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if o.MapStringAny == nil {
+		o.MapStringAny = make(map[string]interface{}, 0)
+	}
+	if o.MapStringAnyAlias == nil {
+		o.MapStringAnyAlias = make(map[string]AnyAlias, 0)
+	}
+	return nil
+}
+
 func (o MapStringAnyObject) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -325,6 +2238,25 @@ func (o *MapStringAnyObject) UnmarshalYAML(unmarshal func(interface{}) error) er
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the MapStringAnyObject is equal to other according to the Conjure semantics of its values.
+func (o MapStringAnyObject) Equal(other MapStringAnyObject) bool {
+	if !o.MapStringAny.Equal(other.MapStringAny) {
+		return false
+	}
+	if !o.MapStringAnyAlias.Equal(other.MapStringAnyAlias) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the MapStringAnyObject.
+func (o MapStringAnyObject) Clone() MapStringAnyObject {
+	out := o
+	out.MapStringAny = o.MapStringAny.Clone()
+	out.MapStringAnyAlias = o.MapStringAnyAlias.Clone()
+	return out
+}
+
 type OptionalFields struct {
 	Opt1 *string           `json:"opt1"`
 	Opt2 *string           `json:"opt2"`
@@ -332,6 +2264,214 @@ type OptionalFields struct {
 	Opt3 OptionalUuidAlias `json:"opt3"`
 }
 
+func (o OptionalFields) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"opt1\":"...)
+	if o.Opt1 == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, *o.Opt1)
+	}
+	out = append(out, ",\"opt2\":"...)
+	if o.Opt2 == nil {
+		out = append(out, "null"...)
+	} else {
+		out = appendJSONString(out, *o.Opt2)
+	}
+	out = append(out, ",\"reqd\":"...)
+	out = appendJSONString(out, o.Reqd)
+	out = append(out, ",\"opt3\":"...)
+	out, err = o.Opt3.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o OptionalFields) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 33
+	if o.Opt1 == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(*o.Opt1)
+	}
+	if o.Opt2 == nil {
+		size += 4
+	} else {
+		size += jsonStringSize(*o.Opt2)
+	}
+	size += jsonStringSize(o.Reqd)
+	n, err = o.Opt3.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (o *OptionalFields) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenOpt1, seenOpt2, seenReqd, seenOpt3 bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "opt1":
+			if seenOpt1 {
+				ok = false
+				return false
+			}
+			seenOpt1 = true
+			if field.Type != gjson.Null {
+				var v string
+				if !unmarshalJSONString(field, &v) {
+					ok = false
+					return false
+				}
+				o.Opt1 = &v
+			}
+		case "opt2":
+			if seenOpt2 {
+				ok = false
+				return false
+			}
+			seenOpt2 = true
+			if field.Type != gjson.Null {
+				var v1 string
+				if !unmarshalJSONString(field, &v1) {
+					ok = false
+					return false
+				}
+				o.Opt2 = &v1
+			}
+		case "reqd":
+			if seenReqd {
+				ok = false
+				return false
+			}
+			seenReqd = true
+			if !unmarshalJSONString(field, &o.Reqd) {
+				ok = false
+				return false
+			}
+		case "opt3":
+			if seenOpt3 {
+				ok = false
+				return false
+			}
+			seenOpt3 = true
+			if field.Type != gjson.Null {
+				var s string
+				if !unmarshalJSONString(field, &s) || o.Opt3.UnmarshalText([]byte(s)) != nil {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "opt1", "opt2", "reqd", "opt3") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *OptionalFields) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *OptionalFields) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = OptionalFields{}
+	var seenOpt1, seenOpt2, seenReqd, seenOpt3 bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "opt1":
+			if seenOpt1 {
+				err = fmt.Errorf("duplicate field \"opt1\"")
+				return false
+			}
+			seenOpt1 = true
+			if field.Type != gjson.Null {
+				var v string
+				v, err = decodeJSONString(field)
+				if err != nil {
+					err = fmt.Errorf("field \"opt1\": %w", err)
+					return false
+				}
+				o.Opt1 = &v
+			}
+		case "opt2":
+			if seenOpt2 {
+				err = fmt.Errorf("duplicate field \"opt2\"")
+				return false
+			}
+			seenOpt2 = true
+			if field.Type != gjson.Null {
+				var v1 string
+				v1, err = decodeJSONString(field)
+				if err != nil {
+					err = fmt.Errorf("field \"opt2\": %w", err)
+					return false
+				}
+				o.Opt2 = &v1
+			}
+		case "reqd":
+			if seenReqd {
+				err = fmt.Errorf("duplicate field \"reqd\"")
+				return false
+			}
+			seenReqd = true
+			o.Reqd, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"reqd\": %w", err)
+				return false
+			}
+		case "opt3":
+			if seenOpt3 {
+				err = fmt.Errorf("duplicate field \"opt3\"")
+				return false
+			}
+			seenOpt3 = true
+			err = o.Opt3.decodeJSONStrict(field)
+			if err != nil {
+				err = fmt.Errorf("field \"opt3\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenReqd {
+		return fmt.Errorf("field \"reqd\" is required")
+	}
+	return nil
+}
+
 func (o OptionalFields) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -348,24 +2488,124 @@ func (o *OptionalFields) UnmarshalYAML(unmarshal func(interface{}) error) error
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
 
+// Equal returns true if the OptionalFields is equal to other according to the Conjure semantics of its values.
+func (o OptionalFields) Equal(other OptionalFields) bool {
+	if (o.Opt1 == nil) != (other.Opt1 == nil) {
+		return false
+	}
+	if o.Opt1 != nil {
+		if *o.Opt1 != *other.Opt1 {
+			return false
+		}
+	}
+	if (o.Opt2 == nil) != (other.Opt2 == nil) {
+		return false
+	}
+	if o.Opt2 != nil {
+		if *o.Opt2 != *other.Opt2 {
+			return false
+		}
+	}
+	if o.Reqd != other.Reqd {
+		return false
+	}
+	if !o.Opt3.Equal(other.Opt3) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalFields.
+func (o OptionalFields) Clone() OptionalFields {
+	out := o
+	if o.Opt1 != nil {
+		v := *o.Opt1
+		out.Opt1 = &v
+	}
+	if o.Opt2 != nil {
+		v := *o.Opt2
+		out.Opt2 = &v
+	}
+	out.Opt3 = o.Opt3.Clone()
+	return out
+}
+
 // A type using go keywords
 type Type struct {
 	Type []string          `json:"type"`
 	Chan map[string]string `json:"chan"`
 }
 
-func (o Type) MarshalJSON() ([]byte, error) {
-	if o.Type == nil {
-		o.Type = make([]string, 0)
+func (o Type) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"type\":"...)
+	out = append(out, '[')
+	for i, v := range o.Type {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
 	}
-	if o.Chan == nil {
-		o.Chan = make(map[string]string, 0)
+	out = append(out, ']')
+	out = append(out, ",\"chan\":"...)
+	out = append(out, '{')
+	{
+		type mapEntry struct {
+			key   string
+			value string
+		}
+		entries := make([]mapEntry, 0, len(o.Chan))
+		for k, v := range o.Chan {
+			entries = append(entries, mapEntry{key: k, value: v})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for i, entry := range entries {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, entry.key)
+			out = append(out, ':')
+			out = appendJSONString(out, entry.value)
+		}
 	}
-	type TypeAlias Type
-	return safejson.Marshal(TypeAlias(o))
+	out = append(out, '}')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Type) JSONSize() (int, error) {
+	size := 17
+	size += 2
+	if len(o.Type) > 1 {
+		size += len(o.Type) - 1
+	}
+	for _, v := range o.Type {
+		size += jsonStringSize(v)
+	}
+	size += 2
+	if len(o.Chan) > 1 {
+		size += len(o.Chan) - 1
+	}
+	for k, v := range o.Chan {
+		size += jsonStringSize(k) + 1
+		size += jsonStringSize(v)
+	}
+	return size, nil
+}
+
+func (o Type) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
 }
 
 func (o *Type) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Type
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
 	type TypeAlias Type
 	var rawType TypeAlias
 	if err := safejson.Unmarshal(data, &rawType); err != nil {
@@ -381,6 +2621,195 @@ func (o *Type) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (o *Type) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Type == nil {
+			o.Type = make([]string, 0)
+		}
+		if o.Chan == nil {
+			o.Chan = make(map[string]string, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenType, seenChan bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Type = make([]string, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v string
+					if !unmarshalJSONString(elem, &v) {
+						ok1 = false
+						return false
+					}
+					o.Type = append(o.Type, v)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "chan":
+			if seenChan {
+				ok = false
+				return false
+			}
+			seenChan = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					ok = false
+					return false
+				}
+				o.Chan = make(map[string]string)
+				ok2 := true
+				field.ForEach(func(key1, elem1 gjson.Result) bool {
+					var k string
+					if !unmarshalJSONString(key1, &k) {
+						ok2 = false
+						return false
+					}
+					var v1 string
+					if !unmarshalJSONString(elem1, &v1) {
+						ok2 = false
+						return false
+					}
+					o.Chan[k] = v1
+					return true
+				})
+				if !ok2 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "type", "chan") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Type == nil {
+		o.Type = make([]string, 0)
+	}
+	if o.Chan == nil {
+		o.Chan = make(map[string]string, 0)
+	}
+	return true
+}
+
+func (o *Type) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Type) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Type{}
+	var seenType, seenChan bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"type\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Type = make([]string, 0)
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v string
+					v, err = decodeJSONString(elem)
+					if err != nil {
+						return false
+					}
+					o.Type = append(o.Type, v)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"type\": %w", err)
+					return false
+				}
+			}
+		case "chan":
+			if seenChan {
+				err = fmt.Errorf("duplicate field \"chan\"")
+				return false
+			}
+			seenChan = true
+			if field.Type != gjson.Null {
+				if !field.IsObject() {
+					err = fmt.Errorf("field \"chan\": %w", jsonTypeError(field, "object"))
+					return false
+				}
+				o.Chan = make(map[string]string)
+				field.ForEach(func(key1, elem1 gjson.Result) bool {
+					var k string
+					k = key1.Str
+					if _, ok := o.Chan[k]; ok {
+						err = fmt.Errorf("duplicate map key %s", key1.Raw)
+						return false
+					}
+					var v1 string
+					v1, err = decodeJSONString(elem1)
+					if err != nil {
+						return false
+					}
+					o.Chan[k] = v1
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"chan\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if o.Type == nil {
+		o.Type = make([]string, 0)
+	}
+	if o.Chan == nil {
+		o.Chan = make(map[string]string, 0)
+	}
+	return nil
+}
+
 func (o Type) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(o)
 	if err != nil {
@@ -396,3 +2825,44 @@ func (o *Type) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	}
 	return safejson.Unmarshal(jsonBytes, *&o)
 }
+
+// Equal returns true if the Type is equal to other according to the Conjure semantics of its values.
+func (o Type) Equal(other Type) bool {
+	if len(o.Type) != len(other.Type) {
+		return false
+	}
+	for i := range o.Type {
+		if o.Type[i] != other.Type[i] {
+			return false
+		}
+	}
+	if len(o.Chan) != len(other.Chan) {
+		return false
+	}
+	for k, v := range o.Chan {
+		otherV, ok := other.Chan[k]
+		if !ok {
+			return false
+		}
+		if v != otherV {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Type.
+func (o Type) Clone() Type {
+	out := o
+	if o.Type != nil {
+		out.Type = make([]string, len(o.Type))
+		copy(out.Type, o.Type)
+	}
+	if o.Chan != nil {
+		out.Chan = make(map[string]string, len(o.Chan))
+		for k, v := range o.Chan {
+			out.Chan[k] = v
+		}
+	}
+	return out
+}
@@ -5,9 +5,11 @@ package api
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
 )
 
 type ExampleUnion struct {
@@ -28,7 +30,7 @@ func (u *exampleUnionDeserializer) toStruct() ExampleUnion {
 	return ExampleUnion{typ: u.Type, str: u.Str, strOptional: u.StrOptional, other: u.Other}
 }
 
-func (u *ExampleUnion) toSerializer() (interface{}, error) {
+func (u ExampleUnion) AppendJSON(out []byte) ([]byte, error) {
 	switch u.typ {
 	default:
 		return nil, fmt.Errorf("unknown type %q", u.typ)
@@ -36,39 +38,74 @@ func (u *ExampleUnion) toSerializer() (interface{}, error) {
 		if u.str == nil {
 			return nil, fmt.Errorf("field \"str\" is required")
 		}
-		return struct {
-			Type string `json:"type"`
-			Str  string `json:"str"`
-		}{Type: "str", Str: *u.str}, nil
+		out = append(out, "{\"type\":\"str\",\"str\":"...)
+		out = appendJSONString(out, *u.str)
 	case "strOptional":
 		var strOptional *string
 		if u.strOptional != nil {
 			strOptional = *u.strOptional
 		}
-		return struct {
-			Type        string  `json:"type"`
-			StrOptional *string `json:"strOptional"`
-		}{Type: "strOptional", StrOptional: strOptional}, nil
+		out = append(out, "{\"type\":\"strOptional\",\"strOptional\":"...)
+		if strOptional == nil {
+			out = append(out, "null"...)
+		} else {
+			out = appendJSONString(out, *strOptional)
+		}
 	case "other":
 		if u.other == nil {
 			return nil, fmt.Errorf("field \"other\" is required")
 		}
-		return struct {
-			Type  string `json:"type"`
-			Other int    `json:"other"`
-		}{Type: "other", Other: *u.other}, nil
+		out = append(out, "{\"type\":\"other\",\"other\":"...)
+		out = strconv.AppendInt(out, int64(*u.other), 10)
 	}
+	out = append(out, '}')
+	return out, nil
 }
 
-func (u ExampleUnion) MarshalJSON() ([]byte, error) {
-	ser, err := u.toSerializer()
-	if err != nil {
-		return nil, err
+func (u ExampleUnion) JSONSize() (int, error) {
+	var size int
+	switch u.typ {
+	default:
+		return 0, fmt.Errorf("unknown type %q", u.typ)
+	case "str":
+		if u.str == nil {
+			return 0, fmt.Errorf("field \"str\" is required")
+		}
+		size = 21
+		size += jsonStringSize(*u.str)
+	case "strOptional":
+		var strOptional *string
+		if u.strOptional != nil {
+			strOptional = *u.strOptional
+		}
+		size = 37
+		if strOptional == nil {
+			size += 4
+		} else {
+			size += jsonStringSize(*strOptional)
+		}
+	case "other":
+		if u.other == nil {
+			return 0, fmt.Errorf("field \"other\" is required")
+		}
+		size = 25
+		size += jsonIntSize(int64(*u.other))
 	}
-	return safejson.Marshal(ser)
+	return size, nil
+}
+
+func (u ExampleUnion) MarshalJSON() ([]byte, error) {
+	return u.AppendJSON(nil)
 }
 
 func (u *ExampleUnion) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v ExampleUnion
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*u = v
+			return nil
+		}
+	}
 	var deser exampleUnionDeserializer
 	if err := safejson.Unmarshal(data, &deser); err != nil {
 		return err
@@ -88,6 +125,184 @@ func (u *ExampleUnion) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (u *ExampleUnion) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenType, seenStr, seenStrOptional, seenOther bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !unmarshalJSONString(field, &u.typ) {
+				ok = false
+				return false
+			}
+		case "str":
+			if seenStr {
+				ok = false
+				return false
+			}
+			seenStr = true
+			if field.Type != gjson.Null {
+				var v string
+				if !unmarshalJSONString(field, &v) {
+					ok = false
+					return false
+				}
+				u.str = &v
+			}
+		case "strOptional":
+			if seenStrOptional {
+				ok = false
+				return false
+			}
+			seenStrOptional = true
+			if field.Type != gjson.Null {
+				var v1 *string
+				if field.Type != gjson.Null {
+					var v2 string
+					if !unmarshalJSONString(field, &v2) {
+						ok = false
+						return false
+					}
+					v1 = &v2
+				}
+				u.strOptional = &v1
+			}
+		case "other":
+			if seenOther {
+				ok = false
+				return false
+			}
+			seenOther = true
+			if field.Type != gjson.Null {
+				var v3 int
+				if !unmarshalJSONInt(field, &v3) {
+					ok = false
+					return false
+				}
+				u.other = &v3
+			}
+		default:
+			if matchesJSONField(key.Str, "type", "str", "strOptional", "other") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	switch u.typ {
+	case "str":
+		if u.str == nil {
+			return false
+		}
+	case "other":
+		if u.other == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *ExampleUnion) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return u.decodeJSONStrict(value)
+}
+
+func (u *ExampleUnion) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	var typ string
+	var variantName string
+	var variant gjson.Result
+	var seenType, seenVariant bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch {
+		case key.Str == "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			typ, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"type\": %w", err)
+				return false
+			}
+			return true
+		case seenVariant && key.Str == variantName:
+			err = fmt.Errorf("duplicate field %q", key.Str)
+			return false
+		case seenVariant:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		variantName, variant, seenVariant = key.Str, field, true
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenType {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	if seenVariant && variantName != typ {
+		return fmt.Errorf("unknown field %q", variantName)
+	}
+	*u = ExampleUnion{typ: typ}
+	switch typ {
+	case "str":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"str\" is required")
+		}
+		var v string
+		v, err = decodeJSONString(variant)
+		if err != nil {
+			return fmt.Errorf("field \"str\": %w", err)
+		}
+		u.str = &v
+	case "strOptional":
+		var v1 *string
+		if variant.Type != gjson.Null {
+			var v2 string
+			v2, err = decodeJSONString(variant)
+			if err != nil {
+				return fmt.Errorf("field \"strOptional\": %w", err)
+			}
+			v1 = &v2
+		}
+		u.strOptional = &v1
+	case "other":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"other\" is required")
+		}
+		var v3 int
+		v3, err = decodeJSONInt(variant)
+		if err != nil {
+			return fmt.Errorf("field \"other\": %w", err)
+		}
+		u.other = &v3
+	}
+	return nil
+}
+
 func (u ExampleUnion) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(u)
 	if err != nil {
@@ -223,3 +438,96 @@ func NewExampleUnionFromStrOptional(v *string) ExampleUnion {
 func NewExampleUnionFromOther(v int) ExampleUnion {
 	return ExampleUnion{typ: "other", other: &v}
 }
+
+// ExampleUnionVariant identifies the variants of ExampleUnion.
+type ExampleUnionVariant string
+
+const (
+	ExampleUnionVariantStr         ExampleUnionVariant = "str"
+	ExampleUnionVariantStrOptional ExampleUnionVariant = "strOptional"
+	ExampleUnionVariantOther       ExampleUnionVariant = "other"
+)
+
+// Type returns the variant of the union. For variants that are unknown to this version of ExampleUnion, it
+// returns the name of the variant, which does not match any of the declared constants.
+func (u *ExampleUnion) Type() ExampleUnionVariant {
+	return ExampleUnionVariant(u.typ)
+}
+
+// AsStr returns the value of the str variant and true if the union is set to it.
+func (u *ExampleUnion) AsStr() (v string, ok bool) {
+	if u.typ != "str" || u.str == nil {
+		return v, false
+	}
+	return *u.str, true
+}
+
+// AsStrOptional returns the value of the strOptional variant and true if the union is set to it.
+func (u *ExampleUnion) AsStrOptional() (v *string, ok bool) {
+	if u.typ != "strOptional" {
+		return v, false
+	}
+	if u.strOptional != nil {
+		v = *u.strOptional
+	}
+	return v, true
+}
+
+// AsOther returns the value of the other variant and true if the union is set to it.
+func (u *ExampleUnion) AsOther() (v int, ok bool) {
+	if u.typ != "other" || u.other == nil {
+		return v, false
+	}
+	return *u.other, true
+}
+
+// Equal returns true if the ExampleUnion is equal to other according to the Conjure semantics of its values.
+func (u ExampleUnion) Equal(other ExampleUnion) bool {
+	if u.typ != other.typ {
+		return false
+	}
+	if (u.str == nil) != (other.str == nil) {
+		return false
+	}
+	if u.str != nil {
+		if *u.str != *other.str {
+			return false
+		}
+	}
+	if (u.strOptional == nil) != (other.strOptional == nil) {
+		return false
+	}
+	if u.strOptional != nil {
+		if (*u.strOptional == nil) != (*other.strOptional == nil) {
+			return false
+		}
+		if *u.strOptional != nil {
+			if **u.strOptional != **other.strOptional {
+				return false
+			}
+		}
+	}
+	if (u.other == nil) != (other.other == nil) {
+		return false
+	}
+	if u.other != nil {
+		if *u.other != *other.other {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the ExampleUnion.
+func (u ExampleUnion) Clone() ExampleUnion {
+	out := u
+	if u.strOptional != nil {
+		var v *string
+		if *u.strOptional != nil {
+			v1 := **u.strOptional
+			v = &v1
+		}
+		out.strOptional = &v
+	}
+	return out
+}
@@ -44,3 +44,32 @@ type ExampleUnionVisitorWithT[T any] interface {
 	VisitOther(ctx context.Context, v int) (T, error)
 	VisitUnknown(ctx context.Context, typ string) (T, error)
 }
+
+// MatchExampleUnion calls the function for the variant of u with the value of the variant and returns its result.
+// unknownFunc is called with the name of the variant for variants that are unknown to this version of the union.
+func MatchExampleUnion[T any](u ExampleUnion, strFunc func(string) (T, error), strOptionalFunc func(*string) (T, error), otherFunc func(int) (T, error), unknownFunc func(string) (T, error)) (T, error) {
+	var result T
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return result, fmt.Errorf("invalid value in union type")
+		}
+		return unknownFunc(u.typ)
+	case "str":
+		if u.str == nil {
+			return result, fmt.Errorf("field \"str\" is required")
+		}
+		return strFunc(*u.str)
+	case "strOptional":
+		var strOptional *string
+		if u.strOptional != nil {
+			strOptional = *u.strOptional
+		}
+		return strOptionalFunc(strOptional)
+	case "other":
+		if u.other == nil {
+			return result, fmt.Errorf("field \"other\" is required")
+		}
+		return otherFunc(*u.other)
+	}
+}
@@ -230,6 +230,69 @@ func TestUnions(t *testing.T) {
 	}
 }
 
+func TestUnionMatch(t *testing.T) {
+	describe := func(union api.ExampleUnion) (string, error) {
+		return api.MatchExampleUnion(union,
+			func(s string) (string, error) {
+				return "str " + s, nil
+			},
+			func(s *string) (string, error) {
+				if s == nil {
+					return "empty strOptional", nil
+				}
+				return "strOptional " + *s, nil
+			},
+			func(i int) (string, error) {
+				return "other " + strconv.Itoa(i), nil
+			},
+			func(typeName string) (string, error) {
+				return "unknown " + typeName, nil
+			},
+		)
+	}
+	var unknown api.ExampleUnion
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"notAValidType","notAValidType":"foo"}`), &unknown))
+	for _, tc := range []struct {
+		union api.ExampleUnion
+		want  string
+	}{
+		{api.NewExampleUnionFromStr("foo"), "str foo"},
+		{api.NewExampleUnionFromStrOptional(nil), "empty strOptional"},
+		{api.NewExampleUnionFromOther(5), "other 5"},
+		{unknown, "unknown notAValidType"},
+	} {
+		got, err := describe(tc.union)
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, got)
+	}
+
+	_, err := describe(api.ExampleUnion{})
+	assert.EqualError(t, err, "invalid value in union type")
+}
+
+func TestUnionAccessors(t *testing.T) {
+	union := api.NewExampleUnionFromOther(5)
+	assert.Equal(t, api.ExampleUnionVariantOther, union.Type())
+	other, ok := union.AsOther()
+	assert.True(t, ok)
+	assert.Equal(t, 5, other)
+	str, ok := union.AsStr()
+	assert.False(t, ok)
+	assert.Equal(t, "", str)
+
+	union = api.NewExampleUnionFromStrOptional(nil)
+	assert.Equal(t, api.ExampleUnionVariantStrOptional, union.Type())
+	strOptional, ok := union.AsStrOptional()
+	assert.True(t, ok)
+	assert.Nil(t, strOptional)
+
+	var unknown api.ExampleUnion
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"notAValidType","notAValidType":"foo"}`), &unknown))
+	assert.Equal(t, api.ExampleUnionVariant("notAValidType"), unknown.Type())
+	_, ok = unknown.AsOther()
+	assert.False(t, ok)
+}
+
 func TestUnknownUnions(t *testing.T) {
 	for idx, unmarshalFunc := range unmarshalFuncs {
 		var unknownUnion *api.ExampleUnion
@@ -0,0 +1,61 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	wparams "github.com/palantir/witchcraft-go-params"
+)
+
+// FakeTestService is an in-memory implementation of TestService for use in tests.
+// Each endpoint records its arguments and then invokes the corresponding <Endpoint>Func field.
+// If the field is nil, the endpoint returns DefaultErr (or a Conjure Internal error if DefaultErr is nil).
+// The zero value is ready to use and all methods are safe for concurrent use.
+type FakeTestService struct {
+	// EchoFunc is invoked by Echo if non-nil.
+	EchoFunc func(ctx context.Context, inputArg string, repsArg int, optionalArg *string, listParamArg []int, lastParamArg *string) (string, error)
+	// DefaultErr is returned by endpoints whose func field is nil.
+	DefaultErr error
+
+	mu        sync.Mutex
+	echoCalls []FakeTestServiceEchoCall
+}
+
+var _ TestService = (*FakeTestService)(nil)
+
+// FakeTestServiceEchoCall records the arguments of a call to FakeTestService.Echo.
+type FakeTestServiceEchoCall struct {
+	Input     string
+	Reps      int
+	Optional  *string
+	ListParam []int
+	LastParam *string
+}
+
+func (f *FakeTestService) Echo(ctx context.Context, inputArg string, repsArg int, optionalArg *string, listParamArg []int, lastParamArg *string) (string, error) {
+	f.mu.Lock()
+	f.echoCalls = append(f.echoCalls, FakeTestServiceEchoCall{Input: inputArg, Reps: repsArg, Optional: optionalArg, ListParam: listParamArg, LastParam: lastParamArg})
+	f.mu.Unlock()
+	if f.EchoFunc != nil {
+		return f.EchoFunc(ctx, inputArg, repsArg, optionalArg, listParamArg, lastParamArg)
+	}
+	var defaultReturnVal string
+	return defaultReturnVal, f.defaultErr("echo")
+}
+
+// EchoCalls returns the arguments of every call made to Echo, in call order.
+func (f *FakeTestService) EchoCalls() []FakeTestServiceEchoCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceEchoCall(nil), f.echoCalls...)
+}
+
+func (f *FakeTestService) defaultErr(endpoint string) error {
+	if f.DefaultErr != nil {
+		return f.DefaultErr
+	}
+	return errors.NewInternal(wparams.NewSafeParamStorer(map[string]interface{}{"fakeEndpoint": endpoint}))
+}
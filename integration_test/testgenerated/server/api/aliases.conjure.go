@@ -3,20 +3,39 @@
 package api
 
 import (
+	"strconv"
+
 	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/pkg/safeyaml"
 	"github.com/palantir/pkg/uuid"
+	"github.com/tidwall/gjson"
 )
 
 type OptionalIntegerAlias struct {
 	Value *int
 }
 
-func (a OptionalIntegerAlias) MarshalJSON() ([]byte, error) {
+func (a OptionalIntegerAlias) AppendJSON(out []byte) ([]byte, error) {
+	if a.Value == nil {
+		out = append(out, "null"...)
+	} else {
+		out = strconv.AppendInt(out, int64(*a.Value), 10)
+	}
+	return out, nil
+}
+
+func (a OptionalIntegerAlias) JSONSize() (int, error) {
+	var size int
 	if a.Value == nil {
-		return []byte("null"), nil
+		size += 4
+	} else {
+		size += jsonIntSize(int64(*a.Value))
 	}
-	return safejson.Marshal(a.Value)
+	return size, nil
+}
+
+func (a OptionalIntegerAlias) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
 }
 
 func (a *OptionalIntegerAlias) UnmarshalJSON(data []byte) error {
@@ -26,6 +45,29 @@ func (a *OptionalIntegerAlias) UnmarshalJSON(data []byte) error {
 	return safejson.Unmarshal(data, a.Value)
 }
 
+func (a *OptionalIntegerAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *OptionalIntegerAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *int
+	if value.Type != gjson.Null {
+		var v1 int
+		v1, err = decodeJSONInt(value)
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = OptionalIntegerAlias{Value: v}
+	return nil
+}
+
 func (a OptionalIntegerAlias) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
@@ -42,15 +84,67 @@ func (a *OptionalIntegerAlias) UnmarshalYAML(unmarshal func(interface{}) error)
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+// Equal returns true if the OptionalIntegerAlias is equal to other according to the Conjure semantics of its values.
+func (a OptionalIntegerAlias) Equal(other OptionalIntegerAlias) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		if *a.Value != *other.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalIntegerAlias.
+func (a OptionalIntegerAlias) Clone() OptionalIntegerAlias {
+	out := a
+	if a.Value != nil {
+		v := *a.Value
+		out.Value = &v
+	}
+	return out
+}
+
 type OptionalListAlias struct {
 	Value *[]string
 }
 
-func (a OptionalListAlias) MarshalJSON() ([]byte, error) {
+func (a OptionalListAlias) AppendJSON(out []byte) ([]byte, error) {
 	if a.Value == nil {
-		return []byte("null"), nil
+		out = append(out, "null"...)
+	} else {
+		out = append(out, '[')
+		for i, v := range *a.Value {
+			if i > 0 {
+				out = append(out, ',')
+			}
+			out = appendJSONString(out, v)
+		}
+		out = append(out, ']')
 	}
-	return safejson.Marshal(a.Value)
+	return out, nil
+}
+
+func (a OptionalListAlias) JSONSize() (int, error) {
+	var size int
+	if a.Value == nil {
+		size += 4
+	} else {
+		size += 2
+		if len(*a.Value) > 1 {
+			size += len(*a.Value) - 1
+		}
+		for _, v := range *a.Value {
+			size += jsonStringSize(v)
+		}
+	}
+	return size, nil
+}
+
+func (a OptionalListAlias) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
 }
 
 func (a *OptionalListAlias) UnmarshalJSON(data []byte) error {
@@ -60,6 +154,41 @@ func (a *OptionalListAlias) UnmarshalJSON(data []byte) error {
 	return safejson.Unmarshal(data, a.Value)
 }
 
+func (a *OptionalListAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *OptionalListAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v *[]string
+	if value.Type != gjson.Null {
+		var v1 []string
+		if !value.IsArray() {
+			return jsonTypeError(value, "array")
+		}
+		v1 = make([]string, 0)
+		value.ForEach(func(_, elem gjson.Result) bool {
+			var v2 string
+			v2, err = decodeJSONString(elem)
+			if err != nil {
+				return false
+			}
+			v1 = append(v1, v2)
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		v = &v1
+	}
+	*a = OptionalListAlias{Value: v}
+	return nil
+}
+
 func (a OptionalListAlias) MarshalYAML() (interface{}, error) {
 	jsonBytes, err := safejson.Marshal(a)
 	if err != nil {
@@ -76,8 +205,57 @@ func (a *OptionalListAlias) UnmarshalYAML(unmarshal func(interface{}) error) err
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+// Equal returns true if the OptionalListAlias is equal to other according to the Conjure semantics of its values.
+func (a OptionalListAlias) Equal(other OptionalListAlias) bool {
+	if (a.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if a.Value != nil {
+		v, otherV := *a.Value, *other.Value
+		if len(v) != len(otherV) {
+			return false
+		}
+		for i1 := range v {
+			if v[i1] != otherV[i1] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the OptionalListAlias.
+func (a OptionalListAlias) Clone() OptionalListAlias {
+	out := a
+	if a.Value != nil {
+		elems := *a.Value
+		var v []string
+		if elems != nil {
+			v = make([]string, len(elems))
+			copy(v, elems)
+		}
+		out.Value = &v
+	}
+	return out
+}
+
 type SafeUuid uuid.UUID
 
+func (a SafeUuid) AppendJSON(out []byte) ([]byte, error) {
+	out = appendJSONUUID(out, uuid.UUID(a))
+	return out, nil
+}
+
+func (a SafeUuid) JSONSize() (int, error) {
+	var size int
+	size += 38
+	return size, nil
+}
+
+func (a SafeUuid) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
 func (a SafeUuid) String() string {
 	return uuid.UUID(a).String()
 }
@@ -111,4 +289,62 @@ func (a *SafeUuid) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return safejson.Unmarshal(jsonBytes, *&a)
 }
 
+func (a *SafeUuid) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *SafeUuid) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v uuid.UUID
+	v, err = decodeJSONUUID(value)
+	if err != nil {
+		return err
+	}
+	*a = SafeUuid(v)
+	return nil
+}
+
+// Equal returns true if the SafeUuid is equal to other according to the Conjure semantics of its values.
+func (a SafeUuid) Equal(other SafeUuid) bool {
+	return a == other
+}
+
+// Clone returns a deep copy of the SafeUuid.
+func (a SafeUuid) Clone() SafeUuid {
+	return a
+}
+
 type StringAlias string
+
+func (a *StringAlias) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *StringAlias) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v string
+	v, err = decodeJSONString(value)
+	if err != nil {
+		return err
+	}
+	*a = StringAlias(v)
+	return nil
+}
+
+// Equal returns true if the StringAlias is equal to other according to the Conjure semantics of its values.
+func (a StringAlias) Equal(other StringAlias) bool {
+	return string(a) == string(other)
+}
+
+// Clone returns a deep copy of the StringAlias.
+func (a StringAlias) Clone() StringAlias {
+	return a
+}
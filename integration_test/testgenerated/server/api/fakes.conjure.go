@@ -0,0 +1,810 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/pkg/bearertoken"
+	"github.com/palantir/pkg/datetime"
+	"github.com/palantir/pkg/rid"
+	"github.com/palantir/pkg/safelong"
+	"github.com/palantir/pkg/uuid"
+	wparams "github.com/palantir/witchcraft-go-params"
+)
+
+// FakeTestService is an in-memory implementation of TestService for use in tests.
+// Each endpoint records its arguments and then invokes the corresponding <Endpoint>Func field.
+// If the field is nil, the endpoint returns DefaultErr (or a Conjure Internal error if DefaultErr is nil).
+// The zero value is ready to use and all methods are safe for concurrent use.
+type FakeTestService struct {
+	// EchoFunc is invoked by Echo if non-nil.
+	EchoFunc func(ctx context.Context, cookieToken bearertoken.Token) error
+	// EchoStringsFunc is invoked by EchoStrings if non-nil.
+	EchoStringsFunc func(ctx context.Context, bodyArg []string) ([]string, error)
+	// EchoCustomObjectFunc is invoked by EchoCustomObject if non-nil.
+	EchoCustomObjectFunc func(ctx context.Context, bodyArg *CustomObject) (*CustomObject, error)
+	// EchoOptionalAliasFunc is invoked by EchoOptionalAlias if non-nil.
+	EchoOptionalAliasFunc func(ctx context.Context, bodyArg OptionalIntegerAlias) (OptionalIntegerAlias, error)
+	// EchoOptionalListAliasFunc is invoked by EchoOptionalListAlias if non-nil.
+	EchoOptionalListAliasFunc func(ctx context.Context, bodyArg OptionalListAlias) (OptionalListAlias, error)
+	// GetPathParamFunc is invoked by GetPathParam if non-nil.
+	GetPathParamFunc func(ctx context.Context, authHeader bearertoken.Token, myPathParamArg string) error
+	// GetPathParamAliasFunc is invoked by GetPathParamAlias if non-nil.
+	GetPathParamAliasFunc func(ctx context.Context, authHeader bearertoken.Token, myPathParamArg StringAlias) error
+	// QueryParamListFunc is invoked by QueryParamList if non-nil.
+	QueryParamListFunc func(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []string) error
+	// QueryParamListBooleanFunc is invoked by QueryParamListBoolean if non-nil.
+	QueryParamListBooleanFunc func(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []bool) error
+	// QueryParamListDateTimeFunc is invoked by QueryParamListDateTime if non-nil.
+	QueryParamListDateTimeFunc func(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []datetime.DateTime) error
+	// QueryParamSetDateTimeFunc is invoked by QueryParamSetDateTime if non-nil.
+	QueryParamSetDateTimeFunc func(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []datetime.DateTime) ([]datetime.DateTime, error)
+	// QueryParamListDoubleFunc is invoked by QueryParamListDouble if non-nil.
+	QueryParamListDoubleFunc func(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []float64) error
+	// QueryParamListIntegerFunc is invoked by QueryParamListInteger if non-nil.
+	QueryParamListIntegerFunc func(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []int) error
+	// QueryParamListRidFunc is invoked by QueryParamListRid if non-nil.
+	QueryParamListRidFunc func(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []rid.ResourceIdentifier) error
+	// QueryParamListSafeLongFunc is invoked by QueryParamListSafeLong if non-nil.
+	QueryParamListSafeLongFunc func(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []safelong.SafeLong) error
+	// QueryParamListStringFunc is invoked by QueryParamListString if non-nil.
+	QueryParamListStringFunc func(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []string) error
+	// QueryParamListUuidFunc is invoked by QueryParamListUuid if non-nil.
+	QueryParamListUuidFunc func(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []uuid.UUID) error
+	// QueryParamExternalStringFunc is invoked by QueryParamExternalString if non-nil.
+	QueryParamExternalStringFunc func(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg string) error
+	// QueryParamExternalIntegerFunc is invoked by QueryParamExternalInteger if non-nil.
+	QueryParamExternalIntegerFunc func(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg int) error
+	// PathParamExternalStringFunc is invoked by PathParamExternalString if non-nil.
+	PathParamExternalStringFunc func(ctx context.Context, authHeader bearertoken.Token, myPathParam1Arg string) error
+	// PathParamExternalIntegerFunc is invoked by PathParamExternalInteger if non-nil.
+	PathParamExternalIntegerFunc func(ctx context.Context, authHeader bearertoken.Token, myPathParam1Arg int) error
+	// PostPathParamFunc is invoked by PostPathParam if non-nil.
+	PostPathParamFunc func(ctx context.Context, authHeader bearertoken.Token, myPathParam1Arg string, myPathParam2Arg bool, myBodyParamArg CustomObject, myQueryParam1Arg string, myQueryParam2Arg string, myQueryParam3Arg float64, myQueryParam4Arg *safelong.SafeLong, myQueryParam5Arg *string, myQueryParam6Arg OptionalIntegerAlias, myHeaderParam1Arg safelong.SafeLong, myHeaderParam2Arg *uuid.UUID) (CustomObject, error)
+	// PostSafeParamsFunc is invoked by PostSafeParams if non-nil.
+	PostSafeParamsFunc func(ctx context.Context, authHeader bearertoken.Token, myPathParam1Arg string, myPathParam2Arg bool, myBodyParamArg CustomObject, myQueryParam1Arg string, myQueryParam2Arg string, myQueryParam3Arg float64, myQueryParam4Arg *safelong.SafeLong, myQueryParam5Arg *string, myHeaderParam1Arg safelong.SafeLong, myHeaderParam2Arg *SafeUuid) error
+	// BytesFunc is invoked by Bytes if non-nil.
+	BytesFunc func(ctx context.Context) (CustomObject, error)
+	// GetBinaryFunc is invoked by GetBinary if non-nil.
+	GetBinaryFunc func(ctx context.Context) (io.ReadCloser, error)
+	// PostBinaryFunc is invoked by PostBinary if non-nil.
+	PostBinaryFunc func(ctx context.Context, myBytesArg io.ReadCloser) (io.ReadCloser, error)
+	// PutBinaryFunc is invoked by PutBinary if non-nil.
+	PutBinaryFunc func(ctx context.Context, myBytesArg io.ReadCloser) error
+	// GetOptionalBinaryFunc is invoked by GetOptionalBinary if non-nil.
+	GetOptionalBinaryFunc func(ctx context.Context) (*io.ReadCloser, error)
+	// ChanFunc is invoked by Chan if non-nil.
+	ChanFunc func(ctx context.Context, varArg string, importArg map[string]string, typeArg string, returnArg safelong.SafeLong, httpArg string, jsonArg string, reqArg string, rwArg string) error
+	// DefaultErr is returned by endpoints whose func field is nil.
+	DefaultErr error
+
+	mu                             sync.Mutex
+	echoCalls                      []FakeTestServiceEchoCall
+	echoStringsCalls               []FakeTestServiceEchoStringsCall
+	echoCustomObjectCalls          []FakeTestServiceEchoCustomObjectCall
+	echoOptionalAliasCalls         []FakeTestServiceEchoOptionalAliasCall
+	echoOptionalListAliasCalls     []FakeTestServiceEchoOptionalListAliasCall
+	getPathParamCalls              []FakeTestServiceGetPathParamCall
+	getPathParamAliasCalls         []FakeTestServiceGetPathParamAliasCall
+	queryParamListCalls            []FakeTestServiceQueryParamListCall
+	queryParamListBooleanCalls     []FakeTestServiceQueryParamListBooleanCall
+	queryParamListDateTimeCalls    []FakeTestServiceQueryParamListDateTimeCall
+	queryParamSetDateTimeCalls     []FakeTestServiceQueryParamSetDateTimeCall
+	queryParamListDoubleCalls      []FakeTestServiceQueryParamListDoubleCall
+	queryParamListIntegerCalls     []FakeTestServiceQueryParamListIntegerCall
+	queryParamListRidCalls         []FakeTestServiceQueryParamListRidCall
+	queryParamListSafeLongCalls    []FakeTestServiceQueryParamListSafeLongCall
+	queryParamListStringCalls      []FakeTestServiceQueryParamListStringCall
+	queryParamListUuidCalls        []FakeTestServiceQueryParamListUuidCall
+	queryParamExternalStringCalls  []FakeTestServiceQueryParamExternalStringCall
+	queryParamExternalIntegerCalls []FakeTestServiceQueryParamExternalIntegerCall
+	pathParamExternalStringCalls   []FakeTestServicePathParamExternalStringCall
+	pathParamExternalIntegerCalls  []FakeTestServicePathParamExternalIntegerCall
+	postPathParamCalls             []FakeTestServicePostPathParamCall
+	postSafeParamsCalls            []FakeTestServicePostSafeParamsCall
+	bytesCalls                     []FakeTestServiceBytesCall
+	getBinaryCalls                 []FakeTestServiceGetBinaryCall
+	postBinaryCalls                []FakeTestServicePostBinaryCall
+	putBinaryCalls                 []FakeTestServicePutBinaryCall
+	getOptionalBinaryCalls         []FakeTestServiceGetOptionalBinaryCall
+	chanCalls                      []FakeTestServiceChanCall
+}
+
+var _ TestService = (*FakeTestService)(nil)
+
+// FakeTestServiceEchoCall records the arguments of a call to FakeTestService.Echo.
+type FakeTestServiceEchoCall struct {
+	CookieToken bearertoken.Token
+}
+
+func (f *FakeTestService) Echo(ctx context.Context, cookieToken bearertoken.Token) error {
+	f.mu.Lock()
+	f.echoCalls = append(f.echoCalls, FakeTestServiceEchoCall{CookieToken: cookieToken})
+	f.mu.Unlock()
+	if f.EchoFunc != nil {
+		return f.EchoFunc(ctx, cookieToken)
+	}
+	return f.defaultErr("echo")
+}
+
+// EchoCalls returns the arguments of every call made to Echo, in call order.
+func (f *FakeTestService) EchoCalls() []FakeTestServiceEchoCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceEchoCall(nil), f.echoCalls...)
+}
+
+// FakeTestServiceEchoStringsCall records the arguments of a call to FakeTestService.EchoStrings.
+type FakeTestServiceEchoStringsCall struct {
+	Body []string
+}
+
+func (f *FakeTestService) EchoStrings(ctx context.Context, bodyArg []string) ([]string, error) {
+	f.mu.Lock()
+	f.echoStringsCalls = append(f.echoStringsCalls, FakeTestServiceEchoStringsCall{Body: bodyArg})
+	f.mu.Unlock()
+	if f.EchoStringsFunc != nil {
+		return f.EchoStringsFunc(ctx, bodyArg)
+	}
+	var defaultReturnVal []string
+	return defaultReturnVal, f.defaultErr("echoStrings")
+}
+
+// EchoStringsCalls returns the arguments of every call made to EchoStrings, in call order.
+func (f *FakeTestService) EchoStringsCalls() []FakeTestServiceEchoStringsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceEchoStringsCall(nil), f.echoStringsCalls...)
+}
+
+// FakeTestServiceEchoCustomObjectCall records the arguments of a call to FakeTestService.EchoCustomObject.
+type FakeTestServiceEchoCustomObjectCall struct {
+	Body *CustomObject
+}
+
+func (f *FakeTestService) EchoCustomObject(ctx context.Context, bodyArg *CustomObject) (*CustomObject, error) {
+	f.mu.Lock()
+	f.echoCustomObjectCalls = append(f.echoCustomObjectCalls, FakeTestServiceEchoCustomObjectCall{Body: bodyArg})
+	f.mu.Unlock()
+	if f.EchoCustomObjectFunc != nil {
+		return f.EchoCustomObjectFunc(ctx, bodyArg)
+	}
+	var defaultReturnVal *CustomObject
+	return defaultReturnVal, f.defaultErr("echoCustomObject")
+}
+
+// EchoCustomObjectCalls returns the arguments of every call made to EchoCustomObject, in call order.
+func (f *FakeTestService) EchoCustomObjectCalls() []FakeTestServiceEchoCustomObjectCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceEchoCustomObjectCall(nil), f.echoCustomObjectCalls...)
+}
+
+// FakeTestServiceEchoOptionalAliasCall records the arguments of a call to FakeTestService.EchoOptionalAlias.
+type FakeTestServiceEchoOptionalAliasCall struct {
+	Body OptionalIntegerAlias
+}
+
+func (f *FakeTestService) EchoOptionalAlias(ctx context.Context, bodyArg OptionalIntegerAlias) (OptionalIntegerAlias, error) {
+	f.mu.Lock()
+	f.echoOptionalAliasCalls = append(f.echoOptionalAliasCalls, FakeTestServiceEchoOptionalAliasCall{Body: bodyArg})
+	f.mu.Unlock()
+	if f.EchoOptionalAliasFunc != nil {
+		return f.EchoOptionalAliasFunc(ctx, bodyArg)
+	}
+	var defaultReturnVal OptionalIntegerAlias
+	return defaultReturnVal, f.defaultErr("echoOptionalAlias")
+}
+
+// EchoOptionalAliasCalls returns the arguments of every call made to EchoOptionalAlias, in call order.
+func (f *FakeTestService) EchoOptionalAliasCalls() []FakeTestServiceEchoOptionalAliasCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceEchoOptionalAliasCall(nil), f.echoOptionalAliasCalls...)
+}
+
+// FakeTestServiceEchoOptionalListAliasCall records the arguments of a call to FakeTestService.EchoOptionalListAlias.
+type FakeTestServiceEchoOptionalListAliasCall struct {
+	Body OptionalListAlias
+}
+
+func (f *FakeTestService) EchoOptionalListAlias(ctx context.Context, bodyArg OptionalListAlias) (OptionalListAlias, error) {
+	f.mu.Lock()
+	f.echoOptionalListAliasCalls = append(f.echoOptionalListAliasCalls, FakeTestServiceEchoOptionalListAliasCall{Body: bodyArg})
+	f.mu.Unlock()
+	if f.EchoOptionalListAliasFunc != nil {
+		return f.EchoOptionalListAliasFunc(ctx, bodyArg)
+	}
+	var defaultReturnVal OptionalListAlias
+	return defaultReturnVal, f.defaultErr("echoOptionalListAlias")
+}
+
+// EchoOptionalListAliasCalls returns the arguments of every call made to EchoOptionalListAlias, in call order.
+func (f *FakeTestService) EchoOptionalListAliasCalls() []FakeTestServiceEchoOptionalListAliasCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceEchoOptionalListAliasCall(nil), f.echoOptionalListAliasCalls...)
+}
+
+// FakeTestServiceGetPathParamCall records the arguments of a call to FakeTestService.GetPathParam.
+type FakeTestServiceGetPathParamCall struct {
+	AuthHeader  bearertoken.Token
+	MyPathParam string
+}
+
+func (f *FakeTestService) GetPathParam(ctx context.Context, authHeader bearertoken.Token, myPathParamArg string) error {
+	f.mu.Lock()
+	f.getPathParamCalls = append(f.getPathParamCalls, FakeTestServiceGetPathParamCall{AuthHeader: authHeader, MyPathParam: myPathParamArg})
+	f.mu.Unlock()
+	if f.GetPathParamFunc != nil {
+		return f.GetPathParamFunc(ctx, authHeader, myPathParamArg)
+	}
+	return f.defaultErr("getPathParam")
+}
+
+// GetPathParamCalls returns the arguments of every call made to GetPathParam, in call order.
+func (f *FakeTestService) GetPathParamCalls() []FakeTestServiceGetPathParamCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceGetPathParamCall(nil), f.getPathParamCalls...)
+}
+
+// FakeTestServiceGetPathParamAliasCall records the arguments of a call to FakeTestService.GetPathParamAlias.
+type FakeTestServiceGetPathParamAliasCall struct {
+	AuthHeader  bearertoken.Token
+	MyPathParam StringAlias
+}
+
+func (f *FakeTestService) GetPathParamAlias(ctx context.Context, authHeader bearertoken.Token, myPathParamArg StringAlias) error {
+	f.mu.Lock()
+	f.getPathParamAliasCalls = append(f.getPathParamAliasCalls, FakeTestServiceGetPathParamAliasCall{AuthHeader: authHeader, MyPathParam: myPathParamArg})
+	f.mu.Unlock()
+	if f.GetPathParamAliasFunc != nil {
+		return f.GetPathParamAliasFunc(ctx, authHeader, myPathParamArg)
+	}
+	return f.defaultErr("getPathParamAlias")
+}
+
+// GetPathParamAliasCalls returns the arguments of every call made to GetPathParamAlias, in call order.
+func (f *FakeTestService) GetPathParamAliasCalls() []FakeTestServiceGetPathParamAliasCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceGetPathParamAliasCall(nil), f.getPathParamAliasCalls...)
+}
+
+// FakeTestServiceQueryParamListCall records the arguments of a call to FakeTestService.QueryParamList.
+type FakeTestServiceQueryParamListCall struct {
+	AuthHeader    bearertoken.Token
+	MyQueryParam1 []string
+}
+
+func (f *FakeTestService) QueryParamList(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []string) error {
+	f.mu.Lock()
+	f.queryParamListCalls = append(f.queryParamListCalls, FakeTestServiceQueryParamListCall{AuthHeader: authHeader, MyQueryParam1: myQueryParam1Arg})
+	f.mu.Unlock()
+	if f.QueryParamListFunc != nil {
+		return f.QueryParamListFunc(ctx, authHeader, myQueryParam1Arg)
+	}
+	return f.defaultErr("queryParamList")
+}
+
+// QueryParamListCalls returns the arguments of every call made to QueryParamList, in call order.
+func (f *FakeTestService) QueryParamListCalls() []FakeTestServiceQueryParamListCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceQueryParamListCall(nil), f.queryParamListCalls...)
+}
+
+// FakeTestServiceQueryParamListBooleanCall records the arguments of a call to FakeTestService.QueryParamListBoolean.
+type FakeTestServiceQueryParamListBooleanCall struct {
+	AuthHeader    bearertoken.Token
+	MyQueryParam1 []bool
+}
+
+func (f *FakeTestService) QueryParamListBoolean(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []bool) error {
+	f.mu.Lock()
+	f.queryParamListBooleanCalls = append(f.queryParamListBooleanCalls, FakeTestServiceQueryParamListBooleanCall{AuthHeader: authHeader, MyQueryParam1: myQueryParam1Arg})
+	f.mu.Unlock()
+	if f.QueryParamListBooleanFunc != nil {
+		return f.QueryParamListBooleanFunc(ctx, authHeader, myQueryParam1Arg)
+	}
+	return f.defaultErr("queryParamListBoolean")
+}
+
+// QueryParamListBooleanCalls returns the arguments of every call made to QueryParamListBoolean, in call order.
+func (f *FakeTestService) QueryParamListBooleanCalls() []FakeTestServiceQueryParamListBooleanCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceQueryParamListBooleanCall(nil), f.queryParamListBooleanCalls...)
+}
+
+// FakeTestServiceQueryParamListDateTimeCall records the arguments of a call to FakeTestService.QueryParamListDateTime.
+type FakeTestServiceQueryParamListDateTimeCall struct {
+	AuthHeader    bearertoken.Token
+	MyQueryParam1 []datetime.DateTime
+}
+
+func (f *FakeTestService) QueryParamListDateTime(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []datetime.DateTime) error {
+	f.mu.Lock()
+	f.queryParamListDateTimeCalls = append(f.queryParamListDateTimeCalls, FakeTestServiceQueryParamListDateTimeCall{AuthHeader: authHeader, MyQueryParam1: myQueryParam1Arg})
+	f.mu.Unlock()
+	if f.QueryParamListDateTimeFunc != nil {
+		return f.QueryParamListDateTimeFunc(ctx, authHeader, myQueryParam1Arg)
+	}
+	return f.defaultErr("queryParamListDateTime")
+}
+
+// QueryParamListDateTimeCalls returns the arguments of every call made to QueryParamListDateTime, in call order.
+func (f *FakeTestService) QueryParamListDateTimeCalls() []FakeTestServiceQueryParamListDateTimeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceQueryParamListDateTimeCall(nil), f.queryParamListDateTimeCalls...)
+}
+
+// FakeTestServiceQueryParamSetDateTimeCall records the arguments of a call to FakeTestService.QueryParamSetDateTime.
+type FakeTestServiceQueryParamSetDateTimeCall struct {
+	AuthHeader    bearertoken.Token
+	MyQueryParam1 []datetime.DateTime
+}
+
+func (f *FakeTestService) QueryParamSetDateTime(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []datetime.DateTime) ([]datetime.DateTime, error) {
+	f.mu.Lock()
+	f.queryParamSetDateTimeCalls = append(f.queryParamSetDateTimeCalls, FakeTestServiceQueryParamSetDateTimeCall{AuthHeader: authHeader, MyQueryParam1: myQueryParam1Arg})
+	f.mu.Unlock()
+	if f.QueryParamSetDateTimeFunc != nil {
+		return f.QueryParamSetDateTimeFunc(ctx, authHeader, myQueryParam1Arg)
+	}
+	var defaultReturnVal []datetime.DateTime
+	return defaultReturnVal, f.defaultErr("queryParamSetDateTime")
+}
+
+// QueryParamSetDateTimeCalls returns the arguments of every call made to QueryParamSetDateTime, in call order.
+func (f *FakeTestService) QueryParamSetDateTimeCalls() []FakeTestServiceQueryParamSetDateTimeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceQueryParamSetDateTimeCall(nil), f.queryParamSetDateTimeCalls...)
+}
+
+// FakeTestServiceQueryParamListDoubleCall records the arguments of a call to FakeTestService.QueryParamListDouble.
+type FakeTestServiceQueryParamListDoubleCall struct {
+	AuthHeader    bearertoken.Token
+	MyQueryParam1 []float64
+}
+
+func (f *FakeTestService) QueryParamListDouble(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []float64) error {
+	f.mu.Lock()
+	f.queryParamListDoubleCalls = append(f.queryParamListDoubleCalls, FakeTestServiceQueryParamListDoubleCall{AuthHeader: authHeader, MyQueryParam1: myQueryParam1Arg})
+	f.mu.Unlock()
+	if f.QueryParamListDoubleFunc != nil {
+		return f.QueryParamListDoubleFunc(ctx, authHeader, myQueryParam1Arg)
+	}
+	return f.defaultErr("queryParamListDouble")
+}
+
+// QueryParamListDoubleCalls returns the arguments of every call made to QueryParamListDouble, in call order.
+func (f *FakeTestService) QueryParamListDoubleCalls() []FakeTestServiceQueryParamListDoubleCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceQueryParamListDoubleCall(nil), f.queryParamListDoubleCalls...)
+}
+
+// FakeTestServiceQueryParamListIntegerCall records the arguments of a call to FakeTestService.QueryParamListInteger.
+type FakeTestServiceQueryParamListIntegerCall struct {
+	AuthHeader    bearertoken.Token
+	MyQueryParam1 []int
+}
+
+func (f *FakeTestService) QueryParamListInteger(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []int) error {
+	f.mu.Lock()
+	f.queryParamListIntegerCalls = append(f.queryParamListIntegerCalls, FakeTestServiceQueryParamListIntegerCall{AuthHeader: authHeader, MyQueryParam1: myQueryParam1Arg})
+	f.mu.Unlock()
+	if f.QueryParamListIntegerFunc != nil {
+		return f.QueryParamListIntegerFunc(ctx, authHeader, myQueryParam1Arg)
+	}
+	return f.defaultErr("queryParamListInteger")
+}
+
+// QueryParamListIntegerCalls returns the arguments of every call made to QueryParamListInteger, in call order.
+func (f *FakeTestService) QueryParamListIntegerCalls() []FakeTestServiceQueryParamListIntegerCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceQueryParamListIntegerCall(nil), f.queryParamListIntegerCalls...)
+}
+
+// FakeTestServiceQueryParamListRidCall records the arguments of a call to FakeTestService.QueryParamListRid.
+type FakeTestServiceQueryParamListRidCall struct {
+	AuthHeader    bearertoken.Token
+	MyQueryParam1 []rid.ResourceIdentifier
+}
+
+func (f *FakeTestService) QueryParamListRid(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []rid.ResourceIdentifier) error {
+	f.mu.Lock()
+	f.queryParamListRidCalls = append(f.queryParamListRidCalls, FakeTestServiceQueryParamListRidCall{AuthHeader: authHeader, MyQueryParam1: myQueryParam1Arg})
+	f.mu.Unlock()
+	if f.QueryParamListRidFunc != nil {
+		return f.QueryParamListRidFunc(ctx, authHeader, myQueryParam1Arg)
+	}
+	return f.defaultErr("queryParamListRid")
+}
+
+// QueryParamListRidCalls returns the arguments of every call made to QueryParamListRid, in call order.
+func (f *FakeTestService) QueryParamListRidCalls() []FakeTestServiceQueryParamListRidCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceQueryParamListRidCall(nil), f.queryParamListRidCalls...)
+}
+
+// FakeTestServiceQueryParamListSafeLongCall records the arguments of a call to FakeTestService.QueryParamListSafeLong.
+type FakeTestServiceQueryParamListSafeLongCall struct {
+	AuthHeader    bearertoken.Token
+	MyQueryParam1 []safelong.SafeLong
+}
+
+func (f *FakeTestService) QueryParamListSafeLong(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []safelong.SafeLong) error {
+	f.mu.Lock()
+	f.queryParamListSafeLongCalls = append(f.queryParamListSafeLongCalls, FakeTestServiceQueryParamListSafeLongCall{AuthHeader: authHeader, MyQueryParam1: myQueryParam1Arg})
+	f.mu.Unlock()
+	if f.QueryParamListSafeLongFunc != nil {
+		return f.QueryParamListSafeLongFunc(ctx, authHeader, myQueryParam1Arg)
+	}
+	return f.defaultErr("queryParamListSafeLong")
+}
+
+// QueryParamListSafeLongCalls returns the arguments of every call made to QueryParamListSafeLong, in call order.
+func (f *FakeTestService) QueryParamListSafeLongCalls() []FakeTestServiceQueryParamListSafeLongCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceQueryParamListSafeLongCall(nil), f.queryParamListSafeLongCalls...)
+}
+
+// FakeTestServiceQueryParamListStringCall records the arguments of a call to FakeTestService.QueryParamListString.
+type FakeTestServiceQueryParamListStringCall struct {
+	AuthHeader    bearertoken.Token
+	MyQueryParam1 []string
+}
+
+func (f *FakeTestService) QueryParamListString(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []string) error {
+	f.mu.Lock()
+	f.queryParamListStringCalls = append(f.queryParamListStringCalls, FakeTestServiceQueryParamListStringCall{AuthHeader: authHeader, MyQueryParam1: myQueryParam1Arg})
+	f.mu.Unlock()
+	if f.QueryParamListStringFunc != nil {
+		return f.QueryParamListStringFunc(ctx, authHeader, myQueryParam1Arg)
+	}
+	return f.defaultErr("queryParamListString")
+}
+
+// QueryParamListStringCalls returns the arguments of every call made to QueryParamListString, in call order.
+func (f *FakeTestService) QueryParamListStringCalls() []FakeTestServiceQueryParamListStringCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceQueryParamListStringCall(nil), f.queryParamListStringCalls...)
+}
+
+// FakeTestServiceQueryParamListUuidCall records the arguments of a call to FakeTestService.QueryParamListUuid.
+type FakeTestServiceQueryParamListUuidCall struct {
+	AuthHeader    bearertoken.Token
+	MyQueryParam1 []uuid.UUID
+}
+
+func (f *FakeTestService) QueryParamListUuid(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg []uuid.UUID) error {
+	f.mu.Lock()
+	f.queryParamListUuidCalls = append(f.queryParamListUuidCalls, FakeTestServiceQueryParamListUuidCall{AuthHeader: authHeader, MyQueryParam1: myQueryParam1Arg})
+	f.mu.Unlock()
+	if f.QueryParamListUuidFunc != nil {
+		return f.QueryParamListUuidFunc(ctx, authHeader, myQueryParam1Arg)
+	}
+	return f.defaultErr("queryParamListUuid")
+}
+
+// QueryParamListUuidCalls returns the arguments of every call made to QueryParamListUuid, in call order.
+func (f *FakeTestService) QueryParamListUuidCalls() []FakeTestServiceQueryParamListUuidCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceQueryParamListUuidCall(nil), f.queryParamListUuidCalls...)
+}
+
+// FakeTestServiceQueryParamExternalStringCall records the arguments of a call to FakeTestService.QueryParamExternalString.
+type FakeTestServiceQueryParamExternalStringCall struct {
+	AuthHeader    bearertoken.Token
+	MyQueryParam1 string
+}
+
+func (f *FakeTestService) QueryParamExternalString(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg string) error {
+	f.mu.Lock()
+	f.queryParamExternalStringCalls = append(f.queryParamExternalStringCalls, FakeTestServiceQueryParamExternalStringCall{AuthHeader: authHeader, MyQueryParam1: myQueryParam1Arg})
+	f.mu.Unlock()
+	if f.QueryParamExternalStringFunc != nil {
+		return f.QueryParamExternalStringFunc(ctx, authHeader, myQueryParam1Arg)
+	}
+	return f.defaultErr("queryParamExternalString")
+}
+
+// QueryParamExternalStringCalls returns the arguments of every call made to QueryParamExternalString, in call order.
+func (f *FakeTestService) QueryParamExternalStringCalls() []FakeTestServiceQueryParamExternalStringCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceQueryParamExternalStringCall(nil), f.queryParamExternalStringCalls...)
+}
+
+// FakeTestServiceQueryParamExternalIntegerCall records the arguments of a call to FakeTestService.QueryParamExternalInteger.
+type FakeTestServiceQueryParamExternalIntegerCall struct {
+	AuthHeader    bearertoken.Token
+	MyQueryParam1 int
+}
+
+func (f *FakeTestService) QueryParamExternalInteger(ctx context.Context, authHeader bearertoken.Token, myQueryParam1Arg int) error {
+	f.mu.Lock()
+	f.queryParamExternalIntegerCalls = append(f.queryParamExternalIntegerCalls, FakeTestServiceQueryParamExternalIntegerCall{AuthHeader: authHeader, MyQueryParam1: myQueryParam1Arg})
+	f.mu.Unlock()
+	if f.QueryParamExternalIntegerFunc != nil {
+		return f.QueryParamExternalIntegerFunc(ctx, authHeader, myQueryParam1Arg)
+	}
+	return f.defaultErr("queryParamExternalInteger")
+}
+
+// QueryParamExternalIntegerCalls returns the arguments of every call made to QueryParamExternalInteger, in call order.
+func (f *FakeTestService) QueryParamExternalIntegerCalls() []FakeTestServiceQueryParamExternalIntegerCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceQueryParamExternalIntegerCall(nil), f.queryParamExternalIntegerCalls...)
+}
+
+// FakeTestServicePathParamExternalStringCall records the arguments of a call to FakeTestService.PathParamExternalString.
+type FakeTestServicePathParamExternalStringCall struct {
+	AuthHeader   bearertoken.Token
+	MyPathParam1 string
+}
+
+func (f *FakeTestService) PathParamExternalString(ctx context.Context, authHeader bearertoken.Token, myPathParam1Arg string) error {
+	f.mu.Lock()
+	f.pathParamExternalStringCalls = append(f.pathParamExternalStringCalls, FakeTestServicePathParamExternalStringCall{AuthHeader: authHeader, MyPathParam1: myPathParam1Arg})
+	f.mu.Unlock()
+	if f.PathParamExternalStringFunc != nil {
+		return f.PathParamExternalStringFunc(ctx, authHeader, myPathParam1Arg)
+	}
+	return f.defaultErr("pathParamExternalString")
+}
+
+// PathParamExternalStringCalls returns the arguments of every call made to PathParamExternalString, in call order.
+func (f *FakeTestService) PathParamExternalStringCalls() []FakeTestServicePathParamExternalStringCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServicePathParamExternalStringCall(nil), f.pathParamExternalStringCalls...)
+}
+
+// FakeTestServicePathParamExternalIntegerCall records the arguments of a call to FakeTestService.PathParamExternalInteger.
+type FakeTestServicePathParamExternalIntegerCall struct {
+	AuthHeader   bearertoken.Token
+	MyPathParam1 int
+}
+
+func (f *FakeTestService) PathParamExternalInteger(ctx context.Context, authHeader bearertoken.Token, myPathParam1Arg int) error {
+	f.mu.Lock()
+	f.pathParamExternalIntegerCalls = append(f.pathParamExternalIntegerCalls, FakeTestServicePathParamExternalIntegerCall{AuthHeader: authHeader, MyPathParam1: myPathParam1Arg})
+	f.mu.Unlock()
+	if f.PathParamExternalIntegerFunc != nil {
+		return f.PathParamExternalIntegerFunc(ctx, authHeader, myPathParam1Arg)
+	}
+	return f.defaultErr("pathParamExternalInteger")
+}
+
+// PathParamExternalIntegerCalls returns the arguments of every call made to PathParamExternalInteger, in call order.
+func (f *FakeTestService) PathParamExternalIntegerCalls() []FakeTestServicePathParamExternalIntegerCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServicePathParamExternalIntegerCall(nil), f.pathParamExternalIntegerCalls...)
+}
+
+// FakeTestServicePostPathParamCall records the arguments of a call to FakeTestService.PostPathParam.
+type FakeTestServicePostPathParamCall struct {
+	AuthHeader     bearertoken.Token
+	MyPathParam1   string
+	MyPathParam2   bool
+	MyBodyParam    CustomObject
+	MyQueryParam1  string
+	MyQueryParam2  string
+	MyQueryParam3  float64
+	MyQueryParam4  *safelong.SafeLong
+	MyQueryParam5  *string
+	MyQueryParam6  OptionalIntegerAlias
+	MyHeaderParam1 safelong.SafeLong
+	MyHeaderParam2 *uuid.UUID
+}
+
+func (f *FakeTestService) PostPathParam(ctx context.Context, authHeader bearertoken.Token, myPathParam1Arg string, myPathParam2Arg bool, myBodyParamArg CustomObject, myQueryParam1Arg string, myQueryParam2Arg string, myQueryParam3Arg float64, myQueryParam4Arg *safelong.SafeLong, myQueryParam5Arg *string, myQueryParam6Arg OptionalIntegerAlias, myHeaderParam1Arg safelong.SafeLong, myHeaderParam2Arg *uuid.UUID) (CustomObject, error) {
+	f.mu.Lock()
+	f.postPathParamCalls = append(f.postPathParamCalls, FakeTestServicePostPathParamCall{AuthHeader: authHeader, MyPathParam1: myPathParam1Arg, MyPathParam2: myPathParam2Arg, MyBodyParam: myBodyParamArg, MyQueryParam1: myQueryParam1Arg, MyQueryParam2: myQueryParam2Arg, MyQueryParam3: myQueryParam3Arg, MyQueryParam4: myQueryParam4Arg, MyQueryParam5: myQueryParam5Arg, MyQueryParam6: myQueryParam6Arg, MyHeaderParam1: myHeaderParam1Arg, MyHeaderParam2: myHeaderParam2Arg})
+	f.mu.Unlock()
+	if f.PostPathParamFunc != nil {
+		return f.PostPathParamFunc(ctx, authHeader, myPathParam1Arg, myPathParam2Arg, myBodyParamArg, myQueryParam1Arg, myQueryParam2Arg, myQueryParam3Arg, myQueryParam4Arg, myQueryParam5Arg, myQueryParam6Arg, myHeaderParam1Arg, myHeaderParam2Arg)
+	}
+	var defaultReturnVal CustomObject
+	return defaultReturnVal, f.defaultErr("postPathParam")
+}
+
+// PostPathParamCalls returns the arguments of every call made to PostPathParam, in call order.
+func (f *FakeTestService) PostPathParamCalls() []FakeTestServicePostPathParamCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServicePostPathParamCall(nil), f.postPathParamCalls...)
+}
+
+// FakeTestServicePostSafeParamsCall records the arguments of a call to FakeTestService.PostSafeParams.
+type FakeTestServicePostSafeParamsCall struct {
+	AuthHeader     bearertoken.Token
+	MyPathParam1   string
+	MyPathParam2   bool
+	MyBodyParam    CustomObject
+	MyQueryParam1  string
+	MyQueryParam2  string
+	MyQueryParam3  float64
+	MyQueryParam4  *safelong.SafeLong
+	MyQueryParam5  *string
+	MyHeaderParam1 safelong.SafeLong
+	MyHeaderParam2 *SafeUuid
+}
+
+func (f *FakeTestService) PostSafeParams(ctx context.Context, authHeader bearertoken.Token, myPathParam1Arg string, myPathParam2Arg bool, myBodyParamArg CustomObject, myQueryParam1Arg string, myQueryParam2Arg string, myQueryParam3Arg float64, myQueryParam4Arg *safelong.SafeLong, myQueryParam5Arg *string, myHeaderParam1Arg safelong.SafeLong, myHeaderParam2Arg *SafeUuid) error {
+	f.mu.Lock()
+	f.postSafeParamsCalls = append(f.postSafeParamsCalls, FakeTestServicePostSafeParamsCall{AuthHeader: authHeader, MyPathParam1: myPathParam1Arg, MyPathParam2: myPathParam2Arg, MyBodyParam: myBodyParamArg, MyQueryParam1: myQueryParam1Arg, MyQueryParam2: myQueryParam2Arg, MyQueryParam3: myQueryParam3Arg, MyQueryParam4: myQueryParam4Arg, MyQueryParam5: myQueryParam5Arg, MyHeaderParam1: myHeaderParam1Arg, MyHeaderParam2: myHeaderParam2Arg})
+	f.mu.Unlock()
+	if f.PostSafeParamsFunc != nil {
+		return f.PostSafeParamsFunc(ctx, authHeader, myPathParam1Arg, myPathParam2Arg, myBodyParamArg, myQueryParam1Arg, myQueryParam2Arg, myQueryParam3Arg, myQueryParam4Arg, myQueryParam5Arg, myHeaderParam1Arg, myHeaderParam2Arg)
+	}
+	return f.defaultErr("postSafeParams")
+}
+
+// PostSafeParamsCalls returns the arguments of every call made to PostSafeParams, in call order.
+func (f *FakeTestService) PostSafeParamsCalls() []FakeTestServicePostSafeParamsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServicePostSafeParamsCall(nil), f.postSafeParamsCalls...)
+}
+
+// FakeTestServiceBytesCall records the arguments of a call to FakeTestService.Bytes.
+type FakeTestServiceBytesCall struct{}
+
+func (f *FakeTestService) Bytes(ctx context.Context) (CustomObject, error) {
+	f.mu.Lock()
+	f.bytesCalls = append(f.bytesCalls, FakeTestServiceBytesCall{})
+	f.mu.Unlock()
+	if f.BytesFunc != nil {
+		return f.BytesFunc(ctx)
+	}
+	var defaultReturnVal CustomObject
+	return defaultReturnVal, f.defaultErr("bytes")
+}
+
+// BytesCalls returns the arguments of every call made to Bytes, in call order.
+func (f *FakeTestService) BytesCalls() []FakeTestServiceBytesCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceBytesCall(nil), f.bytesCalls...)
+}
+
+// FakeTestServiceGetBinaryCall records the arguments of a call to FakeTestService.GetBinary.
+type FakeTestServiceGetBinaryCall struct{}
+
+func (f *FakeTestService) GetBinary(ctx context.Context) (io.ReadCloser, error) {
+	f.mu.Lock()
+	f.getBinaryCalls = append(f.getBinaryCalls, FakeTestServiceGetBinaryCall{})
+	f.mu.Unlock()
+	if f.GetBinaryFunc != nil {
+		return f.GetBinaryFunc(ctx)
+	}
+	var defaultReturnVal io.ReadCloser
+	return defaultReturnVal, f.defaultErr("getBinary")
+}
+
+// GetBinaryCalls returns the arguments of every call made to GetBinary, in call order.
+func (f *FakeTestService) GetBinaryCalls() []FakeTestServiceGetBinaryCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceGetBinaryCall(nil), f.getBinaryCalls...)
+}
+
+// FakeTestServicePostBinaryCall records the arguments of a call to FakeTestService.PostBinary.
+type FakeTestServicePostBinaryCall struct {
+	MyBytes io.ReadCloser
+}
+
+func (f *FakeTestService) PostBinary(ctx context.Context, myBytesArg io.ReadCloser) (io.ReadCloser, error) {
+	f.mu.Lock()
+	f.postBinaryCalls = append(f.postBinaryCalls, FakeTestServicePostBinaryCall{MyBytes: myBytesArg})
+	f.mu.Unlock()
+	if f.PostBinaryFunc != nil {
+		return f.PostBinaryFunc(ctx, myBytesArg)
+	}
+	var defaultReturnVal io.ReadCloser
+	return defaultReturnVal, f.defaultErr("postBinary")
+}
+
+// PostBinaryCalls returns the arguments of every call made to PostBinary, in call order.
+func (f *FakeTestService) PostBinaryCalls() []FakeTestServicePostBinaryCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServicePostBinaryCall(nil), f.postBinaryCalls...)
+}
+
+// FakeTestServicePutBinaryCall records the arguments of a call to FakeTestService.PutBinary.
+type FakeTestServicePutBinaryCall struct {
+	MyBytes io.ReadCloser
+}
+
+func (f *FakeTestService) PutBinary(ctx context.Context, myBytesArg io.ReadCloser) error {
+	f.mu.Lock()
+	f.putBinaryCalls = append(f.putBinaryCalls, FakeTestServicePutBinaryCall{MyBytes: myBytesArg})
+	f.mu.Unlock()
+	if f.PutBinaryFunc != nil {
+		return f.PutBinaryFunc(ctx, myBytesArg)
+	}
+	return f.defaultErr("putBinary")
+}
+
+// PutBinaryCalls returns the arguments of every call made to PutBinary, in call order.
+func (f *FakeTestService) PutBinaryCalls() []FakeTestServicePutBinaryCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServicePutBinaryCall(nil), f.putBinaryCalls...)
+}
+
+// FakeTestServiceGetOptionalBinaryCall records the arguments of a call to FakeTestService.GetOptionalBinary.
+type FakeTestServiceGetOptionalBinaryCall struct{}
+
+func (f *FakeTestService) GetOptionalBinary(ctx context.Context) (*io.ReadCloser, error) {
+	f.mu.Lock()
+	f.getOptionalBinaryCalls = append(f.getOptionalBinaryCalls, FakeTestServiceGetOptionalBinaryCall{})
+	f.mu.Unlock()
+	if f.GetOptionalBinaryFunc != nil {
+		return f.GetOptionalBinaryFunc(ctx)
+	}
+	var defaultReturnVal *io.ReadCloser
+	return defaultReturnVal, f.defaultErr("getOptionalBinary")
+}
+
+// GetOptionalBinaryCalls returns the arguments of every call made to GetOptionalBinary, in call order.
+func (f *FakeTestService) GetOptionalBinaryCalls() []FakeTestServiceGetOptionalBinaryCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceGetOptionalBinaryCall(nil), f.getOptionalBinaryCalls...)
+}
+
+// FakeTestServiceChanCall records the arguments of a call to FakeTestService.Chan.
+type FakeTestServiceChanCall struct {
+	Var    string
+	Import map[string]string
+	Type   string
+	Return safelong.SafeLong
+	Http   string
+	Json   string
+	Req    string
+	Rw     string
+}
+
+func (f *FakeTestService) Chan(ctx context.Context, varArg string, importArg map[string]string, typeArg string, returnArg safelong.SafeLong, httpArg string, jsonArg string, reqArg string, rwArg string) error {
+	f.mu.Lock()
+	f.chanCalls = append(f.chanCalls, FakeTestServiceChanCall{Var: varArg, Import: importArg, Type: typeArg, Return: returnArg, Http: httpArg, Json: jsonArg, Req: reqArg, Rw: rwArg})
+	f.mu.Unlock()
+	if f.ChanFunc != nil {
+		return f.ChanFunc(ctx, varArg, importArg, typeArg, returnArg, httpArg, jsonArg, reqArg, rwArg)
+	}
+	return f.defaultErr("chan")
+}
+
+// ChanCalls returns the arguments of every call made to Chan, in call order.
+func (f *FakeTestService) ChanCalls() []FakeTestServiceChanCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeTestServiceChanCall(nil), f.chanCalls...)
+}
+
+func (f *FakeTestService) defaultErr(endpoint string) error {
+	if f.DefaultErr != nil {
+		return f.DefaultErr
+	}
+	return errors.NewInternal(wparams.NewSafeParamStorer(map[string]interface{}{"fakeEndpoint": endpoint}))
+}
@@ -0,0 +1,131 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
+)
+
+type Name string
+
+func (a *Name) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *Name) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v string
+	v, err = decodeJSONString(value)
+	if err != nil {
+		return err
+	}
+	*a = Name(v)
+	return nil
+}
+
+// Equal returns true if the Name is equal to other according to the Conjure semantics of its values.
+func (a Name) Equal(other Name) bool {
+	return string(a) == string(other)
+}
+
+// Clone returns a deep copy of the Name.
+func (a Name) Clone() Name {
+	return a
+}
+
+// Hash returns a hash of the Name. Values that are Equal have the same hash.
+func (a Name) Hash() uint64 {
+	return hashString(string(a))
+}
+
+type Tags StringSet
+
+func (a Tags) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out, err = StringSet(a).AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (a Tags) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	n, err = StringSet(a).JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	return size, nil
+}
+
+func (a Tags) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+func (a *Tags) UnmarshalJSON(data []byte) error {
+	var rawTags StringSet
+	if err := safejson.Unmarshal(data, &rawTags); err != nil {
+		return err
+	}
+	*a = Tags(rawTags)
+	return nil
+}
+
+func (a Tags) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (a *Tags) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&a)
+}
+
+func (a *Tags) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *Tags) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v StringSet
+	err = v.decodeJSONStrict(value)
+	if err != nil {
+		return err
+	}
+	*a = Tags(v)
+	return nil
+}
+
+// Equal returns true if the Tags is equal to other according to the Conjure semantics of its values.
+func (a Tags) Equal(other Tags) bool {
+	return StringSet(a).Equal(StringSet(other))
+}
+
+// Clone returns a deep copy of the Tags.
+func (a Tags) Clone() Tags {
+	return Tags(StringSet(a).Clone())
+}
+
+// Hash returns a hash of the Tags. Values that are Equal have the same hash.
+func (a Tags) Hash() uint64 {
+	return StringSet(a).Hash()
+}
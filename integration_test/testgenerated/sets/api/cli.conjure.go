@@ -0,0 +1,247 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/codecs"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	wlogzap "github.com/palantir/witchcraft-go-logging/wlog-zap"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/trclog/trc1log"
+	"github.com/palantir/witchcraft-go-tracing/wtracing"
+	"github.com/palantir/witchcraft-go-tracing/wzipkin"
+	"github.com/spf13/cobra"
+	pflag "github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+type CLIConfig struct {
+	Client httpclient.ClientConfig `yaml:",inline"`
+}
+
+// Commands for SetService
+
+type CLISetServiceClientProvider interface {
+	Get(ctx context.Context, flags *pflag.FlagSet) (SetServiceClient, error)
+}
+
+type defaultCLISetServiceClientProvider struct{}
+
+func NewDefaultCLISetServiceClientProvider() CLISetServiceClientProvider {
+	return defaultCLISetServiceClientProvider{}
+}
+
+func (d defaultCLISetServiceClientProvider) Get(ctx context.Context, flags *pflag.FlagSet) (SetServiceClient, error) {
+	conf, err := loadCLIConfig(ctx, flags)
+	if err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "failed to load CLI configuration file")
+	}
+	client, err := httpclient.NewClient(httpclient.WithConfig(conf.Client))
+	if err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "failed to create client with provided config")
+	}
+	return NewSetServiceClient(client), nil
+}
+
+type SetServiceCLICommand struct {
+	clientProvider CLISetServiceClientProvider
+}
+
+func NewSetServiceCLICommand() *cobra.Command {
+	return NewSetServiceCLICommandWithClientProvider(NewDefaultCLISetServiceClientProvider())
+}
+
+func NewSetServiceCLICommandWithClientProvider(clientProvider CLISetServiceClientProvider) *cobra.Command {
+	rootCmd := &cobra.Command{
+		Short: "Runs commands on the SetService",
+		Use:   "setService",
+	}
+	rootCmd.PersistentFlags().String("conf", "var/conf/configuration.yml", "The configuration file is optional. The default path is ./var/conf/configuration.yml.")
+	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "Enables verbose mode for debugging client connections.")
+
+	cliCommand := SetServiceCLICommand{clientProvider: clientProvider}
+
+	setService_EchoStrings_Cmd := &cobra.Command{
+		RunE:  cliCommand.setService_EchoStrings_CmdRun,
+		Short: "Calls the echoStrings endpoint.",
+		Use:   "echoStrings",
+	}
+	rootCmd.AddCommand(setService_EchoStrings_Cmd)
+	setService_EchoStrings_Cmd.Flags().String("values", "", "Required. ")
+	setService_EchoStrings_Cmd.Flags().String("numbers", "", "Required. ")
+
+	setService_EchoColors_Cmd := &cobra.Command{
+		RunE:  cliCommand.setService_EchoColors_CmdRun,
+		Short: "Calls the echoColors endpoint.",
+		Use:   "echoColors",
+	}
+	rootCmd.AddCommand(setService_EchoColors_Cmd)
+	setService_EchoColors_Cmd.Flags().String("colors", "", "Required. ")
+
+	return rootCmd
+}
+
+func (c SetServiceCLICommand) setService_EchoStrings_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	valuesRaw, err := flags.GetString("values")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument values")
+	}
+	if valuesRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "values is a required argument")
+	}
+	var valuesArg StringSet
+	var valuesArgReader io.ReadCloser
+	switch {
+	case valuesRaw == "@-":
+		valuesArgReader = io.NopCloser(cmd.InOrStdin())
+	case strings.HasPrefix(valuesRaw, "@"):
+		valuesArgReader, err = os.Open(strings.TrimSpace(valuesRaw[1:]))
+		if err != nil {
+			return werror.WrapWithContextParams(ctx, err, "failed to open file for argument values")
+		}
+	default:
+		valuesArgReader = io.NopCloser(bytes.NewReader([]byte(valuesRaw)))
+	}
+	defer valuesArgReader.Close()
+	if err := codecs.JSON.Decode(valuesArgReader, &valuesArg); err != nil {
+		return werror.WrapWithContextParams(ctx, err, "invalid value for values argument")
+	}
+
+	numbersRaw, err := flags.GetString("numbers")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument numbers")
+	}
+	if numbersRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "numbers is a required argument")
+	}
+	var numbersArg IntegerSet
+	var numbersArgReader io.ReadCloser
+	switch {
+	case numbersRaw == "@-":
+		numbersArgReader = io.NopCloser(cmd.InOrStdin())
+	case strings.HasPrefix(numbersRaw, "@"):
+		numbersArgReader, err = os.Open(strings.TrimSpace(numbersRaw[1:]))
+		if err != nil {
+			return werror.WrapWithContextParams(ctx, err, "failed to open file for argument numbers")
+		}
+	default:
+		numbersArgReader = io.NopCloser(bytes.NewReader([]byte(numbersRaw)))
+	}
+	defer numbersArgReader.Close()
+	if err := codecs.JSON.Decode(numbersArgReader, &numbersArg); err != nil {
+		return werror.WrapWithContextParams(ctx, err, "invalid value for numbers argument")
+	}
+
+	result, err := client.EchoStrings(ctx, valuesArg, numbersArg)
+	if err != nil {
+		return err
+	}
+	resultBytes, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		fmt.Printf("Failed to marshal to json with err: %v\n\nPrinting as string:\n%v\n", err, result)
+		return nil
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%v\n", string(resultBytes))
+	return nil
+}
+
+func (c SetServiceCLICommand) setService_EchoColors_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	colorsRaw, err := flags.GetString("colors")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument colors")
+	}
+	if colorsRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "colors is a required argument")
+	}
+	var colorsArg ColorSet
+	var colorsArgReader io.ReadCloser
+	switch {
+	case colorsRaw == "@-":
+		colorsArgReader = io.NopCloser(cmd.InOrStdin())
+	case strings.HasPrefix(colorsRaw, "@"):
+		colorsArgReader, err = os.Open(strings.TrimSpace(colorsRaw[1:]))
+		if err != nil {
+			return werror.WrapWithContextParams(ctx, err, "failed to open file for argument colors")
+		}
+	default:
+		colorsArgReader = io.NopCloser(bytes.NewReader([]byte(colorsRaw)))
+	}
+	defer colorsArgReader.Close()
+	if err := codecs.JSON.Decode(colorsArgReader, &colorsArg); err != nil {
+		return werror.WrapWithContextParams(ctx, err, "invalid value for colors argument")
+	}
+
+	result, err := client.EchoColors(ctx, colorsArg)
+	if err != nil {
+		return err
+	}
+	resultBytes, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		fmt.Printf("Failed to marshal to json with err: %v\n\nPrinting as string:\n%v\n", err, result)
+		return nil
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%v\n", string(resultBytes))
+	return nil
+}
+
+func loadCLIConfig(ctx context.Context, flags *pflag.FlagSet) (CLIConfig, error) {
+	var emptyConfig CLIConfig
+	configPath, err := flags.GetString("conf")
+	if err != nil || configPath == "" {
+		return emptyConfig, werror.WrapWithContextParams(ctx, err, "config file location must be specified")
+	}
+	confBytes, err := os.ReadFile(configPath)
+	if err != nil {
+		return emptyConfig, err
+	}
+	var conf CLIConfig
+	err = yaml.Unmarshal(confBytes, &conf)
+	if err != nil {
+		return emptyConfig, err
+	}
+	return conf, nil
+}
+
+func getCLIContext(flags *pflag.FlagSet) context.Context {
+	ctx := context.Background()
+	logProvider := wlog.NewNoopLoggerProvider()
+	logWriter := io.Discard
+	verbose, err := flags.GetBool("verbose")
+	if verbose && err == nil {
+		logProvider = wlogzap.LoggerProvider()
+		logWriter = os.Stdout
+	}
+	wlog.SetDefaultLoggerProvider(logProvider)
+	ctx = svc1log.WithLogger(ctx, svc1log.New(logWriter, wlog.DebugLevel))
+	traceLogger := trc1log.New(logWriter)
+	ctx = trc1log.WithLogger(ctx, traceLogger)
+	ctx = evt2log.WithLogger(ctx, evt2log.New(logWriter))
+	tracer, err := wzipkin.NewTracer(traceLogger)
+	if err != nil {
+		return ctx
+	}
+	return wtracing.ContextWithTracer(ctx, tracer)
+}
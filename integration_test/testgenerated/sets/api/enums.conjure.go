@@ -0,0 +1,104 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+type Color struct {
+	val Color_Value
+}
+
+type Color_Value string
+
+const (
+	Color_RED     Color_Value = "RED"
+	Color_GREEN   Color_Value = "GREEN"
+	Color_BLUE    Color_Value = "BLUE"
+	Color_UNKNOWN Color_Value = "UNKNOWN"
+)
+
+// Color_Values returns all known variants of Color.
+func Color_Values() []Color_Value {
+	return []Color_Value{Color_RED, Color_GREEN, Color_BLUE}
+}
+
+func New_Color(value Color_Value) Color {
+	return Color{val: value}
+}
+
+// IsUnknown returns false for all known variants of Color and true otherwise.
+func (e Color) IsUnknown() bool {
+	switch e.val {
+	case Color_RED, Color_GREEN, Color_BLUE:
+		return false
+	}
+	return true
+}
+
+func (e Color) Value() Color_Value {
+	if e.IsUnknown() {
+		return Color_UNKNOWN
+	}
+	return e.val
+}
+
+func (e Color) String() string {
+	return string(e.val)
+}
+
+func (e Color) MarshalText() ([]byte, error) {
+	return []byte(e.val), nil
+}
+
+func (e *Color) UnmarshalText(data []byte) error {
+	switch v := strings.ToUpper(string(data)); v {
+	default:
+		*e = New_Color(Color_Value(v))
+	case "RED":
+		*e = New_Color(Color_RED)
+	case "GREEN":
+		*e = New_Color(Color_GREEN)
+	case "BLUE":
+		*e = New_Color(Color_BLUE)
+	}
+	return nil
+}
+
+func (e Color) AppendJSON(out []byte) ([]byte, error) {
+	return appendJSONString(out, string(e.val)), nil
+}
+
+func (e Color) JSONSize() (int, error) {
+	return jsonStringSize(string(e.val)), nil
+}
+
+func (e *Color) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return e.decodeJSONStrict(value)
+}
+
+func (e *Color) decodeJSONStrict(value gjson.Result) error {
+	return decodeJSONEnum(value, e)
+}
+
+// Equal returns true if the Color is equal to other according to the Conjure semantics of its values.
+func (e Color) Equal(other Color) bool {
+	return e.val == other.val
+}
+
+// Clone returns a deep copy of the Color.
+func (e Color) Clone() Color {
+	return e
+}
+
+// Hash returns a hash of the Color. Values that are Equal have the same hash.
+func (e Color) Hash() uint64 {
+	return hashString(string(e.val))
+}
@@ -0,0 +1,84 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	wparams "github.com/palantir/witchcraft-go-params"
+)
+
+// FakeSetService is an in-memory implementation of SetService for use in tests.
+// Each endpoint records its arguments and then invokes the corresponding <Endpoint>Func field.
+// If the field is nil, the endpoint returns DefaultErr (or a Conjure Internal error if DefaultErr is nil).
+// The zero value is ready to use and all methods are safe for concurrent use.
+type FakeSetService struct {
+	// EchoStringsFunc is invoked by EchoStrings if non-nil.
+	EchoStringsFunc func(ctx context.Context, valuesArg StringSet, numbersArg IntegerSet) (StringSet, error)
+	// EchoColorsFunc is invoked by EchoColors if non-nil.
+	EchoColorsFunc func(ctx context.Context, colorsArg ColorSet) (ColorSet, error)
+	// DefaultErr is returned by endpoints whose func field is nil.
+	DefaultErr error
+
+	mu               sync.Mutex
+	echoStringsCalls []FakeSetServiceEchoStringsCall
+	echoColorsCalls  []FakeSetServiceEchoColorsCall
+}
+
+var _ SetService = (*FakeSetService)(nil)
+
+// FakeSetServiceEchoStringsCall records the arguments of a call to FakeSetService.EchoStrings.
+type FakeSetServiceEchoStringsCall struct {
+	Values  StringSet
+	Numbers IntegerSet
+}
+
+func (f *FakeSetService) EchoStrings(ctx context.Context, valuesArg StringSet, numbersArg IntegerSet) (StringSet, error) {
+	f.mu.Lock()
+	f.echoStringsCalls = append(f.echoStringsCalls, FakeSetServiceEchoStringsCall{Values: valuesArg, Numbers: numbersArg})
+	f.mu.Unlock()
+	if f.EchoStringsFunc != nil {
+		return f.EchoStringsFunc(ctx, valuesArg, numbersArg)
+	}
+	var defaultReturnVal StringSet
+	return defaultReturnVal, f.defaultErr("echoStrings")
+}
+
+// EchoStringsCalls returns the arguments of every call made to EchoStrings, in call order.
+func (f *FakeSetService) EchoStringsCalls() []FakeSetServiceEchoStringsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeSetServiceEchoStringsCall(nil), f.echoStringsCalls...)
+}
+
+// FakeSetServiceEchoColorsCall records the arguments of a call to FakeSetService.EchoColors.
+type FakeSetServiceEchoColorsCall struct {
+	Colors ColorSet
+}
+
+func (f *FakeSetService) EchoColors(ctx context.Context, colorsArg ColorSet) (ColorSet, error) {
+	f.mu.Lock()
+	f.echoColorsCalls = append(f.echoColorsCalls, FakeSetServiceEchoColorsCall{Colors: colorsArg})
+	f.mu.Unlock()
+	if f.EchoColorsFunc != nil {
+		return f.EchoColorsFunc(ctx, colorsArg)
+	}
+	var defaultReturnVal ColorSet
+	return defaultReturnVal, f.defaultErr("echoColors")
+}
+
+// EchoColorsCalls returns the arguments of every call made to EchoColors, in call order.
+func (f *FakeSetService) EchoColorsCalls() []FakeSetServiceEchoColorsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeSetServiceEchoColorsCall(nil), f.echoColorsCalls...)
+}
+
+func (f *FakeSetService) defaultErr(endpoint string) error {
+	if f.DefaultErr != nil {
+		return f.DefaultErr
+	}
+	return errors.NewInternal(wparams.NewSafeParamStorer(map[string]interface{}{"fakeEndpoint": endpoint}))
+}
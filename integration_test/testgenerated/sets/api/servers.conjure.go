@@ -0,0 +1,78 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/codecs"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-server/httpserver"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-server/v2/witchcraft/wresource"
+	"github.com/palantir/witchcraft-go-server/v2/wrouter"
+)
+
+type SetService interface {
+	EchoStrings(ctx context.Context, valuesArg StringSet, numbersArg IntegerSet) (StringSet, error)
+	EchoColors(ctx context.Context, colorsArg ColorSet) (ColorSet, error)
+}
+
+// RegisterRoutesSetService registers handlers for the SetService endpoints with a witchcraft wrouter.
+// This should typically be called in a witchcraft server's InitFunc.
+// impl provides an implementation of each endpoint, which can assume the request parameters have been parsed
+// in accordance with the Conjure specification.
+func RegisterRoutesSetService(router wrouter.Router, impl SetService, routerParams ...wrouter.RouteParam) error {
+	handler := setServiceHandler{impl: impl}
+	resource := wresource.New("setservice", router)
+	if err := resource.Get("EchoStrings", "/sets/strings", httpserver.NewJSONHandler(handler.HandleEchoStrings, httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add echoStrings route")
+	}
+	if err := resource.Post("EchoColors", "/sets/colors", httpserver.NewJSONHandler(handler.HandleEchoColors, httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add echoColors route")
+	}
+	return nil
+}
+
+type setServiceHandler struct {
+	impl SetService
+}
+
+func (s *setServiceHandler) HandleEchoStrings(rw http.ResponseWriter, req *http.Request) error {
+	var valuesArg StringSet
+	valuesArg.Add(req.URL.Query()["values"]...)
+	var numbersArg IntegerSet
+	for _, v := range req.URL.Query()["numbers"] {
+		convertedVal, err := strconv.Atoi(v)
+		if err != nil {
+			return werror.WrapWithContextParams(req.Context(), errors.WrapWithInvalidArgument(err), "failed to parse \"numbers\" as integer")
+		}
+		numbersArg.Add(convertedVal)
+	}
+	respArg, err := s.impl.EchoStrings(req.Context(), valuesArg, numbersArg)
+	if err != nil {
+		return err
+	}
+	rw.Header().Add("Content-Type", codecs.JSON.ContentType())
+	return codecs.JSON.Encode(rw, respArg)
+}
+
+func (s *setServiceHandler) HandleEchoColors(rw http.ResponseWriter, req *http.Request) error {
+	var colorsArg ColorSet
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	if err := colorsArg.UnmarshalJSONStrict(data); err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	respArg, err := s.impl.EchoColors(req.Context(), colorsArg)
+	if err != nil {
+		return err
+	}
+	rw.Header().Add("Content-Type", codecs.JSON.ContentType())
+	return codecs.JSON.Encode(rw, respArg)
+}
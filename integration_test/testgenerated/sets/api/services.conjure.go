@@ -0,0 +1,66 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	werror "github.com/palantir/witchcraft-go-error"
+)
+
+type SetServiceClient interface {
+	EchoStrings(ctx context.Context, valuesArg StringSet, numbersArg IntegerSet) (StringSet, error)
+	EchoColors(ctx context.Context, colorsArg ColorSet) (ColorSet, error)
+}
+
+type setServiceClient struct {
+	client httpclient.Client
+}
+
+func NewSetServiceClient(client httpclient.Client) SetServiceClient {
+	return &setServiceClient{client: client}
+}
+
+func (c *setServiceClient) EchoStrings(ctx context.Context, valuesArg StringSet, numbersArg IntegerSet) (StringSet, error) {
+	var returnVal StringSet
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("EchoStrings"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("GET"))
+	requestParams = append(requestParams, httpclient.WithPathf("/sets/strings"))
+	queryParams := make(url.Values)
+	for _, v := range valuesArg.Sorted() {
+		queryParams.Add("values", fmt.Sprint(v))
+	}
+	for _, v := range numbersArg.Sorted() {
+		queryParams.Add("numbers", fmt.Sprint(v))
+	}
+	requestParams = append(requestParams, httpclient.WithQueryValues(queryParams))
+	requestParams = append(requestParams, httpclient.WithJSONResponse(&returnVal))
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "echoStrings failed")
+	}
+	if returnVal == nil {
+		return nil, werror.ErrorWithContextParams(ctx, "echoStrings response cannot be nil")
+	}
+	return returnVal, nil
+}
+
+func (c *setServiceClient) EchoColors(ctx context.Context, colorsArg ColorSet) (ColorSet, error) {
+	var returnVal ColorSet
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("EchoColors"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("POST"))
+	requestParams = append(requestParams, httpclient.WithPathf("/sets/colors"))
+	requestParams = append(requestParams, httpclient.WithJSONRequest(colorsArg))
+	requestParams = append(requestParams, httpclient.WithJSONResponse(&returnVal))
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "echoColors failed")
+	}
+	if returnVal == nil {
+		return nil, werror.ErrorWithContextParams(ctx, "echoColors response cannot be nil")
+	}
+	return returnVal, nil
+}
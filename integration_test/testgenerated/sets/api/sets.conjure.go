@@ -0,0 +1,1687 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/palantir/pkg/rid"
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safelong"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/palantir/pkg/uuid"
+	"github.com/tidwall/gjson"
+)
+
+// StringSet is a set of string values. The zero value is an empty set to which elements can be added.
+type StringSet map[string]struct{}
+
+// NewStringSet returns a StringSet that contains the provided elements.
+func NewStringSet(elems ...string) StringSet {
+	s := make(StringSet, len(elems))
+	s.Add(elems...)
+	return s
+}
+
+// Add adds the provided elements to the set. Adding elements to a nil set initializes it.
+func (s *StringSet) Add(elems ...string) {
+	if *s == nil {
+		*s = make(StringSet, len(elems))
+	}
+	for _, elem := range elems {
+		(*s)[elem] = struct{}{}
+	}
+}
+
+// Contains returns true if the set contains elem.
+func (s StringSet) Contains(elem string) bool {
+	_, ok := s[elem]
+	return ok
+}
+
+// Remove removes the provided elements from the set.
+func (s StringSet) Remove(elems ...string) {
+	for _, elem := range elems {
+		delete(s, elem)
+	}
+}
+
+// Sorted returns the elements of the set in ascending order.
+func (s StringSet) Sorted() []string {
+	elems := make([]string, 0, len(s))
+	for elem := range s {
+		elems = append(elems, elem)
+	}
+	sort.Slice(elems, func(i, j int) bool {
+		return elems[i] < elems[j]
+	})
+	return elems
+}
+
+func (s StringSet) AppendJSON(out []byte) ([]byte, error) {
+	elems := s.Sorted()
+	out = append(out, '[')
+	for i, v := range elems {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, v)
+	}
+	out = append(out, ']')
+	return out, nil
+}
+
+func (s StringSet) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len(s) > 1 {
+		size += len(s) - 1
+	}
+	for elem := range s {
+		size += jsonStringSize(elem)
+	}
+	return size, nil
+}
+
+func (s StringSet) MarshalJSON() ([]byte, error) {
+	return s.AppendJSON(nil)
+}
+
+func (s *StringSet) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v StringSet
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*s = v
+			return nil
+		}
+	}
+	var elems []string
+	if err := safejson.Unmarshal(data, &elems); err != nil {
+		return err
+	}
+	*s = NewStringSet(elems...)
+	return nil
+}
+
+func (s *StringSet) unmarshalJSONValue(value gjson.Result) bool {
+	var elems []string
+	if value.Type != gjson.Null {
+		if !value.IsArray() {
+			return false
+		}
+		elems = make([]string, 0)
+		ok := true
+		value.ForEach(func(_, elem gjson.Result) bool {
+			var v string
+			if !unmarshalJSONString(elem, &v) {
+				ok = false
+				return false
+			}
+			elems = append(elems, v)
+			return true
+		})
+		if !ok {
+			return false
+		}
+	}
+	*s = NewStringSet(elems...)
+	return true
+}
+
+func (s *StringSet) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return s.decodeJSONStrict(value)
+}
+
+func (s *StringSet) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var elems []string
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	elems = make([]string, 0)
+	seen := make(map[string]struct{})
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v string
+		v, err = decodeJSONString(elem)
+		if err != nil {
+			return false
+		}
+		if _, ok := seen[v]; ok {
+			err = fmt.Errorf("duplicate set element %s", elem.Raw)
+			return false
+		}
+		seen[v] = struct{}{}
+		elems = append(elems, v)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*s = NewStringSet(elems...)
+	return nil
+}
+
+func (s StringSet) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (s *StringSet) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&s)
+}
+
+// Equal returns true if the StringSet is equal to other according to the Conjure semantics of its values.
+func (s StringSet) Equal(other StringSet) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	for elem := range s {
+		if !other.Contains(elem) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the StringSet.
+func (s StringSet) Clone() StringSet {
+	if s == nil {
+		return nil
+	}
+	out := make(StringSet, len(s))
+	for elem := range s {
+		out[elem] = struct{}{}
+	}
+	return out
+}
+
+// Hash returns a hash of the StringSet. Values that are Equal have the same hash.
+func (s StringSet) Hash() uint64 {
+	var h uint64
+	for elem := range s {
+		var elemHash uint64
+		elemHash = hashMix(elemHash, hashString(elem))
+		h += elemHash
+	}
+	return h
+}
+
+// IntegerSet is a set of integer values. The zero value is an empty set to which elements can be added.
+type IntegerSet map[int]struct{}
+
+// NewIntegerSet returns a IntegerSet that contains the provided elements.
+func NewIntegerSet(elems ...int) IntegerSet {
+	s := make(IntegerSet, len(elems))
+	s.Add(elems...)
+	return s
+}
+
+// Add adds the provided elements to the set. Adding elements to a nil set initializes it.
+func (s *IntegerSet) Add(elems ...int) {
+	if *s == nil {
+		*s = make(IntegerSet, len(elems))
+	}
+	for _, elem := range elems {
+		(*s)[elem] = struct{}{}
+	}
+}
+
+// Contains returns true if the set contains elem.
+func (s IntegerSet) Contains(elem int) bool {
+	_, ok := s[elem]
+	return ok
+}
+
+// Remove removes the provided elements from the set.
+func (s IntegerSet) Remove(elems ...int) {
+	for _, elem := range elems {
+		delete(s, elem)
+	}
+}
+
+// Sorted returns the elements of the set in ascending order.
+func (s IntegerSet) Sorted() []int {
+	elems := make([]int, 0, len(s))
+	for elem := range s {
+		elems = append(elems, elem)
+	}
+	sort.Slice(elems, func(i, j int) bool {
+		return elems[i] < elems[j]
+	})
+	return elems
+}
+
+func (s IntegerSet) AppendJSON(out []byte) ([]byte, error) {
+	elems := s.Sorted()
+	out = append(out, '[')
+	for i, v := range elems {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = strconv.AppendInt(out, int64(v), 10)
+	}
+	out = append(out, ']')
+	return out, nil
+}
+
+func (s IntegerSet) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len(s) > 1 {
+		size += len(s) - 1
+	}
+	for elem := range s {
+		size += jsonIntSize(int64(elem))
+	}
+	return size, nil
+}
+
+func (s IntegerSet) MarshalJSON() ([]byte, error) {
+	return s.AppendJSON(nil)
+}
+
+func (s *IntegerSet) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v IntegerSet
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*s = v
+			return nil
+		}
+	}
+	var elems []int
+	if err := safejson.Unmarshal(data, &elems); err != nil {
+		return err
+	}
+	*s = NewIntegerSet(elems...)
+	return nil
+}
+
+func (s *IntegerSet) unmarshalJSONValue(value gjson.Result) bool {
+	var elems []int
+	if value.Type != gjson.Null {
+		if !value.IsArray() {
+			return false
+		}
+		elems = make([]int, 0)
+		ok := true
+		value.ForEach(func(_, elem gjson.Result) bool {
+			var v int
+			if !unmarshalJSONInt(elem, &v) {
+				ok = false
+				return false
+			}
+			elems = append(elems, v)
+			return true
+		})
+		if !ok {
+			return false
+		}
+	}
+	*s = NewIntegerSet(elems...)
+	return true
+}
+
+func (s *IntegerSet) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return s.decodeJSONStrict(value)
+}
+
+func (s *IntegerSet) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var elems []int
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	elems = make([]int, 0)
+	seen := make(map[int]struct{})
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v int
+		v, err = decodeJSONInt(elem)
+		if err != nil {
+			return false
+		}
+		if _, ok := seen[v]; ok {
+			err = fmt.Errorf("duplicate set element %s", elem.Raw)
+			return false
+		}
+		seen[v] = struct{}{}
+		elems = append(elems, v)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*s = NewIntegerSet(elems...)
+	return nil
+}
+
+func (s IntegerSet) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (s *IntegerSet) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&s)
+}
+
+// Equal returns true if the IntegerSet is equal to other according to the Conjure semantics of its values.
+func (s IntegerSet) Equal(other IntegerSet) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	for elem := range s {
+		if !other.Contains(elem) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the IntegerSet.
+func (s IntegerSet) Clone() IntegerSet {
+	if s == nil {
+		return nil
+	}
+	out := make(IntegerSet, len(s))
+	for elem := range s {
+		out[elem] = struct{}{}
+	}
+	return out
+}
+
+// Hash returns a hash of the IntegerSet. Values that are Equal have the same hash.
+func (s IntegerSet) Hash() uint64 {
+	var h uint64
+	for elem := range s {
+		var elemHash uint64
+		elemHash = hashMix(elemHash, uint64(elem))
+		h += elemHash
+	}
+	return h
+}
+
+// BooleanSet is a set of boolean values. The zero value is an empty set to which elements can be added.
+type BooleanSet map[bool]struct{}
+
+// NewBooleanSet returns a BooleanSet that contains the provided elements.
+func NewBooleanSet(elems ...bool) BooleanSet {
+	s := make(BooleanSet, len(elems))
+	s.Add(elems...)
+	return s
+}
+
+// Add adds the provided elements to the set. Adding elements to a nil set initializes it.
+func (s *BooleanSet) Add(elems ...bool) {
+	if *s == nil {
+		*s = make(BooleanSet, len(elems))
+	}
+	for _, elem := range elems {
+		(*s)[elem] = struct{}{}
+	}
+}
+
+// Contains returns true if the set contains elem.
+func (s BooleanSet) Contains(elem bool) bool {
+	_, ok := s[elem]
+	return ok
+}
+
+// Remove removes the provided elements from the set.
+func (s BooleanSet) Remove(elems ...bool) {
+	for _, elem := range elems {
+		delete(s, elem)
+	}
+}
+
+// Sorted returns the elements of the set in ascending order.
+func (s BooleanSet) Sorted() []bool {
+	elems := make([]bool, 0, len(s))
+	for elem := range s {
+		elems = append(elems, elem)
+	}
+	sort.Slice(elems, func(i, j int) bool {
+		return !elems[i] && elems[j]
+	})
+	return elems
+}
+
+func (s BooleanSet) AppendJSON(out []byte) ([]byte, error) {
+	elems := s.Sorted()
+	out = append(out, '[')
+	for i, v := range elems {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = strconv.AppendBool(out, v)
+	}
+	out = append(out, ']')
+	return out, nil
+}
+
+func (s BooleanSet) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len(s) > 1 {
+		size += len(s) - 1
+	}
+	for elem := range s {
+		if elem {
+			size += 4
+		} else {
+			size += 5
+		}
+	}
+	return size, nil
+}
+
+func (s BooleanSet) MarshalJSON() ([]byte, error) {
+	return s.AppendJSON(nil)
+}
+
+func (s *BooleanSet) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v BooleanSet
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*s = v
+			return nil
+		}
+	}
+	var elems []bool
+	if err := safejson.Unmarshal(data, &elems); err != nil {
+		return err
+	}
+	*s = NewBooleanSet(elems...)
+	return nil
+}
+
+func (s *BooleanSet) unmarshalJSONValue(value gjson.Result) bool {
+	var elems []bool
+	if value.Type != gjson.Null {
+		if !value.IsArray() {
+			return false
+		}
+		elems = make([]bool, 0)
+		ok := true
+		value.ForEach(func(_, elem gjson.Result) bool {
+			var v bool
+			if !unmarshalJSONBool(elem, &v) {
+				ok = false
+				return false
+			}
+			elems = append(elems, v)
+			return true
+		})
+		if !ok {
+			return false
+		}
+	}
+	*s = NewBooleanSet(elems...)
+	return true
+}
+
+func (s *BooleanSet) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return s.decodeJSONStrict(value)
+}
+
+func (s *BooleanSet) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var elems []bool
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	elems = make([]bool, 0)
+	seen := make(map[bool]struct{})
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v bool
+		v, err = decodeJSONBool(elem)
+		if err != nil {
+			return false
+		}
+		if _, ok := seen[v]; ok {
+			err = fmt.Errorf("duplicate set element %s", elem.Raw)
+			return false
+		}
+		seen[v] = struct{}{}
+		elems = append(elems, v)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*s = NewBooleanSet(elems...)
+	return nil
+}
+
+func (s BooleanSet) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (s *BooleanSet) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&s)
+}
+
+// Equal returns true if the BooleanSet is equal to other according to the Conjure semantics of its values.
+func (s BooleanSet) Equal(other BooleanSet) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	for elem := range s {
+		if !other.Contains(elem) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the BooleanSet.
+func (s BooleanSet) Clone() BooleanSet {
+	if s == nil {
+		return nil
+	}
+	out := make(BooleanSet, len(s))
+	for elem := range s {
+		out[elem] = struct{}{}
+	}
+	return out
+}
+
+// Hash returns a hash of the BooleanSet. Values that are Equal have the same hash.
+func (s BooleanSet) Hash() uint64 {
+	var h uint64
+	for elem := range s {
+		var elemHash uint64
+		elemHash = hashMix(elemHash, hashBool(elem))
+		h += elemHash
+	}
+	return h
+}
+
+// UUIDSet is a set of uuid values. The zero value is an empty set to which elements can be added.
+type UUIDSet map[uuid.UUID]struct{}
+
+// NewUUIDSet returns a UUIDSet that contains the provided elements.
+func NewUUIDSet(elems ...uuid.UUID) UUIDSet {
+	s := make(UUIDSet, len(elems))
+	s.Add(elems...)
+	return s
+}
+
+// Add adds the provided elements to the set. Adding elements to a nil set initializes it.
+func (s *UUIDSet) Add(elems ...uuid.UUID) {
+	if *s == nil {
+		*s = make(UUIDSet, len(elems))
+	}
+	for _, elem := range elems {
+		(*s)[elem] = struct{}{}
+	}
+}
+
+// Contains returns true if the set contains elem.
+func (s UUIDSet) Contains(elem uuid.UUID) bool {
+	_, ok := s[elem]
+	return ok
+}
+
+// Remove removes the provided elements from the set.
+func (s UUIDSet) Remove(elems ...uuid.UUID) {
+	for _, elem := range elems {
+		delete(s, elem)
+	}
+}
+
+// Sorted returns the elements of the set in ascending order.
+func (s UUIDSet) Sorted() []uuid.UUID {
+	elems := make([]uuid.UUID, 0, len(s))
+	for elem := range s {
+		elems = append(elems, elem)
+	}
+	sort.Slice(elems, func(i, j int) bool {
+		return bytes.Compare(elems[i][:], elems[j][:]) < 0
+	})
+	return elems
+}
+
+func (s UUIDSet) AppendJSON(out []byte) ([]byte, error) {
+	elems := s.Sorted()
+	out = append(out, '[')
+	for i, v := range elems {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONUUID(out, v)
+	}
+	out = append(out, ']')
+	return out, nil
+}
+
+func (s UUIDSet) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len(s) > 0 {
+		size += len(s)*39 - 1
+	}
+	return size, nil
+}
+
+func (s UUIDSet) MarshalJSON() ([]byte, error) {
+	return s.AppendJSON(nil)
+}
+
+func (s *UUIDSet) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v UUIDSet
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*s = v
+			return nil
+		}
+	}
+	var elems []uuid.UUID
+	if err := safejson.Unmarshal(data, &elems); err != nil {
+		return err
+	}
+	*s = NewUUIDSet(elems...)
+	return nil
+}
+
+func (s *UUIDSet) unmarshalJSONValue(value gjson.Result) bool {
+	var elems []uuid.UUID
+	if value.Type != gjson.Null {
+		if !value.IsArray() {
+			return false
+		}
+		elems = make([]uuid.UUID, 0)
+		ok := true
+		value.ForEach(func(_, elem gjson.Result) bool {
+			var v uuid.UUID
+			if elem.Type != gjson.Null {
+				var s string
+				if !unmarshalJSONString(elem, &s) || v.UnmarshalText([]byte(s)) != nil {
+					ok = false
+					return false
+				}
+			}
+			elems = append(elems, v)
+			return true
+		})
+		if !ok {
+			return false
+		}
+	}
+	*s = NewUUIDSet(elems...)
+	return true
+}
+
+func (s *UUIDSet) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return s.decodeJSONStrict(value)
+}
+
+func (s *UUIDSet) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var elems []uuid.UUID
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	elems = make([]uuid.UUID, 0)
+	seen := make(map[uuid.UUID]struct{})
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v uuid.UUID
+		v, err = decodeJSONUUID(elem)
+		if err != nil {
+			return false
+		}
+		if _, ok := seen[v]; ok {
+			err = fmt.Errorf("duplicate set element %s", elem.Raw)
+			return false
+		}
+		seen[v] = struct{}{}
+		elems = append(elems, v)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*s = NewUUIDSet(elems...)
+	return nil
+}
+
+func (s UUIDSet) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (s *UUIDSet) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&s)
+}
+
+// Equal returns true if the UUIDSet is equal to other according to the Conjure semantics of its values.
+func (s UUIDSet) Equal(other UUIDSet) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	for elem := range s {
+		if !other.Contains(elem) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the UUIDSet.
+func (s UUIDSet) Clone() UUIDSet {
+	if s == nil {
+		return nil
+	}
+	out := make(UUIDSet, len(s))
+	for elem := range s {
+		out[elem] = struct{}{}
+	}
+	return out
+}
+
+// Hash returns a hash of the UUIDSet. Values that are Equal have the same hash.
+func (s UUIDSet) Hash() uint64 {
+	var h uint64
+	for elem := range s {
+		var elemHash uint64
+		elemHash = hashMix(elemHash, hashBytes(elem[:]))
+		h += elemHash
+	}
+	return h
+}
+
+// RIDSet is a set of rid values. The zero value is an empty set to which elements can be added.
+type RIDSet map[rid.ResourceIdentifier]struct{}
+
+// NewRIDSet returns a RIDSet that contains the provided elements.
+func NewRIDSet(elems ...rid.ResourceIdentifier) RIDSet {
+	s := make(RIDSet, len(elems))
+	s.Add(elems...)
+	return s
+}
+
+// Add adds the provided elements to the set. Adding elements to a nil set initializes it.
+func (s *RIDSet) Add(elems ...rid.ResourceIdentifier) {
+	if *s == nil {
+		*s = make(RIDSet, len(elems))
+	}
+	for _, elem := range elems {
+		(*s)[elem] = struct{}{}
+	}
+}
+
+// Contains returns true if the set contains elem.
+func (s RIDSet) Contains(elem rid.ResourceIdentifier) bool {
+	_, ok := s[elem]
+	return ok
+}
+
+// Remove removes the provided elements from the set.
+func (s RIDSet) Remove(elems ...rid.ResourceIdentifier) {
+	for _, elem := range elems {
+		delete(s, elem)
+	}
+}
+
+// Sorted returns the elements of the set in ascending order.
+func (s RIDSet) Sorted() []rid.ResourceIdentifier {
+	elems := make([]rid.ResourceIdentifier, 0, len(s))
+	for elem := range s {
+		elems = append(elems, elem)
+	}
+	sort.Slice(elems, func(i, j int) bool {
+		return elems[i].String() < elems[j].String()
+	})
+	return elems
+}
+
+func (s RIDSet) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	elems := s.Sorted()
+	out = append(out, '[')
+	for i, v := range elems {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = appendJSONText(out, v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	return out, nil
+}
+
+func (s RIDSet) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	size += 2
+	if len(s) > 1 {
+		size += len(s) - 1
+	}
+	for elem := range s {
+		n, err = jsonTextSize(elem)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (s RIDSet) MarshalJSON() ([]byte, error) {
+	return s.AppendJSON(nil)
+}
+
+func (s *RIDSet) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v RIDSet
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*s = v
+			return nil
+		}
+	}
+	var elems []rid.ResourceIdentifier
+	if err := safejson.Unmarshal(data, &elems); err != nil {
+		return err
+	}
+	*s = NewRIDSet(elems...)
+	return nil
+}
+
+func (s *RIDSet) unmarshalJSONValue(value gjson.Result) bool {
+	var elems []rid.ResourceIdentifier
+	if value.Type != gjson.Null {
+		if !value.IsArray() {
+			return false
+		}
+		elems = make([]rid.ResourceIdentifier, 0)
+		ok := true
+		value.ForEach(func(_, elem gjson.Result) bool {
+			var v rid.ResourceIdentifier
+			if elem.Type != gjson.Null {
+				var s string
+				if !unmarshalJSONString(elem, &s) || v.UnmarshalText([]byte(s)) != nil {
+					ok = false
+					return false
+				}
+			}
+			elems = append(elems, v)
+			return true
+		})
+		if !ok {
+			return false
+		}
+	}
+	*s = NewRIDSet(elems...)
+	return true
+}
+
+func (s *RIDSet) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return s.decodeJSONStrict(value)
+}
+
+func (s *RIDSet) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var elems []rid.ResourceIdentifier
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	elems = make([]rid.ResourceIdentifier, 0)
+	seen := make(map[rid.ResourceIdentifier]struct{})
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v rid.ResourceIdentifier
+		v, err = decodeJSONRID(elem)
+		if err != nil {
+			return false
+		}
+		if _, ok := seen[v]; ok {
+			err = fmt.Errorf("duplicate set element %s", elem.Raw)
+			return false
+		}
+		seen[v] = struct{}{}
+		elems = append(elems, v)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*s = NewRIDSet(elems...)
+	return nil
+}
+
+func (s RIDSet) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (s *RIDSet) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&s)
+}
+
+// Equal returns true if the RIDSet is equal to other according to the Conjure semantics of its values.
+func (s RIDSet) Equal(other RIDSet) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	for elem := range s {
+		if !other.Contains(elem) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the RIDSet.
+func (s RIDSet) Clone() RIDSet {
+	if s == nil {
+		return nil
+	}
+	out := make(RIDSet, len(s))
+	for elem := range s {
+		out[elem] = struct{}{}
+	}
+	return out
+}
+
+// Hash returns a hash of the RIDSet. Values that are Equal have the same hash.
+func (s RIDSet) Hash() uint64 {
+	var h uint64
+	for elem := range s {
+		var elemHash uint64
+		elemHash = hashMix(elemHash, hashString(elem.String()))
+		h += elemHash
+	}
+	return h
+}
+
+// ColorSet is a set of Color values. The zero value is an empty set to which elements can be added.
+type ColorSet map[Color]struct{}
+
+// NewColorSet returns a ColorSet that contains the provided elements.
+func NewColorSet(elems ...Color) ColorSet {
+	s := make(ColorSet, len(elems))
+	s.Add(elems...)
+	return s
+}
+
+// Add adds the provided elements to the set. Adding elements to a nil set initializes it.
+func (s *ColorSet) Add(elems ...Color) {
+	if *s == nil {
+		*s = make(ColorSet, len(elems))
+	}
+	for _, elem := range elems {
+		(*s)[elem] = struct{}{}
+	}
+}
+
+// Contains returns true if the set contains elem.
+func (s ColorSet) Contains(elem Color) bool {
+	_, ok := s[elem]
+	return ok
+}
+
+// Remove removes the provided elements from the set.
+func (s ColorSet) Remove(elems ...Color) {
+	for _, elem := range elems {
+		delete(s, elem)
+	}
+}
+
+// Sorted returns the elements of the set in ascending order.
+func (s ColorSet) Sorted() []Color {
+	elems := make([]Color, 0, len(s))
+	for elem := range s {
+		elems = append(elems, elem)
+	}
+	sort.Slice(elems, func(i, j int) bool {
+		return elems[i].String() < elems[j].String()
+	})
+	return elems
+}
+
+func (s ColorSet) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	elems := s.Sorted()
+	out = append(out, '[')
+	for i, v := range elems {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	return out, nil
+}
+
+func (s ColorSet) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	size += 2
+	if len(s) > 1 {
+		size += len(s) - 1
+	}
+	for elem := range s {
+		n, err = elem.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (s ColorSet) MarshalJSON() ([]byte, error) {
+	return s.AppendJSON(nil)
+}
+
+func (s *ColorSet) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v ColorSet
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*s = v
+			return nil
+		}
+	}
+	var elems []Color
+	if err := safejson.Unmarshal(data, &elems); err != nil {
+		return err
+	}
+	*s = NewColorSet(elems...)
+	return nil
+}
+
+func (s *ColorSet) unmarshalJSONValue(value gjson.Result) bool {
+	var elems []Color
+	if value.Type != gjson.Null {
+		if !value.IsArray() {
+			return false
+		}
+		elems = make([]Color, 0)
+		ok := true
+		value.ForEach(func(_, elem gjson.Result) bool {
+			var v Color
+			if elem.Type != gjson.Null {
+				var s string
+				if !unmarshalJSONString(elem, &s) || v.UnmarshalText([]byte(s)) != nil {
+					ok = false
+					return false
+				}
+			}
+			elems = append(elems, v)
+			return true
+		})
+		if !ok {
+			return false
+		}
+	}
+	*s = NewColorSet(elems...)
+	return true
+}
+
+func (s *ColorSet) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return s.decodeJSONStrict(value)
+}
+
+func (s *ColorSet) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var elems []Color
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	elems = make([]Color, 0)
+	seen := make(map[Color]struct{})
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v Color
+		err = v.decodeJSONStrict(elem)
+		if err != nil {
+			return false
+		}
+		if _, ok := seen[v]; ok {
+			err = fmt.Errorf("duplicate set element %s", elem.Raw)
+			return false
+		}
+		seen[v] = struct{}{}
+		elems = append(elems, v)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*s = NewColorSet(elems...)
+	return nil
+}
+
+func (s ColorSet) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (s *ColorSet) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&s)
+}
+
+// Equal returns true if the ColorSet is equal to other according to the Conjure semantics of its values.
+func (s ColorSet) Equal(other ColorSet) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	for elem := range s {
+		if !other.Contains(elem) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the ColorSet.
+func (s ColorSet) Clone() ColorSet {
+	if s == nil {
+		return nil
+	}
+	out := make(ColorSet, len(s))
+	for elem := range s {
+		out[elem] = struct{}{}
+	}
+	return out
+}
+
+// Hash returns a hash of the ColorSet. Values that are Equal have the same hash.
+func (s ColorSet) Hash() uint64 {
+	var h uint64
+	for elem := range s {
+		var elemHash uint64
+		elemHash = hashMix(elemHash, elem.Hash())
+		h += elemHash
+	}
+	return h
+}
+
+// NameSet is a set of Name (string) values. The zero value is an empty set to which elements can be added.
+type NameSet map[Name]struct{}
+
+// NewNameSet returns a NameSet that contains the provided elements.
+func NewNameSet(elems ...Name) NameSet {
+	s := make(NameSet, len(elems))
+	s.Add(elems...)
+	return s
+}
+
+// Add adds the provided elements to the set. Adding elements to a nil set initializes it.
+func (s *NameSet) Add(elems ...Name) {
+	if *s == nil {
+		*s = make(NameSet, len(elems))
+	}
+	for _, elem := range elems {
+		(*s)[elem] = struct{}{}
+	}
+}
+
+// Contains returns true if the set contains elem.
+func (s NameSet) Contains(elem Name) bool {
+	_, ok := s[elem]
+	return ok
+}
+
+// Remove removes the provided elements from the set.
+func (s NameSet) Remove(elems ...Name) {
+	for _, elem := range elems {
+		delete(s, elem)
+	}
+}
+
+// Sorted returns the elements of the set in ascending order.
+func (s NameSet) Sorted() []Name {
+	elems := make([]Name, 0, len(s))
+	for elem := range s {
+		elems = append(elems, elem)
+	}
+	sort.Slice(elems, func(i, j int) bool {
+		return elems[i] < elems[j]
+	})
+	return elems
+}
+
+func (s NameSet) AppendJSON(out []byte) ([]byte, error) {
+	elems := s.Sorted()
+	out = append(out, '[')
+	for i, v := range elems {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONString(out, string(v))
+	}
+	out = append(out, ']')
+	return out, nil
+}
+
+func (s NameSet) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len(s) > 1 {
+		size += len(s) - 1
+	}
+	for elem := range s {
+		size += jsonStringSize(string(elem))
+	}
+	return size, nil
+}
+
+func (s NameSet) MarshalJSON() ([]byte, error) {
+	return s.AppendJSON(nil)
+}
+
+func (s *NameSet) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v NameSet
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*s = v
+			return nil
+		}
+	}
+	var elems []Name
+	if err := safejson.Unmarshal(data, &elems); err != nil {
+		return err
+	}
+	*s = NewNameSet(elems...)
+	return nil
+}
+
+func (s *NameSet) unmarshalJSONValue(value gjson.Result) bool {
+	var elems []Name
+	if value.Type != gjson.Null {
+		if !value.IsArray() {
+			return false
+		}
+		elems = make([]Name, 0)
+		ok := true
+		value.ForEach(func(_, elem gjson.Result) bool {
+			var v Name
+			var v1 string
+			if !unmarshalJSONString(elem, &v1) {
+				ok = false
+				return false
+			}
+			v = Name(v1)
+			elems = append(elems, v)
+			return true
+		})
+		if !ok {
+			return false
+		}
+	}
+	*s = NewNameSet(elems...)
+	return true
+}
+
+func (s *NameSet) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return s.decodeJSONStrict(value)
+}
+
+func (s *NameSet) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var elems []Name
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	elems = make([]Name, 0)
+	seen := make(map[Name]struct{})
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v Name
+		err = v.decodeJSONStrict(elem)
+		if err != nil {
+			return false
+		}
+		if _, ok := seen[v]; ok {
+			err = fmt.Errorf("duplicate set element %s", elem.Raw)
+			return false
+		}
+		seen[v] = struct{}{}
+		elems = append(elems, v)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*s = NewNameSet(elems...)
+	return nil
+}
+
+func (s NameSet) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (s *NameSet) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&s)
+}
+
+// Equal returns true if the NameSet is equal to other according to the Conjure semantics of its values.
+func (s NameSet) Equal(other NameSet) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	for elem := range s {
+		if !other.Contains(elem) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the NameSet.
+func (s NameSet) Clone() NameSet {
+	if s == nil {
+		return nil
+	}
+	out := make(NameSet, len(s))
+	for elem := range s {
+		out[elem] = struct{}{}
+	}
+	return out
+}
+
+// Hash returns a hash of the NameSet. Values that are Equal have the same hash.
+func (s NameSet) Hash() uint64 {
+	var h uint64
+	for elem := range s {
+		var elemHash uint64
+		elemHash = hashMix(elemHash, elem.Hash())
+		h += elemHash
+	}
+	return h
+}
+
+// SafeLongSet is a set of safelong values. The zero value is an empty set to which elements can be added.
+type SafeLongSet map[safelong.SafeLong]struct{}
+
+// NewSafeLongSet returns a SafeLongSet that contains the provided elements.
+func NewSafeLongSet(elems ...safelong.SafeLong) SafeLongSet {
+	s := make(SafeLongSet, len(elems))
+	s.Add(elems...)
+	return s
+}
+
+// Add adds the provided elements to the set. Adding elements to a nil set initializes it.
+func (s *SafeLongSet) Add(elems ...safelong.SafeLong) {
+	if *s == nil {
+		*s = make(SafeLongSet, len(elems))
+	}
+	for _, elem := range elems {
+		(*s)[elem] = struct{}{}
+	}
+}
+
+// Contains returns true if the set contains elem.
+func (s SafeLongSet) Contains(elem safelong.SafeLong) bool {
+	_, ok := s[elem]
+	return ok
+}
+
+// Remove removes the provided elements from the set.
+func (s SafeLongSet) Remove(elems ...safelong.SafeLong) {
+	for _, elem := range elems {
+		delete(s, elem)
+	}
+}
+
+// Sorted returns the elements of the set in ascending order.
+func (s SafeLongSet) Sorted() []safelong.SafeLong {
+	elems := make([]safelong.SafeLong, 0, len(s))
+	for elem := range s {
+		elems = append(elems, elem)
+	}
+	sort.Slice(elems, func(i, j int) bool {
+		return elems[i] < elems[j]
+	})
+	return elems
+}
+
+func (s SafeLongSet) AppendJSON(out []byte) ([]byte, error) {
+	elems := s.Sorted()
+	out = append(out, '[')
+	for i, v := range elems {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		if _, err := safelong.NewSafeLong(int64(v)); err != nil {
+			return nil, err
+		}
+		out = strconv.AppendInt(out, int64(v), 10)
+	}
+	out = append(out, ']')
+	return out, nil
+}
+
+func (s SafeLongSet) JSONSize() (int, error) {
+	var size int
+	size += 2
+	if len(s) > 1 {
+		size += len(s) - 1
+	}
+	for elem := range s {
+		if _, err := safelong.NewSafeLong(int64(elem)); err != nil {
+			return 0, err
+		}
+		size += jsonIntSize(int64(elem))
+	}
+	return size, nil
+}
+
+func (s SafeLongSet) MarshalJSON() ([]byte, error) {
+	return s.AppendJSON(nil)
+}
+
+func (s *SafeLongSet) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v SafeLongSet
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*s = v
+			return nil
+		}
+	}
+	var elems []safelong.SafeLong
+	if err := safejson.Unmarshal(data, &elems); err != nil {
+		return err
+	}
+	*s = NewSafeLongSet(elems...)
+	return nil
+}
+
+func (s *SafeLongSet) unmarshalJSONValue(value gjson.Result) bool {
+	var elems []safelong.SafeLong
+	if value.Type != gjson.Null {
+		if !value.IsArray() {
+			return false
+		}
+		elems = make([]safelong.SafeLong, 0)
+		ok := true
+		value.ForEach(func(_, elem gjson.Result) bool {
+			var v safelong.SafeLong
+			if !unmarshalJSONSafeLong(elem, &v) {
+				ok = false
+				return false
+			}
+			elems = append(elems, v)
+			return true
+		})
+		if !ok {
+			return false
+		}
+	}
+	*s = NewSafeLongSet(elems...)
+	return true
+}
+
+func (s *SafeLongSet) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return s.decodeJSONStrict(value)
+}
+
+func (s *SafeLongSet) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var elems []safelong.SafeLong
+	if !value.IsArray() {
+		return jsonTypeError(value, "array")
+	}
+	elems = make([]safelong.SafeLong, 0)
+	seen := make(map[safelong.SafeLong]struct{})
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v safelong.SafeLong
+		v, err = decodeJSONSafeLong(elem)
+		if err != nil {
+			return false
+		}
+		if _, ok := seen[v]; ok {
+			err = fmt.Errorf("duplicate set element %s", elem.Raw)
+			return false
+		}
+		seen[v] = struct{}{}
+		elems = append(elems, v)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	*s = NewSafeLongSet(elems...)
+	return nil
+}
+
+func (s SafeLongSet) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (s *SafeLongSet) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&s)
+}
+
+// Equal returns true if the SafeLongSet is equal to other according to the Conjure semantics of its values.
+func (s SafeLongSet) Equal(other SafeLongSet) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	for elem := range s {
+		if !other.Contains(elem) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the SafeLongSet.
+func (s SafeLongSet) Clone() SafeLongSet {
+	if s == nil {
+		return nil
+	}
+	out := make(SafeLongSet, len(s))
+	for elem := range s {
+		out[elem] = struct{}{}
+	}
+	return out
+}
+
+// Hash returns a hash of the SafeLongSet. Values that are Equal have the same hash.
+func (s SafeLongSet) Hash() uint64 {
+	var h uint64
+	for elem := range s {
+		var elemHash uint64
+		elemHash = hashMix(elemHash, uint64(elem))
+		h += elemHash
+	}
+	return h
+}
@@ -0,0 +1,834 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
+)
+
+type Record struct {
+	Strings         StringSet     `json:"strings"`
+	Numbers         IntegerSet    `json:"numbers"`
+	Flags           BooleanSet    `json:"flags"`
+	Ids             UUIDSet       `json:"ids"`
+	Rids            RIDSet        `json:"rids"`
+	Colors          ColorSet      `json:"colors"`
+	Names           NameSet       `json:"names"`
+	Tags            Tags          `json:"tags"`
+	Ratios          []float64     `json:"ratios"`
+	OptionalStrings *StringSet    `json:"optionalStrings"`
+	Nested          []SafeLongSet `json:"nested"`
+}
+
+func (o Record) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	out = append(out, "{\"strings\":"...)
+	out, err = o.Strings.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"numbers\":"...)
+	out, err = o.Numbers.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"flags\":"...)
+	out, err = o.Flags.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"ids\":"...)
+	out, err = o.Ids.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"rids\":"...)
+	out, err = o.Rids.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"colors\":"...)
+	out, err = o.Colors.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"names\":"...)
+	out, err = o.Names.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"tags\":"...)
+	out, err = o.Tags.AppendJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, ",\"ratios\":"...)
+	out = append(out, '[')
+	for i, v := range o.Ratios {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out = appendJSONFloat64(out, v)
+	}
+	out = append(out, ']')
+	out = append(out, ",\"optionalStrings\":"...)
+	if o.OptionalStrings == nil {
+		out = append(out, "null"...)
+	} else {
+		out, err = o.OptionalStrings.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ",\"nested\":"...)
+	out = append(out, '[')
+	for i, v := range o.Nested {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		out, err = v.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, ']')
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Record) JSONSize() (int, error) {
+	var n int
+	var err error
+	size := 113
+	n, err = o.Strings.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.Numbers.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.Flags.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.Ids.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.Rids.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.Colors.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.Names.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	n, err = o.Tags.JSONSize()
+	if err != nil {
+		return 0, err
+	}
+	size += n
+	size += 2
+	if len(o.Ratios) > 1 {
+		size += len(o.Ratios) - 1
+	}
+	for _, v := range o.Ratios {
+		size += jsonFloat64Size(v)
+	}
+	if o.OptionalStrings == nil {
+		size += 4
+	} else {
+		n, err = o.OptionalStrings.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	size += 2
+	if len(o.Nested) > 1 {
+		size += len(o.Nested) - 1
+	}
+	for _, v := range o.Nested {
+		n, err = v.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (o Record) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
+}
+
+func (o *Record) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Record
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*o = v
+			return nil
+		}
+	}
+	type RecordAlias Record
+	var rawRecord RecordAlias
+	if err := safejson.Unmarshal(data, &rawRecord); err != nil {
+		return err
+	}
+	if rawRecord.Strings == nil {
+		rawRecord.Strings = make(StringSet, 0)
+	}
+	if rawRecord.Numbers == nil {
+		rawRecord.Numbers = make(IntegerSet, 0)
+	}
+	if rawRecord.Flags == nil {
+		rawRecord.Flags = make(BooleanSet, 0)
+	}
+	if rawRecord.Ids == nil {
+		rawRecord.Ids = make(UUIDSet, 0)
+	}
+	if rawRecord.Rids == nil {
+		rawRecord.Rids = make(RIDSet, 0)
+	}
+	if rawRecord.Colors == nil {
+		rawRecord.Colors = make(ColorSet, 0)
+	}
+	if rawRecord.Names == nil {
+		rawRecord.Names = make(NameSet, 0)
+	}
+	if rawRecord.Tags == nil {
+		rawRecord.Tags = Tags(make(StringSet, 0))
+	}
+	if rawRecord.Ratios == nil {
+		rawRecord.Ratios = make([]float64, 0)
+	}
+	if rawRecord.Nested == nil {
+		rawRecord.Nested = make([]SafeLongSet, 0)
+	}
+	*o = Record(rawRecord)
+	return nil
+}
+
+func (o *Record) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		if o.Strings == nil {
+			o.Strings = make(StringSet, 0)
+		}
+		if o.Numbers == nil {
+			o.Numbers = make(IntegerSet, 0)
+		}
+		if o.Flags == nil {
+			o.Flags = make(BooleanSet, 0)
+		}
+		if o.Ids == nil {
+			o.Ids = make(UUIDSet, 0)
+		}
+		if o.Rids == nil {
+			o.Rids = make(RIDSet, 0)
+		}
+		if o.Colors == nil {
+			o.Colors = make(ColorSet, 0)
+		}
+		if o.Names == nil {
+			o.Names = make(NameSet, 0)
+		}
+		if o.Tags == nil {
+			o.Tags = Tags(make(StringSet, 0))
+		}
+		if o.Ratios == nil {
+			o.Ratios = make([]float64, 0)
+		}
+		if o.Nested == nil {
+			o.Nested = make([]SafeLongSet, 0)
+		}
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenStrings, seenNumbers, seenFlags, seenIds, seenRids, seenColors, seenNames, seenTags, seenRatios, seenOptionalStrings, seenNested bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "strings":
+			if seenStrings {
+				ok = false
+				return false
+			}
+			seenStrings = true
+			if !o.Strings.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "numbers":
+			if seenNumbers {
+				ok = false
+				return false
+			}
+			seenNumbers = true
+			if !o.Numbers.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "flags":
+			if seenFlags {
+				ok = false
+				return false
+			}
+			seenFlags = true
+			if !o.Flags.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "ids":
+			if seenIds {
+				ok = false
+				return false
+			}
+			seenIds = true
+			if !o.Ids.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "rids":
+			if seenRids {
+				ok = false
+				return false
+			}
+			seenRids = true
+			if !o.Rids.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "colors":
+			if seenColors {
+				ok = false
+				return false
+			}
+			seenColors = true
+			if !o.Colors.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "names":
+			if seenNames {
+				ok = false
+				return false
+			}
+			seenNames = true
+			if !o.Names.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+		case "tags":
+			if seenTags {
+				ok = false
+				return false
+			}
+			seenTags = true
+			var v StringSet
+			if !v.unmarshalJSONValue(field) {
+				ok = false
+				return false
+			}
+			o.Tags = Tags(v)
+		case "ratios":
+			if seenRatios {
+				ok = false
+				return false
+			}
+			seenRatios = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Ratios = make([]float64, 0)
+				ok1 := true
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v1 float64
+					if !unmarshalJSONFloat64(elem, &v1) {
+						ok1 = false
+						return false
+					}
+					o.Ratios = append(o.Ratios, v1)
+					return true
+				})
+				if !ok1 {
+					ok = false
+					return false
+				}
+			}
+		case "optionalStrings":
+			if seenOptionalStrings {
+				ok = false
+				return false
+			}
+			seenOptionalStrings = true
+			if field.Type != gjson.Null {
+				var v2 StringSet
+				if !v2.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				o.OptionalStrings = &v2
+			}
+		case "nested":
+			if seenNested {
+				ok = false
+				return false
+			}
+			seenNested = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					ok = false
+					return false
+				}
+				o.Nested = make([]SafeLongSet, 0)
+				ok2 := true
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v3 SafeLongSet
+					if !v3.unmarshalJSONValue(elem1) {
+						ok2 = false
+						return false
+					}
+					o.Nested = append(o.Nested, v3)
+					return true
+				})
+				if !ok2 {
+					ok = false
+					return false
+				}
+			}
+		default:
+			if matchesJSONField(key.Str, "strings", "numbers", "flags", "ids", "rids", "colors", "names", "tags", "ratios", "optionalStrings", "nested") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	if o.Strings == nil {
+		o.Strings = make(StringSet, 0)
+	}
+	if o.Numbers == nil {
+		o.Numbers = make(IntegerSet, 0)
+	}
+	if o.Flags == nil {
+		o.Flags = make(BooleanSet, 0)
+	}
+	if o.Ids == nil {
+		o.Ids = make(UUIDSet, 0)
+	}
+	if o.Rids == nil {
+		o.Rids = make(RIDSet, 0)
+	}
+	if o.Colors == nil {
+		o.Colors = make(ColorSet, 0)
+	}
+	if o.Names == nil {
+		o.Names = make(NameSet, 0)
+	}
+	if o.Tags == nil {
+		o.Tags = Tags(make(StringSet, 0))
+	}
+	if o.Ratios == nil {
+		o.Ratios = make([]float64, 0)
+	}
+	if o.Nested == nil {
+		o.Nested = make([]SafeLongSet, 0)
+	}
+	return true
+}
+
+func (o *Record) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Record) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Record{}
+	var seenStrings, seenNumbers, seenFlags, seenIds, seenRids, seenColors, seenNames, seenTags, seenRatios, seenOptionalStrings, seenNested bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "strings":
+			if seenStrings {
+				err = fmt.Errorf("duplicate field \"strings\"")
+				return false
+			}
+			seenStrings = true
+			if field.Type != gjson.Null {
+				err = o.Strings.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"strings\": %w", err)
+					return false
+				}
+			}
+		case "numbers":
+			if seenNumbers {
+				err = fmt.Errorf("duplicate field \"numbers\"")
+				return false
+			}
+			seenNumbers = true
+			if field.Type != gjson.Null {
+				err = o.Numbers.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"numbers\": %w", err)
+					return false
+				}
+			}
+		case "flags":
+			if seenFlags {
+				err = fmt.Errorf("duplicate field \"flags\"")
+				return false
+			}
+			seenFlags = true
+			if field.Type != gjson.Null {
+				err = o.Flags.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"flags\": %w", err)
+					return false
+				}
+			}
+		case "ids":
+			if seenIds {
+				err = fmt.Errorf("duplicate field \"ids\"")
+				return false
+			}
+			seenIds = true
+			if field.Type != gjson.Null {
+				err = o.Ids.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"ids\": %w", err)
+					return false
+				}
+			}
+		case "rids":
+			if seenRids {
+				err = fmt.Errorf("duplicate field \"rids\"")
+				return false
+			}
+			seenRids = true
+			if field.Type != gjson.Null {
+				err = o.Rids.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"rids\": %w", err)
+					return false
+				}
+			}
+		case "colors":
+			if seenColors {
+				err = fmt.Errorf("duplicate field \"colors\"")
+				return false
+			}
+			seenColors = true
+			if field.Type != gjson.Null {
+				err = o.Colors.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"colors\": %w", err)
+					return false
+				}
+			}
+		case "names":
+			if seenNames {
+				err = fmt.Errorf("duplicate field \"names\"")
+				return false
+			}
+			seenNames = true
+			if field.Type != gjson.Null {
+				err = o.Names.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"names\": %w", err)
+					return false
+				}
+			}
+		case "tags":
+			if seenTags {
+				err = fmt.Errorf("duplicate field \"tags\"")
+				return false
+			}
+			seenTags = true
+			if field.Type != gjson.Null {
+				err = o.Tags.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"tags\": %w", err)
+					return false
+				}
+			}
+		case "ratios":
+			if seenRatios {
+				err = fmt.Errorf("duplicate field \"ratios\"")
+				return false
+			}
+			seenRatios = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"ratios\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Ratios = make([]float64, 0)
+				seen := make(map[float64]struct{})
+				field.ForEach(func(_, elem gjson.Result) bool {
+					var v float64
+					v, err = decodeJSONFloat64(elem)
+					if err != nil {
+						return false
+					}
+					if _, ok := seen[v]; ok {
+						err = fmt.Errorf("duplicate set element %s", elem.Raw)
+						return false
+					}
+					seen[v] = struct{}{}
+					o.Ratios = append(o.Ratios, v)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"ratios\": %w", err)
+					return false
+				}
+			}
+		case "optionalStrings":
+			if seenOptionalStrings {
+				err = fmt.Errorf("duplicate field \"optionalStrings\"")
+				return false
+			}
+			seenOptionalStrings = true
+			if field.Type != gjson.Null {
+				var v1 StringSet
+				err = v1.decodeJSONStrict(field)
+				if err != nil {
+					err = fmt.Errorf("field \"optionalStrings\": %w", err)
+					return false
+				}
+				o.OptionalStrings = &v1
+			}
+		case "nested":
+			if seenNested {
+				err = fmt.Errorf("duplicate field \"nested\"")
+				return false
+			}
+			seenNested = true
+			if field.Type != gjson.Null {
+				if !field.IsArray() {
+					err = fmt.Errorf("field \"nested\": %w", jsonTypeError(field, "array"))
+					return false
+				}
+				o.Nested = make([]SafeLongSet, 0)
+				field.ForEach(func(_, elem1 gjson.Result) bool {
+					var v2 SafeLongSet
+					err = v2.decodeJSONStrict(elem1)
+					if err != nil {
+						return false
+					}
+					o.Nested = append(o.Nested, v2)
+					return true
+				})
+				if err != nil {
+					err = fmt.Errorf("field \"nested\": %w", err)
+					return false
+				}
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if o.Strings == nil {
+		o.Strings = make(StringSet, 0)
+	}
+	if o.Numbers == nil {
+		o.Numbers = make(IntegerSet, 0)
+	}
+	if o.Flags == nil {
+		o.Flags = make(BooleanSet, 0)
+	}
+	if o.Ids == nil {
+		o.Ids = make(UUIDSet, 0)
+	}
+	if o.Rids == nil {
+		o.Rids = make(RIDSet, 0)
+	}
+	if o.Colors == nil {
+		o.Colors = make(ColorSet, 0)
+	}
+	if o.Names == nil {
+		o.Names = make(NameSet, 0)
+	}
+	if o.Tags == nil {
+		o.Tags = Tags(make(StringSet, 0))
+	}
+	if o.Ratios == nil {
+		o.Ratios = make([]float64, 0)
+	}
+	if o.Nested == nil {
+		o.Nested = make([]SafeLongSet, 0)
+	}
+	return nil
+}
+
+func (o Record) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *Record) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the Record is equal to other according to the Conjure semantics of its values.
+func (o Record) Equal(other Record) bool {
+	if !o.Strings.Equal(other.Strings) {
+		return false
+	}
+	if !o.Numbers.Equal(other.Numbers) {
+		return false
+	}
+	if !o.Flags.Equal(other.Flags) {
+		return false
+	}
+	if !o.Ids.Equal(other.Ids) {
+		return false
+	}
+	if !o.Rids.Equal(other.Rids) {
+		return false
+	}
+	if !o.Colors.Equal(other.Colors) {
+		return false
+	}
+	if !o.Names.Equal(other.Names) {
+		return false
+	}
+	if !o.Tags.Equal(other.Tags) {
+		return false
+	}
+	if !func() bool {
+		if len(o.Ratios) != len(other.Ratios) {
+			return false
+		}
+		matched := make([]bool, len(other.Ratios))
+		for _, v := range o.Ratios {
+			found := false
+			for j, otherV := range other.Ratios {
+				if !matched[j] && (v == otherV || math.IsNaN(v) && math.IsNaN(otherV)) {
+					matched[j] = true
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}() {
+		return false
+	}
+	if (o.OptionalStrings == nil) != (other.OptionalStrings == nil) {
+		return false
+	}
+	if o.OptionalStrings != nil {
+		if !(*o.OptionalStrings).Equal(*other.OptionalStrings) {
+			return false
+		}
+	}
+	if len(o.Nested) != len(other.Nested) {
+		return false
+	}
+	for i := range o.Nested {
+		if !o.Nested[i].Equal(other.Nested[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Record.
+func (o Record) Clone() Record {
+	out := o
+	out.Strings = o.Strings.Clone()
+	out.Numbers = o.Numbers.Clone()
+	out.Flags = o.Flags.Clone()
+	out.Ids = o.Ids.Clone()
+	out.Rids = o.Rids.Clone()
+	out.Colors = o.Colors.Clone()
+	out.Names = o.Names.Clone()
+	out.Tags = o.Tags.Clone()
+	if o.Ratios != nil {
+		out.Ratios = make([]float64, len(o.Ratios))
+		copy(out.Ratios, o.Ratios)
+	}
+	if o.OptionalStrings != nil {
+		v := (*o.OptionalStrings).Clone()
+		out.OptionalStrings = &v
+	}
+	if o.Nested != nil {
+		out.Nested = make([]SafeLongSet, len(o.Nested))
+		for i := range o.Nested {
+			out.Nested[i] = o.Nested[i].Clone()
+		}
+	}
+	return out
+}
+
+// Hash returns a hash of the Record. Values that are Equal have the same hash.
+func (o Record) Hash() uint64 {
+	var h uint64
+	h = hashMix(h, o.Strings.Hash())
+	h = hashMix(h, o.Numbers.Hash())
+	h = hashMix(h, o.Flags.Hash())
+	h = hashMix(h, o.Ids.Hash())
+	h = hashMix(h, o.Rids.Hash())
+	h = hashMix(h, o.Colors.Hash())
+	h = hashMix(h, o.Names.Hash())
+	h = hashMix(h, o.Tags.Hash())
+	for _, v := range o.Ratios {
+		var elemHash uint64
+		elemHash = hashMix(elemHash, hashFloat64(v))
+		h += elemHash
+	}
+	if o.OptionalStrings != nil {
+		h = hashMix(h, (*o.OptionalStrings).Hash())
+	}
+	for _, v := range o.Nested {
+		h = hashMix(h, v.Hash())
+	}
+	return h
+}
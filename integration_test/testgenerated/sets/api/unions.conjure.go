@@ -0,0 +1,424 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
+)
+
+type Selection struct {
+	typ     string
+	strings *StringSet
+	colors  *ColorSet
+}
+
+type selectionDeserializer struct {
+	Type    string     `json:"type"`
+	Strings *StringSet `json:"strings"`
+	Colors  *ColorSet  `json:"colors"`
+}
+
+func (u *selectionDeserializer) toStruct() Selection {
+	return Selection{typ: u.Type, strings: u.Strings, colors: u.Colors}
+}
+
+func (u Selection) AppendJSON(out []byte) ([]byte, error) {
+	var err error
+	switch u.typ {
+	default:
+		return nil, fmt.Errorf("unknown type %q", u.typ)
+	case "strings":
+		if u.strings == nil {
+			return nil, fmt.Errorf("field \"strings\" is required")
+		}
+		out = append(out, "{\"type\":\"strings\",\"strings\":"...)
+		out, err = u.strings.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	case "colors":
+		if u.colors == nil {
+			return nil, fmt.Errorf("field \"colors\" is required")
+		}
+		out = append(out, "{\"type\":\"colors\",\"colors\":"...)
+		out, err = u.colors.AppendJSON(out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out = append(out, '}')
+	return out, nil
+}
+
+func (u Selection) JSONSize() (int, error) {
+	var n int
+	var err error
+	var size int
+	switch u.typ {
+	default:
+		return 0, fmt.Errorf("unknown type %q", u.typ)
+	case "strings":
+		if u.strings == nil {
+			return 0, fmt.Errorf("field \"strings\" is required")
+		}
+		size = 29
+		n, err = u.strings.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	case "colors":
+		if u.colors == nil {
+			return 0, fmt.Errorf("field \"colors\" is required")
+		}
+		size = 27
+		n, err = u.colors.JSONSize()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+func (u Selection) MarshalJSON() ([]byte, error) {
+	return u.AppendJSON(nil)
+}
+
+func (u *Selection) UnmarshalJSON(data []byte) error {
+	if gjson.ValidBytes(data) {
+		var v Selection
+		if v.unmarshalJSONValue(gjson.ParseBytes(data)) {
+			*u = v
+			return nil
+		}
+	}
+	var deser selectionDeserializer
+	if err := safejson.Unmarshal(data, &deser); err != nil {
+		return err
+	}
+	*u = deser.toStruct()
+	switch u.typ {
+	case "strings":
+		if u.strings == nil {
+			return fmt.Errorf("field \"strings\" is required")
+		}
+	case "colors":
+		if u.colors == nil {
+			return fmt.Errorf("field \"colors\" is required")
+		}
+	}
+	return nil
+}
+
+func (u *Selection) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenType, seenStrings, seenColors bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "type":
+			if seenType {
+				ok = false
+				return false
+			}
+			seenType = true
+			if !unmarshalJSONString(field, &u.typ) {
+				ok = false
+				return false
+			}
+		case "strings":
+			if seenStrings {
+				ok = false
+				return false
+			}
+			seenStrings = true
+			if field.Type != gjson.Null {
+				var v StringSet
+				if !v.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.strings = &v
+			}
+		case "colors":
+			if seenColors {
+				ok = false
+				return false
+			}
+			seenColors = true
+			if field.Type != gjson.Null {
+				var v1 ColorSet
+				if !v1.unmarshalJSONValue(field) {
+					ok = false
+					return false
+				}
+				u.colors = &v1
+			}
+		default:
+			if matchesJSONField(key.Str, "type", "strings", "colors") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	switch u.typ {
+	case "strings":
+		if u.strings == nil {
+			return false
+		}
+	case "colors":
+		if u.colors == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *Selection) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return u.decodeJSONStrict(value)
+}
+
+func (u *Selection) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	var typ string
+	var variantName string
+	var variant gjson.Result
+	var seenType, seenVariant bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch {
+		case key.Str == "type":
+			if seenType {
+				err = fmt.Errorf("duplicate field \"type\"")
+				return false
+			}
+			seenType = true
+			typ, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"type\": %w", err)
+				return false
+			}
+			return true
+		case seenVariant && key.Str == variantName:
+			err = fmt.Errorf("duplicate field %q", key.Str)
+			return false
+		case seenVariant:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		variantName, variant, seenVariant = key.Str, field, true
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenType {
+		return fmt.Errorf("field \"type\" is required")
+	}
+	if seenVariant && variantName != typ {
+		return fmt.Errorf("unknown field %q", variantName)
+	}
+	*u = Selection{typ: typ}
+	switch typ {
+	case "strings":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"strings\" is required")
+		}
+		var v StringSet
+		err = v.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"strings\": %w", err)
+		}
+		u.strings = &v
+	case "colors":
+		if variant.Type == gjson.Null {
+			return fmt.Errorf("field \"colors\" is required")
+		}
+		var v1 ColorSet
+		err = v1.decodeJSONStrict(variant)
+		if err != nil {
+			return fmt.Errorf("field \"colors\": %w", err)
+		}
+		u.colors = &v1
+	}
+	return nil
+}
+
+func (u Selection) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (u *Selection) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&u)
+}
+
+func (u *Selection) AcceptFuncs(stringsFunc func(StringSet) error, colorsFunc func(ColorSet) error, unknownFunc func(string) error) error {
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return fmt.Errorf("invalid value in union type")
+		}
+		return unknownFunc(u.typ)
+	case "strings":
+		if u.strings == nil {
+			return fmt.Errorf("field \"strings\" is required")
+		}
+		return stringsFunc(*u.strings)
+	case "colors":
+		if u.colors == nil {
+			return fmt.Errorf("field \"colors\" is required")
+		}
+		return colorsFunc(*u.colors)
+	}
+}
+
+func (u *Selection) StringsNoopSuccess(StringSet) error {
+	return nil
+}
+
+func (u *Selection) ColorsNoopSuccess(ColorSet) error {
+	return nil
+}
+
+func (u *Selection) ErrorOnUnknown(typeName string) error {
+	return fmt.Errorf("invalid value in union type. Type name: %s", typeName)
+}
+
+func (u *Selection) Accept(v SelectionVisitor) error {
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return fmt.Errorf("invalid value in union type")
+		}
+		return v.VisitUnknown(u.typ)
+	case "strings":
+		if u.strings == nil {
+			return fmt.Errorf("field \"strings\" is required")
+		}
+		return v.VisitStrings(*u.strings)
+	case "colors":
+		if u.colors == nil {
+			return fmt.Errorf("field \"colors\" is required")
+		}
+		return v.VisitColors(*u.colors)
+	}
+}
+
+type SelectionVisitor interface {
+	VisitStrings(v StringSet) error
+	VisitColors(v ColorSet) error
+	VisitUnknown(typeName string) error
+}
+
+func (u *Selection) AcceptWithContext(ctx context.Context, v SelectionVisitorWithContext) error {
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return fmt.Errorf("invalid value in union type")
+		}
+		return v.VisitUnknownWithContext(ctx, u.typ)
+	case "strings":
+		if u.strings == nil {
+			return fmt.Errorf("field \"strings\" is required")
+		}
+		return v.VisitStringsWithContext(ctx, *u.strings)
+	case "colors":
+		if u.colors == nil {
+			return fmt.Errorf("field \"colors\" is required")
+		}
+		return v.VisitColorsWithContext(ctx, *u.colors)
+	}
+}
+
+type SelectionVisitorWithContext interface {
+	VisitStringsWithContext(ctx context.Context, v StringSet) error
+	VisitColorsWithContext(ctx context.Context, v ColorSet) error
+	VisitUnknownWithContext(ctx context.Context, typeName string) error
+}
+
+func NewSelectionFromStrings(v StringSet) Selection {
+	return Selection{typ: "strings", strings: &v}
+}
+
+func NewSelectionFromColors(v ColorSet) Selection {
+	return Selection{typ: "colors", colors: &v}
+}
+
+// Equal returns true if the Selection is equal to other according to the Conjure semantics of its values.
+func (u Selection) Equal(other Selection) bool {
+	if u.typ != other.typ {
+		return false
+	}
+	if (u.strings == nil) != (other.strings == nil) {
+		return false
+	}
+	if u.strings != nil {
+		if !(*u.strings).Equal(*other.strings) {
+			return false
+		}
+	}
+	if (u.colors == nil) != (other.colors == nil) {
+		return false
+	}
+	if u.colors != nil {
+		if !(*u.colors).Equal(*other.colors) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Selection.
+func (u Selection) Clone() Selection {
+	out := u
+	if u.strings != nil {
+		v := (*u.strings).Clone()
+		out.strings = &v
+	}
+	if u.colors != nil {
+		v := (*u.colors).Clone()
+		out.colors = &v
+	}
+	return out
+}
+
+// Hash returns a hash of the Selection. Values that are Equal have the same hash.
+func (u Selection) Hash() uint64 {
+	h := hashString(u.typ)
+	if u.strings != nil {
+		h = hashMix(h, (*u.strings).Hash())
+	}
+	if u.colors != nil {
+		h = hashMix(h, (*u.colors).Hash())
+	}
+	return h
+}
@@ -0,0 +1,39 @@
+// This file was generated by Conjure and should not be manually edited.
+
+//go:build go1.18
+
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+type SelectionWithT[T any] Selection
+
+func (u *SelectionWithT[T]) Accept(ctx context.Context, v SelectionVisitorWithT[T]) (T, error) {
+	var result T
+	switch u.typ {
+	default:
+		if u.typ == "" {
+			return result, fmt.Errorf("invalid value in union type")
+		}
+		return v.VisitUnknown(ctx, u.typ)
+	case "strings":
+		if u.strings == nil {
+			return result, fmt.Errorf("field \"strings\" is required")
+		}
+		return v.VisitStrings(ctx, *u.strings)
+	case "colors":
+		if u.colors == nil {
+			return result, fmt.Errorf("field \"colors\" is required")
+		}
+		return v.VisitColors(ctx, *u.colors)
+	}
+}
+
+type SelectionVisitorWithT[T any] interface {
+	VisitStrings(ctx context.Context, v StringSet) (T, error)
+	VisitColors(ctx context.Context, v ColorSet) (T, error)
+	VisitUnknown(ctx context.Context, typ string) (T, error)
+}
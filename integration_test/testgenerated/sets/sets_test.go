@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sets_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/sets/api"
+	"github.com/palantir/pkg/rid"
+	"github.com/palantir/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMethods(t *testing.T) {
+	var s api.StringSet
+	assert.False(t, s.Contains("a"))
+	s.Add("b", "a", "b")
+	assert.True(t, s.Contains("a"))
+	assert.True(t, s.Contains("b"))
+	assert.Len(t, s, 2)
+	assert.Equal(t, []string{"a", "b"}, s.Sorted())
+	s.Remove("a", "c")
+	assert.Equal(t, []string{"b"}, s.Sorted())
+
+	assert.Equal(t, []bool{false, true}, api.NewBooleanSet(true, false).Sorted())
+	assert.Equal(t, []uuid.UUID{{1}, {2}}, api.NewUUIDSet(uuid.UUID{2}, uuid.UUID{1}).Sorted())
+	assert.Equal(t, []api.Color{api.New_Color(api.Color_BLUE), api.New_Color(api.Color_RED)},
+		api.NewColorSet(api.New_Color(api.Color_RED), api.New_Color(api.Color_BLUE)).Sorted())
+	assert.Equal(t, []api.Name{"x", "y"}, api.NewNameSet("y", "x").Sorted())
+}
+
+func TestSetJSON(t *testing.T) {
+	out, err := json.Marshal(api.NewIntegerSet(3, 1, 2))
+	require.NoError(t, err)
+	assert.Equal(t, `[1,2,3]`, string(out))
+
+	out, err = json.Marshal(api.IntegerSet(nil))
+	require.NoError(t, err)
+	assert.Equal(t, `[]`, string(out))
+
+	var s api.IntegerSet
+	require.NoError(t, json.Unmarshal([]byte(`[2,1,2]`), &s))
+	assert.Equal(t, api.NewIntegerSet(1, 2), s)
+
+	err = s.UnmarshalJSONStrict([]byte(`[2,1,2]`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "duplicate set element")
+	require.NoError(t, s.UnmarshalJSONStrict([]byte(`[2,1]`)))
+	assert.Equal(t, api.NewIntegerSet(1, 2), s)
+}
+
+func TestRecordJSON(t *testing.T) {
+	strs := api.NewStringSet("o")
+	record := api.Record{
+		Strings:         api.NewStringSet("b", "a"),
+		Numbers:         api.NewIntegerSet(2, 1),
+		Flags:           api.NewBooleanSet(true),
+		Ids:             api.NewUUIDSet(uuid.UUID{1}),
+		Rids:            api.NewRIDSet(rid.MustNew("service", "instance", "type", "locator")),
+		Colors:          api.NewColorSet(api.New_Color(api.Color_GREEN)),
+		Names:           api.NewNameSet("n"),
+		Tags:            api.Tags(api.NewStringSet("t")),
+		Ratios:          []float64{1},
+		OptionalStrings: &strs,
+		Nested:          []api.SafeLongSet{api.NewSafeLongSet(2, 1)},
+	}
+	out, err := json.Marshal(record)
+	require.NoError(t, err)
+	assert.Equal(t, `{"strings":["a","b"],"numbers":[1,2],"flags":[true],"ids":["01000000-0000-0000-0000-000000000000"],`+
+		`"rids":["ri.service.instance.type.locator"],"colors":["GREEN"],"names":["n"],"tags":["t"],"ratios":[1],`+
+		`"optionalStrings":["o"],"nested":[[1,2]]}`, string(out))
+
+	var decoded api.Record
+	require.NoError(t, json.Unmarshal(out, &decoded))
+	assert.True(t, record.Equal(decoded))
+	assert.Equal(t, record.Hash(), decoded.Hash())
+}
+
+func TestRecordEquality(t *testing.T) {
+	record := api.Record{Strings: api.NewStringSet("a", "b"), Tags: api.Tags(api.NewStringSet("t"))}
+	assert.True(t, record.Equal(api.Record{Strings: api.NewStringSet("b", "a"), Tags: api.Tags(api.NewStringSet("t"))}))
+	assert.Equal(t, record.Hash(), api.Record{Strings: api.NewStringSet("b", "a"), Tags: api.Tags(api.NewStringSet("t"))}.Hash())
+	assert.False(t, record.Equal(api.Record{Strings: api.NewStringSet("a"), Tags: api.Tags(api.NewStringSet("t"))}))
+	assert.False(t, record.Equal(api.Record{Strings: api.NewStringSet("a", "c"), Tags: api.Tags(api.NewStringSet("t"))}))
+
+	clone := record.Clone()
+	assert.True(t, record.Equal(clone))
+	clone.Strings.Add("c")
+	assert.False(t, record.Equal(clone))
+	assert.Equal(t, []string{"a", "b"}, record.Strings.Sorted())
+}
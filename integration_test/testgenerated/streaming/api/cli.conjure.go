@@ -0,0 +1,208 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	wlogzap "github.com/palantir/witchcraft-go-logging/wlog-zap"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/trclog/trc1log"
+	"github.com/palantir/witchcraft-go-tracing/wtracing"
+	"github.com/palantir/witchcraft-go-tracing/wzipkin"
+	"github.com/spf13/cobra"
+	pflag "github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+type CLIConfig struct {
+	Client httpclient.ClientConfig `yaml:",inline"`
+}
+
+// Commands for ItemService
+
+type CLIItemServiceClientProvider interface {
+	Get(ctx context.Context, flags *pflag.FlagSet) (ItemServiceClient, error)
+}
+
+type defaultCLIItemServiceClientProvider struct{}
+
+func NewDefaultCLIItemServiceClientProvider() CLIItemServiceClientProvider {
+	return defaultCLIItemServiceClientProvider{}
+}
+
+func (d defaultCLIItemServiceClientProvider) Get(ctx context.Context, flags *pflag.FlagSet) (ItemServiceClient, error) {
+	conf, err := loadCLIConfig(ctx, flags)
+	if err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "failed to load CLI configuration file")
+	}
+	client, err := httpclient.NewClient(httpclient.WithConfig(conf.Client))
+	if err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "failed to create client with provided config")
+	}
+	return NewItemServiceClient(client), nil
+}
+
+type ItemServiceCLICommand struct {
+	clientProvider CLIItemServiceClientProvider
+}
+
+func NewItemServiceCLICommand() *cobra.Command {
+	return NewItemServiceCLICommandWithClientProvider(NewDefaultCLIItemServiceClientProvider())
+}
+
+func NewItemServiceCLICommandWithClientProvider(clientProvider CLIItemServiceClientProvider) *cobra.Command {
+	rootCmd := &cobra.Command{
+		Short: "Runs commands on the ItemService",
+		Use:   "itemService",
+	}
+	rootCmd.PersistentFlags().String("conf", "var/conf/configuration.yml", "The configuration file is optional. The default path is ./var/conf/configuration.yml.")
+	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "Enables verbose mode for debugging client connections.")
+
+	cliCommand := ItemServiceCLICommand{clientProvider: clientProvider}
+
+	itemService_StreamItems_Cmd := &cobra.Command{
+		RunE:  cliCommand.itemService_StreamItems_CmdRun,
+		Short: "Calls the streamItems endpoint.",
+		Use:   "streamItems",
+	}
+	rootCmd.AddCommand(itemService_StreamItems_Cmd)
+	itemService_StreamItems_Cmd.Flags().String("count", "", "Required. ")
+
+	itemService_StreamNames_Cmd := &cobra.Command{
+		RunE:  cliCommand.itemService_StreamNames_CmdRun,
+		Short: "Calls the streamNames endpoint.",
+		Use:   "streamNames",
+	}
+	rootCmd.AddCommand(itemService_StreamNames_Cmd)
+
+	itemService_ListItems_Cmd := &cobra.Command{
+		RunE:  cliCommand.itemService_ListItems_CmdRun,
+		Short: "Calls the listItems endpoint.",
+		Use:   "listItems",
+	}
+	rootCmd.AddCommand(itemService_ListItems_Cmd)
+
+	return rootCmd
+}
+
+func (c ItemServiceCLICommand) itemService_StreamItems_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	countRaw, err := flags.GetString("count")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument count")
+	}
+	if countRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "count is a required argument")
+	}
+	countArg, err := strconv.Atoi(countRaw)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, errors.WrapWithInvalidArgument(err), "failed to parse \"count\" as integer")
+	}
+
+	result, err := client.StreamItems(ctx, countArg)
+	if err != nil {
+		return err
+	}
+	resultBytes, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		fmt.Printf("Failed to marshal to json with err: %v\n\nPrinting as string:\n%v\n", err, result)
+		return nil
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%v\n", string(resultBytes))
+	return nil
+}
+
+func (c ItemServiceCLICommand) itemService_StreamNames_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	result, err := client.StreamNames(ctx)
+	if err != nil {
+		return err
+	}
+	resultBytes, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		fmt.Printf("Failed to marshal to json with err: %v\n\nPrinting as string:\n%v\n", err, result)
+		return nil
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%v\n", string(resultBytes))
+	return nil
+}
+
+func (c ItemServiceCLICommand) itemService_ListItems_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	result, err := client.ListItems(ctx)
+	if err != nil {
+		return err
+	}
+	resultBytes, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		fmt.Printf("Failed to marshal to json with err: %v\n\nPrinting as string:\n%v\n", err, result)
+		return nil
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%v\n", string(resultBytes))
+	return nil
+}
+
+func loadCLIConfig(ctx context.Context, flags *pflag.FlagSet) (CLIConfig, error) {
+	var emptyConfig CLIConfig
+	configPath, err := flags.GetString("conf")
+	if err != nil || configPath == "" {
+		return emptyConfig, werror.WrapWithContextParams(ctx, err, "config file location must be specified")
+	}
+	confBytes, err := os.ReadFile(configPath)
+	if err != nil {
+		return emptyConfig, err
+	}
+	var conf CLIConfig
+	err = yaml.Unmarshal(confBytes, &conf)
+	if err != nil {
+		return emptyConfig, err
+	}
+	return conf, nil
+}
+
+func getCLIContext(flags *pflag.FlagSet) context.Context {
+	ctx := context.Background()
+	logProvider := wlog.NewNoopLoggerProvider()
+	logWriter := io.Discard
+	verbose, err := flags.GetBool("verbose")
+	if verbose && err == nil {
+		logProvider = wlogzap.LoggerProvider()
+		logWriter = os.Stdout
+	}
+	wlog.SetDefaultLoggerProvider(logProvider)
+	ctx = svc1log.WithLogger(ctx, svc1log.New(logWriter, wlog.DebugLevel))
+	traceLogger := trc1log.New(logWriter)
+	ctx = trc1log.WithLogger(ctx, traceLogger)
+	ctx = evt2log.WithLogger(ctx, evt2log.New(logWriter))
+	tracer, err := wzipkin.NewTracer(traceLogger)
+	if err != nil {
+		return ctx
+	}
+	return wtracing.ContextWithTracer(ctx, tracer)
+}
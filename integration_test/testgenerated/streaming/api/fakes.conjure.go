@@ -0,0 +1,103 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	wparams "github.com/palantir/witchcraft-go-params"
+)
+
+// FakeItemService is an in-memory implementation of ItemService for use in tests.
+// Each endpoint records its arguments and then invokes the corresponding <Endpoint>Func field.
+// If the field is nil, the endpoint returns DefaultErr (or a Conjure Internal error if DefaultErr is nil).
+// The zero value is ready to use and all methods are safe for concurrent use.
+type FakeItemService struct {
+	// StreamItemsFunc is invoked by StreamItems if non-nil.
+	StreamItemsFunc func(ctx context.Context, countArg int, writeItem func(Item) error) error
+	// StreamNamesFunc is invoked by StreamNames if non-nil.
+	StreamNamesFunc func(ctx context.Context, writeItem func(string) error) error
+	// ListItemsFunc is invoked by ListItems if non-nil.
+	ListItemsFunc func(ctx context.Context) ([]Item, error)
+	// DefaultErr is returned by endpoints whose func field is nil.
+	DefaultErr error
+
+	mu               sync.Mutex
+	streamItemsCalls []FakeItemServiceStreamItemsCall
+	streamNamesCalls []FakeItemServiceStreamNamesCall
+	listItemsCalls   []FakeItemServiceListItemsCall
+}
+
+var _ ItemService = (*FakeItemService)(nil)
+
+// FakeItemServiceStreamItemsCall records the arguments of a call to FakeItemService.StreamItems.
+type FakeItemServiceStreamItemsCall struct {
+	Count int
+}
+
+func (f *FakeItemService) StreamItems(ctx context.Context, countArg int, writeItem func(Item) error) error {
+	f.mu.Lock()
+	f.streamItemsCalls = append(f.streamItemsCalls, FakeItemServiceStreamItemsCall{Count: countArg})
+	f.mu.Unlock()
+	if f.StreamItemsFunc != nil {
+		return f.StreamItemsFunc(ctx, countArg, writeItem)
+	}
+	return f.defaultErr("streamItems")
+}
+
+// StreamItemsCalls returns the arguments of every call made to StreamItems, in call order.
+func (f *FakeItemService) StreamItemsCalls() []FakeItemServiceStreamItemsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeItemServiceStreamItemsCall(nil), f.streamItemsCalls...)
+}
+
+// FakeItemServiceStreamNamesCall records the arguments of a call to FakeItemService.StreamNames.
+type FakeItemServiceStreamNamesCall struct{}
+
+func (f *FakeItemService) StreamNames(ctx context.Context, writeItem func(string) error) error {
+	f.mu.Lock()
+	f.streamNamesCalls = append(f.streamNamesCalls, FakeItemServiceStreamNamesCall{})
+	f.mu.Unlock()
+	if f.StreamNamesFunc != nil {
+		return f.StreamNamesFunc(ctx, writeItem)
+	}
+	return f.defaultErr("streamNames")
+}
+
+// StreamNamesCalls returns the arguments of every call made to StreamNames, in call order.
+func (f *FakeItemService) StreamNamesCalls() []FakeItemServiceStreamNamesCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeItemServiceStreamNamesCall(nil), f.streamNamesCalls...)
+}
+
+// FakeItemServiceListItemsCall records the arguments of a call to FakeItemService.ListItems.
+type FakeItemServiceListItemsCall struct{}
+
+func (f *FakeItemService) ListItems(ctx context.Context) ([]Item, error) {
+	f.mu.Lock()
+	f.listItemsCalls = append(f.listItemsCalls, FakeItemServiceListItemsCall{})
+	f.mu.Unlock()
+	if f.ListItemsFunc != nil {
+		return f.ListItemsFunc(ctx)
+	}
+	var defaultReturnVal []Item
+	return defaultReturnVal, f.defaultErr("listItems")
+}
+
+// ListItemsCalls returns the arguments of every call made to ListItems, in call order.
+func (f *FakeItemService) ListItemsCalls() []FakeItemServiceListItemsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeItemServiceListItemsCall(nil), f.listItemsCalls...)
+}
+
+func (f *FakeItemService) defaultErr(endpoint string) error {
+	if f.DefaultErr != nil {
+		return f.DefaultErr
+	}
+	return errors.NewInternal(wparams.NewSafeParamStorer(map[string]interface{}{"fakeEndpoint": endpoint}))
+}
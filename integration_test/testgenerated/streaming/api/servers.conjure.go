@@ -0,0 +1,78 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/codecs"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-server/httpserver"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-server/v2/witchcraft/wresource"
+	"github.com/palantir/witchcraft-go-server/v2/wrouter"
+)
+
+type ItemService interface {
+	StreamItems(ctx context.Context, countArg int, writeItem func(Item) error) error
+	StreamNames(ctx context.Context, writeItem func(string) error) error
+	ListItems(ctx context.Context) ([]Item, error)
+}
+
+// RegisterRoutesItemService registers handlers for the ItemService endpoints with a witchcraft wrouter.
+// This should typically be called in a witchcraft server's InitFunc.
+// impl provides an implementation of each endpoint, which can assume the request parameters have been parsed
+// in accordance with the Conjure specification.
+func RegisterRoutesItemService(router wrouter.Router, impl ItemService, routerParams ...wrouter.RouteParam) error {
+	handler := itemServiceHandler{impl: impl}
+	resource := wresource.New("itemservice", router)
+	if err := resource.Get("StreamItems", "/items/stream", httpserver.NewJSONHandler(handler.HandleStreamItems, httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add streamItems route")
+	}
+	if err := resource.Get("StreamNames", "/items/names", httpserver.NewJSONHandler(handler.HandleStreamNames, httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add streamNames route")
+	}
+	if err := resource.Get("ListItems", "/items/", httpserver.NewJSONHandler(handler.HandleListItems, httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add listItems route")
+	}
+	return nil
+}
+
+type itemServiceHandler struct {
+	impl ItemService
+}
+
+func (i *itemServiceHandler) HandleStreamItems(rw http.ResponseWriter, req *http.Request) error {
+	countArg, err := strconv.Atoi(req.URL.Query().Get("count"))
+	if err != nil {
+		return werror.WrapWithContextParams(req.Context(), errors.WrapWithInvalidArgument(err), "failed to parse \"count\" as integer")
+	}
+	arrayWriter := &jsonArrayWriter{rw: rw}
+	if err := i.impl.StreamItems(req.Context(), countArg, func(item Item) error {
+		return arrayWriter.writeElem(item)
+	}); err != nil {
+		return arrayWriter.fail(err)
+	}
+	return arrayWriter.close()
+}
+
+func (i *itemServiceHandler) HandleStreamNames(rw http.ResponseWriter, req *http.Request) error {
+	arrayWriter := &jsonArrayWriter{rw: rw}
+	if err := i.impl.StreamNames(req.Context(), func(item string) error {
+		return arrayWriter.writeElem(item)
+	}); err != nil {
+		return arrayWriter.fail(err)
+	}
+	return arrayWriter.close()
+}
+
+func (i *itemServiceHandler) HandleListItems(rw http.ResponseWriter, req *http.Request) error {
+	respArg, err := i.impl.ListItems(req.Context())
+	if err != nil {
+		return err
+	}
+	rw.Header().Add("Content-Type", codecs.JSON.ContentType())
+	return codecs.JSON.Encode(rw, respArg)
+}
@@ -0,0 +1,350 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	"github.com/palantir/pkg/safejson"
+	werror "github.com/palantir/witchcraft-go-error"
+)
+
+type ItemServiceClient interface {
+	StreamItems(ctx context.Context, countArg int) ([]Item, error)
+	// StreamItemsIter is like StreamItems, but returns an iterator over the elements of the response, which are decoded
+	// from the response body as they are read.
+	StreamItemsIter(ctx context.Context, countArg int) (*ItemServiceStreamItemsIterator, error)
+	StreamNames(ctx context.Context) ([]string, error)
+	// StreamNamesIter is like StreamNames, but returns an iterator over the elements of the response, which are decoded
+	// from the response body as they are read.
+	StreamNamesIter(ctx context.Context) (*ItemServiceStreamNamesIterator, error)
+	ListItems(ctx context.Context) ([]Item, error)
+	// ListItemsIter is like ListItems, but returns an iterator over the elements of the response, which are decoded
+	// from the response body as they are read.
+	ListItemsIter(ctx context.Context) (*ItemServiceListItemsIterator, error)
+}
+
+type itemServiceClient struct {
+	client httpclient.Client
+}
+
+func NewItemServiceClient(client httpclient.Client) ItemServiceClient {
+	return &itemServiceClient{client: client}
+}
+
+func (c *itemServiceClient) StreamItems(ctx context.Context, countArg int) ([]Item, error) {
+	var returnVal []Item
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("StreamItems"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("GET"))
+	requestParams = append(requestParams, httpclient.WithPathf("/items/stream"))
+	queryParams := make(url.Values)
+	queryParams.Set("count", fmt.Sprint(countArg))
+	requestParams = append(requestParams, httpclient.WithQueryValues(queryParams))
+	requestParams = append(requestParams, httpclient.WithJSONResponse(&returnVal))
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "streamItems failed")
+	}
+	if returnVal == nil {
+		return nil, werror.ErrorWithContextParams(ctx, "streamItems response cannot be nil")
+	}
+	return returnVal, nil
+}
+
+func (c *itemServiceClient) StreamItemsIter(ctx context.Context, countArg int) (*ItemServiceStreamItemsIterator, error) {
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("StreamItems"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("GET"))
+	requestParams = append(requestParams, httpclient.WithPathf("/items/stream"))
+	queryParams := make(url.Values)
+	queryParams.Set("count", fmt.Sprint(countArg))
+	requestParams = append(requestParams, httpclient.WithQueryValues(queryParams))
+	requestParams = append(requestParams, httpclient.WithRawResponseBody())
+	resp, err := c.client.Do(ctx, requestParams...)
+	if err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "streamItems failed")
+	}
+	return &ItemServiceStreamItemsIterator{ctx: ctx, body: resp.Body, decoder: safejson.Decoder(resp.Body)}, nil
+}
+
+// ItemServiceStreamItemsIterator iterates over the elements of the response of StreamItems, which are decoded from the
+// response body as they are read. The body is closed when Next returns false or when Close is called.
+type ItemServiceStreamItemsIterator struct {
+	ctx     context.Context
+	body    io.ReadCloser
+	decoder *json.Decoder
+	started bool
+	value   Item
+	err     error
+}
+
+// Next decodes the next element of the response and returns true if there is one. It returns false when all
+// elements have been read or if the response could not be decoded, in which case Err returns the error.
+func (i *ItemServiceStreamItemsIterator) Next() bool {
+	if i.decoder == nil {
+		return false
+	}
+	if !i.started {
+		i.started = true
+		token, err := i.decoder.Token()
+		switch {
+		case err != nil:
+			return i.stop(werror.WrapWithContextParams(i.ctx, err, "streamItems failed"))
+		case token == nil:
+			return i.stop(werror.ErrorWithContextParams(i.ctx, "streamItems response cannot be nil"))
+		case token != json.Delim('['):
+			return i.stop(werror.ErrorWithContextParams(i.ctx, "streamItems response must be a JSON array"))
+		}
+	}
+	if !i.decoder.More() {
+		// consume the end of the array
+		if _, err := i.decoder.Token(); err != nil {
+			return i.stop(werror.WrapWithContextParams(i.ctx, err, "streamItems failed"))
+		}
+		return i.stop(nil)
+	}
+	var value Item
+	if err := i.decoder.Decode(&value); err != nil {
+		return i.stop(werror.WrapWithContextParams(i.ctx, err, "streamItems failed"))
+	}
+	i.value = value
+	return true
+}
+
+// Value returns the element decoded by the last call to Next.
+func (i *ItemServiceStreamItemsIterator) Value() Item {
+	return i.value
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (i *ItemServiceStreamItemsIterator) Err() error {
+	return i.err
+}
+
+// Close closes the response body. It must be called if the iteration is stopped before Next returns false.
+func (i *ItemServiceStreamItemsIterator) Close() error {
+	if i.decoder == nil {
+		return nil
+	}
+	i.decoder = nil
+	return i.body.Close()
+}
+
+func (i *ItemServiceStreamItemsIterator) stop(err error) bool {
+	var zero Item
+	i.value = zero
+	i.err = err
+	if closeErr := i.Close(); i.err == nil && closeErr != nil {
+		i.err = werror.WrapWithContextParams(i.ctx, closeErr, "streamItems failed")
+	}
+	return false
+}
+
+func (c *itemServiceClient) StreamNames(ctx context.Context) ([]string, error) {
+	var returnVal []string
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("StreamNames"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("GET"))
+	requestParams = append(requestParams, httpclient.WithPathf("/items/names"))
+	requestParams = append(requestParams, httpclient.WithJSONResponse(&returnVal))
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "streamNames failed")
+	}
+	if returnVal == nil {
+		return nil, werror.ErrorWithContextParams(ctx, "streamNames response cannot be nil")
+	}
+	return returnVal, nil
+}
+
+func (c *itemServiceClient) StreamNamesIter(ctx context.Context) (*ItemServiceStreamNamesIterator, error) {
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("StreamNames"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("GET"))
+	requestParams = append(requestParams, httpclient.WithPathf("/items/names"))
+	requestParams = append(requestParams, httpclient.WithRawResponseBody())
+	resp, err := c.client.Do(ctx, requestParams...)
+	if err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "streamNames failed")
+	}
+	return &ItemServiceStreamNamesIterator{ctx: ctx, body: resp.Body, decoder: safejson.Decoder(resp.Body)}, nil
+}
+
+// ItemServiceStreamNamesIterator iterates over the elements of the response of StreamNames, which are decoded from the
+// response body as they are read. The body is closed when Next returns false or when Close is called.
+type ItemServiceStreamNamesIterator struct {
+	ctx     context.Context
+	body    io.ReadCloser
+	decoder *json.Decoder
+	started bool
+	value   string
+	err     error
+}
+
+// Next decodes the next element of the response and returns true if there is one. It returns false when all
+// elements have been read or if the response could not be decoded, in which case Err returns the error.
+func (i *ItemServiceStreamNamesIterator) Next() bool {
+	if i.decoder == nil {
+		return false
+	}
+	if !i.started {
+		i.started = true
+		token, err := i.decoder.Token()
+		switch {
+		case err != nil:
+			return i.stop(werror.WrapWithContextParams(i.ctx, err, "streamNames failed"))
+		case token == nil:
+			return i.stop(werror.ErrorWithContextParams(i.ctx, "streamNames response cannot be nil"))
+		case token != json.Delim('['):
+			return i.stop(werror.ErrorWithContextParams(i.ctx, "streamNames response must be a JSON array"))
+		}
+	}
+	if !i.decoder.More() {
+		// consume the end of the array
+		if _, err := i.decoder.Token(); err != nil {
+			return i.stop(werror.WrapWithContextParams(i.ctx, err, "streamNames failed"))
+		}
+		return i.stop(nil)
+	}
+	var value string
+	if err := i.decoder.Decode(&value); err != nil {
+		return i.stop(werror.WrapWithContextParams(i.ctx, err, "streamNames failed"))
+	}
+	i.value = value
+	return true
+}
+
+// Value returns the element decoded by the last call to Next.
+func (i *ItemServiceStreamNamesIterator) Value() string {
+	return i.value
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (i *ItemServiceStreamNamesIterator) Err() error {
+	return i.err
+}
+
+// Close closes the response body. It must be called if the iteration is stopped before Next returns false.
+func (i *ItemServiceStreamNamesIterator) Close() error {
+	if i.decoder == nil {
+		return nil
+	}
+	i.decoder = nil
+	return i.body.Close()
+}
+
+func (i *ItemServiceStreamNamesIterator) stop(err error) bool {
+	var zero string
+	i.value = zero
+	i.err = err
+	if closeErr := i.Close(); i.err == nil && closeErr != nil {
+		i.err = werror.WrapWithContextParams(i.ctx, closeErr, "streamNames failed")
+	}
+	return false
+}
+
+func (c *itemServiceClient) ListItems(ctx context.Context) ([]Item, error) {
+	var returnVal []Item
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("ListItems"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("GET"))
+	requestParams = append(requestParams, httpclient.WithPathf("/items/"))
+	requestParams = append(requestParams, httpclient.WithJSONResponse(&returnVal))
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "listItems failed")
+	}
+	if returnVal == nil {
+		return nil, werror.ErrorWithContextParams(ctx, "listItems response cannot be nil")
+	}
+	return returnVal, nil
+}
+
+func (c *itemServiceClient) ListItemsIter(ctx context.Context) (*ItemServiceListItemsIterator, error) {
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("ListItems"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("GET"))
+	requestParams = append(requestParams, httpclient.WithPathf("/items/"))
+	requestParams = append(requestParams, httpclient.WithRawResponseBody())
+	resp, err := c.client.Do(ctx, requestParams...)
+	if err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "listItems failed")
+	}
+	return &ItemServiceListItemsIterator{ctx: ctx, body: resp.Body, decoder: safejson.Decoder(resp.Body)}, nil
+}
+
+// ItemServiceListItemsIterator iterates over the elements of the response of ListItems, which are decoded from the
+// response body as they are read. The body is closed when Next returns false or when Close is called.
+type ItemServiceListItemsIterator struct {
+	ctx     context.Context
+	body    io.ReadCloser
+	decoder *json.Decoder
+	started bool
+	value   Item
+	err     error
+}
+
+// Next decodes the next element of the response and returns true if there is one. It returns false when all
+// elements have been read or if the response could not be decoded, in which case Err returns the error.
+func (i *ItemServiceListItemsIterator) Next() bool {
+	if i.decoder == nil {
+		return false
+	}
+	if !i.started {
+		i.started = true
+		token, err := i.decoder.Token()
+		switch {
+		case err != nil:
+			return i.stop(werror.WrapWithContextParams(i.ctx, err, "listItems failed"))
+		case token == nil:
+			return i.stop(werror.ErrorWithContextParams(i.ctx, "listItems response cannot be nil"))
+		case token != json.Delim('['):
+			return i.stop(werror.ErrorWithContextParams(i.ctx, "listItems response must be a JSON array"))
+		}
+	}
+	if !i.decoder.More() {
+		// consume the end of the array
+		if _, err := i.decoder.Token(); err != nil {
+			return i.stop(werror.WrapWithContextParams(i.ctx, err, "listItems failed"))
+		}
+		return i.stop(nil)
+	}
+	var value Item
+	if err := i.decoder.Decode(&value); err != nil {
+		return i.stop(werror.WrapWithContextParams(i.ctx, err, "listItems failed"))
+	}
+	i.value = value
+	return true
+}
+
+// Value returns the element decoded by the last call to Next.
+func (i *ItemServiceListItemsIterator) Value() Item {
+	return i.value
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (i *ItemServiceListItemsIterator) Err() error {
+	return i.err
+}
+
+// Close closes the response body. It must be called if the iteration is stopped before Next returns false.
+func (i *ItemServiceListItemsIterator) Close() error {
+	if i.decoder == nil {
+		return nil
+	}
+	i.decoder = nil
+	return i.body.Close()
+}
+
+func (i *ItemServiceListItemsIterator) stop(err error) bool {
+	var zero Item
+	i.value = zero
+	i.err = err
+	if closeErr := i.Close(); i.err == nil && closeErr != nil {
+		i.err = werror.WrapWithContextParams(i.ctx, closeErr, "listItems failed")
+	}
+	return false
+}
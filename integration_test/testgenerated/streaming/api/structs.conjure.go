@@ -0,0 +1,168 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
+)
+
+type Item struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func (o Item) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"id\":"...)
+	out = strconv.AppendInt(out, int64(o.Id), 10)
+	out = append(out, ",\"name\":"...)
+	out = appendJSONString(out, o.Name)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Item) JSONSize() (int, error) {
+	size := 15
+	size += jsonIntSize(int64(o.Id))
+	size += jsonStringSize(o.Name)
+	return size, nil
+}
+
+func (o *Item) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenId, seenName bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "id":
+			if seenId {
+				ok = false
+				return false
+			}
+			seenId = true
+			if !unmarshalJSONInt(field, &o.Id) {
+				ok = false
+				return false
+			}
+		case "name":
+			if seenName {
+				ok = false
+				return false
+			}
+			seenName = true
+			if !unmarshalJSONString(field, &o.Name) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "id", "name") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *Item) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Item) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Item{}
+	var seenId, seenName bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "id":
+			if seenId {
+				err = fmt.Errorf("duplicate field \"id\"")
+				return false
+			}
+			seenId = true
+			o.Id, err = decodeJSONInt(field)
+			if err != nil {
+				err = fmt.Errorf("field \"id\": %w", err)
+				return false
+			}
+		case "name":
+			if seenName {
+				err = fmt.Errorf("duplicate field \"name\"")
+				return false
+			}
+			seenName = true
+			o.Name, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"name\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenId {
+		return fmt.Errorf("field \"id\" is required")
+	}
+	if !seenName {
+		return fmt.Errorf("field \"name\" is required")
+	}
+	return nil
+}
+
+func (o Item) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *Item) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the Item is equal to other according to the Conjure semantics of its values.
+func (o Item) Equal(other Item) bool {
+	if o.Id != other.Id {
+		return false
+	}
+	if o.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Item.
+func (o Item) Clone() Item {
+	out := o
+	return out
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streaming_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/streaming/api"
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/streaming/api/apitest"
+	"github.com/palantir/witchcraft-go-server/v2/wrouter"
+	"github.com/palantir/witchcraft-go-server/v2/wrouter/whttprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeItems(count int, writeItem func(api.Item) error) error {
+	for i := 0; i < count; i++ {
+		if err := writeItem(api.Item{Id: i, Name: "item-" + strconv.Itoa(i)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestStreamedResponse(t *testing.T) {
+	ctx := context.Background()
+	fake := &api.FakeItemService{
+		StreamItemsFunc: func(ctx context.Context, count int, writeItem func(api.Item) error) error {
+			return writeItems(count, writeItem)
+		},
+		StreamNamesFunc: func(ctx context.Context, writeItem func(string) error) error {
+			if err := writeItem("a"); err != nil {
+				return err
+			}
+			return writeItem("b")
+		},
+	}
+	client := apitest.NewItemServiceTestPair(t, fake, apitest.TestPairOptions{
+		ClientParams: []httpclient.ClientParam{httpclient.WithMaxRetries(0)},
+	})
+
+	items, err := client.StreamItems(ctx, 1000)
+	require.NoError(t, err)
+	require.Len(t, items, 1000)
+	for i, item := range items {
+		assert.Equal(t, api.Item{Id: i, Name: "item-" + strconv.Itoa(i)}, item)
+	}
+
+	items, err = client.StreamItems(ctx, 0)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	names, err := client.StreamNames(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, names)
+}
+
+func TestStreamedResponseErrors(t *testing.T) {
+	ctx := context.Background()
+	fake := &api.FakeItemService{
+		StreamItemsFunc: func(ctx context.Context, count int, writeItem func(api.Item) error) error {
+			if err := writeItems(count, writeItem); err != nil {
+				return err
+			}
+			return errors.NewNotFound()
+		},
+	}
+	client := apitest.NewItemServiceTestPair(t, fake, apitest.TestPairOptions{
+		ClientParams: []httpclient.ClientParam{httpclient.WithMaxRetries(0)},
+	})
+
+	// errors returned before any elements are written are returned to the client
+	_, err := client.StreamItems(ctx, 0)
+	require.Error(t, err)
+	assert.Equal(t, errors.NotFound, errors.GetConjureError(err).Code())
+
+	// errors returned after elements are written abort the response
+	_, err = client.StreamItems(ctx, 1)
+	require.Error(t, err)
+}
+
+func TestStreamedResponseIsWrittenIncrementally(t *testing.T) {
+	received := make(chan struct{})
+	fake := &api.FakeItemService{
+		StreamItemsFunc: func(ctx context.Context, count int, writeItem func(api.Item) error) error {
+			if err := writeItems(count, writeItem); err != nil {
+				return err
+			}
+			select {
+			case <-received:
+			case <-time.After(10 * time.Second):
+				t.Error("response was not received before the implementation returned")
+			}
+			return writeItems(1, writeItem)
+		},
+	}
+	router := wrouter.New(whttprouter.New())
+	require.NoError(t, api.RegisterRoutesItemService(router, fake))
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	// each element is flushed, so a single element is received before the implementation returns
+	resp, err := http.Get(server.URL + "/items/stream?count=1")
+	require.NoError(t, err)
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	prefix := make([]byte, 25)
+	_, err = io.ReadFull(resp.Body, prefix)
+	require.NoError(t, err)
+	assert.Equal(t, `[{"id":0,"name":"item-0"}`, string(prefix))
+	close(received)
+
+	rest, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `,{"id":0,"name":"item-0"}]`, string(rest))
+}
+
+func TestStreamedResponseIsAbortedOnError(t *testing.T) {
+	fake := &api.FakeItemService{
+		StreamItemsFunc: func(ctx context.Context, count int, writeItem func(api.Item) error) error {
+			if err := writeItems(count, writeItem); err != nil {
+				return err
+			}
+			return errors.NewInternal()
+		},
+	}
+	router := wrouter.New(whttprouter.New())
+	require.NoError(t, api.RegisterRoutesItemService(router, fake))
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/items/stream?count=1")
+	require.NoError(t, err)
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	// the array is neither ended nor followed by the error, and the body ends unexpectedly
+	body, err := io.ReadAll(resp.Body)
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+	assert.Equal(t, `[{"id":0,"name":"item-0"}`, string(body))
+}
+
+func TestClientIterator(t *testing.T) {
+	ctx := context.Background()
+	var listItems []api.Item
+	fake := &api.FakeItemService{
+		StreamItemsFunc: func(ctx context.Context, count int, writeItem func(api.Item) error) error {
+			if err := writeItems(count, writeItem); err != nil {
+				return err
+			}
+			if count == 3 {
+				return errors.NewInternal()
+			}
+			return nil
+		},
+		StreamNamesFunc: func(ctx context.Context, writeItem func(string) error) error {
+			return writeItem("a")
+		},
+		ListItemsFunc: func(ctx context.Context) ([]api.Item, error) {
+			return listItems, nil
+		},
+	}
+	client := apitest.NewItemServiceTestPair(t, fake, apitest.TestPairOptions{
+		ClientParams: []httpclient.ClientParam{httpclient.WithMaxRetries(0)},
+	})
+
+	iter, err := client.StreamItemsIter(ctx, 1000)
+	require.NoError(t, err)
+	var count int
+	for iter.Next() {
+		assert.Equal(t, api.Item{Id: count, Name: "item-" + strconv.Itoa(count)}, iter.Value())
+		count++
+	}
+	require.NoError(t, iter.Err())
+	assert.Equal(t, 1000, count)
+	assert.False(t, iter.Next())
+	assert.NoError(t, iter.Close())
+
+	names, err := client.StreamNamesIter(ctx)
+	require.NoError(t, err)
+	require.True(t, names.Next())
+	assert.Equal(t, "a", names.Value())
+	assert.False(t, names.Next())
+	assert.NoError(t, names.Err())
+
+	t.Run("close before end", func(t *testing.T) {
+		iter, err := client.StreamItemsIter(ctx, 1000)
+		require.NoError(t, err)
+		require.True(t, iter.Next())
+		assert.NoError(t, iter.Close())
+		assert.False(t, iter.Next())
+		assert.NoError(t, iter.Err())
+	})
+
+	t.Run("truncated response", func(t *testing.T) {
+		iter, err := client.StreamItemsIter(ctx, 3)
+		require.NoError(t, err)
+		var count int
+		for iter.Next() {
+			count++
+		}
+		assert.Equal(t, 3, count)
+		assert.Error(t, iter.Err())
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		fake.DefaultErr = errors.NewNotFound()
+		fake.StreamItemsFunc = nil
+		_, err := client.StreamItemsIter(ctx, 0)
+		require.Error(t, err)
+		assert.Equal(t, errors.NotFound, errors.GetConjureError(err).Code())
+	})
+
+	t.Run("non-streamed response", func(t *testing.T) {
+		listItems = []api.Item{{Id: 1, Name: "one"}, {Id: 2, Name: "two"}}
+		iter, err := client.ListItemsIter(ctx)
+		require.NoError(t, err)
+		var items []api.Item
+		for iter.Next() {
+			items = append(items, iter.Value())
+		}
+		require.NoError(t, iter.Err())
+		assert.Equal(t, listItems, items)
+
+		listItems = nil
+		iter, err = client.ListItemsIter(ctx)
+		require.NoError(t, err)
+		assert.False(t, iter.Next())
+		assert.EqualError(t, iter.Err(), "listItems response cannot be nil")
+	})
+}
@@ -0,0 +1,38 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"github.com/tidwall/gjson"
+)
+
+type WidgetName string
+
+func (a *WidgetName) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return a.decodeJSONStrict(value)
+}
+
+func (a *WidgetName) decodeJSONStrict(value gjson.Result) error {
+	var err error
+	var v string
+	v, err = decodeJSONString(value)
+	if err != nil {
+		return err
+	}
+	*a = WidgetName(v)
+	return nil
+}
+
+// Equal returns true if the WidgetName is equal to other according to the Conjure semantics of its values.
+func (a WidgetName) Equal(other WidgetName) bool {
+	return string(a) == string(other)
+}
+
+// Clone returns a deep copy of the WidgetName.
+func (a WidgetName) Clone() WidgetName {
+	return a
+}
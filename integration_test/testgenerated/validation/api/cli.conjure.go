@@ -0,0 +1,249 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/codecs"
+	werror "github.com/palantir/witchcraft-go-error"
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	wlogzap "github.com/palantir/witchcraft-go-logging/wlog-zap"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/trclog/trc1log"
+	"github.com/palantir/witchcraft-go-tracing/wtracing"
+	"github.com/palantir/witchcraft-go-tracing/wzipkin"
+	"github.com/spf13/cobra"
+	pflag "github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+type CLIConfig struct {
+	Client httpclient.ClientConfig `yaml:",inline"`
+}
+
+// Commands for WidgetService
+
+type CLIWidgetServiceClientProvider interface {
+	Get(ctx context.Context, flags *pflag.FlagSet) (WidgetServiceClient, error)
+}
+
+type defaultCLIWidgetServiceClientProvider struct{}
+
+func NewDefaultCLIWidgetServiceClientProvider() CLIWidgetServiceClientProvider {
+	return defaultCLIWidgetServiceClientProvider{}
+}
+
+func (d defaultCLIWidgetServiceClientProvider) Get(ctx context.Context, flags *pflag.FlagSet) (WidgetServiceClient, error) {
+	conf, err := loadCLIConfig(ctx, flags)
+	if err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "failed to load CLI configuration file")
+	}
+	client, err := httpclient.NewClient(httpclient.WithConfig(conf.Client))
+	if err != nil {
+		return nil, werror.WrapWithContextParams(ctx, err, "failed to create client with provided config")
+	}
+	return NewWidgetServiceClient(client), nil
+}
+
+type WidgetServiceCLICommand struct {
+	clientProvider CLIWidgetServiceClientProvider
+}
+
+func NewWidgetServiceCLICommand() *cobra.Command {
+	return NewWidgetServiceCLICommandWithClientProvider(NewDefaultCLIWidgetServiceClientProvider())
+}
+
+func NewWidgetServiceCLICommandWithClientProvider(clientProvider CLIWidgetServiceClientProvider) *cobra.Command {
+	rootCmd := &cobra.Command{
+		Short: "Runs commands on the WidgetService",
+		Use:   "widgetService",
+	}
+	rootCmd.PersistentFlags().String("conf", "var/conf/configuration.yml", "The configuration file is optional. The default path is ./var/conf/configuration.yml.")
+	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "Enables verbose mode for debugging client connections.")
+
+	cliCommand := WidgetServiceCLICommand{clientProvider: clientProvider}
+
+	widgetService_CreateWidget_Cmd := &cobra.Command{
+		RunE:  cliCommand.widgetService_CreateWidget_CmdRun,
+		Short: "Calls the createWidget endpoint.",
+		Use:   "createWidget",
+	}
+	rootCmd.AddCommand(widgetService_CreateWidget_Cmd)
+	widgetService_CreateWidget_Cmd.Flags().String("widget", "", "Required. ")
+
+	widgetService_CreateWidgets_Cmd := &cobra.Command{
+		RunE:  cliCommand.widgetService_CreateWidgets_CmdRun,
+		Short: "Calls the createWidgets endpoint.",
+		Use:   "createWidgets",
+	}
+	rootCmd.AddCommand(widgetService_CreateWidgets_Cmd)
+	widgetService_CreateWidgets_Cmd.Flags().String("widgets", "", "Required. ")
+
+	widgetService_RenameWidget_Cmd := &cobra.Command{
+		RunE:  cliCommand.widgetService_RenameWidget_CmdRun,
+		Short: "Calls the renameWidget endpoint.",
+		Use:   "renameWidget",
+	}
+	rootCmd.AddCommand(widgetService_RenameWidget_Cmd)
+	widgetService_RenameWidget_Cmd.Flags().String("name", "", "Required. ")
+	widgetService_RenameWidget_Cmd.Flags().String("newName", "", "Optional. ")
+
+	return rootCmd
+}
+
+func (c WidgetServiceCLICommand) widgetService_CreateWidget_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	widgetRaw, err := flags.GetString("widget")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument widget")
+	}
+	if widgetRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "widget is a required argument")
+	}
+	var widgetArg Widget
+	var widgetArgReader io.ReadCloser
+	switch {
+	case widgetRaw == "@-":
+		widgetArgReader = io.NopCloser(cmd.InOrStdin())
+	case strings.HasPrefix(widgetRaw, "@"):
+		widgetArgReader, err = os.Open(strings.TrimSpace(widgetRaw[1:]))
+		if err != nil {
+			return werror.WrapWithContextParams(ctx, err, "failed to open file for argument widget")
+		}
+	default:
+		widgetArgReader = io.NopCloser(bytes.NewReader([]byte(widgetRaw)))
+	}
+	defer widgetArgReader.Close()
+	if err := codecs.JSON.Decode(widgetArgReader, &widgetArg); err != nil {
+		return werror.WrapWithContextParams(ctx, err, "invalid value for widget argument")
+	}
+
+	result, err := client.CreateWidget(ctx, widgetArg)
+	if err != nil {
+		return err
+	}
+	resultBytes, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		fmt.Printf("Failed to marshal to json with err: %v\n\nPrinting as string:\n%v\n", err, result)
+		return nil
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%v\n", string(resultBytes))
+	return nil
+}
+
+func (c WidgetServiceCLICommand) widgetService_CreateWidgets_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	widgetsRaw, err := flags.GetString("widgets")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument widgets")
+	}
+	if widgetsRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "widgets is a required argument")
+	}
+	var widgetsArg []Widget
+	var widgetsArgReader io.ReadCloser
+	switch {
+	case widgetsRaw == "@-":
+		widgetsArgReader = io.NopCloser(cmd.InOrStdin())
+	case strings.HasPrefix(widgetsRaw, "@"):
+		widgetsArgReader, err = os.Open(strings.TrimSpace(widgetsRaw[1:]))
+		if err != nil {
+			return werror.WrapWithContextParams(ctx, err, "failed to open file for argument widgets")
+		}
+	default:
+		widgetsArgReader = io.NopCloser(bytes.NewReader([]byte(widgetsRaw)))
+	}
+	defer widgetsArgReader.Close()
+	if err := codecs.JSON.Decode(widgetsArgReader, &widgetsArg); err != nil {
+		return werror.WrapWithContextParams(ctx, err, "invalid value for widgets argument")
+	}
+
+	return client.CreateWidgets(ctx, widgetsArg)
+}
+
+func (c WidgetServiceCLICommand) widgetService_RenameWidget_CmdRun(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	ctx := getCLIContext(flags)
+	client, err := c.clientProvider.Get(ctx, flags)
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to initialize client")
+	}
+	nameRaw, err := flags.GetString("name")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument name")
+	}
+	if nameRaw == "" {
+		return werror.ErrorWithContextParams(ctx, "name is a required argument")
+	}
+	nameArg := WidgetName(nameRaw)
+
+	newNameRaw, err := flags.GetString("newName")
+	if err != nil {
+		return werror.WrapWithContextParams(ctx, err, "failed to parse argument newName")
+	}
+	var newNameArg *WidgetName
+	if newNameArgStr := newNameRaw; newNameArgStr != "" {
+		newNameArgInternal := WidgetName(newNameArgStr)
+		newNameArg = &newNameArgInternal
+	}
+
+	return client.RenameWidget(ctx, nameArg, newNameArg)
+}
+
+func loadCLIConfig(ctx context.Context, flags *pflag.FlagSet) (CLIConfig, error) {
+	var emptyConfig CLIConfig
+	configPath, err := flags.GetString("conf")
+	if err != nil || configPath == "" {
+		return emptyConfig, werror.WrapWithContextParams(ctx, err, "config file location must be specified")
+	}
+	confBytes, err := os.ReadFile(configPath)
+	if err != nil {
+		return emptyConfig, err
+	}
+	var conf CLIConfig
+	err = yaml.Unmarshal(confBytes, &conf)
+	if err != nil {
+		return emptyConfig, err
+	}
+	return conf, nil
+}
+
+func getCLIContext(flags *pflag.FlagSet) context.Context {
+	ctx := context.Background()
+	logProvider := wlog.NewNoopLoggerProvider()
+	logWriter := io.Discard
+	verbose, err := flags.GetBool("verbose")
+	if verbose && err == nil {
+		logProvider = wlogzap.LoggerProvider()
+		logWriter = os.Stdout
+	}
+	wlog.SetDefaultLoggerProvider(logProvider)
+	ctx = svc1log.WithLogger(ctx, svc1log.New(logWriter, wlog.DebugLevel))
+	traceLogger := trc1log.New(logWriter)
+	ctx = trc1log.WithLogger(ctx, traceLogger)
+	ctx = evt2log.WithLogger(ctx, evt2log.New(logWriter))
+	tracer, err := wzipkin.NewTracer(traceLogger)
+	if err != nil {
+		return ctx
+	}
+	return wtracing.ContextWithTracer(ctx, tracer)
+}
@@ -0,0 +1,108 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	wparams "github.com/palantir/witchcraft-go-params"
+)
+
+// FakeWidgetService is an in-memory implementation of WidgetService for use in tests.
+// Each endpoint records its arguments and then invokes the corresponding <Endpoint>Func field.
+// If the field is nil, the endpoint returns DefaultErr (or a Conjure Internal error if DefaultErr is nil).
+// The zero value is ready to use and all methods are safe for concurrent use.
+type FakeWidgetService struct {
+	// CreateWidgetFunc is invoked by CreateWidget if non-nil.
+	CreateWidgetFunc func(ctx context.Context, widgetArg Widget) (Widget, error)
+	// CreateWidgetsFunc is invoked by CreateWidgets if non-nil.
+	CreateWidgetsFunc func(ctx context.Context, widgetsArg []Widget) error
+	// RenameWidgetFunc is invoked by RenameWidget if non-nil.
+	RenameWidgetFunc func(ctx context.Context, nameArg WidgetName, newNameArg *WidgetName) error
+	// DefaultErr is returned by endpoints whose func field is nil.
+	DefaultErr error
+
+	mu                 sync.Mutex
+	createWidgetCalls  []FakeWidgetServiceCreateWidgetCall
+	createWidgetsCalls []FakeWidgetServiceCreateWidgetsCall
+	renameWidgetCalls  []FakeWidgetServiceRenameWidgetCall
+}
+
+var _ WidgetService = (*FakeWidgetService)(nil)
+
+// FakeWidgetServiceCreateWidgetCall records the arguments of a call to FakeWidgetService.CreateWidget.
+type FakeWidgetServiceCreateWidgetCall struct {
+	Widget Widget
+}
+
+func (f *FakeWidgetService) CreateWidget(ctx context.Context, widgetArg Widget) (Widget, error) {
+	f.mu.Lock()
+	f.createWidgetCalls = append(f.createWidgetCalls, FakeWidgetServiceCreateWidgetCall{Widget: widgetArg})
+	f.mu.Unlock()
+	if f.CreateWidgetFunc != nil {
+		return f.CreateWidgetFunc(ctx, widgetArg)
+	}
+	var defaultReturnVal Widget
+	return defaultReturnVal, f.defaultErr("createWidget")
+}
+
+// CreateWidgetCalls returns the arguments of every call made to CreateWidget, in call order.
+func (f *FakeWidgetService) CreateWidgetCalls() []FakeWidgetServiceCreateWidgetCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeWidgetServiceCreateWidgetCall(nil), f.createWidgetCalls...)
+}
+
+// FakeWidgetServiceCreateWidgetsCall records the arguments of a call to FakeWidgetService.CreateWidgets.
+type FakeWidgetServiceCreateWidgetsCall struct {
+	Widgets []Widget
+}
+
+func (f *FakeWidgetService) CreateWidgets(ctx context.Context, widgetsArg []Widget) error {
+	f.mu.Lock()
+	f.createWidgetsCalls = append(f.createWidgetsCalls, FakeWidgetServiceCreateWidgetsCall{Widgets: widgetsArg})
+	f.mu.Unlock()
+	if f.CreateWidgetsFunc != nil {
+		return f.CreateWidgetsFunc(ctx, widgetsArg)
+	}
+	return f.defaultErr("createWidgets")
+}
+
+// CreateWidgetsCalls returns the arguments of every call made to CreateWidgets, in call order.
+func (f *FakeWidgetService) CreateWidgetsCalls() []FakeWidgetServiceCreateWidgetsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeWidgetServiceCreateWidgetsCall(nil), f.createWidgetsCalls...)
+}
+
+// FakeWidgetServiceRenameWidgetCall records the arguments of a call to FakeWidgetService.RenameWidget.
+type FakeWidgetServiceRenameWidgetCall struct {
+	Name    WidgetName
+	NewName *WidgetName
+}
+
+func (f *FakeWidgetService) RenameWidget(ctx context.Context, nameArg WidgetName, newNameArg *WidgetName) error {
+	f.mu.Lock()
+	f.renameWidgetCalls = append(f.renameWidgetCalls, FakeWidgetServiceRenameWidgetCall{Name: nameArg, NewName: newNameArg})
+	f.mu.Unlock()
+	if f.RenameWidgetFunc != nil {
+		return f.RenameWidgetFunc(ctx, nameArg, newNameArg)
+	}
+	return f.defaultErr("renameWidget")
+}
+
+// RenameWidgetCalls returns the arguments of every call made to RenameWidget, in call order.
+func (f *FakeWidgetService) RenameWidgetCalls() []FakeWidgetServiceRenameWidgetCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeWidgetServiceRenameWidgetCall(nil), f.renameWidgetCalls...)
+}
+
+func (f *FakeWidgetService) defaultErr(endpoint string) error {
+	if f.DefaultErr != nil {
+		return f.DefaultErr
+	}
+	return errors.NewInternal(wparams.NewSafeParamStorer(map[string]interface{}{"fakeEndpoint": endpoint}))
+}
@@ -0,0 +1,153 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/codecs"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-server/httpserver"
+	werror "github.com/palantir/witchcraft-go-error"
+	wparams "github.com/palantir/witchcraft-go-params"
+	"github.com/palantir/witchcraft-go-server/v2/witchcraft/wresource"
+	"github.com/palantir/witchcraft-go-server/v2/wrouter"
+	"github.com/tidwall/gjson"
+)
+
+type WidgetService interface {
+	CreateWidget(ctx context.Context, widgetArg Widget) (Widget, error)
+	CreateWidgets(ctx context.Context, widgetsArg []Widget) error
+	RenameWidget(ctx context.Context, nameArg WidgetName, newNameArg *WidgetName) error
+}
+
+// RegisterRoutesWidgetService registers handlers for the WidgetService endpoints with a witchcraft wrouter.
+// This should typically be called in a witchcraft server's InitFunc.
+// impl provides an implementation of each endpoint, which can assume the request parameters have been parsed
+// in accordance with the Conjure specification.
+func RegisterRoutesWidgetService(router wrouter.Router, impl WidgetService, routerParams ...wrouter.RouteParam) error {
+	handler := widgetServiceHandler{impl: impl}
+	resource := wresource.New("widgetservice", router)
+	if err := resource.Post("CreateWidget", "/widgets/", httpserver.NewJSONHandler(handler.HandleCreateWidget, httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add createWidget route")
+	}
+	if err := resource.Put("CreateWidgets", "/widgets/", httpserver.NewJSONHandler(handler.HandleCreateWidgets, httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add createWidgets route")
+	}
+	if err := resource.Post("RenameWidget", "/widgets/{name}", httpserver.NewJSONHandler(handler.HandleRenameWidget, httpserver.StatusCodeMapper, httpserver.ErrHandler), routerParams...); err != nil {
+		return werror.Wrap(err, "failed to add renameWidget route")
+	}
+	return nil
+}
+
+type widgetServiceHandler struct {
+	impl WidgetService
+}
+
+func (w *widgetServiceHandler) HandleCreateWidget(rw http.ResponseWriter, req *http.Request) error {
+	var widgetArg Widget
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	if err := widgetArg.UnmarshalJSONStrict(data); err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	if err := validateRequestParam("widget", &widgetArg); err != nil {
+		return err
+	}
+	respArg, err := w.impl.CreateWidget(req.Context(), widgetArg)
+	if err != nil {
+		return err
+	}
+	rw.Header().Add("Content-Type", codecs.JSON.ContentType())
+	return codecs.JSON.Encode(rw, respArg)
+}
+
+func (w *widgetServiceHandler) HandleCreateWidgets(rw http.ResponseWriter, req *http.Request) error {
+	var widgetsArg []Widget
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	if !value.IsArray() {
+		return errors.WrapWithInvalidArgument(jsonTypeError(value, "array"))
+	}
+	widgetsArg = make([]Widget, 0)
+	value.ForEach(func(_, elem gjson.Result) bool {
+		var v Widget
+		err = v.decodeJSONStrict(elem)
+		if err != nil {
+			return false
+		}
+		widgetsArg = append(widgetsArg, v)
+		return true
+	})
+	if err != nil {
+		return errors.WrapWithInvalidArgument(err)
+	}
+	for i := range widgetsArg {
+		if err := validateRequestParam("widgets["+strconv.Itoa(i)+"]", &widgetsArg[i]); err != nil {
+			return err
+		}
+	}
+	if err := w.impl.CreateWidgets(req.Context(), widgetsArg); err != nil {
+		return err
+	}
+	rw.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (w *widgetServiceHandler) HandleRenameWidget(rw http.ResponseWriter, req *http.Request) error {
+	pathParams := wrouter.PathParams(req)
+	if pathParams == nil {
+		return werror.Wrap(errors.NewInternal(), "path params not found on request: ensure this endpoint is registered with wrouter")
+	}
+	nameArgStr, ok := pathParams["name"]
+	if !ok {
+		return werror.WrapWithContextParams(req.Context(), errors.NewInvalidArgument(), "path parameter \"name\" not present")
+	}
+	nameArg := WidgetName(nameArgStr)
+	var newNameArg *WidgetName
+	if newNameArgStr := req.URL.Query().Get("newName"); newNameArgStr != "" {
+		newNameArgInternal := WidgetName(newNameArgStr)
+		newNameArg = &newNameArgInternal
+	}
+	if err := validateRequestParam("name", &nameArg); err != nil {
+		return err
+	}
+	if newNameArg != nil {
+		if err := validateRequestParam("newName", newNameArg); err != nil {
+			return err
+		}
+	}
+	if err := w.impl.RenameWidget(req.Context(), nameArg, newNameArg); err != nil {
+		return err
+	}
+	rw.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// validateRequestParam returns a Conjure InvalidArgument error with the provided field path as the safe "fieldPath"
+// parameter if value implements Validate() error and its Validate method returns an error. Implement
+// Validate on Conjure types (in a non-generated file of their package) to reject invalid requests before
+// they are passed to the server implementation.
+func validateRequestParam(fieldPath string, value interface{}) error {
+	validator, ok := value.(interface {
+		Validate() error
+	})
+	if !ok {
+		return nil
+	}
+	if err := validator.Validate(); err != nil {
+		return errors.WrapWithInvalidArgument(err, wparams.NewSafeParam("fieldPath", fieldPath))
+	}
+	return nil
+}
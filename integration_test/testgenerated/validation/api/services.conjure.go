@@ -0,0 +1,72 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	werror "github.com/palantir/witchcraft-go-error"
+)
+
+type WidgetServiceClient interface {
+	CreateWidget(ctx context.Context, widgetArg Widget) (Widget, error)
+	CreateWidgets(ctx context.Context, widgetsArg []Widget) error
+	RenameWidget(ctx context.Context, nameArg WidgetName, newNameArg *WidgetName) error
+}
+
+type widgetServiceClient struct {
+	client httpclient.Client
+}
+
+func NewWidgetServiceClient(client httpclient.Client) WidgetServiceClient {
+	return &widgetServiceClient{client: client}
+}
+
+func (c *widgetServiceClient) CreateWidget(ctx context.Context, widgetArg Widget) (Widget, error) {
+	var defaultReturnVal Widget
+	var returnVal *Widget
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("CreateWidget"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("POST"))
+	requestParams = append(requestParams, httpclient.WithPathf("/widgets/"))
+	requestParams = append(requestParams, httpclient.WithJSONRequest(widgetArg))
+	requestParams = append(requestParams, httpclient.WithJSONResponse(&returnVal))
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return defaultReturnVal, werror.WrapWithContextParams(ctx, err, "createWidget failed")
+	}
+	if returnVal == nil {
+		return defaultReturnVal, werror.ErrorWithContextParams(ctx, "createWidget response cannot be nil")
+	}
+	return *returnVal, nil
+}
+
+func (c *widgetServiceClient) CreateWidgets(ctx context.Context, widgetsArg []Widget) error {
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("CreateWidgets"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("PUT"))
+	requestParams = append(requestParams, httpclient.WithPathf("/widgets/"))
+	requestParams = append(requestParams, httpclient.WithJSONRequest(widgetsArg))
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return werror.WrapWithContextParams(ctx, err, "createWidgets failed")
+	}
+	return nil
+}
+
+func (c *widgetServiceClient) RenameWidget(ctx context.Context, nameArg WidgetName, newNameArg *WidgetName) error {
+	var requestParams []httpclient.RequestParam
+	requestParams = append(requestParams, httpclient.WithRPCMethodName("RenameWidget"))
+	requestParams = append(requestParams, httpclient.WithRequestMethod("POST"))
+	requestParams = append(requestParams, httpclient.WithPathf("/widgets/%s", url.PathEscape(fmt.Sprint(nameArg))))
+	queryParams := make(url.Values)
+	if newNameArg != nil {
+		queryParams.Set("newName", fmt.Sprint(*newNameArg))
+	}
+	requestParams = append(requestParams, httpclient.WithQueryValues(queryParams))
+	if _, err := c.client.Do(ctx, requestParams...); err != nil {
+		return werror.WrapWithContextParams(ctx, err, "renameWidget failed")
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+// This file was generated by Conjure and should not be manually edited.
+
+package api
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/pkg/safeyaml"
+	"github.com/tidwall/gjson"
+)
+
+type Widget struct {
+	Name string `json:"name"`
+	Size int    `json:"size"`
+}
+
+// NewWidget returns a Widget with the provided required fields and empty collections.
+func NewWidget(nameArg string, sizeArg int) Widget {
+	return Widget{Name: nameArg, Size: sizeArg}
+}
+
+// ValidateRequired returns an error if a required field of the Widget is missing.
+func (o *Widget) ValidateRequired() error {
+	return nil
+}
+
+func (o Widget) AppendJSON(out []byte) ([]byte, error) {
+	out = append(out, "{\"name\":"...)
+	out = appendJSONString(out, o.Name)
+	out = append(out, ",\"size\":"...)
+	out = strconv.AppendInt(out, int64(o.Size), 10)
+	out = append(out, '}')
+	return out, nil
+}
+
+func (o Widget) JSONSize() (int, error) {
+	size := 17
+	size += jsonStringSize(o.Name)
+	size += jsonIntSize(int64(o.Size))
+	return size, nil
+}
+
+func (o *Widget) unmarshalJSONValue(value gjson.Result) bool {
+	if value.Type == gjson.Null {
+		return true
+	}
+	if !value.IsObject() {
+		return false
+	}
+	var seenName, seenSize bool
+	ok := true
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "name":
+			if seenName {
+				ok = false
+				return false
+			}
+			seenName = true
+			if !unmarshalJSONString(field, &o.Name) {
+				ok = false
+				return false
+			}
+		case "size":
+			if seenSize {
+				ok = false
+				return false
+			}
+			seenSize = true
+			if !unmarshalJSONInt(field, &o.Size) {
+				ok = false
+				return false
+			}
+		default:
+			if matchesJSONField(key.Str, "name", "size") {
+				ok = false
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return false
+	}
+	return true
+}
+
+func (o *Widget) UnmarshalJSONStrict(data []byte) error {
+	value, err := parseJSONStrict(data)
+	if err != nil {
+		return err
+	}
+	return o.decodeJSONStrict(value)
+}
+
+func (o *Widget) decodeJSONStrict(value gjson.Result) error {
+	if !value.IsObject() {
+		return jsonTypeError(value, "object")
+	}
+	*o = Widget{}
+	var seenName, seenSize bool
+	var err error
+	value.ForEach(func(key, field gjson.Result) bool {
+		switch key.Str {
+		case "name":
+			if seenName {
+				err = fmt.Errorf("duplicate field \"name\"")
+				return false
+			}
+			seenName = true
+			o.Name, err = decodeJSONString(field)
+			if err != nil {
+				err = fmt.Errorf("field \"name\": %w", err)
+				return false
+			}
+		case "size":
+			if seenSize {
+				err = fmt.Errorf("duplicate field \"size\"")
+				return false
+			}
+			seenSize = true
+			o.Size, err = decodeJSONInt(field)
+			if err != nil {
+				err = fmt.Errorf("field \"size\": %w", err)
+				return false
+			}
+		default:
+			err = fmt.Errorf("unknown field %q", key.Str)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if !seenName {
+		return fmt.Errorf("field \"name\" is required")
+	}
+	if !seenSize {
+		return fmt.Errorf("field \"size\" is required")
+	}
+	return nil
+}
+
+func (o Widget) MarshalYAML() (interface{}, error) {
+	jsonBytes, err := safejson.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return safeyaml.JSONtoYAMLMapSlice(jsonBytes)
+}
+
+func (o *Widget) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	jsonBytes, err := safeyaml.UnmarshalerToJSONBytes(unmarshal)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, *&o)
+}
+
+// Equal returns true if the Widget is equal to other according to the Conjure semantics of its values.
+func (o Widget) Equal(other Widget) bool {
+	if o.Name != other.Name {
+		return false
+	}
+	if o.Size != other.Size {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of the Widget.
+func (o Widget) Clone() Widget {
+	out := o
+	return out
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Validate returns an error if the widget has no name or a negative size.
+func (o *Widget) Validate() error {
+	if o.Name == "" {
+		return fmt.Errorf("name must not be empty")
+	}
+	if o.Size < 0 {
+		return fmt.Errorf("size must not be negative")
+	}
+	return nil
+}
+
+// Validate returns an error if the name is not lower case.
+func (a WidgetName) Validate() error {
+	if strings.ToLower(string(a)) != string(a) {
+		return fmt.Errorf("widget name must be lower case")
+	}
+	return nil
+}
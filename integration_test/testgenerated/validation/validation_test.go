@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-client/httpclient"
+	"github.com/palantir/conjure-go-runtime/v2/conjure-go-contract/errors"
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/validation/api"
+	"github.com/palantir/conjure-go/v6/integration_test/testgenerated/validation/api/apitest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRequestParams(t *testing.T) {
+	ctx := context.Background()
+	fake := &api.FakeWidgetService{
+		CreateWidgetFunc: func(ctx context.Context, widget api.Widget) (api.Widget, error) {
+			return widget, nil
+		},
+	}
+	client := apitest.NewWidgetServiceTestPair(t, fake, apitest.TestPairOptions{
+		ClientParams: []httpclient.ClientParam{httpclient.WithMaxRetries(0)},
+	})
+
+	resp, err := client.CreateWidget(ctx, api.Widget{Name: "foo", Size: 1})
+	require.NoError(t, err)
+	assert.Equal(t, api.Widget{Name: "foo", Size: 1}, resp)
+
+	for _, tc := range []struct {
+		name      string
+		call      func() error
+		fieldPath string
+	}{
+		{
+			name: "body",
+			call: func() error {
+				_, err := client.CreateWidget(ctx, api.Widget{Size: 1})
+				return err
+			},
+			fieldPath: "widget",
+		},
+		{
+			name: "list element",
+			call: func() error {
+				return client.CreateWidgets(ctx, []api.Widget{{Name: "foo"}, {Name: "bar", Size: -1}})
+			},
+			fieldPath: "widgets[1]",
+		},
+		{
+			name: "path param",
+			call: func() error {
+				return client.RenameWidget(ctx, "Foo", nil)
+			},
+			fieldPath: "name",
+		},
+		{
+			name: "optional query param",
+			call: func() error {
+				newName := api.WidgetName("Bar")
+				return client.RenameWidget(ctx, "foo", &newName)
+			},
+			fieldPath: "newName",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.call()
+			require.Error(t, err)
+			conjureErr := errors.GetConjureError(err)
+			require.NotNil(t, conjureErr)
+			assert.Equal(t, errors.DefaultInvalidArgument.Name(), conjureErr.Name())
+			// the server sets fieldPath as a safe param, but clients receive all error parameters as unsafe
+			assert.Equal(t, tc.fieldPath, conjureErr.UnsafeParams()["fieldPath"])
+		})
+	}
+	assert.Len(t, fake.CreateWidgetCalls(), 1)
+	assert.Empty(t, fake.CreateWidgetsCalls())
+	assert.Empty(t, fake.RenameWidgetCalls())
+}
+
+func TestValidateWithBuilders(t *testing.T) {
+	// the generated ValidateRequired method of builders does not replace the hand-written Validate method
+	widget := api.NewWidget("", 1)
+	assert.NoError(t, widget.ValidateRequired())
+	assert.EqualError(t, widget.Validate(), "name must not be empty")
+}